@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// readConfigFile reads the config file at path, merged over the partial config
+// file its extends key names, if any, which may extend another one in turn.
+// Mappings are merged key by key, and any other value of the config file
+// replaces the one of the partial.
+func readConfigFile(path string) ([]byte, error) {
+	return readExtendingConfigFile(filepath.Clean(path), nil)
+}
+
+func readExtendingConfigFile(path string, extending []string) ([]byte, error) {
+	for _, p := range extending {
+		if p == path {
+			return nil, fmt.Errorf("config file '%s' extends itself", path)
+		}
+	}
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config map[interface{}]interface{}
+	if err := yaml.Unmarshal(buf, &config); err != nil {
+		// The caller reports the error, parsing the config.
+		return buf, nil
+	}
+	extends, found := config["extends"]
+	if !found {
+		return buf, nil
+	}
+	partialPath, ok := extends.(string)
+	if !ok || partialPath == "" {
+		return nil, fmt.Errorf("extends of config file '%s' must be the path of a config file", path)
+	}
+
+	partial, err := readExtendingConfigFile(resolveConfigPath(path, partialPath), append(extending, path))
+	if err != nil {
+		return nil, err
+	}
+	var base map[interface{}]interface{}
+	if err := yaml.Unmarshal(partial, &base); err != nil {
+		return nil, fmt.Errorf("error parsing '%s' as YAML: %w", resolveConfigPath(path, partialPath), err)
+	}
+	delete(config, "extends")
+	return yaml.Marshal(mergeConfigMaps(base, config))
+}
+
+// mergeConfigMaps returns the mapping base with the values of override, the
+// mappings of both being merged in turn.
+func mergeConfigMaps(base, override map[interface{}]interface{}) map[interface{}]interface{} {
+	merged := make(map[interface{}]interface{}, len(base)+len(override))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range override {
+		baseMap, baseIsMap := merged[key].(map[interface{}]interface{})
+		overrideMap, overrideIsMap := value.(map[interface{}]interface{})
+		if baseIsMap && overrideIsMap {
+			value = mergeConfigMaps(baseMap, overrideMap)
+		}
+		merged[key] = value
+	}
+	return merged
+}
+
+// resolveConfigPath returns the path p, found in the config file at
+// configPath, relative to the directory of the config file, unless it's
+// absolute or a URL.
+func resolveConfigPath(configPath, p string) string {
+	if u, err := url.Parse(p); err == nil && u.Scheme != "" && u.Host != "" {
+		return p
+	}
+	if filepath.IsAbs(p) {
+		return p
+	}
+	return filepath.Join(filepath.Dir(configPath), p)
+}
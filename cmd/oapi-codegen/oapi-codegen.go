@@ -14,15 +14,24 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path"
 	"path/filepath"
 	"runtime/debug"
+	"sort"
 	"strings"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"gopkg.in/yaml.v2"
 
 	"github.com/deepmap/oapi-codegen/v2/pkg/codegen"
@@ -44,6 +53,14 @@ var (
 	flagPrintUsage     bool
 	flagGenerate       string
 	flagTemplatesDir   string
+	flagVerbose        bool
+	flagTiming         bool
+	flagTimingFormat   string
+	flagAPICheck       string
+	flagAPICheckAllow  string
+	flagCheck          bool
+	flagWorkspace      string
+	flagWorkspaceFile  string
 
 	// Deprecated: The options below will be removed in a future
 	// release. Please use the new config file format.
@@ -61,6 +78,10 @@ type configuration struct {
 
 	// OutputFile is the filename to output.
 	OutputFile string `yaml:"output,omitempty"`
+
+	// Spec is the path of the spec to generate from, relative to the
+	// configuration file, when it's not given on the command line.
+	Spec string `yaml:"spec,omitempty"`
 }
 
 // oldConfiguration is deprecated. Please add no more flags here. It is here
@@ -91,6 +112,14 @@ func main() {
 	flag.StringVar(&flagPackageName, "package", "", "The package name for generated code.")
 	flag.BoolVar(&flagPrintUsage, "help", false, "Show this help and exit.")
 	flag.BoolVar(&flagPrintUsage, "h", false, "Same as -help.")
+	flag.BoolVar(&flagVerbose, "verbose", false, "Report the progress of generation on stderr.")
+	flag.BoolVar(&flagTiming, "timing", false, "Report the time spent in each phase of generation, and the slowest schemas and operations, on stderr.")
+	flag.StringVar(&flagTimingFormat, "timing-format", "text", "The format of the -timing report, text or json.")
+	flag.StringVar(&flagAPICheck, "apicheck", "", "A directory holding the previous generated code, whose exported API is compared to the generated one. Exits with status 3 on breaking changes.")
+	flag.StringVar(&flagAPICheckAllow, "apicheck-allow", "", "A file listing the declarations whose breaking changes -apicheck accepts, one per line.")
+	flag.BoolVar(&flagCheck, "check", false, "Check that the generated code is up to date instead of writing it. Exits with status 1 when it isn't.")
+	flag.StringVar(&flagWorkspace, "workspace", "", "Generate the code of every config file in a directory, or in a directory and its subdirectories when it ends with /..., eg, ./api/...")
+	flag.StringVar(&flagWorkspaceFile, "workspace-config", "oapi-codegen.yaml", "The pattern the names of the config files found by -workspace match.")
 
 	// All flags below are deprecated, and will be removed in a future release. Please do not
 	// update their behavior.
@@ -127,9 +156,11 @@ func main() {
 		return
 	}
 
-	if flag.NArg() < 1 {
-		errExit("Please specify a path to a OpenAPI 3.0 spec file\n")
-	} else if flag.NArg() > 1 {
+	if flagWorkspace != "" {
+		os.Exit(runWorkspaceCommand())
+	}
+
+	if flag.NArg() > 1 {
 		errExit("Only one OpenAPI 3.0 spec file is accepted and it must be the last CLI argument\n")
 	}
 
@@ -144,7 +175,7 @@ func main() {
 	// We don't know yet, so keep looking. Try to parse the configuration file,
 	// if given.
 	if oldConfigStyle == nil && (flagConfigFile != "") {
-		configFile, err := os.ReadFile(flagConfigFile)
+		configFile, err := readConfigFile(flagConfigFile)
 		if err != nil {
 			errExit("error reading config file '%s': %v\n", flagConfigFile, err)
 		}
@@ -200,7 +231,7 @@ func main() {
 	if !*oldConfigStyle {
 		// We simply read the configuration from disk.
 		if flagConfigFile != "" {
-			buf, err := os.ReadFile(flagConfigFile)
+			buf, err := readConfigFile(flagConfigFile)
 			if err != nil {
 				errExit("error reading config file '%s': %v\n", flagConfigFile, err)
 			}
@@ -231,7 +262,7 @@ func main() {
 	} else {
 		var oldConfig oldConfiguration
 		if flagConfigFile != "" {
-			buf, err := os.ReadFile(flagConfigFile)
+			buf, err := readConfigFile(flagConfigFile)
 			if err != nil {
 				errExit("error reading config file '%s': %v\n", flagConfigFile, err)
 			}
@@ -247,7 +278,15 @@ func main() {
 	// fields.
 	opts.Configuration = opts.UpdateDefaults()
 
-	if err := detectPackageName(&opts); err != nil {
+	specLocation := flag.Arg(0)
+	if specLocation == "" && opts.Spec != "" {
+		specLocation = resolveConfigPath(flagConfigFile, opts.Spec)
+	}
+	if specLocation == "" {
+		errExit("Please specify a path to a OpenAPI 3.0 spec file\n")
+	}
+
+	if err := detectPackageName(&opts, specLocation); err != nil {
 		errExit("%s\n", err)
 	}
 
@@ -266,28 +305,227 @@ func main() {
 		return
 	}
 
-	swagger, err := util.LoadSwaggerWithCircularReferenceCount(flag.Arg(0), opts.Compatibility.CircularReferenceLimit)
+	spec, err := readSpec(specLocation)
 	if err != nil {
-		errExit("error loading swagger spec in %s\n: %s", flag.Arg(0), err)
+		errExit("error loading swagger spec in %s\n: %s", specLocation, err)
 	}
 
-	if len(noVCSVersionOverride) > 0 {
-		opts.Configuration.NoVCSVersionOverride = &noVCSVersionOverride
+	if err := prepareConfiguration(&opts, specLocation); err != nil {
+		errExit("%s\n", err)
+	}
+	if flagCheck && opts.OutputFile == "" {
+		errExit("configuration error: -check requires an output file\n")
 	}
 
-	code, err := codegen.Generate(swagger, opts.Configuration)
+	if flagVerbose {
+		opts.Configuration.Progress = printProgress
+	}
+	var timings codegen.TimingReport
+	if flagTiming {
+		if flagTimingFormat != "text" && flagTimingFormat != "json" {
+			errExit("unknown timing format '%s', expected text or json\n", flagTimingFormat)
+		}
+		opts.Configuration.Timing = timings.Record
+	}
+
+	// Stop generating on interrupt. Nothing has been written by then, and
+	// the outputs are replaced atomically, so no partial file is left behind.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	files, warnings, err := codegen.GenerateFiles(ctx, spec, opts.Configuration)
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "WARNING: %s\n", w)
+	}
+	if flagTiming {
+		printTimings(&timings)
+	}
 	if err != nil {
 		errExit("error generating code: %s\n", err)
 	}
 
-	if opts.OutputFile != "" {
-		err = os.WriteFile(opts.OutputFile, []byte(code), 0o644)
+	// The previous generation is read before being replaced by the new one.
+	var oldAPI codegen.APIModel
+	if flagAPICheck != "" {
+		oldAPI, err = readAPI(flagAPICheck, files)
+		if err != nil {
+			errExit("error reading the previous generated code: %s\n", err)
+		}
+	}
+
+	if flagCheck {
+		stale, err := staleFiles(filepath.Dir(opts.OutputFile), files)
+		if err != nil {
+			errExit("error reading the generated code: %s\n", err)
+		}
+		for _, name := range stale {
+			fmt.Fprintf(os.Stderr, "%s is out of date\n", filepath.Join(filepath.Dir(opts.OutputFile), name))
+		}
+		if len(stale) > 0 {
+			os.Exit(1)
+		}
+	} else if opts.OutputFile != "" {
+		err = codegen.WriteFiles(filepath.Dir(opts.OutputFile), files)
 		if err != nil {
 			errExit("error writing generated code to file: %s\n", err)
 		}
+	} else if len(files) > 1 {
+		// Only the code itself can go to the standard output.
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		errExit("error writing generated code: %s can't all be written to standard output, an output file is needed\n", strings.Join(names, ", "))
+	} else {
+		fmt.Print(string(files[opts.PackageName+".gen.go"]))
+	}
+
+	if flagAPICheck != "" && !checkAPI(oldAPI, files) {
+		os.Exit(3)
+	}
+}
+
+// prepareConfiguration completes opts for generating the code of the spec at
+// specLocation, checking the options which require an output file, and
+// reading the previous manifest when it's needed.
+func prepareConfiguration(opts *configuration, specLocation string) error {
+	if len(noVCSVersionOverride) > 0 {
+		opts.Configuration.NoVCSVersionOverride = &noVCSVersionOverride
+	}
+	opts.Configuration.SpecLocation = specLocation
+
+	if opts.OutputFile != "" {
+		opts.Configuration.OutputFileName = filepath.Base(opts.OutputFile)
+	} else if opts.OutputOptions.Manifest {
+		return errors.New("configuration error: output-options.manifest requires an output file")
+	}
+	if opts.OutputFile == "" && opts.OutputOptions.EmbeddedSpecPackage != nil {
+		return errors.New("configuration error: output-options.embedded-spec-package requires an output file")
+	}
+	if opts.OutputFile == "" && opts.Generate.ParamExampleTests {
+		return errors.New("configuration error: generate.param-example-tests requires an output file")
+	}
+	if opts.OutputOptions.CompatAliases {
+		previous, err := codegen.ReadManifest(filepath.Dir(opts.OutputFile))
+		if err != nil {
+			return fmt.Errorf("error reading the previous manifest: %w", err)
+		}
+		opts.Configuration.PreviousManifest = previous
+	}
+	return nil
+}
+
+// staleFiles returns the names of files whose contents differ from those of
+// the files of dir, or which are missing from it, in order.
+func staleFiles(dir string, files map[string][]byte) ([]string, error) {
+	var stale []string
+	for name, content := range files {
+		existing, err := os.ReadFile(filepath.Join(dir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			stale = append(stale, name)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(existing, content) {
+			stale = append(stale, name)
+		}
+	}
+	sort.Strings(stale)
+	return stale, nil
+}
+
+// readAPI builds the API model of the Go files of the previous generation in
+// dir, named like the ones of the new one.
+func readAPI(dir string, files map[string][]byte) (codegen.APIModel, error) {
+	old := make(map[string][]byte)
+	for name := range files {
+		if filepath.Ext(name) != ".go" {
+			continue
+		}
+		code, err := os.ReadFile(filepath.Join(dir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		old[name] = code
+	}
+	if len(old) == 0 {
+		return nil, fmt.Errorf("no generated file found in %s", dir)
+	}
+	return codegen.NewAPIModel(old)
+}
+
+// checkAPI reports the changes from the old API to the one of files on
+// stderr, and returns whether none of them is an unexpected breaking change.
+func checkAPI(old codegen.APIModel, files map[string][]byte) bool {
+	code := make(map[string][]byte)
+	for name, content := range files {
+		if filepath.Ext(name) == ".go" {
+			code[name] = content
+		}
+	}
+	api, err := codegen.NewAPIModel(code)
+	if err != nil {
+		errExit("error reading the generated code: %s\n", err)
+	}
+	allowed := map[string]bool{}
+	if flagAPICheckAllow != "" {
+		allowed, err = codegen.ReadAPIAllowlist(flagAPICheckAllow)
+		if err != nil {
+			errExit("error reading the apicheck allowlist: %s\n", err)
+		}
+	}
+
+	compatible := true
+	for _, change := range codegen.CompareAPIs(old, api) {
+		level := "additive"
+		switch {
+		case change.Breaking && allowed[change.Name]:
+			level = "allowed"
+		case change.Breaking:
+			level = "breaking"
+			compatible = false
+		}
+		fmt.Fprintf(os.Stderr, "apicheck: %s: %s\n", level, change)
+	}
+	return compatible
+}
+
+// printProgress reports the progress of generation on stderr, once per phase
+// when it starts and finishes, and at most every tenth of the way through.
+func printProgress(phase string, done, total int) {
+	if done == 0 || done == total || done*10/total != (done-1)*10/total {
+		fmt.Fprintf(os.Stderr, "%s: %d/%d\n", phase, done, total)
+	}
+}
+
+// printTimings reports the timings of generation on stderr, in the format
+// chosen with -timing-format.
+func printTimings(timings *codegen.TimingReport) {
+	const slowest = 20
+	var err error
+	if flagTimingFormat == "json" {
+		err = timings.WriteJSON(os.Stderr, slowest)
 	} else {
-		fmt.Print(code)
+		err = timings.WriteText(os.Stderr, slowest)
+	}
+	if err != nil {
+		errExit("error writing timings: %s\n", err)
+	}
+}
+
+// readSpec reads the spec from a file, or from a URL.
+func readSpec(location string) ([]byte, error) {
+	u, err := url.Parse(location)
+	if err == nil && u.Scheme != "" && u.Host != "" {
+		return openapi3.ReadFromHTTP(http.DefaultClient)(nil, u)
 	}
+	return os.ReadFile(location)
 }
 
 func loadTemplateOverrides(templatesDir string) (map[string]string, error) {
@@ -326,8 +564,9 @@ func loadTemplateOverrides(templatesDir string) (map[string]string, error) {
 	return templates, nil
 }
 
-// detectPackageName detects and sets PackageName if not already set.
-func detectPackageName(cfg *configuration) error {
+// detectPackageName detects and sets PackageName if not already set, from the
+// output file or, failing that, from the name of the spec at specLocation.
+func detectPackageName(cfg *configuration, specLocation string) error {
 	if cfg.PackageName != "" {
 		return nil
 	}
@@ -356,7 +595,7 @@ func detectPackageName(cfg *configuration) error {
 	}
 
 	// Fallback to determining from the spec file name.
-	parts := strings.Split(filepath.Base(flag.Arg(0)), ".")
+	parts := strings.Split(filepath.Base(specLocation), ".")
 	cfg.PackageName = codegen.LowercaseFirstCharacter(codegen.ToCamelCase(parts[0]))
 
 	return nil
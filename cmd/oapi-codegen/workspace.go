@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/deepmap/oapi-codegen/v2/pkg/codegen"
+)
+
+// workspaceResult is the outcome of generating the code of a config file of
+// the workspace.
+type workspaceResult struct {
+	config   string
+	files    []string // The generated files, in order.
+	stale    []string // The generated files found out of date, with -check.
+	warnings []codegen.Warning
+	err      error
+}
+
+// runWorkspaceCommand generates the code of every config file -workspace
+// finds, and returns the exit status.
+func runWorkspaceCommand() int {
+	var conflicting []string
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "workspace", "workspace-config", "check":
+		default:
+			conflicting = append(conflicting, "-"+f.Name)
+		}
+	})
+	if len(conflicting) > 0 {
+		errExit("-workspace can't be combined with %s, each config file setting its own options\n", strings.Join(conflicting, ", "))
+	}
+	if flag.NArg() > 0 {
+		errExit("-workspace accepts no spec file, each config file naming its own with spec\n")
+	}
+
+	configs, err := findWorkspaceConfigs(flagWorkspace, flagWorkspaceFile)
+	if err != nil {
+		errExit("error finding the config files of the workspace: %s\n", err)
+	}
+	if len(configs) == 0 {
+		errExit("no config file named %s found in %s\n", flagWorkspaceFile, flagWorkspace)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	results := generateWorkspace(ctx, configs, flagCheck, runtime.GOMAXPROCS(0))
+	if !printWorkspaceSummary(os.Stdout, results) {
+		return 1
+	}
+	return 0
+}
+
+// findWorkspaceConfigs returns the paths of the files of the directory
+// pattern names whose names match name, in order. A pattern ending with /...
+// names a directory along with its subdirectories, except those which the go
+// command ignores too: testdata, vendor, and those whose names start with a
+// dot or an underscore.
+func findWorkspaceConfigs(pattern, name string) ([]string, error) {
+	if _, err := filepath.Match(name, ""); err != nil {
+		return nil, fmt.Errorf("invalid config file pattern %q: %w", name, err)
+	}
+	root, recursive := strings.CutSuffix(pattern, "...")
+	if recursive {
+		root = strings.TrimSuffix(root, "/")
+		if root == "" {
+			root = "."
+		}
+	}
+
+	var configs []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path == root {
+				return nil
+			}
+			base := d.Name()
+			if !recursive || base == "testdata" || base == "vendor" ||
+				strings.HasPrefix(base, ".") || strings.HasPrefix(base, "_") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if matched, _ := filepath.Match(name, d.Name()); matched {
+			configs = append(configs, path)
+		}
+		return nil
+	})
+	return configs, err
+}
+
+// generateWorkspace generates the code of each of configs, with up to jobs of
+// them at a time, and returns their results, in the order of configs. Each
+// generation only depends on its config file, so the generated code is the
+// same as when they're generated one by one.
+func generateWorkspace(ctx context.Context, configs []string, check bool, jobs int) []workspaceResult {
+	results := make([]workspaceResult, len(configs))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for j := 0; j < jobs && j < len(configs); j++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				results[i] = generateWorkspaceConfig(ctx, configs[i], check)
+			}
+		}()
+	}
+	for i := range configs {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+	return results
+}
+
+// generateWorkspaceConfig generates the code of the config file at path, and
+// writes it or, with check, compares it to the code already generated.
+func generateWorkspaceConfig(ctx context.Context, path string, check bool) workspaceResult {
+	result := workspaceResult{config: path}
+	opts, specLocation, err := loadWorkspaceConfig(path)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	spec, err := readSpec(specLocation)
+	if err != nil {
+		result.err = fmt.Errorf("error loading swagger spec in %s: %w", specLocation, err)
+		return result
+	}
+
+	files, warnings, err := codegen.GenerateFiles(ctx, spec, opts.Configuration)
+	result.warnings = warnings
+	if err != nil {
+		result.err = fmt.Errorf("error generating code: %w", err)
+		return result
+	}
+	for name := range files {
+		result.files = append(result.files, name)
+	}
+	sort.Strings(result.files)
+
+	dir := filepath.Dir(opts.OutputFile)
+	if check {
+		result.stale, err = staleFiles(dir, files)
+		if err != nil {
+			result.err = fmt.Errorf("error reading the generated code: %w", err)
+		}
+	} else if err := codegen.WriteFiles(dir, files); err != nil {
+		result.err = fmt.Errorf("error writing generated code to file: %w", err)
+	}
+	return result
+}
+
+// loadWorkspaceConfig reads the config file at path, which must be of the
+// current style and name its spec, output file and package, and returns it
+// along with the location of its spec. The paths of the spec and of the
+// output file are relative to the config file.
+func loadWorkspaceConfig(path string) (configuration, string, error) {
+	var opts configuration
+	buf, err := readConfigFile(path)
+	if err != nil {
+		return opts, "", fmt.Errorf("error reading config file: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(buf, &opts); err != nil {
+		return opts, "", fmt.Errorf("error parsing config file: %w", err)
+	}
+	switch {
+	case opts.Spec == "":
+		return opts, "", errors.New("configuration error: spec is required in a workspace")
+	case opts.OutputFile == "":
+		return opts, "", errors.New("configuration error: output is required in a workspace")
+	case opts.PackageName == "":
+		return opts, "", errors.New("configuration error: package is required in a workspace")
+	}
+	specLocation := resolveConfigPath(path, opts.Spec)
+	opts.OutputFile = resolveConfigPath(path, opts.OutputFile)
+
+	opts.Configuration = opts.UpdateDefaults()
+	if err := opts.Validate(); err != nil {
+		return opts, "", fmt.Errorf("configuration error: %w", err)
+	}
+	if err := prepareConfiguration(&opts, specLocation); err != nil {
+		return opts, "", err
+	}
+	return opts, specLocation, nil
+}
+
+// printWorkspaceSummary reports the result of each config file of the
+// workspace on w, followed by their totals, and returns whether they all
+// succeeded, with their code up to date.
+func printWorkspaceSummary(w io.Writer, results []workspaceResult) bool {
+	var succeeded, stale, failed, warnings int
+	for _, result := range results {
+		switch {
+		case result.err != nil:
+			failed++
+			fmt.Fprintf(w, "FAIL\t%s: %s\n", result.config, result.err)
+		case len(result.stale) > 0:
+			stale++
+			fmt.Fprintf(w, "STALE\t%s: %s out of date\n", result.config, strings.Join(result.stale, ", "))
+		default:
+			succeeded++
+			fmt.Fprintf(w, "ok\t%s: %s\n", result.config, strings.Join(result.files, ", "))
+		}
+		for _, warning := range result.warnings {
+			warnings++
+			fmt.Fprintf(w, "\tWARNING: %s\n", warning)
+		}
+	}
+	fmt.Fprintf(w, "%d config files: %d ok, %d out of date, %d failed, %d warnings\n",
+		len(results), succeeded, stale, failed, warnings)
+	return stale == 0 && failed == 0
+}
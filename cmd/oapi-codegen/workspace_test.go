@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+const workspaceSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: workspace
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+`
+
+// writeWorkspace writes files, keyed by their paths relative to a new
+// directory, and returns the directory.
+func writeWorkspace(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	return dir
+}
+
+func TestFindWorkspaceConfigs(t *testing.T) {
+	dir := writeWorkspace(t, map[string]string{
+		"oapi-codegen.yaml":                "",
+		"pets/oapi-codegen.yaml":           "",
+		"pets/v2/oapi-codegen.yaml":        "",
+		"users/oapi-codegen.yaml":          "",
+		"users/other.yaml":                 "",
+		"users/testdata/oapi-codegen.yaml": "",
+		".cache/oapi-codegen.yaml":         "",
+		"_old/oapi-codegen.yaml":           "",
+	})
+
+	configs, err := findWorkspaceConfigs(dir+"/...", "oapi-codegen.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "oapi-codegen.yaml"),
+		filepath.Join(dir, "pets/oapi-codegen.yaml"),
+		filepath.Join(dir, "pets/v2/oapi-codegen.yaml"),
+		filepath.Join(dir, "users/oapi-codegen.yaml"),
+	}, configs)
+
+	configs, err = findWorkspaceConfigs(filepath.Join(dir, "pets"), "oapi-codegen.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "pets/oapi-codegen.yaml")}, configs)
+
+	configs, err = findWorkspaceConfigs(filepath.Join(dir, "users"), "*.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "users/oapi-codegen.yaml"), filepath.Join(dir, "users/other.yaml")}, configs)
+}
+
+func TestReadConfigFileExtends(t *testing.T) {
+	dir := writeWorkspace(t, map[string]string{
+		"common.yaml": `
+generate:
+  models: true
+output-options:
+  skip-prune: true
+  exclude-tags: [internal]
+`,
+		"base.yaml": `
+extends: common.yaml
+output-options:
+  exclude-tags: [admin]
+`,
+		"pets/oapi-codegen.yaml": `
+extends: ../base.yaml
+package: pets
+output-options:
+  skip-fmt: true
+`,
+		"loop/a.yaml": "extends: b.yaml\n",
+		"loop/b.yaml": "extends: a.yaml\n",
+	})
+
+	buf, err := readConfigFile(filepath.Join(dir, "pets/oapi-codegen.yaml"))
+	require.NoError(t, err)
+	var opts configuration
+	require.NoError(t, yaml.UnmarshalStrict(buf, &opts))
+	assert.Equal(t, "pets", opts.PackageName)
+	assert.True(t, opts.Generate.Models)
+	assert.True(t, opts.OutputOptions.SkipPrune)
+	assert.True(t, opts.OutputOptions.SkipFmt)
+	assert.Equal(t, []string{"admin"}, opts.OutputOptions.ExcludeTags)
+
+	_, err = readConfigFile(filepath.Join(dir, "loop/a.yaml"))
+	assert.EqualError(t, err, "config file '"+filepath.Join(dir, "loop/a.yaml")+"' extends itself")
+}
+
+func TestGenerateWorkspace(t *testing.T) {
+	dir := writeWorkspace(t, map[string]string{
+		"common.yaml": `
+generate:
+  models: true
+output-options:
+  skip-prune: true
+`,
+		"pets/oapi-codegen.yaml": `
+extends: ../common.yaml
+package: pets
+spec: ../specs/pets.yaml
+output: pets.gen.go
+`,
+		"stores/oapi-codegen.yaml": `
+extends: ../common.yaml
+package: stores
+spec: stores.yaml
+output: gen/stores.gen.go
+`,
+		"stores/stores.yaml": workspaceSpec,
+		"specs/pets.yaml":    workspaceSpec,
+		"users/oapi-codegen.yaml": `
+extends: ../common.yaml
+package: users
+output: users.gen.go
+`,
+	})
+	configs, err := findWorkspaceConfigs(dir+"/...", "oapi-codegen.yaml")
+	require.NoError(t, err)
+	require.Len(t, configs, 3)
+
+	// summary runs the workspace, returning the summary with the paths
+	// relative to dir.
+	summary := func(check bool) (string, bool) {
+		results := generateWorkspace(context.Background(), configs, check, 2)
+		var out strings.Builder
+		ok := printWorkspaceSummary(&out, results)
+		return strings.ReplaceAll(out.String(), dir+string(filepath.Separator), ""), ok
+	}
+
+	out, ok := summary(false)
+	assert.False(t, ok)
+	assert.Equal(t, "ok\tpets/oapi-codegen.yaml: pets.gen.go\n"+
+		"ok\tstores/oapi-codegen.yaml: stores.gen.go\n"+
+		"FAIL\tusers/oapi-codegen.yaml: configuration error: spec is required in a workspace\n"+
+		"3 config files: 2 ok, 0 out of date, 1 failed, 0 warnings\n", out)
+
+	code, err := os.ReadFile(filepath.Join(dir, "pets/pets.gen.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(code), "package pets")
+	assert.Contains(t, string(code), "type Pet struct {")
+	code, err = os.ReadFile(filepath.Join(dir, "stores/gen/stores.gen.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(code), "package stores")
+
+	// The code just generated is up to date, until it's edited.
+	configs = configs[:2]
+	out, ok = summary(true)
+	assert.True(t, ok)
+	assert.Contains(t, out, "2 config files: 2 ok, 0 out of date, 0 failed, 0 warnings\n")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pets/pets.gen.go"), append(code, '\n'), 0o644))
+	out, ok = summary(true)
+	assert.False(t, ok)
+	assert.Contains(t, out, "STALE\tpets/oapi-codegen.yaml: pets.gen.go out of date\n")
+	assert.Contains(t, out, "2 config files: 1 ok, 1 out of date, 0 failed, 0 warnings\n")
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -70,8 +71,12 @@ type Client struct {
 	Client HttpRequestDoer
 
 	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
+	// the network. They're applied in order, before the ones given to the call.
 	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -118,6 +123,84 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// ListThings request
@@ -135,7 +218,7 @@ func (c *Client) ListThings(ctx context.Context, reqEditors ...RequestEditorFn)
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "ListThings", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -147,7 +230,7 @@ func (c *Client) AddThingWithBody(ctx context.Context, contentType string, body
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "AddThing", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -159,7 +242,7 @@ func (c *Client) AddThing(ctx context.Context, body AddThingJSONRequestBody, req
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "AddThing", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -232,15 +315,24 @@ func NewAddThingRequestWithBody(server string, contentType string, body io.Reade
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
 		}
 	}
-	for _, r := range additionalEditors {
+	for i, r := range additionalEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
 		}
 	}
 	return nil
@@ -273,6 +365,60 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// ListThingsWithResponse request
@@ -284,6 +430,68 @@ type ClientWithResponsesInterface interface {
 	AddThingWithResponse(ctx context.Context, body AddThingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddThingResponse, error)
 }
 
+// ListThingsWithResponse request returning *ListThingsResponse
+func (c *ClientWithResponses) ListThingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListThingsResponse, error) {
+	rsp, err := c.ListThings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseListThingsResponseWithoutBody(rsp)
+	}
+	return ParseListThingsResponse(rsp)
+}
+
+// parseListThingsResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseListThingsResponseWithoutBody(rsp *http.Response) (*ListThingsResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &ListThingsResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// AddThingWithBodyWithResponse request with arbitrary body returning *AddThingResponse
+func (c *ClientWithResponses) AddThingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddThingResponse, error) {
+	rsp, err := c.AddThingWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseAddThingResponseWithoutBody(rsp)
+	}
+	return ParseAddThingResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddThingWithResponse(ctx context.Context, body AddThingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddThingResponse, error) {
+	rsp, err := c.AddThing(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseAddThingResponseWithoutBody(rsp)
+	}
+	return ParseAddThingResponse(rsp)
+}
+
+// parseAddThingResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseAddThingResponseWithoutBody(rsp *http.Response) (*AddThingResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &AddThingResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ListThingsResponse is the response of ListThings. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type ListThingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -306,6 +514,9 @@ func (r ListThingsResponse) StatusCode() int {
 	return 0
 }
 
+// AddThingResponse is the response of AddThing. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type AddThingResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -328,36 +539,12 @@ func (r AddThingResponse) StatusCode() int {
 	return 0
 }
 
-// ListThingsWithResponse request returning *ListThingsResponse
-func (c *ClientWithResponses) ListThingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListThingsResponse, error) {
-	rsp, err := c.ListThings(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListThingsResponse(rsp)
-}
-
-// AddThingWithBodyWithResponse request with arbitrary body returning *AddThingResponse
-func (c *ClientWithResponses) AddThingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddThingResponse, error) {
-	rsp, err := c.AddThingWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAddThingResponse(rsp)
-}
-
-func (c *ClientWithResponses) AddThingWithResponse(ctx context.Context, body AddThingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddThingResponse, error) {
-	rsp, err := c.AddThing(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAddThingResponse(rsp)
-}
-
-// ParseListThingsResponse parses an HTTP response from a ListThingsWithResponse call
+// ParseListThingsResponse parses an HTTP response from a ListThingsWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseListThingsResponse(rsp *http.Response) (*ListThingsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -380,10 +567,12 @@ func ParseListThingsResponse(rsp *http.Response) (*ListThingsResponse, error) {
 	return response, nil
 }
 
-// ParseAddThingResponse parses an HTTP response from a AddThingWithResponse call
+// ParseAddThingResponse parses an HTTP response from a AddThingWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseAddThingResponse(rsp *http.Response) (*AddThingResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -406,6 +595,56 @@ func ParseAddThingResponse(rsp *http.Response) (*AddThingResponse, error) {
 	return response, nil
 }
 
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "ListThings":
+		return ParseListThingsResponse(rsp)
+	case "AddThing":
+		return ParseAddThingResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -458,6 +697,20 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// NewListThingsHandler returns the handler RegisterHandlers routes the
+// ListThings operation to, so that it can be mounted on its own.
+func NewListThingsHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.ListThings
+}
+
+// NewAddThingHandler returns the handler RegisterHandlers routes the
+// AddThing operation to, so that it can be mounted on its own.
+func NewAddThingHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.AddThing
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
 	RegisterHandlersWithBaseURL(router, si, "")
@@ -466,13 +719,8 @@ func RegisterHandlers(router EchoRouter, si ServerInterface) {
 // Registers handlers, and prepends BaseURL to the paths, so that the paths
 // can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
-
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
-
-	router.GET(baseURL+"/things", wrapper.ListThings)
-	router.POST(baseURL+"/things", wrapper.AddThing)
+	router.GET(baseURL+"/things", NewListThingsHandler(si))
+	router.POST(baseURL+"/things", NewAddThingHandler(si))
 
 }
 
@@ -4,8 +4,10 @@
 package customclienttype
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -41,8 +43,12 @@ type CustomClientType struct {
 	Client HttpRequestDoer
 
 	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
+	// the network. They're applied in order, before the ones given to the call.
 	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -89,6 +95,84 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *CustomClientType) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// GetClient request
@@ -101,7 +185,7 @@ func (c *CustomClientType) GetClient(ctx context.Context, reqEditors ...RequestE
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetClient", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -134,15 +218,24 @@ func NewGetClientRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *CustomClientType) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *CustomClientType) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
 		}
 	}
-	for _, r := range additionalEditors {
+	for i, r := range additionalEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
 		}
 	}
 	return nil
@@ -175,12 +268,93 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// GetClientWithResponse request
 	GetClientWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientResponse, error)
 }
 
+// GetClientWithResponse request returning *GetClientResponse
+func (c *ClientWithResponses) GetClientWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientResponse, error) {
+	rsp, err := c.GetClient(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetClientResponseWithoutBody(rsp)
+	}
+	return ParseGetClientResponse(rsp)
+}
+
+// parseGetClientResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetClientResponseWithoutBody(rsp *http.Response) (*GetClientResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetClientResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetClientResponse is the response of GetClient. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type GetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -203,19 +377,12 @@ func (r GetClientResponse) StatusCode() int {
 	return 0
 }
 
-// GetClientWithResponse request returning *GetClientResponse
-func (c *ClientWithResponses) GetClientWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientResponse, error) {
-	rsp, err := c.GetClient(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetClientResponse(rsp)
-}
-
-// ParseGetClientResponse parses an HTTP response from a GetClientWithResponse call
+// ParseGetClientResponse parses an HTTP response from a GetClientWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetClientResponse(rsp *http.Response) (*GetClientResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -237,3 +404,51 @@ func ParseGetClientResponse(rsp *http.Response) (*GetClientResponse, error) {
 
 	return response, nil
 }
+
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "GetClient":
+		return ParseGetClientResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
@@ -311,35 +311,67 @@ func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string)
 	})
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
-
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		}
 	}
-	wrapper := ServerInterfaceWrapper{
+	return &ServerInterfaceWrapper{
 		Handler:            si,
 		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+		ErrorHandlerFunc:   errorHandlerFunc,
 	}
+}
+
+// NewFindPetsHandler returns the handler HandlerWithOptions routes the
+// FindPets operation to, so that it can be mounted on its own.
+func NewFindPetsHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).FindPets
+}
+
+// NewAddPetHandler returns the handler HandlerWithOptions routes the
+// AddPet operation to, so that it can be mounted on its own.
+func NewAddPetHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).AddPet
+}
 
+// NewDeletePetHandler returns the handler HandlerWithOptions routes the
+// DeletePet operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewDeletePetHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).DeletePet
+}
+
+// NewFindPetByIDHandler returns the handler HandlerWithOptions routes the
+// FindPetByID operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewFindPetByIDHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).FindPetByID
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/pets", wrapper.FindPets)
+		r.Get(options.BaseURL+"/pets", NewFindPetsHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pets", wrapper.AddPet)
+		r.Post(options.BaseURL+"/pets", NewAddPetHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/pets/{id}", wrapper.DeletePet)
+		r.Delete(options.BaseURL+"/pets/{id}", NewDeletePetHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/pets/{id}", wrapper.FindPetByID)
+		r.Get(options.BaseURL+"/pets/{id}", NewFindPetByIDHandler(si, options))
 	})
 
 	return r
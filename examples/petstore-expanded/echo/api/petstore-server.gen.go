@@ -121,6 +121,34 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// NewFindPetsHandler returns the handler RegisterHandlers routes the
+// FindPets operation to, so that it can be mounted on its own.
+func NewFindPetsHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.FindPets
+}
+
+// NewAddPetHandler returns the handler RegisterHandlers routes the
+// AddPet operation to, so that it can be mounted on its own.
+func NewAddPetHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.AddPet
+}
+
+// NewDeletePetHandler returns the handler RegisterHandlers routes the
+// DeletePet operation to, so that it can be mounted on its own.
+func NewDeletePetHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.DeletePet
+}
+
+// NewFindPetByIDHandler returns the handler RegisterHandlers routes the
+// FindPetByID operation to, so that it can be mounted on its own.
+func NewFindPetByIDHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.FindPetByID
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
 	RegisterHandlersWithBaseURL(router, si, "")
@@ -129,15 +157,10 @@ func RegisterHandlers(router EchoRouter, si ServerInterface) {
 // Registers handlers, and prepends BaseURL to the paths, so that the paths
 // can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
-
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
-
-	router.GET(baseURL+"/pets", wrapper.FindPets)
-	router.POST(baseURL+"/pets", wrapper.AddPet)
-	router.DELETE(baseURL+"/pets/:id", wrapper.DeletePet)
-	router.GET(baseURL+"/pets/:id", wrapper.FindPetByID)
+	router.GET(baseURL+"/pets", NewFindPetsHandler(si))
+	router.POST(baseURL+"/pets", NewAddPetHandler(si))
+	router.DELETE(baseURL+"/pets/:id", NewDeletePetHandler(si))
+	router.GET(baseURL+"/pets/:id", NewFindPetByIDHandler(si))
 
 }
 
@@ -85,7 +85,7 @@ func (siw *ServerInterfaceWrapper) DeletePet(c *fiber.Ctx) error {
 	// ------------- Path parameter "id" -------------
 	var id int64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
@@ -101,7 +101,7 @@ func (siw *ServerInterfaceWrapper) FindPetByID(c *fiber.Ctx) error {
 	// ------------- Path parameter "id" -------------
 	var id int64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
@@ -115,6 +115,42 @@ type FiberServerOptions struct {
 	Middlewares []MiddlewareFunc
 }
 
+// NewFindPetsHandler returns the handler RegisterHandlersWithOptions
+// routes the FindPets operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewFindPetsHandler(si ServerInterface) fiber.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.FindPets
+}
+
+// NewAddPetHandler returns the handler RegisterHandlersWithOptions
+// routes the AddPet operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewAddPetHandler(si ServerInterface) fiber.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.AddPet
+}
+
+// NewDeletePetHandler returns the handler RegisterHandlersWithOptions
+// routes the DeletePet operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewDeletePetHandler(si ServerInterface) fiber.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.DeletePet
+}
+
+// NewFindPetByIDHandler returns the handler RegisterHandlersWithOptions
+// routes the FindPetByID operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewFindPetByIDHandler(si ServerInterface) fiber.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.FindPetByID
+}
+
 // RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
 func RegisterHandlers(router fiber.Router, si ServerInterface) {
 	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
@@ -122,21 +158,17 @@ func RegisterHandlers(router fiber.Router, si ServerInterface) {
 
 // RegisterHandlersWithOptions creates http.Handler with additional options
 func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
-
 	for _, m := range options.Middlewares {
-		router.Use(m)
+		router.Use(fiber.Handler(m))
 	}
 
-	router.Get(options.BaseURL+"/pets", wrapper.FindPets)
+	router.Get(options.BaseURL+"/pets", NewFindPetsHandler(si))
 
-	router.Post(options.BaseURL+"/pets", wrapper.AddPet)
+	router.Post(options.BaseURL+"/pets", NewAddPetHandler(si))
 
-	router.Delete(options.BaseURL+"/pets/:id", wrapper.DeletePet)
+	router.Delete(options.BaseURL+"/pets/:id", NewDeletePetHandler(si))
 
-	router.Get(options.BaseURL+"/pets/:id", wrapper.FindPetByID)
+	router.Get(options.BaseURL+"/pets/:id", NewFindPetByIDHandler(si))
 
 }
 
@@ -145,30 +145,58 @@ type GinServerOptions struct {
 	ErrorHandler func(*gin.Context, error, int)
 }
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
-
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandler, defaulting to a JSON object with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options GinServerOptions) *ServerInterfaceWrapper {
 	errorHandler := options.ErrorHandler
 	if errorHandler == nil {
 		errorHandler = func(c *gin.Context, err error, statusCode int) {
 			c.JSON(statusCode, gin.H{"msg": err.Error()})
 		}
 	}
-
-	wrapper := ServerInterfaceWrapper{
+	return &ServerInterfaceWrapper{
 		Handler:            si,
 		HandlerMiddlewares: options.Middlewares,
 		ErrorHandler:       errorHandler,
 	}
+}
+
+// NewFindPetsHandler returns the handler RegisterHandlersWithOptions routes the
+// FindPets operation to, so that it can be mounted on its own.
+func NewFindPetsHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).FindPets
+}
+
+// NewAddPetHandler returns the handler RegisterHandlersWithOptions routes the
+// AddPet operation to, so that it can be mounted on its own.
+func NewAddPetHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).AddPet
+}
+
+// NewDeletePetHandler returns the handler RegisterHandlersWithOptions routes the
+// DeletePet operation to, so that it can be mounted on its own.
+func NewDeletePetHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).DeletePet
+}
+
+// NewFindPetByIDHandler returns the handler RegisterHandlersWithOptions routes the
+// FindPetByID operation to, so that it can be mounted on its own.
+func NewFindPetByIDHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).FindPetByID
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
 
-	router.GET(options.BaseURL+"/pets", wrapper.FindPets)
-	router.POST(options.BaseURL+"/pets", wrapper.AddPet)
-	router.DELETE(options.BaseURL+"/pets/:id", wrapper.DeletePet)
-	router.GET(options.BaseURL+"/pets/:id", wrapper.FindPetByID)
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	router.GET(options.BaseURL+"/pets", NewFindPetsHandler(si, options))
+	router.POST(options.BaseURL+"/pets", NewAddPetHandler(si, options))
+	router.DELETE(options.BaseURL+"/pets/:id", NewDeletePetHandler(si, options))
+	router.GET(options.BaseURL+"/pets/:id", NewFindPetByIDHandler(si, options))
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
@@ -283,6 +283,49 @@ func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string
 	})
 }
 
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options GorillaServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   errorHandlerFunc,
+	}
+}
+
+// NewFindPetsHandler returns the handler HandlerWithOptions routes the
+// FindPets operation to, so that it can be mounted on its own.
+func NewFindPetsHandler(si ServerInterface, options GorillaServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).FindPets
+}
+
+// NewAddPetHandler returns the handler HandlerWithOptions routes the
+// AddPet operation to, so that it can be mounted on its own.
+func NewAddPetHandler(si ServerInterface, options GorillaServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).AddPet
+}
+
+// NewDeletePetHandler returns the handler HandlerWithOptions routes the
+// DeletePet operation to, so that it can be mounted on its own.
+// Its path parameters are read with mux.Vars though.
+func NewDeletePetHandler(si ServerInterface, options GorillaServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).DeletePet
+}
+
+// NewFindPetByIDHandler returns the handler HandlerWithOptions routes the
+// FindPetByID operation to, so that it can be mounted on its own.
+// Its path parameters are read with mux.Vars though.
+func NewFindPetByIDHandler(si ServerInterface, options GorillaServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).FindPetByID
+}
+
 // HandlerWithOptions creates http.Handler with additional options
 func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
 	r := options.BaseRouter
@@ -290,24 +333,14 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 	if r == nil {
 		r = mux.NewRouter()
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
 
-	r.HandleFunc(options.BaseURL+"/pets", wrapper.FindPets).Methods("GET")
+	r.HandleFunc(options.BaseURL+"/pets", NewFindPetsHandler(si, options)).Methods("GET")
 
-	r.HandleFunc(options.BaseURL+"/pets", wrapper.AddPet).Methods("POST")
+	r.HandleFunc(options.BaseURL+"/pets", NewAddPetHandler(si, options)).Methods("POST")
 
-	r.HandleFunc(options.BaseURL+"/pets/{id}", wrapper.DeletePet).Methods("DELETE")
+	r.HandleFunc(options.BaseURL+"/pets/{id}", NewDeletePetHandler(si, options)).Methods("DELETE")
 
-	r.HandleFunc(options.BaseURL+"/pets/{id}", wrapper.FindPetByID).Methods("GET")
+	r.HandleFunc(options.BaseURL+"/pets/{id}", NewFindPetByIDHandler(si, options)).Methods("GET")
 
 	return r
 }
@@ -121,6 +121,42 @@ type IrisServerOptions struct {
 	Middlewares []MiddlewareFunc
 }
 
+// NewFindPetsHandler returns the handler RegisterHandlersWithOptions
+// routes the FindPets operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewFindPetsHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.FindPets
+}
+
+// NewAddPetHandler returns the handler RegisterHandlersWithOptions
+// routes the AddPet operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewAddPetHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.AddPet
+}
+
+// NewDeletePetHandler returns the handler RegisterHandlersWithOptions
+// routes the DeletePet operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewDeletePetHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.DeletePet
+}
+
+// NewFindPetByIDHandler returns the handler RegisterHandlersWithOptions
+// routes the FindPetByID operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewFindPetByIDHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.FindPetByID
+}
+
 // RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
 func RegisterHandlers(router *iris.Application, si ServerInterface) {
 	RegisterHandlersWithOptions(router, si, IrisServerOptions{})
@@ -129,14 +165,14 @@ func RegisterHandlers(router *iris.Application, si ServerInterface) {
 // RegisterHandlersWithOptions creates http.Handler with additional options
 func RegisterHandlersWithOptions(router *iris.Application, si ServerInterface, options IrisServerOptions) {
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	for _, m := range options.Middlewares {
+		router.Use(iris.Handler(m))
 	}
 
-	router.Get(options.BaseURL+"/pets", wrapper.FindPets)
-	router.Post(options.BaseURL+"/pets", wrapper.AddPet)
-	router.Delete(options.BaseURL+"/pets/:id", wrapper.DeletePet)
-	router.Get(options.BaseURL+"/pets/:id", wrapper.FindPetByID)
+	router.Get(options.BaseURL+"/pets", NewFindPetsHandler(si))
+	router.Post(options.BaseURL+"/pets", NewAddPetHandler(si))
+	router.Delete(options.BaseURL+"/pets/:id", NewDeletePetHandler(si))
+	router.Get(options.BaseURL+"/pets/:id", NewFindPetByIDHandler(si))
 
 	router.Build()
 }
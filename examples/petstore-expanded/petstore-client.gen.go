@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -81,8 +82,12 @@ type Client struct {
 	Client HttpRequestDoer
 
 	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
+	// the network. They're applied in order, before the ones given to the call.
 	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -129,6 +134,84 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// FindPets request
@@ -152,7 +235,7 @@ func (c *Client) FindPets(ctx context.Context, params *FindPetsParams, reqEditor
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "FindPets", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -164,7 +247,7 @@ func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "AddPet", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -176,7 +259,7 @@ func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEdit
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "AddPet", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -188,7 +271,7 @@ func (c *Client) DeletePet(ctx context.Context, id int64, reqEditors ...RequestE
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "DeletePet", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -200,7 +283,7 @@ func (c *Client) FindPetByID(ctx context.Context, id int64, reqEditors ...Reques
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "FindPetByID", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -379,15 +462,24 @@ func NewFindPetByIDRequest(server string, id int64) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
 		}
 	}
-	for _, r := range additionalEditors {
+	for i, r := range additionalEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
 		}
 	}
 	return nil
@@ -420,6 +512,60 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// FindPetsWithResponse request
@@ -437,6 +583,116 @@ type ClientWithResponsesInterface interface {
 	FindPetByIDWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*FindPetByIDResponse, error)
 }
 
+// FindPetsWithResponse request returning *FindPetsResponse
+func (c *ClientWithResponses) FindPetsWithResponse(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*FindPetsResponse, error) {
+	rsp, err := c.FindPets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseFindPetsResponseWithoutBody(rsp)
+	}
+	return ParseFindPetsResponse(rsp)
+}
+
+// parseFindPetsResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseFindPetsResponseWithoutBody(rsp *http.Response) (*FindPetsResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &FindPetsResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// AddPetWithBodyWithResponse request with arbitrary body returning *AddPetResponse
+func (c *ClientWithResponses) AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
+	rsp, err := c.AddPetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseAddPetResponseWithoutBody(rsp)
+	}
+	return ParseAddPetResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
+	rsp, err := c.AddPet(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseAddPetResponseWithoutBody(rsp)
+	}
+	return ParseAddPetResponse(rsp)
+}
+
+// parseAddPetResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseAddPetResponseWithoutBody(rsp *http.Response) (*AddPetResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &AddPetResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// DeletePetWithResponse request returning *DeletePetResponse
+func (c *ClientWithResponses) DeletePetWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeletePetResponse, error) {
+	rsp, err := c.DeletePet(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseDeletePetResponseWithoutBody(rsp)
+	}
+	return ParseDeletePetResponse(rsp)
+}
+
+// parseDeletePetResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseDeletePetResponseWithoutBody(rsp *http.Response) (*DeletePetResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &DeletePetResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// FindPetByIDWithResponse request returning *FindPetByIDResponse
+func (c *ClientWithResponses) FindPetByIDWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*FindPetByIDResponse, error) {
+	rsp, err := c.FindPetByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseFindPetByIDResponseWithoutBody(rsp)
+	}
+	return ParseFindPetByIDResponse(rsp)
+}
+
+// parseFindPetByIDResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseFindPetByIDResponseWithoutBody(rsp *http.Response) (*FindPetByIDResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &FindPetByIDResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// FindPetsResponse is the response of FindPets. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type FindPetsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -460,6 +716,9 @@ func (r FindPetsResponse) StatusCode() int {
 	return 0
 }
 
+// AddPetResponse is the response of AddPet. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type AddPetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -483,6 +742,9 @@ func (r AddPetResponse) StatusCode() int {
 	return 0
 }
 
+// DeletePetResponse is the response of DeletePet. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type DeletePetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -505,6 +767,9 @@ func (r DeletePetResponse) StatusCode() int {
 	return 0
 }
 
+// FindPetByIDResponse is the response of FindPetByID. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type FindPetByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -528,54 +793,12 @@ func (r FindPetByIDResponse) StatusCode() int {
 	return 0
 }
 
-// FindPetsWithResponse request returning *FindPetsResponse
-func (c *ClientWithResponses) FindPetsWithResponse(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*FindPetsResponse, error) {
-	rsp, err := c.FindPets(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseFindPetsResponse(rsp)
-}
-
-// AddPetWithBodyWithResponse request with arbitrary body returning *AddPetResponse
-func (c *ClientWithResponses) AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
-	rsp, err := c.AddPetWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAddPetResponse(rsp)
-}
-
-func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
-	rsp, err := c.AddPet(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAddPetResponse(rsp)
-}
-
-// DeletePetWithResponse request returning *DeletePetResponse
-func (c *ClientWithResponses) DeletePetWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeletePetResponse, error) {
-	rsp, err := c.DeletePet(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeletePetResponse(rsp)
-}
-
-// FindPetByIDWithResponse request returning *FindPetByIDResponse
-func (c *ClientWithResponses) FindPetByIDWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*FindPetByIDResponse, error) {
-	rsp, err := c.FindPetByID(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseFindPetByIDResponse(rsp)
-}
-
-// ParseFindPetsResponse parses an HTTP response from a FindPetsWithResponse call
+// ParseFindPetsResponse parses an HTTP response from a FindPetsWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseFindPetsResponse(rsp *http.Response) (*FindPetsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -605,10 +828,12 @@ func ParseFindPetsResponse(rsp *http.Response) (*FindPetsResponse, error) {
 	return response, nil
 }
 
-// ParseAddPetResponse parses an HTTP response from a AddPetWithResponse call
+// ParseAddPetResponse parses an HTTP response from a AddPetWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -638,10 +863,12 @@ func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
 	return response, nil
 }
 
-// ParseDeletePetResponse parses an HTTP response from a DeletePetWithResponse call
+// ParseDeletePetResponse parses an HTTP response from a DeletePetWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseDeletePetResponse(rsp *http.Response) (*DeletePetResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -664,10 +891,12 @@ func ParseDeletePetResponse(rsp *http.Response) (*DeletePetResponse, error) {
 	return response, nil
 }
 
-// ParseFindPetByIDResponse parses an HTTP response from a FindPetByIDWithResponse call
+// ParseFindPetByIDResponse parses an HTTP response from a FindPetByIDWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseFindPetByIDResponse(rsp *http.Response) (*FindPetByIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -696,3 +925,57 @@ func ParseFindPetByIDResponse(rsp *http.Response) (*FindPetByIDResponse, error)
 
 	return response, nil
 }
+
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "FindPets":
+		return ParseFindPetsResponse(rsp)
+	case "AddPet":
+		return ParseAddPetResponse(rsp)
+	case "DeletePet":
+		return ParseDeletePetResponse(rsp)
+	case "FindPetByID":
+		return ParseFindPetByIDResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
@@ -272,42 +272,80 @@ func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string)
 	})
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
-
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		}
 	}
-	wrapper := ServerInterfaceWrapper{
+	return &ServerInterfaceWrapper{
 		Handler:            si,
 		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+		ErrorHandlerFunc:   errorHandlerFunc,
 	}
+}
+
+// NewFindPetsHandler returns the handler HandlerWithOptions routes the
+// FindPets operation to, so that it can be mounted on its own.
+func NewFindPetsHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).FindPets
+}
+
+// NewAddPetHandler returns the handler HandlerWithOptions routes the
+// AddPet operation to, so that it can be mounted on its own.
+func NewAddPetHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).AddPet
+}
+
+// NewDeletePetHandler returns the handler HandlerWithOptions routes the
+// DeletePet operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewDeletePetHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).DeletePet
+}
+
+// NewFindPetByIDHandler returns the handler HandlerWithOptions routes the
+// FindPetByID operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewFindPetByIDHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).FindPetByID
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
 
+	if r == nil {
+		r = chi.NewRouter()
+	}
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/pets", wrapper.FindPets)
+		r.Get(options.BaseURL+"/pets", NewFindPetsHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pets", wrapper.AddPet)
+		r.Post(options.BaseURL+"/pets", NewAddPetHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/pets/{id}", wrapper.DeletePet)
+		r.Delete(options.BaseURL+"/pets/{id}", NewDeletePetHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/pets/{id}", wrapper.FindPetByID)
+		r.Get(options.BaseURL+"/pets/{id}", NewFindPetByIDHandler(si, options))
 	})
 
 	return r
 }
 
 type FindPetsRequestObject struct {
-	Params FindPetsParams
+	FindPetsParams
+}
+
+// Params returns the query, header and cookie parameters of the request,
+// which are embedded in it.
+func (r FindPetsRequestObject) Params() FindPetsParams {
+	return r.FindPetsParams
 }
 
 type FindPetsResponseObject interface {
@@ -470,7 +508,7 @@ type strictHandler struct {
 func (sh *strictHandler) FindPets(w http.ResponseWriter, r *http.Request, params FindPetsParams) {
 	var request FindPetsRequestObject
 
-	request.Params = params
+	request.FindPetsParams = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.FindPets(ctx, request.(FindPetsRequestObject))
@@ -479,7 +517,7 @@ func (sh *strictHandler) FindPets(w http.ResponseWriter, r *http.Request, params
 		handler = middleware(handler, "FindPets")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "FindPets"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -510,7 +548,7 @@ func (sh *strictHandler) AddPet(w http.ResponseWriter, r *http.Request) {
 		handler = middleware(handler, "AddPet")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "AddPet"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -536,7 +574,7 @@ func (sh *strictHandler) DeletePet(w http.ResponseWriter, r *http.Request, id in
 		handler = middleware(handler, "DeletePet")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "DeletePet"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -562,7 +600,7 @@ func (sh *strictHandler) FindPetByID(w http.ResponseWriter, r *http.Request, id
 		handler = middleware(handler, "FindPetByID")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "FindPetByID"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -575,6 +613,85 @@ func (sh *strictHandler) FindPetByID(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// FindPetsHandler handles the FindPets operation with its typed request and response objects.
+type FindPetsHandler func(ctx context.Context, request FindPetsRequestObject) (FindPetsResponseObject, error)
+
+// AddPetHandler handles the AddPet operation with its typed request and response objects.
+type AddPetHandler func(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error)
+
+// DeletePetHandler handles the DeletePet operation with its typed request and response objects.
+type DeletePetHandler func(ctx context.Context, request DeletePetRequestObject) (DeletePetResponseObject, error)
+
+// FindPetByIDHandler handles the FindPetByID operation with its typed request and response objects.
+type FindPetByIDHandler func(ctx context.Context, request FindPetByIDRequestObject) (FindPetByIDResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnFindPets    func(next FindPetsHandler) FindPetsHandler
+	OnAddPet      func(next AddPetHandler) AddPetHandler
+	OnDeletePet   func(next DeletePetHandler) DeletePetHandler
+	OnFindPetByID func(next FindPetByIDHandler) FindPetByIDHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) FindPets(ctx context.Context, request FindPetsRequestObject) (FindPetsResponseObject, error) {
+	handler := FindPetsHandler(s.ssi.FindPets)
+	if s.middlewares.OnFindPets != nil {
+		handler = s.middlewares.OnFindPets(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	handler := AddPetHandler(s.ssi.AddPet)
+	if s.middlewares.OnAddPet != nil {
+		handler = s.middlewares.OnAddPet(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) DeletePet(ctx context.Context, request DeletePetRequestObject) (DeletePetResponseObject, error) {
+	handler := DeletePetHandler(s.ssi.DeletePet)
+	if s.middlewares.OnDeletePet != nil {
+		handler = s.middlewares.OnDeletePet(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) FindPetByID(ctx context.Context, request FindPetByIDRequestObject) (FindPetByIDResponseObject, error) {
+	handler := FindPetByIDHandler(s.ssi.FindPetByID)
+	if s.middlewares.OnFindPetByID != nil {
+		handler = s.middlewares.OnFindPetByID(handler)
+	}
+	return handler(ctx, request)
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
@@ -35,9 +35,9 @@ func (p *PetStore) FindPets(ctx context.Context, request FindPetsRequestObject)
 	var result []Pet
 
 	for _, pet := range p.Pets {
-		if request.Params.Tags != nil {
+		if request.Tags != nil {
 			// If we have tags,  filter pets by tag
-			for _, t := range *request.Params.Tags {
+			for _, t := range *request.Tags {
 				if pet.Tag != nil && (*pet.Tag == t) {
 					result = append(result, pet)
 				}
@@ -47,8 +47,8 @@ func (p *PetStore) FindPets(ctx context.Context, request FindPetsRequestObject)
 			result = append(result, pet)
 		}
 
-		if request.Params.Limit != nil {
-			l := int(*request.Params.Limit)
+		if request.Limit != nil {
+			l := int(*request.Limit)
 			if len(result) >= l {
 				// We're at the limit
 				break
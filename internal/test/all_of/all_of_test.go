@@ -0,0 +1,27 @@
+package allof
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/deepmap/oapi-codegen/v2/internal/test/all_of/v2"
+)
+
+func TestMergedAdditionalProperties(t *testing.T) {
+	var labels v2.OwnedLabels
+	require.NoError(t, json.Unmarshal([]byte(`{"owner":"ops","env":"prod","tier":"web"}`), &labels))
+	require.NotNil(t, labels.Owner)
+	assert.Equal(t, "ops", *labels.Owner)
+	env, found := labels.Get("env")
+	assert.True(t, found)
+	assert.Equal(t, "prod", env)
+	assert.Equal(t, map[string]string{"env": "prod", "tier": "web"}, labels.AdditionalProperties)
+
+	labels.Set("region", "eu")
+	data, err := json.Marshal(labels)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"owner":"ops","env":"prod","tier":"web","region":"eu"}`, string(data))
+}
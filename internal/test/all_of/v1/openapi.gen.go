@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"path"
@@ -15,7 +16,22 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
-// Person defines model for Person.
+// Labels Free-form labels of a resource.
+type Labels map[string]string
+
+// OwnedLabels Labels with an owner, whose own values are bounded. The values have to
+// satisfy the additionalProperties of both members.
+type OwnedLabels struct {
+	// Embedded struct due to allOf(#/components/schemas/Labels)
+	Labels `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Owner                *string           `json:"owner,omitempty"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Person This is a person, with mandatory first and last name, but optional ID
+// number. This would be returned by a `Get` style API. We merge the person
+// properties with another Schema which only provides required fields.
 type Person struct {
 	// Embedded struct due to allOf(#/components/schemas/PersonProperties)
 	PersonProperties `yaml:",inline"`
@@ -30,7 +46,8 @@ type PersonProperties struct {
 	LastName           *string `json:"LastName,omitempty"`
 }
 
-// PersonWithID defines model for PersonWithID.
+// PersonWithID This is a person record as returned from a Create endpoint. It contains
+// all the fields of a Person, with an additional resource UUID.
 type PersonWithID struct {
 	// Embedded struct due to allOf(#/components/schemas/Person)
 	Person `yaml:",inline"`
@@ -38,20 +55,90 @@ type PersonWithID struct {
 	ID int64 `json:"ID"`
 }
 
+// Getter for additional properties for OwnedLabels. Returns the specified
+// element and whether it was found
+func (a OwnedLabels) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for OwnedLabels
+func (a *OwnedLabels) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for OwnedLabels to handle AdditionalProperties
+func (a *OwnedLabels) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["owner"]; found {
+		err = json.Unmarshal(raw, &a.Owner)
+		if err != nil {
+			return fmt.Errorf("error reading 'owner': %w", err)
+		}
+		delete(object, "owner")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for OwnedLabels to handle AdditionalProperties
+func (a OwnedLabels) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Owner != nil {
+		object["owner"], err = json.Marshal(a.Owner)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'owner': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/5SUT2/bOBDFv8qAu0dBTrCLPegWrNtAQJEaaNIc4gAZSyOLKTVkyVEMwfB3L0jJ/+AW",
-	"aX0awOTjvDe/0VZVtnOWiSWoYqtC1VKHqVyQD5ZjhcZ8blTxtFV/e2pUof6aHW/Npiuz8fzCW0deNAW1",
-	"y7bK0/dee6pV8aQ+ah/kDjtSmfqEU/m8e85UTaHy2omO76n7VgfQARBcksxgo6WFDrlGsX6AJgoBcg0G",
-	"gwBjRxmsegGbJNBAOV8y992KfA5JbmN7U8OKwJP0nqmG1QAIL7ckLxBkMAQ3izKHR4KO/JpAWpqeX7I7",
-	"eBo7QbbSkocvyTlsWl21YNkM4Lx90zUF2PuGRpOpQ75klSkZHKlC2dUrVaJ2mbqIrNheZEGBAD1NQiAt",
-	"CgRHlW6GQ0LRJA3pGBpziCGLGS354L0Pk2+Glw+1PnUOxLWzmiWDTUuegLBq4xD2WqMDd9bqcaDFdm8u",
-	"iNe8juZu7Rt57oilnN+lWcRjjfUdiiqUZvnv32MomoXW5OPFAxuXqrtfhviopS3nf0prYvTc1Cjybpu7",
-	"7Iztcv47JIOnyvoaMBw5bLztAOF/Tyh0GEMOpUBlWVBzWHKcaiRygsA2gLA4XQ5kwLrWE/6egu19RfDw",
-	"UM5/yl7sX3NjU8ZaTPzvnoIEuInxQUosJD2VqTfyYXR0nV/lVzF164jRaVWof/Kr/DqygdKmBGfOYEWt",
-	"NfU48jXJJdhf0ei0zgE2yAIoYChus2WCKJVBsCAxwA6/UQSfOmjRuWE0FGeGUaysVaEWJ0/GyQRnOewX",
-	"qsHepBZioMSpROeMrpLA7HX6zo1sxOp9cibeUpDnzk7d79LvRwAAAP//lzc18GUFAAA=",
+	"H4sIAAAAAAAC/5SUT4/bNhDFv8qA7VG1N2iQg25B3QQGFomBbppDHGDH4sjklhqy5MiusPB3L0j5b+0C",
+	"zp64MvE07/fe6FU1vgueiSWp+lWlxlCH5fiIK3LlhFpbsZ7RLaIPFMVSeS5DIFWrJNHyWu0qpSk10YZ8",
+	"V9XqQyT6pfWxA1ekwLeAECn5PjY0UdVBwK9eqJEs8HnLpM9e7NznVtXfXtXPkVpVq5+mp3Gn+1mn+/u7",
+	"6v8n7fCfR+K1GFW/e1tdDx4ubvstU7xhcHc18ff/eh5nga0VA8hQlCrYGp8o/wMbdD0lwEiw8j1r0hN4",
+	"MnR4bnBDIH7JCcWmdgAxBLdMZZYrLwY66lYU02TJ2ceCYvJ8P7rx/km2QIz0d28jaVV/Ux9sTPIJO1KV",
+	"esT98fu17ydjE9gECKFIViOCDlmj+DhAm4UAWYPDJMDYUQWrXsCH0RvMZ0vmPrvJSGyCre+dhhVBJOkj",
+	"k4bVAAjPH0meIcngCN4v5hP4StBRXFOBNb5+yadED2F4MRThj+IctsY2Bjy7AUL0G6spwcE3tJacLkhv",
+	"VPQKWf16xYISlYhHIRCDAilQY9vhSKjkPpRr6NwRQ5UZLfnovU973wzPv2t77hyIdfCWJReMIgFhY3II",
+	"B63RwWW3T4HeWuCPfkORO2KZzz6VLPK1vMMoqlaW5d3bExTLQmuKanfWjXu25gDxqxUzn/1oW0tHL03N",
+	"Z3eNuasuuj2f3dNkiNT4qAHTqYdt9B0g/BYJhY4xTGAu0HgWtJyWnFMVcyxB+fgtzpcD+Wy1j99F+PJl",
+	"PrvZvTy/5dYXxlZc/u2JkiR4n/FBIZaKnqrUhmIaHb2ZPEweMnUfiDFYVatfJw+TN7kbKKYQnAaHDRnv",
+	"9Bj5muS62H+is2WdE2yRBVDAUd5mzwRZqoLkQTLADv+iXHzqwGAIw2goZ4ZZbK5VrRZnr8zJpOA5HRaq",
+	"xd6VETJQ4nLEEJxtisD0Zf+dG7uh6nuas+9bAXnp7Nz9rvz9OwDzCGjCGQcAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,54 @@
+// Package intersect provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package intersect
+
+// Defines values for Color.
+const (
+	ColorBlue  Color = "blue"
+	ColorGreen Color = "green"
+	ColorRed   Color = "red"
+)
+
+// IsValid reports whether v is one of the values of Color.
+func (v Color) IsValid() bool {
+	switch v {
+	case ColorBlue, ColorGreen, ColorRed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for WarmColor.
+const (
+	WarmColorRed WarmColor = "red"
+)
+
+// IsValid reports whether v is one of the values of WarmColor.
+func (v WarmColor) IsValid() bool {
+	switch v {
+	case WarmColorRed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Color defines model for Color.
+type Color string
+
+// Named defines model for Named.
+type Named struct {
+	Name string `json:"name"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Age   *int      `json:"age,omitempty"`
+	Color WarmColor `json:"color"`
+	Name  string    `json:"name"`
+}
+
+// WarmColor defines model for WarmColor.
+type WarmColor string
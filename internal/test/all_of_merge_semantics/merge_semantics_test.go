@@ -0,0 +1,37 @@
+package allofmergesemantics
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/v2/internal/test/all_of_merge_semantics/enum_intersect"
+	"github.com/deepmap/oapi-codegen/v2/internal/test/all_of_merge_semantics/intersect"
+	"github.com/deepmap/oapi-codegen/v2/internal/test/all_of_merge_semantics/union"
+)
+
+func TestUnionSemanticsUnionEnums(t *testing.T) {
+	assert.ElementsMatch(t,
+		[]union.WarmColor{union.WarmColorRed, union.WarmColorGreen, union.WarmColorBlue, union.WarmColorOrange},
+		[]union.WarmColor{"red", "green", "blue", "orange"})
+}
+
+func TestIntersectSemanticsIntersectEnums(t *testing.T) {
+	assert.Equal(t, intersect.WarmColor("red"), intersect.WarmColorRed)
+
+	code, err := os.ReadFile("intersect/openapi.gen.go")
+	require.NoError(t, err)
+	assert.NotContains(t, string(code), "WarmColorOrange")
+	assert.NotContains(t, string(code), "WarmColorGreen")
+}
+
+func TestEnumIntersectStrategyIntersectsEnums(t *testing.T) {
+	assert.Equal(t, enumintersect.WarmColor("red"), enumintersect.WarmColorRed)
+
+	code, err := os.ReadFile("enum_intersect/openapi.gen.go")
+	require.NoError(t, err)
+	assert.NotContains(t, string(code), "WarmColorOrange")
+	assert.NotContains(t, string(code), "WarmColorGreen")
+}
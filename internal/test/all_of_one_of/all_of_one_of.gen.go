@@ -0,0 +1,418 @@
+// Package allofoneof provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package allofoneof
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// BankPayment defines model for BankPayment.
+type BankPayment struct {
+	Iban string `json:"iban"`
+}
+
+// Base defines model for Base.
+type Base struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+}
+
+// CardPayment defines model for CardPayment.
+type CardPayment struct {
+	CardNumber string `json:"cardNumber"`
+}
+
+// CommonFields defines model for CommonFields.
+type CommonFields struct {
+	Amount int    `json:"amount"`
+	Id     string `json:"id"`
+}
+
+// Envelope defines model for Envelope.
+type Envelope struct {
+	Id    string `json:"id"`
+	Kind  string `json:"kind"`
+	union json.RawMessage
+}
+
+// ImagePayload defines model for ImagePayload.
+type ImagePayload struct {
+	Kind *string `json:"kind,omitempty"`
+	Url  string  `json:"url"`
+}
+
+// Payment defines model for Payment.
+type Payment struct {
+	Amount   int     `json:"amount"`
+	Currency *string `json:"currency,omitempty"`
+	Id       string  `json:"id"`
+	union    json.RawMessage
+}
+
+// PaymentMethod defines model for PaymentMethod.
+type PaymentMethod struct {
+	Currency *string `json:"currency,omitempty"`
+	union    json.RawMessage
+}
+
+// TextPayload defines model for TextPayload.
+type TextPayload struct {
+	Kind *string `json:"kind,omitempty"`
+	Text string  `json:"text"`
+}
+
+// AsTextPayload returns the union data inside the Envelope as a TextPayload
+func (t Envelope) AsTextPayload() (TextPayload, error) {
+	var body TextPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTextPayload overwrites any union data inside the Envelope as the provided TextPayload, with
+// the kind discriminator set to "text"
+func (t *Envelope) FromTextPayload(v TextPayload) error {
+	t.Kind = "text"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTextPayload performs a merge with any union data inside the Envelope, using the provided TextPayload, with
+// the kind discriminator set to "text"
+func (t *Envelope) MergeTextPayload(v TextPayload) error {
+	t.Kind = "text"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsImagePayload returns the union data inside the Envelope as a ImagePayload
+func (t Envelope) AsImagePayload() (ImagePayload, error) {
+	var body ImagePayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromImagePayload overwrites any union data inside the Envelope as the provided ImagePayload, with
+// the kind discriminator set to "image"
+func (t *Envelope) FromImagePayload(v ImagePayload) error {
+	t.Kind = "image"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeImagePayload performs a merge with any union data inside the Envelope, using the provided ImagePayload, with
+// the kind discriminator set to "image"
+func (t *Envelope) MergeImagePayload(v ImagePayload) error {
+	t.Kind = "image"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Envelope) Discriminator() (string, error) {
+	return string(t.Kind), nil
+}
+
+func (t Envelope) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "image":
+		return t.AsImagePayload()
+	case "text":
+		return t.AsTextPayload()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t Envelope) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	object["id"], err = json.Marshal(t.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
+	}
+
+	object["kind"], err = json.Marshal(t.Kind)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'kind': %w", err)
+	}
+
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *Envelope) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &t.Id)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+	}
+
+	if raw, found := object["kind"]; found {
+		err = json.Unmarshal(raw, &t.Kind)
+		if err != nil {
+			return fmt.Errorf("error reading 'kind': %w", err)
+		}
+	}
+
+	return err
+}
+
+// AsCardPayment returns the union data inside the Payment as a CardPayment
+func (t Payment) AsCardPayment() (CardPayment, error) {
+	var body CardPayment
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCardPayment overwrites any union data inside the Payment as the provided CardPayment
+func (t *Payment) FromCardPayment(v CardPayment) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCardPayment performs a merge with any union data inside the Payment, using the provided CardPayment
+func (t *Payment) MergeCardPayment(v CardPayment) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsBankPayment returns the union data inside the Payment as a BankPayment
+func (t Payment) AsBankPayment() (BankPayment, error) {
+	var body BankPayment
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromBankPayment overwrites any union data inside the Payment as the provided BankPayment
+func (t *Payment) FromBankPayment(v BankPayment) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeBankPayment performs a merge with any union data inside the Payment, using the provided BankPayment
+func (t *Payment) MergeBankPayment(v BankPayment) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Payment) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	object["amount"], err = json.Marshal(t.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'amount': %w", err)
+	}
+
+	if t.Currency != nil {
+		object["currency"], err = json.Marshal(t.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'currency': %w", err)
+		}
+	}
+
+	object["id"], err = json.Marshal(t.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
+	}
+
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *Payment) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["amount"]; found {
+		err = json.Unmarshal(raw, &t.Amount)
+		if err != nil {
+			return fmt.Errorf("error reading 'amount': %w", err)
+		}
+	}
+
+	if raw, found := object["currency"]; found {
+		err = json.Unmarshal(raw, &t.Currency)
+		if err != nil {
+			return fmt.Errorf("error reading 'currency': %w", err)
+		}
+	}
+
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &t.Id)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+	}
+
+	return err
+}
+
+// AsCardPayment returns the union data inside the PaymentMethod as a CardPayment
+func (t PaymentMethod) AsCardPayment() (CardPayment, error) {
+	var body CardPayment
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCardPayment overwrites any union data inside the PaymentMethod as the provided CardPayment
+func (t *PaymentMethod) FromCardPayment(v CardPayment) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCardPayment performs a merge with any union data inside the PaymentMethod, using the provided CardPayment
+func (t *PaymentMethod) MergeCardPayment(v CardPayment) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsBankPayment returns the union data inside the PaymentMethod as a BankPayment
+func (t PaymentMethod) AsBankPayment() (BankPayment, error) {
+	var body BankPayment
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromBankPayment overwrites any union data inside the PaymentMethod as the provided BankPayment
+func (t *PaymentMethod) FromBankPayment(v BankPayment) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeBankPayment performs a merge with any union data inside the PaymentMethod, using the provided BankPayment
+func (t *PaymentMethod) MergeBankPayment(v BankPayment) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t PaymentMethod) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if t.Currency != nil {
+		object["currency"], err = json.Marshal(t.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'currency': %w", err)
+		}
+	}
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *PaymentMethod) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["currency"]; found {
+		err = json.Unmarshal(raw, &t.Currency)
+		if err != nil {
+			return fmt.Errorf("error reading 'currency': %w", err)
+		}
+	}
+
+	return err
+}
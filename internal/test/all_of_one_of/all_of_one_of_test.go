@@ -0,0 +1,70 @@
+package allofoneof
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelopeRoundTrip(t *testing.T) {
+	envelope := Envelope{Id: "1"}
+	require.NoError(t, envelope.FromTextPayload(TextPayload{Text: "hello"}))
+	assert.Equal(t, "text", envelope.Kind)
+
+	buf, err := json.Marshal(envelope)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"1","kind":"text","text":"hello"}`, string(buf))
+
+	var decoded Envelope
+	require.NoError(t, json.Unmarshal(buf, &decoded))
+	assert.Equal(t, "1", decoded.Id)
+	assert.Equal(t, "text", decoded.Kind)
+
+	payload, err := decoded.ValueByDiscriminator()
+	require.NoError(t, err)
+	text, ok := payload.(TextPayload)
+	require.True(t, ok)
+	assert.Equal(t, "hello", text.Text)
+}
+
+func TestEnvelopeDiscriminator(t *testing.T) {
+	var envelope Envelope
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"2","kind":"image","url":"https://example.com/a.png"}`), &envelope))
+
+	kind, err := envelope.Discriminator()
+	require.NoError(t, err)
+	assert.Equal(t, "image", kind)
+
+	image, err := envelope.AsImagePayload()
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/a.png", image.Url)
+
+	require.NoError(t, envelope.FromTextPayload(TextPayload{Text: "replaced"}))
+	kind, err = envelope.Discriminator()
+	require.NoError(t, err)
+	assert.Equal(t, "text", kind)
+	buf, err := json.Marshal(envelope)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"2","kind":"text","text":"replaced"}`, string(buf))
+}
+
+func TestNestedUnionRoundTrip(t *testing.T) {
+	currency := "EUR"
+	payment := Payment{Id: "3", Amount: 100, Currency: &currency}
+	require.NoError(t, payment.FromBankPayment(BankPayment{Iban: "DE89370400440532013000"}))
+
+	buf, err := json.Marshal(payment)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"3","amount":100,"currency":"EUR","iban":"DE89370400440532013000"}`, string(buf))
+
+	var decoded Payment
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"4","amount":5,"cardNumber":"4111"}`), &decoded))
+	assert.Equal(t, "4", decoded.Id)
+	assert.Equal(t, 5, decoded.Amount)
+	assert.Nil(t, decoded.Currency)
+	card, err := decoded.AsCardPayment()
+	require.NoError(t, err)
+	assert.Equal(t, "4111", card.CardNumber)
+}
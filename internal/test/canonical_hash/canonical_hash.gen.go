@@ -0,0 +1,419 @@
+// Package canonicalhash provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package canonicalhash
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for Status.
+const (
+	Available Status = "available"
+	Sold      Status = "sold"
+)
+
+// IsValid reports whether v is one of the values of Status.
+func (v Status) IsValid() bool {
+	switch v {
+	case Available, Sold:
+		return true
+	default:
+		return false
+	}
+}
+
+// Animal defines model for Animal.
+type Animal struct {
+	union json.RawMessage
+}
+
+// Cat defines model for Cat.
+type Cat struct {
+	Kind  string `json:"kind"`
+	Lives *int   `json:"lives,omitempty"`
+}
+
+// Dog defines model for Dog.
+type Dog struct {
+	Good *bool  `json:"good,omitempty"`
+	Kind string `json:"kind"`
+}
+
+// Labels defines model for Labels.
+type Labels map[string]string
+
+// Pet defines model for Pet.
+type Pet struct {
+	Age    *int               `json:"age,omitempty"`
+	Labels *map[string]string `json:"labels,omitempty"`
+	Name   string             `json:"name"`
+	Tag    *string            `json:"tag"`
+}
+
+// Status defines model for Status.
+type Status string
+
+// AsCat returns the union data inside the Animal as a Cat
+func (t Animal) AsCat() (Cat, error) {
+	var body Cat
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCat overwrites any union data inside the Animal as the provided Cat
+func (t *Animal) FromCat(v Cat) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCat performs a merge with any union data inside the Animal, using the provided Cat
+func (t *Animal) MergeCat(v Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDog returns the union data inside the Animal as a Dog
+func (t Animal) AsDog() (Dog, error) {
+	var body Dog
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDog overwrites any union data inside the Animal as the provided Dog
+func (t *Animal) FromDog(v Dog) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDog performs a merge with any union data inside the Animal, using the provided Dog
+func (t *Animal) MergeDog(v Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Animal) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Animal) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// MarshalCanonicalJSON returns the canonical JSON encoding of the Animal,
+// the same for equal values, see marshalCanonicalJSON.
+func (t Animal) MarshalCanonicalJSON() ([]byte, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return canonicalizeJSON(data)
+}
+
+// CanonicalHash returns the SHA-256 hash of the canonical JSON encoding of the
+// Animal, the same for equal values, eg, to deduplicate them.
+func (t Animal) CanonicalHash() ([32]byte, error) {
+	data, err := t.MarshalCanonicalJSON()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(data), nil
+}
+
+// MarshalCanonicalJSON returns the canonical JSON encoding of the Cat,
+// the same for equal values, see marshalCanonicalJSON.
+func (t Cat) MarshalCanonicalJSON() ([]byte, error) {
+	var o canonicalJSONObject
+	o.property("kind", t.Kind, false)
+	o.property("lives", t.Lives, false)
+	return o.marshal()
+}
+
+// CanonicalHash returns the SHA-256 hash of the canonical JSON encoding of the
+// Cat, the same for equal values, eg, to deduplicate them.
+func (t Cat) CanonicalHash() ([32]byte, error) {
+	data, err := t.MarshalCanonicalJSON()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(data), nil
+}
+
+// MarshalCanonicalJSON returns the canonical JSON encoding of the Dog,
+// the same for equal values, see marshalCanonicalJSON.
+func (t Dog) MarshalCanonicalJSON() ([]byte, error) {
+	var o canonicalJSONObject
+	o.property("good", t.Good, false)
+	o.property("kind", t.Kind, false)
+	return o.marshal()
+}
+
+// CanonicalHash returns the SHA-256 hash of the canonical JSON encoding of the
+// Dog, the same for equal values, eg, to deduplicate them.
+func (t Dog) CanonicalHash() ([32]byte, error) {
+	data, err := t.MarshalCanonicalJSON()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(data), nil
+}
+
+// MarshalCanonicalJSON returns the canonical JSON encoding of the Labels,
+// the same for equal values, see marshalCanonicalJSON.
+func (t Labels) MarshalCanonicalJSON() ([]byte, error) {
+	return marshalCanonicalJSON((map[string]string)(t))
+}
+
+// CanonicalHash returns the SHA-256 hash of the canonical JSON encoding of the
+// Labels, the same for equal values, eg, to deduplicate them.
+func (t Labels) CanonicalHash() ([32]byte, error) {
+	data, err := t.MarshalCanonicalJSON()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(data), nil
+}
+
+// MarshalCanonicalJSON returns the canonical JSON encoding of the Pet,
+// the same for equal values, see marshalCanonicalJSON.
+func (t Pet) MarshalCanonicalJSON() ([]byte, error) {
+	var o canonicalJSONObject
+	o.property("age", t.Age, false)
+	o.property("labels", t.Labels, false)
+	o.property("name", t.Name, false)
+	o.property("tag", t.Tag, true)
+	return o.marshal()
+}
+
+// CanonicalHash returns the SHA-256 hash of the canonical JSON encoding of the
+// Pet, the same for equal values, eg, to deduplicate them.
+func (t Pet) CanonicalHash() ([32]byte, error) {
+	data, err := t.MarshalCanonicalJSON()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(data), nil
+}
+
+// MarshalCanonicalJSON returns the canonical JSON encoding of the Status,
+// the same for equal values, see marshalCanonicalJSON.
+func (t Status) MarshalCanonicalJSON() ([]byte, error) {
+	return marshalCanonicalJSON((string)(t))
+}
+
+// CanonicalHash returns the SHA-256 hash of the canonical JSON encoding of the
+// Status, the same for equal values, eg, to deduplicate them.
+func (t Status) CanonicalHash() ([32]byte, error) {
+	data, err := t.MarshalCanonicalJSON()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(data), nil
+}
+
+// marshalCanonicalJSON returns the canonical JSON encoding of v, the same
+// bytes for equal values, however their Go types order their fields: that of
+// its MarshalCanonicalJSON method, or else, for slices, arrays, and maps with
+// string keys which don't encode themselves, that of their elements, sorted by
+// key for maps, and for other values, their JSON encoding made canonical.
+func marshalCanonicalJSON(v interface{}) ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	switch m := v.(type) {
+	case interface{ MarshalCanonicalJSON() ([]byte, error) }:
+		return m.MarshalCanonicalJSON()
+	case json.Marshaler, encoding.TextMarshaler:
+		// The types encoding themselves are taken as they encode.
+		return marshalCanonicalJSONValue(v)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return []byte("null"), nil
+		}
+		return marshalCanonicalJSON(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		// []byte is encoded in base64, as a string.
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return []byte("null"), nil
+		}
+		var buf bytes.Buffer
+		buf.WriteByte('[')
+		for i := 0; i < rv.Len(); i++ {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			data, err := marshalCanonicalJSON(rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(data)
+		}
+		buf.WriteByte(']')
+		return buf.Bytes(), nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			break
+		}
+		if rv.IsNil() {
+			return []byte("null"), nil
+		}
+		var o canonicalJSONObject
+		o.additionalProperties(v)
+		return o.marshal()
+	}
+
+	return marshalCanonicalJSONValue(v)
+}
+
+// marshalCanonicalJSONValue returns the JSON encoding of v made canonical.
+func marshalCanonicalJSONValue(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return canonicalizeJSON(data)
+}
+
+// canonicalizeJSON returns the canonical form of the JSON data, with the
+// members of its objects sorted by name, and without whitespace. Numbers are
+// kept as written.
+func canonicalizeJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("invalid JSON: data after the top-level value")
+	}
+	// The maps of the decoded JSON are encoded sorted by key, and the
+	// numbers as they were written.
+	return marshalCanonicalJSONString(v)
+}
+
+// marshalCanonicalJSONString returns the JSON encoding of v, not escaping
+// HTML, as json.Marshal does.
+func marshalCanonicalJSONString(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// canonicalJSONObject builds the canonical JSON encoding of an object,
+// property by property, in any order. The zero canonicalJSONObject is an
+// empty object.
+type canonicalJSONObject struct {
+	members map[string]interface{}
+}
+
+// property adds the property name of value. A nil value, such as a nil
+// pointer, is written as null if the property is nullable, and left out
+// otherwise, as an absent property.
+func (o *canonicalJSONObject) property(name string, value interface{}, nullable bool) {
+	if isNilCanonicalJSONValue(value) {
+		if !nullable {
+			return
+		}
+		value = nil
+	}
+	o.set(name, value)
+}
+
+// additionalProperties adds the entries of properties, a map with string
+// keys, such as the AdditionalProperties of the generated structs.
+func (o *canonicalJSONObject) additionalProperties(properties interface{}) {
+	rv := reflect.ValueOf(properties)
+	if rv.Kind() != reflect.Map {
+		return
+	}
+	iter := rv.MapRange()
+	for iter.Next() {
+		o.set(iter.Key().String(), iter.Value().Interface())
+	}
+}
+
+func (o *canonicalJSONObject) set(name string, value interface{}) {
+	if o.members == nil {
+		o.members = make(map[string]interface{})
+	}
+	o.members[name] = value
+}
+
+// marshal returns the canonical JSON encoding of the object, its members
+// sorted by name.
+func (o *canonicalJSONObject) marshal() ([]byte, error) {
+	names := make([]string, 0, len(o.members))
+	for name := range o.members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, name := range names {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		key, err := marshalCanonicalJSONString(name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		data, err := marshalCanonicalJSON(o.members[name])
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %q: %w", name, err)
+		}
+		buf.Write(data)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func isNilCanonicalJSONValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return rv.IsNil()
+	}
+	return false
+}
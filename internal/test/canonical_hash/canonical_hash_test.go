@@ -0,0 +1,190 @@
+package canonicalhash
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The hashes are pinned, so that they stay the same when the code is
+// regenerated, or the fields of the structs are reordered.
+const (
+	petHash = "f0a30c777660d6b47c049baeac510a4ed513d4a465a9c7a3d4ff380de2ad74ba"
+	catHash = "24e41715a3bfd0ad4325c629002b0f03ab651c68ec58d8d453cfa54da78ef86e"
+)
+
+func hashOf(t *testing.T, v interface{ CanonicalHash() ([32]byte, error) }) string {
+	t.Helper()
+	hash, err := v.CanonicalHash()
+	require.NoError(t, err)
+	return hex.EncodeToString(hash[:])
+}
+
+func TestCanonicalHash(t *testing.T) {
+	labels := map[string]string{"b": "2", "a": "1"}
+	pet := Pet{Name: "Rex", Labels: &labels}
+
+	data, err := pet.MarshalCanonicalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"labels":{"a":"1","b":"2"},"name":"Rex","tag":null}`, string(data))
+	assert.Equal(t, petHash, hashOf(t, pet))
+}
+
+func TestCanonicalHashAbsentAndNull(t *testing.T) {
+	// tag is nullable, so absent and null hash alike, while age isn't, and
+	// is left out when unset.
+	for _, data := range []string{
+		`{"name": "Rex", "labels": {"b": "2", "a": "1"}}`,
+		`{"tag": null, "labels": {"a": "1", "b": "2"}, "name": "Rex"}`,
+	} {
+		var pet Pet
+		require.NoError(t, json.Unmarshal([]byte(data), &pet))
+		assert.Equal(t, petHash, hashOf(t, pet), data)
+	}
+
+	tag := "good"
+	assert.NotEqual(t, petHash, hashOf(t, Pet{Name: "Rex", Tag: &tag}))
+}
+
+func TestCanonicalHashAdditionalProperties(t *testing.T) {
+	var first, second Labels
+	require.NoError(t, json.Unmarshal([]byte(`{"a": "1", "b": "2", "c": "3"}`), &first))
+	require.NoError(t, json.Unmarshal([]byte(`{"c": "3", "b": "2", "a": "1"}`), &second))
+	assert.Equal(t, hashOf(t, first), hashOf(t, second))
+
+	data, err := first.MarshalCanonicalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":"1","b":"2","c":"3"}`, string(data))
+}
+
+func TestCanonicalHashUnion(t *testing.T) {
+	// A union hashes its raw JSON made canonical, whatever the order and
+	// spacing of its members.
+	for _, data := range []string{
+		`{"kind": "cat", "lives": 9}`,
+		`{ "lives": 9, "kind": "cat" }`,
+	} {
+		var animal Animal
+		require.NoError(t, json.Unmarshal([]byte(data), &animal))
+		assert.Equal(t, catHash, hashOf(t, animal), data)
+	}
+
+	var animal Animal
+	lives := 9
+	require.NoError(t, animal.FromCat(Cat{Kind: "cat", Lives: &lives}))
+	assert.Equal(t, catHash, hashOf(t, animal))
+	assert.Equal(t, catHash, hashOf(t, Cat{Kind: "cat", Lives: &lives}))
+}
+
+func TestCanonicalHashEnum(t *testing.T) {
+	data, err := Status("sold").MarshalCanonicalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"sold"`, string(data))
+}
+
+// pet and reorderedPet are the same type, with their fields in another
+// order, walking their properties in another order too, as the generated
+// MarshalCanonicalJSON methods do.
+type pet struct {
+	Name   string             `json:"name"`
+	Tag    *string            `json:"tag"`
+	Owner  *string            `json:"owner,omitempty"`
+	Labels map[string]string  `json:"labels,omitempty"`
+	Extra  map[string]float64 `json:"-"`
+}
+
+func (p pet) MarshalCanonicalJSON() ([]byte, error) {
+	var o canonicalJSONObject
+	o.property("name", p.Name, false)
+	o.property("tag", p.Tag, true)
+	o.property("owner", p.Owner, false)
+	o.property("labels", p.Labels, false)
+	o.additionalProperties(p.Extra)
+	return o.marshal()
+}
+
+type reorderedPet struct {
+	Extra  map[string]float64 `json:"-"`
+	Labels map[string]string  `json:"labels,omitempty"`
+	Owner  *string            `json:"owner,omitempty"`
+	Tag    *string            `json:"tag"`
+	Name   string             `json:"name"`
+}
+
+func (p reorderedPet) MarshalCanonicalJSON() ([]byte, error) {
+	var o canonicalJSONObject
+	o.additionalProperties(p.Extra)
+	o.property("labels", p.Labels, false)
+	o.property("owner", p.Owner, false)
+	o.property("tag", p.Tag, true)
+	o.property("name", p.Name, false)
+	return o.marshal()
+}
+
+func TestMarshalCanonicalJSON(t *testing.T) {
+	tag := "<b>"
+	data, err := marshalCanonicalJSON(pet{
+		Name:   "Rex",
+		Tag:    &tag,
+		Labels: map[string]string{"z": "1", "a": "2"},
+		Extra:  map[string]float64{"weight": 12.5, "age": 3},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{"age":3,"labels":{"a":"2","z":"1"},"name":"Rex","tag":"<b>","weight":12.5}`, string(data))
+
+	// Unset properties are left out, but for the nullable ones, which are
+	// null.
+	data, err = marshalCanonicalJSON(pet{Name: "Rex"})
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"Rex","tag":null}`, string(data))
+
+	// Elements are encoded canonically, as are the values which don't encode
+	// themselves as canonical JSON.
+	data, err = marshalCanonicalJSON([]interface{}{
+		pet{Name: "a"},
+		map[string]interface{}{"b": 1, "a": []int{1, 2}},
+		nil,
+		[]byte("hi"),
+		time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		json.RawMessage(`{ "y": 1.50, "x": [true, null] }`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `[{"name":"a","tag":null},{"a":[1,2],"b":1},null,"aGk=","2024-01-02T03:04:05Z",{"x":[true,null],"y":1.50}]`, string(data))
+}
+
+func TestMarshalCanonicalJSONIgnoresFieldOrder(t *testing.T) {
+	tag, owner := "good", "Alice"
+	p := pet{Name: "Rex", Tag: &tag, Owner: &owner, Labels: map[string]string{"a": "b"}, Extra: map[string]float64{"x": 1}}
+	r := reorderedPet{Name: "Rex", Tag: &tag, Owner: &owner, Labels: map[string]string{"a": "b"}, Extra: map[string]float64{"x": 1}}
+
+	d1, err := marshalCanonicalJSON(p)
+	require.NoError(t, err)
+	d2, err := marshalCanonicalJSON(r)
+	require.NoError(t, err)
+	assert.Equal(t, `{"labels":{"a":"b"},"name":"Rex","owner":"Alice","tag":"good","x":1}`, string(d1))
+	assert.Equal(t, string(d1), string(d2))
+	assert.Equal(t, sha256.Sum256(d1), sha256.Sum256(d2))
+
+	// The encoding/json output of the types differs.
+	j1, err := json.Marshal(p)
+	require.NoError(t, err)
+	j2, err := json.Marshal(r)
+	require.NoError(t, err)
+	assert.NotEqual(t, string(j1), string(j2))
+}
+
+func TestCanonicalizeJSON(t *testing.T) {
+	data, err := canonicalizeJSON([]byte(" {\"b\": {\"d\": 1e3, \"c\": \"\\u003c\"}, \"a\": [ ]}\n"))
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":[],"b":{"c":"<","d":1e3}}`, string(data))
+
+	_, err = canonicalizeJSON([]byte(`{} {}`))
+	assert.Error(t, err)
+	_, err = canonicalizeJSON([]byte(`{`))
+	assert.Error(t, err)
+}
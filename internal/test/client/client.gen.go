@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -59,8 +60,12 @@ type Client struct {
 	Client HttpRequestDoer
 
 	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
+	// the network. They're applied in order, before the ones given to the call.
 	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -107,6 +112,84 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// PostBothWithBody request with any body
@@ -146,7 +229,7 @@ func (c *Client) PostBothWithBody(ctx context.Context, contentType string, body
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "PostBoth", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -158,7 +241,7 @@ func (c *Client) PostBoth(ctx context.Context, body PostBothJSONRequestBody, req
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "PostBoth", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -170,7 +253,7 @@ func (c *Client) GetBoth(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetBoth", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -182,7 +265,7 @@ func (c *Client) PostJsonWithBody(ctx context.Context, contentType string, body
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "PostJson", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -194,7 +277,7 @@ func (c *Client) PostJson(ctx context.Context, body PostJsonJSONRequestBody, req
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "PostJson", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -206,7 +289,7 @@ func (c *Client) GetJson(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetJson", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -218,7 +301,7 @@ func (c *Client) PostOtherWithBody(ctx context.Context, contentType string, body
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "PostOther", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -230,7 +313,7 @@ func (c *Client) GetOther(ctx context.Context, reqEditors ...RequestEditorFn) (*
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetOther", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -242,7 +325,7 @@ func (c *Client) GetJsonWithTrailingSlash(ctx context.Context, reqEditors ...Req
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetJsonWithTrailingSlash", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -254,7 +337,7 @@ func (c *Client) PostVendorJsonWithBody(ctx context.Context, contentType string,
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "PostVendorJson", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -266,7 +349,7 @@ func (c *Client) PostVendorJsonWithApplicationVndAPIPlusJSONBody(ctx context.Con
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "PostVendorJson", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -529,15 +612,24 @@ func NewPostVendorJsonRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
 		}
 	}
-	for _, r := range additionalEditors {
+	for i, r := range additionalEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
 		}
 	}
 	return nil
@@ -570,6 +662,60 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// PostBothWithBodyWithResponse request with any body
@@ -603,6 +749,234 @@ type ClientWithResponsesInterface interface {
 	PostVendorJsonWithApplicationVndAPIPlusJSONBodyWithResponse(ctx context.Context, body PostVendorJsonApplicationVndAPIPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVendorJsonResponse, error)
 }
 
+// PostBothWithBodyWithResponse request with arbitrary body returning *PostBothResponse
+func (c *ClientWithResponses) PostBothWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	rsp, err := c.PostBothWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parsePostBothResponseWithoutBody(rsp)
+	}
+	return ParsePostBothResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	rsp, err := c.PostBoth(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parsePostBothResponseWithoutBody(rsp)
+	}
+	return ParsePostBothResponse(rsp)
+}
+
+// parsePostBothResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parsePostBothResponseWithoutBody(rsp *http.Response) (*PostBothResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &PostBothResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetBothWithResponse request returning *GetBothResponse
+func (c *ClientWithResponses) GetBothWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBothResponse, error) {
+	rsp, err := c.GetBoth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetBothResponseWithoutBody(rsp)
+	}
+	return ParseGetBothResponse(rsp)
+}
+
+// parseGetBothResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetBothResponseWithoutBody(rsp *http.Response) (*GetBothResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetBothResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// PostJsonWithBodyWithResponse request with arbitrary body returning *PostJsonResponse
+func (c *ClientWithResponses) PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
+	rsp, err := c.PostJsonWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parsePostJsonResponseWithoutBody(rsp)
+	}
+	return ParsePostJsonResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostJsonWithResponse(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
+	rsp, err := c.PostJson(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parsePostJsonResponseWithoutBody(rsp)
+	}
+	return ParsePostJsonResponse(rsp)
+}
+
+// parsePostJsonResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parsePostJsonResponseWithoutBody(rsp *http.Response) (*PostJsonResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &PostJsonResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetJsonWithResponse request returning *GetJsonResponse
+func (c *ClientWithResponses) GetJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonResponse, error) {
+	rsp, err := c.GetJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetJsonResponseWithoutBody(rsp)
+	}
+	return ParseGetJsonResponse(rsp)
+}
+
+// parseGetJsonResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetJsonResponseWithoutBody(rsp *http.Response) (*GetJsonResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetJsonResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// PostOtherWithBodyWithResponse request with arbitrary body returning *PostOtherResponse
+func (c *ClientWithResponses) PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOtherResponse, error) {
+	rsp, err := c.PostOtherWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parsePostOtherResponseWithoutBody(rsp)
+	}
+	return ParsePostOtherResponse(rsp)
+}
+
+// parsePostOtherResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parsePostOtherResponseWithoutBody(rsp *http.Response) (*PostOtherResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &PostOtherResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetOtherWithResponse request returning *GetOtherResponse
+func (c *ClientWithResponses) GetOtherWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOtherResponse, error) {
+	rsp, err := c.GetOther(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetOtherResponseWithoutBody(rsp)
+	}
+	return ParseGetOtherResponse(rsp)
+}
+
+// parseGetOtherResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetOtherResponseWithoutBody(rsp *http.Response) (*GetOtherResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetOtherResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetJsonWithTrailingSlashWithResponse request returning *GetJsonWithTrailingSlashResponse
+func (c *ClientWithResponses) GetJsonWithTrailingSlashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonWithTrailingSlashResponse, error) {
+	rsp, err := c.GetJsonWithTrailingSlash(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetJsonWithTrailingSlashResponseWithoutBody(rsp)
+	}
+	return ParseGetJsonWithTrailingSlashResponse(rsp)
+}
+
+// parseGetJsonWithTrailingSlashResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetJsonWithTrailingSlashResponseWithoutBody(rsp *http.Response) (*GetJsonWithTrailingSlashResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetJsonWithTrailingSlashResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// PostVendorJsonWithBodyWithResponse request with arbitrary body returning *PostVendorJsonResponse
+func (c *ClientWithResponses) PostVendorJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostVendorJsonResponse, error) {
+	rsp, err := c.PostVendorJsonWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parsePostVendorJsonResponseWithoutBody(rsp)
+	}
+	return ParsePostVendorJsonResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostVendorJsonWithApplicationVndAPIPlusJSONBodyWithResponse(ctx context.Context, body PostVendorJsonApplicationVndAPIPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVendorJsonResponse, error) {
+	rsp, err := c.PostVendorJsonWithApplicationVndAPIPlusJSONBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parsePostVendorJsonResponseWithoutBody(rsp)
+	}
+	return ParsePostVendorJsonResponse(rsp)
+}
+
+// parsePostVendorJsonResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parsePostVendorJsonResponseWithoutBody(rsp *http.Response) (*PostVendorJsonResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &PostVendorJsonResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// PostBothResponse is the response of PostBoth. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type PostBothResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -624,6 +998,9 @@ func (r PostBothResponse) StatusCode() int {
 	return 0
 }
 
+// GetBothResponse is the response of GetBoth. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type GetBothResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -645,6 +1022,9 @@ func (r GetBothResponse) StatusCode() int {
 	return 0
 }
 
+// PostJsonResponse is the response of PostJson. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type PostJsonResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -666,6 +1046,9 @@ func (r PostJsonResponse) StatusCode() int {
 	return 0
 }
 
+// GetJsonResponse is the response of GetJson. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type GetJsonResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -687,6 +1070,9 @@ func (r GetJsonResponse) StatusCode() int {
 	return 0
 }
 
+// PostOtherResponse is the response of PostOther. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type PostOtherResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -708,6 +1094,9 @@ func (r PostOtherResponse) StatusCode() int {
 	return 0
 }
 
+// GetOtherResponse is the response of GetOther. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type GetOtherResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -729,6 +1118,9 @@ func (r GetOtherResponse) StatusCode() int {
 	return 0
 }
 
+// GetJsonWithTrailingSlashResponse is the response of GetJsonWithTrailingSlash. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type GetJsonWithTrailingSlashResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -750,6 +1142,9 @@ func (r GetJsonWithTrailingSlashResponse) StatusCode() int {
 	return 0
 }
 
+// PostVendorJsonResponse is the response of PostVendorJson. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type PostVendorJsonResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -771,106 +1166,12 @@ func (r PostVendorJsonResponse) StatusCode() int {
 	return 0
 }
 
-// PostBothWithBodyWithResponse request with arbitrary body returning *PostBothResponse
-func (c *ClientWithResponses) PostBothWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
-	rsp, err := c.PostBothWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostBothResponse(rsp)
-}
-
-func (c *ClientWithResponses) PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
-	rsp, err := c.PostBoth(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostBothResponse(rsp)
-}
-
-// GetBothWithResponse request returning *GetBothResponse
-func (c *ClientWithResponses) GetBothWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBothResponse, error) {
-	rsp, err := c.GetBoth(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetBothResponse(rsp)
-}
-
-// PostJsonWithBodyWithResponse request with arbitrary body returning *PostJsonResponse
-func (c *ClientWithResponses) PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
-	rsp, err := c.PostJsonWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostJsonResponse(rsp)
-}
-
-func (c *ClientWithResponses) PostJsonWithResponse(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
-	rsp, err := c.PostJson(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostJsonResponse(rsp)
-}
-
-// GetJsonWithResponse request returning *GetJsonResponse
-func (c *ClientWithResponses) GetJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonResponse, error) {
-	rsp, err := c.GetJson(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetJsonResponse(rsp)
-}
-
-// PostOtherWithBodyWithResponse request with arbitrary body returning *PostOtherResponse
-func (c *ClientWithResponses) PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOtherResponse, error) {
-	rsp, err := c.PostOtherWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostOtherResponse(rsp)
-}
-
-// GetOtherWithResponse request returning *GetOtherResponse
-func (c *ClientWithResponses) GetOtherWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOtherResponse, error) {
-	rsp, err := c.GetOther(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetOtherResponse(rsp)
-}
-
-// GetJsonWithTrailingSlashWithResponse request returning *GetJsonWithTrailingSlashResponse
-func (c *ClientWithResponses) GetJsonWithTrailingSlashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonWithTrailingSlashResponse, error) {
-	rsp, err := c.GetJsonWithTrailingSlash(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetJsonWithTrailingSlashResponse(rsp)
-}
-
-// PostVendorJsonWithBodyWithResponse request with arbitrary body returning *PostVendorJsonResponse
-func (c *ClientWithResponses) PostVendorJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostVendorJsonResponse, error) {
-	rsp, err := c.PostVendorJsonWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostVendorJsonResponse(rsp)
-}
-
-func (c *ClientWithResponses) PostVendorJsonWithApplicationVndAPIPlusJSONBodyWithResponse(ctx context.Context, body PostVendorJsonApplicationVndAPIPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PostVendorJsonResponse, error) {
-	rsp, err := c.PostVendorJsonWithApplicationVndAPIPlusJSONBody(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostVendorJsonResponse(rsp)
-}
-
-// ParsePostBothResponse parses an HTTP response from a PostBothWithResponse call
+// ParsePostBothResponse parses an HTTP response from a PostBothWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParsePostBothResponse(rsp *http.Response) (*PostBothResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -883,10 +1184,12 @@ func ParsePostBothResponse(rsp *http.Response) (*PostBothResponse, error) {
 	return response, nil
 }
 
-// ParseGetBothResponse parses an HTTP response from a GetBothWithResponse call
+// ParseGetBothResponse parses an HTTP response from a GetBothWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetBothResponse(rsp *http.Response) (*GetBothResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -899,10 +1202,12 @@ func ParseGetBothResponse(rsp *http.Response) (*GetBothResponse, error) {
 	return response, nil
 }
 
-// ParsePostJsonResponse parses an HTTP response from a PostJsonWithResponse call
+// ParsePostJsonResponse parses an HTTP response from a PostJsonWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParsePostJsonResponse(rsp *http.Response) (*PostJsonResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -915,10 +1220,12 @@ func ParsePostJsonResponse(rsp *http.Response) (*PostJsonResponse, error) {
 	return response, nil
 }
 
-// ParseGetJsonResponse parses an HTTP response from a GetJsonWithResponse call
+// ParseGetJsonResponse parses an HTTP response from a GetJsonWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetJsonResponse(rsp *http.Response) (*GetJsonResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -931,10 +1238,12 @@ func ParseGetJsonResponse(rsp *http.Response) (*GetJsonResponse, error) {
 	return response, nil
 }
 
-// ParsePostOtherResponse parses an HTTP response from a PostOtherWithResponse call
+// ParsePostOtherResponse parses an HTTP response from a PostOtherWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParsePostOtherResponse(rsp *http.Response) (*PostOtherResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -947,10 +1256,12 @@ func ParsePostOtherResponse(rsp *http.Response) (*PostOtherResponse, error) {
 	return response, nil
 }
 
-// ParseGetOtherResponse parses an HTTP response from a GetOtherWithResponse call
+// ParseGetOtherResponse parses an HTTP response from a GetOtherWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetOtherResponse(rsp *http.Response) (*GetOtherResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -963,10 +1274,12 @@ func ParseGetOtherResponse(rsp *http.Response) (*GetOtherResponse, error) {
 	return response, nil
 }
 
-// ParseGetJsonWithTrailingSlashResponse parses an HTTP response from a GetJsonWithTrailingSlashWithResponse call
+// ParseGetJsonWithTrailingSlashResponse parses an HTTP response from a GetJsonWithTrailingSlashWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetJsonWithTrailingSlashResponse(rsp *http.Response) (*GetJsonWithTrailingSlashResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -979,10 +1292,12 @@ func ParseGetJsonWithTrailingSlashResponse(rsp *http.Response) (*GetJsonWithTrai
 	return response, nil
 }
 
-// ParsePostVendorJsonResponse parses an HTTP response from a PostVendorJsonWithResponse call
+// ParsePostVendorJsonResponse parses an HTTP response from a PostVendorJsonWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParsePostVendorJsonResponse(rsp *http.Response) (*PostVendorJsonResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -994,3 +1309,65 @@ func ParsePostVendorJsonResponse(rsp *http.Response) (*PostVendorJsonResponse, e
 
 	return response, nil
 }
+
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "PostBoth":
+		return ParsePostBothResponse(rsp)
+	case "GetBoth":
+		return ParseGetBothResponse(rsp)
+	case "PostJson":
+		return ParsePostJsonResponse(rsp)
+	case "GetJson":
+		return ParseGetJsonResponse(rsp)
+	case "PostOther":
+		return ParsePostOtherResponse(rsp)
+	case "GetOther":
+		return ParseGetOtherResponse(rsp)
+	case "GetJsonWithTrailingSlash":
+		return ParseGetJsonWithTrailingSlashResponse(rsp)
+	case "PostVendorJson":
+		return ParsePostVendorJsonResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingDoer records the requests it's given, answering them with an
+// empty response.
+type recordingDoer struct {
+	requests []*http.Request
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.requests = append(d.requests, req)
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+}
+
+func setHeader(value string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-Editor", value)
+		req.Header.Add("X-Order", value)
+		return nil
+	}
+}
+
+func TestRequestEditorsOrder(t *testing.T) {
+	doer := &recordingDoer{}
+	client, err := NewClient(withTrailingSlash,
+		WithHTTPClient(doer),
+		WithRequestEditorFn(setHeader("client-1")),
+		WithRequestEditorFn(setHeader("client-2")),
+	)
+	require.NoError(t, err)
+
+	_, err = client.GetJson(context.Background(), setHeader("call-1"), setHeader("call-2"))
+	require.NoError(t, err)
+
+	req := doer.requests[0]
+	assert.Equal(t, []string{"client-1", "client-2", "call-1", "call-2"}, req.Header.Values("X-Order"))
+	assert.Equal(t, "call-2", req.Header.Get("X-Editor"))
+}
+
+func TestRequestEditorReplacing(t *testing.T) {
+	doer := &recordingDoer{}
+	client, err := NewClient(withTrailingSlash,
+		WithHTTPClient(doer),
+		WithRequestEditorReplacing("auth", setHeader("first")),
+		WithRequestEditorFn(setHeader("other")),
+		WithRequestEditorReplacing("auth", setHeader("second")),
+	)
+	require.NoError(t, err)
+	require.Len(t, client.RequestEditors, 2)
+
+	// The replacing editor keeps the position of the one it replaces.
+	_, err = client.GetJson(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"second", "other"}, doer.requests[0].Header.Values("X-Order"))
+
+	ctx := WithRequestEditorOverride(context.Background(), "auth", setHeader("override"))
+	_, err = client.GetJson(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"override", "other"}, doer.requests[1].Header.Values("X-Order"))
+
+	ctx = WithRequestEditorOverride(context.Background(), "auth", nil)
+	_, err = client.GetJson(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"other"}, doer.requests[2].Header.Values("X-Order"))
+}
+
+func TestRequestEditorErrors(t *testing.T) {
+	errEditor := errors.New("no token")
+	failing := func(ctx context.Context, req *http.Request) error {
+		return errEditor
+	}
+
+	client, err := NewClient(withTrailingSlash,
+		WithHTTPClient(&recordingDoer{}),
+		WithRequestEditorFn(setHeader("client")),
+		WithRequestEditorReplacing("auth", failing),
+	)
+	require.NoError(t, err)
+
+	_, err = client.GetJson(context.Background())
+	assert.EqualError(t, err, "GetJson: client request editor 1 (auth): no token")
+	assert.ErrorIs(t, err, errEditor)
+	var editorErr *RequestEditorError
+	require.ErrorAs(t, err, &editorErr)
+	assert.Equal(t, RequestEditorError{OperationID: "GetJson", Index: 1, Name: "auth", Err: errEditor}, *editorErr)
+
+	client.RequestEditors = nil
+	_, err = client.GetJson(context.Background(), setHeader("call"), failing)
+	assert.EqualError(t, err, "GetJson: request editor 1 of the call: no token")
+	assert.ErrorIs(t, err, errEditor)
+}
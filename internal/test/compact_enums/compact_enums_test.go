@@ -0,0 +1,31 @@
+package compact_enums
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactEnums(t *testing.T) {
+	// Above the threshold the values are kept in a slice, without constants.
+	assert.Len(t, airportCodeValues, 2000)
+	assert.True(t, AirportCode("AAA").IsValid())
+	assert.True(t, airportCodeValues[len(airportCodeValues)-1].IsValid())
+	assert.False(t, AirportCode("aaa").IsValid())
+	assert.False(t, AirportCode("").IsValid())
+
+	// x-enum-varnames still gives constants when they are skipped otherwise.
+	assert.True(t, High.IsValid())
+	assert.True(t, Low.IsValid())
+	assert.True(t, Medium.IsValid())
+	assert.False(t, Priority(4).IsValid())
+
+	assert.True(t, Color("red").IsValid())
+	assert.Equal(t, "The color red", Color("red").Description())
+	assert.Equal(t, "", Color("green").Description())
+	assert.False(t, Color("blue").IsValid())
+
+	// Below the threshold enums are generated as before.
+	assert.True(t, Active.IsValid())
+	assert.False(t, Status("deleted").IsValid())
+}
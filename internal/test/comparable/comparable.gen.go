@@ -0,0 +1,41 @@
+// Package comparable provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package comparable
+
+import (
+	"encoding/json"
+)
+
+// Filter A search filter, which isn't comparable, having arrays, but whose
+// canonical key can be used instead.
+type Filter struct {
+	Labels *map[string]string `json:"labels,omitempty"`
+	Query  *string            `json:"query,omitempty"`
+	Tags   *[]string          `json:"tags,omitempty"`
+}
+
+// Location Where a server is, used as a map key.
+type Location struct {
+	Rack   int    `json:"rack,omitempty"`
+	Region string `json:"region"`
+	Zone   string `json:"zone,omitempty"`
+}
+
+// Placement A location with a weight, comparable as a whole.
+type Placement struct {
+	// Location Where a server is, used as a map key.
+	Location Location `json:"location,omitempty"`
+	Weight   float32  `json:"weight,omitempty"`
+}
+
+// Key returns a canonical string of the value of the Filter, its JSON encoding,
+// equal for equal values, for use as a map key, as the Filter itself isn't
+// comparable. A value which can't be encoded has an empty key.
+func (t Filter) Key() string {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,49 @@
+package comparable
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComparableMapKeys(t *testing.T) {
+	servers := map[Location][]string{}
+	for _, body := range []string{
+		`{"region":"eu","zone":"a","rack":1}`,
+		`{"region":"eu","zone":"a","rack":1}`,
+		`{"region":"eu","zone":"b"}`,
+	} {
+		var location Location
+		require.NoError(t, json.Unmarshal([]byte(body), &location))
+		servers[location] = append(servers[location], body)
+	}
+	assert.Len(t, servers, 2)
+	assert.Len(t, servers[Location{Region: "eu", Zone: "a", Rack: 1}], 2)
+	assert.Len(t, servers[Location{Region: "eu", Zone: "b"}], 1)
+
+	// Comparable objects may be used as the properties of others.
+	weights := map[Placement]bool{{Location: Location{Region: "us"}, Weight: 0.5}: true}
+	assert.True(t, weights[Placement{Location: Location{Region: "us"}, Weight: 0.5}])
+
+	// The optional properties are still omitted when empty.
+	data, err := json.Marshal(Location{Region: "eu"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"region":"eu"}`, string(data))
+}
+
+func TestComparableKey(t *testing.T) {
+	query := "disks"
+	var f1, f2 Filter
+	require.NoError(t, json.Unmarshal([]byte(`{"query":"disks","tags":["a","b"],"labels":{"x":"1","y":"2"}}`), &f1))
+	require.NoError(t, json.Unmarshal([]byte(`{"labels":{"y":"2","x":"1"},"tags":["a","b"],"query":"disks"}`), &f2))
+	assert.Equal(t, f1.Key(), f2.Key())
+
+	results := map[string]Filter{f1.Key(): f1}
+	_, found := results[f2.Key()]
+	assert.True(t, found)
+
+	f3 := Filter{Query: &query, Tags: &[]string{"b", "a"}}
+	assert.NotEqual(t, f1.Key(), f3.Key())
+}
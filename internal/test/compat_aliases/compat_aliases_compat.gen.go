@@ -0,0 +1,23 @@
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package compat_aliases
+
+import (
+	"net/http"
+)
+
+// Client is the name APIClient had before the naming options changed.
+//
+// Deprecated: Use APIClient instead.
+type Client = APIClient
+
+// GetPetResponse is the name GetPetReply had before the naming options changed.
+//
+// Deprecated: Use GetPetReply instead.
+type GetPetResponse = GetPetReply
+
+// ParseGetPetResponse is the name ParseGetPetReply had before the naming options changed.
+//
+// Deprecated: Use ParseGetPetReply instead.
+func ParseGetPetResponse(rsp *http.Response) (*GetPetReply, error) {
+	return ParseGetPetReply(rsp)
+}
@@ -0,0 +1,38 @@
+package compat_aliases
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The previous names still compile, as the ones they were renamed to.
+var (
+	_ *APIClient   = (*Client)(nil)
+	_ *GetPetReply = (*GetPetResponse)(nil)
+)
+
+func TestPreviousNames(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(Pet{Id: "1", Name: "Rex"}))
+	}))
+	defer server.Close()
+
+	var client *Client
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.GetPet(context.Background(), "1")
+	require.NoError(t, err)
+
+	var reply *GetPetResponse
+	reply, err = ParseGetPetResponse(rsp)
+	require.NoError(t, err)
+	assert.Equal(t, &Pet{Id: "1", Name: "Rex"}, reply.JSON200)
+}
@@ -18,6 +18,16 @@ const (
 	Enum1Two   Enum1 = "Two"
 )
 
+// IsValid reports whether v is one of the values of Enum1.
+func (v Enum1) IsValid() bool {
+	switch v {
+	case Enum1One, Enum1Three, Enum1Two:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Enum2.
 const (
 	Enum2Four  Enum2 = "Four"
@@ -25,6 +35,16 @@ const (
 	Enum2Two   Enum2 = "Two"
 )
 
+// IsValid reports whether v is one of the values of Enum2.
+func (v Enum2) IsValid() bool {
+	switch v {
+	case Enum2Four, Enum2Three, Enum2Two:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Enum3.
 const (
 	Enum3Bar      Enum3 = "Bar"
@@ -32,6 +52,16 @@ const (
 	Enum3Foo      Enum3 = "Foo"
 )
 
+// IsValid reports whether v is one of the values of Enum3.
+func (v Enum3) IsValid() bool {
+	switch v {
+	case Enum3Bar, Enum3Enum1One, Enum3Foo:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Enum4.
 const (
 	Cat   Enum4 = "Cat"
@@ -39,6 +69,16 @@ const (
 	Mouse Enum4 = "Mouse"
 )
 
+// IsValid reports whether v is one of the values of Enum4.
+func (v Enum4) IsValid() bool {
+	switch v {
+	case Cat, Dog, Mouse:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for Enum5.
 const (
 	Enum5N5 Enum5 = 5
@@ -46,6 +86,16 @@ const (
 	Enum5N7 Enum5 = 7
 )
 
+// IsValid reports whether v is one of the values of Enum5.
+func (v Enum5) IsValid() bool {
+	switch v {
+	case Enum5N5, Enum5N6, Enum5N7:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EnumUnion.
 const (
 	EnumUnionFour  EnumUnion = "Four"
@@ -54,6 +104,16 @@ const (
 	EnumUnionTwo   EnumUnion = "Two"
 )
 
+// IsValid reports whether v is one of the values of EnumUnion.
+func (v EnumUnion) IsValid() bool {
+	switch v {
+	case EnumUnionFour, EnumUnionOne, EnumUnionThree, EnumUnionTwo:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EnumUnion2.
 const (
 	EnumUnion2One   EnumUnion2 = "One"
@@ -62,6 +122,16 @@ const (
 	EnumUnion2Two   EnumUnion2 = "Two"
 )
 
+// IsValid reports whether v is one of the values of EnumUnion2.
+func (v EnumUnion2) IsValid() bool {
+	switch v {
+	case EnumUnion2One, EnumUnion2Seven, EnumUnion2Three, EnumUnion2Two:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for FunnyValues.
 const (
 	FunnyValuesAnd      FunnyValues = "&"
@@ -71,6 +141,16 @@ const (
 	FunnyValuesPercent  FunnyValues = "%"
 )
 
+// IsValid reports whether v is one of the values of FunnyValues.
+func (v FunnyValues) IsValid() bool {
+	switch v {
+	case FunnyValuesAnd, FunnyValuesAsterisk, FunnyValuesEmpty, FunnyValuesN5, FunnyValuesPercent:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EnumParam1.
 const (
 	EnumParam1Both EnumParam1 = "both"
@@ -78,6 +158,16 @@ const (
 	EnumParam1On   EnumParam1 = "on"
 )
 
+// IsValid reports whether v is one of the values of EnumParam1.
+func (v EnumParam1) IsValid() bool {
+	switch v {
+	case EnumParam1Both, EnumParam1Off, EnumParam1On:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EnumParam2.
 const (
 	EnumParam2Both EnumParam2 = "both"
@@ -85,6 +175,16 @@ const (
 	EnumParam2On   EnumParam2 = "on"
 )
 
+// IsValid reports whether v is one of the values of EnumParam2.
+func (v EnumParam2) IsValid() bool {
+	switch v {
+	case EnumParam2Both, EnumParam2Off, EnumParam2On:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EnumParam3.
 const (
 	Alice EnumParam3 = "alice"
@@ -92,6 +192,16 @@ const (
 	Eve   EnumParam3 = "eve"
 )
 
+// IsValid reports whether v is one of the values of EnumParam3.
+func (v EnumParam3) IsValid() bool {
+	switch v {
+	case Alice, Bob, Eve:
+		return true
+	default:
+		return false
+	}
+}
+
 // AdditionalPropertiesObject1 Has additional properties of type int
 type AdditionalPropertiesObject1 struct {
 	Id                   int            `json:"id"`
@@ -157,10 +267,10 @@ type Enum4 string
 // Enum5 Numerical enum
 type Enum5 int
 
-// EnumUnion defines model for EnumUnion.
+// EnumUnion Two enums of the same type combined with allOf.
 type EnumUnion string
 
-// EnumUnion2 defines model for EnumUnion2.
+// EnumUnion2 Two enums of the same type combined with allOf.
 type EnumUnion2 string
 
 // FunnyValues Edge cases for enum names
@@ -187,10 +297,10 @@ type OneOfObject10 struct {
 }
 
 // OneOfObject100 defines model for .
-type OneOfObject100 = interface{}
+type OneOfObject100 = map[string]interface{}
 
 // OneOfObject101 defines model for .
-type OneOfObject101 = interface{}
+type OneOfObject101 = map[string]interface{}
 
 // OneOfObject11 additional properties of oneOf
 type OneOfObject11 map[string]OneOfObject11_AdditionalProperties
@@ -209,7 +319,7 @@ type OneOfObject11_AdditionalProperties struct {
 	union json.RawMessage
 }
 
-// OneOfObject12 defines model for OneOfObject12.
+// OneOfObject12 allOf of a oneOf, a struct holding a single union
 type OneOfObject12 struct {
 	union json.RawMessage
 }
@@ -329,7 +439,7 @@ type OneOfVariant6 struct {
 
 // ReferenceToRenameMe When a Schema is renamed, $ref should refer to the new name
 type ReferenceToRenameMe struct {
-	// ToNewName This schema should be renamed via x-go-name when generating
+	// NewName This schema should be renamed via x-go-name when generating
 	NewName NewName `json:"ToNewName"`
 }
 
@@ -1309,19 +1419,19 @@ func (t OneOfObject13) AsOneOfVariant1() (OneOfVariant1, error) {
 	return body, err
 }
 
-// FromOneOfVariant1 overwrites any union data inside the OneOfObject13 as the provided OneOfVariant1
+// FromOneOfVariant1 overwrites any union data inside the OneOfObject13 as the provided OneOfVariant1, with
+// the type discriminator set to "v1"
 func (t *OneOfObject13) FromOneOfVariant1(v OneOfVariant1) error {
 	t.Type = "v1"
-
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject13, using the provided OneOfVariant1
+// MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject13, using the provided OneOfVariant1, with
+// the type discriminator set to "v1"
 func (t *OneOfObject13) MergeOneOfVariant1(v OneOfVariant1) error {
 	t.Type = "v1"
-
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1339,19 +1449,19 @@ func (t OneOfObject13) AsOneOfVariant6() (OneOfVariant6, error) {
 	return body, err
 }
 
-// FromOneOfVariant6 overwrites any union data inside the OneOfObject13 as the provided OneOfVariant6
+// FromOneOfVariant6 overwrites any union data inside the OneOfObject13 as the provided OneOfVariant6, with
+// the type discriminator set to "v6"
 func (t *OneOfObject13) FromOneOfVariant6(v OneOfVariant6) error {
 	t.Type = "v6"
-
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeOneOfVariant6 performs a merge with any union data inside the OneOfObject13, using the provided OneOfVariant6
+// MergeOneOfVariant6 performs a merge with any union data inside the OneOfObject13, using the provided OneOfVariant6, with
+// the type discriminator set to "v6"
 func (t *OneOfObject13) MergeOneOfVariant6(v OneOfVariant6) error {
 	t.Type = "v6"
-
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1363,11 +1473,7 @@ func (t *OneOfObject13) MergeOneOfVariant6(v OneOfVariant6) error {
 }
 
 func (t OneOfObject13) Discriminator() (string, error) {
-	var discriminator struct {
-		Discriminator string `json:"type"`
-	}
-	err := json.Unmarshal(t.union, &discriminator)
-	return discriminator.Discriminator, err
+	return string(t.Type), nil
 }
 
 func (t OneOfObject13) ValueByDiscriminator() (interface{}, error) {
@@ -1690,21 +1796,39 @@ func (t OneOfObject5) AsOneOfVariant4() (OneOfVariant4, error) {
 	return body, err
 }
 
-// FromOneOfVariant4 overwrites any union data inside the OneOfObject5 as the provided OneOfVariant4
+// FromOneOfVariant4 overwrites any union data inside the OneOfObject5 as the provided OneOfVariant4, with
+// the discriminator discriminator set to "OneOfVariant4"
 func (t *OneOfObject5) FromOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "OneOfVariant4"
 	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"OneOfVariant4"`)
+	b, err = json.Marshal(object)
 	t.union = b
 	return err
 }
 
-// MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject5, using the provided OneOfVariant4
+// MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject5, using the provided OneOfVariant4, with
+// the discriminator discriminator set to "OneOfVariant4"
 func (t *OneOfObject5) MergeOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "OneOfVariant4"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"OneOfVariant4"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
 
 	merged, err := runtime.JSONMerge(t.union, b)
 	t.union = merged
@@ -1718,21 +1842,39 @@ func (t OneOfObject5) AsOneOfVariant5() (OneOfVariant5, error) {
 	return body, err
 }
 
-// FromOneOfVariant5 overwrites any union data inside the OneOfObject5 as the provided OneOfVariant5
+// FromOneOfVariant5 overwrites any union data inside the OneOfObject5 as the provided OneOfVariant5, with
+// the discriminator discriminator set to "OneOfVariant5"
 func (t *OneOfObject5) FromOneOfVariant5(v OneOfVariant5) error {
-	v.Discriminator = "OneOfVariant5"
 	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"OneOfVariant5"`)
+	b, err = json.Marshal(object)
 	t.union = b
 	return err
 }
 
-// MergeOneOfVariant5 performs a merge with any union data inside the OneOfObject5, using the provided OneOfVariant5
+// MergeOneOfVariant5 performs a merge with any union data inside the OneOfObject5, using the provided OneOfVariant5, with
+// the discriminator discriminator set to "OneOfVariant5"
 func (t *OneOfObject5) MergeOneOfVariant5(v OneOfVariant5) error {
-	v.Discriminator = "OneOfVariant5"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"OneOfVariant5"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
 
 	merged, err := runtime.JSONMerge(t.union, b)
 	t.union = merged
@@ -1779,21 +1921,39 @@ func (t OneOfObject6) AsOneOfVariant4() (OneOfVariant4, error) {
 	return body, err
 }
 
-// FromOneOfVariant4 overwrites any union data inside the OneOfObject6 as the provided OneOfVariant4
+// FromOneOfVariant4 overwrites any union data inside the OneOfObject6 as the provided OneOfVariant4, with
+// the discriminator discriminator set to "v4"
 func (t *OneOfObject6) FromOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "v4"
 	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"v4"`)
+	b, err = json.Marshal(object)
 	t.union = b
 	return err
 }
 
-// MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject6, using the provided OneOfVariant4
+// MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject6, using the provided OneOfVariant4, with
+// the discriminator discriminator set to "v4"
 func (t *OneOfObject6) MergeOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "v4"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"v4"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
 
 	merged, err := runtime.JSONMerge(t.union, b)
 	t.union = merged
@@ -1807,21 +1967,39 @@ func (t OneOfObject6) AsOneOfVariant5() (OneOfVariant5, error) {
 	return body, err
 }
 
-// FromOneOfVariant5 overwrites any union data inside the OneOfObject6 as the provided OneOfVariant5
+// FromOneOfVariant5 overwrites any union data inside the OneOfObject6 as the provided OneOfVariant5, with
+// the discriminator discriminator set to "v5"
 func (t *OneOfObject6) FromOneOfVariant5(v OneOfVariant5) error {
-	v.Discriminator = "v5"
 	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"v5"`)
+	b, err = json.Marshal(object)
 	t.union = b
 	return err
 }
 
-// MergeOneOfVariant5 performs a merge with any union data inside the OneOfObject6, using the provided OneOfVariant5
+// MergeOneOfVariant5 performs a merge with any union data inside the OneOfObject6, using the provided OneOfVariant5, with
+// the discriminator discriminator set to "v5"
 func (t *OneOfObject6) MergeOneOfVariant5(v OneOfVariant5) error {
-	v.Discriminator = "v5"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"v5"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
 
 	merged, err := runtime.JSONMerge(t.union, b)
 	t.union = merged
@@ -1868,21 +2046,39 @@ func (t OneOfObject61) AsOneOfVariant4() (OneOfVariant4, error) {
 	return body, err
 }
 
-// FromOneOfVariant4 overwrites any union data inside the OneOfObject61 as the provided OneOfVariant4
+// FromOneOfVariant4 overwrites any union data inside the OneOfObject61 as the provided OneOfVariant4, with
+// the discriminator discriminator set to "v4"
 func (t *OneOfObject61) FromOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "v4"
 	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"v4"`)
+	b, err = json.Marshal(object)
 	t.union = b
 	return err
 }
 
-// MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject61, using the provided OneOfVariant4
+// MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject61, using the provided OneOfVariant4, with
+// the discriminator discriminator set to "v4"
 func (t *OneOfObject61) MergeOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "v4"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"v4"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
 
 	merged, err := runtime.JSONMerge(t.union, b)
 	t.union = merged
@@ -1896,21 +2092,39 @@ func (t OneOfObject61) AsOneOfVariant5() (OneOfVariant5, error) {
 	return body, err
 }
 
-// FromOneOfVariant5 overwrites any union data inside the OneOfObject61 as the provided OneOfVariant5
+// FromOneOfVariant5 overwrites any union data inside the OneOfObject61 as the provided OneOfVariant5, with
+// the discriminator discriminator set to "OneOfVariant5"
 func (t *OneOfObject61) FromOneOfVariant5(v OneOfVariant5) error {
-	v.Discriminator = "OneOfVariant5"
 	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"OneOfVariant5"`)
+	b, err = json.Marshal(object)
 	t.union = b
 	return err
 }
 
-// MergeOneOfVariant5 performs a merge with any union data inside the OneOfObject61, using the provided OneOfVariant5
+// MergeOneOfVariant5 performs a merge with any union data inside the OneOfObject61, using the provided OneOfVariant5, with
+// the discriminator discriminator set to "OneOfVariant5"
 func (t *OneOfObject61) MergeOneOfVariant5(v OneOfVariant5) error {
-	v.Discriminator = "OneOfVariant5"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"OneOfVariant5"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
 
 	merged, err := runtime.JSONMerge(t.union, b)
 	t.union = merged
@@ -1957,21 +2171,39 @@ func (t OneOfObject62) AsOneOfVariant4() (OneOfVariant4, error) {
 	return body, err
 }
 
-// FromOneOfVariant4 overwrites any union data inside the OneOfObject62 as the provided OneOfVariant4
+// FromOneOfVariant4 overwrites any union data inside the OneOfObject62 as the provided OneOfVariant4, with
+// the discriminator discriminator set to "variant_four"
 func (t *OneOfObject62) FromOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "variant_four"
 	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"variant_four"`)
+	b, err = json.Marshal(object)
 	t.union = b
 	return err
 }
 
-// MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject62, using the provided OneOfVariant4
+// MergeOneOfVariant4 performs a merge with any union data inside the OneOfObject62, using the provided OneOfVariant4, with
+// the discriminator discriminator set to "variant_four"
 func (t *OneOfObject62) MergeOneOfVariant4(v OneOfVariant4) error {
-	v.Discriminator = "variant_four"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"variant_four"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
 
 	merged, err := runtime.JSONMerge(t.union, b)
 	t.union = merged
@@ -1985,21 +2217,39 @@ func (t OneOfObject62) AsOneOfVariant51() (OneOfVariant51, error) {
 	return body, err
 }
 
-// FromOneOfVariant51 overwrites any union data inside the OneOfObject62 as the provided OneOfVariant51
+// FromOneOfVariant51 overwrites any union data inside the OneOfObject62 as the provided OneOfVariant51, with
+// the discriminator discriminator set to "one_of_variant51"
 func (t *OneOfObject62) FromOneOfVariant51(v OneOfVariant51) error {
-	v.Discriminator = "one_of_variant51"
 	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"one_of_variant51"`)
+	b, err = json.Marshal(object)
 	t.union = b
 	return err
 }
 
-// MergeOneOfVariant51 performs a merge with any union data inside the OneOfObject62, using the provided OneOfVariant51
+// MergeOneOfVariant51 performs a merge with any union data inside the OneOfObject62, using the provided OneOfVariant51, with
+// the discriminator discriminator set to "one_of_variant51"
 func (t *OneOfObject62) MergeOneOfVariant51(v OneOfVariant51) error {
-	v.Discriminator = "one_of_variant51"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["discriminator"] = json.RawMessage(`"one_of_variant51"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
 
 	merged, err := runtime.JSONMerge(t.union, b)
 	t.union = merged
@@ -2204,19 +2454,19 @@ func (t OneOfObject9) AsOneOfVariant1() (OneOfVariant1, error) {
 	return body, err
 }
 
-// FromOneOfVariant1 overwrites any union data inside the OneOfObject9 as the provided OneOfVariant1
+// FromOneOfVariant1 overwrites any union data inside the OneOfObject9 as the provided OneOfVariant1, with
+// the type discriminator set to "v1"
 func (t *OneOfObject9) FromOneOfVariant1(v OneOfVariant1) error {
 	t.Type = "v1"
-
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject9, using the provided OneOfVariant1
+// MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject9, using the provided OneOfVariant1, with
+// the type discriminator set to "v1"
 func (t *OneOfObject9) MergeOneOfVariant1(v OneOfVariant1) error {
 	t.Type = "v1"
-
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -2234,19 +2484,19 @@ func (t OneOfObject9) AsOneOfVariant6() (OneOfVariant6, error) {
 	return body, err
 }
 
-// FromOneOfVariant6 overwrites any union data inside the OneOfObject9 as the provided OneOfVariant6
+// FromOneOfVariant6 overwrites any union data inside the OneOfObject9 as the provided OneOfVariant6, with
+// the type discriminator set to "v6"
 func (t *OneOfObject9) FromOneOfVariant6(v OneOfVariant6) error {
 	t.Type = "v6"
-
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeOneOfVariant6 performs a merge with any union data inside the OneOfObject9, using the provided OneOfVariant6
+// MergeOneOfVariant6 performs a merge with any union data inside the OneOfObject9, using the provided OneOfVariant6, with
+// the type discriminator set to "v6"
 func (t *OneOfObject9) MergeOneOfVariant6(v OneOfVariant6) error {
 	t.Type = "v6"
-
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -2258,11 +2508,7 @@ func (t *OneOfObject9) MergeOneOfVariant6(v OneOfVariant6) error {
 }
 
 func (t OneOfObject9) Discriminator() (string, error) {
-	var discriminator struct {
-		Discriminator string `json:"type"`
-	}
-	err := json.Unmarshal(t.union, &discriminator)
-	return discriminator.Discriminator, err
+	return string(t.Type), nil
 }
 
 func (t OneOfObject9) ValueByDiscriminator() (interface{}, error) {
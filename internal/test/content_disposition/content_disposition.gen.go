@@ -0,0 +1,1390 @@
+// Package contentdisposition provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package contentdisposition
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network. They're applied in order, before the ones given to the call.
+	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// DownloadFile request
+	DownloadFile(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadImage request
+	DownloadImage(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) DownloadFile(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadFileRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "DownloadFile", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DownloadImage(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadImageRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "DownloadImage", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewDownloadFileRequest generates requests for DownloadFile
+func NewDownloadFileRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/files/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDownloadImageRequest generates requests for DownloadImage
+func NewDownloadImageRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/images/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
+		}
+	}
+	for i, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// DownloadFileWithResponse request
+	DownloadFileWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DownloadFileResponse, error)
+
+	// DownloadImageWithResponse request
+	DownloadImageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DownloadImageResponse, error)
+}
+
+// DownloadFileWithResponse request returning *DownloadFileResponse
+func (c *ClientWithResponses) DownloadFileWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DownloadFileResponse, error) {
+	rsp, err := c.DownloadFile(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseDownloadFileResponseWithoutBody(rsp)
+	}
+	return ParseDownloadFileResponse(rsp)
+}
+
+// parseDownloadFileResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseDownloadFileResponseWithoutBody(rsp *http.Response) (*DownloadFileResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &DownloadFileResponse{
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers DownloadFile200ResponseHeaders
+		if value := rsp.Header.Get("Content-Disposition"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "Content-Disposition", value, &headers.ContentDisposition, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header Content-Disposition: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	}
+
+	return response, nil
+}
+
+// DownloadImageWithResponse request returning *DownloadImageResponse
+func (c *ClientWithResponses) DownloadImageWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DownloadImageResponse, error) {
+	rsp, err := c.DownloadImage(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseDownloadImageResponseWithoutBody(rsp)
+	}
+	return ParseDownloadImageResponse(rsp)
+}
+
+// parseDownloadImageResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseDownloadImageResponseWithoutBody(rsp *http.Response) (*DownloadImageResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &DownloadImageResponse{
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers DownloadImage200ResponseHeaders
+		if value := rsp.Header.Get("Content-Disposition"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "Content-Disposition", value, &headers.ContentDisposition, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header Content-Disposition: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	}
+
+	return response, nil
+}
+
+// DownloadFileResponse is the response of DownloadFile. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type DownloadFileResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	BodyApplicationOctetStream200 []byte
+	Headers200                    *DownloadFile200ResponseHeaders
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Filename returns the filename of the Content-Disposition header of
+// HTTPResponse, parsed as RFC 6266 specifies, and whether it has one.
+func (r DownloadFileResponse) Filename() (string, bool) {
+	if r.HTTPResponse == nil {
+		return "", false
+	}
+	return contentDispositionFilename(r.HTTPResponse.Header.Get("Content-Disposition"))
+}
+
+// DownloadImageResponse is the response of DownloadImage. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type DownloadImageResponse struct {
+	Body                 []byte
+	HTTPResponse         *http.Response
+	BodyImageWildcard200 []byte
+	Headers200           *DownloadImage200ResponseHeaders
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Filename returns the filename of the Content-Disposition header of
+// HTTPResponse, parsed as RFC 6266 specifies, and whether it has one.
+func (r DownloadImageResponse) Filename() (string, bool) {
+	if r.HTTPResponse == nil {
+		return "", false
+	}
+	return contentDispositionFilename(r.HTTPResponse.Header.Get("Content-Disposition"))
+}
+
+// ParseDownloadFileResponse parses an HTTP response from a DownloadFileWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseDownloadFileResponse(rsp *http.Response) (*DownloadFileResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DownloadFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "application/octet-stream") && rsp.StatusCode == 200:
+		response.BodyApplicationOctetStream200 = bodyBytes
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers DownloadFile200ResponseHeaders
+		if value := rsp.Header.Get("Content-Disposition"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "Content-Disposition", value, &headers.ContentDisposition, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header Content-Disposition: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseDownloadImageResponse parses an HTTP response from a DownloadImageWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseDownloadImageResponse(rsp *http.Response) (*DownloadImageResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DownloadImageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "image/*") && rsp.StatusCode == 200:
+		response.BodyImageWildcard200 = bodyBytes
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers DownloadImage200ResponseHeaders
+		if value := rsp.Header.Get("Content-Disposition"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "Content-Disposition", value, &headers.ContentDisposition, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header Content-Disposition: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "DownloadFile":
+		return ParseDownloadFileResponse(rsp)
+	case "DownloadImage":
+		return ParseDownloadImageResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
+
+// responseHasContentType reports whether contentType, the Content-Type of a
+// response, has the media type of expected, which may be a wildcard, eg,
+// image/*, and at least its parameters.
+func responseHasContentType(contentType string, expected string) bool {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	expectedMediaType, expectedParams, err := mime.ParseMediaType(expected)
+	if err != nil {
+		return false
+	}
+	if expectedMediaType != "*/*" && expectedMediaType != mediaType &&
+		!(strings.HasSuffix(expectedMediaType, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(expectedMediaType, "*"))) {
+		return false
+	}
+	for name, value := range expectedParams {
+		if params[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// contentDispositionFilename returns the filename of header, a
+// Content-Disposition header of RFC 6266, eg, `attachment; filename="a.pdf"`,
+// and whether it has one. As RFC 6266 recommends, filename* is preferred to
+// filename, and only the last segment of a path is kept, so that it can't point
+// outside of the directory the file is saved into. The malformed parameters,
+// and those repeating an earlier one, are ignored.
+func contentDispositionFilename(header string) (string, bool) {
+	parts := contentDispositionSplit(header)
+	if !contentDispositionIsToken(strings.TrimSpace(parts[0])) {
+		return "", false
+	}
+	params := map[string]string{}
+	for _, part := range parts[1:] {
+		name, value, ok := contentDispositionParam(part)
+		if !ok {
+			continue
+		}
+		if _, found := params[name]; !found {
+			params[name] = value
+		}
+	}
+	filename, ok := params["filename*"]
+	if !ok {
+		filename, ok = params["filename"]
+	}
+	if !ok {
+		return "", false
+	}
+	filename = path.Base(strings.ReplaceAll(filename, `\`, "/"))
+	if filename == "/" || filename == "." || filename == ".." {
+		return "", false
+	}
+	return filename, true
+}
+
+// contentDispositionParam parses a parameter, `token "=" ( token /
+// quoted-string )`, or `ext-token "=" ext-value` for the extended ones,
+// returning its lower-cased name and its value, and whether it is well-formed.
+func contentDispositionParam(s string) (string, string, bool) {
+	name, value, found := strings.Cut(s, "=")
+	name = strings.ToLower(strings.TrimSpace(name))
+	value = strings.TrimSpace(value)
+	if !found || !contentDispositionIsToken(name) {
+		return "", "", false
+	}
+	if strings.HasSuffix(name, "*") {
+		decoded, ok := contentDispositionExtValue(value)
+		return name, decoded, ok
+	}
+	if strings.HasPrefix(value, `"`) {
+		unquoted, ok := contentDispositionUnquote(value)
+		if !ok {
+			return "", "", false
+		}
+		// Quoted strings are ISO-8859-1, although some senders write UTF-8.
+		if !utf8.ValidString(unquoted) {
+			unquoted = contentDispositionLatin1(unquoted)
+		}
+		return name, unquoted, true
+	}
+	return name, value, contentDispositionIsToken(value)
+}
+
+// contentDispositionExtValue decodes an ext-value of RFC 8187, `charset "'"
+// [ language ] "'" value-chars`, where value-chars are percent-encoded, whose
+// charset is UTF-8 or ISO-8859-1.
+func contentDispositionExtValue(s string) (string, bool) {
+	charset, rest, found := strings.Cut(s, "'")
+	if !found {
+		return "", false
+	}
+	_, encoded, found := strings.Cut(rest, "'")
+	if !found {
+		return "", false
+	}
+	var b strings.Builder
+	for i := 0; i < len(encoded); i++ {
+		c := encoded[i]
+		switch {
+		case c == '%':
+			if i+2 >= len(encoded) {
+				return "", false
+			}
+			decoded, err := strconv.ParseUint(encoded[i+1:i+3], 16, 8)
+			if err != nil {
+				return "", false
+			}
+			b.WriteByte(byte(decoded))
+			i += 2
+		case contentDispositionIsAttrChar(c):
+			b.WriteByte(c)
+		default:
+			return "", false
+		}
+	}
+	decoded := b.String()
+	switch strings.ToLower(charset) {
+	case "utf-8":
+		return decoded, utf8.ValidString(decoded)
+	case "iso-8859-1":
+		return contentDispositionLatin1(decoded), true
+	}
+	return "", false
+}
+
+// contentDispositionLatin1 decodes s, ISO-8859-1, into UTF-8.
+func contentDispositionLatin1(s string) string {
+	runes := make([]rune, len(s))
+	for i := 0; i < len(s); i++ {
+		runes[i] = rune(s[i])
+	}
+	return string(runes)
+}
+
+// contentDispositionSplit splits s at the semicolons which aren't within a
+// quoted string.
+func contentDispositionSplit(s string) []string {
+	var parts []string
+	quoted, escaped := false, false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case escaped:
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == ';':
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// contentDispositionUnquote returns the content of the quoted string s, with
+// its quoted pairs unescaped.
+func contentDispositionUnquote(s string) (string, bool) {
+	if len(s) < 2 || s[len(s)-1] != '"' {
+		return "", false
+	}
+	var b strings.Builder
+	for i := 1; i < len(s)-1; i++ {
+		c := s[i]
+		switch {
+		case c == '\\':
+			i++
+			if i == len(s)-1 {
+				return "", false
+			}
+			c = s[i]
+		case c == '"':
+			return "", false
+		}
+		b.WriteByte(c)
+	}
+	return b.String(), true
+}
+
+// contentDispositionIsToken returns whether s is a token of RFC 7230.
+func contentDispositionIsToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// contentDispositionIsAttrChar returns whether c is an attr-char of RFC 8187,
+// which extended values don't percent-encode.
+func contentDispositionIsAttrChar(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return strings.IndexByte("!#$&+-.^_`|~", c) >= 0
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /files/{name})
+	DownloadFile(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /images/{name})
+	DownloadImage(w http.ResponseWriter, r *http.Request, name string)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /files/{name})
+func (_ Unimplemented) DownloadFile(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /images/{name})
+func (_ Unimplemented) DownloadImage(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// DownloadFile operation middleware
+func (siw *ServerInterfaceWrapper) DownloadFile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadFile(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DownloadImage operation middleware
+func (siw *ServerInterfaceWrapper) DownloadImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadImage(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   errorHandlerFunc,
+	}
+}
+
+// NewDownloadFileHandler returns the handler HandlerWithOptions routes the
+// DownloadFile operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewDownloadFileHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).DownloadFile
+}
+
+// NewDownloadImageHandler returns the handler HandlerWithOptions routes the
+// DownloadImage operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewDownloadImageHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).DownloadImage
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/files/{name}", NewDownloadFileHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/images/{name}", NewDownloadImageHandler(si, options))
+	})
+
+	return r
+}
+
+type DownloadFileRequestObject struct {
+	Name string `json:"name"`
+}
+
+type DownloadFileResponseObject interface {
+	VisitDownloadFileResponse(w http.ResponseWriter) error
+}
+
+type DownloadFile200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type DownloadFile200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       DownloadFile200ResponseHeaders
+	ContentLength int64
+}
+
+func (response DownloadFile200ApplicationoctetStreamResponse) VisitDownloadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+// NewDownloadFile200ApplicationoctetStreamResponseWithFilename returns the response streaming body as a download named filename, set in its
+// Content-Disposition header, quoted and encoded as RFC 6266 specifies.
+func NewDownloadFile200ApplicationoctetStreamResponseWithFilename(body io.Reader, filename string) DownloadFile200ApplicationoctetStreamResponse {
+	response := DownloadFile200ApplicationoctetStreamResponse{Body: body}
+	response.Headers.ContentDisposition = attachmentContentDisposition(filename)
+	return response
+}
+
+// NewDownloadFile200ApplicationoctetStreamResponse returns the response of body, along with its
+// length.
+func NewDownloadFile200ApplicationoctetStreamResponse(body []byte) DownloadFile200ApplicationoctetStreamResponse {
+	return DownloadFile200ApplicationoctetStreamResponse{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+	}
+}
+
+type DownloadFile404Response struct {
+}
+
+func (response DownloadFile404Response) VisitDownloadFileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DownloadImageRequestObject struct {
+	Name string `json:"name"`
+}
+
+type DownloadImageResponseObject interface {
+	VisitDownloadImageResponse(w http.ResponseWriter) error
+}
+
+type DownloadImage200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type DownloadImage200ImageResponse struct {
+	Body          io.Reader
+	Headers       DownloadImage200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response DownloadImage200ImageResponse) VisitDownloadImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+// NewDownloadImage200ImageResponseWithFilename returns the response streaming body of
+// the given content type as a download named filename, set in its
+// Content-Disposition header, quoted and encoded as RFC 6266 specifies.
+func NewDownloadImage200ImageResponseWithFilename(body io.Reader, contentType string, filename string) DownloadImage200ImageResponse {
+	response := DownloadImage200ImageResponse{Body: body, ContentType: contentType}
+	response.Headers.ContentDisposition = attachmentContentDisposition(filename)
+	return response
+}
+
+// NewDownloadImage200ImageResponse returns the response of body, along with its
+// length, and the content type http.DetectContentType sniffs from it.
+func NewDownloadImage200ImageResponse(body []byte) DownloadImage200ImageResponse {
+	return DownloadImage200ImageResponse{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+		ContentType:   http.DetectContentType(body),
+	}
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /files/{name})
+	DownloadFile(ctx context.Context, request DownloadFileRequestObject) (DownloadFileResponseObject, error)
+
+	// (GET /images/{name})
+	DownloadImage(ctx context.Context, request DownloadImageRequestObject) (DownloadImageResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// DownloadFile operation middleware
+func (sh *strictHandler) DownloadFile(w http.ResponseWriter, r *http.Request, name string) {
+	var request DownloadFileRequestObject
+
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DownloadFile(ctx, request.(DownloadFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DownloadFile")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "DownloadFile"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DownloadFileResponseObject); ok {
+		if err := validResponse.VisitDownloadFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DownloadImage operation middleware
+func (sh *strictHandler) DownloadImage(w http.ResponseWriter, r *http.Request, name string) {
+	var request DownloadImageRequestObject
+
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DownloadImage(ctx, request.(DownloadImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DownloadImage")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "DownloadImage"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DownloadImageResponseObject); ok {
+		if err := validResponse.VisitDownloadImageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// attachmentContentDisposition formats the Content-Disposition header of
+// RFC 6266 of a download named filename, which is omitted when empty. A
+// filename which isn't printable ASCII is written both with the UTF-8 filename*
+// parameter and, for the recipients not supporting it, with a filename
+// parameter replacing its other characters with underscores.
+func attachmentContentDisposition(filename string) string {
+	if filename == "" {
+		return "attachment"
+	}
+	isASCII := true
+	fallback := strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7e {
+			isASCII = false
+			return '_'
+		}
+		return r
+	}, filename)
+
+	var b strings.Builder
+	b.WriteString(`attachment; filename="`)
+	for i := 0; i < len(fallback); i++ {
+		if fallback[i] == '"' || fallback[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(fallback[i])
+	}
+	b.WriteByte('"')
+	if !isASCII {
+		const hex = "0123456789ABCDEF"
+		b.WriteString("; filename*=UTF-8''")
+		for i := 0; i < len(filename); i++ {
+			switch c := filename[i]; {
+			case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9', strings.IndexByte("!#$&+-.^_`|~", c) >= 0:
+				b.WriteByte(c)
+			default:
+				b.WriteByte('%')
+				b.WriteByte(hex[c>>4])
+				b.WriteByte(hex[c&0xf])
+			}
+		}
+	}
+	return b.String()
+}
+
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// DownloadFileHandler handles the DownloadFile operation with its typed request and response objects.
+type DownloadFileHandler func(ctx context.Context, request DownloadFileRequestObject) (DownloadFileResponseObject, error)
+
+// DownloadImageHandler handles the DownloadImage operation with its typed request and response objects.
+type DownloadImageHandler func(ctx context.Context, request DownloadImageRequestObject) (DownloadImageResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnDownloadFile  func(next DownloadFileHandler) DownloadFileHandler
+	OnDownloadImage func(next DownloadImageHandler) DownloadImageHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) DownloadFile(ctx context.Context, request DownloadFileRequestObject) (DownloadFileResponseObject, error) {
+	handler := DownloadFileHandler(s.ssi.DownloadFile)
+	if s.middlewares.OnDownloadFile != nil {
+		handler = s.middlewares.OnDownloadFile(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) DownloadImage(ctx context.Context, request DownloadImageRequestObject) (DownloadImageResponseObject, error) {
+	handler := DownloadImageHandler(s.ssi.DownloadImage)
+	if s.middlewares.OnDownloadImage != nil {
+		handler = s.middlewares.OnDownloadImage(handler)
+	}
+	return handler(ctx, request)
+}
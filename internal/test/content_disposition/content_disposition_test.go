@@ -0,0 +1,175 @@
+package contentdisposition
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// filenames are those of the files served, by name, as they may be awkward
+// to put in a path.
+var filenames = map[string]string{
+	"report":  "report.pdf",
+	"quoted":  `say "hi".txt`,
+	"latin-1": "été.txt",
+	"utf-8":   "€ 100%.txt",
+}
+
+type strictServer struct{}
+
+func (strictServer) DownloadFile(ctx context.Context, request DownloadFileRequestObject) (DownloadFileResponseObject, error) {
+	filename, ok := filenames[request.Name]
+	if !ok {
+		return DownloadFile404Response{}, nil
+	}
+	return NewDownloadFile200ApplicationoctetStreamResponseWithFilename(strings.NewReader("content of "+filename), filename), nil
+}
+
+func (strictServer) DownloadImage(ctx context.Context, request DownloadImageRequestObject) (DownloadImageResponseObject, error) {
+	return NewDownloadImage200ImageResponseWithFilename(strings.NewReader("\x89PNG"), "image/png", request.Name), nil
+}
+
+func newClient(t *testing.T) *ClientWithResponses {
+	server := httptest.NewServer(Handler(NewStrictHandler(strictServer{}, nil)))
+	t.Cleanup(server.Close)
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	return client
+}
+
+func TestDownloadFilename(t *testing.T) {
+	client := newClient(t)
+
+	for name, expected := range filenames {
+		rsp, err := client.DownloadFileWithResponse(context.Background(), name)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rsp.StatusCode())
+		assert.Equal(t, "application/octet-stream", rsp.HTTPResponse.Header.Get("Content-Type"))
+		assert.Equal(t, "content of "+expected, string(rsp.Body))
+		filename, ok := rsp.Filename()
+		assert.True(t, ok)
+		assert.Equal(t, expected, filename)
+	}
+
+	rsp, err := client.DownloadFileWithResponse(context.Background(), "missing")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, rsp.StatusCode())
+	_, ok := rsp.Filename()
+	assert.False(t, ok)
+}
+
+func TestDownloadFilenameHeader(t *testing.T) {
+	client := newClient(t)
+
+	rsp, err := client.DownloadFileWithResponse(context.Background(), "latin-1")
+	require.NoError(t, err)
+	assert.Equal(t, `attachment; filename="_t_.txt"; filename*=UTF-8''%C3%A9t%C3%A9.txt`, rsp.HTTPResponse.Header.Get("Content-Disposition"))
+}
+
+func TestDownloadFilenameWithoutBodyParsing(t *testing.T) {
+	client := newClient(t)
+
+	// The filename is available when streaming the body, as it comes from the
+	// headers.
+	rsp, err := client.DownloadImageWithResponse(context.Background(), "logo.png", WithoutResponseBodyParsing())
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", rsp.HTTPResponse.Header.Get("Content-Type"))
+	filename, ok := rsp.Filename()
+	assert.True(t, ok)
+	assert.Equal(t, "logo.png", filename)
+
+	raw, err := client.DownloadImage(context.Background(), "logo.png")
+	require.NoError(t, err)
+	defer raw.Body.Close()
+	body, err := io.ReadAll(raw.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "\x89PNG", string(body))
+	filename, ok = (&DownloadImageResponse{HTTPResponse: raw}).Filename()
+	assert.True(t, ok)
+	assert.Equal(t, "logo.png", filename)
+}
+
+func TestContentDispositionFilename(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		filename string
+		ok       bool
+	}{
+		{name: "token", header: "attachment; filename=report.pdf", filename: "report.pdf", ok: true},
+		{name: "quoted string", header: `attachment; filename="annual report.pdf"`, filename: "annual report.pdf", ok: true},
+		{name: "quoted pairs", header: `attachment; filename="say \"hi\"; now.txt"`, filename: `say "hi"; now.txt`, ok: true},
+		{name: "case-insensitive", header: `ATTACHMENT; FileName="a.txt"`, filename: "a.txt", ok: true},
+		{name: "inline", header: `inline; filename="a.txt"`, filename: "a.txt", ok: true},
+		{name: "utf-8 extended", header: `attachment; filename*=UTF-8''%E2%82%AC%20rates.txt`, filename: "€ rates.txt", ok: true},
+		{name: "language", header: `attachment; filename*=utf-8'en'%C3%A9t%C3%A9.txt`, filename: "été.txt", ok: true},
+		{name: "latin-1 extended", header: `attachment; filename*=ISO-8859-1''%E9t%E9.txt`, filename: "été.txt", ok: true},
+		{name: "latin-1 quoted string", header: "attachment; filename=\"\xe9t\xe9.txt\"", filename: "été.txt", ok: true},
+		{
+			name:     "both, extended first",
+			header:   `attachment; filename*=UTF-8''%E2%82%AC.txt; filename="EUR.txt"`,
+			filename: "€.txt",
+			ok:       true,
+		},
+		{
+			name:     "both, extended last",
+			header:   `attachment; filename="EUR.txt"; filename*=UTF-8''%E2%82%AC.txt`,
+			filename: "€.txt",
+			ok:       true,
+		},
+		{
+			name:     "unknown charset falls back",
+			header:   `attachment; filename="EUR.txt"; filename*=KOI8-R''%E2.txt`,
+			filename: "EUR.txt",
+			ok:       true,
+		},
+		{
+			name:     "invalid utf-8 falls back",
+			header:   `attachment; filename="EUR.txt"; filename*=UTF-8''%E2.txt`,
+			filename: "EUR.txt",
+			ok:       true,
+		},
+		{
+			name:     "invalid percent-encoding falls back",
+			header:   `attachment; filename="EUR.txt"; filename*=UTF-8''%G2.txt`,
+			filename: "EUR.txt",
+			ok:       true,
+		},
+		{name: "first wins", header: `attachment; filename="a.txt"; filename="b.txt"`, filename: "a.txt", ok: true},
+		{name: "malformed parameter ignored", header: `attachment; =42; size; filename=a.txt`, filename: "a.txt", ok: true},
+		{name: "path", header: `attachment; filename="../../etc/passwd"`, filename: "passwd", ok: true},
+		{name: "windows path", header: `attachment; filename="C:\\Windows\\win.ini"`, filename: "win.ini", ok: true},
+		{name: "no filename", header: "attachment", ok: false},
+		{name: "empty filename", header: `attachment; filename=""`, ok: false},
+		{name: "malformed type", header: `"attachment"; filename=a.txt`, ok: false},
+		{name: "unterminated quoted string", header: `attachment; filename="a.txt`, ok: false},
+		{name: "empty", header: "", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename, ok := contentDispositionFilename(tt.header)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.filename, filename)
+		})
+	}
+}
+
+func TestAttachmentContentDisposition(t *testing.T) {
+	assert.Equal(t, "attachment", attachmentContentDisposition(""))
+	assert.Equal(t, `attachment; filename="report.pdf"`, attachmentContentDisposition("report.pdf"))
+	assert.Equal(t, `attachment; filename="say \"hi\" \\o/.txt"`, attachmentContentDisposition(`say "hi" \o/.txt`))
+	assert.Equal(t, `attachment; filename="_t_ rates.txt"; filename*=UTF-8''%C3%A9t%C3%A9%20rates.txt`,
+		attachmentContentDisposition("été rates.txt"))
+
+	for _, filename := range []string{"report.pdf", `say "hi".txt`, "été.txt", "€ 100%.txt", "日本語.txt"} {
+		parsed, ok := contentDispositionFilename(attachmentContentDisposition(filename))
+		assert.True(t, ok)
+		assert.Equal(t, filename, parsed)
+	}
+}
@@ -0,0 +1,78 @@
+package contenttypeparams
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct{}
+
+func (server) AddThing(ctx context.Context, request AddThingRequestObject) (AddThingResponseObject, error) {
+	if request.JSONVersion2Body != nil {
+		return AddThing200JSONVersion2Response(*request.JSONVersion2Body), nil
+	}
+	return AddThing200JSONResponse(*request.JSONBody), nil
+}
+
+func TestRequestContentTypeParams(t *testing.T) {
+	ts := httptest.NewServer(Handler(NewStrictHandler(server{}, nil)))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.AddThingWithResponse(context.Background(), Thing{Name: "one"})
+	require.NoError(t, err)
+	assert.Equal(t, "application/json", rsp.HTTPResponse.Header.Get("Content-Type"))
+	assert.Equal(t, &Thing{Name: "one"}, rsp.JSON200)
+	assert.Nil(t, rsp.JSONVersion2200)
+
+	rsp, err = client.AddThingWithJSONVersion2BodyWithResponse(context.Background(), ThingV2{Title: "two"})
+	require.NoError(t, err)
+	assert.Equal(t, "application/json; version=2", rsp.HTTPResponse.Header.Get("Content-Type"))
+	assert.Nil(t, rsp.JSON200)
+	assert.Equal(t, &ThingV2{Title: "two"}, rsp.JSONVersion2200)
+
+	// Requests with other parameters are matched by the ones they have.
+	for contentType, want := range map[string]string{
+		"application/json; charset=utf-8":           "application/json",
+		"application/json;version=2; charset=utf-8": "application/json; version=2",
+		"application/json; version=3":               "application/json",
+	} {
+		body := `{"name": "one"}`
+		if want != "application/json" {
+			body = `{"title": "two"}`
+		}
+		rsp, err := client.AddThingWithBody(context.Background(), contentType, bytes.NewReader([]byte(body)))
+		require.NoError(t, err)
+		_, _ = io.Copy(io.Discard, rsp.Body)
+		require.NoError(t, rsp.Body.Close())
+		assert.Equal(t, http.StatusOK, rsp.StatusCode, contentType)
+		assert.Equal(t, want, rsp.Header.Get("Content-Type"), contentType)
+	}
+}
+
+func TestResponseContentTypeParams(t *testing.T) {
+	for contentType, want := range map[string]AddThingResponse{
+		"application/json":                          {JSON200: &Thing{Name: "one"}},
+		"application/json; charset=utf-8":           {JSON200: &Thing{Name: "one"}},
+		"application/json; version=2":               {JSONVersion2200: &ThingV2{Title: "one"}},
+		"application/json; charset=utf-8;version=2": {JSONVersion2200: &ThingV2{Title: "one"}},
+	} {
+		rsp, err := ParseAddThingResponse(&http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{contentType}},
+			Body:       io.NopCloser(bytes.NewReader([]byte(`{"name": "one", "title": "one"}`))),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, want.JSON200, rsp.JSON200, contentType)
+		assert.Equal(t, want.JSONVersion2200, rsp.JSONVersion2200, contentType)
+	}
+}
@@ -0,0 +1,1156 @@
+// Package correlation provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package correlation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// CorrelationIDContextKey is the context key holding the ID correlating
+// requests, carried by the X-Request-Id header.
+const CorrelationIDContextKey = "X-Request-Id.CorrelationID"
+
+// WithCorrelationID returns a copy of ctx holding id, which the client sends
+// as the X-Request-Id header when it isn't given otherwise.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, CorrelationIDContextKey, id)
+}
+
+// CorrelationID returns the ID held by ctx, which the server wrappers put
+// there from the X-Request-Id header of the request, if any.
+func CorrelationID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(CorrelationIDContextKey).(string)
+	return id, ok && id != ""
+}
+
+// Widget defines model for Widget.
+type Widget struct {
+	Id string `json:"id"`
+}
+
+// GetWidgetParams defines parameters for GetWidget.
+type GetWidgetParams struct {
+	XRequestId *string `json:"X-Request-Id,omitempty"`
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network. They're applied in order, before the ones given to the call.
+	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// Ping request
+	Ping(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWidget request
+	GetWidget(ctx context.Context, id string, params *GetWidgetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) Ping(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPingRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "Ping", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWidget(ctx context.Context, id string, params *GetWidgetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWidgetRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	setCorrelationHeader(ctx, req)
+	if err := c.applyEditors(ctx, "GetWidget", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewPingRequest generates requests for Ping
+func NewPingRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ping")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWidgetRequest generates requests for GetWidget
+func NewGetWidgetRequest(server string, id string, params *GetWidgetParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/widgets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XRequestId != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Request-Id", runtime.ParamLocationHeader, *params.XRequestId)
+			if err != nil {
+				return nil, err
+			}
+
+			if err = validateHeaderValue("X-Request-Id", headerParam0); err != nil {
+				return nil, err
+			}
+			req.Header.Set("X-Request-Id", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// validateHeaderValue rejects header parameter values with non-ASCII
+// characters, which HTTP doesn't define an encoding for.
+func validateHeaderValue(name string, value string) error {
+	for i := 0; i < len(value); i++ {
+		if value[i] > 0x7f {
+			return fmt.Errorf("header parameter %s has a non-ASCII value %q", name, value)
+		}
+	}
+	return nil
+}
+
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
+		}
+	}
+	for i, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
+		}
+	}
+	return nil
+}
+
+// setCorrelationHeader sets the X-Request-Id header of req to the ID held by
+// ctx, if any, unless the parameters of the operation gave it already.
+func setCorrelationHeader(ctx context.Context, req *http.Request) {
+	if req.Header.Get("X-Request-Id") != "" {
+		return
+	}
+	if id, ok := CorrelationID(ctx); ok {
+		req.Header.Set("X-Request-Id", id)
+	}
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PingWithResponse request
+	PingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PingResponse, error)
+
+	// GetWidgetWithResponse request
+	GetWidgetWithResponse(ctx context.Context, id string, params *GetWidgetParams, reqEditors ...RequestEditorFn) (*GetWidgetResponse, error)
+}
+
+// PingWithResponse request returning *PingResponse
+func (c *ClientWithResponses) PingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PingResponse, error) {
+	rsp, err := c.Ping(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parsePingResponseWithoutBody(rsp)
+	}
+	return ParsePingResponse(rsp)
+}
+
+// parsePingResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parsePingResponseWithoutBody(rsp *http.Response) (*PingResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &PingResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetWidgetWithResponse request returning *GetWidgetResponse
+func (c *ClientWithResponses) GetWidgetWithResponse(ctx context.Context, id string, params *GetWidgetParams, reqEditors ...RequestEditorFn) (*GetWidgetResponse, error) {
+	rsp, err := c.GetWidget(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetWidgetResponseWithoutBody(rsp)
+	}
+	return ParseGetWidgetResponse(rsp)
+}
+
+// parseGetWidgetResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetWidgetResponseWithoutBody(rsp *http.Response) (*GetWidgetResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetWidgetResponse{
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers GetWidget200ResponseHeaders
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 404:
+		var headers GetWidget404ResponseHeaders
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers404 = &headers
+	}
+
+	return response, nil
+}
+
+// PingResponse is the response of Ping. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type PingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetWidgetResponse is the response of GetWidget. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetWidgetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Widget
+	Headers200   *GetWidget200ResponseHeaders
+	Headers404   *GetWidget404ResponseHeaders
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWidgetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWidgetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ParsePingResponse parses an HTTP response from a PingWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParsePingResponse(rsp *http.Response) (*PingResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetWidgetResponse parses an HTTP response from a GetWidgetWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseGetWidgetResponse(rsp *http.Response) (*GetWidgetResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWidgetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Widget
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers GetWidget200ResponseHeaders
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 404:
+		var headers GetWidget404ResponseHeaders
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers404 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "Ping":
+		return ParsePingResponse(rsp)
+	case "GetWidget":
+		return ParseGetWidgetResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /ping)
+	Ping(w http.ResponseWriter, r *http.Request)
+
+	// (GET /widgets/{id})
+	GetWidget(w http.ResponseWriter, r *http.Request, id string, params GetWidgetParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /ping)
+func (_ Unimplemented) Ping(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /widgets/{id})
+func (_ Unimplemented) GetWidget(w http.ResponseWriter, r *http.Request, id string, params GetWidgetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// Ping operation middleware
+func (siw *ServerInterfaceWrapper) Ping(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Ping(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetWidget operation middleware
+func (siw *ServerInterfaceWrapper) GetWidget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	if id := r.Header.Get("X-Request-Id"); id != "" {
+		ctx = WithCorrelationID(ctx, id)
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWidgetParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Request-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-Id")]; found {
+		var XRequestId string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", valueList[0], &XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-Id", Err: err})
+			return
+		}
+
+		params.XRequestId = &XRequestId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWidget(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   errorHandlerFunc,
+	}
+}
+
+// NewPingHandler returns the handler HandlerWithOptions routes the
+// Ping operation to, so that it can be mounted on its own.
+func NewPingHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).Ping
+}
+
+// NewGetWidgetHandler returns the handler HandlerWithOptions routes the
+// GetWidget operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewGetWidgetHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).GetWidget
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ping", NewPingHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/widgets/{id}", NewGetWidgetHandler(si, options))
+	})
+
+	return r
+}
+
+type PingRequestObject struct {
+}
+
+type PingResponseObject interface {
+	VisitPingResponse(w http.ResponseWriter) error
+}
+
+type Ping204Response struct {
+}
+
+func (response Ping204Response) VisitPingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type GetWidgetRequestObject struct {
+	Id string `json:"id"`
+	GetWidgetParams
+}
+
+// Params returns the query, header and cookie parameters of the request,
+// which are embedded in it.
+func (r GetWidgetRequestObject) Params() GetWidgetParams {
+	return r.GetWidgetParams
+}
+
+type GetWidgetResponseObject interface {
+	VisitGetWidgetResponse(w http.ResponseWriter) error
+}
+
+type GetWidget200ResponseHeaders struct {
+	XRequestId string
+}
+
+type GetWidget200JSONResponse struct {
+	Body    Widget
+	Headers GetWidget200ResponseHeaders
+}
+
+func (response GetWidget200JSONResponse) VisitGetWidgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-Id", fmt.Sprint(response.Headers.XRequestId))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// withCorrelationID fills in the X-Request-Id header with id, unless the
+// handler did.
+func (response GetWidget200JSONResponse) withCorrelationID(id string) GetWidgetResponseObject {
+	if response.Headers.XRequestId == "" {
+		response.Headers.XRequestId = id
+	}
+	return response
+}
+
+type GetWidget404ResponseHeaders struct {
+	XRequestId string
+}
+
+type GetWidget404Response struct {
+	Headers GetWidget404ResponseHeaders
+}
+
+func (response GetWidget404Response) VisitGetWidgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Request-Id", fmt.Sprint(response.Headers.XRequestId))
+	w.WriteHeader(404)
+	return nil
+}
+
+// withCorrelationID fills in the X-Request-Id header with id, unless the
+// handler did.
+func (response GetWidget404Response) withCorrelationID(id string) GetWidgetResponseObject {
+	if response.Headers.XRequestId == "" {
+		response.Headers.XRequestId = id
+	}
+	return response
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /ping)
+	Ping(ctx context.Context, request PingRequestObject) (PingResponseObject, error)
+
+	// (GET /widgets/{id})
+	GetWidget(ctx context.Context, request GetWidgetRequestObject) (GetWidgetResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// Ping operation middleware
+func (sh *strictHandler) Ping(w http.ResponseWriter, r *http.Request) {
+	var request PingRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Ping(ctx, request.(PingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Ping")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "Ping"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PingResponseObject); ok {
+		if err := validResponse.VisitPingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWidget operation middleware
+func (sh *strictHandler) GetWidget(w http.ResponseWriter, r *http.Request, id string, params GetWidgetParams) {
+	var request GetWidgetRequestObject
+
+	request.Id = id
+	request.GetWidgetParams = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWidget(ctx, request.(GetWidgetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWidget")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "GetWidget"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWidgetResponseObject); ok {
+		if echoed, ok := validResponse.(interface {
+			withCorrelationID(string) GetWidgetResponseObject
+		}); ok {
+			if id, ok := CorrelationID(r.Context()); ok {
+				validResponse = echoed.withCorrelationID(id)
+			}
+		}
+		if err := validResponse.VisitGetWidgetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// PingHandler handles the Ping operation with its typed request and response objects.
+type PingHandler func(ctx context.Context, request PingRequestObject) (PingResponseObject, error)
+
+// GetWidgetHandler handles the GetWidget operation with its typed request and response objects.
+type GetWidgetHandler func(ctx context.Context, request GetWidgetRequestObject) (GetWidgetResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnPing      func(next PingHandler) PingHandler
+	OnGetWidget func(next GetWidgetHandler) GetWidgetHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) Ping(ctx context.Context, request PingRequestObject) (PingResponseObject, error) {
+	handler := PingHandler(s.ssi.Ping)
+	if s.middlewares.OnPing != nil {
+		handler = s.middlewares.OnPing(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) GetWidget(ctx context.Context, request GetWidgetRequestObject) (GetWidgetResponseObject, error) {
+	handler := GetWidgetHandler(s.ssi.GetWidget)
+	if s.middlewares.OnGetWidget != nil {
+		handler = s.middlewares.OnGetWidget(handler)
+	}
+	return handler(ctx, request)
+}
@@ -0,0 +1,111 @@
+package correlation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingDoer struct {
+	req *http.Request
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.req = req
+	return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+}
+
+func TestClientSendsCorrelationID(t *testing.T) {
+	doer := &recordingDoer{}
+	client, err := NewClient("https://api.example.com", WithHTTPClient(doer))
+	require.NoError(t, err)
+	ctx := WithCorrelationID(context.Background(), "from-context")
+
+	_, err = client.GetWidget(ctx, "w1", &GetWidgetParams{})
+	require.NoError(t, err)
+	assert.Equal(t, "from-context", doer.req.Header.Get("X-Request-Id"))
+
+	given := "from-params"
+	_, err = client.GetWidget(ctx, "w1", &GetWidgetParams{XRequestId: &given})
+	require.NoError(t, err)
+	assert.Equal(t, "from-params", doer.req.Header.Get("X-Request-Id"))
+
+	_, err = client.GetWidget(context.Background(), "w1", &GetWidgetParams{})
+	require.NoError(t, err)
+	assert.Empty(t, doer.req.Header.Values("X-Request-Id"))
+
+	// Operations which don't declare the header don't send it.
+	_, err = client.Ping(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, doer.req.Header.Values("X-Request-Id"))
+}
+
+type strictServer struct {
+	received string
+	echo     bool
+}
+
+func (s *strictServer) GetWidget(ctx context.Context, request GetWidgetRequestObject) (GetWidgetResponseObject, error) {
+	s.received, _ = CorrelationID(ctx)
+	if request.Id != "w1" {
+		return GetWidget404Response{}, nil
+	}
+	response := GetWidget200JSONResponse{Body: Widget{Id: request.Id}}
+	if !s.echo {
+		response.Headers.XRequestId = "from-handler"
+	}
+	return response, nil
+}
+
+func (s *strictServer) Ping(ctx context.Context, request PingRequestObject) (PingResponseObject, error) {
+	_, ok := CorrelationID(ctx)
+	if ok {
+		s.received = "unexpected"
+	}
+	return Ping204Response{}, nil
+}
+
+func TestServerEchoesCorrelationID(t *testing.T) {
+	server := &strictServer{echo: true}
+	handler := Handler(NewStrictHandler(server, nil))
+
+	req := httptest.NewRequest(http.MethodGet, "/widgets/w1", nil)
+	req.Header.Set("X-Request-Id", "abc")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "abc", server.received)
+	assert.Equal(t, "abc", rec.Header().Get("X-Request-Id"))
+	var widget Widget
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&widget))
+	assert.Equal(t, "w1", widget.Id)
+
+	req = httptest.NewRequest(http.MethodGet, "/widgets/w2", nil)
+	req.Header.Set("X-Request-Id", "def")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "def", rec.Header().Get("X-Request-Id"))
+
+	// The header set by the handler is kept.
+	server.echo = false
+	req = httptest.NewRequest(http.MethodGet, "/widgets/w1", nil)
+	req.Header.Set("X-Request-Id", "abc")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, "from-handler", rec.Header().Get("X-Request-Id"))
+
+	// Operations which don't declare the header don't put it in the context.
+	server.received = ""
+	req = httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.Header.Set("X-Request-Id", "abc")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, server.received)
+}
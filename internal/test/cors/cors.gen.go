@@ -0,0 +1,471 @@
+// Package cors provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package cors
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+)
+
+// Thing defines model for Thing.
+type Thing struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// ListThingsParams defines parameters for ListThings.
+type ListThingsParams struct {
+	XRequestId *string `json:"X-Request-Id,omitempty"`
+}
+
+// DeleteThingParams defines parameters for DeleteThing.
+type DeleteThingParams struct {
+	XTrace *string `json:"X-Trace,omitempty"`
+}
+
+// CreateThingJSONRequestBody defines body for CreateThing for application/json ContentType.
+type CreateThingJSONRequestBody = Thing
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /things)
+	ListThings(w http.ResponseWriter, r *http.Request, params ListThingsParams)
+
+	// (POST /things)
+	CreateThing(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /things/{id})
+	DeleteThing(w http.ResponseWriter, r *http.Request, id int, params DeleteThingParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /things)
+func (_ Unimplemented) ListThings(w http.ResponseWriter, r *http.Request, params ListThingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /things)
+func (_ Unimplemented) CreateThing(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /things/{id})
+func (_ Unimplemented) DeleteThing(w http.ResponseWriter, r *http.Request, id int, params DeleteThingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListThings operation middleware
+func (siw *ServerInterfaceWrapper) ListThings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListThingsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Request-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-Id")]; found {
+		var XRequestId string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Request-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", valueList[0], &XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Request-Id", Err: err})
+			return
+		}
+
+		params.XRequestId = &XRequestId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListThings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateThing operation middleware
+func (siw *ServerInterfaceWrapper) CreateThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateThing(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteThing operation middleware
+func (siw *ServerInterfaceWrapper) DeleteThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteThingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Trace" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Trace")]; found {
+		var XTrace string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Trace", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Trace", valueList[0], &XTrace, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Trace", Err: err})
+			return
+		}
+
+		params.XTrace = &XTrace
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteThing(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   errorHandlerFunc,
+	}
+}
+
+// NewListThingsHandler returns the handler HandlerWithOptions routes the
+// ListThings operation to, so that it can be mounted on its own.
+func NewListThingsHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ListThings
+}
+
+// NewCreateThingHandler returns the handler HandlerWithOptions routes the
+// CreateThing operation to, so that it can be mounted on its own.
+func NewCreateThingHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).CreateThing
+}
+
+// NewDeleteThingHandler returns the handler HandlerWithOptions routes the
+// DeleteThing operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewDeleteThingHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).DeleteThing
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/things", NewListThingsHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/things", NewCreateThingHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/things/{id}", NewDeleteThingHandler(si, options))
+	})
+
+	return r
+}
+
+// CORSPathPolicy lists the methods and request headers used by the operations
+// on a single path.
+type CORSPathPolicy struct {
+	Methods []string
+	Headers []string
+}
+
+// CORSPolicy returns, per path template, the methods and request headers used
+// by the API, as defined in the spec.
+func CORSPolicy() map[string]CORSPathPolicy {
+	return map[string]CORSPathPolicy{
+		"/things": {
+			Methods: []string{"GET", "POST"},
+			Headers: []string{"Content-Type", "X-Request-Id"},
+		},
+		"/things/{id}": {
+			Methods: []string{"DELETE"},
+			Headers: []string{"X-Trace"},
+		},
+	}
+}
+
+// CORSOptions holds the parts of the CORS configuration which don't come from
+// the spec.
+type CORSOptions struct {
+	// AllowedOrigins lists the origins allowed to make cross-origin requests,
+	// "*" allows any origin.
+	AllowedOrigins []string
+	// AllowedHeaders lists request headers allowed on every path, in addition
+	// to the ones used by the spec.
+	AllowedHeaders []string
+	// AllowCredentials sets the Access-Control-Allow-Credentials header.
+	AllowCredentials bool
+	// MaxAge is the number of seconds a preflight response may be cached for.
+	MaxAge int
+	// BaseURL is stripped from the request path before it is matched against
+	// the path templates of the spec.
+	BaseURL string
+}
+
+// corsHeaders returns the CORS response headers for a request to path from
+// origin. The allowed methods and headers are only included for preflight
+// requests.
+func corsHeaders(options CORSOptions, path string, origin string, preflight bool) http.Header {
+	header := make(http.Header)
+	header.Set("Vary", "Origin")
+	if origin == "" || !corsOriginAllowed(options.AllowedOrigins, origin) {
+		return header
+	}
+	header.Set("Access-Control-Allow-Origin", origin)
+	if options.AllowCredentials {
+		header.Set("Access-Control-Allow-Credentials", "true")
+	}
+	if !preflight {
+		return header
+	}
+
+	policy, found := corsPathPolicy(strings.TrimPrefix(path, options.BaseURL))
+	if !found {
+		return header
+	}
+	header.Set("Access-Control-Allow-Methods", strings.Join(policy.Methods, ", "))
+	headers := append(append([]string{}, policy.Headers...), options.AllowedHeaders...)
+	if len(headers) != 0 {
+		header.Set("Access-Control-Allow-Headers", strings.Join(headers, ", "))
+	}
+	if options.MaxAge > 0 {
+		header.Set("Access-Control-Max-Age", strconv.Itoa(options.MaxAge))
+	}
+	return header
+}
+
+func corsOriginAllowed(allowed []string, origin string) bool {
+	for _, o := range allowed {
+		if o == "*" || strings.EqualFold(o, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// corsPathPolicy finds the policy of the path template matching path, where
+// a {param} segment of the template matches any single segment. A template
+// without parameters takes precedence.
+func corsPathPolicy(path string) (CORSPathPolicy, bool) {
+	policies := CORSPolicy()
+	if policy, found := policies[path]; found {
+		return policy, true
+	}
+	segments := strings.Split(path, "/")
+	for template, policy := range policies {
+		templateSegments := strings.Split(template, "/")
+		if len(templateSegments) != len(segments) {
+			continue
+		}
+		matched := true
+		for i, s := range templateSegments {
+			if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+				if segments[i] == "" {
+					matched = false
+					break
+				}
+				continue
+			}
+			if s != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return policy, true
+		}
+	}
+	return CORSPathPolicy{}, false
+}
+
+// isCORSPreflight reports whether a request is a CORS preflight request.
+func isCORSPreflight(method string, requestMethod string) bool {
+	return method == http.MethodOptions && requestMethod != ""
+}
+
+// CORSMiddleware answers CORS preflight requests from CORSPolicy, and adds the
+// CORS headers to the responses of other requests from an allowed origin.
+// Wrap the whole router with it, since preflight requests have no route of
+// their own.
+func CORSMiddleware(options CORSOptions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			preflight := isCORSPreflight(r.Method, r.Header.Get("Access-Control-Request-Method"))
+			for key, values := range corsHeaders(options, r.URL.Path, r.Header.Get("Origin"), preflight) {
+				w.Header()[key] = values
+			}
+			if preflight {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package cors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSPolicy(t *testing.T) {
+	assert.Equal(t, map[string]CORSPathPolicy{
+		"/things": {
+			Methods: []string{"GET", "POST"},
+			Headers: []string{"Content-Type", "X-Request-Id"},
+		},
+		"/things/{id}": {
+			Methods: []string{"DELETE"},
+			Headers: []string{"X-Trace"},
+		},
+	}, CORSPolicy())
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	handler := CORSMiddleware(CORSOptions{
+		AllowedOrigins: []string{"https://example.com"},
+		AllowedHeaders: []string{"Authorization"},
+		MaxAge:         600,
+		BaseURL:        "/api",
+	})(HandlerWithOptions(Unimplemented{}, ChiServerOptions{BaseURL: "/api"}))
+
+	t.Run("Preflight", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/api/things/42", nil)
+		req.Header.Set("Origin", "https://example.com")
+		req.Header.Set("Access-Control-Request-Method", "DELETE")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, "https://example.com", rr.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "DELETE", rr.Header().Get("Access-Control-Allow-Methods"))
+		assert.Equal(t, "X-Trace, Authorization", rr.Header().Get("Access-Control-Allow-Headers"))
+		assert.Equal(t, "600", rr.Header().Get("Access-Control-Max-Age"))
+	})
+
+	t.Run("DisallowedOrigin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/api/things", nil)
+		req.Header.Set("Origin", "https://example.org")
+		req.Header.Set("Access-Control-Request-Method", "GET")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Empty(t, rr.Header().Get("Access-Control-Allow-Origin"))
+		assert.Empty(t, rr.Header().Get("Access-Control-Allow-Methods"))
+	})
+
+	t.Run("SimpleRequest", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/things", nil)
+		req.Header.Set("Origin", "https://example.com")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotImplemented, rr.Code)
+		assert.Equal(t, "https://example.com", rr.Header().Get("Access-Control-Allow-Origin"))
+		assert.Empty(t, rr.Header().Get("Access-Control-Allow-Methods"))
+	})
+}
@@ -0,0 +1,806 @@
+// Package discriminator provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package discriminator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for PetType.
+const (
+	PetTypeCat     PetType = "cat"
+	PetTypeDog     PetType = "dog"
+	PetTypeLizard  PetType = "lizard"
+	PetTypeLizard1 PetType = "Lizard"
+)
+
+// IsValid reports whether v is one of the values of PetType.
+func (v PetType) IsValid() bool {
+	switch v {
+	case PetTypeCat, PetTypeDog, PetTypeLizard, PetTypeLizard1:
+		return true
+	default:
+		return false
+	}
+}
+
+// Bike defines model for Bike.
+type Bike struct {
+	Kind *string `json:"kind,omitempty"`
+}
+
+// Car defines model for Car.
+type Car struct {
+	Kind   *string `json:"kind,omitempty"`
+	Wheels *int    `json:"wheels,omitempty"`
+}
+
+// Cat defines model for Cat.
+type Cat struct {
+	Meows   *bool  `json:"meows,omitempty"`
+	PetType string `json:"petType"`
+}
+
+// Common defines model for Common.
+type Common struct {
+	Id string `json:"id"`
+}
+
+// Dog defines model for Dog.
+type Dog struct {
+	Barks   *bool   `json:"barks,omitempty"`
+	PetType *string `json:"petType,omitempty"`
+}
+
+// Lizard defines model for Lizard.
+type Lizard struct {
+	PetType PetType `json:"petType"`
+}
+
+// OptionalTagged defines model for OptionalTagged.
+type OptionalTagged struct {
+	Kind  *string `json:"kind,omitempty"`
+	union json.RawMessage
+}
+
+// Owned defines model for Owned.
+type Owned struct {
+	Id    string `json:"id"`
+	union json.RawMessage
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	union json.RawMessage
+}
+
+// PetType defines model for PetType.
+type PetType string
+
+// Tagged defines model for Tagged.
+type Tagged struct {
+	Kind  string `json:"kind"`
+	union json.RawMessage
+}
+
+// Vehicle defines model for Vehicle.
+type Vehicle struct {
+	union json.RawMessage
+}
+
+// AsCar returns the union data inside the OptionalTagged as a Car
+func (t OptionalTagged) AsCar() (Car, error) {
+	var body Car
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCar overwrites any union data inside the OptionalTagged as the provided Car, with
+// the kind discriminator set to "Car"
+func (t *OptionalTagged) FromCar(v Car) error {
+	discriminator := string("Car")
+	t.Kind = &discriminator
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCar performs a merge with any union data inside the OptionalTagged, using the provided Car, with
+// the kind discriminator set to "Car"
+func (t *OptionalTagged) MergeCar(v Car) error {
+	discriminator := string("Car")
+	t.Kind = &discriminator
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsBike returns the union data inside the OptionalTagged as a Bike
+func (t OptionalTagged) AsBike() (Bike, error) {
+	var body Bike
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromBike overwrites any union data inside the OptionalTagged as the provided Bike, with
+// the kind discriminator set to "Bike"
+func (t *OptionalTagged) FromBike(v Bike) error {
+	discriminator := string("Bike")
+	t.Kind = &discriminator
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeBike performs a merge with any union data inside the OptionalTagged, using the provided Bike, with
+// the kind discriminator set to "Bike"
+func (t *OptionalTagged) MergeBike(v Bike) error {
+	discriminator := string("Bike")
+	t.Kind = &discriminator
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t OptionalTagged) Discriminator() (string, error) {
+	if t.Kind != nil {
+		return string(*t.Kind), nil
+	}
+	var discriminator struct {
+		Discriminator string `json:"kind"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t OptionalTagged) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "Bike":
+		return t.AsBike()
+	case "Car":
+		return t.AsCar()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t OptionalTagged) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if t.Kind != nil {
+		object["kind"], err = json.Marshal(t.Kind)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'kind': %w", err)
+		}
+	}
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *OptionalTagged) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["kind"]; found {
+		err = json.Unmarshal(raw, &t.Kind)
+		if err != nil {
+			return fmt.Errorf("error reading 'kind': %w", err)
+		}
+	}
+
+	return err
+}
+
+// AsCat returns the union data inside the Owned as a Cat
+func (t Owned) AsCat() (Cat, error) {
+	var body Cat
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCat overwrites any union data inside the Owned as the provided Cat, with
+// the petType discriminator set to "cat"
+func (t *Owned) FromCat(v Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["petType"] = json.RawMessage(`"cat"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeCat performs a merge with any union data inside the Owned, using the provided Cat, with
+// the petType discriminator set to "cat"
+func (t *Owned) MergeCat(v Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["petType"] = json.RawMessage(`"cat"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDog returns the union data inside the Owned as a Dog
+func (t Owned) AsDog() (Dog, error) {
+	var body Dog
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDog overwrites any union data inside the Owned as the provided Dog, with
+// the petType discriminator set to "dog"
+func (t *Owned) FromDog(v Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["petType"] = json.RawMessage(`"dog"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeDog performs a merge with any union data inside the Owned, using the provided Dog, with
+// the petType discriminator set to "dog"
+func (t *Owned) MergeDog(v Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["petType"] = json.RawMessage(`"dog"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Owned) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"petType"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t Owned) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "cat":
+		return t.AsCat()
+	case "dog":
+		return t.AsDog()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t Owned) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	object["id"], err = json.Marshal(t.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
+	}
+
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *Owned) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &t.Id)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+	}
+
+	return err
+}
+
+// AsCat returns the union data inside the Pet as a Cat
+func (t Pet) AsCat() (Cat, error) {
+	var body Cat
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCat overwrites any union data inside the Pet as the provided Cat, with
+// the petType discriminator set to "cat"
+func (t *Pet) FromCat(v Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["petType"] = json.RawMessage(`"cat"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeCat performs a merge with any union data inside the Pet, using the provided Cat, with
+// the petType discriminator set to "cat"
+func (t *Pet) MergeCat(v Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["petType"] = json.RawMessage(`"cat"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDog returns the union data inside the Pet as a Dog
+func (t Pet) AsDog() (Dog, error) {
+	var body Dog
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDog overwrites any union data inside the Pet as the provided Dog, with
+// the petType discriminator set to "dog"
+func (t *Pet) FromDog(v Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["petType"] = json.RawMessage(`"dog"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeDog performs a merge with any union data inside the Pet, using the provided Dog, with
+// the petType discriminator set to "dog"
+func (t *Pet) MergeDog(v Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["petType"] = json.RawMessage(`"dog"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsLizard returns the union data inside the Pet as a Lizard
+func (t Pet) AsLizard() (Lizard, error) {
+	var body Lizard
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromLizard overwrites any union data inside the Pet as the provided Lizard, with
+// the petType discriminator set to "Lizard"
+func (t *Pet) FromLizard(v Lizard) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["petType"] = json.RawMessage(`"Lizard"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeLizard performs a merge with any union data inside the Pet, using the provided Lizard, with
+// the petType discriminator set to "Lizard"
+func (t *Pet) MergeLizard(v Lizard) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["petType"] = json.RawMessage(`"Lizard"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Pet) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"petType"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t Pet) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "Lizard":
+		return t.AsLizard()
+	case "cat":
+		return t.AsCat()
+	case "dog":
+		return t.AsDog()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t Pet) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Pet) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsCar returns the union data inside the Tagged as a Car
+func (t Tagged) AsCar() (Car, error) {
+	var body Car
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCar overwrites any union data inside the Tagged as the provided Car, with
+// the kind discriminator set to "Car"
+func (t *Tagged) FromCar(v Car) error {
+	t.Kind = "Car"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCar performs a merge with any union data inside the Tagged, using the provided Car, with
+// the kind discriminator set to "Car"
+func (t *Tagged) MergeCar(v Car) error {
+	t.Kind = "Car"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsBike returns the union data inside the Tagged as a Bike
+func (t Tagged) AsBike() (Bike, error) {
+	var body Bike
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromBike overwrites any union data inside the Tagged as the provided Bike, with
+// the kind discriminator set to "Bike"
+func (t *Tagged) FromBike(v Bike) error {
+	t.Kind = "Bike"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeBike performs a merge with any union data inside the Tagged, using the provided Bike, with
+// the kind discriminator set to "Bike"
+func (t *Tagged) MergeBike(v Bike) error {
+	t.Kind = "Bike"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Tagged) Discriminator() (string, error) {
+	return string(t.Kind), nil
+}
+
+func (t Tagged) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "Bike":
+		return t.AsBike()
+	case "Car":
+		return t.AsCar()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t Tagged) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	object["kind"], err = json.Marshal(t.Kind)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'kind': %w", err)
+	}
+
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *Tagged) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["kind"]; found {
+		err = json.Unmarshal(raw, &t.Kind)
+		if err != nil {
+			return fmt.Errorf("error reading 'kind': %w", err)
+		}
+	}
+
+	return err
+}
+
+// AsCar returns the union data inside the Vehicle as a Car
+func (t Vehicle) AsCar() (Car, error) {
+	var body Car
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCar overwrites any union data inside the Vehicle as the provided Car, with
+// the kind discriminator set to "Car"
+func (t *Vehicle) FromCar(v Car) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"Car"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeCar performs a merge with any union data inside the Vehicle, using the provided Car, with
+// the kind discriminator set to "Car"
+func (t *Vehicle) MergeCar(v Car) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"Car"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsBike returns the union data inside the Vehicle as a Bike
+func (t Vehicle) AsBike() (Bike, error) {
+	var body Bike
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromBike overwrites any union data inside the Vehicle as the provided Bike, with
+// the kind discriminator set to "Bike"
+func (t *Vehicle) FromBike(v Bike) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"Bike"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeBike performs a merge with any union data inside the Vehicle, using the provided Bike, with
+// the kind discriminator set to "Bike"
+func (t *Vehicle) MergeBike(v Bike) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"Bike"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Vehicle) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"kind"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t Vehicle) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "Bike":
+		return t.AsBike()
+	case "Car":
+		return t.AsCar()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t Vehicle) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Vehicle) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
@@ -0,0 +1,120 @@
+package discriminator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplicitMapping(t *testing.T) {
+	meows := true
+	var pet Pet
+	require.NoError(t, pet.FromCat(Cat{Meows: &meows}))
+
+	buf, err := json.Marshal(pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"petType":"cat","meows":true}`, string(buf))
+
+	var decoded Pet
+	require.NoError(t, json.Unmarshal(buf, &decoded))
+	value, err := decoded.ValueByDiscriminator()
+	require.NoError(t, err)
+	assert.Equal(t, Cat{PetType: "cat", Meows: &meows}, value)
+
+	// The optional discriminator of a Dog is set too.
+	require.NoError(t, pet.FromDog(Dog{}))
+	value, err = pet.ValueByDiscriminator()
+	require.NoError(t, err)
+	require.IsType(t, Dog{}, value)
+	require.NotNil(t, value.(Dog).PetType)
+	assert.Equal(t, "dog", *value.(Dog).PetType)
+}
+
+func TestImplicitMapping(t *testing.T) {
+	// Lizard isn't in the mapping of Pet, so it's known by its schema name.
+	var pet Pet
+	require.NoError(t, pet.FromLizard(Lizard{PetType: "lizard"}))
+	buf, err := json.Marshal(pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"petType":"Lizard"}`, string(buf))
+	value, err := pet.ValueByDiscriminator()
+	require.NoError(t, err)
+	assert.Equal(t, Lizard{PetType: "Lizard"}, value)
+
+	wheels := 4
+	var vehicle Vehicle
+	require.NoError(t, vehicle.FromCar(Car{Wheels: &wheels}))
+	buf, err = json.Marshal(vehicle)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"kind":"Car","wheels":4}`, string(buf))
+
+	var decoded Vehicle
+	require.NoError(t, json.Unmarshal(buf, &decoded))
+	discriminator, err := decoded.Discriminator()
+	require.NoError(t, err)
+	assert.Equal(t, "Car", discriminator)
+	value, err = decoded.ValueByDiscriminator()
+	require.NoError(t, err)
+	require.IsType(t, Car{}, value)
+	assert.Equal(t, &wheels, value.(Car).Wheels)
+}
+
+func TestMerge(t *testing.T) {
+	var vehicle Vehicle
+	require.NoError(t, vehicle.FromCar(Car{}))
+	require.NoError(t, vehicle.MergeBike(Bike{}))
+	discriminator, err := vehicle.Discriminator()
+	require.NoError(t, err)
+	assert.Equal(t, "Bike", discriminator)
+
+	var owned Owned
+	require.NoError(t, owned.MergeDog(Dog{}))
+	discriminator, err = owned.Discriminator()
+	require.NoError(t, err)
+	assert.Equal(t, "dog", discriminator)
+}
+
+func TestAllOfFlattenedUnion(t *testing.T) {
+	barks := true
+	owned := Owned{Id: "1"}
+	require.NoError(t, owned.FromDog(Dog{Barks: &barks}))
+
+	buf, err := json.Marshal(owned)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"1","petType":"dog","barks":true}`, string(buf))
+
+	var decoded Owned
+	require.NoError(t, json.Unmarshal(buf, &decoded))
+	assert.Equal(t, "1", decoded.Id)
+	value, err := decoded.ValueByDiscriminator()
+	require.NoError(t, err)
+	require.IsType(t, Dog{}, value)
+	assert.Equal(t, &barks, value.(Dog).Barks)
+}
+
+func TestDiscriminatorProperty(t *testing.T) {
+	// The discriminator is a property of Tagged, which is set rather than
+	// that of the variant.
+	var tagged Tagged
+	require.NoError(t, tagged.FromBike(Bike{}))
+	assert.Equal(t, "Bike", tagged.Kind)
+	buf, err := json.Marshal(tagged)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"kind":"Bike"}`, string(buf))
+
+	var decoded Tagged
+	require.NoError(t, json.Unmarshal(buf, &decoded))
+	value, err := decoded.ValueByDiscriminator()
+	require.NoError(t, err)
+	assert.IsType(t, Bike{}, value)
+
+	var optional OptionalTagged
+	require.NoError(t, optional.FromCar(Car{}))
+	require.NotNil(t, optional.Kind)
+	assert.Equal(t, "Car", *optional.Kind)
+	discriminator, err := optional.Discriminator()
+	require.NoError(t, err)
+	assert.Equal(t, "Car", discriminator)
+}
@@ -0,0 +1,156 @@
+// Package embedded_all_of provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package embedded_all_of
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Defines values for PetKind.
+const (
+	PetKindCat PetKind = "cat"
+	PetKindDog PetKind = "dog"
+)
+
+// IsValid reports whether v is one of the values of PetKind.
+func (v PetKind) IsValid() bool {
+	switch v {
+	case PetKindCat, PetKindDog:
+		return true
+	default:
+		return false
+	}
+}
+
+// Base defines model for Base.
+type Base struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Id        string     `json:"id"`
+}
+
+// Dog A struct embedding another embedding one.
+type Dog struct {
+	Pet   `yaml:",inline"`
+	Breed *string `json:"breed,omitempty"`
+}
+
+// PetKind defines model for Pet.Kind.
+type PetKind string
+
+// Labeled Labels encodes itself, so it's flattened.
+type Labeled struct {
+	CreatedAt            *time.Time        `json:"createdAt,omitempty"`
+	Id                   string            `json:"id"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Labels defines model for Labels.
+type Labels map[string]string
+
+// Named defines model for Named.
+type Named struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// Pet A pet embeds Base and Named.
+type Pet struct {
+	Base  `yaml:",inline"`
+	Named `yaml:",inline"`
+	Kind  PetKind `json:"kind"`
+}
+
+// Renamed Redefining a property of Base, so it's flattened.
+type Renamed struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Id        string     `json:"id"`
+}
+
+// Stamped Requiring a property of Base changes it, so it's flattened.
+type Stamped struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        string    `json:"id"`
+}
+
+// Getter for additional properties for Labeled. Returns the specified
+// element and whether it was found
+func (a Labeled) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Labeled
+func (a *Labeled) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Labeled to handle AdditionalProperties
+func (a *Labeled) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["createdAt"]; found {
+		err = json.Unmarshal(raw, &a.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("error reading 'createdAt': %w", err)
+		}
+		delete(object, "createdAt")
+	}
+
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &a.Id)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+		delete(object, "id")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Labeled to handle AdditionalProperties
+func (a Labeled) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.CreatedAt != nil {
+		object["createdAt"], err = json.Marshal(a.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'createdAt': %w", err)
+		}
+	}
+
+	object["id"], err = json.Marshal(a.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
@@ -0,0 +1,45 @@
+package embedded_all_of
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedAllOf(t *testing.T) {
+	name := "Rex"
+	breed := "beagle"
+	dog := Dog{
+		Pet: Pet{
+			Base:  Base{Id: "1"},
+			Named: Named{Name: &name},
+			Kind:  PetKindDog,
+		},
+		Breed: &breed,
+	}
+	// The methods of the embedded types are promoted.
+	assert.Equal(t, "pets/1", dog.Key())
+
+	// The JSON object is as flat as that of a flattened struct.
+	buf, err := json.Marshal(dog)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"1","name":"Rex","kind":"dog","breed":"beagle"}`, string(buf))
+
+	var decoded Dog
+	require.NoError(t, json.Unmarshal(buf, &decoded))
+	assert.Equal(t, dog, decoded)
+}
+
+func TestEmbeddedAllOfFallback(t *testing.T) {
+	// Merging changed the createdAt of Base, so it isn't embedded.
+	var stamped Stamped
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"1","createdAt":"2024-01-02T03:04:05Z"}`), &stamped))
+	assert.Equal(t, 2024, stamped.CreatedAt.Year())
+
+	var labeled Labeled
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"1","color":"red"}`), &labeled))
+	assert.Equal(t, "1", labeled.Id)
+	assert.Equal(t, map[string]string{"color": "red"}, labeled.AdditionalProperties)
+}
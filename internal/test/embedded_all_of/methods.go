@@ -0,0 +1,6 @@
+package embedded_all_of
+
+// Key is a method of Base which the structs embedding it are given too.
+func (b Base) Key() string {
+	return "pets/" + b.Id
+}
@@ -0,0 +1,25 @@
+package embedded_spec_package
+
+import (
+	"go/build"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/v2/internal/test/embedded_spec_package/spec"
+)
+
+func TestEmbeddedSpecPackage(t *testing.T) {
+	swagger, err := spec.GetSwagger()
+	require.NoError(t, err)
+	assert.NotNil(t, swagger.Paths.Find("/pets/{id}"))
+	assert.Contains(t, swagger.Components.Schemas, "Pet")
+}
+
+func TestCodeDoesNotImportSpec(t *testing.T) {
+	pkg, err := build.ImportDir(".", 0)
+	require.NoError(t, err)
+	assert.NotContains(t, pkg.Imports, "github.com/getkin/kin-openapi/openapi3")
+	assert.NotContains(t, pkg.Imports, "compress/gzip")
+}
@@ -0,0 +1,140 @@
+package empty_collections
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPetAbsentCollections(t *testing.T) {
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"Rex","metadata":{}}`), &pet))
+	assert.Nil(t, pet.Tags)
+	assert.Nil(t, pet.Nicknames)
+	assert.Nil(t, pet.Labels)
+	assert.Nil(t, pet.Scores)
+	require.NotNil(t, pet.Metadata)
+	assert.Nil(t, pet.Metadata.Owners)
+
+	b, err := json.Marshal(pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Rex","metadata":{}}`, string(b))
+}
+
+func TestPetEmptyCollections(t *testing.T) {
+	const body = `{"name":"Rex","tags":[],"nicknames":[],"labels":{},"scores":{},"metadata":{"owners":[]}}`
+
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(body), &pet))
+	require.NotNil(t, pet.Tags)
+	assert.NotNil(t, *pet.Tags)
+	assert.Equal(t, []string{}, pet.Nicknames)
+	assert.Equal(t, map[string]string{}, pet.Labels)
+	require.NotNil(t, pet.Scores)
+	assert.NotNil(t, *pet.Scores)
+	require.NotNil(t, pet.Metadata)
+	assert.Equal(t, []string{}, pet.Metadata.Owners)
+
+	b, err := json.Marshal(pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, body, string(b))
+}
+
+func TestPetMarshalEmptyCollections(t *testing.T) {
+	b, err := json.Marshal(Pet{Name: "Rex", Nicknames: []string{}, Labels: map[string]string{}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Rex","nicknames":[],"labels":{}}`, string(b))
+
+	b, err = json.Marshal(Pet{Name: "Rex"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Rex"}`, string(b))
+}
+
+func TestMetadataAdditionalProperties(t *testing.T) {
+	// A decoded object has an empty map of additional properties, like a
+	// decoded map, rather than a nil one.
+	var metadata Metadata
+	require.NoError(t, json.Unmarshal([]byte(`{"owners":["Ann"]}`), &metadata))
+	assert.Equal(t, map[string]string{}, metadata.AdditionalProperties)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"color":"brown"}`), &metadata))
+	assert.Equal(t, map[string]string{"color": "brown"}, metadata.AdditionalProperties)
+
+	b, err := json.Marshal(Metadata{Owners: []string{}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"owners":[]}`, string(b))
+
+	b, err = json.Marshal(Metadata{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(b))
+}
+
+func TestUnionCollections(t *testing.T) {
+	for body, toys := range map[string][]string{
+		`{"purrs":true}`:                 nil,
+		`{"purrs":true,"toys":[]}`:       {},
+		`{"purrs":true,"toys":["ball"]}`: {"ball"},
+	} {
+		var animal Animal
+		require.NoError(t, json.Unmarshal([]byte(body), &animal), body)
+		assert.Equal(t, toys, animal.Toys, body)
+
+		b, err := json.Marshal(animal)
+		require.NoError(t, err)
+		assert.JSONEq(t, body, string(b))
+	}
+}
+
+func TestUnionAndAdditionalPropertiesCollections(t *testing.T) {
+	for body, regions := range map[string][]string{
+		`{"zone":"north"}`:              nil,
+		`{"zone":"north","regions":[]}`: {},
+	} {
+		var habitat Habitat
+		require.NoError(t, json.Unmarshal([]byte(body), &habitat), body)
+		assert.Equal(t, regions, habitat.Regions, body)
+		assert.Equal(t, map[string]string{"zone": "north"}, habitat.AdditionalProperties, body)
+
+		b, err := json.Marshal(habitat)
+		require.NoError(t, err)
+		assert.JSONEq(t, body, string(b))
+	}
+}
+
+type strictServer struct {
+	pet *Pet
+}
+
+func (s *strictServer) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	s.pet = request.Body
+	return AddPet200JSONResponse(*request.Body), nil
+}
+
+func TestStrictServerBindsEmptyCollections(t *testing.T) {
+	server := &strictServer{}
+	handler := Handler(NewStrictHandler(server, nil))
+
+	for body, nicknames := range map[string][]string{
+		`{"name":"Rex"}`:                nil,
+		`{"name":"Rex","nicknames":[]}`: {},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		require.NotNil(t, server.pet, body)
+		assert.Equal(t, nicknames, server.pet.Nicknames, body)
+
+		b, err := json.Marshal(server.pet)
+		require.NoError(t, err)
+		assert.JSONEq(t, body, string(b))
+	}
+}
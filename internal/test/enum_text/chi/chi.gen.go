@@ -0,0 +1,490 @@
+// Package chi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package chi
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for Priority.
+const (
+	N1 Priority = 1
+	N2 Priority = 2
+	N3 Priority = 3
+)
+
+// IsValid reports whether v is one of the values of Priority.
+func (v Priority) IsValid() bool {
+	switch v {
+	case N1, N2, N3:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalText encodes v as the text of its value.
+func (v Priority) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalText decodes v from text, failing when it isn't one of the values
+// of Priority.
+func (v *Priority) UnmarshalText(text []byte) error {
+	parsed, err := strconv.ParseInt(string(text), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid Priority value %q: %w", text, err)
+	}
+	value := Priority(parsed)
+	if !value.IsValid() {
+		return fmt.Errorf("invalid Priority value %q", text)
+	}
+	*v = value
+	return nil
+}
+
+// UnmarshalJSON decodes v as its underlying int32, without the validation of
+// UnmarshalText, which encoding/json would use otherwise.
+func (v *Priority) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*int32)(v))
+}
+
+// MarshalJSON encodes v as its underlying int32, rather than as the string of
+// MarshalText, which encoding/json would use otherwise.
+func (v Priority) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int32(v))
+}
+
+// Defines values for Status.
+const (
+	Available Status = "available"
+	Sold      Status = "sold"
+)
+
+// IsValid reports whether v is one of the values of Status.
+func (v Status) IsValid() bool {
+	switch v {
+	case Available, Sold:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalText encodes v as the text of its value.
+func (v Status) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText decodes v from text, failing when it isn't one of the values
+// of Status.
+func (v *Status) UnmarshalText(text []byte) error {
+	value := Status(text)
+	if !value.IsValid() {
+		return fmt.Errorf("invalid Status value %q", text)
+	}
+	*v = value
+	return nil
+}
+
+// UnmarshalJSON decodes v as its underlying string, without the validation of
+// UnmarshalText, which encoding/json would use otherwise.
+func (v *Status) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*string)(v))
+}
+
+// Defines values for Weight.
+const (
+	N05 Weight = 0.5
+	N15 Weight = 1.5
+)
+
+// IsValid reports whether v is one of the values of Weight.
+func (v Weight) IsValid() bool {
+	switch v {
+	case N05, N15:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalText encodes v as the text of its value.
+func (v Weight) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatFloat(float64(v), 'g', -1, 64)), nil
+}
+
+// UnmarshalText decodes v from text, failing when it isn't one of the values
+// of Weight.
+func (v *Weight) UnmarshalText(text []byte) error {
+	parsed, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return fmt.Errorf("invalid Weight value %q: %w", text, err)
+	}
+	value := Weight(parsed)
+	if !value.IsValid() {
+		return fmt.Errorf("invalid Weight value %q", text)
+	}
+	*v = value
+	return nil
+}
+
+// UnmarshalJSON decodes v as its underlying float64, without the validation of
+// UnmarshalText, which encoding/json would use otherwise.
+func (v *Weight) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*float64)(v))
+}
+
+// MarshalJSON encodes v as its underlying float64, rather than as the string of
+// MarshalText, which encoding/json would use otherwise.
+func (v Weight) MarshalJSON() ([]byte, error) {
+	return json.Marshal(float64(v))
+}
+
+// Defines values for FindPetsParamsTags.
+const (
+	Cute   FindPetsParamsTags = "cute"
+	Fluffy FindPetsParamsTags = "fluffy"
+)
+
+// IsValid reports whether v is one of the values of FindPetsParamsTags.
+func (v FindPetsParamsTags) IsValid() bool {
+	switch v {
+	case Cute, Fluffy:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalText encodes v as the text of its value.
+func (v FindPetsParamsTags) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText decodes v from text, failing when it isn't one of the values
+// of FindPetsParamsTags.
+func (v *FindPetsParamsTags) UnmarshalText(text []byte) error {
+	value := FindPetsParamsTags(text)
+	if !value.IsValid() {
+		return fmt.Errorf("invalid FindPetsParamsTags value %q", text)
+	}
+	*v = value
+	return nil
+}
+
+// UnmarshalJSON decodes v as its underlying string, without the validation of
+// UnmarshalText, which encoding/json would use otherwise.
+func (v *FindPetsParamsTags) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*string)(v))
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Priority Priority `json:"priority"`
+	Status   Status   `json:"status"`
+	Weight   *Weight  `json:"weight,omitempty"`
+}
+
+// Priority defines model for Priority.
+type Priority int32
+
+// Status defines model for Status.
+type Status string
+
+// Weight defines model for Weight.
+type Weight float64
+
+// FindPetsParams defines parameters for FindPets.
+type FindPetsParams struct {
+	Previous  *Status               `form:"previous,omitempty" json:"previous,omitempty"`
+	Tags      *[]FindPetsParamsTags `form:"tags,omitempty" json:"tags,omitempty"`
+	XPriority *Priority             `json:"X-Priority,omitempty"`
+}
+
+// FindPetsParamsTags defines parameters for FindPets.
+type FindPetsParamsTags string
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /pets/{status})
+	FindPets(w http.ResponseWriter, r *http.Request, status Status, params FindPetsParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /pets/{status})
+func (_ Unimplemented) FindPets(w http.ResponseWriter, r *http.Request, status Status, params FindPetsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// FindPets operation middleware
+func (siw *ServerInterfaceWrapper) FindPets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "status" -------------
+	var status Status
+
+	err = runtime.BindStyledParameterWithOptions("simple", "status", chi.URLParam(r, "status"), &status, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindPetsParams
+
+	// ------------- Optional query parameter "previous" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "previous", r.URL.Query(), &params.Previous)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "previous", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Priority" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Priority")]; found {
+		var XPriority Priority
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Priority", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Priority", valueList[0], &XPriority, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Priority", Err: err})
+			return
+		}
+
+		params.XPriority = &XPriority
+
+	}
+
+	if err := bindEnumText(&params.Previous); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "previous", Err: err})
+		return
+	}
+
+	if err := bindEnumText(&params.Tags); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	if err := bindEnumText(&params.XPriority); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Priority", Err: err})
+		return
+	}
+
+	if err := bindEnumText(&status); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindPets(w, r, status, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   errorHandlerFunc,
+	}
+}
+
+// NewFindPetsHandler returns the handler HandlerWithOptions routes the
+// FindPets operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewFindPetsHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).FindPets
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pets/{status}", NewFindPetsHandler(si, options))
+	})
+
+	return r
+}
+
+// bindEnumText passes the enum values bound to dest, which points to a
+// parameter, through their UnmarshalText, which the runtime skips for
+// optional parameters and the items of arrays, so that values which aren't
+// one of the enum's are rejected.
+func bindEnumText(dest interface{}) error {
+	v := reflect.ValueOf(dest)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		if u, ok := v.Interface().(encoding.TextUnmarshaler); ok {
+			if m, ok := v.Elem().Interface().(encoding.TextMarshaler); ok {
+				text, err := m.MarshalText()
+				if err != nil {
+					return err
+				}
+				return u.UnmarshalText(text)
+			}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Slice {
+		for i := 0; i < v.Len(); i++ {
+			if err := bindEnumText(v.Index(i).Addr().Interface()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
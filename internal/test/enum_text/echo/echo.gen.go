@@ -0,0 +1,357 @@
+// Package echo provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package echo
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for Priority.
+const (
+	N1 Priority = 1
+	N2 Priority = 2
+	N3 Priority = 3
+)
+
+// IsValid reports whether v is one of the values of Priority.
+func (v Priority) IsValid() bool {
+	switch v {
+	case N1, N2, N3:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalText encodes v as the text of its value.
+func (v Priority) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalText decodes v from text, failing when it isn't one of the values
+// of Priority.
+func (v *Priority) UnmarshalText(text []byte) error {
+	parsed, err := strconv.ParseInt(string(text), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid Priority value %q: %w", text, err)
+	}
+	value := Priority(parsed)
+	if !value.IsValid() {
+		return fmt.Errorf("invalid Priority value %q", text)
+	}
+	*v = value
+	return nil
+}
+
+// UnmarshalJSON decodes v as its underlying int32, without the validation of
+// UnmarshalText, which encoding/json would use otherwise.
+func (v *Priority) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*int32)(v))
+}
+
+// MarshalJSON encodes v as its underlying int32, rather than as the string of
+// MarshalText, which encoding/json would use otherwise.
+func (v Priority) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int32(v))
+}
+
+// Defines values for Status.
+const (
+	Available Status = "available"
+	Sold      Status = "sold"
+)
+
+// IsValid reports whether v is one of the values of Status.
+func (v Status) IsValid() bool {
+	switch v {
+	case Available, Sold:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalText encodes v as the text of its value.
+func (v Status) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText decodes v from text, failing when it isn't one of the values
+// of Status.
+func (v *Status) UnmarshalText(text []byte) error {
+	value := Status(text)
+	if !value.IsValid() {
+		return fmt.Errorf("invalid Status value %q", text)
+	}
+	*v = value
+	return nil
+}
+
+// UnmarshalJSON decodes v as its underlying string, without the validation of
+// UnmarshalText, which encoding/json would use otherwise.
+func (v *Status) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*string)(v))
+}
+
+// Defines values for Weight.
+const (
+	N05 Weight = 0.5
+	N15 Weight = 1.5
+)
+
+// IsValid reports whether v is one of the values of Weight.
+func (v Weight) IsValid() bool {
+	switch v {
+	case N05, N15:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalText encodes v as the text of its value.
+func (v Weight) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatFloat(float64(v), 'g', -1, 64)), nil
+}
+
+// UnmarshalText decodes v from text, failing when it isn't one of the values
+// of Weight.
+func (v *Weight) UnmarshalText(text []byte) error {
+	parsed, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return fmt.Errorf("invalid Weight value %q: %w", text, err)
+	}
+	value := Weight(parsed)
+	if !value.IsValid() {
+		return fmt.Errorf("invalid Weight value %q", text)
+	}
+	*v = value
+	return nil
+}
+
+// UnmarshalJSON decodes v as its underlying float64, without the validation of
+// UnmarshalText, which encoding/json would use otherwise.
+func (v *Weight) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*float64)(v))
+}
+
+// MarshalJSON encodes v as its underlying float64, rather than as the string of
+// MarshalText, which encoding/json would use otherwise.
+func (v Weight) MarshalJSON() ([]byte, error) {
+	return json.Marshal(float64(v))
+}
+
+// Defines values for FindPetsParamsTags.
+const (
+	Cute   FindPetsParamsTags = "cute"
+	Fluffy FindPetsParamsTags = "fluffy"
+)
+
+// IsValid reports whether v is one of the values of FindPetsParamsTags.
+func (v FindPetsParamsTags) IsValid() bool {
+	switch v {
+	case Cute, Fluffy:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalText encodes v as the text of its value.
+func (v FindPetsParamsTags) MarshalText() ([]byte, error) {
+	return []byte(v), nil
+}
+
+// UnmarshalText decodes v from text, failing when it isn't one of the values
+// of FindPetsParamsTags.
+func (v *FindPetsParamsTags) UnmarshalText(text []byte) error {
+	value := FindPetsParamsTags(text)
+	if !value.IsValid() {
+		return fmt.Errorf("invalid FindPetsParamsTags value %q", text)
+	}
+	*v = value
+	return nil
+}
+
+// UnmarshalJSON decodes v as its underlying string, without the validation of
+// UnmarshalText, which encoding/json would use otherwise.
+func (v *FindPetsParamsTags) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*string)(v))
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Priority Priority `json:"priority"`
+	Status   Status   `json:"status"`
+	Weight   *Weight  `json:"weight,omitempty"`
+}
+
+// Priority defines model for Priority.
+type Priority int32
+
+// Status defines model for Status.
+type Status string
+
+// Weight defines model for Weight.
+type Weight float64
+
+// FindPetsParams defines parameters for FindPets.
+type FindPetsParams struct {
+	Previous  *Status               `form:"previous,omitempty" json:"previous,omitempty"`
+	Tags      *[]FindPetsParamsTags `form:"tags,omitempty" json:"tags,omitempty"`
+	XPriority *Priority             `json:"X-Priority,omitempty"`
+}
+
+// FindPetsParamsTags defines parameters for FindPets.
+type FindPetsParamsTags string
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /pets/{status})
+	FindPets(ctx echo.Context, status Status, params FindPetsParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// FindPets converts echo context to params.
+func (w *ServerInterfaceWrapper) FindPets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "status" -------------
+	var status Status
+
+	err = runtime.BindStyledParameterWithOptions("simple", "status", ctx.Param("status"), &status, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindPetsParams
+	// ------------- Optional query parameter "previous" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "previous", ctx.QueryParams(), &params.Previous)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter previous: %s", err))
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", ctx.QueryParams(), &params.Tags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Priority" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Priority")]; found {
+		var XPriority Priority
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Priority, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Priority", valueList[0], &XPriority, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Priority: %s", err))
+		}
+
+		params.XPriority = &XPriority
+	}
+
+	if err := bindEnumText(&params.Previous); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter previous: %s", err))
+	}
+
+	if err := bindEnumText(&params.Tags); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+	}
+
+	if err := bindEnumText(&params.XPriority); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Priority: %s", err))
+	}
+
+	if err := bindEnumText(&status); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FindPets(ctx, status, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// NewFindPetsHandler returns the handler RegisterHandlers routes the
+// FindPets operation to, so that it can be mounted on its own.
+func NewFindPetsHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.FindPets
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	router.GET(baseURL+"/pets/:status", NewFindPetsHandler(si))
+
+}
+
+// bindEnumText passes the enum values bound to dest, which points to a
+// parameter, through their UnmarshalText, which the runtime skips for
+// optional parameters and the items of arrays, so that values which aren't
+// one of the enum's are rejected.
+func bindEnumText(dest interface{}) error {
+	v := reflect.ValueOf(dest)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		if u, ok := v.Interface().(encoding.TextUnmarshaler); ok {
+			if m, ok := v.Elem().Interface().(encoding.TextMarshaler); ok {
+				text, err := m.MarshalText()
+				if err != nil {
+					return err
+				}
+				return u.UnmarshalText(text)
+			}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Slice {
+		for i := 0; i < v.Len(); i++ {
+			if err := bindEnumText(v.Index(i).Addr().Interface()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
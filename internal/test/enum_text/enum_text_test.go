@@ -0,0 +1,132 @@
+package enumtext
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/v2/internal/test/enum_text/chi"
+	echoapi "github.com/deepmap/oapi-codegen/v2/internal/test/enum_text/echo"
+)
+
+func TestUnmarshalText(t *testing.T) {
+	var status chi.Status
+	require.NoError(t, status.UnmarshalText([]byte("sold")))
+	assert.Equal(t, chi.Sold, status)
+	assert.EqualError(t, status.UnmarshalText([]byte("lost")), `invalid Status value "lost"`)
+	assert.Equal(t, chi.Sold, status)
+
+	var priority chi.Priority
+	require.NoError(t, priority.UnmarshalText([]byte("2")))
+	assert.Equal(t, chi.N2, priority)
+	assert.EqualError(t, priority.UnmarshalText([]byte("4")), `invalid Priority value "4"`)
+	assert.Error(t, priority.UnmarshalText([]byte("high")))
+
+	var weight chi.Weight
+	require.NoError(t, weight.UnmarshalText([]byte("1.5")))
+	assert.Equal(t, chi.N15, weight)
+	assert.Error(t, weight.UnmarshalText([]byte("2")))
+}
+
+func TestMarshalText(t *testing.T) {
+	text, err := chi.Available.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "available", string(text))
+
+	text, err = chi.N3.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "3", string(text))
+
+	text, err = chi.N05.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "0.5", string(text))
+}
+
+func TestJSON(t *testing.T) {
+	weight := chi.N05
+	data, err := json.Marshal(chi.Pet{Status: chi.Sold, Priority: chi.N1, Weight: &weight})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"sold","priority":1,"weight":0.5}`, string(data))
+
+	// Decoding JSON keeps accepting any value, as it did before.
+	var pet chi.Pet
+	require.NoError(t, json.Unmarshal([]byte(`{"status":"lost","priority":7}`), &pet))
+	assert.Equal(t, chi.Pet{Status: "lost", Priority: 7}, pet)
+}
+
+type chiServer struct {
+	status chi.Status
+	params chi.FindPetsParams
+}
+
+func (s *chiServer) FindPets(w http.ResponseWriter, r *http.Request, status chi.Status, params chi.FindPetsParams) {
+	s.status = status
+	s.params = params
+}
+
+func TestChiBindsEnums(t *testing.T) {
+	server := &chiServer{}
+	handler := chi.Handler(server)
+
+	req := httptest.NewRequest(http.MethodGet, "/pets/sold?previous=available&tags=cute&tags=fluffy", nil)
+	req.Header.Set("X-Priority", "2")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, chi.Sold, server.status)
+	assert.Equal(t, chi.Available, *server.params.Previous)
+	assert.Equal(t, []chi.FindPetsParamsTags{chi.Cute, chi.Fluffy}, *server.params.Tags)
+	assert.Equal(t, chi.N2, *server.params.XPriority)
+
+	for name, req := range map[string]*http.Request{
+		"path":   httptest.NewRequest(http.MethodGet, "/pets/lost", nil),
+		"query":  httptest.NewRequest(http.MethodGet, "/pets/sold?previous=lost", nil),
+		"array":  httptest.NewRequest(http.MethodGet, "/pets/sold?tags=cute&tags=bald", nil),
+		"header": httptest.NewRequest(http.MethodGet, "/pets/sold", nil),
+	} {
+		if name == "header" {
+			req.Header.Set("X-Priority", "4")
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, name)
+	}
+}
+
+type echoServer struct {
+	status echoapi.Status
+	params echoapi.FindPetsParams
+}
+
+func (s *echoServer) FindPets(ctx echo.Context, status echoapi.Status, params echoapi.FindPetsParams) error {
+	s.status = status
+	s.params = params
+	return nil
+}
+
+func TestEchoBindsEnums(t *testing.T) {
+	server := &echoServer{}
+	e := echo.New()
+	echoapi.RegisterHandlers(e, server)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets/available?tags=fluffy", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, echoapi.Available, server.status)
+	assert.Equal(t, []echoapi.FindPetsParamsTags{echoapi.Fluffy}, *server.params.Tags)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets/available?previous=lost", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/pets/available", nil)
+	req.Header.Set("X-Priority", "high")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,51 @@
+// Package enumvalues provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package enumvalues
+
+// Defines values for Level.
+const (
+	Minus1  Level = -1
+	Minus20 Level = -20
+	N0      Level = 0
+	N1      Level = 1
+)
+
+// IsValid reports whether v is one of the values of Level.
+func (v Level) IsValid() bool {
+	switch v {
+	case Minus1, Minus20, N0, N1:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for Region.
+const (
+	ApSouth           Region = "ap south"
+	EuWest2           Region = "eu.west.2"
+	N1st              Region = "1st"
+	QuoteAndBackslash Region = "quote \" and \\ backslash"
+	RegionEmpty       Region = ""
+	USEAST1           Region = "US-EAST-1"
+	UsEast1           Region = "us-east-1"
+	X日本               Region = "日本"
+	Ünïcode           Region = "ünïcode"
+)
+
+// IsValid reports whether v is one of the values of Region.
+func (v Region) IsValid() bool {
+	switch v {
+	case ApSouth, EuWest2, N1st, QuoteAndBackslash, RegionEmpty, USEAST1, UsEast1, X日本, Ünïcode:
+		return true
+	default:
+		return false
+	}
+}
+
+// Level defines model for Level.
+type Level int
+
+// Region defines model for Region.
+type Region string
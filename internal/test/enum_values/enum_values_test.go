@@ -0,0 +1,43 @@
+package enumvalues
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumValuesAreVerbatim(t *testing.T) {
+	regions := map[Region]string{
+		USEAST1:           "US-EAST-1",
+		UsEast1:           "us-east-1",
+		EuWest2:           "eu.west.2",
+		ApSouth:           "ap south",
+		N1st:              "1st",
+		Ünïcode:           "ünïcode",
+		X日本:               "日本",
+		RegionEmpty:       "",
+		QuoteAndBackslash: `quote " and \ backslash`,
+	}
+	assert.Len(t, regions, 9)
+	for region, value := range regions {
+		assert.Equal(t, value, string(region))
+
+		buf, err := json.Marshal(region)
+		require.NoError(t, err)
+		var decoded Region
+		require.NoError(t, json.Unmarshal(buf, &decoded))
+		assert.Equal(t, region, decoded)
+	}
+
+	levels := map[Level]int{
+		Minus20: -20,
+		Minus1:  -1,
+		N0:      0,
+		N1:      1,
+	}
+	for level, value := range levels {
+		assert.Equal(t, value, int(level))
+	}
+}
@@ -0,0 +1,88 @@
+package explodedformobject
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct {
+	params *ListItemsParams
+}
+
+func (s *server) ListItems(w http.ResponseWriter, r *http.Request, params ListItemsParams) {
+	s.params = &params
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestRoundTrip(t *testing.T) {
+	s := &server{}
+	ts := httptest.NewServer(Handler(s))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL)
+	require.NoError(t, err)
+
+	params := ListItemsParams{Paging: &Paging{Limit: 10, Offset: ptr(20)}}
+	params.Filter.Status = "open"
+	rsp, err := client.ListItems(context.Background(), &params)
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	require.Equal(t, http.StatusNoContent, rsp.StatusCode)
+
+	require.NotNil(t, s.params)
+	assert.Equal(t, params, *s.params)
+}
+
+func TestBind(t *testing.T) {
+	s := &server{}
+	h := Handler(s)
+	get := func(query string) *httptest.ResponseRecorder {
+		s.params = nil
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items?"+query, nil))
+		return rec
+	}
+
+	rec := get("status=open&limit=10")
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	assert.Equal(t, &Paging{Limit: 10}, s.params.Paging)
+	assert.Equal(t, "open", s.params.Filter.Status)
+	assert.Nil(t, s.params.Filter.Owner)
+
+	rec = get("status=open&owner=me&limit=10&sort=name&unrelated=1")
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	assert.Equal(t, &Paging{Limit: 10, Sort: ptr("name")}, s.params.Paging)
+	assert.Equal(t, ptr("me"), s.params.Filter.Owner)
+
+	rec = get("status=open&unrelated=1")
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	assert.Nil(t, s.params.Paging)
+
+	rec = get("status=open&offset=20")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "property 'limit' of parameter 'paging' is required")
+
+	rec = get("owner=me")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "property 'status' of parameter 'filter' is required")
+
+	rec = get("limit=10")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "query parameter 'filter' is required")
+
+	rec = get("status=open&limit=x")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = get("status=open&limit=1&limit=2")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "multiple times")
+}
@@ -0,0 +1,52 @@
+package externalcomponents
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/v2/internal/test/external_components/common"
+)
+
+type server struct {
+	request AddPetRequestObject
+}
+
+func (s *server) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	s.request = request
+	return AddPet200JSONResponse{common.PetResponseJSONResponse{
+		Body:    *request.Body,
+		Headers: common.PetResponseResponseHeaders{XRateLimit: 99},
+	}}, nil
+}
+
+func TestExternalComponents(t *testing.T) {
+	s := &server{}
+	ts := httptest.NewServer(Handler(NewStrictHandler(s, nil)))
+	defer ts.Close()
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	// The parameters, request body, response and headers all come from the
+	// common spec, and so do the schemas their refs point to, rather than
+	// our own Pet.
+	limit := common.Limit(10)
+	age := common.Count(3)
+	pet := common.Pet{Name: "Rex", Age: &age}
+	rsp, err := client.AddPetWithResponse(context.Background(), &AddPetParams{XTenant: "acme", Limit: &limit}, pet)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rsp.StatusCode())
+
+	assert.Equal(t, common.TenantHeader("acme"), s.request.XTenant)
+	assert.Equal(t, &limit, s.request.Params().Limit)
+	assert.Equal(t, &pet, s.request.Body)
+
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, pet, common.Pet(*rsp.JSON200))
+	require.NotNil(t, rsp.Headers200)
+	assert.Equal(t, common.RateLimit(99), rsp.Headers200.XRateLimit)
+}
@@ -7,6 +7,8 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"path"
@@ -15,8 +17,14 @@ import (
 	externalRef0 "github.com/deepmap/oapi-codegen/v2/internal/test/externalref/packageA"
 	externalRef1 "github.com/deepmap/oapi-codegen/v2/internal/test/externalref/packageB"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oapi-codegen/runtime"
 )
 
+// AnnotatedPet Pet defined by packageB
+type AnnotatedPet struct {
+	union json.RawMessage
+}
+
 // Container defines model for Container.
 type Container struct {
 	ObjectA *externalRef0.ObjectA   `json:"object_a,omitempty"`
@@ -24,13 +32,395 @@ type Container struct {
 	ObjectC *map[string]interface{} `json:"object_c,omitempty"`
 }
 
+// ExtendedStatusMap defines model for ExtendedStatusMap.
+type ExtendedStatusMap struct {
+	Comment              *string                        `json:"comment,omitempty"`
+	History              *[]externalRef1.Status         `json:"history,omitempty"`
+	Primary              *externalRef1.Status           `json:"primary,omitempty"`
+	AdditionalProperties map[string]externalRef1.Status `json:"-"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	union json.RawMessage
+}
+
+// Status defines model for Status.
+type Status = externalRef1.Status
+
+// StatusByName defines model for StatusByName.
+type StatusByName map[string]externalRef1.Status
+
+// TaggedItem defines model for TaggedItem.
+type TaggedItem struct {
+	Comment    *string                      `json:"comment,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	PrimaryTag *externalRef1.Tag            `json:"primaryTag,omitempty"`
+	Tags       *[]externalRef1.Tag          `json:"tags,omitempty"`
+	TagsByName *map[string]externalRef1.Tag `json:"tagsByName,omitempty"`
+}
+
+// Getter for additional properties for ExtendedStatusMap. Returns the specified
+// element and whether it was found
+func (a ExtendedStatusMap) Get(fieldName string) (value externalRef1.Status, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ExtendedStatusMap
+func (a *ExtendedStatusMap) Set(fieldName string, value externalRef1.Status) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]externalRef1.Status)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ExtendedStatusMap to handle AdditionalProperties
+func (a *ExtendedStatusMap) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["comment"]; found {
+		err = json.Unmarshal(raw, &a.Comment)
+		if err != nil {
+			return fmt.Errorf("error reading 'comment': %w", err)
+		}
+		delete(object, "comment")
+	}
+
+	if raw, found := object["history"]; found {
+		err = json.Unmarshal(raw, &a.History)
+		if err != nil {
+			return fmt.Errorf("error reading 'history': %w", err)
+		}
+		delete(object, "history")
+	}
+
+	if raw, found := object["primary"]; found {
+		err = json.Unmarshal(raw, &a.Primary)
+		if err != nil {
+			return fmt.Errorf("error reading 'primary': %w", err)
+		}
+		delete(object, "primary")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]externalRef1.Status)
+		for fieldName, fieldBuf := range object {
+			var fieldVal externalRef1.Status
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ExtendedStatusMap to handle AdditionalProperties
+func (a ExtendedStatusMap) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Comment != nil {
+		object["comment"], err = json.Marshal(a.Comment)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'comment': %w", err)
+		}
+	}
+
+	if a.History != nil {
+		object["history"], err = json.Marshal(a.History)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'history': %w", err)
+		}
+	}
+
+	if a.Primary != nil {
+		object["primary"], err = json.Marshal(a.Primary)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'primary': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// AsExternalRef1Cat returns the union data inside the AnnotatedPet as a externalRef1.Cat
+func (t AnnotatedPet) AsExternalRef1Cat() (externalRef1.Cat, error) {
+	var body externalRef1.Cat
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromExternalRef1Cat overwrites any union data inside the AnnotatedPet as the provided externalRef1.Cat, with
+// the kind discriminator set to "cat"
+func (t *AnnotatedPet) FromExternalRef1Cat(v externalRef1.Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"cat"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeExternalRef1Cat performs a merge with any union data inside the AnnotatedPet, using the provided externalRef1.Cat, with
+// the kind discriminator set to "cat"
+func (t *AnnotatedPet) MergeExternalRef1Cat(v externalRef1.Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"cat"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsExternalRef1Dog returns the union data inside the AnnotatedPet as a externalRef1.Dog
+func (t AnnotatedPet) AsExternalRef1Dog() (externalRef1.Dog, error) {
+	var body externalRef1.Dog
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromExternalRef1Dog overwrites any union data inside the AnnotatedPet as the provided externalRef1.Dog, with
+// the kind discriminator set to "dog"
+func (t *AnnotatedPet) FromExternalRef1Dog(v externalRef1.Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"dog"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeExternalRef1Dog performs a merge with any union data inside the AnnotatedPet, using the provided externalRef1.Dog, with
+// the kind discriminator set to "dog"
+func (t *AnnotatedPet) MergeExternalRef1Dog(v externalRef1.Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"dog"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t AnnotatedPet) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"kind"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t AnnotatedPet) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "cat":
+		return t.AsExternalRef1Cat()
+	case "dog":
+		return t.AsExternalRef1Dog()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t AnnotatedPet) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *AnnotatedPet) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsExternalRef1Cat returns the union data inside the Pet as a externalRef1.Cat
+func (t Pet) AsExternalRef1Cat() (externalRef1.Cat, error) {
+	var body externalRef1.Cat
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromExternalRef1Cat overwrites any union data inside the Pet as the provided externalRef1.Cat, with
+// the kind discriminator set to "cat"
+func (t *Pet) FromExternalRef1Cat(v externalRef1.Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"cat"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeExternalRef1Cat performs a merge with any union data inside the Pet, using the provided externalRef1.Cat, with
+// the kind discriminator set to "cat"
+func (t *Pet) MergeExternalRef1Cat(v externalRef1.Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"cat"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsExternalRef1Dog returns the union data inside the Pet as a externalRef1.Dog
+func (t Pet) AsExternalRef1Dog() (externalRef1.Dog, error) {
+	var body externalRef1.Dog
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromExternalRef1Dog overwrites any union data inside the Pet as the provided externalRef1.Dog, with
+// the kind discriminator set to "dog"
+func (t *Pet) FromExternalRef1Dog(v externalRef1.Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"dog"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeExternalRef1Dog performs a merge with any union data inside the Pet, using the provided externalRef1.Dog, with
+// the kind discriminator set to "dog"
+func (t *Pet) MergeExternalRef1Dog(v externalRef1.Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"dog"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Pet) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"kind"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t Pet) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "cat":
+		return t.AsExternalRef1Cat()
+	case "dog":
+		return t.AsExternalRef1Dog()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t Pet) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Pet) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/5yPQU7EMAxF72JYRtOR2GXHcAC4AfJkPNSotaPEIKEqd0dJKa1gQcUqtvzf/z8TBB2j",
-	"Coll8BPk0NOIbXxQMWShVJeYNFIypnbS8ysFe8Y63ya6goebbjXqvly6x6a7h+IW5LwPOW2Q8BfyrSul",
-	"OFgyf5UWHKm+9hEJPGRLLC//qrbGnHbHlB8fwsuFjVVweNrglt7ILegsn1GWqzZXtqHewME7pcwqdane",
-	"kQQjg4e7w/FwBAcRra+NSvkMAAD//3vXjDblAQAA",
+	"H4sIAAAAAAAC/6xVTW/bMAz9KwG3o5AU2M23pt1hh7UB1ltRDLTFOFqsj8nMMCPQfx8kO21SO62C7hQF",
+	"4uN7pB7pPVRWO2vIcAvFHtpqQxrT8doYy8gkV8TxPzbN/RqKxz189rSGAj4tXrCLAbiIwUHsQVJbeeVY",
+	"WQMFrIhnktbKkJyV3cxhtcWalhCegoAbTPmdt448K0rsW2Vk/OXOERTQslemhiDAoKaJiyDA0++d8iSh",
+	"eOzhT+IQZctfVEVhcGMNozLkx5R90E+E4u0S71Pcdcw2QMo8yPIIUr0HeY4LsbZbW48Fl+i37VEvSmsb",
+	"QhNZzrQvt0tf/zIZSfIHI+/a7+jyDfACCeK14MpqTYanhb0SEZ1xh5rkuO4zFhDgvNLouwes3+vuA06S",
+	"Cjg8bj7pxR4IzzTLbJqIGeZQqjhZWhlkm1ys0bkYFBuMDAXMF4cBW7SOqnmHupmUFCdPgLT1JaBbO/Q6",
+	"ye7ukuDeSrEbhjJMEomDeDsm8UQX9I661IETW6i/OL+I+vvlUNIeUEoVodisTt4oi3xsrdNh+lDuU89s",
+	"VMvWd/GomPTlGtF77I4GKDfBVJXD8J0qbLCkJmvsU4KaZP5z90tivGwY6za7JWkfjPsRc3zMD6eJD1VO",
+	"r7u+8m9MOr/6HvMfdu3xd2m6UPY7GlcSBCiztolHcRPvQMAf8m0/dGkpODLoFBTwZX41vwIBDnkTZYbw",
+	"bwC2yax2gggAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -16,6 +16,33 @@ func TestParameters(t *testing.T) {
 	}
 }
 
+func TestMappedEnumsAndUnions(t *testing.T) {
+	var status Status = packageB.Active
+	_ = StatusByName{"a": status}
+
+	var pet Pet
+	require.NoError(t, pet.FromExternalRef1Cat(packageB.Cat{Name: &[]string{"Tom"}[0]}))
+	value, err := pet.ValueByDiscriminator()
+	require.NoError(t, err)
+	require.IsType(t, packageB.Cat{}, value)
+	require.Equal(t, "cat", value.(packageB.Cat).Kind)
+
+	var annotated AnnotatedPet
+	require.NoError(t, annotated.FromExternalRef1Dog(packageB.Dog{Kind: "dog"}))
+	dog, err := annotated.AsExternalRef1Dog()
+	require.NoError(t, err)
+	require.Equal(t, "dog", dog.Kind)
+
+	extended := ExtendedStatusMap{
+		Primary: &status,
+		History: &[]packageB.Status{packageB.Inactive},
+	}
+	extended.Set("b", packageB.Inactive)
+	got, found := extended.Get("b")
+	require.True(t, found)
+	require.Equal(t, packageB.Inactive, got)
+}
+
 func TestGetSwagger(t *testing.T) {
 	_, err := packageB.GetSwagger()
 	require.Nil(t, err)
@@ -26,3 +53,16 @@ func TestGetSwagger(t *testing.T) {
 	_, err = GetSwagger()
 	require.Nil(t, err)
 }
+
+func TestRemoteRefsOfMergedSchemas(t *testing.T) {
+	// The refs of packageB, in the properties of its nested allOf member,
+	// its array items and its additional properties, are to its types.
+	label := "urgent"
+	tag := packageB.Tag{Label: &label}
+	item := TaggedItem{
+		PrimaryTag: &tag,
+		Tags:       &[]packageB.Tag{tag},
+		TagsByName: &map[string]packageB.Tag{label: tag},
+	}
+	require.Equal(t, "urgent", *(*item.TagsByName)["urgent"].Label)
+}
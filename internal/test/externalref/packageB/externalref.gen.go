@@ -7,24 +7,302 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"path"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for Status.
+const (
+	Active   Status = "active"
+	Inactive Status = "inactive"
+)
+
+// IsValid reports whether v is one of the values of Status.
+func (v Status) IsValid() bool {
+	switch v {
+	case Active, Inactive:
+		return true
+	default:
+		return false
+	}
+}
+
+// Cat defines model for Cat.
+type Cat struct {
+	Kind string  `json:"kind"`
+	Name *string `json:"name,omitempty"`
+}
+
+// Dog defines model for Dog.
+type Dog struct {
+	Barks *bool  `json:"barks,omitempty"`
+	Kind  string `json:"kind"`
+}
+
+// Named defines model for Named.
+type Named struct {
+	Name       *string `json:"name,omitempty"`
+	PrimaryTag *Tag    `json:"primaryTag,omitempty"`
+}
+
 // ObjectB defines model for ObjectB.
 type ObjectB struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// Pet defines model for Pet.
+type Pet struct {
+	union json.RawMessage
+}
+
+// Status defines model for Status.
+type Status string
+
+// StatusMap defines model for StatusMap.
+type StatusMap struct {
+	History              *[]Status         `json:"history,omitempty"`
+	Primary              *Status           `json:"primary,omitempty"`
+	AdditionalProperties map[string]Status `json:"-"`
+}
+
+// Tag defines model for Tag.
+type Tag struct {
+	Label *string `json:"label,omitempty"`
+}
+
+// Tagged defines model for Tagged.
+type Tagged struct {
+	Name       *string         `json:"name,omitempty"`
+	PrimaryTag *Tag            `json:"primaryTag,omitempty"`
+	Tags       *[]Tag          `json:"tags,omitempty"`
+	TagsByName *map[string]Tag `json:"tagsByName,omitempty"`
+}
+
+// Getter for additional properties for StatusMap. Returns the specified
+// element and whether it was found
+func (a StatusMap) Get(fieldName string) (value Status, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for StatusMap
+func (a *StatusMap) Set(fieldName string, value Status) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]Status)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for StatusMap to handle AdditionalProperties
+func (a *StatusMap) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["history"]; found {
+		err = json.Unmarshal(raw, &a.History)
+		if err != nil {
+			return fmt.Errorf("error reading 'history': %w", err)
+		}
+		delete(object, "history")
+	}
+
+	if raw, found := object["primary"]; found {
+		err = json.Unmarshal(raw, &a.Primary)
+		if err != nil {
+			return fmt.Errorf("error reading 'primary': %w", err)
+		}
+		delete(object, "primary")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]Status)
+		for fieldName, fieldBuf := range object {
+			var fieldVal Status
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for StatusMap to handle AdditionalProperties
+func (a StatusMap) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.History != nil {
+		object["history"], err = json.Marshal(a.History)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'history': %w", err)
+		}
+	}
+
+	if a.Primary != nil {
+		object["primary"], err = json.Marshal(a.Primary)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'primary': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// AsCat returns the union data inside the Pet as a Cat
+func (t Pet) AsCat() (Cat, error) {
+	var body Cat
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCat overwrites any union data inside the Pet as the provided Cat, with
+// the kind discriminator set to "cat"
+func (t *Pet) FromCat(v Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"cat"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeCat performs a merge with any union data inside the Pet, using the provided Cat, with
+// the kind discriminator set to "cat"
+func (t *Pet) MergeCat(v Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"cat"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDog returns the union data inside the Pet as a Dog
+func (t Pet) AsDog() (Dog, error) {
+	var body Dog
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDog overwrites any union data inside the Pet as the provided Dog, with
+// the kind discriminator set to "dog"
+func (t *Pet) FromDog(v Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"dog"`)
+	b, err = json.Marshal(object)
+	t.union = b
+	return err
+}
+
+// MergeDog performs a merge with any union data inside the Pet, using the provided Dog, with
+// the kind discriminator set to "dog"
+func (t *Pet) MergeDog(v Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+	object["kind"] = json.RawMessage(`"dog"`)
+	b, err = json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Pet) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"kind"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t Pet) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "cat":
+		return t.AsCat()
+	case "dog":
+		return t.AsDog()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t Pet) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Pet) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/yTJwQ0CMQxE0V7mnApypAFqCNHAGm1sKzYHtNreUZa5zJfegW7DTakZqAeibxztyvvj",
-	"zZ63lT7NOVN4gbbB9fl1oiJyir5wrhWIPg1VP/teYE5tLqhAgbfc4i/nLwAA//8neaWPdgAAAA==",
+	"H4sIAAAAAAAC/5xTTW/bMAz9L9yOAnLXset1bYH1VuTA2IzDVV+T6AFG4P8+SFbQJrYDrycREsn3+Ph0",
+	"hsbb4B05SaDPkJoTWSzhD5R8hOgDRWEql+/s2nzKEAg0JInsOhgVOLS08DAqiPSn50gt6LepfK8uWf7w",
+	"mxrJ5Y++m4MdML6nT00P3htCl/NXeGyFe0JL7RxwZQgFIbLFOLxiYfk90hE0fNt9aLerwu1ecaIxg3wu",
+	"0cNm0NzkhcoKWk5NZMsOxcd8YTGEnKTP0KCsUckLVND6bi3h0dfhCp3hqRCZRBsVeEfPR9Bv9+fNIKO6",
+	"n1Nw9qOCX4LSl6HJ9TZvCBvhvwQK2NVwr26VuNT9xJBLsW1Z2Ds0L1c63mNQgT+GrTUnTuLjkEMWsv/R",
+	"p3LEGHH45JCtDZYcUt11zdDggcyy05cadJOt0ZgNu5t+wahuMQW7tFmSYvi5HrnHQ7XU13Z23fgy5fxm",
+	"X74Ku6MH7XpjFPhADgODBlAQUE5pehn/DQAl7G487gQAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
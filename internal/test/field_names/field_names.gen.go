@@ -0,0 +1,659 @@
+// Package fieldnames provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package fieldnames
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// Cat defines model for Cat.
+type Cat struct {
+	Meows *bool `json:"meows,omitempty"`
+}
+
+// Dog defines model for Dog.
+type Dog struct {
+	Barks *bool `json:"barks,omitempty"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	AsCat1 *string `json:"asCat,omitempty"`
+	Union  *string `json:"union,omitempty"`
+	union  json.RawMessage
+}
+
+// Thing defines model for Thing.
+type Thing struct {
+	N2faEnabled           *bool             `json:"2fa_enabled,omitempty"`
+	Underscore            *string           `json:"_,omitempty"`
+	Id1                   *string           `json:"_id,omitempty"`
+	AdditionalProperties1 *string           `json:"additionalProperties,omitempty"`
+	Func                  *string           `json:"func,omitempty"`
+	Get1                  *string           `json:"get,omitempty"`
+	Id                    *string           `json:"id,omitempty"`
+	Range                 *int              `json:"range,omitempty"`
+	Type                  string            `json:"type"`
+	AdditionalProperties  map[string]string `json:"-"`
+}
+
+// PutThingJSONRequestBody defines body for PutThing for application/json ContentType.
+type PutThingJSONRequestBody = Thing
+
+// Getter for additional properties for Thing. Returns the specified
+// element and whether it was found
+func (a Thing) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Thing
+func (a *Thing) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Thing to handle AdditionalProperties
+func (a *Thing) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["2fa_enabled"]; found {
+		err = json.Unmarshal(raw, &a.N2faEnabled)
+		if err != nil {
+			return fmt.Errorf("error reading '2fa_enabled': %w", err)
+		}
+		delete(object, "2fa_enabled")
+	}
+
+	if raw, found := object["_"]; found {
+		err = json.Unmarshal(raw, &a.Underscore)
+		if err != nil {
+			return fmt.Errorf("error reading '_': %w", err)
+		}
+		delete(object, "_")
+	}
+
+	if raw, found := object["_id"]; found {
+		err = json.Unmarshal(raw, &a.Id1)
+		if err != nil {
+			return fmt.Errorf("error reading '_id': %w", err)
+		}
+		delete(object, "_id")
+	}
+
+	if raw, found := object["additionalProperties"]; found {
+		err = json.Unmarshal(raw, &a.AdditionalProperties1)
+		if err != nil {
+			return fmt.Errorf("error reading 'additionalProperties': %w", err)
+		}
+		delete(object, "additionalProperties")
+	}
+
+	if raw, found := object["func"]; found {
+		err = json.Unmarshal(raw, &a.Func)
+		if err != nil {
+			return fmt.Errorf("error reading 'func': %w", err)
+		}
+		delete(object, "func")
+	}
+
+	if raw, found := object["get"]; found {
+		err = json.Unmarshal(raw, &a.Get1)
+		if err != nil {
+			return fmt.Errorf("error reading 'get': %w", err)
+		}
+		delete(object, "get")
+	}
+
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &a.Id)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+		delete(object, "id")
+	}
+
+	if raw, found := object["range"]; found {
+		err = json.Unmarshal(raw, &a.Range)
+		if err != nil {
+			return fmt.Errorf("error reading 'range': %w", err)
+		}
+		delete(object, "range")
+	}
+
+	if raw, found := object["type"]; found {
+		err = json.Unmarshal(raw, &a.Type)
+		if err != nil {
+			return fmt.Errorf("error reading 'type': %w", err)
+		}
+		delete(object, "type")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Thing to handle AdditionalProperties
+func (a Thing) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.N2faEnabled != nil {
+		object["2fa_enabled"], err = json.Marshal(a.N2faEnabled)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '2fa_enabled': %w", err)
+		}
+	}
+
+	if a.Underscore != nil {
+		object["_"], err = json.Marshal(a.Underscore)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '_': %w", err)
+		}
+	}
+
+	if a.Id1 != nil {
+		object["_id"], err = json.Marshal(a.Id1)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '_id': %w", err)
+		}
+	}
+
+	if a.AdditionalProperties1 != nil {
+		object["additionalProperties"], err = json.Marshal(a.AdditionalProperties1)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'additionalProperties': %w", err)
+		}
+	}
+
+	if a.Func != nil {
+		object["func"], err = json.Marshal(a.Func)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'func': %w", err)
+		}
+	}
+
+	if a.Get1 != nil {
+		object["get"], err = json.Marshal(a.Get1)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'get': %w", err)
+		}
+	}
+
+	if a.Id != nil {
+		object["id"], err = json.Marshal(a.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'id': %w", err)
+		}
+	}
+
+	if a.Range != nil {
+		object["range"], err = json.Marshal(a.Range)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'range': %w", err)
+		}
+	}
+
+	object["type"], err = json.Marshal(a.Type)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'type': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// AsCat returns the union data inside the Pet as a Cat
+func (t Pet) AsCat() (Cat, error) {
+	var body Cat
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCat overwrites any union data inside the Pet as the provided Cat
+func (t *Pet) FromCat(v Cat) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCat performs a merge with any union data inside the Pet, using the provided Cat
+func (t *Pet) MergeCat(v Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDog returns the union data inside the Pet as a Dog
+func (t Pet) AsDog() (Dog, error) {
+	var body Dog
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDog overwrites any union data inside the Pet as the provided Dog
+func (t *Pet) FromDog(v Dog) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDog performs a merge with any union data inside the Pet, using the provided Dog
+func (t *Pet) MergeDog(v Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Pet) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if t.AsCat1 != nil {
+		object["asCat"], err = json.Marshal(t.AsCat1)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'asCat': %w", err)
+		}
+	}
+
+	if t.Union != nil {
+		object["union"], err = json.Marshal(t.Union)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'union': %w", err)
+		}
+	}
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *Pet) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["asCat"]; found {
+		err = json.Unmarshal(raw, &t.AsCat1)
+		if err != nil {
+			return fmt.Errorf("error reading 'asCat': %w", err)
+		}
+	}
+
+	if raw, found := object["union"]; found {
+		err = json.Unmarshal(raw, &t.Union)
+		if err != nil {
+			return fmt.Errorf("error reading 'union': %w", err)
+		}
+	}
+
+	return err
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (PUT /things/{body})
+	PutThing(w http.ResponseWriter, r *http.Request, bodyPath string)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (PUT /things/{body})
+func (_ Unimplemented) PutThing(w http.ResponseWriter, r *http.Request, bodyPath string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// PutThing operation middleware
+func (siw *ServerInterfaceWrapper) PutThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "body" -------------
+	var bodyPath string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "body", chi.URLParam(r, "body"), &bodyPath, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "body", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutThing(w, r, bodyPath)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   errorHandlerFunc,
+	}
+}
+
+// NewPutThingHandler returns the handler HandlerWithOptions routes the
+// PutThing operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewPutThingHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).PutThing
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/things/{body}", NewPutThingHandler(si, options))
+	})
+
+	return r
+}
+
+type PutThingRequestObject struct {
+	BodyPath string `json:"body"`
+	Body     *PutThingJSONRequestBody
+}
+
+type PutThingResponseObject interface {
+	VisitPutThingResponse(w http.ResponseWriter) error
+}
+
+type PutThing200JSONResponse Thing
+
+func (response PutThing200JSONResponse) VisitPutThingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (PUT /things/{body})
+	PutThing(ctx context.Context, request PutThingRequestObject) (PutThingResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// PutThing operation middleware
+func (sh *strictHandler) PutThing(w http.ResponseWriter, r *http.Request, bodyPath string) {
+	var request PutThingRequestObject
+
+	request.BodyPath = bodyPath
+
+	var body PutThingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutThing(ctx, request.(PutThingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutThing")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "PutThing"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutThingResponseObject); ok {
+		if err := validResponse.VisitPutThingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// PutThingHandler handles the PutThing operation with its typed request and response objects.
+type PutThingHandler func(ctx context.Context, request PutThingRequestObject) (PutThingResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnPutThing func(next PutThingHandler) PutThingHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) PutThing(ctx context.Context, request PutThingRequestObject) (PutThingResponseObject, error) {
+	handler := PutThingHandler(s.ssi.PutThing)
+	if s.middlewares.OnPutThing != nil {
+		handler = s.middlewares.OnPutThing(handler)
+	}
+	return handler(ctx, request)
+}
@@ -0,0 +1,77 @@
+package fieldnames
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const thingJSON = `{
+	"type": "t", "func": "f", "range": 1, "2fa_enabled": true,
+	"_id": "underscored", "id": "plain", "_": "u",
+	"additionalProperties": "property", "get": "g", "extra": "additional"
+}`
+
+func TestThingRoundTrip(t *testing.T) {
+	var thing Thing
+	require.NoError(t, json.Unmarshal([]byte(thingJSON), &thing))
+
+	// Every property keeps its JSON name, whatever its field is called.
+	assert.Equal(t, "t", thing.Type)
+	assert.Equal(t, "f", *thing.Func)
+	assert.Equal(t, 1, *thing.Range)
+	assert.True(t, *thing.N2faEnabled)
+	assert.Equal(t, "plain", *thing.Id)
+	assert.Equal(t, "underscored", *thing.Id1)
+	assert.Equal(t, "u", *thing.Underscore)
+	assert.Equal(t, "property", *thing.AdditionalProperties1)
+	assert.Equal(t, "g", *thing.Get1)
+	assert.Equal(t, map[string]string{"extra": "additional"}, thing.AdditionalProperties)
+
+	buf, err := json.Marshal(thing)
+	require.NoError(t, err)
+	assert.JSONEq(t, thingJSON, string(buf))
+}
+
+func TestPetRoundTrip(t *testing.T) {
+	const petJSON = `{"union": "u", "asCat": "c", "meows": true}`
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(petJSON), &pet))
+	assert.Equal(t, "u", *pet.Union)
+	assert.Equal(t, "c", *pet.AsCat1)
+	cat, err := pet.AsCat()
+	require.NoError(t, err)
+	assert.True(t, *cat.Meows)
+
+	buf, err := json.Marshal(pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, petJSON, string(buf))
+}
+
+type server struct{}
+
+func (server) PutThing(ctx context.Context, request PutThingRequestObject) (PutThingResponseObject, error) {
+	thing := *request.Body
+	thing.Type = request.BodyPath
+	return PutThing200JSONResponse(thing), nil
+}
+
+func TestBodyPathParameter(t *testing.T) {
+	h := Handler(NewStrictHandler(server{}, nil))
+	req := httptest.NewRequest(http.MethodPut, "/things/renamed", strings.NewReader(thingJSON))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var thing Thing
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &thing))
+	assert.Equal(t, "renamed", thing.Type)
+	assert.Equal(t, "underscored", *thing.Id1)
+}
@@ -0,0 +1,94 @@
+// Package flattenanyof provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package flattenanyof
+
+import (
+	"encoding/json"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Address defines model for Address.
+type Address struct {
+	City   *string `json:"city,omitempty"`
+	Street string  `json:"street"`
+}
+
+// Contact The ways to reach a customer, any of which may be given.
+type Contact struct {
+	City   *string `json:"city,omitempty"`
+	Email  *string `json:"email,omitempty"`
+	Phone  *string `json:"phone,omitempty"`
+	Street *string `json:"street,omitempty"`
+}
+
+// Target defines model for Target.
+type Target struct {
+	union json.RawMessage
+}
+
+// Target1 defines model for .
+type Target1 = string
+
+// AsAddress returns the union data inside the Target as a Address
+func (t Target) AsAddress() (Address, error) {
+	var body Address
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromAddress overwrites any union data inside the Target as the provided Address
+func (t *Target) FromAddress(v Address) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeAddress performs a merge with any union data inside the Target, using the provided Address
+func (t *Target) MergeAddress(v Address) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsTarget1 returns the union data inside the Target as a Target1
+func (t Target) AsTarget1() (Target1, error) {
+	var body Target1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTarget1 overwrites any union data inside the Target as the provided Target1
+func (t *Target) FromTarget1(v Target1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTarget1 performs a merge with any union data inside the Target, using the provided Target1
+func (t *Target) MergeTarget1(v Target1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Target) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Target) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
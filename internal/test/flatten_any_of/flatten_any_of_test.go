@@ -0,0 +1,34 @@
+package flattenanyof
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenedAnyOf(t *testing.T) {
+	var contact Contact
+	require.NoError(t, json.Unmarshal([]byte(`{"street": "Main St", "email": "a@example.com"}`), &contact))
+	require.NotNil(t, contact.Street)
+	assert.Equal(t, "Main St", *contact.Street)
+	require.NotNil(t, contact.Email)
+	assert.Equal(t, "a@example.com", *contact.Email)
+	assert.Nil(t, contact.Phone)
+	assert.Nil(t, contact.City)
+
+	phone := "555-0100"
+	data, err := json.Marshal(Contact{Phone: &phone})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"phone": "555-0100"}`, string(data))
+}
+
+func TestUnflattenedAnyOf(t *testing.T) {
+	// An anyOf whose members aren't all objects is a union still.
+	var target Target
+	require.NoError(t, target.FromTarget1("somewhere"))
+	value, err := target.AsTarget1()
+	require.NoError(t, err)
+	assert.Equal(t, "somewhere", value)
+}
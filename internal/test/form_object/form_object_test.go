@@ -0,0 +1,111 @@
+package formobject
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct {
+	params *GetColorParams
+}
+
+func (s *server) GetColor(w http.ResponseWriter, r *http.Request, params GetColorParams) {
+	s.params = &params
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestRoundTrip(t *testing.T) {
+	s := &server{}
+	ts := httptest.NewServer(Handler(s))
+	defer ts.Close()
+
+	var rawQuery string
+	client, err := NewClient(ts.URL, WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		rawQuery = req.URL.RawQuery
+		return nil
+	}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params GetColorParams
+		query  string
+	}{
+		{
+			name:   "required properties",
+			params: GetColorParams{Color: Color{R: 100, G: 200}},
+			query:  "color=G,200,R,100",
+		},
+		{
+			name:   "commas and spaces in values",
+			params: GetColorParams{Color: Color{R: 1, G: 2, Name: ptr("a,b c"), Opaque: ptr(true)}},
+			query:  "color=G,2,R,1,name,a%2Cb+c,opaque,true",
+		},
+		{
+			name: "map",
+			params: GetColorParams{
+				Color:  Color{R: 1, G: 2},
+				Labels: &map[string]string{"k,1": "v&1", "k2": ""},
+			},
+			query: "color=G,2,R,1&labels=k%2C1,v%261,k2,",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := test.params
+			rsp, err := client.GetColor(context.Background(), &params)
+			require.NoError(t, err)
+			defer rsp.Body.Close()
+			require.Equal(t, http.StatusNoContent, rsp.StatusCode)
+
+			assert.Equal(t, test.query, rawQuery)
+			require.NotNil(t, s.params)
+			assert.Equal(t, test.params, *s.params)
+		})
+	}
+}
+
+func TestBind(t *testing.T) {
+	s := &server{}
+	h := Handler(s)
+
+	get := func(query string) *httptest.ResponseRecorder {
+		s.params = nil
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/colors?"+query, nil))
+		return rec
+	}
+
+	rec := get("color=R,100,G,200")
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, Color{R: 100, G: 200}, s.params.Color)
+	assert.Nil(t, s.params.Labels)
+
+	rec = get("labels=a,1&color=R,1,G,2")
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, &map[string]string{"a": "1"}, s.params.Labels)
+
+	rec = get("color=R,100,G")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "pairs")
+
+	rec = get("color=R,x,G,1")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = get("color=R,1,R,2")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "multiple times")
+
+	rec = get("labels=a,1")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "required")
+}
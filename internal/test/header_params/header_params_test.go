@@ -0,0 +1,85 @@
+package headerparams
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct {
+	header http.Header
+	params GetThingsParams
+}
+
+func (s *server) GetThings(w http.ResponseWriter, r *http.Request, params GetThingsParams) {
+	s.header = r.Header.Clone()
+	s.params = params
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestStyledHeaderParams(t *testing.T) {
+	s := &server{}
+	ts := httptest.NewServer(Handler(s))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL)
+	require.NoError(t, err)
+
+	params := GetThingsParams{
+		XColors:         &[]Color{Red, Blue},
+		XColorsExploded: &[]Color{Green, Red},
+		XSort:           &Sort{Field: "name", Order: Asc},
+		XSortExploded:   &Sort{Field: "age", Order: Desc},
+	}
+	rsp, err := client.GetThings(context.Background(), &params)
+	require.NoError(t, err)
+	require.NoError(t, rsp.Body.Close())
+	assert.Equal(t, http.StatusNoContent, rsp.StatusCode)
+
+	assert.Equal(t, "red,blue", s.header.Get("X-Colors"))
+	assert.Equal(t, "green,red", s.header.Get("X-Colors-Exploded"))
+	assert.Equal(t, "field,name,order,asc", s.header.Get("X-Sort"))
+	assert.Equal(t, "field=age,order=desc", s.header.Get("X-Sort-Exploded"))
+
+	assert.Equal(t, params, s.params)
+}
+
+func TestBindStyledHeaderParams(t *testing.T) {
+	s := &server{}
+	ts := httptest.NewServer(Handler(s))
+	defer ts.Close()
+
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"/things", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Colors", "blue,green")
+	req.Header.Set("X-Colors-Exploded", "red")
+	req.Header.Set("X-Sort", "order,desc,field,name")
+	req.Header.Set("X-Sort-Exploded", "order=asc,field=age")
+
+	rsp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, rsp.Body.Close())
+	assert.Equal(t, http.StatusNoContent, rsp.StatusCode)
+
+	assert.Equal(t, GetThingsParams{
+		XColors:         &[]Color{Blue, Green},
+		XColorsExploded: &[]Color{Red},
+		XSort:           &Sort{Field: "name", Order: Desc},
+		XSortExploded:   &Sort{Field: "age", Order: Asc},
+	}, s.params)
+}
+
+func TestNonASCIIHeaderParamIsRejected(t *testing.T) {
+	label := "café"
+	_, err := NewGetThingsRequest("https://example.com", &GetThingsParams{XLabel: &label})
+	assert.EqualError(t, err, `header parameter X-Label has a non-ASCII value "café"`)
+
+	_, err = NewGetThingsRequest("https://example.com", &GetThingsParams{XColors: &[]Color{"rouge", "vert"}})
+	assert.NoError(t, err)
+	_, err = NewGetThingsRequest("https://example.com", &GetThingsParams{XColors: &[]Color{"rouge", "vért"}})
+	assert.EqualError(t, err, `header parameter X-Colors has a non-ASCII value "rouge,vért"`)
+}
@@ -0,0 +1,86 @@
+package httpdate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var lastModified = time.Date(2024, time.March, 5, 10, 30, 0, 0, time.UTC)
+
+type server struct {
+	requestedAt time.Time
+}
+
+func (s *server) GetPet(ctx context.Context, request GetPetRequestObject) (GetPetResponseObject, error) {
+	s.requestedAt = request.XRequestedAt
+	if since := request.IfModifiedSince; since != nil && !lastModified.After(*since) {
+		return GetPet304Response{Headers: GetPet304ResponseHeaders{LastModified: lastModified}}, nil
+	}
+	return GetPet200JSONResponse{
+		Body:    "Fido",
+		Headers: GetPet200ResponseHeaders{LastModified: lastModified},
+	}, nil
+}
+
+func TestHTTPDateRoundTrip(t *testing.T) {
+	s := &server{}
+	ts := httptest.NewServer(Handler(NewStrictHandler(s, nil)))
+	defer ts.Close()
+
+	var sent http.Header
+	client, err := NewClientWithResponses(ts.URL, WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		sent = req.Header
+		return nil
+	}))
+	require.NoError(t, err)
+
+	// Times in other zones are sent as GMT, which is all HTTP-dates allow.
+	requestedAt := time.Date(2024, time.March, 6, 9, 0, 0, 0, time.FixedZone("CET", 3600))
+	rsp, err := client.GetPetWithResponse(context.Background(), "7", &GetPetParams{XRequestedAt: requestedAt})
+	require.NoError(t, err)
+	assert.Equal(t, "Wed, 06 Mar 2024 08:00:00 GMT", sent.Get("X-Requested-At"))
+	assert.True(t, requestedAt.Equal(s.requestedAt))
+	require.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Equal(t, "Tue, 05 Mar 2024 10:30:00 GMT", rsp.HTTPResponse.Header.Get("Last-Modified"))
+	require.NotNil(t, rsp.Headers200)
+	assert.Equal(t, lastModified, rsp.Headers200.LastModified)
+
+	since := rsp.Headers200.LastModified
+	rsp, err = client.GetPetWithResponse(context.Background(), "7", &GetPetParams{XRequestedAt: requestedAt, IfModifiedSince: &since})
+	require.NoError(t, err)
+	assert.Equal(t, "Tue, 05 Mar 2024 10:30:00 GMT", sent.Get("If-Modified-Since"))
+	require.Equal(t, http.StatusNotModified, rsp.StatusCode())
+	require.NotNil(t, rsp.Headers304)
+	assert.Equal(t, lastModified, rsp.Headers304.LastModified)
+}
+
+func TestHTTPDateFormats(t *testing.T) {
+	s := &server{}
+	h := Handler(NewStrictHandler(s, nil))
+
+	for _, value := range []string{
+		"Wed, 06 Mar 2024 08:00:00 GMT",     // RFC 1123
+		"Wednesday, 06-Mar-24 08:00:00 GMT", // RFC 850
+		"Wed Mar  6 08:00:00 2024",          // ANSI C asctime
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/pets/7", nil)
+		req.Header.Set("X-Requested-At", value)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code, value)
+		assert.True(t, time.Date(2024, time.March, 6, 8, 0, 0, 0, time.UTC).Equal(s.requestedAt), value)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/pets/7", nil)
+	req.Header.Set("X-Requested-At", "2024-03-06T08:00:00Z")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "X-Requested-At")
+}
@@ -0,0 +1,3 @@
+package indexeddeepobject
+
+//go:generate go run github.com/deepmap/oapi-codegen/v2/cmd/oapi-codegen --config=config.yaml spec.yaml
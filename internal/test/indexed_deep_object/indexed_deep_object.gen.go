@@ -0,0 +1,937 @@
+// Package indexeddeepobject provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package indexeddeepobject
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for FilterOp.
+const (
+	Eq FilterOp = "eq"
+	Gt FilterOp = "gt"
+	Lt FilterOp = "lt"
+)
+
+// IsValid reports whether v is one of the values of FilterOp.
+func (v FilterOp) IsValid() bool {
+	switch v {
+	case Eq, Gt, Lt:
+		return true
+	default:
+		return false
+	}
+}
+
+// Filter defines model for Filter.
+type Filter struct {
+	Field string   `json:"field"`
+	Op    FilterOp `json:"op"`
+	Range *struct {
+		Max *int `json:"max,omitempty"`
+		Min int  `json:"min"`
+	} `json:"range,omitempty"`
+	Value *int `json:"value,omitempty"`
+}
+
+// FilterOp defines model for Filter.Op.
+type FilterOp string
+
+// Query defines model for Query.
+type Query struct {
+	Filters *[]Filter `json:"filters,omitempty"`
+	Sort    *[]struct {
+		Descending *bool   `json:"descending,omitempty"`
+		Field      *string `json:"field,omitempty"`
+	} `json:"sort,omitempty"`
+}
+
+// ListPeopleParams defines parameters for ListPeople.
+type ListPeopleParams struct {
+	Filters *[]Filter `json:"filters,omitempty"`
+	Sort    []struct {
+		Descending *bool  `json:"descending,omitempty"`
+		Field      string `json:"field"`
+	} `json:"sort"`
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network. They're applied in order, before the ones given to the call.
+	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListPeople request
+	ListPeople(ctx context.Context, params *ListPeopleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListPeople(ctx context.Context, params *ListPeopleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPeopleRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "ListPeople", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListPeopleRequest generates requests for ListPeople
+func NewListPeopleRequest(server string, params *ListPeopleParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/people")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filters != nil {
+
+			if indexedValues, err := styleIndexedDeepObjectQueryParam("filters", *params.Filters); err != nil {
+				return nil, err
+			} else {
+				for k, v := range indexedValues {
+					queryValues[k] = v
+				}
+			}
+
+		}
+
+		if indexedValues, err := styleIndexedDeepObjectQueryParam("sort", params.Sort); err != nil {
+			return nil, err
+		} else {
+			for k, v := range indexedValues {
+				queryValues[k] = v
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// styleIndexedDeepObjectQueryParam styles value, which holds an array of
+// objects, keying the properties of each object by its index, eg,
+// filters[0][field]=age&filters[0][op]=gt, and those of nested objects by a
+// segment per level, eg, filters[0][range][min]=1. Null properties are left
+// out.
+func styleIndexedDeepObjectQueryParam(paramName string, value interface{}) (url.Values, error) {
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling parameter %s: %w", paramName, err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+	var items []interface{}
+	if err := decoder.Decode(&items); err != nil {
+		return nil, fmt.Errorf("parameter %s isn't an array: %w", paramName, err)
+	}
+	values := make(url.Values)
+	var style func(key string, value interface{}) error
+	style = func(key string, value interface{}) error {
+		switch value := value.(type) {
+		case nil:
+		case map[string]interface{}:
+			for name, property := range value {
+				if err := style(key+"["+name+"]", property); err != nil {
+					return err
+				}
+			}
+		case string:
+			values.Set(key, value)
+		case json.Number:
+			values.Set(key, value.String())
+		case bool:
+			values.Set(key, strconv.FormatBool(value))
+		default:
+			return fmt.Errorf("%s of parameter %s is an array, which the indexed-deep-object style doesn't define", key, paramName)
+		}
+		return nil
+	}
+	for i, item := range items {
+		if _, ok := item.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("item %d of parameter %s isn't an object", i, paramName)
+		}
+		if err := style(fmt.Sprintf("%s[%d]", paramName, i), item); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
+		}
+	}
+	for i, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListPeopleWithResponse request
+	ListPeopleWithResponse(ctx context.Context, params *ListPeopleParams, reqEditors ...RequestEditorFn) (*ListPeopleResponse, error)
+}
+
+// ListPeopleWithResponse request returning *ListPeopleResponse
+func (c *ClientWithResponses) ListPeopleWithResponse(ctx context.Context, params *ListPeopleParams, reqEditors ...RequestEditorFn) (*ListPeopleResponse, error) {
+	rsp, err := c.ListPeople(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseListPeopleResponseWithoutBody(rsp)
+	}
+	return ParseListPeopleResponse(rsp)
+}
+
+// parseListPeopleResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseListPeopleResponseWithoutBody(rsp *http.Response) (*ListPeopleResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &ListPeopleResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ListPeopleResponse is the response of ListPeople. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type ListPeopleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Query
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPeopleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPeopleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ParseListPeopleResponse parses an HTTP response from a ListPeopleWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseListPeopleResponse(rsp *http.Response) (*ListPeopleResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPeopleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Query
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "ListPeople":
+		return ParseListPeopleResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /people)
+	ListPeople(w http.ResponseWriter, r *http.Request, params ListPeopleParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /people)
+func (_ Unimplemented) ListPeople(w http.ResponseWriter, r *http.Request, params ListPeopleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListPeople operation middleware
+func (siw *ServerInterfaceWrapper) ListPeople(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPeopleParams
+
+	// ------------- Optional query parameter "filters" -------------
+
+	err = bindIndexedDeepObjectQueryParam(false, "filters", r.URL.Query(), &params.Filters, "[field]", "[op]", "[range][min]")
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filters", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "sort" -------------
+
+	err = bindIndexedDeepObjectQueryParam(true, "sort", r.URL.Query(), &params.Sort, "[field]")
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPeople(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   errorHandlerFunc,
+	}
+}
+
+// NewListPeopleHandler returns the handler HandlerWithOptions routes the
+// ListPeople operation to, so that it can be mounted on its own.
+func NewListPeopleHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ListPeople
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/people", NewListPeopleHandler(si, options))
+	})
+
+	return r
+}
+
+// maxIndexedDeepObjectDepth is the number of bracketed segments after the
+// index, which the keys of indexed deep objects may have.
+const maxIndexedDeepObjectDepth = 8
+
+// InvalidQueryKeyError is the error binding a query parameter with the
+// indexed-deep-object query style, eg, filters[0][field]=age, naming the key
+// of the query at fault.
+type InvalidQueryKeyError struct {
+	ParamName string
+	Key       string
+	Err       error
+}
+
+func (e *InvalidQueryKeyError) Error() string {
+	return fmt.Sprintf("invalid key '%s' of parameter '%s': %s", e.Key, e.ParamName, e.Err)
+}
+
+func (e *InvalidQueryKeyError) Unwrap() error {
+	return e.Err
+}
+
+// bindIndexedDeepObjectQueryParam binds the query parameter paramName, an
+// array of objects whose properties are keyed by the index of their object,
+// eg, filters[0][field]=age&filters[0][op]=gt&filters[1][field]=name, to
+// dest, which points to the parameter, or to a pointer to it when it's
+// optional. Nested objects add a segment per level, eg, filters[0][range][min].
+// Indices may be sparse and in any order, the objects are sorted by them.
+// Every object must have the properties of requiredProperties, given as the
+// keys following the index, and those of nested objects when they're present.
+// Malformed or unknown keys, and values which don't bind to the type of their
+// property, or aren't one of the values of its enum, are an
+// *InvalidQueryKeyError.
+func bindIndexedDeepObjectQueryParam(required bool, paramName string, query url.Values, dest interface{}, requiredProperties ...string) error {
+	type property struct {
+		key   string
+		path  []string
+		value string
+	}
+	// The keys are walked in order, so that the error of a query with many
+	// bad keys is always about the same one.
+	var keys []string
+	for key := range query {
+		if key == paramName || strings.HasPrefix(key, paramName+"[") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	items := make(map[int][]property)
+	for _, key := range keys {
+		values := query[key]
+		var segments []string
+		for rest := strings.TrimPrefix(key, paramName); rest != ""; {
+			end := strings.IndexByte(rest, ']')
+			if rest[0] != '[' || end < 0 {
+				return &InvalidQueryKeyError{ParamName: paramName, Key: key, Err: errors.New("malformed brackets")}
+			}
+			segment := rest[1:end]
+			if segment == "" || strings.ContainsRune(segment, '[') {
+				return &InvalidQueryKeyError{ParamName: paramName, Key: key, Err: errors.New("malformed brackets")}
+			}
+			if len(segments) > maxIndexedDeepObjectDepth {
+				return &InvalidQueryKeyError{ParamName: paramName, Key: key, Err: fmt.Errorf("nested more than %d levels deep", maxIndexedDeepObjectDepth)}
+			}
+			segments = append(segments, segment)
+			rest = rest[end+1:]
+		}
+		if len(segments) == 0 {
+			return &InvalidQueryKeyError{ParamName: paramName, Key: key, Err: errors.New("expected an index and a property, eg, [0][name]")}
+		}
+		index, err := strconv.Atoi(segments[0])
+		if err != nil || index < 0 || strings.TrimLeft(segments[0], "0123456789") != "" {
+			return &InvalidQueryKeyError{ParamName: paramName, Key: key, Err: fmt.Errorf("invalid index '%s'", segments[0])}
+		}
+		if len(segments) == 1 {
+			return &InvalidQueryKeyError{ParamName: paramName, Key: key, Err: errors.New("expected an index and a property, eg, [0][name]")}
+		}
+		if len(values) != 1 {
+			return &InvalidQueryKeyError{ParamName: paramName, Key: key, Err: errors.New("specified multiple times")}
+		}
+		items[index] = append(items[index], property{key: key, path: segments[1:], value: values[0]})
+	}
+
+	if len(items) == 0 {
+		if required {
+			return fmt.Errorf("query parameter '%s' is required", paramName)
+		}
+		return nil
+	}
+	indices := make([]int, 0, len(items))
+	for index := range items {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+
+	v := reflect.ValueOf(dest).Elem()
+	t := v.Type()
+	if !required {
+		t = t.Elem()
+	}
+	array := reflect.MakeSlice(t, 0, len(indices))
+	for _, index := range indices {
+		item := reflect.New(t.Elem()).Elem()
+		present := make(map[string]bool)
+		for _, p := range items[index] {
+			field := item
+			for i, name := range p.path {
+				for field.Kind() == reflect.Ptr {
+					if field.IsNil() {
+						field.Set(reflect.New(field.Type().Elem()))
+					}
+					field = field.Elem()
+				}
+				if field.Kind() != reflect.Struct {
+					return &InvalidQueryKeyError{ParamName: paramName, Key: p.key, Err: fmt.Errorf("property '%s' isn't an object", strings.Join(p.path[:i], "."))}
+				}
+				found := false
+				for j := 0; j < field.NumField(); j++ {
+					if !field.Type().Field(j).IsExported() {
+						continue
+					}
+					jsonName, _, _ := strings.Cut(field.Type().Field(j).Tag.Get("json"), ",")
+					if jsonName == "" {
+						jsonName = field.Type().Field(j).Name
+					}
+					if jsonName == name {
+						field = field.Field(j)
+						found = true
+						break
+					}
+				}
+				if !found {
+					return &InvalidQueryKeyError{ParamName: paramName, Key: p.key, Err: fmt.Errorf("unknown property '%s'", name)}
+				}
+				present["["+strings.Join(p.path[:i+1], "][")+"]"] = true
+			}
+			for field.Kind() == reflect.Ptr {
+				field.Set(reflect.New(field.Type().Elem()))
+				field = field.Elem()
+			}
+			if err := runtime.BindStringToObject(p.value, field.Addr().Interface()); err != nil {
+				return &InvalidQueryKeyError{ParamName: paramName, Key: p.key, Err: err}
+			}
+			if enum, ok := field.Interface().(interface{ IsValid() bool }); ok && !enum.IsValid() {
+				return &InvalidQueryKeyError{ParamName: paramName, Key: p.key, Err: fmt.Errorf("'%s' isn't one of the values of %s", p.value, field.Type().Name())}
+			}
+		}
+		for _, key := range requiredProperties {
+			parent := key[:strings.LastIndexByte(key, '[')]
+			if (parent == "" || present[parent]) && !present[key] {
+				return &InvalidQueryKeyError{ParamName: paramName, Key: fmt.Sprintf("%s[%d]%s", paramName, index, key), Err: errors.New("required property is missing")}
+			}
+		}
+		array = reflect.Append(array, item)
+	}
+
+	if !required {
+		pointer := reflect.New(t)
+		pointer.Elem().Set(array)
+		array = pointer
+	}
+	v.Set(array)
+	return nil
+}
@@ -0,0 +1,147 @@
+package indexeddeepobject
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct {
+	params ListPeopleParams
+}
+
+func (s *server) ListPeople(w http.ResponseWriter, r *http.Request, params ListPeopleParams) {
+	s.params = params
+	w.WriteHeader(http.StatusOK)
+}
+
+// bind binds rawQuery with the generated server, returning the parameters
+// it bound, or the error it handled.
+func bind(t *testing.T, rawQuery string) (ListPeopleParams, error) {
+	t.Helper()
+	s := &server{}
+	var bindErr error
+	handler := HandlerWithOptions(s, ChiServerOptions{
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			bindErr = err
+			w.WriteHeader(http.StatusBadRequest)
+		},
+	})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/people?"+rawQuery, nil))
+	return s.params, bindErr
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestBindIndexedDeepObject(t *testing.T) {
+	params, err := bind(t, "filters[0][field]=age&filters[0][op]=gt&filters[0][value]=30"+
+		"&filters[1][field]=height&filters[1][op]=eq&filters[1][range][min]=150&filters[1][range][max]=200"+
+		"&sort[0][field]=name")
+	require.NoError(t, err)
+	require.NotNil(t, params.Filters)
+	require.Len(t, *params.Filters, 2)
+	assert.Equal(t, Filter{Field: "age", Op: Gt, Value: ptr(30)}, (*params.Filters)[0])
+	assert.Equal(t, "height", (*params.Filters)[1].Field)
+	require.NotNil(t, (*params.Filters)[1].Range)
+	assert.Equal(t, 150, (*params.Filters)[1].Range.Min)
+	assert.Equal(t, ptr(200), (*params.Filters)[1].Range.Max)
+	require.Len(t, params.Sort, 1)
+	assert.Equal(t, "name", params.Sort[0].Field)
+	assert.Nil(t, params.Sort[0].Descending)
+}
+
+func TestBindIndexedDeepObjectSparseIndices(t *testing.T) {
+	// The indices are sorted numerically, not as strings, and the gaps
+	// between them are dropped.
+	params, err := bind(t, "sort[10][field]=c&sort[2][field]=b&sort[0][field]=a&sort[2][descending]=true")
+	require.NoError(t, err)
+	require.Len(t, params.Sort, 3)
+	assert.Equal(t, "a", params.Sort[0].Field)
+	assert.Equal(t, "b", params.Sort[1].Field)
+	assert.Equal(t, ptr(true), params.Sort[1].Descending)
+	assert.Equal(t, "c", params.Sort[2].Field)
+	assert.Nil(t, params.Filters)
+}
+
+func TestBindIndexedDeepObjectErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		rawQuery string
+		key      string
+		message  string
+	}{
+		{"missing index", "sort[field]=a", "sort[field]", "invalid index 'field'"},
+		{"negative index", "sort[-1][field]=a", "sort[-1][field]", "invalid index '-1'"},
+		{"unclosed bracket", "sort[0][field=a", "sort[0][field", "malformed brackets"},
+		{"empty segment", "sort[0][]=a", "sort[0][]", "malformed brackets"},
+		{"trailing text", "sort[0][field]x=a", "sort[0][field]x", "malformed brackets"},
+		{"no property", "sort[0]=a", "sort[0]", "expected an index and a property, eg, [0][name]"},
+		{"bare name", "sort=a", "sort", "expected an index and a property, eg, [0][name]"},
+		{"unknown property", "sort[0][field]=a&sort[0][color]=red", "sort[0][color]", "unknown property 'color'"},
+		{"repeated key", "sort[0][field]=a&sort[0][field]=b", "sort[0][field]", "specified multiple times"},
+		{"invalid boolean", "sort[0][field]=a&sort[0][descending]=maybe", "sort[0][descending]", ""},
+		{"invalid enum", "sort[0][field]=a&filters[0][field]=age&filters[0][op]=ne", "filters[0][op]", "'ne' isn't one of the values of FilterOp"},
+		{"missing required property", "sort[0][descending]=true", "sort[0][field]", "required property is missing"},
+		{"missing required nested property", "sort[0][field]=a&filters[0][field]=age&filters[0][op]=gt&filters[0][range][max]=1", "filters[0][range][min]", "required property is missing"},
+		{"primitive with properties", "sort[0][field][x]=a", "sort[0][field][x]", "property 'field' isn't an object"},
+		{"too deep", "sort[0][a][b][c][d][e][f][g][h][i]=a", "sort[0][a][b][c][d][e][f][g][h][i]", "nested more than 8 levels deep"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := bind(t, tt.rawQuery)
+			var keyErr *InvalidQueryKeyError
+			require.True(t, errors.As(err, &keyErr), "%v", err)
+			assert.Equal(t, tt.key, keyErr.Key)
+			if tt.message != "" {
+				assert.EqualError(t, keyErr.Err, tt.message)
+			}
+			var formatErr *InvalidParamFormatError
+			assert.True(t, errors.As(err, &formatErr))
+		})
+	}
+
+	_, err := bind(t, "")
+	var formatErr *InvalidParamFormatError
+	require.True(t, errors.As(err, &formatErr))
+	assert.Equal(t, "sort", formatErr.ParamName)
+}
+
+func TestIndexedDeepObjectRoundTrip(t *testing.T) {
+	s := &server{}
+	ts := httptest.NewServer(Handler(s))
+	defer ts.Close()
+	client, err := NewClient(ts.URL)
+	require.NoError(t, err)
+
+	filters := []Filter{
+		{Field: "age", Op: Gt, Value: ptr(30)},
+		{Field: "height", Op: Eq},
+	}
+	filters[1].Range = &struct {
+		Max *int `json:"max,omitempty"`
+		Min int  `json:"min"`
+	}{Min: 150}
+	params := ListPeopleParams{Filters: &filters}
+	// Enough items for indices of two digits, which must keep their order.
+	for _, field := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"} {
+		params.Sort = append(params.Sort, struct {
+			Descending *bool  `json:"descending,omitempty"`
+			Field      string `json:"field"`
+		}{Field: field})
+	}
+	params.Sort[1].Descending = ptr(true)
+
+	rsp, err := client.ListPeople(context.Background(), &params)
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, params, s.params)
+}
@@ -0,0 +1,87 @@
+// Package inheriteddiscriminators provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package inheriteddiscriminators
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Cat defines model for Cat.
+type Cat struct {
+	Meow    *bool  `json:"meow,omitempty"`
+	Name    string `json:"name"`
+	PetType string `json:"petType"`
+}
+
+// Dog defines model for Dog.
+type Dog struct {
+	Bark    *bool  `json:"bark,omitempty"`
+	Name    string `json:"name"`
+	PetType string `json:"petType"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Name    string `json:"name"`
+	PetType string `json:"petType"`
+}
+
+// Puppy defines model for Puppy.
+type Puppy struct {
+	Age     *int   `json:"age,omitempty"`
+	Bark    *bool  `json:"bark,omitempty"`
+	Name    string `json:"name"`
+	PetType string `json:"petType"`
+}
+
+// Discriminator returns "Cat", the value of the petType discriminator
+// of Pet identifying a Cat.
+func (t Cat) Discriminator() string {
+	return "Cat"
+}
+
+// Discriminator returns "dog", the value of the petType discriminator
+// of Pet identifying a Dog.
+func (t Dog) Discriminator() string {
+	return "dog"
+}
+
+// Discriminator returns "Puppy", the value of the petType discriminator
+// of Pet identifying a Puppy.
+func (t Puppy) Discriminator() string {
+	return "Puppy"
+}
+
+// UnmarshalPet decodes data into the type its petType discriminator
+// identifies among those of Pet: Cat for "Cat", Puppy for "Puppy", Dog for "dog".
+func UnmarshalPet(data []byte) (interface{}, error) {
+	var discriminator struct {
+		Value string `json:"petType"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+	switch discriminator.Value {
+	case "Cat":
+		var v Cat
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case "Puppy":
+		var v Puppy
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case "dog":
+		var v Dog
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("unknown petType discriminator value %q of Pet", discriminator.Value)
+}
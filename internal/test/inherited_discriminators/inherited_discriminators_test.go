@@ -0,0 +1,33 @@
+package inheriteddiscriminators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalPet(t *testing.T) {
+	pet, err := UnmarshalPet([]byte(`{"petType": "dog", "name": "Rex", "bark": true}`))
+	require.NoError(t, err)
+	require.IsType(t, Dog{}, pet)
+	dog := pet.(Dog)
+	assert.Equal(t, "Rex", dog.Name)
+	assert.Equal(t, "dog", dog.Discriminator())
+
+	pet, err = UnmarshalPet([]byte(`{"petType": "Cat", "name": "Tom", "meow": true}`))
+	require.NoError(t, err)
+	require.IsType(t, Cat{}, pet)
+	assert.Equal(t, "Cat", pet.(Cat).Discriminator())
+
+	pet, err = UnmarshalPet([]byte(`{"petType": "Puppy", "name": "Bit", "age": 1}`))
+	require.NoError(t, err)
+	require.IsType(t, Puppy{}, pet)
+	assert.Equal(t, "Puppy", pet.(Puppy).Discriminator())
+
+	_, err = UnmarshalPet([]byte(`{"petType": "Bird", "name": "Tweety"}`))
+	assert.EqualError(t, err, `unknown petType discriminator value "Bird" of Pet`)
+
+	_, err = UnmarshalPet([]byte(`[]`))
+	assert.Error(t, err)
+}
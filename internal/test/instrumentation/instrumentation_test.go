@@ -0,0 +1,317 @@
+package instrumentation
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofiber/fiber/v2"
+	fiberrecover "github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/kataras/iris/v12"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/v2/internal/test/instrumentation/chi"
+	echoapi "github.com/deepmap/oapi-codegen/v2/internal/test/instrumentation/echo"
+	fiberapi "github.com/deepmap/oapi-codegen/v2/internal/test/instrumentation/fiber"
+	ginapi "github.com/deepmap/oapi-codegen/v2/internal/test/instrumentation/gin"
+	"github.com/deepmap/oapi-codegen/v2/internal/test/instrumentation/gorilla"
+	irisapi "github.com/deepmap/oapi-codegen/v2/internal/test/instrumentation/iris"
+)
+
+// call is an operation the recorder was told about.
+type call struct {
+	operationID string
+	status      int
+	err         error
+}
+
+// recorder is an Instrumentation recording the calls it's told about, and
+// the number of them which ended.
+type recorder struct {
+	mu    sync.Mutex
+	calls []call
+}
+
+func (r *recorder) OperationStarted(ctx context.Context, operationID string) func(status int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	i := len(r.calls)
+	r.calls = append(r.calls, call{operationID: operationID, status: -1})
+	ended := false
+	return func(status int, err error) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if ended {
+			panic("operation ended twice")
+		}
+		ended = true
+		r.calls[i].status = status
+		r.calls[i].err = err
+	}
+}
+
+func (r *recorder) take() []call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	calls := r.calls
+	r.calls = nil
+	return calls
+}
+
+// assertInstrumented serves a successful request, one whose parameter can't
+// be bound, and one which panics, with handler, checking that each was told
+// to the recorder once.
+func assertInstrumented(t *testing.T, r *recorder, handler http.Handler) {
+	serve := func(path string) int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w.Code
+	}
+	assertServed(t, r, serve, false)
+}
+
+// assertServed is assertInstrumented, serving the requests with serve, which
+// returns the status of the response. When recovers is set, the server
+// recovers the panic, with a 500, rather than carrying it on.
+func assertServed(t *testing.T, r *recorder, serve func(path string) int, recovers bool) {
+	assert.Equal(t, http.StatusOK, serve("/pets/1"))
+	assert.Equal(t, []call{{operationID: "GetPet", status: http.StatusOK}}, r.take())
+
+	assert.Equal(t, http.StatusBadRequest, serve("/pets/one"))
+	calls := r.take()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "GetPet", calls[0].operationID)
+	assert.Equal(t, http.StatusBadRequest, calls[0].status)
+	assert.Error(t, calls[0].err)
+
+	if recovers {
+		assert.Equal(t, http.StatusInternalServerError, serve("/panic"))
+	} else {
+		assert.PanicsWithValue(t, "boom", func() { serve("/panic") })
+	}
+	calls = r.take()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "Panic", calls[0].operationID)
+	assert.Equal(t, http.StatusInternalServerError, calls[0].status)
+	// Each package has its own PanicError.
+	assert.EqualError(t, calls[0].err, "panic: boom")
+}
+
+// stream writes the optional interfaces w implements, flushing it when it
+// can, as a streaming handler would.
+func stream(w http.ResponseWriter) {
+	if flusher, ok := w.(http.Flusher); ok {
+		_, _ = io.WriteString(w, "flusher")
+		flusher.Flush()
+	}
+	if _, ok := w.(http.Hijacker); ok {
+		_, _ = io.WriteString(w, " hijacker")
+	}
+}
+
+// assertStreams checks that the writer of the handler serving /stream, over
+// a real connection, can still be flushed and hijacked once instrumented.
+func assertStreams(t *testing.T, r *recorder, handler http.Handler) {
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	rsp, err := http.Get(server.URL + "/stream")
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	body, err := io.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "flusher hijacker", string(body))
+	assert.Equal(t, []call{{operationID: "Stream", status: http.StatusOK}}, r.take())
+}
+
+type chiServer struct{}
+
+func (chiServer) Stream(w http.ResponseWriter, r *http.Request) {
+	stream(w)
+}
+
+func (chiServer) GetPet(w http.ResponseWriter, r *http.Request, id int) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(chi.Pet{Id: id})
+}
+
+func (chiServer) Panic(w http.ResponseWriter, r *http.Request) {
+	panic("boom")
+}
+
+func TestChiInstrumentation(t *testing.T) {
+	r := &recorder{}
+	assertInstrumented(t, r, chi.HandlerWithOptions(chiServer{}, chi.ChiServerOptions{Instrumentation: r}))
+	assertStreams(t, r, chi.HandlerWithOptions(chiServer{}, chi.ChiServerOptions{Instrumentation: r}))
+
+	w := httptest.NewRecorder()
+	assert.Panics(t, func() {
+		chi.NewPanicHandler(chiServer{}, chi.ChiServerOptions{Instrumentation: r}).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/panic", nil))
+	})
+	calls := r.take()
+	require.Len(t, calls, 1)
+	var panicErr *chi.PanicError
+	if assert.ErrorAs(t, calls[0].err, &panicErr) {
+		assert.Equal(t, "boom", panicErr.Value)
+	}
+
+	// Without it, the handlers are left as they are.
+	w = httptest.NewRecorder()
+	chi.Handler(chiServer{}).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pets/1", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+type gorillaServer struct{}
+
+func (gorillaServer) Stream(w http.ResponseWriter, r *http.Request) {
+	stream(w)
+}
+
+func (gorillaServer) GetPet(w http.ResponseWriter, r *http.Request, id int) {
+	_ = json.NewEncoder(w).Encode(gorilla.Pet{Id: id})
+}
+
+func (gorillaServer) Panic(w http.ResponseWriter, r *http.Request) {
+	panic("boom")
+}
+
+func TestGorillaInstrumentation(t *testing.T) {
+	r := &recorder{}
+	assertInstrumented(t, r, gorilla.HandlerWithOptions(gorillaServer{}, gorilla.GorillaServerOptions{Instrumentation: r}))
+	assertStreams(t, r, gorilla.HandlerWithOptions(gorillaServer{}, gorilla.GorillaServerOptions{Instrumentation: r}))
+}
+
+type ginServer struct{}
+
+func (ginServer) Stream(c *gin.Context) {
+	stream(c.Writer)
+}
+
+func (ginServer) GetPet(c *gin.Context, id int) {
+	c.JSON(http.StatusOK, ginapi.Pet{Id: id})
+}
+
+func (ginServer) Panic(c *gin.Context) {
+	panic("boom")
+}
+
+func TestGinInstrumentation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := &recorder{}
+	router := gin.New()
+	ginapi.RegisterHandlersWithOptions(router, ginServer{}, ginapi.GinServerOptions{Instrumentation: r})
+	assertInstrumented(t, r, router)
+	assertStreams(t, r, router)
+}
+
+type echoServer struct{}
+
+func (echoServer) Stream(ctx echo.Context) error {
+	stream(ctx.Response())
+	return nil
+}
+
+func (echoServer) GetPet(ctx echo.Context, id int) error {
+	return ctx.JSON(http.StatusOK, echoapi.Pet{Id: id})
+}
+
+func (echoServer) Panic(ctx echo.Context) error {
+	panic("boom")
+}
+
+func TestEchoInstrumentation(t *testing.T) {
+	r := &recorder{}
+	e := echo.New()
+	echoapi.RegisterHandlersWithOptions(e, echoServer{}, echoapi.EchoServerOptions{Instrumentation: r})
+	assertInstrumented(t, r, e)
+	assertStreams(t, r, e)
+
+	// Without it, the handlers are left as they are.
+	e = echo.New()
+	echoapi.RegisterHandlers(e, echoServer{})
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pets/1", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+type fiberServer struct{}
+
+func (fiberServer) Stream(c *fiber.Ctx) error {
+	return c.SendString("stream")
+}
+
+func (fiberServer) GetPet(c *fiber.Ctx, id int) error {
+	return c.JSON(fiberapi.Pet{Id: id})
+}
+
+func (fiberServer) Panic(c *fiber.Ctx) error {
+	panic("boom")
+}
+
+func TestFiberInstrumentation(t *testing.T) {
+	r := &recorder{}
+	app := fiber.New()
+	// fiber serves the requests of app.Test on another goroutine, so the
+	// panic has to be recovered there.
+	app.Use(fiberrecover.New())
+	fiberapi.RegisterHandlersWithOptions(app, fiberServer{}, fiberapi.FiberServerOptions{Instrumentation: r})
+	assertServed(t, r, func(path string) int {
+		rsp, err := app.Test(httptest.NewRequest(http.MethodGet, path, nil))
+		require.NoError(t, err)
+		return rsp.StatusCode
+	}, true)
+}
+
+type irisServer struct{}
+
+func (irisServer) Stream(ctx iris.Context) {
+	stream(ctx.ResponseWriter())
+}
+
+func (irisServer) GetPet(ctx iris.Context, id int) {
+	_ = ctx.JSON(irisapi.Pet{Id: id})
+}
+
+func (irisServer) Panic(ctx iris.Context) {
+	panic("boom")
+}
+
+func TestIrisInstrumentation(t *testing.T) {
+	r := &recorder{}
+	app := iris.New()
+	irisapi.RegisterHandlersWithOptions(app, irisServer{}, irisapi.IrisServerOptions{Instrumentation: r})
+	assertInstrumented(t, r, app)
+	assertStreams(t, r, app)
+}
+
+func TestClientInstrumentation(t *testing.T) {
+	server := httptest.NewServer(chi.Handler(chiServer{}))
+	defer server.Close()
+
+	r := &recorder{}
+	client, err := chi.NewClient(server.URL, chi.WithInstrumentation(r))
+	require.NoError(t, err)
+
+	rsp, err := client.GetPet(context.Background(), 1)
+	require.NoError(t, err)
+	rsp.Body.Close()
+	assert.Equal(t, []call{{operationID: "GetPet", status: http.StatusOK}}, r.take())
+
+	// A call failing without a response has no status.
+	failing := func(ctx context.Context, req *http.Request) error {
+		return assert.AnError
+	}
+	_, err = client.GetPet(context.Background(), 1, failing)
+	require.Error(t, err)
+	calls := r.take()
+	require.Len(t, calls, 1)
+	assert.Equal(t, 0, calls[0].status)
+	assert.ErrorIs(t, calls[0].err, assert.AnError)
+}
@@ -0,0 +1,81 @@
+// Package interfaces provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package interfaces
+
+// Owner defines model for Owner.
+type Owner struct {
+	Name string `json:"name"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	FavoriteToy *Toy    `json:"favoriteToy,omitempty"`
+	Keeper      Owner   `json:"keeper"`
+	Name        string  `json:"name"`
+	Owner       *Owner  `json:"owner,omitempty"`
+	Tag         *string `json:"tag,omitempty"`
+	Toys        *[]Toy  `json:"toys,omitempty"`
+}
+
+// Toy defines model for Toy.
+type Toy struct {
+	Kind *string `json:"kind,omitempty"`
+}
+
+// OwnerLike is the interface of the getters of Owner.
+type OwnerLike interface {
+	GetName() string
+}
+
+var _ OwnerLike = Owner{}
+
+// GetName returns the Name field of the Owner.
+func (t Owner) GetName() string {
+	return t.Name
+}
+
+// PetLike is the interface of the getters of Pet.
+type PetLike interface {
+	GetFavoriteToy() *Toy
+	GetKeeper() OwnerLike
+	GetName() string
+	GetOwner() OwnerLike
+	GetTag() *string
+	GetToys() *[]Toy
+}
+
+var _ PetLike = Pet{}
+
+// GetFavoriteToy returns the FavoriteToy field of the Pet.
+func (t Pet) GetFavoriteToy() *Toy {
+	return t.FavoriteToy
+}
+
+// GetKeeper returns the Keeper field of the Pet.
+func (t Pet) GetKeeper() OwnerLike {
+	return t.Keeper
+}
+
+// GetName returns the Name field of the Pet.
+func (t Pet) GetName() string {
+	return t.Name
+}
+
+// GetOwner returns the Owner field of the Pet.
+func (t Pet) GetOwner() OwnerLike {
+	if t.Owner == nil {
+		return nil
+	}
+	return t.Owner
+}
+
+// GetTag returns the Tag field of the Pet.
+func (t Pet) GetTag() *string {
+	return t.Tag
+}
+
+// GetToys returns the Toys field of the Pet.
+func (t Pet) GetToys() *[]Toy {
+	return t.Toys
+}
@@ -0,0 +1,45 @@
+package interfaces
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// describe stands for a data layer accepting any PetLike.
+func describe(pet PetLike) string {
+	description := pet.GetName() + " kept by " + pet.GetKeeper().GetName()
+	if owner := pet.GetOwner(); owner != nil {
+		description += ", owned by " + owner.GetName()
+	}
+	return description
+}
+
+// fakePet is a PetLike which isn't a Pet, like a mock would be.
+type fakePet struct {
+	Pet
+}
+
+func (fakePet) GetName() string {
+	return "fake"
+}
+
+func TestGetters(t *testing.T) {
+	tag := "good"
+	pet := Pet{
+		Name:   "Fido",
+		Tag:    &tag,
+		Keeper: Owner{Name: "Alice"},
+	}
+	assert.Equal(t, "Fido kept by Alice", describe(pet))
+	assert.Equal(t, &tag, pet.GetTag())
+	assert.Nil(t, pet.GetToys())
+
+	// A nil Owner gives a nil OwnerLike, rather than one holding a nil
+	// pointer.
+	assert.Nil(t, pet.GetOwner())
+	pet.Owner = &Owner{Name: "Bob"}
+	assert.Equal(t, "Fido kept by Alice, owned by Bob", describe(&pet))
+
+	assert.Equal(t, "fake kept by Alice, owned by Bob", describe(fakePet{pet}))
+}
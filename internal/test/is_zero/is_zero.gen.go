@@ -0,0 +1,233 @@
+// Package iszero provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package iszero
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"net/netip"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for Status.
+const (
+	Available Status = "available"
+	Sold      Status = "sold"
+)
+
+// IsValid reports whether v is one of the values of Status.
+func (v Status) IsValid() bool {
+	switch v {
+	case Available, Sold:
+		return true
+	default:
+		return false
+	}
+}
+
+// Animal defines model for Animal.
+type Animal struct {
+	union json.RawMessage
+}
+
+// Labels defines model for Labels.
+type Labels struct {
+	Kind                 *string           `json:"kind,omitempty"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Owner defines model for Owner.
+type Owner struct {
+	Birthday openapi_types.Date `json:"birthday"`
+	Name     string             `json:"name"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Address netip.Addr `json:"address"`
+	Collar  struct {
+		Color *string `json:"color,omitempty"`
+	} `json:"collar"`
+	Id     *int     `json:"id,omitempty"`
+	Name   string   `json:"name"`
+	Owner  Owner    `json:"owner"`
+	Price  Money    `json:"price"`
+	Status Status   `json:"status"`
+	Tags   []string `json:"tags"`
+}
+
+// Status defines model for Status.
+type Status string
+
+// Getter for additional properties for Labels. Returns the specified
+// element and whether it was found
+func (a Labels) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Labels
+func (a *Labels) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Labels to handle AdditionalProperties
+func (a *Labels) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["kind"]; found {
+		err = json.Unmarshal(raw, &a.Kind)
+		if err != nil {
+			return fmt.Errorf("error reading 'kind': %w", err)
+		}
+		delete(object, "kind")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Labels to handle AdditionalProperties
+func (a Labels) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Kind != nil {
+		object["kind"], err = json.Marshal(a.Kind)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'kind': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// AsPet returns the union data inside the Animal as a Pet
+func (t Animal) AsPet() (Pet, error) {
+	var body Pet
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPet overwrites any union data inside the Animal as the provided Pet
+func (t *Animal) FromPet(v Pet) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePet performs a merge with any union data inside the Animal, using the provided Pet
+func (t *Animal) MergePet(v Pet) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOwner returns the union data inside the Animal as a Owner
+func (t Animal) AsOwner() (Owner, error) {
+	var body Owner
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOwner overwrites any union data inside the Animal as the provided Owner
+func (t *Animal) FromOwner(v Owner) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOwner performs a merge with any union data inside the Animal, using the provided Owner
+func (t *Animal) MergeOwner(v Owner) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Animal) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Animal) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// IsZero reports whether every field of the Animal is unset.
+func (t Animal) IsZero() bool {
+	return len(t.union) == 0
+}
+
+// IsZero reports whether every field of the Labels is unset.
+func (t Labels) IsZero() bool {
+	return t.Kind == nil &&
+		len(t.AdditionalProperties) == 0
+}
+
+// IsZero reports whether every field of the Owner is unset.
+func (t Owner) IsZero() bool {
+	return t.Birthday.IsZero() &&
+		t.Name == ""
+}
+
+// IsZero reports whether every field of the Pet is unset.
+func (t Pet) IsZero() bool {
+	return isZeroValue(t.Address) &&
+		(t.Collar.Color == nil) &&
+		t.Id == nil &&
+		t.Name == "" &&
+		t.Owner.IsZero() &&
+		isZeroValue(t.Price) &&
+		t.Status == "" &&
+		len(t.Tags) == 0
+}
+
+// isZeroValue reports whether v is unset, using its IsZero method when it has
+// one, and comparing it with the zero value of its type otherwise.
+func isZeroValue[T comparable](v T) bool {
+	if z, ok := any(v).(interface{ IsZero() bool }); ok {
+		return z.IsZero()
+	}
+	var zero T
+	return v == zero
+}
@@ -0,0 +1,47 @@
+package iszero
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsZero(t *testing.T) {
+	assert.True(t, Pet{}.IsZero())
+	assert.True(t, Pet{Price: "0"}.IsZero(), "x-go-type's own IsZero is used")
+
+	id := 1
+	color := "red"
+	for name, pet := range map[string]Pet{
+		"pointer": {Id: &id},
+		"scalar":  {Name: "Fido"},
+		"slice":   {Tags: []string{"good"}},
+		"enum":    {Status: Sold},
+		"nested":  {Owner: Owner{Birthday: openapi_types.Date{Time: time.Now()}}},
+		"inline struct": {Collar: struct {
+			Color *string `json:"color,omitempty"`
+		}{Color: &color}},
+		"x-go-type":  {Price: "1"},
+		"comparable": {Address: netip.MustParseAddr("127.0.0.1")},
+	} {
+		assert.False(t, pet.IsZero(), name)
+	}
+}
+
+func TestIsZeroAdditionalProperties(t *testing.T) {
+	var labels Labels
+	assert.True(t, labels.IsZero())
+	labels.Set("color", "red")
+	assert.False(t, labels.IsZero())
+}
+
+func TestIsZeroUnion(t *testing.T) {
+	var animal Animal
+	assert.True(t, animal.IsZero())
+	require.NoError(t, animal.FromOwner(Owner{Name: "Alice"}))
+	assert.False(t, animal.IsZero())
+}
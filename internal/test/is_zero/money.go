@@ -0,0 +1,9 @@
+package iszero
+
+// Money is substituted for the price of a Pet with x-go-type. Its amount is
+// held as a string, where "0" is as unset as "".
+type Money string
+
+func (m Money) IsZero() bool {
+	return m == "" || m == "0"
+}
@@ -57,27 +57,37 @@ type GinServerOptions struct {
 	ErrorHandler func(*gin.Context, error, int)
 }
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
-
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandler, defaulting to a JSON object with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options GinServerOptions) *ServerInterfaceWrapper {
 	errorHandler := options.ErrorHandler
 	if errorHandler == nil {
 		errorHandler = func(c *gin.Context, err error, statusCode int) {
 			c.JSON(statusCode, gin.H{"msg": err.Error()})
 		}
 	}
-
-	wrapper := ServerInterfaceWrapper{
+	return &ServerInterfaceWrapper{
 		Handler:            si,
 		HandlerMiddlewares: options.Middlewares,
 		ErrorHandler:       errorHandler,
 	}
+}
+
+// NewGetPetsHandler returns the handler RegisterHandlersWithOptions routes the
+// GetPets operation to, so that it can be mounted on its own.
+func NewGetPetsHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).GetPets
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
 
-	router.GET(options.BaseURL+"/pets", wrapper.GetPets)
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	router.GET(options.BaseURL+"/pets", NewGetPetsHandler(si, options))
 }
 
 type GetPetsRequestObject struct {
@@ -126,6 +136,7 @@ func (sh *strictHandler) GetPets(ctx *gin.Context) {
 		handler = middleware(handler, "GetPets")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "GetPets")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -140,6 +151,49 @@ func (sh *strictHandler) GetPets(ctx *gin.Context) {
 	}
 }
 
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// GetPetsHandler handles the GetPets operation with its typed request and response objects.
+type GetPetsHandler func(ctx context.Context, request GetPetsRequestObject) (GetPetsResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnGetPets func(next GetPetsHandler) GetPetsHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) GetPets(ctx context.Context, request GetPetsRequestObject) (GetPetsResponseObject, error) {
+	handler := GetPetsHandler(s.ssi.GetPets)
+	if s.middlewares.OnGetPets != nil {
+		handler = s.middlewares.OnGetPets(handler)
+	}
+	return handler(ctx, request)
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
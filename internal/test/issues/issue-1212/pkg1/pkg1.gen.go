@@ -8,6 +8,8 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime"
@@ -15,6 +17,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"reflect"
 	"strings"
 
 	externalRef0 "github.com/deepmap/oapi-codegen/v2/internal/test/issues/issue-1212/pkg2"
@@ -46,8 +49,12 @@ type Client struct {
 	Client HttpRequestDoer
 
 	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
+	// the network. They're applied in order, before the ones given to the call.
 	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -94,6 +101,84 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// Test request
@@ -106,7 +191,7 @@ func (c *Client) Test(ctx context.Context, reqEditors ...RequestEditorFn) (*http
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "Test", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -139,15 +224,24 @@ func NewTestRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
 		}
 	}
-	for _, r := range additionalEditors {
+	for i, r := range additionalEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
 		}
 	}
 	return nil
@@ -180,15 +274,101 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// TestWithResponse request
 	TestWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TestResponse, error)
 }
 
+// TestWithResponse request returning *TestResponse
+func (c *ClientWithResponses) TestWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TestResponse, error) {
+	rsp, err := c.Test(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseTestResponseWithoutBody(rsp)
+	}
+	return ParseTestResponse(rsp)
+}
+
+// parseTestResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseTestResponseWithoutBody(rsp *http.Response) (*TestResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &TestResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// TestResponse is the response of Test. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type TestResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	Body                    []byte
+	HTTPResponse            *http.Response
+	BodyMultipartRelated200 []byte
+	MultipartRelated200     *struct {
+		JsonBar *externalRef0.Bar `json:"jsonBar,omitempty"`
+		JsonFoo *externalRef0.Foo `json:"jsonFoo,omitempty"`
+	}
 }
 
 // Status returns HTTPResponse.Status
@@ -207,19 +387,12 @@ func (r TestResponse) StatusCode() int {
 	return 0
 }
 
-// TestWithResponse request returning *TestResponse
-func (c *ClientWithResponses) TestWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TestResponse, error) {
-	rsp, err := c.Test(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseTestResponse(rsp)
-}
-
-// ParseTestResponse parses an HTTP response from a TestWithResponse call
+// ParseTestResponse parses an HTTP response from a TestWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseTestResponse(rsp *http.Response) (*TestResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -229,9 +402,95 @@ func ParseTestResponse(rsp *http.Response) (*TestResponse, error) {
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "multipart/related") && rsp.StatusCode == 200:
+		response.BodyMultipartRelated200 = bodyBytes
+		var dest struct {
+			JsonBar *externalRef0.Bar `json:"jsonBar,omitempty"`
+			JsonFoo *externalRef0.Foo `json:"jsonFoo,omitempty"`
+		}
+		if err := readMultipartParts(rsp.Header.Get("Content-Type"), bodyBytes, []multipartPart{{Name: "jsonBar", Kind: multipartJSON, ContentType: "application/json"}, {Name: "jsonFoo", Kind: multipartJSON, ContentType: "application/json"}}, &dest); err != nil {
+			return nil, err
+		}
+		response.MultipartRelated200 = &dest
+
+	}
+
 	return response, nil
 }
 
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "Test":
+		return ParseTestResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
+
+// responseHasContentType reports whether contentType, the Content-Type of a
+// response, has the media type of expected, which may be a wildcard, eg,
+// image/*, and at least its parameters.
+func responseHasContentType(contentType string, expected string) bool {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	expectedMediaType, expectedParams, err := mime.ParseMediaType(expected)
+	if err != nil {
+		return false
+	}
+	if expectedMediaType != "*/*" && expectedMediaType != mediaType &&
+		!(strings.HasSuffix(expectedMediaType, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(expectedMediaType, "*"))) {
+		return false
+	}
+	for name, value := range expectedParams {
+		if params[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -268,27 +527,37 @@ type GinServerOptions struct {
 	ErrorHandler func(*gin.Context, error, int)
 }
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
-
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandler, defaulting to a JSON object with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options GinServerOptions) *ServerInterfaceWrapper {
 	errorHandler := options.ErrorHandler
 	if errorHandler == nil {
 		errorHandler = func(c *gin.Context, err error, statusCode int) {
 			c.JSON(statusCode, gin.H{"msg": err.Error()})
 		}
 	}
-
-	wrapper := ServerInterfaceWrapper{
+	return &ServerInterfaceWrapper{
 		Handler:            si,
 		HandlerMiddlewares: options.Middlewares,
 		ErrorHandler:       errorHandler,
 	}
+}
 
-	router.GET(options.BaseURL+"/test", wrapper.Test)
+// NewTestHandler returns the handler RegisterHandlersWithOptions routes the
+// Test operation to, so that it can be mounted on its own.
+func NewTestHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).Test
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	router.GET(options.BaseURL+"/test", NewTestHandler(si, options))
 }
 
 type TestRequestObject struct {
@@ -339,6 +608,7 @@ func (sh *strictHandler) Test(ctx *gin.Context) {
 		handler = middleware(handler, "Test")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "Test")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -353,6 +623,247 @@ func (sh *strictHandler) Test(ctx *gin.Context) {
 	}
 }
 
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// TestHandler handles the Test operation with its typed request and response objects.
+type TestHandler func(ctx context.Context, request TestRequestObject) (TestResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnTest func(next TestHandler) TestHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) Test(ctx context.Context, request TestRequestObject) (TestResponseObject, error) {
+	handler := TestHandler(s.ssi.Test)
+	if s.middlewares.OnTest != nil {
+		handler = s.middlewares.OnTest(handler)
+	}
+	return handler(ctx, request)
+}
+
+// multipartPartKind is how the data of a part of a multipart body whose
+// schema is an object is read into its field, and written from it.
+type multipartPartKind int
+
+const (
+	// multipartJSON parts are the JSON of their field, eg, an object, or a
+	// number, which is written as it is in form data.
+	multipartJSON multipartPartKind = iota
+	// multipartText parts are the text of a string field, as it is.
+	multipartText
+	// multipartBinary parts are files, whose fields are set to their data and
+	// their filename, when they're of a type which has one, such as
+	// openapi_types.File, or to their data, when they're []byte.
+	multipartBinary
+)
+
+// multipartPart describes a part of a multipart body whose schema is an
+// object, which is the property of the same name.
+type multipartPart struct {
+	// Name is the name of the part, which is that of its property, and the
+	// JSON name of its field.
+	Name string
+	Kind multipartPartKind
+	// ContentType is the Content-Type the part is written with. When it's
+	// empty, that's application/json, text/plain or application/octet-stream,
+	// as its kind is multipartJSON, multipartText or multipartBinary.
+	ContentType string
+	Required    bool
+}
+
+// MissingMultipartPartError is the error of a multipart body without a part
+// which its schema requires.
+type MissingMultipartPartError struct {
+	Name string
+}
+
+func (e *MissingMultipartPartError) Error() string {
+	return fmt.Sprintf("multipart body is missing the required part %q", e.Name)
+}
+
+// multipartFile is a field holding the data and the filename of a
+// multipartBinary part, such as openapi_types.File.
+type multipartFile interface {
+	InitFromBytes(data []byte, filename string)
+	Reader() (io.ReadCloser, error)
+	Filename() string
+}
+
+// readMultipartParts reads body, a multipart body of the given Content-Type,
+// into dest, a pointer to a struct. The parts are told apart by the name of
+// their Content-Disposition, or else by their Content-ID, and those which
+// aren't any of parts are ignored. It returns a *MissingMultipartPartError
+// when a required part is missing.
+func readMultipartParts(contentType string, body []byte, parts []multipartPart, dest interface{}) error {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("invalid multipart Content-Type %q: %w", contentType, err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") || params["boundary"] == "" {
+		return fmt.Errorf("Content-Type %q isn't multipart with a boundary", contentType)
+	}
+	fields, err := multipartFields(dest)
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]multipartPart, len(parts))
+	for _, part := range parts {
+		byName[part.Name] = part
+	}
+	values := make(map[string]json.RawMessage)
+	read := make(map[string]bool)
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		p, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error reading multipart body: %w", err)
+		}
+		// The name of a part is that of its Content-Disposition, or else its
+		// Content-ID, without its angle brackets.
+		name := strings.TrimSuffix(strings.TrimPrefix(p.Header.Get("Content-ID"), "<"), ">")
+		if _, dispositionParams, err := mime.ParseMediaType(p.Header.Get("Content-Disposition")); err == nil && dispositionParams["name"] != "" {
+			name = dispositionParams["name"]
+		}
+		part, found := byName[name]
+		if !found {
+			continue
+		}
+		if read[name] {
+			return fmt.Errorf("multipart body has more than one part %q", name)
+		}
+		read[name] = true
+		data, err := io.ReadAll(p)
+		if err != nil {
+			return fmt.Errorf("error reading part %q: %w", name, err)
+		}
+
+		switch part.Kind {
+		case multipartBinary:
+			if field, ok := fields[name]; ok && setMultipartFile(field, data, p.FileName()) {
+				continue
+			}
+			values[name], err = json.Marshal(data)
+		case multipartText:
+			values[name], err = json.Marshal(string(data))
+		default:
+			if !json.Valid(data) {
+				return fmt.Errorf("part %q isn't valid JSON", name)
+			}
+			values[name] = data
+		}
+		if err != nil {
+			return fmt.Errorf("error reading part %q: %w", name, err)
+		}
+	}
+
+	for _, part := range parts {
+		if part.Required && !read[part.Name] {
+			return &MissingMultipartPartError{Name: part.Name}
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	object, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(object, dest); err != nil {
+		return fmt.Errorf("error decoding the parts of the multipart body: %w", err)
+	}
+	return nil
+}
+
+// setMultipartFile sets field to data and filename, allocating it when it's a
+// nil pointer, reporting whether it's of a file type, or []byte.
+func setMultipartFile(field reflect.Value, data []byte, filename string) bool {
+	if !field.CanSet() {
+		return false
+	}
+	if field.Kind() == reflect.Pointer {
+		if _, ok := reflect.New(field.Type().Elem()).Interface().(multipartFile); !ok {
+			return false
+		}
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		field.Interface().(multipartFile).InitFromBytes(data, filename)
+		return true
+	}
+	if f, ok := field.Addr().Interface().(multipartFile); ok {
+		f.InitFromBytes(data, filename)
+		return true
+	}
+	if field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Uint8 {
+		field.SetBytes(data)
+		return true
+	}
+	return false
+}
+
+// multipartFields returns the fields of the struct v is, or points to, by their
+// JSON name, which are addressable.
+func multipartFields(v interface{}) (map[string]reflect.Value, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("multipart parts must be the fields of a struct, not of %T", v)
+	}
+	if !value.CanAddr() {
+		// The file fields are only files through their address.
+		addressable := reflect.New(value.Type()).Elem()
+		addressable.Set(value)
+		value = addressable
+	}
+	fields := make(map[string]reflect.Value, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		fields[name] = value.Field(i)
+	}
+	return fields, nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
@@ -8,11 +8,17 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"path"
+	"reflect"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -53,8 +59,12 @@ type Client struct {
 	Client HttpRequestDoer
 
 	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
+	// the network. They're applied in order, before the ones given to the call.
 	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -101,19 +111,106 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
 		}
 	}
-	for _, r := range additionalEditors {
+	for i, r := range additionalEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
 		}
 	}
 	return nil
@@ -146,10 +243,110 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 }
 
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 }
@@ -170,6 +367,23 @@ type GinServerOptions struct {
 	ErrorHandler func(*gin.Context, error, int)
 }
 
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandler, defaulting to a JSON object with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options GinServerOptions) *ServerInterfaceWrapper {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+}
+
 // RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
 func RegisterHandlers(router gin.IRouter, si ServerInterface) {
 	RegisterHandlersWithOptions(router, si, GinServerOptions{})
@@ -177,11 +391,23 @@ func RegisterHandlers(router gin.IRouter, si ServerInterface) {
 
 // RegisterHandlersWithOptions creates http.Handler with additional options
 func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-
 }
 
 type TestMultipartResponse func(writer *multipart.Writer) error
 
+// NewTestMultipartResponseBody returns the body of
+// TestMultipartResponse writing the fields of parts as its
+// parts, each with a Content-Disposition naming it, and the Content-Type of
+// its encoding.
+func NewTestMultipartResponseBody(parts struct {
+	JsonBar *Bar `json:"jsonBar,omitempty"`
+	JsonFoo *Foo `json:"jsonFoo,omitempty"`
+}) func(writer *multipart.Writer) error {
+	return func(writer *multipart.Writer) error {
+		return writeMultipartParts(writer, "multipart/related", parts, []multipartPart{{Name: "jsonBar", Kind: multipartJSON, ContentType: "application/json"}, {Name: "jsonFoo", Kind: multipartJSON, ContentType: "application/json"}})
+	}
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 }
@@ -198,6 +424,233 @@ type strictHandler struct {
 	middlewares []StrictMiddlewareFunc
 }
 
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+// multipartPartKind is how the data of a part of a multipart body whose
+// schema is an object is read into its field, and written from it.
+type multipartPartKind int
+
+const (
+	// multipartJSON parts are the JSON of their field, eg, an object, or a
+	// number, which is written as it is in form data.
+	multipartJSON multipartPartKind = iota
+	// multipartText parts are the text of a string field, as it is.
+	multipartText
+	// multipartBinary parts are files, whose fields are set to their data and
+	// their filename, when they're of a type which has one, such as
+	// openapi_types.File, or to their data, when they're []byte.
+	multipartBinary
+)
+
+// multipartPart describes a part of a multipart body whose schema is an
+// object, which is the property of the same name.
+type multipartPart struct {
+	// Name is the name of the part, which is that of its property, and the
+	// JSON name of its field.
+	Name string
+	Kind multipartPartKind
+	// ContentType is the Content-Type the part is written with. When it's
+	// empty, that's application/json, text/plain or application/octet-stream,
+	// as its kind is multipartJSON, multipartText or multipartBinary.
+	ContentType string
+	Required    bool
+}
+
+// MissingMultipartPartError is the error of a multipart body without a part
+// which its schema requires.
+type MissingMultipartPartError struct {
+	Name string
+}
+
+func (e *MissingMultipartPartError) Error() string {
+	return fmt.Sprintf("multipart body is missing the required part %q", e.Name)
+}
+
+// multipartFile is a field holding the data and the filename of a
+// multipartBinary part, such as openapi_types.File.
+type multipartFile interface {
+	InitFromBytes(data []byte, filename string)
+	Reader() (io.ReadCloser, error)
+	Filename() string
+}
+
+// writeMultipartParts writes v, a struct or a pointer to one, as the parts of
+// writer, the writer of a multipart body of mediaType, eg, multipart/mixed.
+// Each part has a Content-Disposition, of form-data for multipart/form-data
+// and inline otherwise, naming it, and the filename of a multipartBinary part
+// which has one, and the Content-Type of its multipartPart. The parts are
+// written in their order, and those whose field is empty or nil and omitted
+// from its JSON are left out, unless they're required, which is a
+// *MissingMultipartPartError.
+func writeMultipartParts(writer *multipart.Writer, mediaType string, v interface{}, parts []multipartPart) error {
+	fields, err := multipartFields(v)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	disposition := "inline"
+	if mediaType == "multipart/form-data" {
+		disposition = "form-data"
+	}
+	for _, part := range parts {
+		params := map[string]string{"name": part.Name}
+		var body io.Reader
+		if f, ok := multipartFileOf(fields[part.Name]); ok && part.Kind == multipartBinary {
+			reader, err := f.Reader()
+			if err != nil {
+				return fmt.Errorf("error writing part %q: %w", part.Name, err)
+			}
+			defer reader.Close()
+			if filename := f.Filename(); filename != "" {
+				params["filename"] = filename
+			}
+			body = reader
+		} else if value, ok := values[part.Name]; ok && string(value) != "null" {
+			content, err := multipartPartContent(part, value)
+			if err != nil {
+				return fmt.Errorf("error writing part %q: %w", part.Name, err)
+			}
+			body = bytes.NewReader(content)
+		} else if part.Required {
+			return &MissingMultipartPartError{Name: part.Name}
+		} else {
+			continue
+		}
+
+		contentType := part.ContentType
+		switch {
+		case contentType != "":
+		case part.Kind == multipartText:
+			contentType = "text/plain; charset=utf-8"
+		case part.Kind == multipartBinary:
+			contentType = "application/octet-stream"
+		default:
+			contentType = "application/json"
+		}
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", mime.FormatMediaType(disposition, params))
+		header.Set("Content-Type", contentType)
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(w, body); err != nil {
+			return fmt.Errorf("error writing part %q: %w", part.Name, err)
+		}
+	}
+	return nil
+}
+
+// multipartPartContent returns the content of part, whose field's JSON is
+// value.
+func multipartPartContent(part multipartPart, value json.RawMessage) ([]byte, error) {
+	switch part.Kind {
+	case multipartBinary:
+		var data []byte
+		if err := json.Unmarshal(value, &data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	case multipartText:
+		var text string
+		if err := json.Unmarshal(value, &text); err != nil {
+			// The field isn't a string in JSON, eg, a number of a type
+			// the schema's string maps to, whose JSON is its text.
+			return value, nil
+		}
+		return []byte(text), nil
+	}
+	return value, nil
+}
+
+// multipartFileOf returns the file field is, or points to, and whether it's a
+// file.
+func multipartFileOf(field reflect.Value) (multipartFile, bool) {
+	if !field.IsValid() || (field.Kind() == reflect.Pointer && field.IsNil()) {
+		return nil, false
+	}
+	if f, ok := field.Interface().(multipartFile); ok {
+		return f, true
+	}
+	if field.CanAddr() {
+		if f, ok := field.Addr().Interface().(multipartFile); ok {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// multipartFields returns the fields of the struct v is, or points to, by their
+// JSON name, which are addressable.
+func multipartFields(v interface{}) (map[string]reflect.Value, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("multipart parts must be the fields of a struct, not of %T", v)
+	}
+	if !value.CanAddr() {
+		// The file fields are only files through their address.
+		addressable := reflect.New(value.Type()).Elem()
+		addressable.Set(value)
+		value = addressable
+	}
+	fields := make(map[string]reflect.Value, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		fields[name] = value.Field(i)
+	}
+	return fields, nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
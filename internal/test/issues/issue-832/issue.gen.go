@@ -23,6 +23,16 @@ const (
 	Two   Document_Status = "two"
 )
 
+// IsValid reports whether v is one of the values of Document_Status.
+func (v Document_Status) IsValid() bool {
+	switch v {
+	case Four, One, Three, Two:
+		return true
+	default:
+		return false
+	}
+}
+
 // Document defines model for Document.
 type Document struct {
 	Name   *string          `json:"name,omitempty"`
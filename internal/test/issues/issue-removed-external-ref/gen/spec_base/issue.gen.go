@@ -182,29 +182,47 @@ func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string)
 	})
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
-
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		}
 	}
-	wrapper := ServerInterfaceWrapper{
+	return &ServerInterfaceWrapper{
 		Handler:            si,
 		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+		ErrorHandlerFunc:   errorHandlerFunc,
 	}
+}
+
+// NewPostInvalidExtRefTroubleHandler returns the handler HandlerWithOptions routes the
+// PostInvalidExtRefTrouble operation to, so that it can be mounted on its own.
+func NewPostInvalidExtRefTroubleHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).PostInvalidExtRefTrouble
+}
 
+// NewPostNoTroubleHandler returns the handler HandlerWithOptions routes the
+// PostNoTrouble operation to, so that it can be mounted on its own.
+func NewPostNoTroubleHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).PostNoTrouble
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/invalidExtRefTrouble", wrapper.PostInvalidExtRefTrouble)
+		r.Post(options.BaseURL+"/invalidExtRefTrouble", NewPostInvalidExtRefTroubleHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/noTrouble", wrapper.PostNoTrouble)
+		r.Post(options.BaseURL+"/noTrouble", NewPostNoTroubleHandler(si, options))
 	})
 
 	return r
@@ -299,7 +317,7 @@ func (sh *strictHandler) PostInvalidExtRefTrouble(w http.ResponseWriter, r *http
 		handler = middleware(handler, "PostInvalidExtRefTrouble")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "PostInvalidExtRefTrouble"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -323,7 +341,7 @@ func (sh *strictHandler) PostNoTrouble(w http.ResponseWriter, r *http.Request) {
 		handler = middleware(handler, "PostNoTrouble")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "PostNoTrouble"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -335,3 +353,58 @@ func (sh *strictHandler) PostNoTrouble(w http.ResponseWriter, r *http.Request) {
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// PostInvalidExtRefTroubleHandler handles the PostInvalidExtRefTrouble operation with its typed request and response objects.
+type PostInvalidExtRefTroubleHandler func(ctx context.Context, request PostInvalidExtRefTroubleRequestObject) (PostInvalidExtRefTroubleResponseObject, error)
+
+// PostNoTroubleHandler handles the PostNoTrouble operation with its typed request and response objects.
+type PostNoTroubleHandler func(ctx context.Context, request PostNoTroubleRequestObject) (PostNoTroubleResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnPostInvalidExtRefTrouble func(next PostInvalidExtRefTroubleHandler) PostInvalidExtRefTroubleHandler
+	OnPostNoTrouble            func(next PostNoTroubleHandler) PostNoTroubleHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) PostInvalidExtRefTrouble(ctx context.Context, request PostInvalidExtRefTroubleRequestObject) (PostInvalidExtRefTroubleResponseObject, error) {
+	handler := PostInvalidExtRefTroubleHandler(s.ssi.PostInvalidExtRefTrouble)
+	if s.middlewares.OnPostInvalidExtRefTrouble != nil {
+		handler = s.middlewares.OnPostInvalidExtRefTrouble(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) PostNoTrouble(ctx context.Context, request PostNoTroubleRequestObject) (PostNoTroubleResponseObject, error) {
+	handler := PostNoTroubleHandler(s.ssi.PostNoTrouble)
+	if s.middlewares.OnPostNoTrouble != nil {
+		handler = s.middlewares.OnPostNoTrouble(handler)
+	}
+	return handler(ctx, request)
+}
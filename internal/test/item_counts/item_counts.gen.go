@@ -0,0 +1,720 @@
+// Package itemcounts provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package itemcounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// Closed defines model for Closed.
+type Closed struct {
+	A *string `json:"a,omitempty"`
+	B *string `json:"b,omitempty"`
+}
+
+// Labels defines model for Labels.
+type Labels map[string]string
+
+// Patterned defines model for Patterned.
+type Patterned struct {
+	Name                 *string           `json:"name,omitempty"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Name                 string            `json:"name"`
+	Nicknames            *[]string         `json:"nicknames,omitempty"`
+	Scores               *map[string]int   `json:"scores,omitempty"`
+	Tags                 *Tags             `json:"tags,omitempty"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Tags defines model for Tags.
+type Tags = []string
+
+// AddPetsJSONBody defines parameters for AddPets.
+type AddPetsJSONBody = []Pet
+
+// AddPetsParams defines parameters for AddPets.
+type AddPetsParams struct {
+	Ids *[]int `form:"ids,omitempty" json:"ids,omitempty"`
+}
+
+// AddPetsJSONRequestBody defines body for AddPets for application/json ContentType.
+type AddPetsJSONRequestBody = AddPetsJSONBody
+
+// Getter for additional properties for Patterned. Returns the specified
+// element and whether it was found
+func (a Patterned) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Patterned
+func (a *Patterned) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Patterned to handle AdditionalProperties
+func (a *Patterned) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Patterned to handle AdditionalProperties
+func (a Patterned) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Name != nil {
+		object["name"], err = json.Marshal(a.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'name': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for Pet. Returns the specified
+// element and whether it was found
+func (a Pet) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Pet
+func (a *Pet) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Pet to handle AdditionalProperties
+func (a *Pet) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if raw, found := object["nicknames"]; found {
+		err = json.Unmarshal(raw, &a.Nicknames)
+		if err != nil {
+			return fmt.Errorf("error reading 'nicknames': %w", err)
+		}
+		delete(object, "nicknames")
+	}
+
+	if raw, found := object["scores"]; found {
+		err = json.Unmarshal(raw, &a.Scores)
+		if err != nil {
+			return fmt.Errorf("error reading 'scores': %w", err)
+		}
+		delete(object, "scores")
+	}
+
+	if raw, found := object["tags"]; found {
+		err = json.Unmarshal(raw, &a.Tags)
+		if err != nil {
+			return fmt.Errorf("error reading 'tags': %w", err)
+		}
+		delete(object, "tags")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Pet to handle AdditionalProperties
+func (a Pet) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	if a.Nicknames != nil {
+		object["nicknames"], err = json.Marshal(a.Nicknames)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'nicknames': %w", err)
+		}
+	}
+
+	if a.Scores != nil {
+		object["scores"], err = json.Marshal(a.Scores)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'scores': %w", err)
+		}
+	}
+
+	if a.Tags != nil {
+		object["tags"], err = json.Marshal(a.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'tags': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Validate checks the constraints of the spec which the Closed type
+// doesn't enforce by itself.
+func (t Closed) Validate() error {
+	{
+		n := 0
+		if t.A != nil {
+			n++
+		}
+		if t.B != nil {
+			n++
+		}
+		if err := checkCount("Closed", "properties", n, 1, -1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the constraints of the spec which the Patterned type
+// doesn't enforce by itself.
+func (t Patterned) Validate() error {
+	{
+		n := len(t.AdditionalProperties)
+		if t.Name != nil {
+			n++
+		}
+		if err := checkCount("Patterned", "properties", n, 0, 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the constraints of the spec which the Pet type
+// doesn't enforce by itself.
+func (t Pet) Validate() error {
+	{
+		n := 1 + len(t.AdditionalProperties)
+		if t.Nicknames != nil {
+			n++
+		}
+		if t.Scores != nil {
+			n++
+		}
+		if t.Tags != nil {
+			n++
+		}
+		if err := checkCount("Pet", "properties", n, 2, 4); err != nil {
+			return err
+		}
+	}
+	if t.Nicknames != nil {
+		if err := checkCount("nicknames", "items", len(*t.Nicknames), 2, -1); err != nil {
+			return err
+		}
+	}
+	if t.Scores != nil {
+		if err := checkCount("scores", "properties", len(*t.Scores), 0, 2); err != nil {
+			return err
+		}
+	}
+	if t.Tags != nil {
+		if err := checkCount("tags", "items", len(*t.Tags), 1, 3); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the constraints of the spec which the AddPetsParams type
+// doesn't enforce by itself.
+func (t AddPetsParams) Validate() error {
+	if t.Ids != nil {
+		if err := checkCount("ids", "items", len(*t.Ids), 2, 3); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the constraints of the spec which the Labels type
+// doesn't enforce by itself.
+func (t Labels) Validate() error {
+	if err := checkCount("Labels", "properties", len(t), 1, 2); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkCount returns an error when count, the number of items or properties,
+// as told by unit, of the value called name, is below minCount, or above
+// maxCount unless it's negative.
+func checkCount(name string, unit string, count int, minCount int, maxCount int) error {
+	if count < minCount {
+		return fmt.Errorf("%s has %d %s, fewer than the minimum of %d", name, count, unit, minCount)
+	}
+	if maxCount >= 0 && count > maxCount {
+		return fmt.Errorf("%s has %d %s, more than the maximum of %d", name, count, unit, maxCount)
+	}
+	return nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /pets/{owners})
+	AddPets(w http.ResponseWriter, r *http.Request, owners []string, params AddPetsParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (POST /pets/{owners})
+func (_ Unimplemented) AddPets(w http.ResponseWriter, r *http.Request, owners []string, params AddPetsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// AddPets operation middleware
+func (siw *ServerInterfaceWrapper) AddPets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "owners" -------------
+	var owners []string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owners", chi.URLParam(r, "owners"), &owners, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owners", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddPetsParams
+
+	// ------------- Optional query parameter "ids" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ids", r.URL.Query(), &params.Ids)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ids", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddPets(w, r, owners, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   errorHandlerFunc,
+	}
+}
+
+// NewAddPetsHandler returns the handler HandlerWithOptions routes the
+// AddPets operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewAddPetsHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).AddPets
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pets/{owners}", NewAddPetsHandler(si, options))
+	})
+
+	return r
+}
+
+type AddPetsRequestObject struct {
+	Owners []string `json:"owners"`
+	AddPetsParams
+	Body *AddPetsJSONRequestBody
+}
+
+// Params returns the query, header and cookie parameters of the request,
+// which are embedded in it.
+func (r AddPetsRequestObject) Params() AddPetsParams {
+	return r.AddPetsParams
+}
+
+type AddPetsResponseObject interface {
+	VisitAddPetsResponse(w http.ResponseWriter) error
+}
+
+type AddPets204Response struct {
+}
+
+func (response AddPets204Response) VisitAddPetsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (POST /pets/{owners})
+	AddPets(ctx context.Context, request AddPetsRequestObject) (AddPetsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// AddPets operation middleware
+func (sh *strictHandler) AddPets(w http.ResponseWriter, r *http.Request, owners []string, params AddPetsParams) {
+	var request AddPetsRequestObject
+
+	request.Owners = owners
+	request.AddPetsParams = params
+
+	var body AddPetsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	if err := checkAddPetsItemCounts(request); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, err)
+		return
+	}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddPets(ctx, request.(AddPetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddPets")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "AddPets"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddPetsResponseObject); ok {
+		if err := validResponse.VisitAddPetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ItemCountError is the error of a request whose array body or parameter has
+// fewer items than its minItems, or more than its maxItems.
+type ItemCountError struct {
+	Name     string // The name of the parameter, or "body"
+	Count    int
+	MinItems int
+	MaxItems int // -1 when there's no maximum
+}
+
+func (e *ItemCountError) Error() string {
+	if e.Count < e.MinItems {
+		return fmt.Sprintf("%s has %d items, fewer than the minimum of %d", e.Name, e.Count, e.MinItems)
+	}
+	return fmt.Sprintf("%s has %d items, more than the maximum of %d", e.Name, e.Count, e.MaxItems)
+}
+
+// checkItemCount returns an *ItemCountError when count is out of the bounds.
+func checkItemCount(name string, count int, minItems int, maxItems int) error {
+	if count < minItems || (maxItems >= 0 && count > maxItems) {
+		return &ItemCountError{Name: name, Count: count, MinItems: minItems, MaxItems: maxItems}
+	}
+	return nil
+}
+
+// checkAddPetsItemCounts checks the minItems and maxItems of the array
+// parameters and body of a AddPets request.
+func checkAddPetsItemCounts(request AddPetsRequestObject) error {
+	{
+		if err := checkItemCount("owners", len(request.Owners), 0, 2); err != nil {
+			return err
+		}
+	}
+	if request.AddPetsParams.Ids != nil {
+		if err := checkItemCount("ids", len(*request.AddPetsParams.Ids), 2, 3); err != nil {
+			return err
+		}
+	}
+	if request.Body != nil {
+		if err := checkItemCount("body", len(*request.Body), 1, 2); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// AddPetsHandler handles the AddPets operation with its typed request and response objects.
+type AddPetsHandler func(ctx context.Context, request AddPetsRequestObject) (AddPetsResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnAddPets func(next AddPetsHandler) AddPetsHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) AddPets(ctx context.Context, request AddPetsRequestObject) (AddPetsResponseObject, error) {
+	handler := AddPetsHandler(s.ssi.AddPets)
+	if s.middlewares.OnAddPets != nil {
+		handler = s.middlewares.OnAddPets(handler)
+	}
+	return handler(ctx, request)
+}
@@ -0,0 +1,135 @@
+package itemcounts
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	valid := func() Pet {
+		return Pet{
+			Name:                 "Fido",
+			Tags:                 &Tags{"good"},
+			AdditionalProperties: map[string]string{},
+		}
+	}
+	require.NoError(t, valid().Validate())
+
+	pet := valid()
+	pet.Tags = nil
+	assert.EqualError(t, pet.Validate(), "Pet has 1 properties, fewer than the minimum of 2")
+
+	// Additional properties count towards minProperties and maxProperties.
+	pet.AdditionalProperties = map[string]string{"color": "brown"}
+	assert.NoError(t, pet.Validate())
+	pet.Nicknames = &[]string{"a", "b"}
+	pet.Scores = &map[string]int{"speed": 1}
+	pet.AdditionalProperties["size"] = "big"
+	assert.EqualError(t, pet.Validate(), "Pet has 5 properties, more than the maximum of 4")
+
+	pet = valid()
+	pet.Tags = &Tags{}
+	assert.EqualError(t, pet.Validate(), "tags has 0 items, fewer than the minimum of 1")
+	pet.Tags = &Tags{"a", "b", "c", "d"}
+	assert.EqualError(t, pet.Validate(), "tags has 4 items, more than the maximum of 3")
+
+	pet = valid()
+	pet.Nicknames = &[]string{"a"}
+	assert.EqualError(t, pet.Validate(), "nicknames has 1 items, fewer than the minimum of 2")
+
+	pet = valid()
+	pet.Scores = &map[string]int{"a": 1, "b": 2, "c": 3}
+	assert.EqualError(t, pet.Validate(), "scores has 3 properties, more than the maximum of 2")
+
+	assert.NoError(t, Labels{"a": "b"}.Validate())
+	assert.EqualError(t, Labels{}.Validate(), "Labels has 0 properties, fewer than the minimum of 1")
+	assert.EqualError(t, Labels{"a": "", "b": "", "c": ""}.Validate(), "Labels has 3 properties, more than the maximum of 2")
+
+	assert.NoError(t, AddPetsParams{Ids: &[]int{1, 2}}.Validate())
+	assert.EqualError(t, AddPetsParams{Ids: &[]int{1}}.Validate(), "ids has 1 items, fewer than the minimum of 2")
+}
+
+func TestValidateNoAdditionalProperties(t *testing.T) {
+	// Closed has no additional properties, so its maxProperties of 5 always
+	// holds, while its minProperties still counts the properties set.
+	a := "a"
+	assert.NoError(t, Closed{A: &a}.Validate())
+	assert.EqualError(t, Closed{}.Validate(), "Closed has 0 properties, fewer than the minimum of 1")
+}
+
+func TestValidatePatternProperties(t *testing.T) {
+	// patternProperties isn't supported, so the properties it matches end up,
+	// and are counted, among the additional properties.
+	var patterned Patterned
+	require.NoError(t, patterned.UnmarshalJSON([]byte(`{"x-a":"1"}`)))
+	assert.NoError(t, patterned.Validate())
+	require.NoError(t, patterned.UnmarshalJSON([]byte(`{"name":"n","x-a":"1"}`)))
+	assert.EqualError(t, patterned.Validate(), "Patterned has 2 properties, more than the maximum of 1")
+}
+
+type server struct {
+	request *AddPetsRequestObject
+}
+
+func (s *server) AddPets(ctx context.Context, request AddPetsRequestObject) (AddPetsResponseObject, error) {
+	s.request = &request
+	return AddPets204Response{}, nil
+}
+
+func TestStrictItemCounts(t *testing.T) {
+	s := &server{}
+	var requestErr error
+	h := Handler(NewStrictHandlerWithOptions(s, nil, StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			requestErr = err
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+	}))
+
+	post := func(path string, body string) *httptest.ResponseRecorder {
+		s.request, requestErr = nil, nil
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := post("/pets/a,b?ids=1&ids=2", `[{"name":"Fido"}]`)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	require.NotNil(t, s.request)
+	assert.Equal(t, []string{"a", "b"}, s.request.Owners)
+
+	rec = post("/pets/a", `[{"name":"Fido"}]`)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	for _, test := range []struct {
+		path, body string
+		expected   ItemCountError
+	}{
+		{"/pets/a,b,c", `[{"name":"Fido"}]`, ItemCountError{Name: "owners", Count: 3, MinItems: 0, MaxItems: 2}},
+		{"/pets/a?ids=1", `[{"name":"Fido"}]`, ItemCountError{Name: "ids", Count: 1, MinItems: 2, MaxItems: 3}},
+		{"/pets/a?ids=1&ids=2&ids=3&ids=4", `[{"name":"Fido"}]`, ItemCountError{Name: "ids", Count: 4, MinItems: 2, MaxItems: 3}},
+		{"/pets/a", `[]`, ItemCountError{Name: "body", Count: 0, MinItems: 1, MaxItems: 2}},
+		{"/pets/a", `[{"name":"a"},{"name":"b"},{"name":"c"}]`, ItemCountError{Name: "body", Count: 3, MinItems: 1, MaxItems: 2}},
+	} {
+		t.Run(test.path+" "+test.body, func(t *testing.T) {
+			rec := post(test.path, test.body)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Nil(t, s.request)
+			var countErr *ItemCountError
+			require.True(t, errors.As(requestErr, &countErr), "%v", requestErr)
+			assert.Equal(t, test.expected, *countErr)
+		})
+	}
+
+	rec = post("/pets/a", `[]`)
+	assert.Equal(t, "body has 0 items, fewer than the minimum of 1\n", rec.Body.String())
+}
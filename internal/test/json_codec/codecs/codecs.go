@@ -0,0 +1,46 @@
+// Package codecs provides the x-go-json-codec functions of the json_codec
+// test.
+package codecs
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// MarshalEpochMillis encodes t as the number of milliseconds since the epoch.
+func MarshalEpochMillis(t time.Time) ([]byte, error) {
+	return json.Marshal(t.UnixMilli())
+}
+
+// UnmarshalEpochMillis decodes a number of milliseconds since the epoch.
+func UnmarshalEpochMillis(b []byte, t *time.Time) error {
+	var millis int64
+	if err := json.Unmarshal(b, &millis); err != nil {
+		return err
+	}
+	*t = time.UnixMilli(millis).UTC()
+	return nil
+}
+
+// MarshalCommaList encodes items as a string joining them with commas, and an
+// empty list as nothing, so that it is omitted.
+func MarshalCommaList(items []string) ([]byte, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(strings.Join(items, ","))
+}
+
+// UnmarshalCommaList decodes a string of items joined with commas.
+func UnmarshalCommaList(b []byte, items *[]string) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*items = nil
+	if s != "" {
+		*items = strings.Split(s, ",")
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+// Package json_codec provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package json_codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/v2/internal/test/json_codec/codecs"
+)
+
+// Closed defines model for Closed.
+type Closed struct {
+	SeenAt *time.Time `json:"seen_at,omitempty"`
+}
+
+// Event defines model for Event.
+type Event struct {
+	CreatedAt time.Time  `json:"created_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+	Name      string     `json:"name"`
+	Tags      []string   `json:"tags,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// Labelled defines model for Labelled.
+type Labelled struct {
+	Labels               []string          `json:"labels"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Summary defines model for Summary.
+type Summary struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// Getter for additional properties for Labelled. Returns the specified
+// element and whether it was found
+func (a Labelled) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Labelled
+func (a *Labelled) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Labelled to handle AdditionalProperties
+func (a *Labelled) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["labels"]; found {
+		err = codecs.UnmarshalCommaList(raw, &a.Labels)
+		if err != nil {
+			return fmt.Errorf("error reading 'labels': %w", err)
+		}
+		delete(object, "labels")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Labelled to handle AdditionalProperties
+func (a Labelled) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["labels"], err = codecs.MarshalCommaList(a.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'labels': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// UnmarshalJSON decodes Closed, rejecting the fields its schema doesn't
+// define, since it disallows additional properties.
+func (a *Closed) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+
+	var unknownFields []string
+	for fieldName := range object {
+		switch fieldName {
+		case "seen_at":
+			continue
+		}
+		unknownFields = append(unknownFields, fieldName)
+	}
+	if len(unknownFields) != 0 {
+		sort.Strings(unknownFields)
+		return fmt.Errorf("unknown fields in Closed: %s", strings.Join(unknownFields, ", "))
+	}
+
+	return a.unmarshalJSONCodecs(b)
+}
+
+// MarshalJSON encodes Closed, encoding the fields with x-go-json-codec
+// through their marshal function.
+func (a Closed) MarshalJSON() ([]byte, error) {
+	type plain Closed
+	object := struct {
+		plain
+		SeenAt json.RawMessage `json:"seen_at,omitempty"`
+	}{plain: plain(a)}
+
+	var err error
+
+	if a.SeenAt != nil {
+		object.SeenAt, err = marshalJSONCodec(a.SeenAt, codecs.MarshalEpochMillis)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'seen_at': %w", err)
+		}
+	}
+
+	return json.Marshal(object)
+}
+
+// unmarshalJSONCodecs decodes Closed once its fields are checked,
+// decoding the fields with x-go-json-codec through their unmarshal function.
+func (a *Closed) unmarshalJSONCodecs(b []byte) error {
+	type plain Closed
+	object := struct {
+		*plain
+		SeenAt json.RawMessage `json:"seen_at"`
+	}{plain: (*plain)(a)}
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+
+	if object.SeenAt != nil {
+		if err := unmarshalJSONCodec(object.SeenAt, &a.SeenAt, codecs.UnmarshalEpochMillis); err != nil {
+			return fmt.Errorf("error reading 'seen_at': %w", err)
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes Event, encoding the fields with x-go-json-codec
+// through their marshal function.
+func (a Event) MarshalJSON() ([]byte, error) {
+	type plain Event
+	object := struct {
+		plain
+		CreatedAt json.RawMessage `json:"created_at"`
+		DeletedAt json.RawMessage `json:"deleted_at"`
+		Tags      json.RawMessage `json:"tags,omitempty"`
+		UpdatedAt json.RawMessage `json:"updated_at,omitempty"`
+	}{plain: plain(a)}
+
+	var err error
+
+	object.CreatedAt, err = codecs.MarshalEpochMillis(a.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'created_at': %w", err)
+	}
+
+	object.DeletedAt, err = marshalJSONCodec(a.DeletedAt, codecs.MarshalEpochMillis)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'deleted_at': %w", err)
+	}
+
+	object.Tags, err = codecs.MarshalCommaList(a.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'tags': %w", err)
+	}
+
+	if a.UpdatedAt != nil {
+		object.UpdatedAt, err = marshalJSONCodec(a.UpdatedAt, codecs.MarshalEpochMillis)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'updated_at': %w", err)
+		}
+	}
+
+	return json.Marshal(object)
+}
+
+// UnmarshalJSON decodes Event, decoding the fields with x-go-json-codec
+// through their unmarshal function.
+func (a *Event) UnmarshalJSON(b []byte) error {
+	type plain Event
+	object := struct {
+		*plain
+		CreatedAt json.RawMessage `json:"created_at"`
+		DeletedAt json.RawMessage `json:"deleted_at"`
+		Tags      json.RawMessage `json:"tags"`
+		UpdatedAt json.RawMessage `json:"updated_at"`
+	}{plain: (*plain)(a)}
+	if err := json.Unmarshal(b, &object); err != nil {
+		return err
+	}
+
+	if object.CreatedAt != nil {
+		if err := codecs.UnmarshalEpochMillis(object.CreatedAt, &a.CreatedAt); err != nil {
+			return fmt.Errorf("error reading 'created_at': %w", err)
+		}
+	}
+
+	if object.DeletedAt != nil {
+		if err := unmarshalJSONCodec(object.DeletedAt, &a.DeletedAt, codecs.UnmarshalEpochMillis); err != nil {
+			return fmt.Errorf("error reading 'deleted_at': %w", err)
+		}
+	}
+
+	if object.Tags != nil {
+		if err := codecs.UnmarshalCommaList(object.Tags, &a.Tags); err != nil {
+			return fmt.Errorf("error reading 'tags': %w", err)
+		}
+	}
+
+	if object.UpdatedAt != nil {
+		if err := unmarshalJSONCodec(object.UpdatedAt, &a.UpdatedAt, codecs.UnmarshalEpochMillis); err != nil {
+			return fmt.Errorf("error reading 'updated_at': %w", err)
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes Summary, encoding the fields with x-go-json-codec
+// through their marshal function.
+func (a Summary) MarshalJSON() ([]byte, error) {
+	type plain Summary
+	object := struct {
+		plain
+		CreatedAt json.RawMessage `json:"created_at,omitempty"`
+	}{plain: plain(a)}
+
+	var err error
+
+	if a.CreatedAt != nil {
+		object.CreatedAt, err = marshalJSONCodec(a.CreatedAt, codecs.MarshalEpochMillis)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'created_at': %w", err)
+		}
+	}
+
+	return json.Marshal(object)
+}
+
+// marshalJSONCodec encodes the value v points to with marshal, and a nil v as
+// null.
+func marshalJSONCodec[T any](v *T, marshal func(T) ([]byte, error)) ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return marshal(*v)
+}
+
+// unmarshalJSONCodec decodes b with unmarshal into a new value v is set to
+// point to, and null as a nil v.
+func unmarshalJSONCodec[T any](b []byte, v **T, unmarshal func([]byte, *T) error) error {
+	if string(b) == "null" {
+		*v = nil
+		return nil
+	}
+	value := new(T)
+	if err := unmarshal(b, value); err != nil {
+		return err
+	}
+	*v = value
+	return nil
+}
@@ -0,0 +1,94 @@
+package json_codec
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	created = time.Date(2024, 1, 2, 3, 4, 5, 6000000, time.UTC)
+	updated = created.Add(time.Hour)
+)
+
+func TestEventRoundTrip(t *testing.T) {
+	event := Event{
+		Name:      "launch",
+		CreatedAt: created,
+		UpdatedAt: &updated,
+		Tags:      []string{"a", "b"},
+	}
+
+	b, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"launch","created_at":1704164645006,"updated_at":1704168245006,"deleted_at":null,"tags":"a,b"}`, string(b))
+
+	var decoded Event
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, event, decoded)
+}
+
+func TestEventOptionalFields(t *testing.T) {
+	// Unset optional pointers are omitted, unless nullable, and the codec of
+	// the non-pointer tags omits them by returning nothing.
+	b, err := json.Marshal(Event{Name: "launch", CreatedAt: created})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"launch","created_at":1704164645006,"deleted_at":null}`, string(b))
+
+	var decoded Event
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"launch","created_at":1704164645006,"deleted_at":1704164645006}`), &decoded))
+	require.NotNil(t, decoded.DeletedAt)
+	assert.Equal(t, created, *decoded.DeletedAt)
+	assert.Nil(t, decoded.UpdatedAt)
+	assert.Nil(t, decoded.Tags)
+
+	// null sets a pointer back to nil without calling the codec.
+	require.NoError(t, json.Unmarshal([]byte(`{"deleted_at":null}`), &decoded))
+	assert.Nil(t, decoded.DeletedAt)
+	assert.Equal(t, "launch", decoded.Name)
+}
+
+func TestEventCodecError(t *testing.T) {
+	var decoded Event
+	err := json.Unmarshal([]byte(`{"created_at":"yesterday"}`), &decoded)
+	assert.ErrorContains(t, err, "error reading 'created_at'")
+}
+
+func TestClosedUnknownFields(t *testing.T) {
+	var closed Closed
+	require.NoError(t, json.Unmarshal([]byte(`{"seen_at":1704164645006}`), &closed))
+	require.NotNil(t, closed.SeenAt)
+	assert.Equal(t, created, *closed.SeenAt)
+
+	err := json.Unmarshal([]byte(`{"seen_at":1704164645006,"extra":1}`), &closed)
+	assert.EqualError(t, err, "unknown fields in Closed: extra")
+}
+
+func TestLabelledAdditionalProperties(t *testing.T) {
+	labelled := Labelled{Labels: []string{"x", "y"}}
+	labelled.Set("colour", "blue")
+
+	b, err := json.Marshal(labelled)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"labels":"x,y","colour":"blue"}`, string(b))
+
+	var decoded Labelled
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, labelled, decoded)
+}
+
+func TestSummaryMarshalOnly(t *testing.T) {
+	summary := Summary{CreatedAt: &created}
+
+	b, err := json.Marshal(summary)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"created_at":1704164645006}`, string(b))
+
+	// Without an unmarshal function, the field is decoded by encoding/json.
+	var decoded Summary
+	require.NoError(t, json.Unmarshal([]byte(`{"created_at":"2024-01-02T03:04:05.006Z"}`), &decoded))
+	assert.Equal(t, summary, decoded)
+}
@@ -0,0 +1,1386 @@
+// Package multipartresponses provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package multipartresponses
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Document defines model for Document.
+type Document struct {
+	Metadata  Metadata            `json:"metadata"`
+	Payload   openapi_types.File  `json:"payload"`
+	Thumbnail *openapi_types.File `json:"thumbnail,omitempty"`
+}
+
+// Form defines model for Form.
+type Form struct {
+	Age  *int      `json:"age,omitempty"`
+	Name string    `json:"name"`
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// Metadata defines model for Metadata.
+type Metadata struct {
+	Pages *int   `json:"pages,omitempty"`
+	Title string `json:"title"`
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network. They're applied in order, before the ones given to the call.
+	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetDocument request
+	GetDocument(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetForm request
+	GetForm(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetDocument(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDocumentRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "GetDocument", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetForm(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFormRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "GetForm", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetDocumentRequest generates requests for GetDocument
+func NewGetDocumentRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/documents/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFormRequest generates requests for GetForm
+func NewGetFormRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/forms/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
+		}
+	}
+	for i, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetDocumentWithResponse request
+	GetDocumentWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetDocumentResponse, error)
+
+	// GetFormWithResponse request
+	GetFormWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetFormResponse, error)
+}
+
+// GetDocumentWithResponse request returning *GetDocumentResponse
+func (c *ClientWithResponses) GetDocumentWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetDocumentResponse, error) {
+	rsp, err := c.GetDocument(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetDocumentResponseWithoutBody(rsp)
+	}
+	return ParseGetDocumentResponse(rsp)
+}
+
+// parseGetDocumentResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetDocumentResponseWithoutBody(rsp *http.Response) (*GetDocumentResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetDocumentResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetFormWithResponse request returning *GetFormResponse
+func (c *ClientWithResponses) GetFormWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetFormResponse, error) {
+	rsp, err := c.GetForm(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetFormResponseWithoutBody(rsp)
+	}
+	return ParseGetFormResponse(rsp)
+}
+
+// parseGetFormResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetFormResponseWithoutBody(rsp *http.Response) (*GetFormResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetFormResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetDocumentResponse is the response of GetDocument. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetDocumentResponse struct {
+	Body                  []byte
+	HTTPResponse          *http.Response
+	BodyMultipartMixed200 []byte
+	MultipartMixed200     *Document
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDocumentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDocumentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetFormResponse is the response of GetForm. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetFormResponse struct {
+	Body                     []byte
+	HTTPResponse             *http.Response
+	BodyMultipartFormData200 []byte
+	MultipartFormData200     *Form
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFormResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFormResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ParseGetDocumentResponse parses an HTTP response from a GetDocumentWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseGetDocumentResponse(rsp *http.Response) (*GetDocumentResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDocumentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "multipart/mixed") && rsp.StatusCode == 200:
+		response.BodyMultipartMixed200 = bodyBytes
+		var dest Document
+		if err := readMultipartParts(rsp.Header.Get("Content-Type"), bodyBytes, []multipartPart{{Name: "metadata", Kind: multipartJSON, ContentType: "application/json", Required: true}, {Name: "payload", Kind: multipartBinary, ContentType: "application/pdf", Required: true}, {Name: "thumbnail", Kind: multipartBinary, ContentType: "application/octet-stream"}}, &dest); err != nil {
+			return nil, err
+		}
+		response.MultipartMixed200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFormResponse parses an HTTP response from a GetFormWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseGetFormResponse(rsp *http.Response) (*GetFormResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFormResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "multipart/form-data") && rsp.StatusCode == 200:
+		response.BodyMultipartFormData200 = bodyBytes
+		var dest Form
+		if err := readMultipartParts(rsp.Header.Get("Content-Type"), bodyBytes, []multipartPart{{Name: "age", Kind: multipartJSON, ContentType: "text/plain; charset=utf-8"}, {Name: "name", Kind: multipartText, ContentType: "text/plain; charset=utf-8", Required: true}, {Name: "tags", Kind: multipartJSON, ContentType: "application/json"}}, &dest); err != nil {
+			return nil, err
+		}
+		response.MultipartFormData200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "GetDocument":
+		return ParseGetDocumentResponse(rsp)
+	case "GetForm":
+		return ParseGetFormResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
+
+// responseHasContentType reports whether contentType, the Content-Type of a
+// response, has the media type of expected, which may be a wildcard, eg,
+// image/*, and at least its parameters.
+func responseHasContentType(contentType string, expected string) bool {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	expectedMediaType, expectedParams, err := mime.ParseMediaType(expected)
+	if err != nil {
+		return false
+	}
+	if expectedMediaType != "*/*" && expectedMediaType != mediaType &&
+		!(strings.HasSuffix(expectedMediaType, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(expectedMediaType, "*"))) {
+		return false
+	}
+	for name, value := range expectedParams {
+		if params[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /documents/{id})
+	GetDocument(w http.ResponseWriter, r *http.Request, id string)
+
+	// (GET /forms/{id})
+	GetForm(w http.ResponseWriter, r *http.Request, id string)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /documents/{id})
+func (_ Unimplemented) GetDocument(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /forms/{id})
+func (_ Unimplemented) GetForm(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetDocument operation middleware
+func (siw *ServerInterfaceWrapper) GetDocument(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDocument(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetForm operation middleware
+func (siw *ServerInterfaceWrapper) GetForm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetForm(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   errorHandlerFunc,
+	}
+}
+
+// NewGetDocumentHandler returns the handler HandlerWithOptions routes the
+// GetDocument operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewGetDocumentHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).GetDocument
+}
+
+// NewGetFormHandler returns the handler HandlerWithOptions routes the
+// GetForm operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewGetFormHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).GetForm
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/documents/{id}", NewGetDocumentHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/forms/{id}", NewGetFormHandler(si, options))
+	})
+
+	return r
+}
+
+type GetDocumentRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetDocumentResponseObject interface {
+	VisitGetDocumentResponse(w http.ResponseWriter) error
+}
+
+type GetDocument200MultipartResponse func(writer *multipart.Writer) error
+
+func (response GetDocument200MultipartResponse) VisitGetDocumentResponse(w http.ResponseWriter) error {
+	writer := multipart.NewWriter(w)
+	w.Header().Set("Content-Type", mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": writer.Boundary()}))
+	w.WriteHeader(200)
+
+	defer writer.Close()
+	return response(writer)
+}
+
+// NewGetDocument200MultipartResponseBody returns the body of GetDocument200MultipartResponse
+// writing the fields of parts as its parts, each with a Content-Disposition
+// naming it, and the Content-Type of its encoding.
+func NewGetDocument200MultipartResponseBody(parts Document) func(writer *multipart.Writer) error {
+	return func(writer *multipart.Writer) error {
+		return writeMultipartParts(writer, "multipart/mixed", parts, []multipartPart{{Name: "metadata", Kind: multipartJSON, ContentType: "application/json", Required: true}, {Name: "payload", Kind: multipartBinary, ContentType: "application/pdf", Required: true}, {Name: "thumbnail", Kind: multipartBinary, ContentType: "application/octet-stream"}})
+	}
+}
+
+type GetFormRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetFormResponseObject interface {
+	VisitGetFormResponse(w http.ResponseWriter) error
+}
+
+type GetForm200MultipartResponse func(writer *multipart.Writer) error
+
+func (response GetForm200MultipartResponse) VisitGetFormResponse(w http.ResponseWriter) error {
+	writer := multipart.NewWriter(w)
+	w.Header().Set("Content-Type", writer.FormDataContentType())
+	w.WriteHeader(200)
+
+	defer writer.Close()
+	return response(writer)
+}
+
+// NewGetForm200MultipartResponseBody returns the body of GetForm200MultipartResponse
+// writing the fields of parts as its parts, each with a Content-Disposition
+// naming it, and the Content-Type of its encoding.
+func NewGetForm200MultipartResponseBody(parts Form) func(writer *multipart.Writer) error {
+	return func(writer *multipart.Writer) error {
+		return writeMultipartParts(writer, "multipart/form-data", parts, []multipartPart{{Name: "age", Kind: multipartJSON, ContentType: "text/plain; charset=utf-8"}, {Name: "name", Kind: multipartText, ContentType: "text/plain; charset=utf-8", Required: true}, {Name: "tags", Kind: multipartJSON, ContentType: "application/json"}})
+	}
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /documents/{id})
+	GetDocument(ctx context.Context, request GetDocumentRequestObject) (GetDocumentResponseObject, error)
+
+	// (GET /forms/{id})
+	GetForm(ctx context.Context, request GetFormRequestObject) (GetFormResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetDocument operation middleware
+func (sh *strictHandler) GetDocument(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetDocumentRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDocument(ctx, request.(GetDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDocument")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "GetDocument"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDocumentResponseObject); ok {
+		if err := validResponse.VisitGetDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetForm operation middleware
+func (sh *strictHandler) GetForm(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetFormRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetForm(ctx, request.(GetFormRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetForm")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "GetForm"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFormResponseObject); ok {
+		if err := validResponse.VisitGetFormResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// GetDocumentHandler handles the GetDocument operation with its typed request and response objects.
+type GetDocumentHandler func(ctx context.Context, request GetDocumentRequestObject) (GetDocumentResponseObject, error)
+
+// GetFormHandler handles the GetForm operation with its typed request and response objects.
+type GetFormHandler func(ctx context.Context, request GetFormRequestObject) (GetFormResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnGetDocument func(next GetDocumentHandler) GetDocumentHandler
+	OnGetForm     func(next GetFormHandler) GetFormHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) GetDocument(ctx context.Context, request GetDocumentRequestObject) (GetDocumentResponseObject, error) {
+	handler := GetDocumentHandler(s.ssi.GetDocument)
+	if s.middlewares.OnGetDocument != nil {
+		handler = s.middlewares.OnGetDocument(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) GetForm(ctx context.Context, request GetFormRequestObject) (GetFormResponseObject, error) {
+	handler := GetFormHandler(s.ssi.GetForm)
+	if s.middlewares.OnGetForm != nil {
+		handler = s.middlewares.OnGetForm(handler)
+	}
+	return handler(ctx, request)
+}
+
+// multipartPartKind is how the data of a part of a multipart body whose
+// schema is an object is read into its field, and written from it.
+type multipartPartKind int
+
+const (
+	// multipartJSON parts are the JSON of their field, eg, an object, or a
+	// number, which is written as it is in form data.
+	multipartJSON multipartPartKind = iota
+	// multipartText parts are the text of a string field, as it is.
+	multipartText
+	// multipartBinary parts are files, whose fields are set to their data and
+	// their filename, when they're of a type which has one, such as
+	// openapi_types.File, or to their data, when they're []byte.
+	multipartBinary
+)
+
+// multipartPart describes a part of a multipart body whose schema is an
+// object, which is the property of the same name.
+type multipartPart struct {
+	// Name is the name of the part, which is that of its property, and the
+	// JSON name of its field.
+	Name string
+	Kind multipartPartKind
+	// ContentType is the Content-Type the part is written with. When it's
+	// empty, that's application/json, text/plain or application/octet-stream,
+	// as its kind is multipartJSON, multipartText or multipartBinary.
+	ContentType string
+	Required    bool
+}
+
+// MissingMultipartPartError is the error of a multipart body without a part
+// which its schema requires.
+type MissingMultipartPartError struct {
+	Name string
+}
+
+func (e *MissingMultipartPartError) Error() string {
+	return fmt.Sprintf("multipart body is missing the required part %q", e.Name)
+}
+
+// multipartFile is a field holding the data and the filename of a
+// multipartBinary part, such as openapi_types.File.
+type multipartFile interface {
+	InitFromBytes(data []byte, filename string)
+	Reader() (io.ReadCloser, error)
+	Filename() string
+}
+
+// readMultipartParts reads body, a multipart body of the given Content-Type,
+// into dest, a pointer to a struct. The parts are told apart by the name of
+// their Content-Disposition, or else by their Content-ID, and those which
+// aren't any of parts are ignored. It returns a *MissingMultipartPartError
+// when a required part is missing.
+func readMultipartParts(contentType string, body []byte, parts []multipartPart, dest interface{}) error {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("invalid multipart Content-Type %q: %w", contentType, err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") || params["boundary"] == "" {
+		return fmt.Errorf("Content-Type %q isn't multipart with a boundary", contentType)
+	}
+	fields, err := multipartFields(dest)
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]multipartPart, len(parts))
+	for _, part := range parts {
+		byName[part.Name] = part
+	}
+	values := make(map[string]json.RawMessage)
+	read := make(map[string]bool)
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		p, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error reading multipart body: %w", err)
+		}
+		// The name of a part is that of its Content-Disposition, or else its
+		// Content-ID, without its angle brackets.
+		name := strings.TrimSuffix(strings.TrimPrefix(p.Header.Get("Content-ID"), "<"), ">")
+		if _, dispositionParams, err := mime.ParseMediaType(p.Header.Get("Content-Disposition")); err == nil && dispositionParams["name"] != "" {
+			name = dispositionParams["name"]
+		}
+		part, found := byName[name]
+		if !found {
+			continue
+		}
+		if read[name] {
+			return fmt.Errorf("multipart body has more than one part %q", name)
+		}
+		read[name] = true
+		data, err := io.ReadAll(p)
+		if err != nil {
+			return fmt.Errorf("error reading part %q: %w", name, err)
+		}
+
+		switch part.Kind {
+		case multipartBinary:
+			if field, ok := fields[name]; ok && setMultipartFile(field, data, p.FileName()) {
+				continue
+			}
+			values[name], err = json.Marshal(data)
+		case multipartText:
+			values[name], err = json.Marshal(string(data))
+		default:
+			if !json.Valid(data) {
+				return fmt.Errorf("part %q isn't valid JSON", name)
+			}
+			values[name] = data
+		}
+		if err != nil {
+			return fmt.Errorf("error reading part %q: %w", name, err)
+		}
+	}
+
+	for _, part := range parts {
+		if part.Required && !read[part.Name] {
+			return &MissingMultipartPartError{Name: part.Name}
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	object, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(object, dest); err != nil {
+		return fmt.Errorf("error decoding the parts of the multipart body: %w", err)
+	}
+	return nil
+}
+
+// setMultipartFile sets field to data and filename, allocating it when it's a
+// nil pointer, reporting whether it's of a file type, or []byte.
+func setMultipartFile(field reflect.Value, data []byte, filename string) bool {
+	if !field.CanSet() {
+		return false
+	}
+	if field.Kind() == reflect.Pointer {
+		if _, ok := reflect.New(field.Type().Elem()).Interface().(multipartFile); !ok {
+			return false
+		}
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		field.Interface().(multipartFile).InitFromBytes(data, filename)
+		return true
+	}
+	if f, ok := field.Addr().Interface().(multipartFile); ok {
+		f.InitFromBytes(data, filename)
+		return true
+	}
+	if field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Uint8 {
+		field.SetBytes(data)
+		return true
+	}
+	return false
+}
+
+// writeMultipartParts writes v, a struct or a pointer to one, as the parts of
+// writer, the writer of a multipart body of mediaType, eg, multipart/mixed.
+// Each part has a Content-Disposition, of form-data for multipart/form-data
+// and inline otherwise, naming it, and the filename of a multipartBinary part
+// which has one, and the Content-Type of its multipartPart. The parts are
+// written in their order, and those whose field is empty or nil and omitted
+// from its JSON are left out, unless they're required, which is a
+// *MissingMultipartPartError.
+func writeMultipartParts(writer *multipart.Writer, mediaType string, v interface{}, parts []multipartPart) error {
+	fields, err := multipartFields(v)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	disposition := "inline"
+	if mediaType == "multipart/form-data" {
+		disposition = "form-data"
+	}
+	for _, part := range parts {
+		params := map[string]string{"name": part.Name}
+		var body io.Reader
+		if f, ok := multipartFileOf(fields[part.Name]); ok && part.Kind == multipartBinary {
+			reader, err := f.Reader()
+			if err != nil {
+				return fmt.Errorf("error writing part %q: %w", part.Name, err)
+			}
+			defer reader.Close()
+			if filename := f.Filename(); filename != "" {
+				params["filename"] = filename
+			}
+			body = reader
+		} else if value, ok := values[part.Name]; ok && string(value) != "null" {
+			content, err := multipartPartContent(part, value)
+			if err != nil {
+				return fmt.Errorf("error writing part %q: %w", part.Name, err)
+			}
+			body = bytes.NewReader(content)
+		} else if part.Required {
+			return &MissingMultipartPartError{Name: part.Name}
+		} else {
+			continue
+		}
+
+		contentType := part.ContentType
+		switch {
+		case contentType != "":
+		case part.Kind == multipartText:
+			contentType = "text/plain; charset=utf-8"
+		case part.Kind == multipartBinary:
+			contentType = "application/octet-stream"
+		default:
+			contentType = "application/json"
+		}
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", mime.FormatMediaType(disposition, params))
+		header.Set("Content-Type", contentType)
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(w, body); err != nil {
+			return fmt.Errorf("error writing part %q: %w", part.Name, err)
+		}
+	}
+	return nil
+}
+
+// multipartPartContent returns the content of part, whose field's JSON is
+// value.
+func multipartPartContent(part multipartPart, value json.RawMessage) ([]byte, error) {
+	switch part.Kind {
+	case multipartBinary:
+		var data []byte
+		if err := json.Unmarshal(value, &data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	case multipartText:
+		var text string
+		if err := json.Unmarshal(value, &text); err != nil {
+			// The field isn't a string in JSON, eg, a number of a type
+			// the schema's string maps to, whose JSON is its text.
+			return value, nil
+		}
+		return []byte(text), nil
+	}
+	return value, nil
+}
+
+// multipartFileOf returns the file field is, or points to, and whether it's a
+// file.
+func multipartFileOf(field reflect.Value) (multipartFile, bool) {
+	if !field.IsValid() || (field.Kind() == reflect.Pointer && field.IsNil()) {
+		return nil, false
+	}
+	if f, ok := field.Interface().(multipartFile); ok {
+		return f, true
+	}
+	if field.CanAddr() {
+		if f, ok := field.Addr().Interface().(multipartFile); ok {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// multipartFields returns the fields of the struct v is, or points to, by their
+// JSON name, which are addressable.
+func multipartFields(v interface{}) (map[string]reflect.Value, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("multipart parts must be the fields of a struct, not of %T", v)
+	}
+	if !value.CanAddr() {
+		// The file fields are only files through their address.
+		addressable := reflect.New(value.Type()).Elem()
+		addressable.Set(value)
+		value = addressable
+	}
+	fields := make(map[string]reflect.Value, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		fields[name] = value.Field(i)
+	}
+	return fields, nil
+}
@@ -0,0 +1,98 @@
+package multipartresponses
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct{}
+
+func (s *server) GetDocument(ctx context.Context, request GetDocumentRequestObject) (GetDocumentResponseObject, error) {
+	pages := 2
+	parts := Document{Metadata: Metadata{Title: request.Id, Pages: &pages}}
+	parts.Payload.InitFromBytes([]byte("%PDF-1.7"), request.Id+".pdf")
+	return GetDocument200MultipartResponse(NewGetDocument200MultipartResponseBody(parts)), nil
+}
+
+func (s *server) GetForm(ctx context.Context, request GetFormRequestObject) (GetFormResponseObject, error) {
+	age := 42
+	tags := []string{"a", "b"}
+	return GetForm200MultipartResponse(NewGetForm200MultipartResponseBody(Form{Name: request.Id, Age: &age, Tags: &tags})), nil
+}
+
+func TestMultipartRoundTrip(t *testing.T) {
+	ts := httptest.NewServer(Handler(NewStrictHandler(&server{}, nil)))
+	defer ts.Close()
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	document, err := client.GetDocumentWithResponse(context.Background(), "report")
+	require.NoError(t, err)
+	require.NotNil(t, document.MultipartMixed200)
+	assert.NotEmpty(t, document.BodyMultipartMixed200)
+	assert.Equal(t, "report", document.MultipartMixed200.Metadata.Title)
+	require.NotNil(t, document.MultipartMixed200.Metadata.Pages)
+	assert.Equal(t, 2, *document.MultipartMixed200.Metadata.Pages)
+	payload, err := document.MultipartMixed200.Payload.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF-1.7", string(payload))
+	assert.Equal(t, "report.pdf", document.MultipartMixed200.Payload.Filename())
+	assert.Nil(t, document.MultipartMixed200.Thumbnail)
+
+	form, err := client.GetFormWithResponse(context.Background(), "form")
+	require.NoError(t, err)
+	require.NotNil(t, form.MultipartFormData200)
+	assert.Equal(t, "form", form.MultipartFormData200.Name)
+	require.NotNil(t, form.MultipartFormData200.Age)
+	assert.Equal(t, 42, *form.MultipartFormData200.Age)
+	require.NotNil(t, form.MultipartFormData200.Tags)
+	assert.Equal(t, []string{"a", "b"}, *form.MultipartFormData200.Tags)
+}
+
+func TestMultipartPartHeaders(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Handler(NewStrictHandler(&server{}, nil)).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/documents/report", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	mediaType, params, err := mime.ParseMediaType(rec.Header().Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+	reader := multipart.NewReader(rec.Body, params["boundary"])
+
+	metadata, err := reader.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, `inline; name=metadata`, metadata.Header.Get("Content-Disposition"))
+	assert.Equal(t, "application/json", metadata.Header.Get("Content-Type"))
+
+	payload, err := reader.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, `inline; filename=report.pdf; name=payload`, payload.Header.Get("Content-Disposition"))
+	assert.Equal(t, "application/pdf", payload.Header.Get("Content-Type"))
+
+	// The thumbnail is left out.
+	_, err = reader.NextPart()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestMultipartMissingPart(t *testing.T) {
+	body := "--b\r\nContent-Disposition: inline; name=metadata\r\nContent-Type: application/json\r\n\r\n{\"title\":\"report\"}\r\n--b--\r\n"
+	rsp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"multipart/mixed; boundary=b"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+	_, err := ParseGetDocumentResponse(rsp)
+	var missing *MissingMultipartPartError
+	require.ErrorAs(t, err, &missing)
+	assert.Equal(t, "payload", missing.Name)
+	assert.EqualError(t, err, `multipart body is missing the required part "payload"`)
+}
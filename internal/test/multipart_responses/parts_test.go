@@ -0,0 +1,126 @@
+package multipartresponses
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testFile is a file like openapi_types.File.
+type testFile struct {
+	data     []byte
+	filename string
+}
+
+func (f *testFile) InitFromBytes(data []byte, filename string) {
+	f.data, f.filename = data, filename
+}
+
+func (f testFile) Reader() (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(f.data)), nil
+}
+
+func (f testFile) Filename() string {
+	return f.filename
+}
+
+func (f testFile) MarshalJSON() ([]byte, error) {
+	return []byte(`"ignored"`), nil
+}
+
+type partsMetadata struct {
+	Title string `json:"title"`
+	Pages int    `json:"pages,omitempty"`
+}
+
+type partsDocument struct {
+	Metadata partsMetadata `json:"metadata"`
+	Name     string        `json:"name"`
+	Count    *int          `json:"count,omitempty"`
+	Payload  testFile      `json:"payload"`
+	Preview  *testFile     `json:"preview,omitempty"`
+	Raw      []byte        `json:"raw,omitempty"`
+}
+
+var documentParts = []multipartPart{
+	{Name: "metadata", Kind: multipartJSON, Required: true},
+	{Name: "name", Kind: multipartText},
+	{Name: "count", Kind: multipartJSON, ContentType: "text/plain"},
+	{Name: "payload", Kind: multipartBinary, ContentType: "application/pdf", Required: true},
+	{Name: "preview", Kind: multipartBinary},
+	{Name: "raw", Kind: multipartBinary},
+}
+
+func TestMultipartPartsRoundTrip(t *testing.T) {
+	count := 3
+	sent := partsDocument{Metadata: partsMetadata{Title: "report", Pages: 2}, Name: "a \"name\"", Count: &count, Raw: []byte{0, 1}}
+	sent.Payload.InitFromBytes([]byte("%PDF-1.7"), "report.pdf")
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	require.NoError(t, writeMultipartParts(writer, "multipart/mixed", sent, documentParts))
+	require.NoError(t, writer.Close())
+	assert.Contains(t, body.String(), "Content-Disposition: inline; filename=report.pdf; name=payload\r\nContent-Type: application/pdf\r\n\r\n%PDF-1.7")
+	assert.Contains(t, body.String(), "Content-Disposition: inline; name=name\r\nContent-Type: text/plain; charset=utf-8\r\n\r\na \"name\"\r\n")
+	assert.Contains(t, body.String(), "Content-Type: text/plain\r\n\r\n3\r\n")
+	assert.NotContains(t, body.String(), "name=preview")
+
+	var received partsDocument
+	require.NoError(t, readMultipartParts("multipart/mixed; boundary="+writer.Boundary(), body.Bytes(), documentParts, &received))
+	assert.Equal(t, sent.Metadata, received.Metadata)
+	assert.Equal(t, sent.Name, received.Name)
+	assert.Equal(t, &count, received.Count)
+	assert.Equal(t, sent.Payload, received.Payload)
+	assert.Nil(t, received.Preview)
+	assert.Equal(t, []byte{0, 1}, received.Raw)
+}
+
+func TestReadMultipartParts(t *testing.T) {
+	body := strings.Join([]string{
+		"--b",
+		"Content-ID: <metadata>",
+		"",
+		`{"title":"report"}`,
+		"--b",
+		`Content-Disposition: attachment; name="preview"; filename="preview.png"`,
+		"",
+		"PNG",
+		"--b",
+		`Content-Disposition: form-data; name="unknown"`,
+		"",
+		"ignored",
+		"--b--",
+	}, "\r\n")
+
+	var d partsDocument
+	err := readMultipartParts("multipart/related; boundary=b", []byte(body), documentParts, &d)
+	var missing *MissingMultipartPartError
+	require.ErrorAs(t, err, &missing)
+	assert.Equal(t, "payload", missing.Name)
+	assert.EqualError(t, err, `multipart body is missing the required part "payload"`)
+
+	optional := append([]multipartPart{}, documentParts...)
+	optional[3].Required = false
+	require.NoError(t, readMultipartParts("multipart/related; boundary=b", []byte(body), optional, &d))
+	assert.Equal(t, "report", d.Metadata.Title)
+	require.NotNil(t, d.Preview)
+	assert.Equal(t, testFile{data: []byte("PNG"), filename: "preview.png"}, *d.Preview)
+
+	invalid := strings.Replace(body, `{"title":"report"}`, `{"title":`, 1)
+	assert.EqualError(t, readMultipartParts("multipart/related; boundary=b", []byte(invalid), optional, &d), `part "metadata" isn't valid JSON`)
+	assert.Error(t, readMultipartParts("multipart/related", []byte(body), optional, &d))
+	assert.Error(t, readMultipartParts("application/json", []byte(body), optional, &d))
+}
+
+func TestWriteMultipartPartsMissingPart(t *testing.T) {
+	parts := []multipartPart{{Name: "count", Kind: multipartJSON, Required: true}}
+	err := writeMultipartParts(multipart.NewWriter(io.Discard), "multipart/form-data", partsDocument{}, parts)
+	var missing *MissingMultipartPartError
+	require.ErrorAs(t, err, &missing)
+	assert.Equal(t, "count", missing.Name)
+}
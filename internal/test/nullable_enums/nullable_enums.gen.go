@@ -0,0 +1,127 @@
+// Package nullableenums provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package nullableenums
+
+// Defines values for Answer.
+const (
+	AnswerEmpty Answer = ""
+	No          Answer = "no"
+	Yes         Answer = "yes"
+)
+
+// IsValid reports whether v is one of the values of Answer.
+func (v Answer) IsValid() bool {
+	switch v {
+	case AnswerEmpty, No, Yes:
+		return true
+	default:
+		return false
+	}
+}
+
+// answerDescriptions maps values of Answer to their descriptions.
+var answerDescriptions = map[Answer]string{
+	AnswerEmpty: "Not answered",
+}
+
+// Description returns the human-readable description of the Answer
+// value, or an empty string if it has none.
+func (v Answer) Description() string {
+	return answerDescriptions[v]
+}
+
+// Defines values for MergedStatus.
+const (
+	MergedStatusActive   MergedStatus = "active"
+	MergedStatusArchived MergedStatus = "archived"
+	MergedStatusInactive MergedStatus = "inactive"
+)
+
+// IsValid reports whether v is one of the values of MergedStatus.
+// Null isn't, being held by a nil *MergedStatus instead.
+func (v MergedStatus) IsValid() bool {
+	switch v {
+	case MergedStatusActive, MergedStatusArchived, MergedStatusInactive:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for Priority.
+const (
+	High   Priority = 3
+	Low    Priority = 1
+	Medium Priority = 2
+)
+
+// IsValid reports whether v is one of the values of Priority.
+// Null isn't, being held by a nil *Priority instead.
+func (v Priority) IsValid() bool {
+	switch v {
+	case High, Low, Medium:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for Status.
+const (
+	StatusActive   Status = "active"
+	StatusInactive Status = "inactive"
+)
+
+// IsValid reports whether v is one of the values of Status.
+// Null isn't, being held by a nil *Status instead.
+func (v Status) IsValid() bool {
+	switch v {
+	case StatusActive, StatusInactive:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SurveyComment.
+const (
+	Bad  SurveyComment = "bad"
+	Good SurveyComment = "good"
+)
+
+// IsValid reports whether v is one of the values of SurveyComment.
+// Null isn't, being held by a nil *SurveyComment instead.
+func (v SurveyComment) IsValid() bool {
+	switch v {
+	case Bad, Good:
+		return true
+	default:
+		return false
+	}
+}
+
+// Answer defines model for Answer.
+type Answer string
+
+// MergedStatus defines model for MergedStatus.
+type MergedStatus string
+
+// Priority defines model for Priority.
+type Priority int
+
+// Status defines model for Status.
+type Status string
+
+// Survey defines model for Survey.
+type Survey struct {
+	Answer   Answer                `json:"answer"`
+	Comment  *SurveyComment        `json:"comment"`
+	Merged   *MergedStatus         `json:"merged"`
+	Priority *Priority             `json:"priority"`
+	Scores   *map[string]*Priority `json:"scores,omitempty"`
+	Status   *Status               `json:"status"`
+}
+
+// SurveyComment defines model for Survey.Comment.
+type SurveyComment string
@@ -0,0 +1,51 @@
+package nullableenums
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullMembers(t *testing.T) {
+	var survey Survey
+	require.NoError(t, json.Unmarshal([]byte(`{"status":null,"priority":null,"answer":"","comment":null,"merged":null,"scores":{"a":null,"b":2}}`), &survey))
+	assert.Nil(t, survey.Status)
+	assert.Nil(t, survey.Priority)
+	assert.Nil(t, survey.Comment)
+	assert.Nil(t, survey.Merged)
+	assert.Nil(t, (*survey.Scores)["a"])
+	assert.Equal(t, Medium, *(*survey.Scores)["b"])
+	assert.Equal(t, AnswerEmpty, survey.Answer)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"status":"active","priority":3,"answer":"yes","merged":"archived"}`), &survey))
+	assert.Equal(t, StatusActive, *survey.Status)
+	assert.Equal(t, High, *survey.Priority)
+	assert.Equal(t, MergedStatusArchived, *survey.Merged)
+
+	buf, err := json.Marshal(Survey{Answer: No})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":null,"priority":null,"answer":"no","comment":null,"merged":null}`, string(buf))
+}
+
+func TestIsValid(t *testing.T) {
+	assert.True(t, StatusActive.IsValid())
+	assert.True(t, StatusInactive.IsValid())
+	// Null is a nil *Status, rather than the empty value.
+	assert.False(t, Status("").IsValid())
+	assert.False(t, Status("unknown").IsValid())
+
+	assert.True(t, Low.IsValid())
+	assert.False(t, Priority(0).IsValid())
+
+	// The empty member is one of the values.
+	assert.True(t, AnswerEmpty.IsValid())
+	assert.True(t, Yes.IsValid())
+	assert.False(t, Answer("maybe").IsValid())
+	assert.Equal(t, "Not answered", AnswerEmpty.Description())
+
+	assert.True(t, MergedStatusArchived.IsValid())
+	assert.True(t, MergedStatusActive.IsValid())
+	assert.False(t, MergedStatus("").IsValid())
+}
@@ -0,0 +1,23 @@
+// Package nullable_union provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package nullable_union
+
+// MaybePet A pet, or null.
+type MaybePet = Pet
+
+// Owner defines model for Owner.
+type Owner struct {
+	Nickname   *string          `json:"nickname"`
+	Pet        *Pet             `json:"pet"`
+	Pets       *[]*Pet          `json:"pets,omitempty"`
+	PetsByName *map[string]*Pet `json:"petsByName,omitempty"`
+
+	// Previous A pet, or null.
+	Previous *MaybePet `json:"previous"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,28 @@
+package nullable_union
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullableUnions(t *testing.T) {
+	var owner Owner
+	require.NoError(t, json.Unmarshal([]byte(`{"pet":null,"nickname":null,"pets":[{"name":"a"},null],"petsByName":{"b":null}}`), &owner))
+	assert.Nil(t, owner.Pet)
+	assert.Nil(t, owner.Nickname)
+	require.NotNil(t, owner.Pets)
+	assert.Equal(t, []*Pet{{Name: "a"}, nil}, *owner.Pets)
+	require.NotNil(t, owner.PetsByName)
+	assert.Equal(t, map[string]*Pet{"b": nil}, *owner.PetsByName)
+
+	buf, err := json.Marshal(Owner{Pet: &Pet{Name: "c"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"pet":{"name":"c"},"nickname":null,"previous":null}`, string(buf))
+
+	// The named nullable schema is the referenced type itself.
+	var previous MaybePet = Pet{Name: "d"}
+	assert.Equal(t, "d", previous.Name)
+}
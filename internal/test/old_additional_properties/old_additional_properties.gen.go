@@ -0,0 +1,259 @@
+// Package oldadditionalproperties provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package oldadditionalproperties
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Bag defines model for Bag.
+type Bag struct {
+	Label                *string                `json:"label,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Name string `json:"name"`
+	// Deprecated: AdditionalProperties holds interface{} values because of the
+	// old-additional-properties-type compatibility option, use Get and Set instead.
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Scores defines model for Scores.
+type Scores struct {
+	Total *int `json:"total,omitempty"`
+	// Deprecated: AdditionalProperties holds interface{} values because of the
+	// old-additional-properties-type compatibility option, use Get and Set instead.
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Toy defines model for Toy.
+type Toy struct {
+	Name string `json:"name"`
+	Size *int   `json:"size,omitempty"`
+}
+
+// Getter for additional properties for Bag. Returns the specified
+// element and whether it was found
+func (a Bag) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Bag
+func (a *Bag) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Bag to handle AdditionalProperties
+func (a *Bag) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["label"]; found {
+		err = json.Unmarshal(raw, &a.Label)
+		if err != nil {
+			return fmt.Errorf("error reading 'label': %w", err)
+		}
+		delete(object, "label")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Bag to handle AdditionalProperties
+func (a Bag) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Label != nil {
+		object["label"], err = json.Marshal(a.Label)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'label': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for Pet. Returns the specified
+// element and whether it was found. Elements of another type, such as
+// decoded ones, are converted to Toy through their JSON encoding,
+// and reported as not found when they can't be.
+func (a Pet) Get(fieldName string) (value Toy, found bool) {
+	element, found := a.AdditionalProperties[fieldName]
+	if !found {
+		return
+	}
+	if value, found = element.(Toy); found {
+		return
+	}
+	var converted Toy
+	if buf, err := json.Marshal(element); err == nil && json.Unmarshal(buf, &converted) == nil {
+		return converted, true
+	}
+	return
+}
+
+// Setter for additional properties for Pet
+func (a *Pet) Set(fieldName string, value Toy) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Pet to handle AdditionalProperties
+func (a *Pet) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Pet to handle AdditionalProperties
+func (a Pet) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for Scores. Returns the specified
+// element and whether it was found. Elements of another type, such as
+// decoded ones, are converted to int through their JSON encoding,
+// and reported as not found when they can't be.
+func (a Scores) Get(fieldName string) (value int, found bool) {
+	element, found := a.AdditionalProperties[fieldName]
+	if !found {
+		return
+	}
+	if value, found = element.(int); found {
+		return
+	}
+	var converted int
+	if buf, err := json.Marshal(element); err == nil && json.Unmarshal(buf, &converted) == nil {
+		return converted, true
+	}
+	return
+}
+
+// Setter for additional properties for Scores
+func (a *Scores) Set(fieldName string, value int) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Scores to handle AdditionalProperties
+func (a *Scores) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["total"]; found {
+		err = json.Unmarshal(raw, &a.Total)
+		if err != nil {
+			return fmt.Errorf("error reading 'total': %w", err)
+		}
+		delete(object, "total")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Scores to handle AdditionalProperties
+func (a Scores) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Total != nil {
+		object["total"], err = json.Marshal(a.Total)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'total': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
@@ -0,0 +1,70 @@
+package oldadditionalproperties
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The old generator held additional properties as interface{} values, which
+// hand-written code depends on.
+var (
+	_ map[string]interface{} = Pet{}.AdditionalProperties
+	_ map[string]interface{} = Scores{}.AdditionalProperties
+	_ map[string]interface{} = Bag{}.AdditionalProperties
+)
+
+func TestRoundTrip(t *testing.T) {
+	const petJSON = `{"name":"Fido","ball":{"name":"ball","size":3},"bone":{"name":"bone","extra":true}}`
+
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(petJSON), &pet))
+	assert.Equal(t, map[string]interface{}{
+		"ball": map[string]interface{}{"name": "ball", "size": float64(3)},
+		"bone": map[string]interface{}{"name": "bone", "extra": true},
+	}, pet.AdditionalProperties)
+
+	buf, err := json.Marshal(pet)
+	require.NoError(t, err)
+	assert.Equal(t, `{"ball":{"name":"ball","size":3},"bone":{"extra":true,"name":"bone"},"name":"Fido"}`, string(buf))
+
+	const scoresJSON = `{"alice":3,"bob":1.5,"total":4}`
+
+	var scores Scores
+	require.NoError(t, json.Unmarshal([]byte(scoresJSON), &scores))
+	assert.Equal(t, map[string]interface{}{"alice": float64(3), "bob": 1.5}, scores.AdditionalProperties)
+
+	buf, err = json.Marshal(scores)
+	require.NoError(t, err)
+	assert.Equal(t, scoresJSON, string(buf))
+}
+
+func TestTypedAccessors(t *testing.T) {
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"Fido","ball":{"name":"ball","size":3},"stick":"long"}`), &pet))
+
+	ball, found := pet.Get("ball")
+	require.True(t, found)
+	size := 3
+	assert.Equal(t, Toy{Name: "ball", Size: &size}, ball)
+
+	_, found = pet.Get("stick")
+	assert.False(t, found)
+	_, found = pet.Get("bone")
+	assert.False(t, found)
+
+	pet.Set("bone", Toy{Name: "bone"})
+	bone, found := pet.Get("bone")
+	require.True(t, found)
+	assert.Equal(t, Toy{Name: "bone"}, bone)
+	assert.Equal(t, Toy{Name: "bone"}, pet.AdditionalProperties["bone"])
+
+	var scores Scores
+	scores.Set("alice", 3)
+	require.NoError(t, json.Unmarshal([]byte(`{"bob":2}`), &scores))
+	bob, found := scores.Get("bob")
+	require.True(t, found)
+	assert.Equal(t, 2, bob)
+}
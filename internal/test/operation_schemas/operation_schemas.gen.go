@@ -0,0 +1,127 @@
+// Package operationschemas provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package operationschemas
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// GetGetNode200JSONResponseSchema returns the JSON Schema of the 200 response
+// of GetNode for application/json, with its references resolved.
+func GetGetNode200JSONResponseSchema() []byte {
+	return []byte(`{"$defs":{"Node":{"properties":{"children":{"items":{"$ref":"#/$defs/Node"},"type":"array"},"name":{"type":"string"}},"required":["name"],"type":"object"}},"properties":{"children":{"items":{"$ref":"#/$defs/Node"},"type":"array"},"name":{"type":"string"}},"required":["name"],"type":"object"}`)
+}
+
+// GetCreatePetJSONRequestSchema returns the JSON Schema of the request body
+// of CreatePet for application/json, with its references resolved.
+func GetCreatePetJSONRequestSchema() []byte {
+	return []byte(`{"properties":{"name":{"minLength":1,"type":"string"},"owner":{"properties":{"name":{"type":"string"},"phone":{"nullable":true,"type":"string"}},"type":"object"},"tags":{"items":{"enum":["good","fluffy"],"type":"string"},"type":"array","uniqueItems":true}},"required":["name"],"type":"object"}`)
+}
+
+// GetCreatePet201JSONResponseSchema returns the JSON Schema of the 201 response
+// of CreatePet for application/json, with its references resolved.
+func GetCreatePet201JSONResponseSchema() []byte {
+	return []byte(`{"allOf":[{"properties":{"name":{"minLength":1,"type":"string"},"owner":{"properties":{"name":{"type":"string"},"phone":{"nullable":true,"type":"string"}},"type":"object"},"tags":{"items":{"enum":["good","fluffy"],"type":"string"},"type":"array","uniqueItems":true}},"required":["name"],"type":"object"},{"properties":{"id":{"format":"int64","type":"integer"}},"required":["id"],"type":"object"}]}`)
+}
+
+// GetCreatePetDefaultJSONResponseSchema returns the JSON Schema of the default response
+// of CreatePet for application/json, with its references resolved.
+func GetCreatePetDefaultJSONResponseSchema() []byte {
+	return []byte(`{"properties":{"message":{"type":"string"}},"required":["message"],"type":"object"}`)
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/6xUvW7bMBB+FeHakYjstuigrS2KIkBRd8gWeGDEk8RAOjLkqYFh8N0LkpaSWmqSwYtB",
+	"i8f7fu4jj1CbwRpCYg/VEXzd4SDT8rtzxsWFdcaiY43p84Deyxbjkg8WoQLPTlMLIQhw+DBqhwqq27lw",
+	"L6ZCc3ePNUMQ8AsffyMvm5McUudB00+kljuotuIcR4B5JEzU3jtsoIJ35ZOK8iSh3KWiIIBlm5prxsG/",
+	"dupGJoQTpHROHkDASPphxOvcgN2I52IT8VWlRuFSZ93pXjmkN9NKbc55BTEb9vIo/stuNxm5PoaF8bYz",
+	"lHZo7Ht512N2Q6zgL7BOA5d9v2ugun1Fbw5IEOfUtIq/jXGDZKhAE3/+BDOaJsY2Tv3MAK1W5O+DgDju",
+	"6ghI4xDrWmMUCGj6sWkOz47MuoIATY1J5miO+mFn0UnWhorp8gj4g85rQ1DB9mpztUmZtUjSaqjgY/ok",
+	"wErukqaSjEJfHrUK8W+bjTJT32sFFfxAThmIx5wckNH55KKOKLEVTGmIap/LzxPK5Nayso/F3hry2eIP",
+	"m03KqCFGyjOzttd1IlPee0NPL8XbchttU+hrpy1nW246LKLqQpIqNPtivhEhVZcW84NkjV9x45tDyRgT",
+	"koWi569GHS5H+xS/f3OULv7CrO3FUGfIpVd1EqwKmy4FKGzk2PPFkPNbv4L9hQqc9kIIfwMAAP//s+Ay",
+	"Zy8GAAA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
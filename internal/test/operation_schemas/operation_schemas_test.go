@@ -0,0 +1,78 @@
+package operationschemas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// inline clears the references of the given schema, which has no cycles, so
+// that it's encoded with the schemas they resolve to.
+func inline(sref *openapi3.SchemaRef) *openapi3.SchemaRef {
+	if sref == nil {
+		return nil
+	}
+	s := *sref.Value
+	inlineList := func(srefs openapi3.SchemaRefs) openapi3.SchemaRefs {
+		var out openapi3.SchemaRefs
+		for _, sref := range srefs {
+			out = append(out, inline(sref))
+		}
+		return out
+	}
+	s.AllOf, s.AnyOf, s.OneOf = inlineList(s.AllOf), inlineList(s.AnyOf), inlineList(s.OneOf)
+	s.Not, s.Items = inline(s.Not), inline(s.Items)
+	s.AdditionalProperties.Schema = inline(s.AdditionalProperties.Schema)
+	if s.Properties != nil {
+		s.Properties = make(openapi3.Schemas, len(sref.Value.Properties))
+		for name, property := range sref.Value.Properties {
+			s.Properties[name] = inline(property)
+		}
+	}
+	return &openapi3.SchemaRef{Value: &s}
+}
+
+func TestSchemasMatchEmbeddedSpec(t *testing.T) {
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+	createPet := swagger.Paths.Find("/pets").Post
+
+	tests := []struct {
+		name   string
+		got    []byte
+		schema *openapi3.SchemaRef
+	}{
+		{"request", GetCreatePetJSONRequestSchema(), createPet.RequestBody.Value.Content["application/json"].Schema},
+		{"201", GetCreatePet201JSONResponseSchema(), createPet.Responses.Value("201").Value.Content["application/json"].Schema},
+		{"default", GetCreatePetDefaultJSONResponseSchema(), createPet.Responses.Default().Value.Content["application/json"].Schema},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			want, err := json.Marshal(inline(test.schema))
+			require.NoError(t, err)
+			assert.JSONEq(t, string(want), string(test.got))
+		})
+	}
+}
+
+func TestCyclesUseDefs(t *testing.T) {
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal(GetGetNode200JSONResponseSchema(), &schema))
+
+	node := map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"name"},
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string"},
+			"children": map[string]interface{}{
+				"type":  "array",
+				"items": map[string]interface{}{"$ref": "#/$defs/Node"},
+			},
+		},
+	}
+	assert.Equal(t, node["properties"], schema["properties"])
+	assert.Equal(t, map[string]interface{}{"Node": node}, schema["$defs"])
+}
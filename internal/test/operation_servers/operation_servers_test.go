@@ -0,0 +1,85 @@
+package operationservers
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingDoer struct {
+	req *http.Request
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.req = req
+	return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+}
+
+func TestOperationServers(t *testing.T) {
+	doer := &recordingDoer{}
+	client, err := NewClient("https://api.example.com/v1", WithHTTPClient(doer))
+	require.NoError(t, err)
+	ctx := context.Background()
+	version := 2
+
+	for _, tc := range []struct {
+		name     string
+		call     func(editors ...RequestEditorFn) (*http.Response, error)
+		editors  []RequestEditorFn
+		expected string
+	}{
+		{
+			name: "client server",
+			call: func(editors ...RequestEditorFn) (*http.Response, error) {
+				return client.ListFiles(ctx, editors...)
+			},
+			expected: "https://api.example.com/v1/files",
+		},
+		{
+			name: "first server, with variables defaulted",
+			call: func(editors ...RequestEditorFn) (*http.Response, error) {
+				return client.UploadFileWithBody(ctx, "application/octet-stream", bytes.NewReader(nil), editors...)
+			},
+			expected: "https://eu.upload.example.com/v1/files",
+		},
+		{
+			name: "relative server",
+			call: func(editors ...RequestEditorFn) (*http.Response, error) {
+				return client.UploadFileWithBody(ctx, "application/octet-stream", bytes.NewReader(nil), editors...)
+			},
+			editors:  []RequestEditorFn{WithRequestServer(UploadFileServer2)},
+			expected: "https://api.example.com/upload/files",
+		},
+		{
+			name: "path server",
+			call: func(editors ...RequestEditorFn) (*http.Response, error) {
+				return client.DownloadFile(ctx, "a b", &DownloadFileParams{Version: &version}, editors...)
+			},
+			expected: "https://download.example.com/files/a%20b?version=2",
+		},
+		{
+			name: "other server",
+			call: func(editors ...RequestEditorFn) (*http.Response, error) {
+				return client.DownloadFile(ctx, "a b", &DownloadFileParams{Version: &version}, editors...)
+			},
+			editors:  []RequestEditorFn{WithRequestServer("https://mirror.example.com/dl")},
+			expected: "https://mirror.example.com/dl/files/a%20b?version=2",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doer.req = nil
+			_, err := tc.call(tc.editors...)
+			require.NoError(t, err)
+			require.NotNil(t, doer.req)
+			assert.Equal(t, tc.expected, doer.req.URL.String())
+			assert.Equal(t, doer.req.URL.Host, doer.req.Host)
+		})
+	}
+
+	_, err = client.ListFiles(ctx, WithRequestServer(UploadFileServer2))
+	assert.EqualError(t, err, "ListFiles: request editor 0 of the call: WithRequestServer is only supported by operations declaring servers")
+}
@@ -0,0 +1,183 @@
+// Package echo provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package echo
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for Status.
+const (
+	Available Status = "available"
+	Sold      Status = "sold"
+)
+
+// IsValid reports whether v is one of the values of Status.
+func (v Status) IsValid() bool {
+	switch v {
+	case Available, Sold:
+		return true
+	default:
+		return false
+	}
+}
+
+// Status defines model for Status.
+type Status string
+
+// FindPetsParams defines parameters for FindPets.
+type FindPetsParams struct {
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// LimitSet reports whether the request carried Limit, which ApplyDefaults otherwise sets to its default.
+	LimitSet bool    `json:"-"`
+	Status   *Status `form:"status,omitempty" json:"status,omitempty"`
+
+	// StatusSet reports whether the request carried Status, which ApplyDefaults otherwise sets to its default.
+	StatusSet bool    `json:"-"`
+	Sort      *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SortSet reports whether the request carried Sort, which ApplyDefaults otherwise sets to its default.
+	SortSet  bool                `json:"-"`
+	Since    *openapi_types.Date `form:"since,omitempty" json:"since,omitempty"`
+	XVerbose *bool               `json:"X-Verbose,omitempty"`
+
+	// XVerboseSet reports whether the request carried XVerbose, which ApplyDefaults otherwise sets to its default.
+	XVerboseSet bool `json:"-"`
+}
+
+// ApplyDefaults sets the optional parameters of FindPetsParams the request
+// left out to their defaults, recording in their Set field whether the
+// request carried them. The servers call it once the parameters are bound.
+func (p *FindPetsParams) ApplyDefaults() {
+	p.LimitSet = p.Limit != nil
+	if p.Limit == nil {
+		value := int32(20)
+		p.Limit = &value
+	}
+	p.StatusSet = p.Status != nil
+	if p.Status == nil {
+		value := Status("available")
+		p.Status = &value
+	}
+	p.SortSet = p.Sort != nil
+	if p.Sort == nil {
+		value := "name"
+		p.Sort = &value
+	}
+	p.XVerboseSet = p.XVerbose != nil
+	if p.XVerbose == nil {
+		value := false
+		p.XVerbose = &value
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /pets)
+	FindPets(ctx echo.Context, params FindPetsParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// FindPets converts echo context to params.
+func (w *ServerInterfaceWrapper) FindPets(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindPetsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Verbose" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Verbose")]; found {
+		var XVerbose bool
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Verbose, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Verbose", valueList[0], &XVerbose, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Verbose: %s", err))
+		}
+
+		params.XVerbose = &XVerbose
+	}
+
+	params.ApplyDefaults()
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FindPets(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// NewFindPetsHandler returns the handler RegisterHandlers routes the
+// FindPets operation to, so that it can be mounted on its own.
+func NewFindPetsHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.FindPets
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	router.GET(baseURL+"/pets", NewFindPetsHandler(si))
+
+}
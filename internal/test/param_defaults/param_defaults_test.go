@@ -0,0 +1,95 @@
+package paramdefaults
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/v2/internal/test/param_defaults/chi"
+	echoapi "github.com/deepmap/oapi-codegen/v2/internal/test/param_defaults/echo"
+)
+
+type chiServer struct {
+	params chi.FindPetsParams
+}
+
+func (s *chiServer) FindPets(w http.ResponseWriter, r *http.Request, params chi.FindPetsParams) {
+	s.params = params
+}
+
+func TestChiAppliesDefaults(t *testing.T) {
+	server := &chiServer{}
+	handler := chi.Handler(server)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/pets", nil))
+	require.NotNil(t, server.params.Limit)
+	assert.Equal(t, int32(20), *server.params.Limit)
+	assert.False(t, server.params.LimitSet)
+	require.NotNil(t, server.params.Status)
+	assert.Equal(t, chi.Status("available"), *server.params.Status)
+	require.NotNil(t, server.params.Sort)
+	assert.Equal(t, "name", *server.params.Sort)
+	require.NotNil(t, server.params.XVerbose)
+	assert.False(t, *server.params.XVerbose)
+	assert.False(t, server.params.XVerboseSet)
+	// Dates have no literal, so they're left out.
+	assert.Nil(t, server.params.Since)
+
+	req := httptest.NewRequest(http.MethodGet, "/pets?limit=20&status=sold", nil)
+	req.Header.Set("X-Verbose", "true")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, int32(20), *server.params.Limit)
+	assert.True(t, server.params.LimitSet)
+	assert.Equal(t, chi.Status("sold"), *server.params.Status)
+	assert.True(t, server.params.StatusSet)
+	assert.False(t, server.params.SortSet)
+	assert.True(t, *server.params.XVerbose)
+	assert.True(t, server.params.XVerboseSet)
+}
+
+type echoServer struct {
+	params echoapi.FindPetsParams
+}
+
+func (s *echoServer) FindPets(ctx echo.Context, params echoapi.FindPetsParams) error {
+	s.params = params
+	return nil
+}
+
+func TestEchoAppliesDefaults(t *testing.T) {
+	server := &echoServer{}
+	e := echo.New()
+	echoapi.RegisterHandlers(e, server)
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/pets?sort=age", nil))
+	require.NotNil(t, server.params.Limit)
+	assert.Equal(t, int32(20), *server.params.Limit)
+	assert.False(t, server.params.LimitSet)
+	assert.Equal(t, "age", *server.params.Sort)
+	assert.True(t, server.params.SortSet)
+}
+
+func TestClientDoesNotSendDefaults(t *testing.T) {
+	req, err := chi.NewFindPetsRequest("http://example.com", &chi.FindPetsParams{})
+	require.NoError(t, err)
+	assert.Empty(t, req.URL.RawQuery)
+	assert.Empty(t, req.Header.Get("X-Verbose"))
+
+	var query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+	}))
+	defer ts.Close()
+	client, err := chi.NewClient(ts.URL)
+	require.NoError(t, err)
+	limit := int32(5)
+	rsp, err := client.FindPets(context.Background(), &chi.FindPetsParams{Limit: &limit})
+	require.NoError(t, err)
+	rsp.Body.Close()
+	assert.Equal(t, "limit=5", query)
+}
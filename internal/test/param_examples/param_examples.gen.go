@@ -0,0 +1,38 @@
+// Package param_examples provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package param_examples
+
+// Defines values for Kind.
+const (
+	Cat Kind = "cat"
+	Dog Kind = "dog"
+)
+
+// IsValid reports whether v is one of the values of Kind.
+func (v Kind) IsValid() bool {
+	switch v {
+	case Cat, Dog:
+		return true
+	default:
+		return false
+	}
+}
+
+// Filter defines model for Filter.
+type Filter struct {
+	Age  *int    `json:"age,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// Kind defines model for Kind.
+type Kind string
+
+// GetPetParams defines parameters for GetPet.
+type GetPetParams struct {
+	Kind             *Kind      `form:"kind,omitempty" json:"kind,omitempty"`
+	Filter           *Filter    `json:"filter,omitempty"`
+	Ids              *[]int     `json:"ids,omitempty"`
+	XRequestSequence *[]float32 `json:"X-Request-Sequence,omitempty"`
+	Session          *string    `form:"session,omitempty" json:"session,omitempty"`
+}
@@ -0,0 +1,118 @@
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package param_examples
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// TestParameterExamples styles the examples of the parameters as the client
+// does and binds them back as the server does, checking that the valid ones
+// bind to the value they were styled from, and that the ones with x-invalid
+// fail to bind.
+func TestParameterExamples(t *testing.T) {
+	t.Run("GetPet/path/id/negative", func(t *testing.T) {
+		testParameterExample[int](t, "path", "simple", false, "id", "-42", false)
+	})
+	t.Run("GetPet/path/id/notANumber", func(t *testing.T) {
+		testParameterExample[int](t, "path", "simple", false, "id", "\"forty-two\"", true)
+	})
+	t.Run("GetPet/path/id/zero", func(t *testing.T) {
+		testParameterExample[int](t, "path", "simple", false, "id", "0", false)
+	})
+	t.Run("GetPet/path/tags/example", func(t *testing.T) {
+		testParameterExample[[]string](t, "path", "label", true, "tags", "[\"cat\",\"a b\"]", false)
+	})
+	t.Run("GetPet/query/kind/dog", func(t *testing.T) {
+		testParameterExample[Kind](t, "query", "form", true, "kind", "\"dog\"", false)
+	})
+	t.Run("GetPet/query/filter/everything", func(t *testing.T) {
+		testParameterExample[Filter](t, "query", "deepObject", true, "filter", "{\"age\":3,\"name\":\"Rex\"}", false)
+	})
+	t.Run("GetPet/query/ids/some", func(t *testing.T) {
+		t.Skip("the runtime doesn't bind query parameters of style pipeDelimited")
+	})
+	t.Run("GetPet/header/X-Request-Sequence/fractions", func(t *testing.T) {
+		testParameterExample[[]float32](t, "header", "simple", false, "X-Request-Sequence", "[0.5,1.25]", false)
+	})
+	t.Run("GetPet/cookie/session/escaped", func(t *testing.T) {
+		testParameterExample[string](t, "cookie", "simple", true, "session", "\"a;b=c d\"", false)
+	})
+}
+
+// testParameterExample round-trips the example, in JSON, of the parameter
+// called name, in the given location, with the given style, into a T. An
+// invalid example is styled as it is rather than as a T, which it may not
+// decode to.
+func testParameterExample[T any](t *testing.T, in, style string, explode bool, name, example string, invalid bool) {
+	t.Helper()
+
+	var value interface{}
+	var want T
+	if invalid {
+		err := json.Unmarshal([]byte(example), &value)
+		if err != nil {
+			t.Fatalf("error decoding the example: %s", err)
+		}
+	} else {
+		err := json.Unmarshal([]byte(example), &want)
+		if err != nil {
+			t.Fatalf("error decoding the example: %s", err)
+		}
+		value = want
+	}
+
+	var got T
+	err := bindParameterExample(in, style, explode, name, value, &got)
+	if invalid {
+		if err == nil {
+			t.Fatalf("the invalid example bound to %#v", got)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("error binding the example: %s", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("the example bound to %#v, want %#v", got, want)
+	}
+}
+
+// bindParameterExample styles value as the client does, and binds it into
+// dest as the server does.
+func bindParameterExample(in, style string, explode bool, name string, value interface{}, dest interface{}) error {
+	switch in {
+	case "path":
+		styled, err := runtime.StyleParamWithLocation(style, explode, name, runtime.ParamLocationPath, value)
+		if err != nil {
+			return err
+		}
+		return runtime.BindStyledParameterWithOptions(style, name, styled, dest, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: explode, Required: true})
+	case "query":
+		styled, err := runtime.StyleParamWithLocation(style, explode, name, runtime.ParamLocationQuery, value)
+		if err != nil {
+			return err
+		}
+		query, err := url.ParseQuery(styled)
+		if err != nil {
+			return err
+		}
+		return runtime.BindQueryParameter(style, explode, true, name, query, dest)
+	case "header":
+		styled, err := runtime.StyleParamWithLocation(style, explode, name, runtime.ParamLocationHeader, value)
+		if err != nil {
+			return err
+		}
+		return runtime.BindStyledParameterWithOptions(style, name, styled, dest, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: explode, Required: true})
+	default:
+		styled, err := runtime.StyleParamWithLocation(style, explode, name, runtime.ParamLocationCookie, value)
+		if err != nil {
+			return err
+		}
+		return runtime.BindStyledParameterWithOptions(style, name, styled, dest, runtime.BindStyledParameterOptions{Explode: explode, Required: true})
+	}
+}
@@ -0,0 +1,54 @@
+package parameternames
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct {
+	id     string
+	params GetThingParams
+}
+
+func (s *server) GetThing(w http.ResponseWriter, r *http.Request, id string, params GetThingParams) {
+	s.id = id
+	s.params = params
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestParametersSharingAName(t *testing.T) {
+	var s server
+	ts := httptest.NewServer(Handler(&s))
+	defer ts.Close()
+
+	var request *http.Request
+	client, err := NewClient(ts.URL, WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		request = req
+		return nil
+	}))
+	require.NoError(t, err)
+
+	idQuery := 3
+	idHeader := "from-header"
+	rsp, err := client.GetThing(context.Background(), "from-path", &GetThingParams{
+		IdQuery:  &idQuery,
+		IdHeader: &idHeader,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rsp.StatusCode)
+
+	assert.Equal(t, "/things/from-path", request.URL.Path)
+	assert.Equal(t, "3", request.URL.Query().Get("id"))
+	assert.Equal(t, "from-header", request.Header.Get("id"))
+
+	assert.Equal(t, "from-path", s.id)
+	require.NotNil(t, s.params.IdQuery)
+	assert.Equal(t, 3, *s.params.IdQuery)
+	require.NotNil(t, s.params.IdHeader)
+	assert.Equal(t, "from-header", *s.params.IdHeader)
+}
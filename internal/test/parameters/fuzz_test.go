@@ -0,0 +1,114 @@
+package parameters
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/oapi-codegen/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The parameter binders are handed strings straight from requests, so they
+// must return an error rather than panic whatever the input.
+
+var styles = []string{"simple", "label", "matrix", "form"}
+
+func FuzzBindStyledParameter(f *testing.F) {
+	for _, seed := range []string{
+		"5", ".5", ";id=5", "id=5",
+		"3,4,5", ".3.4.5", ";ea=3;ea=4", "ea=3,4",
+		"role,admin,firstName,Alex", "role=admin,firstName=Alex", ".role=admin.firstName=Alex", ";role=admin;firstName=Alex",
+		"", ",", "=", "role", "role,", ";", "..", "role=admin,role",
+	} {
+		for i := range styles {
+			f.Add(uint8(i), true, seed)
+			f.Add(uint8(i), false, seed)
+		}
+	}
+	f.Fuzz(func(t *testing.T, style uint8, explode bool, value string) {
+		opts := runtime.BindStyledParameterOptions{
+			ParamLocation: runtime.ParamLocationPath,
+			Explode:       explode,
+			Required:      true,
+		}
+		s := styles[int(style)%len(styles)]
+
+		var primitive int32
+		_ = runtime.BindStyledParameterWithOptions(s, "id", value, &primitive, opts)
+		var array []int32
+		_ = runtime.BindStyledParameterWithOptions(s, "ea", value, &array, opts)
+		var object Object
+		_ = runtime.BindStyledParameterWithOptions(s, "o", value, &object, opts)
+		var complexObject ComplexObject
+		_ = runtime.BindStyledParameterWithOptions(s, "co", value, &complexObject, opts)
+	})
+}
+
+func FuzzBindQueryParameter(f *testing.F) {
+	for _, seed := range []string{
+		"id=5", "ea=3&ea=4", "a=3,4", "role=admin&firstName=Alex", "o=role,admin",
+		"", "&", "=", "%zz", "ea=&ea=", "role=admin&role=user",
+	} {
+		f.Add(true, seed)
+		f.Add(false, seed)
+	}
+	f.Fuzz(func(t *testing.T, explode bool, query string) {
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			return
+		}
+
+		// Optional parameters are bound to pointers, like generated code does.
+		var primitive *int32
+		_ = runtime.BindQueryParameter("form", explode, false, "id", values, &primitive)
+		var array *[]int32
+		_ = runtime.BindQueryParameter("form", explode, false, "ea", values, &array)
+		var object *Object
+		_ = runtime.BindQueryParameter("form", explode, false, "o", values, &object)
+		var requiredArray []int32
+		_ = runtime.BindQueryParameter("form", explode, true, "ea", values, &requiredArray)
+		var complexObject ComplexObject
+		_ = runtime.BindQueryParameter("form", explode, true, "co", values, &complexObject)
+	})
+}
+
+func FuzzDeepObject(f *testing.F) {
+	for _, seed := range []string{
+		"deepObj[Id]=12&deepObj[IsAdmin]=true&deepObj[Object][firstName]=Alex&deepObj[Object][role]=admin",
+		"deepObj[Id]=12", "deepObj[Object]=x", "deepObj[Object][role][x]=y", "deepObj[]=1", "deepObj[=1",
+		"deepObj]=1", "deepObj[Id][0]=1", "deepObj[Id]=1&deepObj[Id]=2", "deepObj",
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, query string) {
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			return
+		}
+
+		var complexObject ComplexObject
+		_ = bindDeepObjectQueryParam(true, "deepObj", values, &complexObject)
+		var object map[string]interface{}
+		_ = bindDeepObjectQueryParam(true, "deepObj", values, &object)
+		var array []Object
+		_ = bindDeepObjectQueryParam(true, "deepObj", values, &array)
+	})
+}
+
+// TestDeepObjectRegressions checks the inputs the runtime panics on, found by
+// FuzzDeepObject, which are errors once bound by the generated code. Whether
+// the runtime panics depends on the order of the keys, which is random.
+func TestDeepObjectRegressions(t *testing.T) {
+	for _, query := range []string{
+		"deepObj[&deepObj[][",
+		"deepObj[a]=1&deepObj[a][b]=2",
+	} {
+		values, err := url.ParseQuery(query)
+		require.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			var object map[string]interface{}
+			assert.Error(t, bindDeepObjectQueryParam(true, "deepObj", values, &object), query)
+		}
+	}
+}
@@ -9,11 +9,15 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
 	"path"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -27,6 +31,16 @@ const (
 	N200 EnumParamsParamsEnumPathParam = 200
 )
 
+// IsValid reports whether v is one of the values of EnumParamsParamsEnumPathParam.
+func (v EnumParamsParamsEnumPathParam) IsValid() bool {
+	switch v {
+	case N100, N200:
+		return true
+	default:
+		return false
+	}
+}
+
 // ComplexObject defines model for ComplexObject.
 type ComplexObject struct {
 	Id      int    `json:"Id"`
@@ -162,8 +176,12 @@ type Client struct {
 	Client HttpRequestDoer
 
 	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
+	// the network. They're applied in order, before the ones given to the call.
 	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -210,6 +228,84 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// GetContentObject request
@@ -282,7 +378,7 @@ func (c *Client) GetContentObject(ctx context.Context, param ComplexObject, reqE
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetContentObject", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -294,7 +390,7 @@ func (c *Client) GetCookie(ctx context.Context, params *GetCookieParams, reqEdit
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetCookie", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -306,7 +402,7 @@ func (c *Client) EnumParams(ctx context.Context, params *EnumParamsParams, reqEd
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "EnumParams", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -318,7 +414,7 @@ func (c *Client) GetHeader(ctx context.Context, params *GetHeaderParams, reqEdit
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetHeader", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -330,7 +426,7 @@ func (c *Client) GetLabelExplodeArray(ctx context.Context, param []int32, reqEdi
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetLabelExplodeArray", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -342,7 +438,7 @@ func (c *Client) GetLabelExplodeObject(ctx context.Context, param Object, reqEdi
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetLabelExplodeObject", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -354,7 +450,7 @@ func (c *Client) GetLabelNoExplodeArray(ctx context.Context, param []int32, reqE
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetLabelNoExplodeArray", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -366,7 +462,7 @@ func (c *Client) GetLabelNoExplodeObject(ctx context.Context, param Object, reqE
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetLabelNoExplodeObject", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -378,7 +474,7 @@ func (c *Client) GetMatrixExplodeArray(ctx context.Context, id []int32, reqEdito
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetMatrixExplodeArray", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -390,7 +486,7 @@ func (c *Client) GetMatrixExplodeObject(ctx context.Context, id Object, reqEdito
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetMatrixExplodeObject", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -402,7 +498,7 @@ func (c *Client) GetMatrixNoExplodeArray(ctx context.Context, id []int32, reqEdi
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetMatrixNoExplodeArray", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -414,7 +510,7 @@ func (c *Client) GetMatrixNoExplodeObject(ctx context.Context, id Object, reqEdi
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetMatrixNoExplodeObject", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -426,7 +522,7 @@ func (c *Client) GetPassThrough(ctx context.Context, param string, reqEditors ..
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetPassThrough", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -438,7 +534,7 @@ func (c *Client) GetDeepObject(ctx context.Context, params *GetDeepObjectParams,
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetDeepObject", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -450,7 +546,7 @@ func (c *Client) GetQueryForm(ctx context.Context, params *GetQueryFormParams, r
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetQueryForm", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -462,7 +558,7 @@ func (c *Client) GetSimpleExplodeArray(ctx context.Context, param []int32, reqEd
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetSimpleExplodeArray", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -474,7 +570,7 @@ func (c *Client) GetSimpleExplodeObject(ctx context.Context, param Object, reqEd
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetSimpleExplodeObject", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -486,7 +582,7 @@ func (c *Client) GetSimpleNoExplodeArray(ctx context.Context, param []int32, req
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetSimpleNoExplodeArray", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -498,7 +594,7 @@ func (c *Client) GetSimpleNoExplodeObject(ctx context.Context, param Object, req
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetSimpleNoExplodeObject", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -510,7 +606,7 @@ func (c *Client) GetSimplePrimitive(ctx context.Context, param int32, reqEditors
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetSimplePrimitive", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -522,7 +618,7 @@ func (c *Client) GetStartingWithNumber(ctx context.Context, n1param string, reqE
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetStartingWithNumber", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -798,6 +894,9 @@ func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request,
 				return nil, err
 			}
 
+			if err = validateHeaderValue("X-Primitive", headerParam0); err != nil {
+				return nil, err
+			}
 			req.Header.Set("X-Primitive", headerParam0)
 		}
 
@@ -809,6 +908,9 @@ func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request,
 				return nil, err
 			}
 
+			if err = validateHeaderValue("X-Primitive-Exploded", headerParam1); err != nil {
+				return nil, err
+			}
 			req.Header.Set("X-Primitive-Exploded", headerParam1)
 		}
 
@@ -820,6 +922,9 @@ func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request,
 				return nil, err
 			}
 
+			if err = validateHeaderValue("X-Array-Exploded", headerParam2); err != nil {
+				return nil, err
+			}
 			req.Header.Set("X-Array-Exploded", headerParam2)
 		}
 
@@ -831,6 +936,9 @@ func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request,
 				return nil, err
 			}
 
+			if err = validateHeaderValue("X-Array", headerParam3); err != nil {
+				return nil, err
+			}
 			req.Header.Set("X-Array", headerParam3)
 		}
 
@@ -842,6 +950,9 @@ func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request,
 				return nil, err
 			}
 
+			if err = validateHeaderValue("X-Object-Exploded", headerParam4); err != nil {
+				return nil, err
+			}
 			req.Header.Set("X-Object-Exploded", headerParam4)
 		}
 
@@ -853,6 +964,9 @@ func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request,
 				return nil, err
 			}
 
+			if err = validateHeaderValue("X-Object", headerParam5); err != nil {
+				return nil, err
+			}
 			req.Header.Set("X-Object", headerParam5)
 		}
 
@@ -866,6 +980,9 @@ func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request,
 			}
 			headerParam6 = string(headerParamBuf6)
 
+			if err = validateHeaderValue("X-Complex-Object", headerParam6); err != nil {
+				return nil, err
+			}
 			req.Header.Set("X-Complex-Object", headerParam6)
 		}
 
@@ -877,6 +994,9 @@ func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request,
 				return nil, err
 			}
 
+			if err = validateHeaderValue("1-Starting-With-Number", headerParam7); err != nil {
+				return nil, err
+			}
 			req.Header.Set("1-Starting-With-Number", headerParam7)
 		}
 
@@ -1254,6 +1374,7 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 	if params != nil {
 		queryValues := queryURL.Query()
+		var formObjectQueryFrags []string
 
 		if params.Ea != nil {
 
@@ -1305,16 +1426,10 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 		if params.O != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "o", runtime.ParamLocationQuery, *params.O); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			if queryFrag, err := styleFormObjectQueryParam("o", *params.O); err != nil {
 				return nil, err
 			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
+				formObjectQueryFrags = append(formObjectQueryFrags, queryFrag)
 			}
 
 		}
@@ -1394,6 +1509,14 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
+		// Form objects are appended as they are, since encoding them again
+		// would unescape the commas within their values.
+		for _, queryFrag := range formObjectQueryFrags {
+			if queryURL.RawQuery != "" {
+				queryURL.RawQuery += "&"
+			}
+			queryURL.RawQuery += queryFrag
+		}
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -1605,15 +1728,77 @@ func NewGetStartingWithNumberRequest(server string, n1param string) (*http.Reque
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
+// validateHeaderValue rejects header parameter values with non-ASCII
+// characters, which HTTP doesn't define an encoding for.
+func validateHeaderValue(name string, value string) error {
+	for i := 0; i < len(value); i++ {
+		if value[i] > 0x7f {
+			return fmt.Errorf("header parameter %s has a non-ASCII value %q", name, value)
+		}
+	}
+	return nil
+}
+
+// styleFormObjectQueryParam styles value, which holds an object, as a
+// non-exploded form query parameter, eg, color=R,100,G,200, escaping the
+// commas within its keys and values.
+func styleFormObjectQueryParam(paramName string, value interface{}) (string, error) {
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling parameter %s: %w", paramName, err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return "", fmt.Errorf("parameter %s isn't an object", paramName)
+	}
+	var parts []string
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("error styling parameter %s: %w", paramName, err)
+		}
+		key := token.(string)
+		var field interface{}
+		if err := decoder.Decode(&field); err != nil {
+			return "", fmt.Errorf("error styling parameter %s: %w", paramName, err)
+		}
+		var fieldValue string
+		switch field := field.(type) {
+		case nil:
+			continue
+		case string:
+			fieldValue = field
+		case json.Number:
+			fieldValue = field.String()
+		case bool:
+			fieldValue = strconv.FormatBool(field)
+		default:
+			return "", fmt.Errorf("property %s of parameter %s isn't a primitive value, which form style doesn't define", key, paramName)
+		}
+		parts = append(parts, url.QueryEscape(key), url.QueryEscape(fieldValue))
+	}
+	return url.QueryEscape(paramName) + "=" + strings.Join(parts, ","), nil
+}
+
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
 		}
 	}
-	for _, r := range additionalEditors {
+	for i, r := range additionalEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
 		}
 	}
 	return nil
@@ -1646,6 +1831,60 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// GetContentObjectWithResponse request
@@ -1712,160 +1951,521 @@ type ClientWithResponsesInterface interface {
 	GetStartingWithNumberWithResponse(ctx context.Context, n1param string, reqEditors ...RequestEditorFn) (*GetStartingWithNumberResponse, error)
 }
 
-type GetContentObjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetContentObjectWithResponse request returning *GetContentObjectResponse
+func (c *ClientWithResponses) GetContentObjectWithResponse(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*GetContentObjectResponse, error) {
+	rsp, err := c.GetContentObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetContentObjectResponseWithoutBody(rsp)
+	}
+	return ParseGetContentObjectResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetContentObjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// parseGetContentObjectResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetContentObjectResponseWithoutBody(rsp *http.Response) (*GetContentObjectResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetContentObjectResponse{
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetContentObjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetCookieWithResponse request returning *GetCookieResponse
+func (c *ClientWithResponses) GetCookieWithResponse(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*GetCookieResponse, error) {
+	rsp, err := c.GetCookie(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	if !parsesResponseBody(rsp) {
+		return parseGetCookieResponseWithoutBody(rsp)
+	}
+	return ParseGetCookieResponse(rsp)
 }
 
-type GetCookieResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// parseGetCookieResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetCookieResponseWithoutBody(rsp *http.Response) (*GetCookieResponse, error) {
+	discardResponseBody(rsp)
 
-// Status returns HTTPResponse.Status
-func (r GetCookieResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetCookieResponse{
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCookieResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EnumParamsWithResponse request returning *EnumParamsResponse
+func (c *ClientWithResponses) EnumParamsWithResponse(ctx context.Context, params *EnumParamsParams, reqEditors ...RequestEditorFn) (*EnumParamsResponse, error) {
+	rsp, err := c.EnumParams(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	if !parsesResponseBody(rsp) {
+		return parseEnumParamsResponseWithoutBody(rsp)
+	}
+	return ParseEnumParamsResponse(rsp)
 }
 
-type EnumParamsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// parseEnumParamsResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseEnumParamsResponseWithoutBody(rsp *http.Response) (*EnumParamsResponse, error) {
+	discardResponseBody(rsp)
 
-// Status returns HTTPResponse.Status
-func (r EnumParamsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &EnumParamsResponse{
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r EnumParamsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetHeaderWithResponse request returning *GetHeaderResponse
+func (c *ClientWithResponses) GetHeaderWithResponse(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*GetHeaderResponse, error) {
+	rsp, err := c.GetHeader(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	if !parsesResponseBody(rsp) {
+		return parseGetHeaderResponseWithoutBody(rsp)
+	}
+	return ParseGetHeaderResponse(rsp)
 }
 
-type GetHeaderResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// parseGetHeaderResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetHeaderResponseWithoutBody(rsp *http.Response) (*GetHeaderResponse, error) {
+	discardResponseBody(rsp)
 
-// Status returns HTTPResponse.Status
-func (r GetHeaderResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetHeaderResponse{
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHeaderResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetLabelExplodeArrayWithResponse request returning *GetLabelExplodeArrayResponse
+func (c *ClientWithResponses) GetLabelExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelExplodeArrayResponse, error) {
+	rsp, err := c.GetLabelExplodeArray(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	if !parsesResponseBody(rsp) {
+		return parseGetLabelExplodeArrayResponseWithoutBody(rsp)
+	}
+	return ParseGetLabelExplodeArrayResponse(rsp)
 }
 
-type GetLabelExplodeArrayResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// parseGetLabelExplodeArrayResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetLabelExplodeArrayResponseWithoutBody(rsp *http.Response) (*GetLabelExplodeArrayResponse, error) {
+	discardResponseBody(rsp)
 
-// Status returns HTTPResponse.Status
-func (r GetLabelExplodeArrayResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetLabelExplodeArrayResponse{
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLabelExplodeArrayResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetLabelExplodeObjectWithResponse request returning *GetLabelExplodeObjectResponse
+func (c *ClientWithResponses) GetLabelExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelExplodeObjectResponse, error) {
+	rsp, err := c.GetLabelExplodeObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	if !parsesResponseBody(rsp) {
+		return parseGetLabelExplodeObjectResponseWithoutBody(rsp)
+	}
+	return ParseGetLabelExplodeObjectResponse(rsp)
 }
 
-type GetLabelExplodeObjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// parseGetLabelExplodeObjectResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetLabelExplodeObjectResponseWithoutBody(rsp *http.Response) (*GetLabelExplodeObjectResponse, error) {
+	discardResponseBody(rsp)
 
-// Status returns HTTPResponse.Status
-func (r GetLabelExplodeObjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetLabelExplodeObjectResponse{
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLabelExplodeObjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetLabelNoExplodeArrayWithResponse request returning *GetLabelNoExplodeArrayResponse
+func (c *ClientWithResponses) GetLabelNoExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeArrayResponse, error) {
+	rsp, err := c.GetLabelNoExplodeArray(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	if !parsesResponseBody(rsp) {
+		return parseGetLabelNoExplodeArrayResponseWithoutBody(rsp)
+	}
+	return ParseGetLabelNoExplodeArrayResponse(rsp)
 }
 
-type GetLabelNoExplodeArrayResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// parseGetLabelNoExplodeArrayResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetLabelNoExplodeArrayResponseWithoutBody(rsp *http.Response) (*GetLabelNoExplodeArrayResponse, error) {
+	discardResponseBody(rsp)
 
-// Status returns HTTPResponse.Status
-func (r GetLabelNoExplodeArrayResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetLabelNoExplodeArrayResponse{
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLabelNoExplodeArrayResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetLabelNoExplodeObjectWithResponse request returning *GetLabelNoExplodeObjectResponse
+func (c *ClientWithResponses) GetLabelNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeObjectResponse, error) {
+	rsp, err := c.GetLabelNoExplodeObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	if !parsesResponseBody(rsp) {
+		return parseGetLabelNoExplodeObjectResponseWithoutBody(rsp)
+	}
+	return ParseGetLabelNoExplodeObjectResponse(rsp)
 }
 
-type GetLabelNoExplodeObjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// parseGetLabelNoExplodeObjectResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetLabelNoExplodeObjectResponseWithoutBody(rsp *http.Response) (*GetLabelNoExplodeObjectResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetLabelNoExplodeObjectResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetMatrixExplodeArrayWithResponse request returning *GetMatrixExplodeArrayResponse
+func (c *ClientWithResponses) GetMatrixExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixExplodeArrayResponse, error) {
+	rsp, err := c.GetMatrixExplodeArray(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetMatrixExplodeArrayResponseWithoutBody(rsp)
+	}
+	return ParseGetMatrixExplodeArrayResponse(rsp)
+}
+
+// parseGetMatrixExplodeArrayResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetMatrixExplodeArrayResponseWithoutBody(rsp *http.Response) (*GetMatrixExplodeArrayResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetMatrixExplodeArrayResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetMatrixExplodeObjectWithResponse request returning *GetMatrixExplodeObjectResponse
+func (c *ClientWithResponses) GetMatrixExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixExplodeObjectResponse, error) {
+	rsp, err := c.GetMatrixExplodeObject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetMatrixExplodeObjectResponseWithoutBody(rsp)
+	}
+	return ParseGetMatrixExplodeObjectResponse(rsp)
+}
+
+// parseGetMatrixExplodeObjectResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetMatrixExplodeObjectResponseWithoutBody(rsp *http.Response) (*GetMatrixExplodeObjectResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetMatrixExplodeObjectResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetMatrixNoExplodeArrayWithResponse request returning *GetMatrixNoExplodeArrayResponse
+func (c *ClientWithResponses) GetMatrixNoExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeArrayResponse, error) {
+	rsp, err := c.GetMatrixNoExplodeArray(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetMatrixNoExplodeArrayResponseWithoutBody(rsp)
+	}
+	return ParseGetMatrixNoExplodeArrayResponse(rsp)
+}
+
+// parseGetMatrixNoExplodeArrayResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetMatrixNoExplodeArrayResponseWithoutBody(rsp *http.Response) (*GetMatrixNoExplodeArrayResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetMatrixNoExplodeArrayResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetMatrixNoExplodeObjectWithResponse request returning *GetMatrixNoExplodeObjectResponse
+func (c *ClientWithResponses) GetMatrixNoExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeObjectResponse, error) {
+	rsp, err := c.GetMatrixNoExplodeObject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetMatrixNoExplodeObjectResponseWithoutBody(rsp)
+	}
+	return ParseGetMatrixNoExplodeObjectResponse(rsp)
+}
+
+// parseGetMatrixNoExplodeObjectResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetMatrixNoExplodeObjectResponseWithoutBody(rsp *http.Response) (*GetMatrixNoExplodeObjectResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetMatrixNoExplodeObjectResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetPassThroughWithResponse request returning *GetPassThroughResponse
+func (c *ClientWithResponses) GetPassThroughWithResponse(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*GetPassThroughResponse, error) {
+	rsp, err := c.GetPassThrough(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetPassThroughResponseWithoutBody(rsp)
+	}
+	return ParseGetPassThroughResponse(rsp)
+}
+
+// parseGetPassThroughResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetPassThroughResponseWithoutBody(rsp *http.Response) (*GetPassThroughResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetPassThroughResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetDeepObjectWithResponse request returning *GetDeepObjectResponse
+func (c *ClientWithResponses) GetDeepObjectWithResponse(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*GetDeepObjectResponse, error) {
+	rsp, err := c.GetDeepObject(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetDeepObjectResponseWithoutBody(rsp)
+	}
+	return ParseGetDeepObjectResponse(rsp)
+}
+
+// parseGetDeepObjectResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetDeepObjectResponseWithoutBody(rsp *http.Response) (*GetDeepObjectResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetDeepObjectResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetQueryFormWithResponse request returning *GetQueryFormResponse
+func (c *ClientWithResponses) GetQueryFormWithResponse(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*GetQueryFormResponse, error) {
+	rsp, err := c.GetQueryForm(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetQueryFormResponseWithoutBody(rsp)
+	}
+	return ParseGetQueryFormResponse(rsp)
+}
+
+// parseGetQueryFormResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetQueryFormResponseWithoutBody(rsp *http.Response) (*GetQueryFormResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetQueryFormResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetSimpleExplodeArrayWithResponse request returning *GetSimpleExplodeArrayResponse
+func (c *ClientWithResponses) GetSimpleExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleExplodeArrayResponse, error) {
+	rsp, err := c.GetSimpleExplodeArray(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetSimpleExplodeArrayResponseWithoutBody(rsp)
+	}
+	return ParseGetSimpleExplodeArrayResponse(rsp)
+}
+
+// parseGetSimpleExplodeArrayResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetSimpleExplodeArrayResponseWithoutBody(rsp *http.Response) (*GetSimpleExplodeArrayResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetSimpleExplodeArrayResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetSimpleExplodeObjectWithResponse request returning *GetSimpleExplodeObjectResponse
+func (c *ClientWithResponses) GetSimpleExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleExplodeObjectResponse, error) {
+	rsp, err := c.GetSimpleExplodeObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetSimpleExplodeObjectResponseWithoutBody(rsp)
+	}
+	return ParseGetSimpleExplodeObjectResponse(rsp)
+}
+
+// parseGetSimpleExplodeObjectResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetSimpleExplodeObjectResponseWithoutBody(rsp *http.Response) (*GetSimpleExplodeObjectResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetSimpleExplodeObjectResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetSimpleNoExplodeArrayWithResponse request returning *GetSimpleNoExplodeArrayResponse
+func (c *ClientWithResponses) GetSimpleNoExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeArrayResponse, error) {
+	rsp, err := c.GetSimpleNoExplodeArray(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetSimpleNoExplodeArrayResponseWithoutBody(rsp)
+	}
+	return ParseGetSimpleNoExplodeArrayResponse(rsp)
+}
+
+// parseGetSimpleNoExplodeArrayResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetSimpleNoExplodeArrayResponseWithoutBody(rsp *http.Response) (*GetSimpleNoExplodeArrayResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetSimpleNoExplodeArrayResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetSimpleNoExplodeObjectWithResponse request returning *GetSimpleNoExplodeObjectResponse
+func (c *ClientWithResponses) GetSimpleNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeObjectResponse, error) {
+	rsp, err := c.GetSimpleNoExplodeObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetSimpleNoExplodeObjectResponseWithoutBody(rsp)
+	}
+	return ParseGetSimpleNoExplodeObjectResponse(rsp)
+}
+
+// parseGetSimpleNoExplodeObjectResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetSimpleNoExplodeObjectResponseWithoutBody(rsp *http.Response) (*GetSimpleNoExplodeObjectResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetSimpleNoExplodeObjectResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetSimplePrimitiveWithResponse request returning *GetSimplePrimitiveResponse
+func (c *ClientWithResponses) GetSimplePrimitiveWithResponse(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*GetSimplePrimitiveResponse, error) {
+	rsp, err := c.GetSimplePrimitive(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetSimplePrimitiveResponseWithoutBody(rsp)
+	}
+	return ParseGetSimplePrimitiveResponse(rsp)
+}
+
+// parseGetSimplePrimitiveResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetSimplePrimitiveResponseWithoutBody(rsp *http.Response) (*GetSimplePrimitiveResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetSimplePrimitiveResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetStartingWithNumberWithResponse request returning *GetStartingWithNumberResponse
+func (c *ClientWithResponses) GetStartingWithNumberWithResponse(ctx context.Context, n1param string, reqEditors ...RequestEditorFn) (*GetStartingWithNumberResponse, error) {
+	rsp, err := c.GetStartingWithNumber(ctx, n1param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetStartingWithNumberResponseWithoutBody(rsp)
+	}
+	return ParseGetStartingWithNumberResponse(rsp)
+}
+
+// parseGetStartingWithNumberResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetStartingWithNumberResponseWithoutBody(rsp *http.Response) (*GetStartingWithNumberResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &GetStartingWithNumberResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetContentObjectResponse is the response of GetContentObject. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetContentObjectResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLabelNoExplodeObjectResponse) Status() string {
+func (r GetContentObjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1873,20 +2473,24 @@ func (r GetLabelNoExplodeObjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLabelNoExplodeObjectResponse) StatusCode() int {
+func (r GetContentObjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetMatrixExplodeArrayResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetCookieResponse is the response of GetCookie. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetCookieResponse struct {
+	Body                 []byte
+	HTTPResponse         *http.Response
+	BodyTextPlainDefault []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetMatrixExplodeArrayResponse) Status() string {
+func (r GetCookieResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1894,20 +2498,23 @@ func (r GetMatrixExplodeArrayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetMatrixExplodeArrayResponse) StatusCode() int {
+func (r GetCookieResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetMatrixExplodeObjectResponse struct {
+// EnumParamsResponse is the response of EnumParams. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type EnumParamsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r GetMatrixExplodeObjectResponse) Status() string {
+func (r EnumParamsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1915,20 +2522,24 @@ func (r GetMatrixExplodeObjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetMatrixExplodeObjectResponse) StatusCode() int {
+func (r EnumParamsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetMatrixNoExplodeArrayResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetHeaderResponse is the response of GetHeader. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetHeaderResponse struct {
+	Body                 []byte
+	HTTPResponse         *http.Response
+	BodyTextPlainDefault []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetMatrixNoExplodeArrayResponse) Status() string {
+func (r GetHeaderResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1936,20 +2547,24 @@ func (r GetMatrixNoExplodeArrayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetMatrixNoExplodeArrayResponse) StatusCode() int {
+func (r GetHeaderResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetMatrixNoExplodeObjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetLabelExplodeArrayResponse is the response of GetLabelExplodeArray. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetLabelExplodeArrayResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetMatrixNoExplodeObjectResponse) Status() string {
+func (r GetLabelExplodeArrayResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1957,20 +2572,24 @@ func (r GetMatrixNoExplodeObjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetMatrixNoExplodeObjectResponse) StatusCode() int {
+func (r GetLabelExplodeArrayResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetPassThroughResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetLabelExplodeObjectResponse is the response of GetLabelExplodeObject. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetLabelExplodeObjectResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetPassThroughResponse) Status() string {
+func (r GetLabelExplodeObjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1978,20 +2597,24 @@ func (r GetPassThroughResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetPassThroughResponse) StatusCode() int {
+func (r GetLabelExplodeObjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetDeepObjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetLabelNoExplodeArrayResponse is the response of GetLabelNoExplodeArray. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetLabelNoExplodeArrayResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetDeepObjectResponse) Status() string {
+func (r GetLabelNoExplodeArrayResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1999,20 +2622,24 @@ func (r GetDeepObjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetDeepObjectResponse) StatusCode() int {
+func (r GetLabelNoExplodeArrayResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetQueryFormResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetLabelNoExplodeObjectResponse is the response of GetLabelNoExplodeObject. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetLabelNoExplodeObjectResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetQueryFormResponse) Status() string {
+func (r GetLabelNoExplodeObjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2020,20 +2647,24 @@ func (r GetQueryFormResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetQueryFormResponse) StatusCode() int {
+func (r GetLabelNoExplodeObjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSimpleExplodeArrayResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetMatrixExplodeArrayResponse is the response of GetMatrixExplodeArray. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetMatrixExplodeArrayResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSimpleExplodeArrayResponse) Status() string {
+func (r GetMatrixExplodeArrayResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2041,20 +2672,24 @@ func (r GetSimpleExplodeArrayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSimpleExplodeArrayResponse) StatusCode() int {
+func (r GetMatrixExplodeArrayResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSimpleExplodeObjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetMatrixExplodeObjectResponse is the response of GetMatrixExplodeObject. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetMatrixExplodeObjectResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSimpleExplodeObjectResponse) Status() string {
+func (r GetMatrixExplodeObjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2062,20 +2697,24 @@ func (r GetSimpleExplodeObjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSimpleExplodeObjectResponse) StatusCode() int {
+func (r GetMatrixExplodeObjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSimpleNoExplodeArrayResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetMatrixNoExplodeArrayResponse is the response of GetMatrixNoExplodeArray. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetMatrixNoExplodeArrayResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSimpleNoExplodeArrayResponse) Status() string {
+func (r GetMatrixNoExplodeArrayResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2083,20 +2722,24 @@ func (r GetSimpleNoExplodeArrayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSimpleNoExplodeArrayResponse) StatusCode() int {
+func (r GetMatrixNoExplodeArrayResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSimpleNoExplodeObjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetMatrixNoExplodeObjectResponse is the response of GetMatrixNoExplodeObject. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetMatrixNoExplodeObjectResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSimpleNoExplodeObjectResponse) Status() string {
+func (r GetMatrixNoExplodeObjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2104,20 +2747,24 @@ func (r GetSimpleNoExplodeObjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSimpleNoExplodeObjectResponse) StatusCode() int {
+func (r GetMatrixNoExplodeObjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSimplePrimitiveResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetPassThroughResponse is the response of GetPassThrough. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetPassThroughResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSimplePrimitiveResponse) Status() string {
+func (r GetPassThroughResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2125,20 +2772,24 @@ func (r GetSimplePrimitiveResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSimplePrimitiveResponse) StatusCode() int {
+func (r GetPassThroughResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetStartingWithNumberResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetDeepObjectResponse is the response of GetDeepObject. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetDeepObjectResponse struct {
+	Body                 []byte
+	HTTPResponse         *http.Response
+	BodyTextPlainDefault []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r GetStartingWithNumberResponse) Status() string {
+func (r GetDeepObjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2146,206 +2797,194 @@ func (r GetStartingWithNumberResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetStartingWithNumberResponse) StatusCode() int {
+func (r GetDeepObjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-// GetContentObjectWithResponse request returning *GetContentObjectResponse
-func (c *ClientWithResponses) GetContentObjectWithResponse(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*GetContentObjectResponse, error) {
-	rsp, err := c.GetContentObject(ctx, param, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetContentObjectResponse(rsp)
+// GetQueryFormResponse is the response of GetQueryForm. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetQueryFormResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
-// GetCookieWithResponse request returning *GetCookieResponse
-func (c *ClientWithResponses) GetCookieWithResponse(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*GetCookieResponse, error) {
-	rsp, err := c.GetCookie(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetQueryFormResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetCookieResponse(rsp)
+	return http.StatusText(0)
 }
 
-// EnumParamsWithResponse request returning *EnumParamsResponse
-func (c *ClientWithResponses) EnumParamsWithResponse(ctx context.Context, params *EnumParamsParams, reqEditors ...RequestEditorFn) (*EnumParamsResponse, error) {
-	rsp, err := c.EnumParams(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetQueryFormResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseEnumParamsResponse(rsp)
+	return 0
 }
 
-// GetHeaderWithResponse request returning *GetHeaderResponse
-func (c *ClientWithResponses) GetHeaderWithResponse(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*GetHeaderResponse, error) {
-	rsp, err := c.GetHeader(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetHeaderResponse(rsp)
+// GetSimpleExplodeArrayResponse is the response of GetSimpleExplodeArray. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetSimpleExplodeArrayResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
-// GetLabelExplodeArrayWithResponse request returning *GetLabelExplodeArrayResponse
-func (c *ClientWithResponses) GetLabelExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelExplodeArrayResponse, error) {
-	rsp, err := c.GetLabelExplodeArray(ctx, param, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetSimpleExplodeArrayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetLabelExplodeArrayResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetLabelExplodeObjectWithResponse request returning *GetLabelExplodeObjectResponse
-func (c *ClientWithResponses) GetLabelExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelExplodeObjectResponse, error) {
-	rsp, err := c.GetLabelExplodeObject(ctx, param, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSimpleExplodeArrayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetLabelExplodeObjectResponse(rsp)
+	return 0
 }
 
-// GetLabelNoExplodeArrayWithResponse request returning *GetLabelNoExplodeArrayResponse
-func (c *ClientWithResponses) GetLabelNoExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeArrayResponse, error) {
-	rsp, err := c.GetLabelNoExplodeArray(ctx, param, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetLabelNoExplodeArrayResponse(rsp)
+// GetSimpleExplodeObjectResponse is the response of GetSimpleExplodeObject. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetSimpleExplodeObjectResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
-// GetLabelNoExplodeObjectWithResponse request returning *GetLabelNoExplodeObjectResponse
-func (c *ClientWithResponses) GetLabelNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeObjectResponse, error) {
-	rsp, err := c.GetLabelNoExplodeObject(ctx, param, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetSimpleExplodeObjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetLabelNoExplodeObjectResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetMatrixExplodeArrayWithResponse request returning *GetMatrixExplodeArrayResponse
-func (c *ClientWithResponses) GetMatrixExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixExplodeArrayResponse, error) {
-	rsp, err := c.GetMatrixExplodeArray(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSimpleExplodeObjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetMatrixExplodeArrayResponse(rsp)
+	return 0
 }
 
-// GetMatrixExplodeObjectWithResponse request returning *GetMatrixExplodeObjectResponse
-func (c *ClientWithResponses) GetMatrixExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixExplodeObjectResponse, error) {
-	rsp, err := c.GetMatrixExplodeObject(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetMatrixExplodeObjectResponse(rsp)
+// GetSimpleNoExplodeArrayResponse is the response of GetSimpleNoExplodeArray. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetSimpleNoExplodeArrayResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
-// GetMatrixNoExplodeArrayWithResponse request returning *GetMatrixNoExplodeArrayResponse
-func (c *ClientWithResponses) GetMatrixNoExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeArrayResponse, error) {
-	rsp, err := c.GetMatrixNoExplodeArray(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetSimpleNoExplodeArrayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetMatrixNoExplodeArrayResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetMatrixNoExplodeObjectWithResponse request returning *GetMatrixNoExplodeObjectResponse
-func (c *ClientWithResponses) GetMatrixNoExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeObjectResponse, error) {
-	rsp, err := c.GetMatrixNoExplodeObject(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSimpleNoExplodeArrayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetMatrixNoExplodeObjectResponse(rsp)
+	return 0
 }
 
-// GetPassThroughWithResponse request returning *GetPassThroughResponse
-func (c *ClientWithResponses) GetPassThroughWithResponse(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*GetPassThroughResponse, error) {
-	rsp, err := c.GetPassThrough(ctx, param, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetPassThroughResponse(rsp)
+// GetSimpleNoExplodeObjectResponse is the response of GetSimpleNoExplodeObject. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetSimpleNoExplodeObjectResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
-// GetDeepObjectWithResponse request returning *GetDeepObjectResponse
-func (c *ClientWithResponses) GetDeepObjectWithResponse(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*GetDeepObjectResponse, error) {
-	rsp, err := c.GetDeepObject(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetSimpleNoExplodeObjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetDeepObjectResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetQueryFormWithResponse request returning *GetQueryFormResponse
-func (c *ClientWithResponses) GetQueryFormWithResponse(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*GetQueryFormResponse, error) {
-	rsp, err := c.GetQueryForm(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSimpleNoExplodeObjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetQueryFormResponse(rsp)
+	return 0
 }
 
-// GetSimpleExplodeArrayWithResponse request returning *GetSimpleExplodeArrayResponse
-func (c *ClientWithResponses) GetSimpleExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleExplodeArrayResponse, error) {
-	rsp, err := c.GetSimpleExplodeArray(ctx, param, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetSimpleExplodeArrayResponse(rsp)
+// GetSimplePrimitiveResponse is the response of GetSimplePrimitive. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetSimplePrimitiveResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
-// GetSimpleExplodeObjectWithResponse request returning *GetSimpleExplodeObjectResponse
-func (c *ClientWithResponses) GetSimpleExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleExplodeObjectResponse, error) {
-	rsp, err := c.GetSimpleExplodeObject(ctx, param, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetSimplePrimitiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetSimpleExplodeObjectResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetSimpleNoExplodeArrayWithResponse request returning *GetSimpleNoExplodeArrayResponse
-func (c *ClientWithResponses) GetSimpleNoExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeArrayResponse, error) {
-	rsp, err := c.GetSimpleNoExplodeArray(ctx, param, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSimplePrimitiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetSimpleNoExplodeArrayResponse(rsp)
+	return 0
 }
 
-// GetSimpleNoExplodeObjectWithResponse request returning *GetSimpleNoExplodeObjectResponse
-func (c *ClientWithResponses) GetSimpleNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeObjectResponse, error) {
-	rsp, err := c.GetSimpleNoExplodeObject(ctx, param, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetSimpleNoExplodeObjectResponse(rsp)
+// GetStartingWithNumberResponse is the response of GetStartingWithNumber. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type GetStartingWithNumberResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
-// GetSimplePrimitiveWithResponse request returning *GetSimplePrimitiveResponse
-func (c *ClientWithResponses) GetSimplePrimitiveWithResponse(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*GetSimplePrimitiveResponse, error) {
-	rsp, err := c.GetSimplePrimitive(ctx, param, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetStartingWithNumberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGetSimplePrimitiveResponse(rsp)
+	return http.StatusText(0)
 }
 
-// GetStartingWithNumberWithResponse request returning *GetStartingWithNumberResponse
-func (c *ClientWithResponses) GetStartingWithNumberWithResponse(ctx context.Context, n1param string, reqEditors ...RequestEditorFn) (*GetStartingWithNumberResponse, error) {
-	rsp, err := c.GetStartingWithNumber(ctx, n1param, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStartingWithNumberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseGetStartingWithNumberResponse(rsp)
+	return 0
 }
 
-// ParseGetContentObjectResponse parses an HTTP response from a GetContentObjectWithResponse call
+// ParseGetContentObjectResponse parses an HTTP response from a GetContentObjectWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetContentObjectResponse(rsp *http.Response) (*GetContentObjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2355,13 +2994,21 @@ func ParseGetContentObjectResponse(rsp *http.Response) (*GetContentObjectRespons
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetCookieResponse parses an HTTP response from a GetCookieWithResponse call
+// ParseGetCookieResponse parses an HTTP response from a GetCookieWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetCookieResponse(rsp *http.Response) (*GetCookieResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2371,13 +3018,21 @@ func ParseGetCookieResponse(rsp *http.Response) (*GetCookieResponse, error) {
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && true:
+		response.BodyTextPlainDefault = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseEnumParamsResponse parses an HTTP response from a EnumParamsWithResponse call
+// ParseEnumParamsResponse parses an HTTP response from a EnumParamsWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseEnumParamsResponse(rsp *http.Response) (*EnumParamsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2390,10 +3045,12 @@ func ParseEnumParamsResponse(rsp *http.Response) (*EnumParamsResponse, error) {
 	return response, nil
 }
 
-// ParseGetHeaderResponse parses an HTTP response from a GetHeaderWithResponse call
+// ParseGetHeaderResponse parses an HTTP response from a GetHeaderWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetHeaderResponse(rsp *http.Response) (*GetHeaderResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2403,13 +3060,21 @@ func ParseGetHeaderResponse(rsp *http.Response) (*GetHeaderResponse, error) {
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && true:
+		response.BodyTextPlainDefault = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetLabelExplodeArrayResponse parses an HTTP response from a GetLabelExplodeArrayWithResponse call
+// ParseGetLabelExplodeArrayResponse parses an HTTP response from a GetLabelExplodeArrayWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetLabelExplodeArrayResponse(rsp *http.Response) (*GetLabelExplodeArrayResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2419,13 +3084,21 @@ func ParseGetLabelExplodeArrayResponse(rsp *http.Response) (*GetLabelExplodeArra
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetLabelExplodeObjectResponse parses an HTTP response from a GetLabelExplodeObjectWithResponse call
+// ParseGetLabelExplodeObjectResponse parses an HTTP response from a GetLabelExplodeObjectWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetLabelExplodeObjectResponse(rsp *http.Response) (*GetLabelExplodeObjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2435,13 +3108,21 @@ func ParseGetLabelExplodeObjectResponse(rsp *http.Response) (*GetLabelExplodeObj
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetLabelNoExplodeArrayResponse parses an HTTP response from a GetLabelNoExplodeArrayWithResponse call
+// ParseGetLabelNoExplodeArrayResponse parses an HTTP response from a GetLabelNoExplodeArrayWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetLabelNoExplodeArrayResponse(rsp *http.Response) (*GetLabelNoExplodeArrayResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2451,13 +3132,21 @@ func ParseGetLabelNoExplodeArrayResponse(rsp *http.Response) (*GetLabelNoExplode
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetLabelNoExplodeObjectResponse parses an HTTP response from a GetLabelNoExplodeObjectWithResponse call
+// ParseGetLabelNoExplodeObjectResponse parses an HTTP response from a GetLabelNoExplodeObjectWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetLabelNoExplodeObjectResponse(rsp *http.Response) (*GetLabelNoExplodeObjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2467,13 +3156,21 @@ func ParseGetLabelNoExplodeObjectResponse(rsp *http.Response) (*GetLabelNoExplod
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetMatrixExplodeArrayResponse parses an HTTP response from a GetMatrixExplodeArrayWithResponse call
+// ParseGetMatrixExplodeArrayResponse parses an HTTP response from a GetMatrixExplodeArrayWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetMatrixExplodeArrayResponse(rsp *http.Response) (*GetMatrixExplodeArrayResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2483,13 +3180,21 @@ func ParseGetMatrixExplodeArrayResponse(rsp *http.Response) (*GetMatrixExplodeAr
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetMatrixExplodeObjectResponse parses an HTTP response from a GetMatrixExplodeObjectWithResponse call
+// ParseGetMatrixExplodeObjectResponse parses an HTTP response from a GetMatrixExplodeObjectWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetMatrixExplodeObjectResponse(rsp *http.Response) (*GetMatrixExplodeObjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2499,13 +3204,21 @@ func ParseGetMatrixExplodeObjectResponse(rsp *http.Response) (*GetMatrixExplodeO
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetMatrixNoExplodeArrayResponse parses an HTTP response from a GetMatrixNoExplodeArrayWithResponse call
+// ParseGetMatrixNoExplodeArrayResponse parses an HTTP response from a GetMatrixNoExplodeArrayWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetMatrixNoExplodeArrayResponse(rsp *http.Response) (*GetMatrixNoExplodeArrayResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2515,13 +3228,21 @@ func ParseGetMatrixNoExplodeArrayResponse(rsp *http.Response) (*GetMatrixNoExplo
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetMatrixNoExplodeObjectResponse parses an HTTP response from a GetMatrixNoExplodeObjectWithResponse call
+// ParseGetMatrixNoExplodeObjectResponse parses an HTTP response from a GetMatrixNoExplodeObjectWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetMatrixNoExplodeObjectResponse(rsp *http.Response) (*GetMatrixNoExplodeObjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2531,13 +3252,21 @@ func ParseGetMatrixNoExplodeObjectResponse(rsp *http.Response) (*GetMatrixNoExpl
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetPassThroughResponse parses an HTTP response from a GetPassThroughWithResponse call
+// ParseGetPassThroughResponse parses an HTTP response from a GetPassThroughWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetPassThroughResponse(rsp *http.Response) (*GetPassThroughResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2547,13 +3276,21 @@ func ParseGetPassThroughResponse(rsp *http.Response) (*GetPassThroughResponse, e
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetDeepObjectResponse parses an HTTP response from a GetDeepObjectWithResponse call
+// ParseGetDeepObjectResponse parses an HTTP response from a GetDeepObjectWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetDeepObjectResponse(rsp *http.Response) (*GetDeepObjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2563,13 +3300,21 @@ func ParseGetDeepObjectResponse(rsp *http.Response) (*GetDeepObjectResponse, err
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && true:
+		response.BodyTextPlainDefault = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetQueryFormResponse parses an HTTP response from a GetQueryFormWithResponse call
+// ParseGetQueryFormResponse parses an HTTP response from a GetQueryFormWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetQueryFormResponse(rsp *http.Response) (*GetQueryFormResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2579,13 +3324,21 @@ func ParseGetQueryFormResponse(rsp *http.Response) (*GetQueryFormResponse, error
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetSimpleExplodeArrayResponse parses an HTTP response from a GetSimpleExplodeArrayWithResponse call
+// ParseGetSimpleExplodeArrayResponse parses an HTTP response from a GetSimpleExplodeArrayWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetSimpleExplodeArrayResponse(rsp *http.Response) (*GetSimpleExplodeArrayResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2595,13 +3348,21 @@ func ParseGetSimpleExplodeArrayResponse(rsp *http.Response) (*GetSimpleExplodeAr
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetSimpleExplodeObjectResponse parses an HTTP response from a GetSimpleExplodeObjectWithResponse call
+// ParseGetSimpleExplodeObjectResponse parses an HTTP response from a GetSimpleExplodeObjectWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetSimpleExplodeObjectResponse(rsp *http.Response) (*GetSimpleExplodeObjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2611,13 +3372,21 @@ func ParseGetSimpleExplodeObjectResponse(rsp *http.Response) (*GetSimpleExplodeO
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetSimpleNoExplodeArrayResponse parses an HTTP response from a GetSimpleNoExplodeArrayWithResponse call
+// ParseGetSimpleNoExplodeArrayResponse parses an HTTP response from a GetSimpleNoExplodeArrayWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetSimpleNoExplodeArrayResponse(rsp *http.Response) (*GetSimpleNoExplodeArrayResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2627,13 +3396,21 @@ func ParseGetSimpleNoExplodeArrayResponse(rsp *http.Response) (*GetSimpleNoExplo
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetSimpleNoExplodeObjectResponse parses an HTTP response from a GetSimpleNoExplodeObjectWithResponse call
+// ParseGetSimpleNoExplodeObjectResponse parses an HTTP response from a GetSimpleNoExplodeObjectWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetSimpleNoExplodeObjectResponse(rsp *http.Response) (*GetSimpleNoExplodeObjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2643,13 +3420,21 @@ func ParseGetSimpleNoExplodeObjectResponse(rsp *http.Response) (*GetSimpleNoExpl
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetSimplePrimitiveResponse parses an HTTP response from a GetSimplePrimitiveWithResponse call
+// ParseGetSimplePrimitiveResponse parses an HTTP response from a GetSimplePrimitiveWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetSimplePrimitiveResponse(rsp *http.Response) (*GetSimplePrimitiveResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2659,13 +3444,21 @@ func ParseGetSimplePrimitiveResponse(rsp *http.Response) (*GetSimplePrimitiveRes
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseGetStartingWithNumberResponse parses an HTTP response from a GetStartingWithNumberWithResponse call
+// ParseGetStartingWithNumberResponse parses an HTTP response from a GetStartingWithNumberWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetStartingWithNumberResponse(rsp *http.Response) (*GetStartingWithNumberResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -2675,9 +3468,127 @@ func ParseGetStartingWithNumberResponse(rsp *http.Response) (*GetStartingWithNum
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "GetContentObject":
+		return ParseGetContentObjectResponse(rsp)
+	case "GetCookie":
+		return ParseGetCookieResponse(rsp)
+	case "EnumParams":
+		return ParseEnumParamsResponse(rsp)
+	case "GetHeader":
+		return ParseGetHeaderResponse(rsp)
+	case "GetLabelExplodeArray":
+		return ParseGetLabelExplodeArrayResponse(rsp)
+	case "GetLabelExplodeObject":
+		return ParseGetLabelExplodeObjectResponse(rsp)
+	case "GetLabelNoExplodeArray":
+		return ParseGetLabelNoExplodeArrayResponse(rsp)
+	case "GetLabelNoExplodeObject":
+		return ParseGetLabelNoExplodeObjectResponse(rsp)
+	case "GetMatrixExplodeArray":
+		return ParseGetMatrixExplodeArrayResponse(rsp)
+	case "GetMatrixExplodeObject":
+		return ParseGetMatrixExplodeObjectResponse(rsp)
+	case "GetMatrixNoExplodeArray":
+		return ParseGetMatrixNoExplodeArrayResponse(rsp)
+	case "GetMatrixNoExplodeObject":
+		return ParseGetMatrixNoExplodeObjectResponse(rsp)
+	case "GetPassThrough":
+		return ParseGetPassThroughResponse(rsp)
+	case "GetDeepObject":
+		return ParseGetDeepObjectResponse(rsp)
+	case "GetQueryForm":
+		return ParseGetQueryFormResponse(rsp)
+	case "GetSimpleExplodeArray":
+		return ParseGetSimpleExplodeArrayResponse(rsp)
+	case "GetSimpleExplodeObject":
+		return ParseGetSimpleExplodeObjectResponse(rsp)
+	case "GetSimpleNoExplodeArray":
+		return ParseGetSimpleNoExplodeArrayResponse(rsp)
+	case "GetSimpleNoExplodeObject":
+		return ParseGetSimpleNoExplodeObjectResponse(rsp)
+	case "GetSimplePrimitive":
+		return ParseGetSimplePrimitiveResponse(rsp)
+	case "GetStartingWithNumber":
+		return ParseGetStartingWithNumberResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
+
+// responseHasContentType reports whether contentType, the Content-Type of a
+// response, has the media type of expected, which may be a wildcard, eg,
+// image/*, and at least its parameters.
+func responseHasContentType(contentType string, expected string) bool {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	expectedMediaType, expectedParams, err := mime.ParseMediaType(expected)
+	if err != nil {
+		return false
+	}
+	if expectedMediaType != "*/*" && expectedMediaType != mediaType &&
+		!(strings.HasSuffix(expectedMediaType, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(expectedMediaType, "*"))) {
+		return false
+	}
+	for name, value := range expectedParams {
+		if params[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -3172,7 +4083,7 @@ func (w *ServerInterfaceWrapper) GetDeepObject(ctx echo.Context) error {
 	var params GetDeepObjectParams
 	// ------------- Required query parameter "deepObj" -------------
 
-	err = runtime.BindQueryParameter("deepObject", true, true, "deepObj", ctx.QueryParams(), &params.DeepObj)
+	err = bindDeepObjectQueryParam(true, "deepObj", ctx.QueryParams(), &params.DeepObj)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deepObj: %s", err))
 	}
@@ -3204,14 +4115,14 @@ func (w *ServerInterfaceWrapper) GetQueryForm(ctx echo.Context) error {
 
 	// ------------- Optional query parameter "eo" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "eo", ctx.QueryParams(), &params.Eo)
+	err = bindExplodedFormObjectQueryParam(false, "eo", ctx.QueryParams(), &params.Eo, "role", "firstName")
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eo: %s", err))
 	}
 
 	// ------------- Optional query parameter "o" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "o", ctx.QueryParams(), &params.O)
+	err = bindFormObjectQueryParam(false, "o", ctx.Request().URL.RawQuery, &params.O)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter o: %s", err))
 	}
@@ -3370,6 +4281,153 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// NewGetContentObjectHandler returns the handler RegisterHandlers routes the
+// GetContentObject operation to, so that it can be mounted on its own.
+func NewGetContentObjectHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetContentObject
+}
+
+// NewGetCookieHandler returns the handler RegisterHandlers routes the
+// GetCookie operation to, so that it can be mounted on its own.
+func NewGetCookieHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetCookie
+}
+
+// NewEnumParamsHandler returns the handler RegisterHandlers routes the
+// EnumParams operation to, so that it can be mounted on its own.
+func NewEnumParamsHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.EnumParams
+}
+
+// NewGetHeaderHandler returns the handler RegisterHandlers routes the
+// GetHeader operation to, so that it can be mounted on its own.
+func NewGetHeaderHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetHeader
+}
+
+// NewGetLabelExplodeArrayHandler returns the handler RegisterHandlers routes the
+// GetLabelExplodeArray operation to, so that it can be mounted on its own.
+func NewGetLabelExplodeArrayHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetLabelExplodeArray
+}
+
+// NewGetLabelExplodeObjectHandler returns the handler RegisterHandlers routes the
+// GetLabelExplodeObject operation to, so that it can be mounted on its own.
+func NewGetLabelExplodeObjectHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetLabelExplodeObject
+}
+
+// NewGetLabelNoExplodeArrayHandler returns the handler RegisterHandlers routes the
+// GetLabelNoExplodeArray operation to, so that it can be mounted on its own.
+func NewGetLabelNoExplodeArrayHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetLabelNoExplodeArray
+}
+
+// NewGetLabelNoExplodeObjectHandler returns the handler RegisterHandlers routes the
+// GetLabelNoExplodeObject operation to, so that it can be mounted on its own.
+func NewGetLabelNoExplodeObjectHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetLabelNoExplodeObject
+}
+
+// NewGetMatrixExplodeArrayHandler returns the handler RegisterHandlers routes the
+// GetMatrixExplodeArray operation to, so that it can be mounted on its own.
+func NewGetMatrixExplodeArrayHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetMatrixExplodeArray
+}
+
+// NewGetMatrixExplodeObjectHandler returns the handler RegisterHandlers routes the
+// GetMatrixExplodeObject operation to, so that it can be mounted on its own.
+func NewGetMatrixExplodeObjectHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetMatrixExplodeObject
+}
+
+// NewGetMatrixNoExplodeArrayHandler returns the handler RegisterHandlers routes the
+// GetMatrixNoExplodeArray operation to, so that it can be mounted on its own.
+func NewGetMatrixNoExplodeArrayHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetMatrixNoExplodeArray
+}
+
+// NewGetMatrixNoExplodeObjectHandler returns the handler RegisterHandlers routes the
+// GetMatrixNoExplodeObject operation to, so that it can be mounted on its own.
+func NewGetMatrixNoExplodeObjectHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetMatrixNoExplodeObject
+}
+
+// NewGetPassThroughHandler returns the handler RegisterHandlers routes the
+// GetPassThrough operation to, so that it can be mounted on its own.
+func NewGetPassThroughHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetPassThrough
+}
+
+// NewGetDeepObjectHandler returns the handler RegisterHandlers routes the
+// GetDeepObject operation to, so that it can be mounted on its own.
+func NewGetDeepObjectHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetDeepObject
+}
+
+// NewGetQueryFormHandler returns the handler RegisterHandlers routes the
+// GetQueryForm operation to, so that it can be mounted on its own.
+func NewGetQueryFormHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetQueryForm
+}
+
+// NewGetSimpleExplodeArrayHandler returns the handler RegisterHandlers routes the
+// GetSimpleExplodeArray operation to, so that it can be mounted on its own.
+func NewGetSimpleExplodeArrayHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetSimpleExplodeArray
+}
+
+// NewGetSimpleExplodeObjectHandler returns the handler RegisterHandlers routes the
+// GetSimpleExplodeObject operation to, so that it can be mounted on its own.
+func NewGetSimpleExplodeObjectHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetSimpleExplodeObject
+}
+
+// NewGetSimpleNoExplodeArrayHandler returns the handler RegisterHandlers routes the
+// GetSimpleNoExplodeArray operation to, so that it can be mounted on its own.
+func NewGetSimpleNoExplodeArrayHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetSimpleNoExplodeArray
+}
+
+// NewGetSimpleNoExplodeObjectHandler returns the handler RegisterHandlers routes the
+// GetSimpleNoExplodeObject operation to, so that it can be mounted on its own.
+func NewGetSimpleNoExplodeObjectHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetSimpleNoExplodeObject
+}
+
+// NewGetSimplePrimitiveHandler returns the handler RegisterHandlers routes the
+// GetSimplePrimitive operation to, so that it can be mounted on its own.
+func NewGetSimplePrimitiveHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetSimplePrimitive
+}
+
+// NewGetStartingWithNumberHandler returns the handler RegisterHandlers routes the
+// GetStartingWithNumber operation to, so that it can be mounted on its own.
+func NewGetStartingWithNumberHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetStartingWithNumber
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
 	RegisterHandlersWithBaseURL(router, si, "")
@@ -3378,33 +4436,209 @@ func RegisterHandlers(router EchoRouter, si ServerInterface) {
 // Registers handlers, and prepends BaseURL to the paths, so that the paths
 // can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	router.GET(baseURL+"/contentObject/:param", NewGetContentObjectHandler(si))
+	router.GET(baseURL+"/cookie", NewGetCookieHandler(si))
+	router.GET(baseURL+"/enums", NewEnumParamsHandler(si))
+	router.GET(baseURL+"/header", NewGetHeaderHandler(si))
+	router.GET(baseURL+"/labelExplodeArray/:param", NewGetLabelExplodeArrayHandler(si))
+	router.GET(baseURL+"/labelExplodeObject/:param", NewGetLabelExplodeObjectHandler(si))
+	router.GET(baseURL+"/labelNoExplodeArray/:param", NewGetLabelNoExplodeArrayHandler(si))
+	router.GET(baseURL+"/labelNoExplodeObject/:param", NewGetLabelNoExplodeObjectHandler(si))
+	router.GET(baseURL+"/matrixExplodeArray/:id", NewGetMatrixExplodeArrayHandler(si))
+	router.GET(baseURL+"/matrixExplodeObject/:id", NewGetMatrixExplodeObjectHandler(si))
+	router.GET(baseURL+"/matrixNoExplodeArray/:id", NewGetMatrixNoExplodeArrayHandler(si))
+	router.GET(baseURL+"/matrixNoExplodeObject/:id", NewGetMatrixNoExplodeObjectHandler(si))
+	router.GET(baseURL+"/passThrough/:param", NewGetPassThroughHandler(si))
+	router.GET(baseURL+"/queryDeepObject", NewGetDeepObjectHandler(si))
+	router.GET(baseURL+"/queryForm", NewGetQueryFormHandler(si))
+	router.GET(baseURL+"/simpleExplodeArray/:param", NewGetSimpleExplodeArrayHandler(si))
+	router.GET(baseURL+"/simpleExplodeObject/:param", NewGetSimpleExplodeObjectHandler(si))
+	router.GET(baseURL+"/simpleNoExplodeArray/:param", NewGetSimpleNoExplodeArrayHandler(si))
+	router.GET(baseURL+"/simpleNoExplodeObject/:param", NewGetSimpleNoExplodeObjectHandler(si))
+	router.GET(baseURL+"/simplePrimitive/:param", NewGetSimplePrimitiveHandler(si))
+	router.GET(baseURL+"/startingWithNumber/:1param", NewGetStartingWithNumberHandler(si))
+
+}
+
+// bindFormObjectQueryParam binds the query parameter paramName of rawQuery, an
+// object styled as a non-exploded form, eg, color=R,100,G,200, to dest, which
+// points to the parameter, or to a pointer to it when it's optional. The
+// value is split on commas before being unescaped, so that escaped commas may
+// appear within keys and values.
+func bindFormObjectQueryParam(required bool, paramName string, rawQuery string, dest interface{}) error {
+	var rawValues []string
+	for _, part := range strings.Split(rawQuery, "&") {
+		key, value, _ := strings.Cut(part, "=")
+		if key, err := url.QueryUnescape(key); err == nil && key == paramName {
+			rawValues = append(rawValues, value)
+		}
+	}
+	switch len(rawValues) {
+	case 0:
+		if required {
+			return fmt.Errorf("query parameter '%s' is required", paramName)
+		}
+		return nil
+	case 1:
+	default:
+		return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+	}
+
+	var parts []string
+	if rawValues[0] != "" {
+		parts = strings.Split(rawValues[0], ",")
+	}
+	if len(parts)%2 != 0 {
+		return fmt.Errorf("parameter '%s' has invalid format, property/values need to be pairs", paramName)
+	}
+	fields := make(url.Values, len(parts)/2)
+	for i := 0; i < len(parts); i += 2 {
+		key, err := url.QueryUnescape(parts[i])
+		if err != nil {
+			return fmt.Errorf("error unescaping parameter '%s': %w", paramName, err)
+		}
+		value, err := url.QueryUnescape(parts[i+1])
+		if err != nil {
+			return fmt.Errorf("error unescaping parameter '%s': %w", paramName, err)
+		}
+		if _, found := fields[key]; found {
+			return fmt.Errorf("property '%s' specified multiple times for parameter '%s'", key, paramName)
+		}
+		fields.Set(key, value)
+	}
+
+	v := reflect.ValueOf(dest).Elem()
+	if !required {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+			if name == "" {
+				name = t.Field(i).Name
+			}
+			value, found := fields[name]
+			if name == "-" || !found {
+				continue
+			}
+			field := v.Field(i)
+			if field.Kind() == reflect.Ptr {
+				field.Set(reflect.New(field.Type().Elem()))
+				field = field.Elem()
+			}
+			if err := runtime.BindStringToObject(value[0], field.Addr().Interface()); err != nil {
+				return fmt.Errorf("error binding property '%s' of parameter '%s': %w", name, paramName, err)
+			}
+		}
+		return nil
+	}
+	m := reflect.MakeMapWithSize(v.Type(), len(fields))
+	for key, values := range fields {
+		elem := reflect.New(v.Type().Elem())
+		if err := runtime.BindStringToObject(values[0], elem.Interface()); err != nil {
+			return fmt.Errorf("error binding property '%s' of parameter '%s': %w", key, paramName, err)
+		}
+		m.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), elem.Elem())
+	}
+	v.Set(m)
+	return nil
+}
+
+// bindExplodedFormObjectQueryParam binds the query parameter paramName, an
+// object styled as an exploded form, eg, limit=10&offset=20, to dest, which
+// points to the parameter, or to a pointer to it when it's optional. The
+// properties present in query are bound and the others are left unset, keys
+// which aren't properties are ignored, and the object is only absent when
+// none of its properties are present. When it's present, all of
+// requiredProperties must be.
+func bindExplodedFormObjectQueryParam(required bool, paramName string, query url.Values, dest interface{}, requiredProperties ...string) error {
+	v := reflect.ValueOf(dest).Elem()
+	t := v.Type()
+	if !required {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return runtime.BindQueryParameter("form", true, required, paramName, query, dest)
+	}
+
+	object := reflect.New(t).Elem()
+	present := false
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" {
+			name = t.Field(i).Name
+		}
+		values, found := query[name]
+		if name == "-" || !found {
+			continue
+		}
+		if len(values) != 1 {
+			return fmt.Errorf("property '%s' specified multiple times for parameter '%s'", name, paramName)
+		}
+		field := object.Field(i)
+		if field.Kind() == reflect.Ptr {
+			field.Set(reflect.New(field.Type().Elem()))
+			field = field.Elem()
+		}
+		if err := runtime.BindStringToObject(values[0], field.Addr().Interface()); err != nil {
+			return fmt.Errorf("error binding property '%s' of parameter '%s': %w", name, paramName, err)
+		}
+		present = true
+	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
-
-	router.GET(baseURL+"/contentObject/:param", wrapper.GetContentObject)
-	router.GET(baseURL+"/cookie", wrapper.GetCookie)
-	router.GET(baseURL+"/enums", wrapper.EnumParams)
-	router.GET(baseURL+"/header", wrapper.GetHeader)
-	router.GET(baseURL+"/labelExplodeArray/:param", wrapper.GetLabelExplodeArray)
-	router.GET(baseURL+"/labelExplodeObject/:param", wrapper.GetLabelExplodeObject)
-	router.GET(baseURL+"/labelNoExplodeArray/:param", wrapper.GetLabelNoExplodeArray)
-	router.GET(baseURL+"/labelNoExplodeObject/:param", wrapper.GetLabelNoExplodeObject)
-	router.GET(baseURL+"/matrixExplodeArray/:id", wrapper.GetMatrixExplodeArray)
-	router.GET(baseURL+"/matrixExplodeObject/:id", wrapper.GetMatrixExplodeObject)
-	router.GET(baseURL+"/matrixNoExplodeArray/:id", wrapper.GetMatrixNoExplodeArray)
-	router.GET(baseURL+"/matrixNoExplodeObject/:id", wrapper.GetMatrixNoExplodeObject)
-	router.GET(baseURL+"/passThrough/:param", wrapper.GetPassThrough)
-	router.GET(baseURL+"/queryDeepObject", wrapper.GetDeepObject)
-	router.GET(baseURL+"/queryForm", wrapper.GetQueryForm)
-	router.GET(baseURL+"/simpleExplodeArray/:param", wrapper.GetSimpleExplodeArray)
-	router.GET(baseURL+"/simpleExplodeObject/:param", wrapper.GetSimpleExplodeObject)
-	router.GET(baseURL+"/simpleNoExplodeArray/:param", wrapper.GetSimpleNoExplodeArray)
-	router.GET(baseURL+"/simpleNoExplodeObject/:param", wrapper.GetSimpleNoExplodeObject)
-	router.GET(baseURL+"/simplePrimitive/:param", wrapper.GetSimplePrimitive)
-	router.GET(baseURL+"/startingWithNumber/:1param", wrapper.GetStartingWithNumber)
+	if !present {
+		if required {
+			return fmt.Errorf("query parameter '%s' is required", paramName)
+		}
+		return nil
+	}
+	for _, name := range requiredProperties {
+		if _, found := query[name]; !found {
+			return fmt.Errorf("property '%s' of parameter '%s' is required", name, paramName)
+		}
+	}
+
+	if !required {
+		object = object.Addr()
+	}
+	v.Set(object)
+	return nil
+}
+
+// bindDeepObjectQueryParam binds the query parameter paramName, an object
+// styled as a deepObject, eg, filter[name]=Alex&filter[age]=30, to dest, which
+// points to the parameter, or to a pointer to it when it's optional. The
+// runtime binds it, once each key of the parameter is checked to be made of
+// bracketed segments, and not to give a property both a value and properties,
+// eg, filter[a]=1&filter[a][b]=2. The runtime panics on those keys, which are
+// errors instead.
+func bindDeepObjectQueryParam(required bool, paramName string, query url.Values, dest interface{}) error {
+	for key := range query {
+		if !strings.HasPrefix(key, paramName+"[") {
+			continue
+		}
+		for rest := key[len(paramName):]; rest != ""; {
+			end := strings.IndexByte(rest, ']')
+			if rest[0] != '[' || end < 0 || strings.IndexByte(rest[1:end], '[') >= 0 {
+				return fmt.Errorf("invalid key '%s' of parameter '%s'", key, paramName)
+			}
+			rest = rest[end+1:]
+			if property := key[:len(key)-len(rest)]; rest != "" {
+				if _, found := query[property]; found {
+					return fmt.Errorf("key '%s' of parameter '%s' has both a value and properties", property, paramName)
+				}
+			}
+		}
+	}
 
+	return runtime.BindQueryParameter("deepObject", true, required, paramName, query, dest)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
@@ -0,0 +1,1423 @@
+// Package echo provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package echo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+)
+
+// Defines values for AddPetParamsPrefer.
+const (
+	AddPetParamsPreferReturnMinimal        AddPetParamsPrefer = "return=minimal"
+	AddPetParamsPreferReturnRepresentation AddPetParamsPrefer = "return=representation"
+)
+
+// IsValid reports whether v is one of the values of AddPetParamsPrefer.
+func (v AddPetParamsPrefer) IsValid() bool {
+	switch v {
+	case AddPetParamsPreferReturnMinimal, AddPetParamsPreferReturnRepresentation:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AddPet201ResponseHeadersPreferenceApplied.
+const (
+	AddPet201ResponseHeadersPreferenceAppliedReturnMinimal        AddPet201ResponseHeadersPreferenceApplied = "return=minimal"
+	AddPet201ResponseHeadersPreferenceAppliedReturnRepresentation AddPet201ResponseHeadersPreferenceApplied = "return=representation"
+)
+
+// IsValid reports whether v is one of the values of AddPet201ResponseHeadersPreferenceApplied.
+func (v AddPet201ResponseHeadersPreferenceApplied) IsValid() bool {
+	switch v {
+	case AddPet201ResponseHeadersPreferenceAppliedReturnMinimal, AddPet201ResponseHeadersPreferenceAppliedReturnRepresentation:
+		return true
+	default:
+		return false
+	}
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Name string `json:"name"`
+}
+
+// AddJobParams defines parameters for AddJob.
+type AddJobParams struct {
+	Prefer *string `json:"Prefer,omitempty"`
+}
+
+// AddPetParams defines parameters for AddPet.
+type AddPetParams struct {
+	Prefer *AddPetParamsPrefer `json:"Prefer,omitempty"`
+}
+
+// AddPetParamsPrefer defines parameters for AddPet.
+type AddPetParamsPrefer string
+
+// AddPet201ResponseHeadersPreferenceApplied defines parameters for AddPet.
+type AddPet201ResponseHeadersPreferenceApplied string
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network. They're applied in order, before the ones given to the call.
+	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AddJob request
+	AddJob(ctx context.Context, params *AddJobParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddPet request
+	AddPet(ctx context.Context, params *AddPetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AddJob(ctx context.Context, params *AddJobParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddJobRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "AddJob", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddPet(ctx context.Context, params *AddPetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddPetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "AddPet", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAddJobRequest generates requests for AddJob
+func NewAddJobRequest(server string, params *AddJobParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			if err = validateHeaderValue("Prefer", headerParam0); err != nil {
+				return nil, err
+			}
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAddPetRequest generates requests for AddPet
+func NewAddPetRequest(server string, params *AddPetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.Prefer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Prefer", runtime.ParamLocationHeader, *params.Prefer)
+			if err != nil {
+				return nil, err
+			}
+
+			if err = validateHeaderValue("Prefer", headerParam0); err != nil {
+				return nil, err
+			}
+			req.Header.Set("Prefer", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// validateHeaderValue rejects header parameter values with non-ASCII
+// characters, which HTTP doesn't define an encoding for.
+func validateHeaderValue(name string, value string) error {
+	for i := 0; i < len(value); i++ {
+		if value[i] > 0x7f {
+			return fmt.Errorf("header parameter %s has a non-ASCII value %q", name, value)
+		}
+	}
+	return nil
+}
+
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
+		}
+	}
+	for i, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AddJobWithResponse request
+	AddJobWithResponse(ctx context.Context, params *AddJobParams, reqEditors ...RequestEditorFn) (*AddJobResponse, error)
+
+	// AddPetWithResponse request
+	AddPetWithResponse(ctx context.Context, params *AddPetParams, reqEditors ...RequestEditorFn) (*AddPetResponse, error)
+}
+
+// AddJobWithResponse request returning *AddJobResponse
+func (c *ClientWithResponses) AddJobWithResponse(ctx context.Context, params *AddJobParams, reqEditors ...RequestEditorFn) (*AddJobResponse, error) {
+	rsp, err := c.AddJob(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseAddJobResponseWithoutBody(rsp)
+	}
+	return ParseAddJobResponse(rsp)
+}
+
+// parseAddJobResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseAddJobResponseWithoutBody(rsp *http.Response) (*AddJobResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &AddJobResponse{
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 202:
+		var headers AddJob202ResponseHeaders
+		if value := rsp.Header.Get("Preference-Applied"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "Preference-Applied", value, &headers.PreferenceApplied, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header Preference-Applied: %w", err)
+			}
+		}
+		response.Headers202 = &headers
+	}
+
+	return response, nil
+}
+
+// AddPetWithResponse request returning *AddPetResponse
+func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, params *AddPetParams, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
+	rsp, err := c.AddPet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseAddPetResponseWithoutBody(rsp)
+	}
+	return ParseAddPetResponse(rsp)
+}
+
+// parseAddPetResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseAddPetResponseWithoutBody(rsp *http.Response) (*AddPetResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &AddPetResponse{
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 201:
+		var headers AddPet201ResponseHeaders
+		if value := rsp.Header.Get("Preference-Applied"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "Preference-Applied", value, &headers.PreferenceApplied, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header Preference-Applied: %w", err)
+			}
+			switch headers.PreferenceApplied {
+			case "return=minimal", "return=representation":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header Preference-Applied", value)
+			}
+		}
+		response.Headers201 = &headers
+	case rsp.StatusCode == 400:
+		var headers InvalidResponseHeaders
+		if value := rsp.Header.Get("Preference-Applied"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "Preference-Applied", value, &headers.PreferenceApplied, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header Preference-Applied: %w", err)
+			}
+			switch headers.PreferenceApplied {
+			case "handling=strict":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header Preference-Applied", value)
+			}
+		}
+		response.Headers400 = &headers
+	}
+
+	return response, nil
+}
+
+// AddJobResponse is the response of AddJob. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type AddJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	Headers202   *AddJob202ResponseHeaders
+}
+
+// Status returns HTTPResponse.Status
+func (r AddJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AddPetResponse is the response of AddPet. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type AddPetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Pet
+	Headers201   *AddPet201ResponseHeaders
+	Headers400   *InvalidResponseHeaders
+}
+
+// Status returns HTTPResponse.Status
+func (r AddPetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddPetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ParseAddJobResponse parses an HTTP response from a AddJobWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseAddJobResponse(rsp *http.Response) (*AddJobResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 202:
+		var headers AddJob202ResponseHeaders
+		if value := rsp.Header.Get("Preference-Applied"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "Preference-Applied", value, &headers.PreferenceApplied, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header Preference-Applied: %w", err)
+			}
+		}
+		response.Headers202 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseAddPetResponse parses an HTTP response from a AddPetWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddPetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Pet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 201:
+		var headers AddPet201ResponseHeaders
+		if value := rsp.Header.Get("Preference-Applied"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "Preference-Applied", value, &headers.PreferenceApplied, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header Preference-Applied: %w", err)
+			}
+			switch headers.PreferenceApplied {
+			case "return=minimal", "return=representation":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header Preference-Applied", value)
+			}
+		}
+		response.Headers201 = &headers
+	case rsp.StatusCode == 400:
+		var headers InvalidResponseHeaders
+		if value := rsp.Header.Get("Preference-Applied"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "Preference-Applied", value, &headers.PreferenceApplied, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header Preference-Applied: %w", err)
+			}
+			switch headers.PreferenceApplied {
+			case "handling=strict":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header Preference-Applied", value)
+			}
+		}
+		response.Headers400 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "AddJob":
+		return ParseAddJobResponse(rsp)
+	case "AddPet":
+		return ParseAddPetResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /jobs)
+	AddJob(ctx echo.Context, params AddJobParams) error
+
+	// (POST /pets)
+	AddPet(ctx echo.Context, params AddPetParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// AddJob converts echo context to params.
+func (w *ServerInterfaceWrapper) AddJob(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddJobParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Prefer" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Prefer")]; found {
+		var Prefer string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Prefer, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Prefer", valueList[0], &Prefer, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Prefer: %s", err))
+		}
+
+		params.Prefer = &Prefer
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddJob(ctx, params)
+	return err
+}
+
+// AddPet converts echo context to params.
+func (w *ServerInterfaceWrapper) AddPet(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddPetParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Prefer" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Prefer")]; found {
+		var Prefer AddPetParamsPrefer
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Prefer, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Prefer", valueList[0], &Prefer, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Prefer: %s", err))
+		}
+
+		params.Prefer = &Prefer
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddPet(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// NewAddJobHandler returns the handler RegisterHandlers routes the
+// AddJob operation to, so that it can be mounted on its own.
+func NewAddJobHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.AddJob
+}
+
+// NewAddPetHandler returns the handler RegisterHandlers routes the
+// AddPet operation to, so that it can be mounted on its own.
+func NewAddPetHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.AddPet
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	router.POST(baseURL+"/jobs", NewAddJobHandler(si))
+	router.POST(baseURL+"/pets", NewAddPetHandler(si))
+
+}
+
+type InvalidResponseHeaders struct {
+	PreferenceApplied string
+}
+type InvalidResponse struct {
+	Headers InvalidResponseHeaders
+}
+
+type AddJobRequestObject struct {
+	AddJobParams
+}
+
+// Params returns the query, header and cookie parameters of the request,
+// which are embedded in it.
+func (r AddJobRequestObject) Params() AddJobParams {
+	return r.AddJobParams
+}
+
+type AddJobResponseObject interface {
+	VisitAddJobResponse(w http.ResponseWriter) error
+}
+
+type AddJob200Response struct {
+}
+
+func (response AddJob200Response) VisitAddJobResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AddJob202ResponseHeaders struct {
+	PreferenceApplied string
+}
+
+type AddJob202Response struct {
+	Headers AddJob202ResponseHeaders
+}
+
+func (response AddJob202Response) VisitAddJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Preference-Applied", fmt.Sprint(response.Headers.PreferenceApplied))
+	w.WriteHeader(202)
+	return nil
+}
+
+type AddPetRequestObject struct {
+	AddPetParams
+}
+
+// Params returns the query, header and cookie parameters of the request,
+// which are embedded in it.
+func (r AddPetRequestObject) Params() AddPetParams {
+	return r.AddPetParams
+}
+
+type AddPetResponseObject interface {
+	VisitAddPetResponse(w http.ResponseWriter) error
+}
+
+type AddPet201ResponseHeaders struct {
+	PreferenceApplied AddPet201ResponseHeadersPreferenceApplied
+}
+
+type AddPet201JSONResponse struct {
+	Body    Pet
+	Headers AddPet201ResponseHeaders
+}
+
+func (response AddPet201JSONResponse) VisitAddPetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Preference-Applied", fmt.Sprint(response.Headers.PreferenceApplied))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddPet400Response = InvalidResponse
+
+func (response AddPet400Response) VisitAddPetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Preference-Applied", fmt.Sprint(response.Headers.PreferenceApplied))
+	w.WriteHeader(400)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (POST /jobs)
+	AddJob(ctx context.Context, request AddJobRequestObject) (AddJobResponseObject, error)
+
+	// (POST /pets)
+	AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// AddJob operation middleware
+func (sh *strictHandler) AddJob(ctx echo.Context, params AddJobParams) error {
+	var request AddJobRequestObject
+
+	request.AddJobParams = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AddJob(ctx.Request().Context(), request.(AddJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddJob")
+	}
+
+	ctx.SetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "AddJob")))
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AddJobResponseObject); ok {
+		return validResponse.VisitAddJobResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddPet operation middleware
+func (sh *strictHandler) AddPet(ctx echo.Context, params AddPetParams) error {
+	var request AddPetRequestObject
+
+	request.AddPetParams = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AddPet(ctx.Request().Context(), request.(AddPetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddPet")
+	}
+
+	ctx.SetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "AddPet")))
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AddPetResponseObject); ok {
+		return validResponse.VisitAddPetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// Preferences returns the preferences of the Prefer header of the request,
+// ignoring the malformed ones.
+func (r AddJobRequestObject) Preferences() Preferences {
+	if r.AddJobParams.Prefer == nil {
+		return nil
+	}
+	return parsePreferences(*r.AddJobParams.Prefer)
+}
+
+// Well-known preferences of the Prefer header, as registered by RFC 7240.
+const (
+	PreferenceRespondAsync = "respond-async"
+	PreferenceReturn       = "return"
+	PreferenceWait         = "wait"
+	PreferenceHandling     = "handling"
+
+	PreferenceReturnMinimal        = "minimal"
+	PreferenceReturnRepresentation = "representation"
+	PreferenceHandlingStrict       = "strict"
+	PreferenceHandlingLenient      = "lenient"
+)
+
+// PreferenceParameter is a parameter of a preference, following it after a
+// semicolon.
+type PreferenceParameter struct {
+	Name  string
+	Value string
+}
+
+// Preference is a single preference of a Prefer header of RFC 7240, eg,
+// `return=minimal`. An empty value is the same as no value.
+type Preference struct {
+	Name       string
+	Value      string
+	Parameters []PreferenceParameter
+}
+
+// Param returns the value of the parameter of p named name, and whether p
+// has it. Names are case-insensitive.
+func (p Preference) Param(name string) (string, bool) {
+	for _, param := range p.Parameters {
+		if strings.EqualFold(param.Name, name) {
+			return param.Value, true
+		}
+	}
+	return "", false
+}
+
+// String formats p as it is written in a header.
+func (p Preference) String() string {
+	var b strings.Builder
+	writePreferencePair(&b, p.Name, p.Value)
+	for _, param := range p.Parameters {
+		b.WriteString("; ")
+		writePreferencePair(&b, param.Name, param.Value)
+	}
+	return b.String()
+}
+
+// Preferences are the preferences of the Prefer header of a request, or of
+// the Preference-Applied header of a response, in their order.
+type Preferences []Preference
+
+// Get returns the preference named name, and whether there is one. Names are
+// case-insensitive.
+func (p Preferences) Get(name string) (Preference, bool) {
+	for _, pref := range p {
+		if strings.EqualFold(pref.Name, name) {
+			return pref, true
+		}
+	}
+	return Preference{}, false
+}
+
+// Has returns whether there is a preference named name.
+func (p Preferences) Has(name string) bool {
+	_, found := p.Get(name)
+	return found
+}
+
+// Value returns the value of the preference named name, which is empty when
+// there is none.
+func (p Preferences) Value(name string) string {
+	pref, _ := p.Get(name)
+	return pref.Value
+}
+
+// RespondAsync returns whether the client prefers an asynchronous response.
+func (p Preferences) RespondAsync() bool {
+	return p.Has(PreferenceRespondAsync)
+}
+
+// Return returns the value of the return preference,
+// PreferenceReturnMinimal or PreferenceReturnRepresentation, which is empty
+// when there is none.
+func (p Preferences) Return() string {
+	return strings.ToLower(p.Value(PreferenceReturn))
+}
+
+// Wait returns how long the client is willing to wait for a response, and
+// whether it said so with a valid wait preference.
+func (p Preferences) Wait() (time.Duration, bool) {
+	seconds, err := strconv.ParseUint(p.Value(PreferenceWait), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// String formats p as it is written in a header, eg, for the
+// Preference-Applied header of a response.
+func (p Preferences) String() string {
+	prefs := make([]string, len(p))
+	for i, pref := range p {
+		prefs[i] = pref.String()
+	}
+	return strings.Join(prefs, ", ")
+}
+
+// parsePreferences parses the preferences of the given Prefer header values.
+// As RFC 7240 requires, the malformed preferences are ignored rather than
+// failing the others, and only the first of those sharing a name is kept.
+func parsePreferences(values ...string) Preferences {
+	var prefs Preferences
+	for _, value := range values {
+		for _, element := range splitPreferences(value, ',') {
+			pref, ok := parsePreference(element)
+			if ok && !prefs.Has(pref.Name) {
+				prefs = append(prefs, pref)
+			}
+		}
+	}
+	return prefs
+}
+
+// parsePreference parses a preference and its parameters, reporting whether
+// it is well-formed.
+func parsePreference(element string) (Preference, bool) {
+	parts := splitPreferences(element, ';')
+	name, value, ok := parsePreferencePair(parts[0])
+	if !ok {
+		return Preference{}, false
+	}
+	pref := Preference{Name: name, Value: value}
+	for _, part := range parts[1:] {
+		// Empty parameters are allowed, eg, a trailing semicolon.
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		name, value, ok := parsePreferencePair(part)
+		if !ok {
+			return Preference{}, false
+		}
+		pref.Parameters = append(pref.Parameters, PreferenceParameter{Name: name, Value: value})
+	}
+	return pref, true
+}
+
+// parsePreferencePair parses `token [ BWS "=" BWS word ]`, where word is a
+// token or a quoted string.
+func parsePreferencePair(s string) (string, string, bool) {
+	name, value, hasValue := strings.Cut(s, "=")
+	name = strings.TrimSpace(name)
+	if !isPreferenceToken(name) {
+		return "", "", false
+	}
+	if !hasValue {
+		return name, "", true
+	}
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, `"`) {
+		if len(value) < 2 || value[len(value)-1] != '"' {
+			return "", "", false
+		}
+		var b strings.Builder
+		for i := 1; i < len(value)-1; i++ {
+			c := value[i]
+			switch {
+			case c == '\\':
+				i++
+				if i == len(value)-1 {
+					return "", "", false
+				}
+				c = value[i]
+			case c == '"':
+				return "", "", false
+			}
+			b.WriteByte(c)
+		}
+		return name, b.String(), true
+	}
+	if value != "" && !isPreferenceToken(value) {
+		return "", "", false
+	}
+	return name, value, true
+}
+
+// splitPreferences splits s at the separators which aren't within a quoted
+// string.
+func splitPreferences(s string, separator byte) []string {
+	var parts []string
+	quoted, escaped := false, false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case escaped:
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == separator:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// writePreferencePair writes name, followed by value when it has one, quoting
+// it when it isn't a token.
+func writePreferencePair(b *strings.Builder, name string, value string) {
+	b.WriteString(name)
+	if value == "" {
+		return
+	}
+	b.WriteByte('=')
+	if isPreferenceToken(value) {
+		b.WriteString(value)
+		return
+	}
+	b.WriteByte('"')
+	for i := 0; i < len(value); i++ {
+		if value[i] == '"' || value[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(value[i])
+	}
+	b.WriteByte('"')
+}
+
+// isPreferenceToken returns whether s is a token of RFC 7230.
+func isPreferenceToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// AddJobHandler handles the AddJob operation with its typed request and response objects.
+type AddJobHandler func(ctx context.Context, request AddJobRequestObject) (AddJobResponseObject, error)
+
+// AddPetHandler handles the AddPet operation with its typed request and response objects.
+type AddPetHandler func(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnAddJob func(next AddJobHandler) AddJobHandler
+	OnAddPet func(next AddPetHandler) AddPetHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) AddJob(ctx context.Context, request AddJobRequestObject) (AddJobResponseObject, error) {
+	handler := AddJobHandler(s.ssi.AddJob)
+	if s.middlewares.OnAddJob != nil {
+		handler = s.middlewares.OnAddJob(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	handler := AddPetHandler(s.ssi.AddPet)
+	if s.middlewares.OnAddPet != nil {
+		handler = s.middlewares.OnAddPet(handler)
+	}
+	return handler(ctx, request)
+}
+
+// TestClientOption configures the server NewTestClient connects its client
+// to.
+type TestClientOption func(*testClientOptions)
+
+type testClientOptions struct {
+	strictMiddlewares []StrictMiddlewareFunc
+	clientOptions     []ClientOption
+	middlewares       []echo.MiddlewareFunc
+	errorHandler      echo.HTTPErrorHandler
+}
+
+// WithTestServerStrictMiddlewares adds strict middlewares to the strict
+// handler of the server.
+func WithTestServerStrictMiddlewares(middlewares ...StrictMiddlewareFunc) TestClientOption {
+	return func(o *testClientOptions) {
+		o.strictMiddlewares = append(o.strictMiddlewares, middlewares...)
+	}
+}
+
+// WithTestServerMiddlewares adds middlewares to the routes of the server.
+func WithTestServerMiddlewares(middlewares ...echo.MiddlewareFunc) TestClientOption {
+	return func(o *testClientOptions) {
+		o.middlewares = append(o.middlewares, middlewares...)
+	}
+}
+
+// WithTestServerErrorHandler sets the HTTPErrorHandler of the echo server.
+func WithTestServerErrorHandler(handler echo.HTTPErrorHandler) TestClientOption {
+	return func(o *testClientOptions) {
+		o.errorHandler = handler
+	}
+}
+
+// WithTestClientOptions adds options to the client.
+func WithTestClientOptions(options ...ClientOption) TestClientOption {
+	return func(o *testClientOptions) {
+		o.clientOptions = append(o.clientOptions, options...)
+	}
+}
+
+// NewTestClient returns a client of the strict server ssi, served in process
+// by the generated server, without a socket, so that tests go through the
+// encoding of the client and the binding of the server. It panics when the
+// client options fail.
+func NewTestClient(ssi StrictServerInterface, options ...TestClientOption) *ClientWithResponses {
+	var o testClientOptions
+	for _, option := range options {
+		option(&o)
+	}
+
+	e := echo.New()
+	if o.errorHandler != nil {
+		e.HTTPErrorHandler = o.errorHandler
+	}
+	e.Use(o.middlewares...)
+	RegisterHandlers(e, NewStrictHandler(ssi, o.strictMiddlewares))
+	transport := http.RoundTripper(testTransport{handler: e})
+
+	clientOptions := append([]ClientOption{WithHTTPClient(&http.Client{Transport: transport})}, o.clientOptions...)
+	client, err := NewClientWithResponses("http://test.invalid", clientOptions...)
+	if err != nil {
+		panic(fmt.Sprintf("creating the test client: %s", err))
+	}
+	return client
+}
+
+// testTransport dispatches the requests of the test client to handler, as
+// the server would receive them.
+type testTransport struct {
+	handler http.Handler
+}
+
+func (t testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	if r.Body == nil {
+		r.Body = http.NoBody
+	}
+	r.RequestURI = r.URL.RequestURI()
+	r.RemoteAddr = "192.0.2.1:1234"
+
+	recorder := httptest.NewRecorder()
+	t.handler.ServeHTTP(recorder, r)
+	rsp := recorder.Result()
+	rsp.Request = req
+	return rsp, nil
+}
@@ -0,0 +1,78 @@
+package echo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePreferences(t *testing.T) {
+	prefs := parsePreferences("respond-async, wait=100")
+	assert.Equal(t, Preferences{{Name: "respond-async"}, {Name: "wait", Value: "100"}}, prefs)
+	assert.True(t, prefs.RespondAsync())
+	wait, ok := prefs.Wait()
+	assert.True(t, ok)
+	assert.Equal(t, 100*time.Second, wait)
+	assert.Empty(t, prefs.Return())
+
+	// The examples of RFC 7240.
+	prefs = parsePreferences(`foo; bar`)
+	assert.Equal(t, Preferences{{Name: "foo", Parameters: []PreferenceParameter{{Name: "bar"}}}}, prefs)
+
+	prefs = parsePreferences(`foo=""; bar`)
+	assert.Equal(t, Preferences{{Name: "foo", Parameters: []PreferenceParameter{{Name: "bar"}}}}, prefs)
+
+	prefs = parsePreferences(`return=minimal; foo="some parameter"`)
+	assert.Equal(t, PreferenceReturnMinimal, prefs.Return())
+	pref, _ := prefs.Get("return")
+	value, ok := pref.Param("FOO")
+	assert.True(t, ok)
+	assert.Equal(t, "some parameter", value)
+
+	prefs = parsePreferences("handling=lenient, wait=100, respond-async")
+	assert.Equal(t, PreferenceHandlingLenient, prefs.Value(PreferenceHandling))
+	assert.True(t, prefs.RespondAsync())
+}
+
+func TestParseSeveralPreferenceHeaders(t *testing.T) {
+	prefs := parsePreferences("respond-async", "wait=10, return=representation")
+	assert.True(t, prefs.RespondAsync())
+	assert.Equal(t, PreferenceReturnRepresentation, prefs.Return())
+}
+
+func TestParsePreferencesFirstWins(t *testing.T) {
+	// Only the first instance of a preference is considered, whatever its
+	// case.
+	prefs := parsePreferences("return=minimal, RETURN=representation")
+	assert.Len(t, prefs, 1)
+	assert.Equal(t, PreferenceReturnMinimal, prefs.Return())
+}
+
+func TestParsePreferencesQuoted(t *testing.T) {
+	prefs := parsePreferences(`foo="a, b; c=d", bar="say \"hi\""`)
+	assert.Equal(t, "a, b; c=d", prefs.Value("foo"))
+	assert.Equal(t, `say "hi"`, prefs.Value("bar"))
+}
+
+func TestParsePreferencesIgnoresMalformed(t *testing.T) {
+	prefs := parsePreferences(`, return=minimal, wait=a b, "quoted", respond-async; =x, bar, foo="unterminated, baz`)
+	assert.Equal(t, Preferences{{Name: "return", Value: "minimal"}, {Name: "bar"}}, prefs)
+
+	assert.Empty(t, parsePreferences(""))
+	assert.Empty(t, parsePreferences(" , ,"))
+
+	_, ok := parsePreferences("wait=soon").Wait()
+	assert.False(t, ok)
+	_, ok = parsePreferences("wait=-1").Wait()
+	assert.False(t, ok)
+}
+
+func TestPreferencesString(t *testing.T) {
+	prefs := Preferences{
+		{Name: "return", Value: "minimal"},
+		{Name: "foo", Value: `a "b"`, Parameters: []PreferenceParameter{{Name: "bar"}, {Name: "baz", Value: "1"}}},
+	}
+	assert.Equal(t, `return=minimal, foo="a \"b\""; bar; baz=1`, prefs.String())
+	assert.Equal(t, prefs, parsePreferences(prefs.String()))
+}
@@ -0,0 +1,82 @@
+package prefer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/v2/internal/test/prefer/chi"
+	echoapi "github.com/deepmap/oapi-codegen/v2/internal/test/prefer/echo"
+)
+
+type chiServer struct{}
+
+func (chiServer) AddJob(ctx context.Context, request chi.AddJobRequestObject) (chi.AddJobResponseObject, error) {
+	prefs := request.Preferences()
+	if !prefs.RespondAsync() {
+		return chi.AddJob200Response{}, nil
+	}
+	applied := chi.Preferences{{Name: chi.PreferenceRespondAsync}}
+	if wait, ok := prefs.Wait(); ok && wait <= time.Minute {
+		applied = append(applied, chi.Preference{Name: chi.PreferenceWait, Value: "10"})
+	}
+	return chi.AddJob202Response{Headers: chi.AddJob202ResponseHeaders{PreferenceApplied: applied.String()}}, nil
+}
+
+func (chiServer) AddPet(ctx context.Context, request chi.AddPetRequestObject) (chi.AddPetResponseObject, error) {
+	if request.Prefer == nil {
+		return chi.AddPet400Response{Headers: chi.InvalidResponseHeaders{PreferenceApplied: "handling=strict"}}, nil
+	}
+	return chi.AddPet201JSONResponse{
+		Body:    chi.Pet{Name: "pet"},
+		Headers: chi.AddPet201ResponseHeaders{PreferenceApplied: chi.AddPet201ResponseHeadersPreferenceApplied(*request.Prefer)},
+	}, nil
+}
+
+func TestChiPreferences(t *testing.T) {
+	ctx := context.Background()
+	client := chi.NewTestClient(chiServer{})
+
+	value := "respond-async, wait=30"
+	rsp, err := client.AddJobWithResponse(ctx, &chi.AddJobParams{Prefer: &value})
+	require.NoError(t, err)
+	assert.Equal(t, 202, rsp.StatusCode())
+	require.NotNil(t, rsp.Headers202)
+	assert.Equal(t, "respond-async, wait=10", rsp.Headers202.PreferenceApplied)
+
+	rsp, err = client.AddJobWithResponse(ctx, &chi.AddJobParams{})
+	require.NoError(t, err)
+	assert.Equal(t, 200, rsp.StatusCode())
+}
+
+func TestChiTypedPreferenceApplied(t *testing.T) {
+	ctx := context.Background()
+	client := chi.NewTestClient(chiServer{})
+
+	minimal := chi.AddPetParamsPreferReturnMinimal
+	rsp, err := client.AddPetWithResponse(ctx, &chi.AddPetParams{Prefer: &minimal})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.Headers201)
+	assert.Equal(t, chi.AddPet201ResponseHeadersPreferenceAppliedReturnMinimal, rsp.Headers201.PreferenceApplied)
+	assert.True(t, rsp.Headers201.PreferenceApplied.IsValid())
+
+	rsp, err = client.AddPetWithResponse(ctx, &chi.AddPetParams{})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.Headers400)
+	assert.Equal(t, "handling=strict", rsp.Headers400.PreferenceApplied)
+}
+
+func TestRequestObjectPreferences(t *testing.T) {
+	assert.Nil(t, echoapi.AddJobRequestObject{}.Preferences())
+
+	value := `return=minimal; foo="bar, baz"`
+	prefs := echoapi.AddJobRequestObject{AddJobParams: echoapi.AddJobParams{Prefer: &value}}.Preferences()
+	assert.Equal(t, echoapi.PreferenceReturnMinimal, prefs.Return())
+	pref, ok := prefs.Get(echoapi.PreferenceReturn)
+	require.True(t, ok)
+	foo, _ := pref.Param("foo")
+	assert.Equal(t, "bar, baz", foo)
+}
@@ -0,0 +1,233 @@
+// Package preserve_zero provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package preserve_zero
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Counter defines model for Counter.
+type Counter struct {
+	Total *int `json:"total,omitempty"`
+}
+
+// Gauge defines model for Gauge.
+type Gauge struct {
+	Value *float32 `json:"value,omitempty"`
+}
+
+// Labeled defines model for Labeled.
+type Labeled struct {
+	Count                int               `json:"count"`
+	Name                 *string           `json:"name,omitempty"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Metrics defines model for Metrics.
+type Metrics struct {
+	Count   int      `json:"count"`
+	Enabled bool     `json:"enabled"`
+	Name    string   `json:"name"`
+	Note    *string  `json:"note,omitempty"`
+	Ratio   *float32 `json:"ratio"`
+}
+
+// Sample defines model for Sample.
+type Sample struct {
+	Count   int   `json:"count"`
+	Enabled *bool `json:"enabled,omitempty"`
+	union   json.RawMessage
+}
+
+// Getter for additional properties for Labeled. Returns the specified
+// element and whether it was found
+func (a Labeled) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Labeled
+func (a *Labeled) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Labeled to handle AdditionalProperties
+func (a *Labeled) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["count"]; found {
+		err = json.Unmarshal(raw, &a.Count)
+		if err != nil {
+			return fmt.Errorf("error reading 'count': %w", err)
+		}
+		delete(object, "count")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Labeled to handle AdditionalProperties
+func (a Labeled) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["count"], err = json.Marshal(a.Count)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'count': %w", err)
+	}
+
+	if a.Name != nil {
+		object["name"], err = json.Marshal(a.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'name': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// AsGauge returns the union data inside the Sample as a Gauge
+func (t Sample) AsGauge() (Gauge, error) {
+	var body Gauge
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromGauge overwrites any union data inside the Sample as the provided Gauge
+func (t *Sample) FromGauge(v Gauge) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeGauge performs a merge with any union data inside the Sample, using the provided Gauge
+func (t *Sample) MergeGauge(v Gauge) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCounter returns the union data inside the Sample as a Counter
+func (t Sample) AsCounter() (Counter, error) {
+	var body Counter
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCounter overwrites any union data inside the Sample as the provided Counter
+func (t *Sample) FromCounter(v Counter) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCounter performs a merge with any union data inside the Sample, using the provided Counter
+func (t *Sample) MergeCounter(v Counter) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Sample) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	object["count"], err = json.Marshal(t.Count)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'count': %w", err)
+	}
+
+	if t.Enabled != nil {
+		object["enabled"], err = json.Marshal(t.Enabled)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'enabled': %w", err)
+		}
+	}
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *Sample) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["count"]; found {
+		err = json.Unmarshal(raw, &t.Count)
+		if err != nil {
+			return fmt.Errorf("error reading 'count': %w", err)
+		}
+	}
+
+	if raw, found := object["enabled"]; found {
+		err = json.Unmarshal(raw, &t.Enabled)
+		if err != nil {
+			return fmt.Errorf("error reading 'enabled': %w", err)
+		}
+	}
+
+	return err
+}
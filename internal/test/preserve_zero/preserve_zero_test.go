@@ -0,0 +1,55 @@
+package preserve_zero
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreserveZero(t *testing.T) {
+	buf, err := json.Marshal(Metrics{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"count":0,"name":"","enabled":false,"ratio":null}`, string(buf))
+
+	var metrics Metrics
+	require.NoError(t, json.Unmarshal(buf, &metrics))
+	assert.Equal(t, Metrics{}, metrics)
+
+	ratio := float32(0)
+	buf, err = json.Marshal(Metrics{Count: 3, Ratio: &ratio})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"count":3,"name":"","enabled":false,"ratio":0}`, string(buf))
+}
+
+func TestPreserveZeroAdditionalProperties(t *testing.T) {
+	buf, err := json.Marshal(Labeled{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"count":0}`, string(buf))
+
+	labeled := Labeled{AdditionalProperties: map[string]string{"unit": "ms"}}
+	buf, err = json.Marshal(labeled)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"count":0,"unit":"ms"}`, string(buf))
+
+	var decoded Labeled
+	require.NoError(t, json.Unmarshal(buf, &decoded))
+	assert.Equal(t, labeled, decoded)
+}
+
+func TestPreserveZeroUnion(t *testing.T) {
+	var sample Sample
+	require.NoError(t, sample.FromCounter(Counter{}))
+	buf, err := json.Marshal(sample)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"count":0}`, string(buf))
+
+	var decoded Sample
+	require.NoError(t, json.Unmarshal([]byte(`{"count":0,"total":2}`), &decoded))
+	assert.Equal(t, 0, decoded.Count)
+	counter, err := decoded.AsCounter()
+	require.NoError(t, err)
+	require.NotNil(t, counter.Total)
+	assert.Equal(t, 2, *counter.Total)
+}
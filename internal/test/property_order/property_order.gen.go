@@ -0,0 +1,136 @@
+// Package propertyorder provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package propertyorder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Order The properties of the members come in the order they're first declared.
+type Order struct {
+	Id string `json:"id"`
+
+	// CreatedAt When the order was placed, overriding when the resource was created.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Kind Always "order", overriding the kind of the resource.
+	Kind *string `json:"kind,omitempty"`
+
+	// Properties A property called properties, which isn't the properties of a schema.
+	Properties *map[string]string `json:"properties,omitempty"`
+	Status     string             `json:"status"`
+	Amount     *float32           `json:"amount,omitempty"`
+	Zone       *string            `json:"zone,omitempty"`
+	Address    *struct {
+		Street  *string `json:"street,omitempty"`
+		City    *string `json:"city,omitempty"`
+		Country *string `json:"country,omitempty"`
+	} `json:"address,omitempty"`
+}
+
+// Resource defines model for Resource.
+type Resource struct {
+	Id        string     `json:"id"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Kind The kind of the resource.
+	Kind *string `json:"kind,omitempty"`
+
+	// Properties A property called properties, which isn't the properties of a schema.
+	Properties *map[string]string `json:"properties,omitempty"`
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/5STwW7bMAyGX0XgBvTiOemyXXTrE7TYCuzQ9qBKdM3NljSKaZYGfvdBStwmsQ/tjZBI",
+	"6uf3UzuwoY/Bo5cEegfJttibEl6zQ86B6brrBvTdDj4zNqDh0+KtaHGoWPzAFNZsEYZqB5FDRBbC0sn0",
+	"Ye0lR7KNCBr8un9EhqGCP+RdvnCYLFMUCh40XHUbs03qHkLWcA+VCs/ITI78k5IWVS5ToSkxHx6uoRr7",
+	"J2HyT7l/EiPrdPT0eDW8JofH32hlTrZzjKmE+M/0scMcWpItaPgZc5+GsHNQwQtF0HD5dfXte371tM++",
+	"YjcVZzMWnr9LwojyPuFgGY2gu5Ipyl8t+oKpoFQbk1TsjEV3wnQzZo0wS+KhbQbbBO6NgAZnBL8I9ThH",
+	"+yV4nJfM+HdNjA703ejJw2SQh+pM/G2L6o3laHiPeXuSsqFHRcfTSYvbC0bVECdRDm1nOOsfKnhdT31u",
+	"8wm8981Jbtaz+WW+/ci+TjaQchfT3ZycT8rOvs8Ibaus6Tp0RxArtWnJtoqSv5Ci5xSwUfsPXcPEnjMb",
+	"yc1YmJPIN6GoJMlfBm5GMcUkqOAZOe2VXtbLepkHCBG9iQQaVvWyXkEF0Uibhx2G/wMA+0M/t6QEAAA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,44 @@
+package propertyorder
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonNames returns the JSON names of the fields of the struct v, in order.
+func jsonNames(v interface{}) []string {
+	return jsonNamesOf(reflect.TypeOf(v))
+}
+
+// jsonNamesOf returns the JSON names of the fields of the struct type t, in
+// order.
+func jsonNamesOf(t reflect.Type) []string {
+	names := make([]string, t.NumField())
+	for i := range names {
+		names[i], _, _ = strings.Cut(t.Field(i).Tag.Get("json"), ",")
+	}
+	return names
+}
+
+func TestDeclaredOrder(t *testing.T) {
+	assert.Equal(t, []string{"id", "createdAt", "kind", "properties"}, jsonNames(Resource{}))
+
+	// The overrides of kind and createdAt by the later members stay where
+	// Resource declares them.
+	assert.Equal(t, []string{"id", "createdAt", "kind", "properties", "status", "amount", "zone", "address"}, jsonNames(Order{}))
+
+	address, _ := reflect.TypeOf(Order{}).FieldByName("Address")
+	assert.Equal(t, []string{"street", "city", "country"}, jsonNamesOf(address.Type.Elem()))
+}
+
+func TestOrderNotEmbedded(t *testing.T) {
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+	for name, schema := range swagger.Components.Schemas {
+		assert.Empty(t, schema.Value.Extensions, name)
+	}
+}
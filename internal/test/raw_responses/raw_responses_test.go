@@ -0,0 +1,84 @@
+package rawresponses
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+type strictServer struct{}
+
+func (strictServer) GetReport(ctx context.Context, request GetReportRequestObject) (GetReportResponseObject, error) {
+	switch request.Id {
+	case "pdf":
+		response := NewGetReport200ApplicationpdfResponse([]byte("%PDF-1.7"))
+		response.Headers.XChecksum = "abc"
+		return response, nil
+	case "png":
+		return NewGetReport200ImageResponse(pngHeader), nil
+	case "missing":
+		return GetReport404TextResponse("no such report"), nil
+	}
+	response := NewGetReportdefaultApplicationoctetStreamResponse([]byte{1, 2, 3})
+	response.StatusCode = http.StatusTeapot
+	return response, nil
+}
+
+func TestRawResponses(t *testing.T) {
+	server := httptest.NewServer(Handler(NewStrictHandler(strictServer{}, nil)))
+	defer server.Close()
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	response, err := client.GetReportWithResponse(ctx, "pdf")
+	require.NoError(t, err)
+	assert.Equal(t, "8", response.HTTPResponse.Header.Get("Content-Length"))
+	assert.Equal(t, []byte("%PDF-1.7"), response.BodyApplicationPdf200)
+	require.NotNil(t, response.Headers200)
+	assert.Equal(t, "abc", response.Headers200.XChecksum)
+	assert.Nil(t, response.BodyImageWildcard200)
+
+	response, err = client.GetReportWithResponse(ctx, "png")
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", response.HTTPResponse.Header.Get("Content-Type"))
+	assert.Equal(t, pngHeader, response.BodyImageWildcard200)
+	assert.Nil(t, response.BodyApplicationPdf200)
+
+	response, err = client.GetReportWithResponse(ctx, "missing")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("no such report"), response.BodyTextPlain404)
+
+	response, err = client.GetReportWithResponse(ctx, "other")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTeapot, response.StatusCode())
+	assert.Equal(t, []byte{1, 2, 3}, response.BodyApplicationOctetStreamDefault)
+}
+
+func TestRawResponsesContentTypeParameters(t *testing.T) {
+	rsp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Header:     http.Header{"Content-Type": []string{"text/plain; charset=utf-8"}},
+		Body:       io.NopCloser(strings.NewReader("gone")),
+	}
+	response, err := ParseGetReportResponse(rsp)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("gone"), response.BodyTextPlain404)
+
+	rsp = &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/pdf; version=1.7"}},
+		Body:       io.NopCloser(strings.NewReader("%PDF")),
+	}
+	response, err = ParseGetReportResponse(rsp)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("%PDF"), response.BodyApplicationPdf200)
+}
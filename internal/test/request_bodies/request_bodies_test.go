@@ -0,0 +1,75 @@
+package request_bodies
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// server answers with the query it's given, or with "none" when there is
+// no body.
+type server struct{}
+
+func (server) Search(ctx context.Context, request SearchRequestObject) (SearchResponseObject, error) {
+	return Search200JSONResponse(*request.Body), nil
+}
+
+func (server) DeleteItems(ctx context.Context, request DeleteItemsRequestObject) (DeleteItemsResponseObject, error) {
+	return DeleteItems200JSONResponse(*request.Body), nil
+}
+
+func (server) AddItem(ctx context.Context, request AddItemRequestObject) (AddItemResponseObject, error) {
+	if request.Body == nil {
+		none := "none"
+		return AddItem200JSONResponse{Q: &none}, nil
+	}
+	return AddItem200JSONResponse(*request.Body), nil
+}
+
+func newClient(t *testing.T) *ClientWithResponses {
+	ts := httptest.NewServer(Handler(NewStrictHandler(server{}, nil)))
+	t.Cleanup(ts.Close)
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+	return client
+}
+
+func TestBodiesOfGetAndDelete(t *testing.T) {
+	client := newClient(t)
+	q := "shoes"
+
+	search, err := client.SearchWithResponse(context.Background(), SearchJSONRequestBody{Q: &q})
+	require.NoError(t, err)
+	require.NotNil(t, search.JSON200)
+	assert.Equal(t, q, *search.JSON200.Q)
+
+	deleted, err := client.DeleteItemsWithResponse(context.Background(), DeleteItemsJSONRequestBody{Q: &q})
+	require.NoError(t, err)
+	require.NotNil(t, deleted.JSON200)
+	assert.Equal(t, q, *deleted.JSON200.Q)
+}
+
+func TestOptionalBody(t *testing.T) {
+	client := newClient(t)
+	q := "hat"
+
+	added, err := client.AddItemWithResponse(context.Background(), AddItemJSONRequestBody{Q: &q})
+	require.NoError(t, err)
+	require.NotNil(t, added.JSON200)
+	assert.Equal(t, q, *added.JSON200.Q)
+
+	added, err = client.AddItemWithoutBodyWithResponse(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, added.JSON200)
+	assert.Equal(t, "none", *added.JSON200.Q)
+}
+
+func TestRequestWithoutBody(t *testing.T) {
+	req, err := NewAddItemRequestWithoutBody("https://example.com")
+	require.NoError(t, err)
+	assert.Nil(t, req.Body)
+	assert.Empty(t, req.Header.Values("Content-Type"))
+}
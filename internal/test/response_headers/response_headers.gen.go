@@ -0,0 +1,2386 @@
+// Package responseheaders provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package responseheaders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// Defines values for XRateLimitPolicy.
+const (
+	Fixed   XRateLimitPolicy = "fixed"
+	Sliding XRateLimitPolicy = "sliding"
+)
+
+// IsValid reports whether v is one of the values of XRateLimitPolicy.
+func (v XRateLimitPolicy) IsValid() bool {
+	switch v {
+	case Fixed, Sliding:
+		return true
+	default:
+		return false
+	}
+}
+
+// XRateLimitLimit defines model for X-Rate-Limit-Limit.
+type XRateLimitLimit = int
+
+// XRateLimitPolicy defines model for X-Rate-Limit-Policy.
+type XRateLimitPolicy string
+
+// XRateLimitRemaining defines model for X-Rate-Limit-Remaining.
+type XRateLimitRemaining = int
+
+// RequestID defines model for X-Request-Id.
+type RequestID = string
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network. They're applied in order, before the ones given to the call.
+	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListClinics request
+	ListClinics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOwners request
+	ListOwners(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPets request
+	ListPets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListVets request
+	ListVets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListVisits request
+	ListVisits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListClinics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClinicsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "ListClinics", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListOwners(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOwnersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "ListOwners", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPetsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "ListPets", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListVets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListVetsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "ListVets", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListVisits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListVisitsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "ListVisits", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListClinicsRequest generates requests for ListClinics
+func NewListClinicsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clinics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListOwnersRequest generates requests for ListOwners
+func NewListOwnersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/owners")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPetsRequest generates requests for ListPets
+func NewListPetsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListVetsRequest generates requests for ListVets
+func NewListVetsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListVisitsRequest generates requests for ListVisits
+func NewListVisitsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/visits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
+		}
+	}
+	for i, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListClinicsWithResponse request
+	ListClinicsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClinicsResponse, error)
+
+	// ListOwnersWithResponse request
+	ListOwnersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOwnersResponse, error)
+
+	// ListPetsWithResponse request
+	ListPetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPetsResponse, error)
+
+	// ListVetsWithResponse request
+	ListVetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListVetsResponse, error)
+
+	// ListVisitsWithResponse request
+	ListVisitsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListVisitsResponse, error)
+}
+
+// ListClinicsWithResponse request returning *ListClinicsResponse
+func (c *ClientWithResponses) ListClinicsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClinicsResponse, error) {
+	rsp, err := c.ListClinics(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseListClinicsResponseWithoutBody(rsp)
+	}
+	return ParseListClinicsResponse(rsp)
+}
+
+// parseListClinicsResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseListClinicsResponseWithoutBody(rsp *http.Response) (*ListClinicsResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &ListClinicsResponse{
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers ListClinics200ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 429:
+		var headers ListClinics429ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers429 = &headers
+	}
+
+	return response, nil
+}
+
+// ListOwnersWithResponse request returning *ListOwnersResponse
+func (c *ClientWithResponses) ListOwnersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOwnersResponse, error) {
+	rsp, err := c.ListOwners(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseListOwnersResponseWithoutBody(rsp)
+	}
+	return ParseListOwnersResponse(rsp)
+}
+
+// parseListOwnersResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseListOwnersResponseWithoutBody(rsp *http.Response) (*ListOwnersResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &ListOwnersResponse{
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers ListOwners200ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 429:
+		var headers ListOwners429ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers429 = &headers
+	}
+
+	return response, nil
+}
+
+// ListPetsWithResponse request returning *ListPetsResponse
+func (c *ClientWithResponses) ListPetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPetsResponse, error) {
+	rsp, err := c.ListPets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseListPetsResponseWithoutBody(rsp)
+	}
+	return ParseListPetsResponse(rsp)
+}
+
+// parseListPetsResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseListPetsResponseWithoutBody(rsp *http.Response) (*ListPetsResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &ListPetsResponse{
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers ListPets200ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 429:
+		var headers ListPets429ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers429 = &headers
+	}
+
+	return response, nil
+}
+
+// ListVetsWithResponse request returning *ListVetsResponse
+func (c *ClientWithResponses) ListVetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListVetsResponse, error) {
+	rsp, err := c.ListVets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseListVetsResponseWithoutBody(rsp)
+	}
+	return ParseListVetsResponse(rsp)
+}
+
+// parseListVetsResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseListVetsResponseWithoutBody(rsp *http.Response) (*ListVetsResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &ListVetsResponse{
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers ListVets200ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 429:
+		var headers ListVets429ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers429 = &headers
+	}
+
+	return response, nil
+}
+
+// ListVisitsWithResponse request returning *ListVisitsResponse
+func (c *ClientWithResponses) ListVisitsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListVisitsResponse, error) {
+	rsp, err := c.ListVisits(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseListVisitsResponseWithoutBody(rsp)
+	}
+	return ParseListVisitsResponse(rsp)
+}
+
+// parseListVisitsResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseListVisitsResponseWithoutBody(rsp *http.Response) (*ListVisitsResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &ListVisitsResponse{
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers ListVisits200ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 429:
+		var headers ListVisits429ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers429 = &headers
+	}
+
+	return response, nil
+}
+
+// ListClinicsResponse is the response of ListClinics. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type ListClinicsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+	Headers200   *ListClinics200ResponseHeaders
+	Headers429   *ListClinics429ResponseHeaders
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClinicsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClinicsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListOwnersResponse is the response of ListOwners. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type ListOwnersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+	Headers200   *ListOwners200ResponseHeaders
+	Headers429   *ListOwners429ResponseHeaders
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOwnersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOwnersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListPetsResponse is the response of ListPets. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type ListPetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+	Headers200   *ListPets200ResponseHeaders
+	Headers429   *ListPets429ResponseHeaders
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListVetsResponse is the response of ListVets. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type ListVetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+	Headers200   *ListVets200ResponseHeaders
+	Headers429   *ListVets429ResponseHeaders
+}
+
+// Status returns HTTPResponse.Status
+func (r ListVetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListVetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListVisitsResponse is the response of ListVisits. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type ListVisitsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+	Headers200   *ListVisits200ResponseHeaders
+	Headers429   *ListVisits429ResponseHeaders
+}
+
+// Status returns HTTPResponse.Status
+func (r ListVisitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListVisitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ParseListClinicsResponse parses an HTTP response from a ListClinicsWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseListClinicsResponse(rsp *http.Response) (*ListClinicsResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClinicsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers ListClinics200ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 429:
+		var headers ListClinics429ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers429 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseListOwnersResponse parses an HTTP response from a ListOwnersWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseListOwnersResponse(rsp *http.Response) (*ListOwnersResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOwnersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers ListOwners200ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 429:
+		var headers ListOwners429ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers429 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseListPetsResponse parses an HTTP response from a ListPetsWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseListPetsResponse(rsp *http.Response) (*ListPetsResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers ListPets200ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 429:
+		var headers ListPets429ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers429 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseListVetsResponse parses an HTTP response from a ListVetsWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseListVetsResponse(rsp *http.Response) (*ListVetsResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListVetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers ListVets200ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 429:
+		var headers ListVets429ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers429 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseListVisitsResponse parses an HTTP response from a ListVisitsWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseListVisitsResponse(rsp *http.Response) (*ListVisitsResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListVisitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers ListVisits200ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	case rsp.StatusCode == 429:
+		var headers ListVisits429ResponseHeaders
+		if value := rsp.Header.Get("X-Rate-Limit-Limit"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Limit", value, &headers.XRateLimitLimit, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Limit: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Policy"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Policy", value, &headers.XRateLimitPolicy, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Policy: %w", err)
+			}
+			switch headers.XRateLimitPolicy {
+			case "fixed", "sliding":
+			default:
+				return nil, fmt.Errorf("invalid value %q for header X-Rate-Limit-Policy", value)
+			}
+		}
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Rate-Limit-Remaining", value, &headers.XRateLimitRemaining, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("X-Request-Id"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "X-Request-Id", value, &headers.XRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Request-Id: %w", err)
+			}
+		}
+		response.Headers429 = &headers
+	}
+
+	return response, nil
+}
+
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "ListClinics":
+		return ParseListClinicsResponse(rsp)
+	case "ListOwners":
+		return ParseListOwnersResponse(rsp)
+	case "ListPets":
+		return ParseListPetsResponse(rsp)
+	case "ListVets":
+		return ParseListVetsResponse(rsp)
+	case "ListVisits":
+		return ParseListVisitsResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /clinics)
+	ListClinics(w http.ResponseWriter, r *http.Request)
+
+	// (GET /owners)
+	ListOwners(w http.ResponseWriter, r *http.Request)
+
+	// (GET /pets)
+	ListPets(w http.ResponseWriter, r *http.Request)
+
+	// (GET /vets)
+	ListVets(w http.ResponseWriter, r *http.Request)
+
+	// (GET /visits)
+	ListVisits(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /clinics)
+func (_ Unimplemented) ListClinics(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /owners)
+func (_ Unimplemented) ListOwners(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /pets)
+func (_ Unimplemented) ListPets(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /vets)
+func (_ Unimplemented) ListVets(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /visits)
+func (_ Unimplemented) ListVisits(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListClinics operation middleware
+func (siw *ServerInterfaceWrapper) ListClinics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListClinics(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListOwners operation middleware
+func (siw *ServerInterfaceWrapper) ListOwners(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOwners(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListPets operation middleware
+func (siw *ServerInterfaceWrapper) ListPets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPets(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListVets operation middleware
+func (siw *ServerInterfaceWrapper) ListVets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVets(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListVisits operation middleware
+func (siw *ServerInterfaceWrapper) ListVisits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVisits(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   errorHandlerFunc,
+	}
+}
+
+// NewListClinicsHandler returns the handler HandlerWithOptions routes the
+// ListClinics operation to, so that it can be mounted on its own.
+func NewListClinicsHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ListClinics
+}
+
+// NewListOwnersHandler returns the handler HandlerWithOptions routes the
+// ListOwners operation to, so that it can be mounted on its own.
+func NewListOwnersHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ListOwners
+}
+
+// NewListPetsHandler returns the handler HandlerWithOptions routes the
+// ListPets operation to, so that it can be mounted on its own.
+func NewListPetsHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ListPets
+}
+
+// NewListVetsHandler returns the handler HandlerWithOptions routes the
+// ListVets operation to, so that it can be mounted on its own.
+func NewListVetsHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ListVets
+}
+
+// NewListVisitsHandler returns the handler HandlerWithOptions routes the
+// ListVisits operation to, so that it can be mounted on its own.
+func NewListVisitsHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ListVisits
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/clinics", NewListClinicsHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/owners", NewListOwnersHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pets", NewListPetsHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vets", NewListVetsHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/visits", NewListVisitsHandler(si, options))
+	})
+
+	return r
+}
+
+type ListClinicsRequestObject struct {
+}
+
+type ListClinicsResponseObject interface {
+	VisitListClinicsResponse(w http.ResponseWriter) error
+}
+
+type ListClinics200ResponseHeaders struct {
+	XRateLimitLimit     XRateLimitLimit
+	XRateLimitPolicy    XRateLimitPolicy
+	XRateLimitRemaining XRateLimitRemaining
+	XRequestId          RequestID
+}
+
+type ListClinics200JSONResponse struct {
+	Body    []string
+	Headers ListClinics200ResponseHeaders
+}
+
+func (response ListClinics200JSONResponse) VisitListClinicsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Rate-Limit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-Rate-Limit-Policy", fmt.Sprint(response.Headers.XRateLimitPolicy))
+	w.Header().Set("X-Rate-Limit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-Request-Id", fmt.Sprint(response.Headers.XRequestId))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListClinics429ResponseHeaders struct {
+	XRateLimitLimit     XRateLimitLimit
+	XRateLimitPolicy    XRateLimitPolicy
+	XRateLimitRemaining XRateLimitRemaining
+	XRequestId          RequestID
+}
+
+type ListClinics429Response struct {
+	Headers ListClinics429ResponseHeaders
+}
+
+func (response ListClinics429Response) VisitListClinicsResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Rate-Limit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-Rate-Limit-Policy", fmt.Sprint(response.Headers.XRateLimitPolicy))
+	w.Header().Set("X-Rate-Limit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-Request-Id", fmt.Sprint(response.Headers.XRequestId))
+	w.WriteHeader(429)
+	return nil
+}
+
+type ListOwnersRequestObject struct {
+}
+
+type ListOwnersResponseObject interface {
+	VisitListOwnersResponse(w http.ResponseWriter) error
+}
+
+type ListOwners200ResponseHeaders struct {
+	XRateLimitLimit     XRateLimitLimit
+	XRateLimitPolicy    XRateLimitPolicy
+	XRateLimitRemaining XRateLimitRemaining
+	XRequestId          RequestID
+}
+
+type ListOwners200JSONResponse struct {
+	Body    []string
+	Headers ListOwners200ResponseHeaders
+}
+
+func (response ListOwners200JSONResponse) VisitListOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Rate-Limit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-Rate-Limit-Policy", fmt.Sprint(response.Headers.XRateLimitPolicy))
+	w.Header().Set("X-Rate-Limit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-Request-Id", fmt.Sprint(response.Headers.XRequestId))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListOwners429ResponseHeaders struct {
+	XRateLimitLimit     XRateLimitLimit
+	XRateLimitPolicy    XRateLimitPolicy
+	XRateLimitRemaining XRateLimitRemaining
+	XRequestId          RequestID
+}
+
+type ListOwners429Response struct {
+	Headers ListOwners429ResponseHeaders
+}
+
+func (response ListOwners429Response) VisitListOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Rate-Limit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-Rate-Limit-Policy", fmt.Sprint(response.Headers.XRateLimitPolicy))
+	w.Header().Set("X-Rate-Limit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-Request-Id", fmt.Sprint(response.Headers.XRequestId))
+	w.WriteHeader(429)
+	return nil
+}
+
+type ListPetsRequestObject struct {
+}
+
+type ListPetsResponseObject interface {
+	VisitListPetsResponse(w http.ResponseWriter) error
+}
+
+type ListPets200ResponseHeaders struct {
+	XRateLimitLimit     XRateLimitLimit
+	XRateLimitPolicy    XRateLimitPolicy
+	XRateLimitRemaining XRateLimitRemaining
+	XRequestId          RequestID
+}
+
+type ListPets200JSONResponse struct {
+	Body    []string
+	Headers ListPets200ResponseHeaders
+}
+
+func (response ListPets200JSONResponse) VisitListPetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Rate-Limit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-Rate-Limit-Policy", fmt.Sprint(response.Headers.XRateLimitPolicy))
+	w.Header().Set("X-Rate-Limit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-Request-Id", fmt.Sprint(response.Headers.XRequestId))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListPets429ResponseHeaders struct {
+	XRateLimitLimit     XRateLimitLimit
+	XRateLimitPolicy    XRateLimitPolicy
+	XRateLimitRemaining XRateLimitRemaining
+	XRequestId          RequestID
+}
+
+type ListPets429Response struct {
+	Headers ListPets429ResponseHeaders
+}
+
+func (response ListPets429Response) VisitListPetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Rate-Limit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-Rate-Limit-Policy", fmt.Sprint(response.Headers.XRateLimitPolicy))
+	w.Header().Set("X-Rate-Limit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-Request-Id", fmt.Sprint(response.Headers.XRequestId))
+	w.WriteHeader(429)
+	return nil
+}
+
+type ListVetsRequestObject struct {
+}
+
+type ListVetsResponseObject interface {
+	VisitListVetsResponse(w http.ResponseWriter) error
+}
+
+type ListVets200ResponseHeaders struct {
+	XRateLimitLimit     XRateLimitLimit
+	XRateLimitPolicy    XRateLimitPolicy
+	XRateLimitRemaining XRateLimitRemaining
+	XRequestId          RequestID
+}
+
+type ListVets200JSONResponse struct {
+	Body    []string
+	Headers ListVets200ResponseHeaders
+}
+
+func (response ListVets200JSONResponse) VisitListVetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Rate-Limit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-Rate-Limit-Policy", fmt.Sprint(response.Headers.XRateLimitPolicy))
+	w.Header().Set("X-Rate-Limit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-Request-Id", fmt.Sprint(response.Headers.XRequestId))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListVets429ResponseHeaders struct {
+	XRateLimitLimit     XRateLimitLimit
+	XRateLimitPolicy    XRateLimitPolicy
+	XRateLimitRemaining XRateLimitRemaining
+	XRequestId          RequestID
+}
+
+type ListVets429Response struct {
+	Headers ListVets429ResponseHeaders
+}
+
+func (response ListVets429Response) VisitListVetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Rate-Limit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-Rate-Limit-Policy", fmt.Sprint(response.Headers.XRateLimitPolicy))
+	w.Header().Set("X-Rate-Limit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-Request-Id", fmt.Sprint(response.Headers.XRequestId))
+	w.WriteHeader(429)
+	return nil
+}
+
+type ListVisitsRequestObject struct {
+}
+
+type ListVisitsResponseObject interface {
+	VisitListVisitsResponse(w http.ResponseWriter) error
+}
+
+type ListVisits200ResponseHeaders struct {
+	XRateLimitLimit     XRateLimitLimit
+	XRateLimitPolicy    XRateLimitPolicy
+	XRateLimitRemaining XRateLimitRemaining
+	XRequestId          RequestID
+}
+
+type ListVisits200JSONResponse struct {
+	Body    []string
+	Headers ListVisits200ResponseHeaders
+}
+
+func (response ListVisits200JSONResponse) VisitListVisitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Rate-Limit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-Rate-Limit-Policy", fmt.Sprint(response.Headers.XRateLimitPolicy))
+	w.Header().Set("X-Rate-Limit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-Request-Id", fmt.Sprint(response.Headers.XRequestId))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListVisits429ResponseHeaders struct {
+	XRateLimitLimit     XRateLimitLimit
+	XRateLimitPolicy    XRateLimitPolicy
+	XRateLimitRemaining XRateLimitRemaining
+	XRequestId          RequestID
+}
+
+type ListVisits429Response struct {
+	Headers ListVisits429ResponseHeaders
+}
+
+func (response ListVisits429Response) VisitListVisitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Rate-Limit-Limit", fmt.Sprint(response.Headers.XRateLimitLimit))
+	w.Header().Set("X-Rate-Limit-Policy", fmt.Sprint(response.Headers.XRateLimitPolicy))
+	w.Header().Set("X-Rate-Limit-Remaining", fmt.Sprint(response.Headers.XRateLimitRemaining))
+	w.Header().Set("X-Request-Id", fmt.Sprint(response.Headers.XRequestId))
+	w.WriteHeader(429)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /clinics)
+	ListClinics(ctx context.Context, request ListClinicsRequestObject) (ListClinicsResponseObject, error)
+
+	// (GET /owners)
+	ListOwners(ctx context.Context, request ListOwnersRequestObject) (ListOwnersResponseObject, error)
+
+	// (GET /pets)
+	ListPets(ctx context.Context, request ListPetsRequestObject) (ListPetsResponseObject, error)
+
+	// (GET /vets)
+	ListVets(ctx context.Context, request ListVetsRequestObject) (ListVetsResponseObject, error)
+
+	// (GET /visits)
+	ListVisits(ctx context.Context, request ListVisitsRequestObject) (ListVisitsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListClinics operation middleware
+func (sh *strictHandler) ListClinics(w http.ResponseWriter, r *http.Request) {
+	var request ListClinicsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListClinics(ctx, request.(ListClinicsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListClinics")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "ListClinics"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListClinicsResponseObject); ok {
+		if err := validResponse.VisitListClinicsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOwners operation middleware
+func (sh *strictHandler) ListOwners(w http.ResponseWriter, r *http.Request) {
+	var request ListOwnersRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOwners(ctx, request.(ListOwnersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOwners")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "ListOwners"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOwnersResponseObject); ok {
+		if err := validResponse.VisitListOwnersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPets operation middleware
+func (sh *strictHandler) ListPets(w http.ResponseWriter, r *http.Request) {
+	var request ListPetsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPets(ctx, request.(ListPetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPets")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "ListPets"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPetsResponseObject); ok {
+		if err := validResponse.VisitListPetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListVets operation middleware
+func (sh *strictHandler) ListVets(w http.ResponseWriter, r *http.Request) {
+	var request ListVetsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListVets(ctx, request.(ListVetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListVets")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "ListVets"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListVetsResponseObject); ok {
+		if err := validResponse.VisitListVetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListVisits operation middleware
+func (sh *strictHandler) ListVisits(w http.ResponseWriter, r *http.Request) {
+	var request ListVisitsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListVisits(ctx, request.(ListVisitsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListVisits")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "ListVisits"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListVisitsResponseObject); ok {
+		if err := validResponse.VisitListVisitsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// ListClinicsHandler handles the ListClinics operation with its typed request and response objects.
+type ListClinicsHandler func(ctx context.Context, request ListClinicsRequestObject) (ListClinicsResponseObject, error)
+
+// ListOwnersHandler handles the ListOwners operation with its typed request and response objects.
+type ListOwnersHandler func(ctx context.Context, request ListOwnersRequestObject) (ListOwnersResponseObject, error)
+
+// ListPetsHandler handles the ListPets operation with its typed request and response objects.
+type ListPetsHandler func(ctx context.Context, request ListPetsRequestObject) (ListPetsResponseObject, error)
+
+// ListVetsHandler handles the ListVets operation with its typed request and response objects.
+type ListVetsHandler func(ctx context.Context, request ListVetsRequestObject) (ListVetsResponseObject, error)
+
+// ListVisitsHandler handles the ListVisits operation with its typed request and response objects.
+type ListVisitsHandler func(ctx context.Context, request ListVisitsRequestObject) (ListVisitsResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnListClinics func(next ListClinicsHandler) ListClinicsHandler
+	OnListOwners  func(next ListOwnersHandler) ListOwnersHandler
+	OnListPets    func(next ListPetsHandler) ListPetsHandler
+	OnListVets    func(next ListVetsHandler) ListVetsHandler
+	OnListVisits  func(next ListVisitsHandler) ListVisitsHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) ListClinics(ctx context.Context, request ListClinicsRequestObject) (ListClinicsResponseObject, error) {
+	handler := ListClinicsHandler(s.ssi.ListClinics)
+	if s.middlewares.OnListClinics != nil {
+		handler = s.middlewares.OnListClinics(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) ListOwners(ctx context.Context, request ListOwnersRequestObject) (ListOwnersResponseObject, error) {
+	handler := ListOwnersHandler(s.ssi.ListOwners)
+	if s.middlewares.OnListOwners != nil {
+		handler = s.middlewares.OnListOwners(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) ListPets(ctx context.Context, request ListPetsRequestObject) (ListPetsResponseObject, error) {
+	handler := ListPetsHandler(s.ssi.ListPets)
+	if s.middlewares.OnListPets != nil {
+		handler = s.middlewares.OnListPets(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) ListVets(ctx context.Context, request ListVetsRequestObject) (ListVetsResponseObject, error) {
+	handler := ListVetsHandler(s.ssi.ListVets)
+	if s.middlewares.OnListVets != nil {
+		handler = s.middlewares.OnListVets(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) ListVisits(ctx context.Context, request ListVisitsRequestObject) (ListVisitsResponseObject, error) {
+	handler := ListVisitsHandler(s.ssi.ListVisits)
+	if s.middlewares.OnListVisits != nil {
+		handler = s.middlewares.OnListVisits(handler)
+	}
+	return handler(ctx, request)
+}
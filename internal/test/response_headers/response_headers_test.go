@@ -0,0 +1,113 @@
+package responseheaders
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct {
+	StrictServerInterface
+	policy XRateLimitPolicy
+}
+
+func (s server) ListPets(ctx context.Context, request ListPetsRequestObject) (ListPetsResponseObject, error) {
+	return ListPets429Response{
+		Headers: ListPets429ResponseHeaders{
+			XRateLimitLimit:     10,
+			XRateLimitPolicy:    s.policy,
+			XRateLimitRemaining: 0,
+			XRequestId:          "abc",
+		},
+	}, nil
+}
+
+func TestSharedResponseHeaders(t *testing.T) {
+	ts := httptest.NewServer(Handler(NewStrictHandler(server{policy: Sliding}, nil)))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.ListPetsWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, rsp.StatusCode())
+	assert.Nil(t, rsp.Headers200)
+	require.NotNil(t, rsp.Headers429)
+	assert.Equal(t, ListPets429ResponseHeaders{
+		XRateLimitLimit:     10,
+		XRateLimitPolicy:    Sliding,
+		XRateLimitRemaining: 0,
+		XRequestId:          "abc",
+	}, *rsp.Headers429)
+}
+
+func TestSharedResponseHeadersRejectUnknownEnumValue(t *testing.T) {
+	ts := httptest.NewServer(Handler(NewStrictHandler(server{policy: "leaky"}, nil)))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	_, err = client.ListPetsWithResponse(context.Background())
+	assert.ErrorContains(t, err, `invalid value "leaky" for header X-Rate-Limit-Policy`)
+}
+
+func TestSharedResponseHeadersAreDefinedOnce(t *testing.T) {
+	code, err := os.ReadFile("response_headers.gen.go")
+	require.NoError(t, err)
+
+	for _, typeName := range []string{"XRateLimitLimit", "XRateLimitRemaining", "XRateLimitPolicy", "RequestID"} {
+		definitions := regexp.MustCompile(`(?m)^type `+typeName+` `).FindAll(code, -1)
+		assert.Len(t, definitions, 1, typeName)
+	}
+}
+
+func TestWithoutResponseBodyParsing(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Rate-Limit-Limit", "10")
+		w.Header().Set("X-Rate-Limit-Policy", "fixed")
+		w.Header().Set("X-Rate-Limit-Remaining", "9")
+		w.Header().Set("X-Request-Id", "abc")
+		_, _ = w.Write([]byte(`["Fido"]`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.ListPetsWithResponse(context.Background(), WithoutResponseBodyParsing())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Nil(t, rsp.Body)
+	assert.Nil(t, rsp.JSON200)
+	assert.Equal(t, http.NoBody, rsp.HTTPResponse.Body)
+	assert.Equal(t, "application/json", rsp.HTTPResponse.Header.Get("Content-Type"))
+	require.NotNil(t, rsp.Headers200)
+	assert.Equal(t, RequestID("abc"), rsp.Headers200.XRequestId)
+
+	rsp, err = client.ListPetsWithResponse(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, []string{"Fido"}, *rsp.JSON200)
+
+	// The client may skip parsing by default, and have some calls parse the
+	// body anyway.
+	client, err = NewClientWithResponses(ts.URL, WithoutResponseBodyParsingByDefault())
+	require.NoError(t, err)
+	rsp, err = client.ListPetsWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, rsp.JSON200)
+	require.NotNil(t, rsp.Headers200)
+	rsp, err = client.ListPetsWithResponse(context.Background(), WithResponseBodyParsing())
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, []string{"Fido"}, *rsp.JSON200)
+}
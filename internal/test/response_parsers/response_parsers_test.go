@@ -0,0 +1,131 @@
+package responseparsers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// trackingBody records how many times it was closed.
+type trackingBody struct {
+	io.Reader
+	closed int
+}
+
+func (b *trackingBody) Close() error {
+	b.closed++
+	return nil
+}
+
+func cannedResponse(t *testing.T, status int, header http.Header, body string) *http.Response {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	for name, values := range header {
+		rec.Header()[name] = values
+	}
+	rec.WriteHeader(status)
+	// Statuses such as 204 don't allow a body, which the recorder refuses.
+	if body != "" {
+		_, err := rec.WriteString(body)
+		require.NoError(t, err)
+	}
+	return rec.Result()
+}
+
+func TestParse(t *testing.T) {
+	rsp := cannedResponse(t, http.StatusOK, http.Header{
+		"Content-Type": {"application/json"},
+		"X-Total":      {"2"},
+	}, `[{"name":"Fido"},{"name":"Rex"}]`)
+	pets, err := ParseListPetsResponse(rsp)
+	require.NoError(t, err)
+	require.NotNil(t, pets.JSON200)
+	assert.Equal(t, []Pet{{Name: "Fido"}, {Name: "Rex"}}, *pets.JSON200)
+	require.NotNil(t, pets.Headers200)
+	assert.Equal(t, 2, pets.Headers200.XTotal)
+	assert.Equal(t, http.StatusOK, pets.StatusCode())
+
+	rsp = cannedResponse(t, http.StatusNotFound, http.Header{"Content-Type": {"application/json"}}, `{"message":"no such pet"}`)
+	pet, err := ParseGetPetResponse(rsp)
+	require.NoError(t, err)
+	assert.Nil(t, pet.JSON200)
+	require.NotNil(t, pet.JSONDefault)
+	assert.Equal(t, "no such pet", pet.JSONDefault.Message)
+
+	rsp = cannedResponse(t, http.StatusOK, http.Header{"Content-Type": {"application/json"}}, `{"name":`)
+	_, err = ParseGetPetResponse(rsp)
+	assert.Error(t, err)
+}
+
+func TestParseBody(t *testing.T) {
+	body := &trackingBody{Reader: bytes.NewBufferString(`{"name":"Fido"}`)}
+	rsp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       body,
+	}
+	pet, err := ParseGetPetResponse(rsp)
+	require.NoError(t, err)
+	assert.Equal(t, 1, body.closed)
+	assert.Equal(t, []byte(`{"name":"Fido"}`), pet.Body)
+
+	// The response can be parsed again, and its body read, without touching
+	// the original body.
+	again, err := ParseGetPetResponse(rsp)
+	require.NoError(t, err)
+	assert.Equal(t, pet.JSON200, again.JSON200)
+	assert.Equal(t, 1, body.closed)
+	read, err := io.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"Fido"}`, string(read))
+	assert.NoError(t, rsp.Body.Close())
+
+	// A body read beforehand is put back replaying what was read.
+	rsp = cannedResponse(t, http.StatusOK, http.Header{"Content-Type": {"application/json"}}, `{"name":"Rex"}`)
+	read, err = io.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	require.NoError(t, rsp.Body.Close())
+	rsp.Body = io.NopCloser(bytes.NewReader(read))
+	pet, err = ParseGetPetResponse(rsp)
+	require.NoError(t, err)
+	assert.Equal(t, &Pet{Name: "Rex"}, pet.JSON200)
+
+	deleted, err := ParseDeletePetResponse(&http.Response{StatusCode: http.StatusNoContent})
+	require.NoError(t, err)
+	assert.Empty(t, deleted.Body)
+
+	_, err = ParseDeletePetResponse(nil)
+	assert.Error(t, err)
+
+	failing := &trackingBody{Reader: io.MultiReader(bytes.NewBufferString("{"), errorReader{})}
+	_, err = ParseGetPetResponse(&http.Response{StatusCode: http.StatusOK, Body: failing})
+	assert.Error(t, err)
+	assert.Equal(t, 1, failing.closed)
+}
+
+type errorReader struct{}
+
+func (errorReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestParseResponse(t *testing.T) {
+	rsp := cannedResponse(t, http.StatusOK, http.Header{"Content-Type": {"application/json"}}, `{"name":"Fido"}`)
+	parsed, err := ParseResponse("GetPet", rsp)
+	require.NoError(t, err)
+	require.IsType(t, &GetPetResponse{}, parsed)
+	assert.Equal(t, &Pet{Name: "Fido"}, parsed.(*GetPetResponse).JSON200)
+
+	parsed, err = ParseResponse("DeletePet", cannedResponse(t, http.StatusNoContent, nil, ""))
+	require.NoError(t, err)
+	assert.IsType(t, &DeletePetResponse{}, parsed)
+
+	_, err = ParseResponse("FeedPet", rsp)
+	assert.EqualError(t, err, `unknown operation "FeedPet"`)
+}
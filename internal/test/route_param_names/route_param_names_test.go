@@ -0,0 +1,128 @@
+package routeparamnames
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/v2/internal/test/route_param_names/chi"
+	echoapi "github.com/deepmap/oapi-codegen/v2/internal/test/route_param_names/echo"
+	"github.com/deepmap/oapi-codegen/v2/internal/test/route_param_names/gorilla"
+)
+
+// The operations of equivalent paths are all routed, each binding the
+// parameters it declares.
+var routeTests = []struct {
+	method, path, body string
+}{
+	{http.MethodGet, "/items/1", "get 1"},
+	{http.MethodPut, "/items/2", "put 2"},
+	{http.MethodGet, "/items/3/tags/red", "get 3 red"},
+	{http.MethodDelete, "/items/4/tags/5", "delete 4 5"},
+}
+
+func testRoutes(t *testing.T, handler http.Handler) {
+	for _, tt := range routeTests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+		assert.Equal(t, http.StatusOK, rec.Code, "%s %s", tt.method, tt.path)
+		assert.Equal(t, tt.body, rec.Body.String(), "%s %s", tt.method, tt.path)
+	}
+}
+
+type chiServer struct{}
+
+func (chiServer) GetItem(w http.ResponseWriter, r *http.Request, id string) {
+	fmt.Fprintf(w, "get %s", id)
+}
+
+func (chiServer) PutItem(w http.ResponseWriter, r *http.Request, itemId string) {
+	fmt.Fprintf(w, "put %s", itemId)
+}
+
+func (chiServer) GetItemTag(w http.ResponseWriter, r *http.Request, id string, tag string) {
+	fmt.Fprintf(w, "get %s %s", id, tag)
+}
+
+func (chiServer) DeleteItemTag(w http.ResponseWriter, r *http.Request, itemId string, name int) {
+	fmt.Fprintf(w, "delete %s %d", itemId, name)
+}
+
+func TestChiRoutes(t *testing.T) {
+	testRoutes(t, chi.Handler(chiServer{}))
+}
+
+func TestClientSubstitutesOwnParameterNames(t *testing.T) {
+	server := httptest.NewServer(chi.Handler(chiServer{}))
+	defer server.Close()
+
+	client, err := chi.NewClient(server.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.PutItem(context.Background(), "7")
+	require.NoError(t, err)
+	body, err := io.ReadAll(rsp.Body)
+	rsp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "put 7", string(body))
+
+	rsp, err = client.DeleteItemTag(context.Background(), "8", 9)
+	require.NoError(t, err)
+	body, err = io.ReadAll(rsp.Body)
+	rsp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "delete 8 9", string(body))
+}
+
+func TestCORSPolicyMergesEquivalentPaths(t *testing.T) {
+	assert.Equal(t, map[string]chi.CORSPathPolicy{
+		"/items/{id}":            {Methods: []string{"GET", "PUT"}},
+		"/items/{id}/tags/{tag}": {Methods: []string{"DELETE", "GET"}},
+	}, chi.CORSPolicy())
+
+	handler := chi.CORSMiddleware(chi.CORSOptions{AllowedOrigins: []string{"*"}})(chi.Handler(chiServer{}))
+	req := httptest.NewRequest(http.MethodOptions, "/items/2", nil)
+	req.Header.Set("Origin", "https://example.org")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPut)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, "GET, PUT", rec.Header().Get("Access-Control-Allow-Methods"))
+}
+
+type echoServer struct{}
+
+func (echoServer) GetItem(ctx echo.Context, id string) error {
+	return ctx.String(http.StatusOK, "get "+id)
+}
+
+func (echoServer) PutItem(ctx echo.Context, itemId string) error {
+	return ctx.String(http.StatusOK, "put "+itemId)
+}
+
+func (echoServer) GetItemTag(ctx echo.Context, id string, tag string) error {
+	return ctx.String(http.StatusOK, "get "+id+" "+tag)
+}
+
+func (echoServer) DeleteItemTag(ctx echo.Context, itemId string, name int) error {
+	return ctx.String(http.StatusOK, fmt.Sprintf("delete %s %d", itemId, name))
+}
+
+func TestEchoRoutes(t *testing.T) {
+	e := echo.New()
+	echoapi.RegisterHandlers(e, echoServer{})
+	testRoutes(t, e)
+}
+
+type gorillaServer struct{ chiServer }
+
+func TestGorillaRoutes(t *testing.T) {
+	testRoutes(t, gorilla.HandlerFromMux(gorillaServer{}, mux.NewRouter()))
+}
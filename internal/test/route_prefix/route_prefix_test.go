@@ -0,0 +1,60 @@
+package routeprefix
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct{}
+
+func (server) GetPet(ctx echo.Context, id int) error {
+	return ctx.JSON(http.StatusOK, Pet{Id: id, Name: "Fido"})
+}
+
+func TestRoutePrefixInsideBaseURL(t *testing.T) {
+	e := echo.New()
+	RegisterHandlersWithBaseURL(e, server{}, "/api")
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	client, err := NewClientWithResponses(ts.URL + "/api")
+	require.NoError(t, err)
+
+	rsp, err := client.GetPetWithResponse(context.Background(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v1/pets/7", rsp.HTTPResponse.Request.URL.Path)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, Pet{Id: 7, Name: "Fido"}, *rsp.JSON200)
+
+	unprefixed, err := http.Get(ts.URL + "/api/pets/7")
+	require.NoError(t, err)
+	defer unprefixed.Body.Close()
+	assert.Equal(t, http.StatusNotFound, unprefixed.StatusCode)
+}
+
+func TestRoutePrefixInCORSPolicy(t *testing.T) {
+	policy := CORSPolicy()
+	assert.Contains(t, policy, "/v1/pets/{id}")
+	assert.NotContains(t, policy, "/pets/{id}")
+}
+
+func TestRoutePrefixInServedSpec(t *testing.T) {
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+	assert.NotNil(t, swagger.Paths.Value("/v1/pets/{id}"))
+	assert.Nil(t, swagger.Paths.Value("/pets/{id}"))
+
+	// The embedded spec itself keeps the paths it was written with.
+	data, err := rawSpec()
+	require.NoError(t, err)
+	embedded, err := openapi3.NewLoader().LoadFromData(data)
+	require.NoError(t, err)
+	assert.NotNil(t, embedded.Paths.Value("/pets/{id}"))
+}
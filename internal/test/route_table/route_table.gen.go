@@ -0,0 +1,204 @@
+// Package routetable provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package routetable
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// OperationRoute describes how an operation of the API is routed and secured.
+type OperationRoute struct {
+	// OperationID is the operationId of the operation as named in the
+	// generated code, eg, GetPet.
+	OperationID string
+	Method      string
+	// Path is the path template the operation is served on, eg, /pets/{id}.
+	Path string
+	// Security lists the alternative security requirements of the operation,
+	// any of which grants access. Each maps the names of security schemes to
+	// the scopes they require, and an empty one allows anonymous access. It
+	// is empty when the operation isn't secured.
+	Security []map[string][]string
+}
+
+// OperationRoutes returns the operations of the API, sorted by path and method.
+func OperationRoutes() []OperationRoute {
+	return []OperationRoute{
+		{
+			OperationID: "Health",
+			Method:      "GET",
+			Path:        "/health",
+		},
+		{
+			OperationID: "ListPets",
+			Method:      "GET",
+			Path:        "/pets",
+			Security: []map[string][]string{
+				{},
+				{"oauth": {"read"}},
+			},
+		},
+		{
+			OperationID: "AddPet",
+			Method:      "POST",
+			Path:        "/pets",
+			Security: []map[string][]string{
+				{"api_key": {}, "oauth": {"read", "write"}},
+			},
+		},
+		{
+			OperationID: "ListMyPets",
+			Method:      "GET",
+			Path:        "/pets/mine",
+			Security: []map[string][]string{
+				{"api_key": {}},
+			},
+		},
+		{
+			OperationID: "GetPet",
+			Method:      "GET",
+			Path:        "/pets/{id}",
+			Security: []map[string][]string{
+				{"api_key": {}},
+			},
+		},
+	}
+}
+
+// FindOperation returns the route of the operation serving a request for
+// method and path, along with the values of the path parameters, or nil when
+// there is none. A {param} segment of a path template matches any single
+// segment, and templates with more fixed segments take precedence.
+func FindOperation(method string, path string) (*OperationRoute, map[string]string) {
+	segments := strings.Split(path, "/")
+	var found *OperationRoute
+	var foundParams map[string]string
+	fixed := -1
+	routes := OperationRoutes()
+	for i := range routes {
+		route := &routes[i]
+		if !strings.EqualFold(route.Method, method) {
+			continue
+		}
+		templateSegments := strings.Split(route.Path, "/")
+		if len(templateSegments) != len(segments) {
+			continue
+		}
+		params := make(map[string]string)
+		matched, n := true, 0
+		for j, s := range templateSegments {
+			if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+				if segments[j] == "" {
+					matched = false
+					break
+				}
+				params[s[1:len(s)-1]] = segments[j]
+				continue
+			}
+			if s != segments[j] {
+				matched = false
+				break
+			}
+			n++
+		}
+		if matched && n > fixed {
+			found, foundParams, fixed = route, params, n
+		}
+	}
+	return found, foundParams
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/6RT32/TPhD/V6b7fh+9pRs85W3wABMgVQMEUhUhL742tyW2sa+MqPL/js5uKW3XAuLp",
+	"kkt8n/v88ApaN3hn0XKEegWx7XDQ+XGKLMUH5zEwYW5aPaBUHj1CDZED2QWkpDYdd3ePLedOxHYZiMf3",
+	"MrMc156+POAoj2Shhg61wQBqPRc+n19Pb87f4Ag/B2pP8p4UOL3kTo7Oe/eYx7U9oeWXAQ1aJt2vKThf",
+	"0AJqAzXcSlHwGIhl4KdcZWP3gPZj6GUPZh/rqsLvevA9XrRuqPLnHWqCfwVJWmTnLutA3Mu3W7dkPGN9",
+	"1yMo+IYhkhOClxeTi0le3qPVnqCGZ7mlwGvu8ppVh7ovzBZFc1FcMzl7I/u/Lp8VBIze2VjIXU2eSzEY",
+	"20CeC1z5ddyRH+pZI++Vx+LxkyBvKfJUfjiAmWSlnWW0XDz0PbX5ZHUfBXYTm2wr45AP/h9wDjX8V20D",
+	"Vq3TVUm0trrqEPRYZN1l86HDs7z0Hp1VUqtNGmbF5SY1SYF38Qlq18YIoBD7usTIL5wZ/4rTb6nk3fdk",
+	"uzx059oYNAdktpdi1qg9WpvUCr2NhdVAFk/6+G484eRRibcAKzLpKMAr5KKm10EPyBhiZpHvs2R6e5vJ",
+	"rEWngAZqDktUv8i6tp8s4wIDpNT8Y/b+wKcjEcv0T/iSmvQjAAD//06nxdsqBQAA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,72 @@
+package routetable
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindOperation(t *testing.T) {
+	route, params := FindOperation("get", "/pets/7")
+	require.NotNil(t, route)
+	assert.Equal(t, "GetPet", route.OperationID)
+	assert.Equal(t, "/pets/{id}", route.Path)
+	assert.Equal(t, map[string]string{"id": "7"}, params)
+	assert.Equal(t, []map[string][]string{{"api_key": {}}}, route.Security)
+
+	// Fixed segments take precedence over parameters.
+	route, params = FindOperation("GET", "/pets/mine")
+	require.NotNil(t, route)
+	assert.Equal(t, "ListMyPets", route.OperationID)
+	assert.Empty(t, params)
+
+	route, _ = FindOperation("POST", "/pets")
+	require.NotNil(t, route)
+	assert.Equal(t, "AddPet", route.OperationID)
+	assert.Equal(t, []map[string][]string{{"api_key": {}, "oauth": {"read", "write"}}}, route.Security)
+
+	route, _ = FindOperation("GET", "/pets")
+	require.NotNil(t, route)
+	assert.Equal(t, []map[string][]string{{}, {"oauth": {"read"}}}, route.Security)
+
+	route, _ = FindOperation("GET", "/health")
+	require.NotNil(t, route)
+	assert.Empty(t, route.Security)
+
+	for _, request := range [][2]string{{"DELETE", "/pets"}, {"GET", "/pets/"}, {"GET", "/pets/7/toys"}, {"GET", "/owners"}} {
+		route, params := FindOperation(request[0], request[1])
+		assert.Nil(t, route, request)
+		assert.Nil(t, params, request)
+	}
+}
+
+func TestOperationRoutesMatchSpec(t *testing.T) {
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+
+	routes := OperationRoutes()
+	assert.Len(t, routes, 5)
+	for _, route := range routes {
+		item := swagger.Paths.Find(route.Path)
+		require.NotNil(t, item, route.Path)
+		assert.NotNil(t, item.GetOperation(route.Method), route.OperationID)
+	}
+}
+
+func TestOnlyRouteTableTypes(t *testing.T) {
+	// Nothing is generated for the schemas, so that the package doesn't need
+	// the ones of the domain.
+	file, err := parser.ParseFile(token.NewFileSet(), "route_table.gen.go", nil, 0)
+	require.NoError(t, err)
+	var types []string
+	for name, object := range file.Scope.Objects {
+		if object.Kind == ast.Typ {
+			types = append(types, name)
+		}
+	}
+	assert.Equal(t, []string{"OperationRoute"}, types)
+}
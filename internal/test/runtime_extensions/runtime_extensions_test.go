@@ -0,0 +1,37 @@
+package runtimeextensions
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decode returns the JSON in s as decoded by encoding/json, which the
+// generated values match.
+func decode(t *testing.T, s string) map[string]map[string]interface{} {
+	var v map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(s), &v))
+	return v
+}
+
+func TestOperationExtensions(t *testing.T) {
+	assert.Equal(t, decode(t, `{
+		"ListPets": {
+			"x-required-entitlements": ["pets:read", "pets:list"],
+			"x-rate-limit": {"requests": 100, "burst": 2.5, "enabled": true, "fallback": null}
+		}
+	}`), OperationExtensions())
+
+	// Operations without extensions are left out.
+	_, found := OperationExtensions()["AddPet"]
+	assert.False(t, found)
+}
+
+func TestFieldExtensions(t *testing.T) {
+	assert.Equal(t, decode(t, `{
+		"name": {"x-ui-widget": "text", "x-ui-order": 1},
+		"tags": {"x-ui-widget": {"name": "chips", "options": ["removable"]}}
+	}`), Pet{}.FieldExtensions())
+}
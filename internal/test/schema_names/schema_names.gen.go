@@ -0,0 +1,343 @@
+// Package schemanames provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package schemanames
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Defines values for Color.
+const (
+	Black Color = "black"
+	White Color = "white"
+)
+
+// IsValid reports whether v is one of the values of Color.
+func (v Color) IsValid() bool {
+	switch v {
+	case Black, White:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PetStatus.
+const (
+	PetStatusAvailable PetStatus = "available"
+	PetStatusSold      PetStatus = "sold"
+)
+
+// IsValid reports whether v is one of the values of PetStatus.
+func (v PetStatus) IsValid() bool {
+	switch v {
+	case PetStatusAvailable, PetStatusSold:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PetUpdateStatus.
+const (
+	PetUpdateStatusAvailable PetUpdateStatus = "available"
+	PetUpdateStatusSold      PetUpdateStatus = "sold"
+)
+
+// IsValid reports whether v is one of the values of PetUpdateStatus.
+func (v PetUpdateStatus) IsValid() bool {
+	switch v {
+	case PetUpdateStatusAvailable, PetUpdateStatusSold:
+		return true
+	default:
+		return false
+	}
+}
+
+// Anything defines model for Anything.
+type Anything = interface{}
+
+// Color defines model for Color.
+type Color string
+
+// Pet defines model for Pet.
+type Pet struct {
+	Color       *Color     `json:"color,omitempty"`
+	Name        string     `json:"name"`
+	Owner       *Pet_Owner `json:"owner,omitempty"`
+	SchemaName1 *string    `json:"schemaName,omitempty"`
+	Status      *PetStatus `json:"status,omitempty"`
+}
+
+// Pet_Owner defines model for Pet.Owner.
+type Pet_Owner struct {
+	Name                 *string           `json:"name,omitempty"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// PetStatus defines model for Pet.Status.
+type PetStatus string
+
+// PetUpdate defines model for PetUpdate.
+type PetUpdate struct {
+	Color       *Color           `json:"color,omitempty"`
+	Name        string           `json:"name"`
+	Owner       *PetUpdate_Owner `json:"owner,omitempty"`
+	Reason      *string          `json:"reason,omitempty"`
+	SchemaName1 *string          `json:"schemaName,omitempty"`
+	Status      *PetUpdateStatus `json:"status,omitempty"`
+}
+
+// PetUpdate_Owner defines model for PetUpdate.Owner.
+type PetUpdate_Owner struct {
+	Name                 *string           `json:"name,omitempty"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// PetUpdateStatus defines model for PetUpdate.Status.
+type PetUpdateStatus string
+
+// PutPetJSONBody defines parameters for PutPet.
+type PutPetJSONBody struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// PutPetJSONRequestBody defines body for PutPet for application/json ContentType.
+type PutPetJSONRequestBody PutPetJSONBody
+
+// Getter for additional properties for Pet_Owner. Returns the specified
+// element and whether it was found
+func (a Pet_Owner) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Pet_Owner
+func (a *Pet_Owner) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Pet_Owner to handle AdditionalProperties
+func (a *Pet_Owner) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Pet_Owner to handle AdditionalProperties
+func (a Pet_Owner) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Name != nil {
+		object["name"], err = json.Marshal(a.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'name': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for PetUpdate_Owner. Returns the specified
+// element and whether it was found
+func (a PetUpdate_Owner) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for PetUpdate_Owner
+func (a *PetUpdate_Owner) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for PetUpdate_Owner to handle AdditionalProperties
+func (a *PetUpdate_Owner) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for PetUpdate_Owner to handle AdditionalProperties
+func (a PetUpdate_Owner) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Name != nil {
+		object["name"], err = json.Marshal(a.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'name': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// SchemaName returns the name of the schema the Color type is generated from.
+func (Color) SchemaName() string {
+	return "Color"
+}
+
+// SchemaPointer returns the JSON pointer, in the spec, of the schema the
+// Color type is generated from.
+func (Color) SchemaPointer() string {
+	return "#/components/schemas/Color"
+}
+
+// SchemaName returns the name of the schema the Pet type is generated from.
+func (Pet) SchemaName() string {
+	return "Pet"
+}
+
+// SchemaPointer returns the JSON pointer, in the spec, of the schema the
+// Pet type is generated from.
+func (Pet) SchemaPointer() string {
+	return "#/components/schemas/Pet"
+}
+
+// SchemaName returns the name of the schema the Pet_Owner type is generated from.
+func (Pet_Owner) SchemaName() string {
+	return "Pet.Owner"
+}
+
+// SchemaPointer returns the JSON pointer, in the spec, of the schema the
+// Pet_Owner type is generated from.
+func (Pet_Owner) SchemaPointer() string {
+	return "#/components/schemas/Pet/properties/owner"
+}
+
+// SchemaName returns the name of the schema the PetStatus type is generated from.
+func (PetStatus) SchemaName() string {
+	return "Pet.Status"
+}
+
+// SchemaPointer returns the JSON pointer, in the spec, of the schema the
+// PetStatus type is generated from.
+func (PetStatus) SchemaPointer() string {
+	return "#/components/schemas/Pet/properties/status"
+}
+
+// SchemaName returns the name of the schema the PetUpdate type is generated from.
+func (PetUpdate) SchemaName() string {
+	return "PetUpdate"
+}
+
+// SchemaPointer returns the JSON pointer, in the spec, of the schema the
+// PetUpdate type is generated from.
+func (PetUpdate) SchemaPointer() string {
+	return "#/components/schemas/PetUpdate"
+}
+
+// SchemaName returns the name of the schema the PetUpdate_Owner type is generated from.
+func (PetUpdate_Owner) SchemaName() string {
+	return "PetUpdate.Owner"
+}
+
+// SchemaPointer returns the JSON pointer, in the spec, of the schema the
+// PetUpdate_Owner type is generated from.
+func (PetUpdate_Owner) SchemaPointer() string {
+	return "#/components/schemas/Pet/properties/owner"
+}
+
+// SchemaName returns the name of the schema the PetUpdateStatus type is generated from.
+func (PetUpdateStatus) SchemaName() string {
+	return "PetUpdate.Status"
+}
+
+// SchemaPointer returns the JSON pointer, in the spec, of the schema the
+// PetUpdateStatus type is generated from.
+func (PetUpdateStatus) SchemaPointer() string {
+	return "#/components/schemas/Pet/properties/status"
+}
+
+// SchemaName returns the name of the schema the PutPetJSONBody type is generated from.
+func (PutPetJSONBody) SchemaName() string {
+	return "PutPetJSONBody"
+}
+
+// SchemaPointer returns the JSON pointer, in the spec, of the schema the
+// PutPetJSONBody type is generated from.
+func (PutPetJSONBody) SchemaPointer() string {
+	return "#/paths/~1pets~1{id}/put/requestBody/content/application~1json/schema"
+}
+
+// SchemaName returns the name of the schema the PutPetJSONRequestBody type is generated from.
+func (PutPetJSONRequestBody) SchemaName() string {
+	return "PutPetJSONRequestBody"
+}
+
+// SchemaPointer returns the JSON pointer, in the spec, of the schema the
+// PutPetJSONRequestBody type is generated from.
+func (PutPetJSONRequestBody) SchemaPointer() string {
+	return "#/paths/~1pets~1{id}/put/requestBody/content/application~1json/schema"
+}
@@ -0,0 +1,41 @@
+package schemanames
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// schemaNamer is what an error reporting layer would look for on a value.
+type schemaNamer interface {
+	SchemaName() string
+	SchemaPointer() string
+}
+
+func TestSchemaNames(t *testing.T) {
+	for _, test := range []struct {
+		value   schemaNamer
+		name    string
+		pointer string
+	}{
+		{Pet{}, "Pet", "#/components/schemas/Pet"},
+		{Color(""), "Color", "#/components/schemas/Color"},
+		{PetUpdate{}, "PetUpdate", "#/components/schemas/PetUpdate"},
+		// Inline schemas are named after the path to them.
+		{PetStatus(""), "Pet.Status", "#/components/schemas/Pet/properties/status"},
+		{Pet_Owner{}, "Pet.Owner", "#/components/schemas/Pet/properties/owner"},
+		// Types merged from another schema point at the schema they come from.
+		{PetUpdateStatus(""), "PetUpdate.Status", "#/components/schemas/Pet/properties/status"},
+		{PutPetJSONBody{}, "PutPetJSONBody", "#/paths/~1pets~1{id}/put/requestBody/content/application~1json/schema"},
+		{PutPetJSONRequestBody{}, "PutPetJSONRequestBody", "#/paths/~1pets~1{id}/put/requestBody/content/application~1json/schema"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.name, test.value.SchemaName())
+			assert.Equal(t, test.pointer, test.value.SchemaPointer())
+		})
+	}
+
+	// The property which would collide with the method is renamed.
+	name := "n"
+	assert.Equal(t, &name, Pet{SchemaName1: &name}.SchemaName1)
+}
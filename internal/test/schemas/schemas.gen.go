@@ -10,8 +10,10 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
 	"path"
@@ -34,6 +36,16 @@ const (
 	Second EnumInObjInArrayVal = "second"
 )
 
+// IsValid reports whether v is one of the values of EnumInObjInArrayVal.
+func (v EnumInObjInArrayVal) IsValid() bool {
+	switch v {
+	case First, Second:
+		return true
+	default:
+		return false
+	}
+}
+
 // N5StartsWithNumber This schema name starts with a number
 type N5StartsWithNumber = map[string]interface{}
 
@@ -136,8 +148,12 @@ type Client struct {
 	Client HttpRequestDoer
 
 	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
+	// the network. They're applied in order, before the ones given to the call.
 	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -184,6 +200,84 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// EnsureEverythingIsReferenced request
@@ -198,6 +292,9 @@ type ClientInterface interface {
 	// Issue185WithBody request with any body
 	Issue185WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// Issue185WithoutBody request without the optional body
+	Issue185WithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	Issue185(ctx context.Context, body Issue185JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Issue209 request
@@ -215,6 +312,9 @@ type ClientInterface interface {
 	// Issue9WithBody request with any body
 	Issue9WithBody(ctx context.Context, params *Issue9Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// Issue9WithoutBody request without the optional body
+	Issue9WithoutBody(ctx context.Context, params *Issue9Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	Issue9(ctx context.Context, params *Issue9Params, body Issue9JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Issue975 request
@@ -227,7 +327,7 @@ func (c *Client) EnsureEverythingIsReferenced(ctx context.Context, reqEditors ..
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "EnsureEverythingIsReferenced", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -239,7 +339,7 @@ func (c *Client) Issue1051(ctx context.Context, reqEditors ...RequestEditorFn) (
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "Issue1051", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -251,7 +351,7 @@ func (c *Client) Issue127(ctx context.Context, reqEditors ...RequestEditorFn) (*
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "Issue127", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -263,7 +363,19 @@ func (c *Client) Issue185WithBody(ctx context.Context, contentType string, body
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "Issue185", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Issue185WithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssue185RequestWithoutBody(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "Issue185", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -275,7 +387,7 @@ func (c *Client) Issue185(ctx context.Context, body Issue185JSONRequestBody, req
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "Issue185", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -287,7 +399,7 @@ func (c *Client) Issue209(ctx context.Context, str StringInPath, reqEditors ...R
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "Issue209", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -299,7 +411,7 @@ func (c *Client) Issue30(ctx context.Context, pFallthrough string, reqEditors ..
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "Issue30", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -311,7 +423,7 @@ func (c *Client) GetIssues375(ctx context.Context, reqEditors ...RequestEditorFn
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "GetIssues375", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -323,7 +435,7 @@ func (c *Client) Issue41(ctx context.Context, n1param N5StartsWithNumber, reqEdi
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "Issue41", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -335,7 +447,19 @@ func (c *Client) Issue9WithBody(ctx context.Context, params *Issue9Params, conte
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "Issue9", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Issue9WithoutBody(ctx context.Context, params *Issue9Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssue9RequestWithoutBody(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "Issue9", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -347,7 +471,7 @@ func (c *Client) Issue9(ctx context.Context, params *Issue9Params, body Issue9JS
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "Issue9", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -359,7 +483,7 @@ func (c *Client) Issue975(ctx context.Context, reqEditors ...RequestEditorFn) (*
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "Issue975", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -457,6 +581,16 @@ func NewIssue185Request(server string, body Issue185JSONRequestBody) (*http.Requ
 	return NewIssue185RequestWithBody(server, "application/json", bodyReader)
 }
 
+// NewIssue185RequestWithoutBody generates requests for Issue185 without its optional body
+func NewIssue185RequestWithoutBody(server string) (*http.Request, error) {
+	req, err := NewIssue185RequestWithBody(server, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewIssue185RequestWithBody generates requests for Issue185 with any type of body
 func NewIssue185RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -626,6 +760,16 @@ func NewIssue9Request(server string, params *Issue9Params, body Issue9JSONReques
 	return NewIssue9RequestWithBody(server, params, "application/json", bodyReader)
 }
 
+// NewIssue9RequestWithoutBody generates requests for Issue9 without its optional body
+func NewIssue9RequestWithoutBody(server string, params *Issue9Params) (*http.Request, error) {
+	req, err := NewIssue9RequestWithBody(server, params, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewIssue9RequestWithBody generates requests for Issue9 with any type of body
 func NewIssue9RequestWithBody(server string, params *Issue9Params, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -700,15 +844,24 @@ func NewIssue975Request(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
 		}
 	}
-	for _, r := range additionalEditors {
+	for i, r := range additionalEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
 		}
 	}
 	return nil
@@ -741,6 +894,60 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// EnsureEverythingIsReferencedWithResponse request
@@ -755,6 +962,9 @@ type ClientWithResponsesInterface interface {
 	// Issue185WithBodyWithResponse request with any body
 	Issue185WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Issue185Response, error)
 
+	// Issue185WithoutBodyWithResponse request without the optional body
+	Issue185WithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Issue185Response, error)
+
 	Issue185WithResponse(ctx context.Context, body Issue185JSONRequestBody, reqEditors ...RequestEditorFn) (*Issue185Response, error)
 
 	// Issue209WithResponse request
@@ -772,12 +982,304 @@ type ClientWithResponsesInterface interface {
 	// Issue9WithBodyWithResponse request with any body
 	Issue9WithBodyWithResponse(ctx context.Context, params *Issue9Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Issue9Response, error)
 
+	// Issue9WithoutBodyWithResponse request without the optional body
+	Issue9WithoutBodyWithResponse(ctx context.Context, params *Issue9Params, reqEditors ...RequestEditorFn) (*Issue9Response, error)
+
 	Issue9WithResponse(ctx context.Context, params *Issue9Params, body Issue9JSONRequestBody, reqEditors ...RequestEditorFn) (*Issue9Response, error)
 
 	// Issue975WithResponse request
 	Issue975WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Issue975Response, error)
 }
 
+// EnsureEverythingIsReferencedWithResponse request returning *EnsureEverythingIsReferencedResponse
+func (c *ClientWithResponses) EnsureEverythingIsReferencedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnsureEverythingIsReferencedResponse, error) {
+	rsp, err := c.EnsureEverythingIsReferenced(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseEnsureEverythingIsReferencedResponseWithoutBody(rsp)
+	}
+	return ParseEnsureEverythingIsReferencedResponse(rsp)
+}
+
+// parseEnsureEverythingIsReferencedResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseEnsureEverythingIsReferencedResponseWithoutBody(rsp *http.Response) (*EnsureEverythingIsReferencedResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &EnsureEverythingIsReferencedResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// Issue1051WithResponse request returning *Issue1051Response
+func (c *ClientWithResponses) Issue1051WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Issue1051Response, error) {
+	rsp, err := c.Issue1051(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseIssue1051ResponseWithoutBody(rsp)
+	}
+	return ParseIssue1051Response(rsp)
+}
+
+// parseIssue1051ResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseIssue1051ResponseWithoutBody(rsp *http.Response) (*Issue1051Response, error) {
+	discardResponseBody(rsp)
+
+	response := &Issue1051Response{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// Issue127WithResponse request returning *Issue127Response
+func (c *ClientWithResponses) Issue127WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Issue127Response, error) {
+	rsp, err := c.Issue127(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseIssue127ResponseWithoutBody(rsp)
+	}
+	return ParseIssue127Response(rsp)
+}
+
+// parseIssue127ResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseIssue127ResponseWithoutBody(rsp *http.Response) (*Issue127Response, error) {
+	discardResponseBody(rsp)
+
+	response := &Issue127Response{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// Issue185WithBodyWithResponse request with arbitrary body returning *Issue185Response
+func (c *ClientWithResponses) Issue185WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Issue185Response, error) {
+	rsp, err := c.Issue185WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseIssue185ResponseWithoutBody(rsp)
+	}
+	return ParseIssue185Response(rsp)
+}
+
+// Issue185WithoutBodyWithResponse request without the optional body returning *Issue185Response
+func (c *ClientWithResponses) Issue185WithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Issue185Response, error) {
+	rsp, err := c.Issue185WithoutBody(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseIssue185ResponseWithoutBody(rsp)
+	}
+	return ParseIssue185Response(rsp)
+}
+
+func (c *ClientWithResponses) Issue185WithResponse(ctx context.Context, body Issue185JSONRequestBody, reqEditors ...RequestEditorFn) (*Issue185Response, error) {
+	rsp, err := c.Issue185(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseIssue185ResponseWithoutBody(rsp)
+	}
+	return ParseIssue185Response(rsp)
+}
+
+// parseIssue185ResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseIssue185ResponseWithoutBody(rsp *http.Response) (*Issue185Response, error) {
+	discardResponseBody(rsp)
+
+	response := &Issue185Response{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// Issue209WithResponse request returning *Issue209Response
+func (c *ClientWithResponses) Issue209WithResponse(ctx context.Context, str StringInPath, reqEditors ...RequestEditorFn) (*Issue209Response, error) {
+	rsp, err := c.Issue209(ctx, str, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseIssue209ResponseWithoutBody(rsp)
+	}
+	return ParseIssue209Response(rsp)
+}
+
+// parseIssue209ResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseIssue209ResponseWithoutBody(rsp *http.Response) (*Issue209Response, error) {
+	discardResponseBody(rsp)
+
+	response := &Issue209Response{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// Issue30WithResponse request returning *Issue30Response
+func (c *ClientWithResponses) Issue30WithResponse(ctx context.Context, pFallthrough string, reqEditors ...RequestEditorFn) (*Issue30Response, error) {
+	rsp, err := c.Issue30(ctx, pFallthrough, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseIssue30ResponseWithoutBody(rsp)
+	}
+	return ParseIssue30Response(rsp)
+}
+
+// parseIssue30ResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseIssue30ResponseWithoutBody(rsp *http.Response) (*Issue30Response, error) {
+	discardResponseBody(rsp)
+
+	response := &Issue30Response{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// GetIssues375WithResponse request returning *GetIssues375Response
+func (c *ClientWithResponses) GetIssues375WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetIssues375Response, error) {
+	rsp, err := c.GetIssues375(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseGetIssues375ResponseWithoutBody(rsp)
+	}
+	return ParseGetIssues375Response(rsp)
+}
+
+// parseGetIssues375ResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseGetIssues375ResponseWithoutBody(rsp *http.Response) (*GetIssues375Response, error) {
+	discardResponseBody(rsp)
+
+	response := &GetIssues375Response{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// Issue41WithResponse request returning *Issue41Response
+func (c *ClientWithResponses) Issue41WithResponse(ctx context.Context, n1param N5StartsWithNumber, reqEditors ...RequestEditorFn) (*Issue41Response, error) {
+	rsp, err := c.Issue41(ctx, n1param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseIssue41ResponseWithoutBody(rsp)
+	}
+	return ParseIssue41Response(rsp)
+}
+
+// parseIssue41ResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseIssue41ResponseWithoutBody(rsp *http.Response) (*Issue41Response, error) {
+	discardResponseBody(rsp)
+
+	response := &Issue41Response{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// Issue9WithBodyWithResponse request with arbitrary body returning *Issue9Response
+func (c *ClientWithResponses) Issue9WithBodyWithResponse(ctx context.Context, params *Issue9Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Issue9Response, error) {
+	rsp, err := c.Issue9WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseIssue9ResponseWithoutBody(rsp)
+	}
+	return ParseIssue9Response(rsp)
+}
+
+// Issue9WithoutBodyWithResponse request without the optional body returning *Issue9Response
+func (c *ClientWithResponses) Issue9WithoutBodyWithResponse(ctx context.Context, params *Issue9Params, reqEditors ...RequestEditorFn) (*Issue9Response, error) {
+	rsp, err := c.Issue9WithoutBody(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseIssue9ResponseWithoutBody(rsp)
+	}
+	return ParseIssue9Response(rsp)
+}
+
+func (c *ClientWithResponses) Issue9WithResponse(ctx context.Context, params *Issue9Params, body Issue9JSONRequestBody, reqEditors ...RequestEditorFn) (*Issue9Response, error) {
+	rsp, err := c.Issue9(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseIssue9ResponseWithoutBody(rsp)
+	}
+	return ParseIssue9Response(rsp)
+}
+
+// parseIssue9ResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseIssue9ResponseWithoutBody(rsp *http.Response) (*Issue9Response, error) {
+	discardResponseBody(rsp)
+
+	response := &Issue9Response{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// Issue975WithResponse request returning *Issue975Response
+func (c *ClientWithResponses) Issue975WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Issue975Response, error) {
+	rsp, err := c.Issue975(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseIssue975ResponseWithoutBody(rsp)
+	}
+	return ParseIssue975Response(rsp)
+}
+
+// parseIssue975ResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseIssue975ResponseWithoutBody(rsp *http.Response) (*Issue975Response, error) {
+	discardResponseBody(rsp)
+
+	response := &Issue975Response{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// EnsureEverythingIsReferencedResponse is the response of EnsureEverythingIsReferenced. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type EnsureEverythingIsReferencedResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -808,6 +1310,9 @@ func (r EnsureEverythingIsReferencedResponse) StatusCode() int {
 	return 0
 }
 
+// Issue1051Response is the response of Issue1051. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type Issue1051Response struct {
 	Body                             []byte
 	HTTPResponse                     *http.Response
@@ -831,13 +1336,18 @@ func (r Issue1051Response) StatusCode() int {
 	return 0
 }
 
+// Issue127Response is the response of Issue127. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type Issue127Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GenericObject
-	XML200       *GenericObject
-	YAML200      *GenericObject
-	JSONDefault  *GenericObject
+	Body                    []byte
+	HTTPResponse            *http.Response
+	JSON200                 *GenericObject
+	XML200                  *GenericObject
+	YAML200                 *GenericObject
+	JSONDefault             *GenericObject
+	BodyTextMarkdown200     []byte
+	BodyTextMarkdownDefault []byte
 }
 
 // Status returns HTTPResponse.Status
@@ -856,6 +1366,9 @@ func (r Issue127Response) StatusCode() int {
 	return 0
 }
 
+// Issue185Response is the response of Issue185. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type Issue185Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -877,6 +1390,9 @@ func (r Issue185Response) StatusCode() int {
 	return 0
 }
 
+// Issue209Response is the response of Issue209. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type Issue209Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -898,6 +1414,9 @@ func (r Issue209Response) StatusCode() int {
 	return 0
 }
 
+// Issue30Response is the response of Issue30. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type Issue30Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -919,6 +1438,9 @@ func (r Issue30Response) StatusCode() int {
 	return 0
 }
 
+// GetIssues375Response is the response of GetIssues375. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type GetIssues375Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -941,6 +1463,9 @@ func (r GetIssues375Response) StatusCode() int {
 	return 0
 }
 
+// Issue41Response is the response of Issue41. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type Issue41Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -962,6 +1487,9 @@ func (r Issue41Response) StatusCode() int {
 	return 0
 }
 
+// Issue9Response is the response of Issue9. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type Issue9Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -983,6 +1511,9 @@ func (r Issue9Response) StatusCode() int {
 	return 0
 }
 
+// Issue975Response is the response of Issue975. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type Issue975Response struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1005,116 +1536,12 @@ func (r Issue975Response) StatusCode() int {
 	return 0
 }
 
-// EnsureEverythingIsReferencedWithResponse request returning *EnsureEverythingIsReferencedResponse
-func (c *ClientWithResponses) EnsureEverythingIsReferencedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnsureEverythingIsReferencedResponse, error) {
-	rsp, err := c.EnsureEverythingIsReferenced(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseEnsureEverythingIsReferencedResponse(rsp)
-}
-
-// Issue1051WithResponse request returning *Issue1051Response
-func (c *ClientWithResponses) Issue1051WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Issue1051Response, error) {
-	rsp, err := c.Issue1051(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseIssue1051Response(rsp)
-}
-
-// Issue127WithResponse request returning *Issue127Response
-func (c *ClientWithResponses) Issue127WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Issue127Response, error) {
-	rsp, err := c.Issue127(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseIssue127Response(rsp)
-}
-
-// Issue185WithBodyWithResponse request with arbitrary body returning *Issue185Response
-func (c *ClientWithResponses) Issue185WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Issue185Response, error) {
-	rsp, err := c.Issue185WithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseIssue185Response(rsp)
-}
-
-func (c *ClientWithResponses) Issue185WithResponse(ctx context.Context, body Issue185JSONRequestBody, reqEditors ...RequestEditorFn) (*Issue185Response, error) {
-	rsp, err := c.Issue185(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseIssue185Response(rsp)
-}
-
-// Issue209WithResponse request returning *Issue209Response
-func (c *ClientWithResponses) Issue209WithResponse(ctx context.Context, str StringInPath, reqEditors ...RequestEditorFn) (*Issue209Response, error) {
-	rsp, err := c.Issue209(ctx, str, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseIssue209Response(rsp)
-}
-
-// Issue30WithResponse request returning *Issue30Response
-func (c *ClientWithResponses) Issue30WithResponse(ctx context.Context, pFallthrough string, reqEditors ...RequestEditorFn) (*Issue30Response, error) {
-	rsp, err := c.Issue30(ctx, pFallthrough, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseIssue30Response(rsp)
-}
-
-// GetIssues375WithResponse request returning *GetIssues375Response
-func (c *ClientWithResponses) GetIssues375WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetIssues375Response, error) {
-	rsp, err := c.GetIssues375(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetIssues375Response(rsp)
-}
-
-// Issue41WithResponse request returning *Issue41Response
-func (c *ClientWithResponses) Issue41WithResponse(ctx context.Context, n1param N5StartsWithNumber, reqEditors ...RequestEditorFn) (*Issue41Response, error) {
-	rsp, err := c.Issue41(ctx, n1param, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseIssue41Response(rsp)
-}
-
-// Issue9WithBodyWithResponse request with arbitrary body returning *Issue9Response
-func (c *ClientWithResponses) Issue9WithBodyWithResponse(ctx context.Context, params *Issue9Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Issue9Response, error) {
-	rsp, err := c.Issue9WithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseIssue9Response(rsp)
-}
-
-func (c *ClientWithResponses) Issue9WithResponse(ctx context.Context, params *Issue9Params, body Issue9JSONRequestBody, reqEditors ...RequestEditorFn) (*Issue9Response, error) {
-	rsp, err := c.Issue9(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseIssue9Response(rsp)
-}
-
-// Issue975WithResponse request returning *Issue975Response
-func (c *ClientWithResponses) Issue975WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*Issue975Response, error) {
-	rsp, err := c.Issue975(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseIssue975Response(rsp)
-}
-
-// ParseEnsureEverythingIsReferencedResponse parses an HTTP response from a EnsureEverythingIsReferencedWithResponse call
+// ParseEnsureEverythingIsReferencedResponse parses an HTTP response from a EnsureEverythingIsReferencedWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseEnsureEverythingIsReferencedResponse(rsp *http.Response) (*EnsureEverythingIsReferencedResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1145,10 +1572,12 @@ func ParseEnsureEverythingIsReferencedResponse(rsp *http.Response) (*EnsureEvery
 	return response, nil
 }
 
-// ParseIssue1051Response parses an HTTP response from a Issue1051WithResponse call
+// ParseIssue1051Response parses an HTTP response from a Issue1051WithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseIssue1051Response(rsp *http.Response) (*Issue1051Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1178,10 +1607,12 @@ func ParseIssue1051Response(rsp *http.Response) (*Issue1051Response, error) {
 	return response, nil
 }
 
-// ParseIssue127Response parses an HTTP response from a Issue127WithResponse call
+// ParseIssue127Response parses an HTTP response from a Issue127WithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseIssue127Response(rsp *http.Response) (*Issue127Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1220,21 +1651,23 @@ func ParseIssue127Response(rsp *http.Response) (*Issue127Response, error) {
 		}
 		response.YAML200 = &dest
 
-	case rsp.StatusCode == 200:
-	// Content-type (text/markdown) unsupported
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/markdown") && rsp.StatusCode == 200:
+		response.BodyTextMarkdown200 = bodyBytes
 
-	case true:
-		// Content-type (text/markdown) unsupported
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/markdown") && true:
+		response.BodyTextMarkdownDefault = bodyBytes
 
 	}
 
 	return response, nil
 }
 
-// ParseIssue185Response parses an HTTP response from a Issue185WithResponse call
+// ParseIssue185Response parses an HTTP response from a Issue185WithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseIssue185Response(rsp *http.Response) (*Issue185Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1247,10 +1680,12 @@ func ParseIssue185Response(rsp *http.Response) (*Issue185Response, error) {
 	return response, nil
 }
 
-// ParseIssue209Response parses an HTTP response from a Issue209WithResponse call
+// ParseIssue209Response parses an HTTP response from a Issue209WithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseIssue209Response(rsp *http.Response) (*Issue209Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1263,10 +1698,12 @@ func ParseIssue209Response(rsp *http.Response) (*Issue209Response, error) {
 	return response, nil
 }
 
-// ParseIssue30Response parses an HTTP response from a Issue30WithResponse call
+// ParseIssue30Response parses an HTTP response from a Issue30WithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseIssue30Response(rsp *http.Response) (*Issue30Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1279,10 +1716,12 @@ func ParseIssue30Response(rsp *http.Response) (*Issue30Response, error) {
 	return response, nil
 }
 
-// ParseGetIssues375Response parses an HTTP response from a GetIssues375WithResponse call
+// ParseGetIssues375Response parses an HTTP response from a GetIssues375WithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseGetIssues375Response(rsp *http.Response) (*GetIssues375Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1305,10 +1744,12 @@ func ParseGetIssues375Response(rsp *http.Response) (*GetIssues375Response, error
 	return response, nil
 }
 
-// ParseIssue41Response parses an HTTP response from a Issue41WithResponse call
+// ParseIssue41Response parses an HTTP response from a Issue41WithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseIssue41Response(rsp *http.Response) (*Issue41Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1321,10 +1762,12 @@ func ParseIssue41Response(rsp *http.Response) (*Issue41Response, error) {
 	return response, nil
 }
 
-// ParseIssue9Response parses an HTTP response from a Issue9WithResponse call
+// ParseIssue9Response parses an HTTP response from a Issue9WithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseIssue9Response(rsp *http.Response) (*Issue9Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1337,10 +1780,12 @@ func ParseIssue9Response(rsp *http.Response) (*Issue9Response, error) {
 	return response, nil
 }
 
-// ParseIssue975Response parses an HTTP response from a Issue975WithResponse call
+// ParseIssue975Response parses an HTTP response from a Issue975WithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseIssue975Response(rsp *http.Response) (*Issue975Response, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1363,6 +1808,96 @@ func ParseIssue975Response(rsp *http.Response) (*Issue975Response, error) {
 	return response, nil
 }
 
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "EnsureEverythingIsReferenced":
+		return ParseEnsureEverythingIsReferencedResponse(rsp)
+	case "Issue1051":
+		return ParseIssue1051Response(rsp)
+	case "Issue127":
+		return ParseIssue127Response(rsp)
+	case "Issue185":
+		return ParseIssue185Response(rsp)
+	case "Issue209":
+		return ParseIssue209Response(rsp)
+	case "Issue30":
+		return ParseIssue30Response(rsp)
+	case "GetIssues375":
+		return ParseGetIssues375Response(rsp)
+	case "Issue41":
+		return ParseIssue41Response(rsp)
+	case "Issue9":
+		return ParseIssue9Response(rsp)
+	case "Issue975":
+		return ParseIssue975Response(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
+
+// responseHasContentType reports whether contentType, the Content-Type of a
+// response, has the media type of expected, which may be a wildcard, eg,
+// image/*, and at least its parameters.
+func responseHasContentType(contentType string, expected string) bool {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	expectedMediaType, expectedParams, err := mime.ParseMediaType(expected)
+	if err != nil {
+		return false
+	}
+	if expectedMediaType != "*/*" && expectedMediaType != mediaType &&
+		!(strings.HasSuffix(expectedMediaType, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(expectedMediaType, "*"))) {
+		return false
+	}
+	for name, value := range expectedParams {
+		if params[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
@@ -1557,6 +2092,76 @@ type EchoRouter interface {
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
+// NewEnsureEverythingIsReferencedHandler returns the handler RegisterHandlers routes the
+// EnsureEverythingIsReferenced operation to, so that it can be mounted on its own.
+func NewEnsureEverythingIsReferencedHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.EnsureEverythingIsReferenced
+}
+
+// NewIssue1051Handler returns the handler RegisterHandlers routes the
+// Issue1051 operation to, so that it can be mounted on its own.
+func NewIssue1051Handler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.Issue1051
+}
+
+// NewIssue127Handler returns the handler RegisterHandlers routes the
+// Issue127 operation to, so that it can be mounted on its own.
+func NewIssue127Handler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.Issue127
+}
+
+// NewIssue185Handler returns the handler RegisterHandlers routes the
+// Issue185 operation to, so that it can be mounted on its own.
+func NewIssue185Handler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.Issue185
+}
+
+// NewIssue209Handler returns the handler RegisterHandlers routes the
+// Issue209 operation to, so that it can be mounted on its own.
+func NewIssue209Handler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.Issue209
+}
+
+// NewIssue30Handler returns the handler RegisterHandlers routes the
+// Issue30 operation to, so that it can be mounted on its own.
+func NewIssue30Handler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.Issue30
+}
+
+// NewGetIssues375Handler returns the handler RegisterHandlers routes the
+// GetIssues375 operation to, so that it can be mounted on its own.
+func NewGetIssues375Handler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetIssues375
+}
+
+// NewIssue41Handler returns the handler RegisterHandlers routes the
+// Issue41 operation to, so that it can be mounted on its own.
+func NewIssue41Handler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.Issue41
+}
+
+// NewIssue9Handler returns the handler RegisterHandlers routes the
+// Issue9 operation to, so that it can be mounted on its own.
+func NewIssue9Handler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.Issue9
+}
+
+// NewIssue975Handler returns the handler RegisterHandlers routes the
+// Issue975 operation to, so that it can be mounted on its own.
+func NewIssue975Handler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.Issue975
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router EchoRouter, si ServerInterface) {
 	RegisterHandlersWithBaseURL(router, si, "")
@@ -1565,21 +2170,16 @@ func RegisterHandlers(router EchoRouter, si ServerInterface) {
 // Registers handlers, and prepends BaseURL to the paths, so that the paths
 // can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
-
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
-
-	router.GET(baseURL+"/ensure-everything-is-referenced", wrapper.EnsureEverythingIsReferenced)
-	router.GET(baseURL+"/issues/1051", wrapper.Issue1051)
-	router.GET(baseURL+"/issues/127", wrapper.Issue127)
-	router.GET(baseURL+"/issues/185", wrapper.Issue185)
-	router.GET(baseURL+"/issues/209/$:str", wrapper.Issue209)
-	router.GET(baseURL+"/issues/30/:fallthrough", wrapper.Issue30)
-	router.GET(baseURL+"/issues/375", wrapper.GetIssues375)
-	router.GET(baseURL+"/issues/41/:1param", wrapper.Issue41)
-	router.GET(baseURL+"/issues/9", wrapper.Issue9)
-	router.GET(baseURL+"/issues/975", wrapper.Issue975)
+	router.GET(baseURL+"/ensure-everything-is-referenced", NewEnsureEverythingIsReferencedHandler(si))
+	router.GET(baseURL+"/issues/1051", NewIssue1051Handler(si))
+	router.GET(baseURL+"/issues/127", NewIssue127Handler(si))
+	router.GET(baseURL+"/issues/185", NewIssue185Handler(si))
+	router.GET(baseURL+"/issues/209/$:str", NewIssue209Handler(si))
+	router.GET(baseURL+"/issues/30/:fallthrough", NewIssue30Handler(si))
+	router.GET(baseURL+"/issues/375", NewGetIssues375Handler(si))
+	router.GET(baseURL+"/issues/41/:1param", NewIssue41Handler(si))
+	router.GET(baseURL+"/issues/9", NewIssue9Handler(si))
+	router.GET(baseURL+"/issues/975", NewIssue975Handler(si))
 
 }
 
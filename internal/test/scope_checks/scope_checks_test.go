@@ -0,0 +1,115 @@
+package scope_checks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredScopes(t *testing.T) {
+	assert.Equal(t, []map[string][]string{{"petstore_auth": {"read:pets"}}}, RequiredScopes("ListPets"))
+	assert.Equal(t, []map[string][]string{}, RequiredScopes("GetHealth"))
+	assert.Equal(t, []map[string][]string{
+		{"petstore_auth": {"admin:pets"}},
+		{"api_key": {}, "petstore_auth": {"write:pets", "read:pets"}},
+	}, RequiredScopes("DeletePet"))
+	assert.Nil(t, RequiredScopes("Unknown"))
+}
+
+func TestCheckScopes(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation string
+		granted   []string
+		// missing is nil when the check passes.
+		missing []string
+	}{
+		{"public operation", "GetHealth", nil, nil},
+		{"global requirement met", "ListPets", []string{"read:pets"}, nil},
+		{"global requirement unmet", "ListPets", []string{"write:pets"}, []string{"read:pets"}},
+		{"all scopes of a requirement", "AddPet", []string{"read:pets", "write:pets"}, nil},
+		{"some scopes of a requirement", "AddPet", []string{"read:pets"}, []string{"write:pets"}},
+		{"no scopes of a requirement", "AddPet", nil, []string{"read:pets", "write:pets"}},
+		{"optional requirement", "GetPet", nil, nil},
+		{"first alternative", "DeletePet", []string{"admin:pets"}, nil},
+		{"second alternative", "DeletePet", []string{"read:pets", "write:pets"}, nil},
+		{"closest alternative", "DeletePet", []string{"read:pets"}, []string{"admin:pets"}},
+		{"alternatives tied", "DeletePet", []string{"read:pets", "other"}, []string{"admin:pets"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckScopes(tt.granted, RequiredScopes(tt.operation))
+			if tt.missing == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var scopeErr *ScopeError
+			require.ErrorAs(t, err, &scopeErr)
+			assert.Equal(t, tt.missing, scopeErr.Missing)
+		})
+	}
+}
+
+type strictServer struct{}
+
+func (strictServer) GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error) {
+	return GetHealth204Response{}, nil
+}
+
+func (strictServer) ListPets(ctx context.Context, request ListPetsRequestObject) (ListPetsResponseObject, error) {
+	return ListPets204Response{}, nil
+}
+
+func (strictServer) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	return AddPet204Response{}, nil
+}
+
+func (strictServer) DeletePet(ctx context.Context, request DeletePetRequestObject) (DeletePetResponseObject, error) {
+	return DeletePet204Response{}, nil
+}
+
+func (strictServer) GetPet(ctx context.Context, request GetPetRequestObject) (GetPetResponseObject, error) {
+	return GetPet204Response{}, nil
+}
+
+func TestStrictServerChecksScopes(t *testing.T) {
+	// The scopes are granted by a stand-in for authentication middleware.
+	handler := HandlerWithOptions(NewStrictHandler(strictServer{}, nil), ChiServerOptions{
+		Middlewares: []MiddlewareFunc{func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if scopes := r.Header.Get("X-Scopes"); scopes != "" {
+					r = r.WithContext(WithGrantedScopes(r.Context(), strings.Split(scopes, " ")))
+				}
+				next.ServeHTTP(w, r)
+			})
+		}},
+	})
+
+	tests := []struct {
+		method, path, scopes string
+		status               int
+		body                 string
+	}{
+		{http.MethodGet, "/health", "", http.StatusNoContent, ""},
+		{http.MethodGet, "/pets", "read:pets", http.StatusNoContent, ""},
+		{http.MethodGet, "/pets", "", http.StatusForbidden, "missing scopes: read:pets\n"},
+		{http.MethodPost, "/pets", "read:pets", http.StatusForbidden, "missing scopes: write:pets\n"},
+		{http.MethodGet, "/pets/1", "", http.StatusNoContent, ""},
+		{http.MethodDelete, "/pets/1", "write:pets read:pets", http.StatusNoContent, ""},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		if tt.scopes != "" {
+			req.Header.Set("X-Scopes", tt.scopes)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, tt.status, rec.Code, "%s %s", tt.method, tt.path)
+		assert.Equal(t, tt.body, rec.Body.String(), "%s %s", tt.method, tt.path)
+	}
+}
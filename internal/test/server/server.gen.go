@@ -19,6 +19,16 @@ const (
 	Text GetWithContentTypeParamsContentType = "text"
 )
 
+// IsValid reports whether v is one of the values of GetWithContentTypeParamsContentType.
+func (v GetWithContentTypeParamsContentType) IsValid() bool {
+	switch v {
+	case Json, Text:
+		return true
+	default:
+		return false
+	}
+}
+
 // EveryTypeOptional defines model for EveryTypeOptional.
 type EveryTypeOptional struct {
 	ArrayInlineField     *[]int              `json:"array_inline_field,omitempty"`
@@ -605,53 +615,124 @@ func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string)
 	})
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
-
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		}
 	}
-	wrapper := ServerInterfaceWrapper{
+	return &ServerInterfaceWrapper{
 		Handler:            si,
 		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+		ErrorHandlerFunc:   errorHandlerFunc,
 	}
+}
+
+// NewGetEveryTypeOptionalHandler returns the handler HandlerWithOptions routes the
+// GetEveryTypeOptional operation to, so that it can be mounted on its own.
+func NewGetEveryTypeOptionalHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).GetEveryTypeOptional
+}
+
+// NewGetSimpleHandler returns the handler HandlerWithOptions routes the
+// GetSimple operation to, so that it can be mounted on its own.
+func NewGetSimpleHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).GetSimple
+}
+
+// NewGetWithArgsHandler returns the handler HandlerWithOptions routes the
+// GetWithArgs operation to, so that it can be mounted on its own.
+func NewGetWithArgsHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).GetWithArgs
+}
+
+// NewGetWithReferencesHandler returns the handler HandlerWithOptions routes the
+// GetWithReferences operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewGetWithReferencesHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).GetWithReferences
+}
 
+// NewGetWithContentTypeHandler returns the handler HandlerWithOptions routes the
+// GetWithContentType operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewGetWithContentTypeHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).GetWithContentType
+}
+
+// NewGetReservedKeywordHandler returns the handler HandlerWithOptions routes the
+// GetReservedKeyword operation to, so that it can be mounted on its own.
+func NewGetReservedKeywordHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).GetReservedKeyword
+}
+
+// NewCreateResourceHandler returns the handler HandlerWithOptions routes the
+// CreateResource operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewCreateResourceHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).CreateResource
+}
+
+// NewCreateResource2Handler returns the handler HandlerWithOptions routes the
+// CreateResource2 operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewCreateResource2Handler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).CreateResource2
+}
+
+// NewUpdateResource3Handler returns the handler HandlerWithOptions routes the
+// UpdateResource3 operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewUpdateResource3Handler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).UpdateResource3
+}
+
+// NewGetResponseWithReferenceHandler returns the handler HandlerWithOptions routes the
+// GetResponseWithReference operation to, so that it can be mounted on its own.
+func NewGetResponseWithReferenceHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).GetResponseWithReference
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/every-type-optional", wrapper.GetEveryTypeOptional)
+		r.Get(options.BaseURL+"/every-type-optional", NewGetEveryTypeOptionalHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/get-simple", wrapper.GetSimple)
+		r.Get(options.BaseURL+"/get-simple", NewGetSimpleHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/get-with-args", wrapper.GetWithArgs)
+		r.Get(options.BaseURL+"/get-with-args", NewGetWithArgsHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/get-with-references/{global_argument}/{argument}", wrapper.GetWithReferences)
+		r.Get(options.BaseURL+"/get-with-references/{global_argument}/{argument}", NewGetWithReferencesHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/get-with-type/{content_type}", wrapper.GetWithContentType)
+		r.Get(options.BaseURL+"/get-with-type/{content_type}", NewGetWithContentTypeHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/reserved-keyword", wrapper.GetReservedKeyword)
+		r.Get(options.BaseURL+"/reserved-keyword", NewGetReservedKeywordHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/resource/{argument}", wrapper.CreateResource)
+		r.Post(options.BaseURL+"/resource/{argument}", NewCreateResourceHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/resource2/{inline_argument}", wrapper.CreateResource2)
+		r.Post(options.BaseURL+"/resource2/{inline_argument}", NewCreateResource2Handler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/resource3/{fallthrough}", wrapper.UpdateResource3)
+		r.Put(options.BaseURL+"/resource3/{fallthrough}", NewUpdateResource3Handler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/response-with-reference", wrapper.GetResponseWithReference)
+		r.Get(options.BaseURL+"/response-with-reference", NewGetResponseWithReferenceHandler(si, options))
 	})
 
 	return r
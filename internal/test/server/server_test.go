@@ -134,3 +134,45 @@ func TestErrorHandlerFuncBackwardsCompatible(t *testing.T) {
 	assert.Equal(t, "text/plain; charset=utf-8", req.Header.Get("Content-Type"))
 	assert.Equal(t, "Query argument required_argument is required, but not found\n", string(b))
 }
+
+func TestOperationHandlerOnServeMux(t *testing.T) {
+	m := fakeServer{}
+	var handlerErr error
+
+	mux := http.NewServeMux()
+	mux.Handle("/legacy/args", NewGetWithArgsHandler(&m, ChiServerOptions{
+		Middlewares: []MiddlewareFunc{
+			func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("X-Middleware", "applied")
+					next.ServeHTTP(w, r)
+				})
+			},
+		},
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			handlerErr = err
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		},
+	}))
+
+	req := httptest.NewRequest("GET", "/legacy/args?required_argument=1", nil)
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusTeapot, rr.Code)
+	assert.Equal(t, "applied", rr.Header().Get("X-Middleware"))
+
+	req = httptest.NewRequest("GET", "/legacy/args", nil)
+	rr = httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	var requiredParamError *RequiredParamError
+	assert.True(t, errors.As(handlerErr, &requiredParamError))
+
+	// Without an error handler, the default one answers as HandlerWithOptions does.
+	mux = http.NewServeMux()
+	mux.Handle("/legacy/args", NewGetWithArgsHandler(&m, ChiServerOptions{}))
+	rr = httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, "Query argument required_argument is required, but not found\n", rr.Body.String())
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The form binder is handed the bodies of requests, so it must return an
+// error rather than panic, or allocate more than the body asks for, whatever
+// the input.
+
+type formObject struct {
+	FirstName string `json:"firstName"`
+	Role      string `json:"role"`
+}
+
+// formBody is a request body as the form and multipart binders see it.
+type formBody struct {
+	Name    string            `json:"name"`
+	Ids     []int32           `json:"ids,omitempty"`
+	Objects []formObject      `json:"objects,omitempty"`
+	Extra   map[string]string `json:"extra,omitempty"`
+	Nested  *struct {
+		Object formObject `json:"Object"`
+	} `json:"nested,omitempty"`
+}
+
+func FuzzBindForm(f *testing.F) {
+	for _, seed := range []string{
+		"name=Alex&ids=1&ids=2", "objects[0][role]=admin&objects[1][firstName]=Alex",
+		"extra[a]=b", "nested[Object][role]=admin", "objects[-1][role]=x",
+		"objects[0]=x", "ids[0]=1", "", "name",
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, query string) {
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			return
+		}
+
+		var body formBody
+		_ = bindForm(&body, values, nil)
+	})
+}
+
+func FuzzBindMultipart(f *testing.F) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	_ = w.SetBoundary("boundary")
+	_ = w.WriteField("name", "Alex")
+	_ = w.WriteField("objects[0][role]", "admin")
+	_ = w.WriteField("ids", "1")
+	_ = w.Close()
+	f.Add(buf.String())
+	f.Add("--boundary\r\nContent-Disposition: form-data; name=\"objects[5][role]\"\r\n\r\nx\r\n--boundary--\r\n")
+	f.Add("--boundary--\r\n")
+	f.Fuzz(func(t *testing.T, body string) {
+		form, err := multipart.NewReader(bytes.NewBufferString(body), "boundary").ReadForm(1 << 20)
+		if err != nil {
+			return
+		}
+		defer func() { _ = form.RemoveAll() }()
+
+		var dest formBody
+		_ = bindForm(&dest, form.Value, form.File)
+	})
+}
+
+// TestFormRegressions checks the inputs the runtime panics on, or allocates an
+// array as long as their index for, found by the fuzz targets above, which
+// are errors once bound by the generated code.
+func TestFormRegressions(t *testing.T) {
+	for _, form := range []url.Values{
+		{"objects[": {""}},
+		{"objects[0][role": {"admin"}},
+		{"objects[99999999][role]": {"admin"}},
+		{"objects[1001][role]": {"admin"}},
+	} {
+		var body formBody
+		assert.Error(t, bindForm(&body, form, nil), "%v", form)
+	}
+
+	body := "--boundary\r\nContent-Disposition: form-data; name=\"objects[\"\r\n\r\n\r\n--boundary--\r\n"
+	form, err := multipart.NewReader(bytes.NewBufferString(body), "boundary").ReadForm(1 << 20)
+	require.NoError(t, err)
+	var dest formBody
+	assert.Error(t, bindForm(&dest, form.Value, form.File))
+
+	// Indices up to maxFormIndex are bound, the ones left out to zero values.
+	var bound formBody
+	require.NoError(t, bindForm(&bound, url.Values{"objects[0][role]": {"admin"}, "objects[1][role]": {"user"}}, nil))
+	assert.Equal(t, []formObject{{Role: "admin"}, {Role: "user"}}, bound.Objects)
+
+	var sparse formBody
+	require.NoError(t, bindForm(&sparse, url.Values{"objects[1][role]": {"admin"}}, nil))
+	assert.Equal(t, []formObject{{}, {Role: "admin"}}, sparse.Objects)
+
+	var last formBody
+	require.NoError(t, bindForm(&last, url.Values{"objects[1000][role]": {"admin"}}, nil))
+	assert.Len(t, last.Objects, maxFormIndex+1)
+}
@@ -14,14 +14,18 @@ import (
 	"mime"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"path"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // ServerInterface represents all server handlers.
@@ -48,6 +52,9 @@ type ServerInterface interface {
 	// (POST /text)
 	TextExample(w http.ResponseWriter, r *http.Request)
 
+	// (GET /typed-path-parameters/{id}/{date})
+	TypedPathParameters(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, date openapi_types.Date, params TypedPathParametersParams)
+
 	// (POST /unknown)
 	UnknownExample(w http.ResponseWriter, r *http.Request)
 
@@ -103,6 +110,11 @@ func (_ Unimplemented) TextExample(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /typed-path-parameters/{id}/{date})
+func (_ Unimplemented) TypedPathParameters(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, date openapi_types.Date, params TypedPathParametersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (POST /unknown)
 func (_ Unimplemented) UnknownExample(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -253,6 +265,52 @@ func (siw *ServerInterfaceWrapper) TextExample(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// TypedPathParameters operation middleware
+func (siw *ServerInterfaceWrapper) TypedPathParameters(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	err = runtime.BindStyledParameterWithOptions("simple", "date", chi.URLParam(r, "date"), &date, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TypedPathParametersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TypedPathParameters(w, r, id, date, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // UnknownExample operation middleware
 func (siw *ServerInterfaceWrapper) UnknownExample(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -472,59 +530,148 @@ func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string)
 	})
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
-
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		}
 	}
-	wrapper := ServerInterfaceWrapper{
+	return &ServerInterfaceWrapper{
 		Handler:            si,
 		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+		ErrorHandlerFunc:   errorHandlerFunc,
 	}
+}
+
+// NewJSONExampleHandler returns the handler HandlerWithOptions routes the
+// JSONExample operation to, so that it can be mounted on its own.
+func NewJSONExampleHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).JSONExample
+}
 
+// NewMultipartExampleHandler returns the handler HandlerWithOptions routes the
+// MultipartExample operation to, so that it can be mounted on its own.
+func NewMultipartExampleHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).MultipartExample
+}
+
+// NewMultipartRelatedExampleHandler returns the handler HandlerWithOptions routes the
+// MultipartRelatedExample operation to, so that it can be mounted on its own.
+func NewMultipartRelatedExampleHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).MultipartRelatedExample
+}
+
+// NewMultipleRequestAndResponseTypesHandler returns the handler HandlerWithOptions routes the
+// MultipleRequestAndResponseTypes operation to, so that it can be mounted on its own.
+func NewMultipleRequestAndResponseTypesHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).MultipleRequestAndResponseTypes
+}
+
+// NewReservedGoKeywordParametersHandler returns the handler HandlerWithOptions routes the
+// ReservedGoKeywordParameters operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewReservedGoKeywordParametersHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ReservedGoKeywordParameters
+}
+
+// NewReusableResponsesHandler returns the handler HandlerWithOptions routes the
+// ReusableResponses operation to, so that it can be mounted on its own.
+func NewReusableResponsesHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ReusableResponses
+}
+
+// NewTextExampleHandler returns the handler HandlerWithOptions routes the
+// TextExample operation to, so that it can be mounted on its own.
+func NewTextExampleHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).TextExample
+}
+
+// NewTypedPathParametersHandler returns the handler HandlerWithOptions routes the
+// TypedPathParameters operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewTypedPathParametersHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).TypedPathParameters
+}
+
+// NewUnknownExampleHandler returns the handler HandlerWithOptions routes the
+// UnknownExample operation to, so that it can be mounted on its own.
+func NewUnknownExampleHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).UnknownExample
+}
+
+// NewUnspecifiedContentTypeHandler returns the handler HandlerWithOptions routes the
+// UnspecifiedContentType operation to, so that it can be mounted on its own.
+func NewUnspecifiedContentTypeHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).UnspecifiedContentType
+}
+
+// NewURLEncodedExampleHandler returns the handler HandlerWithOptions routes the
+// URLEncodedExample operation to, so that it can be mounted on its own.
+func NewURLEncodedExampleHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).URLEncodedExample
+}
+
+// NewHeadersExampleHandler returns the handler HandlerWithOptions routes the
+// HeadersExample operation to, so that it can be mounted on its own.
+func NewHeadersExampleHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).HeadersExample
+}
+
+// NewUnionExampleHandler returns the handler HandlerWithOptions routes the
+// UnionExample operation to, so that it can be mounted on its own.
+func NewUnionExampleHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).UnionExample
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/json", NewJSONExampleHandler(si, options))
+	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/json", wrapper.JSONExample)
+		r.Post(options.BaseURL+"/multipart", NewMultipartExampleHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/multipart", wrapper.MultipartExample)
+		r.Post(options.BaseURL+"/multipart-related", NewMultipartRelatedExampleHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/multipart-related", wrapper.MultipartRelatedExample)
+		r.Post(options.BaseURL+"/multiple", NewMultipleRequestAndResponseTypesHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/multiple", wrapper.MultipleRequestAndResponseTypes)
+		r.Get(options.BaseURL+"/reserved-go-keyword-parameters/{type}", NewReservedGoKeywordParametersHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/reserved-go-keyword-parameters/{type}", wrapper.ReservedGoKeywordParameters)
+		r.Post(options.BaseURL+"/reusable-responses", NewReusableResponsesHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/reusable-responses", wrapper.ReusableResponses)
+		r.Post(options.BaseURL+"/text", NewTextExampleHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/text", wrapper.TextExample)
+		r.Get(options.BaseURL+"/typed-path-parameters/{id}/{date}", NewTypedPathParametersHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/unknown", wrapper.UnknownExample)
+		r.Post(options.BaseURL+"/unknown", NewUnknownExampleHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/unspecified-content-type", wrapper.UnspecifiedContentType)
+		r.Post(options.BaseURL+"/unspecified-content-type", NewUnspecifiedContentTypeHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/urlencoded", wrapper.URLEncodedExample)
+		r.Post(options.BaseURL+"/urlencoded", NewURLEncodedExampleHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/with-headers", wrapper.HeadersExample)
+		r.Post(options.BaseURL+"/with-headers", NewHeadersExampleHandler(si, options))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/with-union", wrapper.UnionExample)
+		r.Post(options.BaseURL+"/with-union", NewUnionExampleHandler(si, options))
 	})
 
 	return r
@@ -595,6 +742,15 @@ func (response MultipartExample200MultipartResponse) VisitMultipartExampleRespon
 	return response(writer)
 }
 
+// NewMultipartExample200MultipartResponseBody returns the body of MultipartExample200MultipartResponse
+// writing the fields of parts as its parts, each with a Content-Disposition
+// naming it, and the Content-Type of its encoding.
+func NewMultipartExample200MultipartResponseBody(parts Example) func(writer *multipart.Writer) error {
+	return func(writer *multipart.Writer) error {
+		return writeMultipartParts(writer, "multipart/form-data", parts, []multipartPart{{Name: "value", Kind: multipartText, ContentType: "text/plain; charset=utf-8"}})
+	}
+}
+
 type MultipartExample400Response = BadrequestResponse
 
 func (response MultipartExample400Response) VisitMultipartExampleResponse(w http.ResponseWriter) error {
@@ -630,6 +786,15 @@ func (response MultipartRelatedExample200MultipartResponse) VisitMultipartRelate
 	return response(writer)
 }
 
+// NewMultipartRelatedExample200MultipartResponseBody returns the body of MultipartRelatedExample200MultipartResponse
+// writing the fields of parts as its parts, each with a Content-Disposition
+// naming it, and the Content-Type of its encoding.
+func NewMultipartRelatedExample200MultipartResponseBody(parts Example) func(writer *multipart.Writer) error {
+	return func(writer *multipart.Writer) error {
+		return writeMultipartParts(writer, "multipart/related", parts, []multipartPart{{Name: "value", Kind: multipartText, ContentType: "text/plain; charset=utf-8"}})
+	}
+}
+
 type MultipartRelatedExample400Response = BadrequestResponse
 
 func (response MultipartRelatedExample400Response) VisitMultipartRelatedExampleResponse(w http.ResponseWriter) error {
@@ -700,6 +865,15 @@ func (response MultipleRequestAndResponseTypes200ImagepngResponse) VisitMultiple
 	return err
 }
 
+// NewMultipleRequestAndResponseTypes200ImagepngResponse returns the response of body, along with its
+// length.
+func NewMultipleRequestAndResponseTypes200ImagepngResponse(body []byte) MultipleRequestAndResponseTypes200ImagepngResponse {
+	return MultipleRequestAndResponseTypes200ImagepngResponse{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+	}
+}
+
 type MultipleRequestAndResponseTypes200MultipartResponse func(writer *multipart.Writer) error
 
 func (response MultipleRequestAndResponseTypes200MultipartResponse) VisitMultipleRequestAndResponseTypesResponse(w http.ResponseWriter) error {
@@ -711,6 +885,15 @@ func (response MultipleRequestAndResponseTypes200MultipartResponse) VisitMultipl
 	return response(writer)
 }
 
+// NewMultipleRequestAndResponseTypes200MultipartResponseBody returns the body of MultipleRequestAndResponseTypes200MultipartResponse
+// writing the fields of parts as its parts, each with a Content-Disposition
+// naming it, and the Content-Type of its encoding.
+func NewMultipleRequestAndResponseTypes200MultipartResponseBody(parts Example) func(writer *multipart.Writer) error {
+	return func(writer *multipart.Writer) error {
+		return writeMultipartParts(writer, "multipart/form-data", parts, []multipartPart{{Name: "value", Kind: multipartText, ContentType: "text/plain; charset=utf-8"}})
+	}
+}
+
 type MultipleRequestAndResponseTypes200TextResponse string
 
 func (response MultipleRequestAndResponseTypes200TextResponse) VisitMultipleRequestAndResponseTypesResponse(w http.ResponseWriter) error {
@@ -815,6 +998,32 @@ func (response TextExampledefaultResponse) VisitTextExampleResponse(w http.Respo
 	return nil
 }
 
+type TypedPathParametersRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Date openapi_types.Date `json:"date"`
+	TypedPathParametersParams
+}
+
+// Params returns the query, header and cookie parameters of the request,
+// which are embedded in it.
+func (r TypedPathParametersRequestObject) Params() TypedPathParametersParams {
+	return r.TypedPathParametersParams
+}
+
+type TypedPathParametersResponseObject interface {
+	VisitTypedPathParametersResponse(w http.ResponseWriter) error
+}
+
+type TypedPathParameters200TextResponse string
+
+func (response TypedPathParameters200TextResponse) VisitTypedPathParametersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
 type UnknownExampleRequestObject struct {
 	Body io.Reader
 }
@@ -842,6 +1051,15 @@ func (response UnknownExample200Videomp4Response) VisitUnknownExampleResponse(w
 	return err
 }
 
+// NewUnknownExample200Videomp4Response returns the response of body, along with its
+// length.
+func NewUnknownExample200Videomp4Response(body []byte) UnknownExample200Videomp4Response {
+	return UnknownExample200Videomp4Response{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+	}
+}
+
 type UnknownExample400Response = BadrequestResponse
 
 func (response UnknownExample400Response) VisitUnknownExampleResponse(w http.ResponseWriter) error {
@@ -887,6 +1105,16 @@ func (response UnspecifiedContentType200VideoResponse) VisitUnspecifiedContentTy
 	return err
 }
 
+// NewUnspecifiedContentType200VideoResponse returns the response of body, along with its
+// length, and the content type http.DetectContentType sniffs from it.
+func NewUnspecifiedContentType200VideoResponse(body []byte) UnspecifiedContentType200VideoResponse {
+	return UnspecifiedContentType200VideoResponse{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+		ContentType:   http.DetectContentType(body),
+	}
+}
+
 type UnspecifiedContentType400Response = BadrequestResponse
 
 func (response UnspecifiedContentType400Response) VisitUnspecifiedContentTypeResponse(w http.ResponseWriter) error {
@@ -958,8 +1186,14 @@ func (response URLEncodedExampledefaultResponse) VisitURLEncodedExampleResponse(
 }
 
 type HeadersExampleRequestObject struct {
-	Params HeadersExampleParams
-	Body   *HeadersExampleJSONRequestBody
+	HeadersExampleParams
+	Body *HeadersExampleJSONRequestBody
+}
+
+// Params returns the query, header and cookie parameters of the request,
+// which are embedded in it.
+func (r HeadersExampleRequestObject) Params() HeadersExampleParams {
+	return r.HeadersExampleParams
 }
 
 type HeadersExampleResponseObject interface {
@@ -1084,6 +1318,9 @@ type StrictServerInterface interface {
 	// (POST /text)
 	TextExample(ctx context.Context, request TextExampleRequestObject) (TextExampleResponseObject, error)
 
+	// (GET /typed-path-parameters/{id}/{date})
+	TypedPathParameters(ctx context.Context, request TypedPathParametersRequestObject) (TypedPathParametersResponseObject, error)
+
 	// (POST /unknown)
 	UnknownExample(ctx context.Context, request UnknownExampleRequestObject) (UnknownExampleResponseObject, error)
 
@@ -1133,13 +1370,17 @@ type strictHandler struct {
 func (sh *strictHandler) JSONExample(w http.ResponseWriter, r *http.Request) {
 	var request JSONExampleRequestObject
 
-	var body JSONExampleJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if r.ContentLength != 0 {
 
+		var body JSONExampleJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.Body = &body
+
+	}
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.JSONExample(ctx, request.(JSONExampleRequestObject))
 	}
@@ -1147,7 +1388,7 @@ func (sh *strictHandler) JSONExample(w http.ResponseWriter, r *http.Request) {
 		handler = middleware(handler, "JSONExample")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "JSONExample"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -1164,13 +1405,17 @@ func (sh *strictHandler) JSONExample(w http.ResponseWriter, r *http.Request) {
 func (sh *strictHandler) MultipartExample(w http.ResponseWriter, r *http.Request) {
 	var request MultipartExampleRequestObject
 
-	if reader, err := r.MultipartReader(); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
-		return
-	} else {
-		request.Body = reader
-	}
+	// The body is optional, so it's left nil when the request has none.
+	if r.ContentLength != 0 {
 
+		if reader, err := r.MultipartReader(); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+			return
+		} else {
+			request.Body = reader
+		}
+
+	}
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.MultipartExample(ctx, request.(MultipartExampleRequestObject))
 	}
@@ -1178,7 +1423,7 @@ func (sh *strictHandler) MultipartExample(w http.ResponseWriter, r *http.Request
 		handler = middleware(handler, "MultipartExample")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "MultipartExample"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -1195,16 +1440,20 @@ func (sh *strictHandler) MultipartExample(w http.ResponseWriter, r *http.Request
 func (sh *strictHandler) MultipartRelatedExample(w http.ResponseWriter, r *http.Request) {
 	var request MultipartRelatedExampleRequestObject
 
-	if _, params, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, err)
-		return
-	} else if boundary := params["boundary"]; boundary == "" {
-		sh.options.RequestErrorHandlerFunc(w, r, http.ErrMissingBoundary)
-		return
-	} else {
-		request.Body = multipart.NewReader(r.Body, boundary)
-	}
+	// The body is optional, so it's left nil when the request has none.
+	if r.ContentLength != 0 {
+
+		if _, params, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, err)
+			return
+		} else if boundary := params["boundary"]; boundary == "" {
+			sh.options.RequestErrorHandlerFunc(w, r, http.ErrMissingBoundary)
+			return
+		} else {
+			request.Body = multipart.NewReader(r.Body, boundary)
+		}
 
+	}
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.MultipartRelatedExample(ctx, request.(MultipartRelatedExampleRequestObject))
 	}
@@ -1212,7 +1461,7 @@ func (sh *strictHandler) MultipartRelatedExample(w http.ResponseWriter, r *http.
 		handler = middleware(handler, "MultipartRelatedExample")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "MultipartRelatedExample"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -1229,48 +1478,51 @@ func (sh *strictHandler) MultipartRelatedExample(w http.ResponseWriter, r *http.
 func (sh *strictHandler) MultipleRequestAndResponseTypes(w http.ResponseWriter, r *http.Request) {
 	var request MultipleRequestAndResponseTypesRequestObject
 
-	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+	// The body is optional, so it's left nil when the request has none.
+	if r.ContentLength != 0 {
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
 
-		var body MultipleRequestAndResponseTypesJSONRequestBody
-		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-			return
+			var body MultipleRequestAndResponseTypesJSONRequestBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+				return
+			}
+			request.JSONBody = &body
 		}
-		request.JSONBody = &body
-	}
-	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
-		if err := r.ParseForm(); err != nil {
-			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode formdata: %w", err))
-			return
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+			if err := r.ParseForm(); err != nil {
+				sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode formdata: %w", err))
+				return
+			}
+			var body MultipleRequestAndResponseTypesFormdataRequestBody
+			if err := bindForm(&body, r.Form, nil); err != nil {
+				sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't bind formdata: %w", err))
+				return
+			}
+			request.FormdataBody = &body
 		}
-		var body MultipleRequestAndResponseTypesFormdataRequestBody
-		if err := runtime.BindForm(&body, r.Form, nil, nil); err != nil {
-			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't bind formdata: %w", err))
-			return
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "image/png") {
+			request.Body = r.Body
 		}
-		request.FormdataBody = &body
-	}
-	if strings.HasPrefix(r.Header.Get("Content-Type"), "image/png") {
-		request.Body = r.Body
-	}
-	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
-		if reader, err := r.MultipartReader(); err != nil {
-			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
-			return
-		} else {
-			request.MultipartBody = reader
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+			if reader, err := r.MultipartReader(); err != nil {
+				sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+				return
+			} else {
+				request.MultipartBody = reader
+			}
 		}
-	}
-	if strings.HasPrefix(r.Header.Get("Content-Type"), "text/plain") {
-		data, err := io.ReadAll(r.Body)
-		if err != nil {
-			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
-			return
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "text/plain") {
+			data, err := io.ReadAll(r.Body)
+			if err != nil {
+				sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+				return
+			}
+			body := MultipleRequestAndResponseTypesTextRequestBody(data)
+			request.TextBody = &body
 		}
-		body := MultipleRequestAndResponseTypesTextRequestBody(data)
-		request.TextBody = &body
-	}
 
+	}
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.MultipleRequestAndResponseTypes(ctx, request.(MultipleRequestAndResponseTypesRequestObject))
 	}
@@ -1278,7 +1530,7 @@ func (sh *strictHandler) MultipleRequestAndResponseTypes(w http.ResponseWriter,
 		handler = middleware(handler, "MultipleRequestAndResponseTypes")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "MultipleRequestAndResponseTypes"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -1304,7 +1556,7 @@ func (sh *strictHandler) ReservedGoKeywordParameters(w http.ResponseWriter, r *h
 		handler = middleware(handler, "ReservedGoKeywordParameters")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "ReservedGoKeywordParameters"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -1321,13 +1573,17 @@ func (sh *strictHandler) ReservedGoKeywordParameters(w http.ResponseWriter, r *h
 func (sh *strictHandler) ReusableResponses(w http.ResponseWriter, r *http.Request) {
 	var request ReusableResponsesRequestObject
 
-	var body ReusableResponsesJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if r.ContentLength != 0 {
+
+		var body ReusableResponsesJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.Body = &body
 
+	}
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.ReusableResponses(ctx, request.(ReusableResponsesRequestObject))
 	}
@@ -1335,7 +1591,7 @@ func (sh *strictHandler) ReusableResponses(w http.ResponseWriter, r *http.Reques
 		handler = middleware(handler, "ReusableResponses")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "ReusableResponses"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -1352,14 +1608,18 @@ func (sh *strictHandler) ReusableResponses(w http.ResponseWriter, r *http.Reques
 func (sh *strictHandler) TextExample(w http.ResponseWriter, r *http.Request) {
 	var request TextExampleRequestObject
 
-	data, err := io.ReadAll(r.Body)
-	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
-		return
-	}
-	body := TextExampleTextRequestBody(data)
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if r.ContentLength != 0 {
+
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+			return
+		}
+		body := TextExampleTextRequestBody(data)
+		request.Body = &body
 
+	}
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.TextExample(ctx, request.(TextExampleRequestObject))
 	}
@@ -1367,7 +1627,7 @@ func (sh *strictHandler) TextExample(w http.ResponseWriter, r *http.Request) {
 		handler = middleware(handler, "TextExample")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "TextExample"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -1380,12 +1640,44 @@ func (sh *strictHandler) TextExample(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// TypedPathParameters operation middleware
+func (sh *strictHandler) TypedPathParameters(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, date openapi_types.Date, params TypedPathParametersParams) {
+	var request TypedPathParametersRequestObject
+
+	request.Id = id
+	request.Date = date
+	request.TypedPathParametersParams = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TypedPathParameters(ctx, request.(TypedPathParametersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TypedPathParameters")
+	}
+
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "TypedPathParameters"), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TypedPathParametersResponseObject); ok {
+		if err := validResponse.VisitTypedPathParametersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // UnknownExample operation middleware
 func (sh *strictHandler) UnknownExample(w http.ResponseWriter, r *http.Request) {
 	var request UnknownExampleRequestObject
 
-	request.Body = r.Body
+	// The body is optional, so it's left nil when the request has none.
+	if r.ContentLength != 0 {
+
+		request.Body = r.Body
 
+	}
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.UnknownExample(ctx, request.(UnknownExampleRequestObject))
 	}
@@ -1393,7 +1685,7 @@ func (sh *strictHandler) UnknownExample(w http.ResponseWriter, r *http.Request)
 		handler = middleware(handler, "UnknownExample")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "UnknownExample"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -1411,9 +1703,12 @@ func (sh *strictHandler) UnspecifiedContentType(w http.ResponseWriter, r *http.R
 	var request UnspecifiedContentTypeRequestObject
 
 	request.ContentType = r.Header.Get("Content-Type")
+	// The body is optional, so it's left nil when the request has none.
+	if r.ContentLength != 0 {
 
-	request.Body = r.Body
+		request.Body = r.Body
 
+	}
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.UnspecifiedContentType(ctx, request.(UnspecifiedContentTypeRequestObject))
 	}
@@ -1421,7 +1716,7 @@ func (sh *strictHandler) UnspecifiedContentType(w http.ResponseWriter, r *http.R
 		handler = middleware(handler, "UnspecifiedContentType")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "UnspecifiedContentType"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -1438,17 +1733,21 @@ func (sh *strictHandler) UnspecifiedContentType(w http.ResponseWriter, r *http.R
 func (sh *strictHandler) URLEncodedExample(w http.ResponseWriter, r *http.Request) {
 	var request URLEncodedExampleRequestObject
 
-	if err := r.ParseForm(); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode formdata: %w", err))
-		return
-	}
-	var body URLEncodedExampleFormdataRequestBody
-	if err := runtime.BindForm(&body, r.Form, nil, nil); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't bind formdata: %w", err))
-		return
-	}
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if r.ContentLength != 0 {
+
+		if err := r.ParseForm(); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode formdata: %w", err))
+			return
+		}
+		var body URLEncodedExampleFormdataRequestBody
+		if err := bindForm(&body, r.Form, nil); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't bind formdata: %w", err))
+			return
+		}
+		request.Body = &body
 
+	}
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.URLEncodedExample(ctx, request.(URLEncodedExampleRequestObject))
 	}
@@ -1456,7 +1755,7 @@ func (sh *strictHandler) URLEncodedExample(w http.ResponseWriter, r *http.Reques
 		handler = middleware(handler, "URLEncodedExample")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "URLEncodedExample"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -1473,15 +1772,18 @@ func (sh *strictHandler) URLEncodedExample(w http.ResponseWriter, r *http.Reques
 func (sh *strictHandler) HeadersExample(w http.ResponseWriter, r *http.Request, params HeadersExampleParams) {
 	var request HeadersExampleRequestObject
 
-	request.Params = params
+	request.HeadersExampleParams = params
+	// The body is optional, so it's left nil when the request has none.
+	if r.ContentLength != 0 {
 
-	var body HeadersExampleJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+		var body HeadersExampleJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.Body = &body
 
+	}
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.HeadersExample(ctx, request.(HeadersExampleRequestObject))
 	}
@@ -1489,7 +1791,7 @@ func (sh *strictHandler) HeadersExample(w http.ResponseWriter, r *http.Request,
 		handler = middleware(handler, "HeadersExample")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "HeadersExample"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -1506,13 +1808,17 @@ func (sh *strictHandler) HeadersExample(w http.ResponseWriter, r *http.Request,
 func (sh *strictHandler) UnionExample(w http.ResponseWriter, r *http.Request) {
 	var request UnionExampleRequestObject
 
-	var body UnionExampleJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if r.ContentLength != 0 {
 
+		var body UnionExampleJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.Body = &body
+
+	}
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.UnionExample(ctx, request.(UnionExampleRequestObject))
 	}
@@ -1520,7 +1826,7 @@ func (sh *strictHandler) UnionExample(w http.ResponseWriter, r *http.Request) {
 		handler = middleware(handler, "UnionExample")
 	}
 
-	response, err := handler(r.Context(), w, r, request)
+	response, err := handler(context.WithValue(r.Context(), StrictOperationIdContextKey, "UnionExample"), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
@@ -1533,27 +1839,456 @@ func (sh *strictHandler) UnionExample(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// maxFormIndex is the largest index of an array bindForm binds, eg,
+// objects[1000][role]. The runtime allocates an array as long as the largest
+// index of a form, whichever indices it has, so a larger one is an error.
+const maxFormIndex = 1000
+
+// bindForm binds the fields of a form, and the files of a multipart one, to
+// dest, as runtime.BindForm does, once the keys of the fields are checked:
+// bracketed segments may follow their name, eg, objects[0][role], and the
+// indices of arrays can't be larger than maxFormIndex. The runtime panics on
+// some malformed keys, which are errors instead. The indices a form leaves out
+// are zero values, eg, objects[1][role]=admin binds two objects.
+func bindForm(dest interface{}, form map[string][]string, files map[string][]*multipart.FileHeader) error {
+	checkKey := func(key string) error {
+		start := strings.IndexByte(key, '[')
+		if start < 0 {
+			return nil
+		}
+		for rest := key[start:]; rest != ""; {
+			end := strings.IndexByte(rest, ']')
+			if rest[0] != '[' || end < 0 || strings.IndexByte(rest[1:end], '[') >= 0 {
+				return fmt.Errorf("invalid form field '%s'", key)
+			}
+			if segment := rest[1:end]; segment != "" && strings.Trim(segment, "0123456789") == "" {
+				if index, err := strconv.Atoi(segment); err != nil || index > maxFormIndex {
+					return fmt.Errorf("index %s of form field '%s' is out of range", segment, key)
+				}
+			}
+			rest = rest[end+1:]
+		}
+		return nil
+	}
+	for key := range form {
+		if err := checkKey(key); err != nil {
+			return err
+		}
+	}
+	for key := range files {
+		if err := checkKey(key); err != nil {
+			return err
+		}
+	}
+
+	return runtime.BindForm(dest, form, files, nil)
+}
+
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// JSONExampleHandler handles the JSONExample operation with its typed request and response objects.
+type JSONExampleHandler func(ctx context.Context, request JSONExampleRequestObject) (JSONExampleResponseObject, error)
+
+// MultipartExampleHandler handles the MultipartExample operation with its typed request and response objects.
+type MultipartExampleHandler func(ctx context.Context, request MultipartExampleRequestObject) (MultipartExampleResponseObject, error)
+
+// MultipartRelatedExampleHandler handles the MultipartRelatedExample operation with its typed request and response objects.
+type MultipartRelatedExampleHandler func(ctx context.Context, request MultipartRelatedExampleRequestObject) (MultipartRelatedExampleResponseObject, error)
+
+// MultipleRequestAndResponseTypesHandler handles the MultipleRequestAndResponseTypes operation with its typed request and response objects.
+type MultipleRequestAndResponseTypesHandler func(ctx context.Context, request MultipleRequestAndResponseTypesRequestObject) (MultipleRequestAndResponseTypesResponseObject, error)
+
+// ReservedGoKeywordParametersHandler handles the ReservedGoKeywordParameters operation with its typed request and response objects.
+type ReservedGoKeywordParametersHandler func(ctx context.Context, request ReservedGoKeywordParametersRequestObject) (ReservedGoKeywordParametersResponseObject, error)
+
+// ReusableResponsesHandler handles the ReusableResponses operation with its typed request and response objects.
+type ReusableResponsesHandler func(ctx context.Context, request ReusableResponsesRequestObject) (ReusableResponsesResponseObject, error)
+
+// TextExampleHandler handles the TextExample operation with its typed request and response objects.
+type TextExampleHandler func(ctx context.Context, request TextExampleRequestObject) (TextExampleResponseObject, error)
+
+// TypedPathParametersHandler handles the TypedPathParameters operation with its typed request and response objects.
+type TypedPathParametersHandler func(ctx context.Context, request TypedPathParametersRequestObject) (TypedPathParametersResponseObject, error)
+
+// UnknownExampleHandler handles the UnknownExample operation with its typed request and response objects.
+type UnknownExampleHandler func(ctx context.Context, request UnknownExampleRequestObject) (UnknownExampleResponseObject, error)
+
+// UnspecifiedContentTypeHandler handles the UnspecifiedContentType operation with its typed request and response objects.
+type UnspecifiedContentTypeHandler func(ctx context.Context, request UnspecifiedContentTypeRequestObject) (UnspecifiedContentTypeResponseObject, error)
+
+// URLEncodedExampleHandler handles the URLEncodedExample operation with its typed request and response objects.
+type URLEncodedExampleHandler func(ctx context.Context, request URLEncodedExampleRequestObject) (URLEncodedExampleResponseObject, error)
+
+// HeadersExampleHandler handles the HeadersExample operation with its typed request and response objects.
+type HeadersExampleHandler func(ctx context.Context, request HeadersExampleRequestObject) (HeadersExampleResponseObject, error)
+
+// UnionExampleHandler handles the UnionExample operation with its typed request and response objects.
+type UnionExampleHandler func(ctx context.Context, request UnionExampleRequestObject) (UnionExampleResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnJSONExample                     func(next JSONExampleHandler) JSONExampleHandler
+	OnMultipartExample                func(next MultipartExampleHandler) MultipartExampleHandler
+	OnMultipartRelatedExample         func(next MultipartRelatedExampleHandler) MultipartRelatedExampleHandler
+	OnMultipleRequestAndResponseTypes func(next MultipleRequestAndResponseTypesHandler) MultipleRequestAndResponseTypesHandler
+	OnReservedGoKeywordParameters     func(next ReservedGoKeywordParametersHandler) ReservedGoKeywordParametersHandler
+	OnReusableResponses               func(next ReusableResponsesHandler) ReusableResponsesHandler
+	OnTextExample                     func(next TextExampleHandler) TextExampleHandler
+	OnTypedPathParameters             func(next TypedPathParametersHandler) TypedPathParametersHandler
+	OnUnknownExample                  func(next UnknownExampleHandler) UnknownExampleHandler
+	OnUnspecifiedContentType          func(next UnspecifiedContentTypeHandler) UnspecifiedContentTypeHandler
+	OnURLEncodedExample               func(next URLEncodedExampleHandler) URLEncodedExampleHandler
+	OnHeadersExample                  func(next HeadersExampleHandler) HeadersExampleHandler
+	OnUnionExample                    func(next UnionExampleHandler) UnionExampleHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) JSONExample(ctx context.Context, request JSONExampleRequestObject) (JSONExampleResponseObject, error) {
+	handler := JSONExampleHandler(s.ssi.JSONExample)
+	if s.middlewares.OnJSONExample != nil {
+		handler = s.middlewares.OnJSONExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) MultipartExample(ctx context.Context, request MultipartExampleRequestObject) (MultipartExampleResponseObject, error) {
+	handler := MultipartExampleHandler(s.ssi.MultipartExample)
+	if s.middlewares.OnMultipartExample != nil {
+		handler = s.middlewares.OnMultipartExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) MultipartRelatedExample(ctx context.Context, request MultipartRelatedExampleRequestObject) (MultipartRelatedExampleResponseObject, error) {
+	handler := MultipartRelatedExampleHandler(s.ssi.MultipartRelatedExample)
+	if s.middlewares.OnMultipartRelatedExample != nil {
+		handler = s.middlewares.OnMultipartRelatedExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) MultipleRequestAndResponseTypes(ctx context.Context, request MultipleRequestAndResponseTypesRequestObject) (MultipleRequestAndResponseTypesResponseObject, error) {
+	handler := MultipleRequestAndResponseTypesHandler(s.ssi.MultipleRequestAndResponseTypes)
+	if s.middlewares.OnMultipleRequestAndResponseTypes != nil {
+		handler = s.middlewares.OnMultipleRequestAndResponseTypes(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) ReservedGoKeywordParameters(ctx context.Context, request ReservedGoKeywordParametersRequestObject) (ReservedGoKeywordParametersResponseObject, error) {
+	handler := ReservedGoKeywordParametersHandler(s.ssi.ReservedGoKeywordParameters)
+	if s.middlewares.OnReservedGoKeywordParameters != nil {
+		handler = s.middlewares.OnReservedGoKeywordParameters(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) ReusableResponses(ctx context.Context, request ReusableResponsesRequestObject) (ReusableResponsesResponseObject, error) {
+	handler := ReusableResponsesHandler(s.ssi.ReusableResponses)
+	if s.middlewares.OnReusableResponses != nil {
+		handler = s.middlewares.OnReusableResponses(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) TextExample(ctx context.Context, request TextExampleRequestObject) (TextExampleResponseObject, error) {
+	handler := TextExampleHandler(s.ssi.TextExample)
+	if s.middlewares.OnTextExample != nil {
+		handler = s.middlewares.OnTextExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) TypedPathParameters(ctx context.Context, request TypedPathParametersRequestObject) (TypedPathParametersResponseObject, error) {
+	handler := TypedPathParametersHandler(s.ssi.TypedPathParameters)
+	if s.middlewares.OnTypedPathParameters != nil {
+		handler = s.middlewares.OnTypedPathParameters(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) UnknownExample(ctx context.Context, request UnknownExampleRequestObject) (UnknownExampleResponseObject, error) {
+	handler := UnknownExampleHandler(s.ssi.UnknownExample)
+	if s.middlewares.OnUnknownExample != nil {
+		handler = s.middlewares.OnUnknownExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) UnspecifiedContentType(ctx context.Context, request UnspecifiedContentTypeRequestObject) (UnspecifiedContentTypeResponseObject, error) {
+	handler := UnspecifiedContentTypeHandler(s.ssi.UnspecifiedContentType)
+	if s.middlewares.OnUnspecifiedContentType != nil {
+		handler = s.middlewares.OnUnspecifiedContentType(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) URLEncodedExample(ctx context.Context, request URLEncodedExampleRequestObject) (URLEncodedExampleResponseObject, error) {
+	handler := URLEncodedExampleHandler(s.ssi.URLEncodedExample)
+	if s.middlewares.OnURLEncodedExample != nil {
+		handler = s.middlewares.OnURLEncodedExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) HeadersExample(ctx context.Context, request HeadersExampleRequestObject) (HeadersExampleResponseObject, error) {
+	handler := HeadersExampleHandler(s.ssi.HeadersExample)
+	if s.middlewares.OnHeadersExample != nil {
+		handler = s.middlewares.OnHeadersExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) UnionExample(ctx context.Context, request UnionExampleRequestObject) (UnionExampleResponseObject, error) {
+	handler := UnionExampleHandler(s.ssi.UnionExample)
+	if s.middlewares.OnUnionExample != nil {
+		handler = s.middlewares.OnUnionExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+// multipartPartKind is how the data of a part of a multipart body whose
+// schema is an object is read into its field, and written from it.
+type multipartPartKind int
+
+const (
+	// multipartJSON parts are the JSON of their field, eg, an object, or a
+	// number, which is written as it is in form data.
+	multipartJSON multipartPartKind = iota
+	// multipartText parts are the text of a string field, as it is.
+	multipartText
+	// multipartBinary parts are files, whose fields are set to their data and
+	// their filename, when they're of a type which has one, such as
+	// openapi_types.File, or to their data, when they're []byte.
+	multipartBinary
+)
+
+// multipartPart describes a part of a multipart body whose schema is an
+// object, which is the property of the same name.
+type multipartPart struct {
+	// Name is the name of the part, which is that of its property, and the
+	// JSON name of its field.
+	Name string
+	Kind multipartPartKind
+	// ContentType is the Content-Type the part is written with. When it's
+	// empty, that's application/json, text/plain or application/octet-stream,
+	// as its kind is multipartJSON, multipartText or multipartBinary.
+	ContentType string
+	Required    bool
+}
+
+// MissingMultipartPartError is the error of a multipart body without a part
+// which its schema requires.
+type MissingMultipartPartError struct {
+	Name string
+}
+
+func (e *MissingMultipartPartError) Error() string {
+	return fmt.Sprintf("multipart body is missing the required part %q", e.Name)
+}
+
+// multipartFile is a field holding the data and the filename of a
+// multipartBinary part, such as openapi_types.File.
+type multipartFile interface {
+	InitFromBytes(data []byte, filename string)
+	Reader() (io.ReadCloser, error)
+	Filename() string
+}
+
+// writeMultipartParts writes v, a struct or a pointer to one, as the parts of
+// writer, the writer of a multipart body of mediaType, eg, multipart/mixed.
+// Each part has a Content-Disposition, of form-data for multipart/form-data
+// and inline otherwise, naming it, and the filename of a multipartBinary part
+// which has one, and the Content-Type of its multipartPart. The parts are
+// written in their order, and those whose field is empty or nil and omitted
+// from its JSON are left out, unless they're required, which is a
+// *MissingMultipartPartError.
+func writeMultipartParts(writer *multipart.Writer, mediaType string, v interface{}, parts []multipartPart) error {
+	fields, err := multipartFields(v)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	disposition := "inline"
+	if mediaType == "multipart/form-data" {
+		disposition = "form-data"
+	}
+	for _, part := range parts {
+		params := map[string]string{"name": part.Name}
+		var body io.Reader
+		if f, ok := multipartFileOf(fields[part.Name]); ok && part.Kind == multipartBinary {
+			reader, err := f.Reader()
+			if err != nil {
+				return fmt.Errorf("error writing part %q: %w", part.Name, err)
+			}
+			defer reader.Close()
+			if filename := f.Filename(); filename != "" {
+				params["filename"] = filename
+			}
+			body = reader
+		} else if value, ok := values[part.Name]; ok && string(value) != "null" {
+			content, err := multipartPartContent(part, value)
+			if err != nil {
+				return fmt.Errorf("error writing part %q: %w", part.Name, err)
+			}
+			body = bytes.NewReader(content)
+		} else if part.Required {
+			return &MissingMultipartPartError{Name: part.Name}
+		} else {
+			continue
+		}
+
+		contentType := part.ContentType
+		switch {
+		case contentType != "":
+		case part.Kind == multipartText:
+			contentType = "text/plain; charset=utf-8"
+		case part.Kind == multipartBinary:
+			contentType = "application/octet-stream"
+		default:
+			contentType = "application/json"
+		}
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", mime.FormatMediaType(disposition, params))
+		header.Set("Content-Type", contentType)
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(w, body); err != nil {
+			return fmt.Errorf("error writing part %q: %w", part.Name, err)
+		}
+	}
+	return nil
+}
+
+// multipartPartContent returns the content of part, whose field's JSON is
+// value.
+func multipartPartContent(part multipartPart, value json.RawMessage) ([]byte, error) {
+	switch part.Kind {
+	case multipartBinary:
+		var data []byte
+		if err := json.Unmarshal(value, &data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	case multipartText:
+		var text string
+		if err := json.Unmarshal(value, &text); err != nil {
+			// The field isn't a string in JSON, eg, a number of a type
+			// the schema's string maps to, whose JSON is its text.
+			return value, nil
+		}
+		return []byte(text), nil
+	}
+	return value, nil
+}
+
+// multipartFileOf returns the file field is, or points to, and whether it's a
+// file.
+func multipartFileOf(field reflect.Value) (multipartFile, bool) {
+	if !field.IsValid() || (field.Kind() == reflect.Pointer && field.IsNil()) {
+		return nil, false
+	}
+	if f, ok := field.Interface().(multipartFile); ok {
+		return f, true
+	}
+	if field.CanAddr() {
+		if f, ok := field.Addr().Interface().(multipartFile); ok {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// multipartFields returns the fields of the struct v is, or points to, by their
+// JSON name, which are addressable.
+func multipartFields(v interface{}) (map[string]reflect.Value, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("multipart parts must be the fields of a struct, not of %T", v)
+	}
+	if !value.CanAddr() {
+		// The file fields are only files through their address.
+		addressable := reflect.New(value.Type()).Elem()
+		addressable.Set(value)
+		value = addressable
+	}
+	fields := make(map[string]reflect.Value, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		fields[name] = value.Field(i)
+	}
+	return fields, nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYS3PbNhD+Kxi0p5QUZccn3hpPJm3T1h3ZPnV8gIilhIQE0MVStEaj/94BQb0sWpUS",
-	"PTqZ3PhY7C6+b3ex2BnPTGmNBk2OpzOO4KzRDpqXoZAI/1TgyL9JcBkqS8ponvJ3Qg7af/OII1RODAtY",
-	"LPfymdEEulkqrC1UJvzS5JPz62fcZWMohX/6ESHnKf8hWbmShL8ugWdR2gL4fD6PXnhw95FHfAxCAjbe",
-	"hserTd00tcBT7giVHnGvJIhdd4opTTAC9Na8aOuEF1j4kc64RWMBSQWMJqKooNtS+8UMP0FGYQdK52Yb",
-	"y1ujSSjtmFR5DgiaWAse8zocc5W1BgkkG06Zt5ARc4ATQB5xUuQd4/fr31nrsOMRnwC6YOiq1+/1PV/G",
-	"ghZW8ZS/bT5F3AoaNxtaEmRNF++/3d/9yZRjoiJTClKZKIopKwW6sShAMqXJeBerjFyPN5awIf5X2a5+",
-	"30Lpo6YJoHdGTk8RME1croXzdb9/pricR/wmGOvSsXQqWUuwRk0uqqID80f9WZtaM0A02O4sKauClBVI",
-	"61xtov3HQmQfyJf6ktxgGUtB4kSoH8vSpYGPEQpBIPcgYBAkD+NhTf1JWfgaOxfloK3HnXXqfmxqx8am",
-	"ZmSYBFGwWtGYLRa+KLBKM8Gc0qMC2MKpqJPMAtpj72ctB+1eHryOk9ezaEPLc1zXddwkUIUF6MzIL6Mw",
-	"4qoUI0isHm0u97oF8ZQPp+RDdvuAO1IiR5zgmRJbCKV3n95nKunfkT5aYod0RWi6EhmPTPwZprVBGVuB",
-	"ogQCdMnMW597xSPoSOW/lpIsE5oNgWlRgmQiJ0D2wbBWpdtK2UFr94P5GERWqpqWZ/mS/j3jHpKmDeIR",
-	"9wZ4GlAJea3Qk05YQbQDtqf/jM+vImCBZmi24w1T3WVwUaKW0CHkzpfELuY68AuWBmsSl2nadkfc1vXj",
-	"HGeQZ/L1o/8Bnvdqu45Y+s6d24cCVoWPr2PWrtoHti+spHugOFESTFLamwM1XwxUZyFTuQIZt7uIg2+v",
-	"lYRbozME2myB/JVOG2JLZf6mSWNgAYGIOcNqYGXliFnhHFPUVJFChduqhK3i8bjy7DZYeliV012svjkR",
-	"p28uxehN/+rwJW9PHDcbrcwr+Tj4/X2QOfTOfrSe6cCO73h2L5TO/pISrw21ulP4lyCwOtMzUBPfEWnJ",
-	"EKhCDZJNlFgMYrZys1WworWrFwpurLqhxYDtkIYo2qnrmke7hnBP3/CI6JSjy3PFaaXVrlHho//N2h76",
-	"5dmgjP6fDgJFQYBakJrAT8e5QW5rMRru8ibTXrAc7Wnh6duLqnnEw+w6lKAKC18niGyaJGHm3XO1GI0A",
-	"e8okwiqPwr8BAAD//4h9qqfAGAAA",
+	"H4sIAAAAAAAC/+xZzZLbNgx+FQ7bUypZzmZPujU7mbRN2+14d0+dHGgRsphIJANC9no8fvcORflvLbt2",
+	"4p9Opre1BH6AAHwAiJ3xzFTWaNDkeDrjCM4a7aD5MRQS4UsNjvwvCS5DZUkZzVP+VshB+24ecYTaiWEJ",
+	"i+NePjOaQDdHhbWlyoQ/mnxy/vyMu6yASvi/fkTIecp/SFamJOGtS+BZVLYEPp/PoxcW3H/gES9ASMDG",
+	"2vDn601smlrgKXeESo+4BwliN51iShOMAL02L9oa4QUWdqQzbtFYQFLBR2NR1tCtqX1ihp8go/AFSudm",
+	"25d3RpNQ2jGp8hwQNLHWecxjOOZqaw0SSDacMq8hI+YAx4A84qTIG8Yf1p+z1mDHIz4GdEHR616/1/fx",
+	"Mha0sIqn/E3zKOJWUNF80DJA1nTF/beH+z+ZckzUZCpBKhNlOWWVQFeIEiRTmow3sc7I9XijCZvA/yrb",
+	"0+9aV/qsaRLorZHTcyRMk5dr6XzT718oL+cRv+33d2EsjUrWCNbA5KIuO3z+pD9rM9EMEA22X5ZUdUnK",
+	"CqT1WG16+4+FyCEuX+IlucEqloLEmbx+Kk3XdnyMUAoCeUAABkHyuDiswZ81Ct+i56oxKGHd9ZvHHgoz",
+	"cawwE0aGSRAlmygq2OLgiwKrNBPMKT0qgS2MijqDWULb9n7WctB+y6PHOHs9izZQnuPJZBI3BKqxBJ0Z",
+	"+XUhjLiqxAgSq0ebxz22IJ7y4ZSARx0N7kREjjjBMyW2FErv794XKun/e/pkxA50RWimEhmPTPwZphOD",
+	"MrYCRQUE6JKZ1z73wCPooPJfS0mWCc2GwLSoQDKREyB7b1gL6bYoO2j1vjcfgsgKqhl5lj/Sv2fcu6QZ",
+	"g3jEvQKeBq8EXiv0QSesIdrjto//mp/fFICFN8OwHW+o6i6DixK1dB1C7nxJ7Ipch/+CpsGaxHWGtv0Z",
+	"t3X9uEQP8pHc3fof4fmgseuEpe/S3D7aYVMLnvdUbJBfyXkyk4L2VgAq2OoMEwhsaGotfSZTAQqbPi5Z",
+	"cxtr2jmMAaeLy1BeirHBrfT2fVt67CPLgpJ7i8KynNd1I7lVzjtBvQcOg20ld8F+qQGnK9xSVYp4tO+y",
+	"e5GqVYeU2M2YNmcOIc1X9tEDODRWEkxS2dsjka9EqVo7C5nKFci4/Yo42LarIdwZnSHQ5gDsL/TaEFuC",
+	"+T0DFcCCByLmDJsAq2pHzArnmKKmh5Qq7CokbHHraWXZXdD0OLWHRPXVmWL66loRve2/Pv7ImzPnzcYg",
+	"u4OPg9/fBZljNzYnm5iPnPdPp/dKdPZX1HhtpdlN4V+CwGqiy0CN/TysJUOgGrXvhEos1nBb3GwBVmHt",
+	"annBjFUfWaxXjxmHo71YNwd0pe90QXjOxfWl8rTWat+i+Mm/Zu0N6mVvUEb/R9fAoiRALUiN4afT7A+2",
+	"UYyG+7xh2osoRwdq+Pj9ZdU84mFYDyWoxpKnvCCyaZKE/3j03ESMRoA9ZRJhlffCPwMAbejYZr4aAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
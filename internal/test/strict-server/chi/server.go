@@ -6,6 +6,7 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"mime/multipart"
 )
@@ -119,7 +120,7 @@ func (s StrictServer) URLEncodedExample(ctx context.Context, request URLEncodedE
 }
 
 func (s StrictServer) HeadersExample(ctx context.Context, request HeadersExampleRequestObject) (HeadersExampleResponseObject, error) {
-	return HeadersExample200JSONResponse{Body: *request.Body, Headers: HeadersExample200ResponseHeaders{Header1: request.Params.Header1, Header2: *request.Params.Header2}}, nil
+	return HeadersExample200JSONResponse{Body: *request.Body, Headers: HeadersExample200ResponseHeaders{Header1: request.Header1, Header2: *request.Header2}}, nil
 }
 
 func (s StrictServer) ReusableResponses(ctx context.Context, request ReusableResponsesRequestObject) (ReusableResponsesResponseObject, error) {
@@ -130,6 +131,14 @@ func (s StrictServer) ReservedGoKeywordParameters(ctx context.Context, request R
 	return ReservedGoKeywordParameters200TextResponse(""), nil
 }
 
+func (s StrictServer) TypedPathParameters(ctx context.Context, request TypedPathParametersRequestObject) (TypedPathParametersResponseObject, error) {
+	limit := 0
+	if request.Limit != nil {
+		limit = *request.Limit
+	}
+	return TypedPathParameters200TextResponse(fmt.Sprintf("%s %s %d", request.Id, request.Date, limit)), nil
+}
+
 func (s StrictServer) UnionExample(ctx context.Context, request UnionExampleRequestObject) (UnionExampleResponseObject, error) {
 	union, err := json.Marshal(*request.Body)
 	if err != nil {
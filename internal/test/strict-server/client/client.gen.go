@@ -7,13 +7,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // Example defines model for example.
@@ -30,6 +35,11 @@ type MultipleRequestAndResponseTypesTextBody = string
 // TextExampleTextBody defines parameters for TextExample.
 type TextExampleTextBody = string
 
+// TypedPathParametersParams defines parameters for TypedPathParameters.
+type TypedPathParametersParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // HeadersExampleParams defines parameters for HeadersExample.
 type HeadersExampleParams struct {
 	Header1 string `json:"header1"`
@@ -95,8 +105,12 @@ type Client struct {
 	Client HttpRequestDoer
 
 	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
+	// the network. They're applied in order, before the ones given to the call.
 	RequestEditors []RequestEditorFn
+
+	// requestEditorNames holds the positions in RequestEditors of the editors
+	// registered with WithRequestEditorReplacing, by name.
+	requestEditorNames map[string]int
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -143,22 +157,112 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithRequestEditorReplacing registers fn as the request editor named name,
+// replacing the editor registered under the same name, if any, in its
+// position, rather than adding another one. The editor can be replaced for a
+// call with WithRequestEditorOverride.
+func WithRequestEditorReplacing(name string, fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		editor := namedRequestEditor(name, fn)
+		if i, ok := c.requestEditorNames[name]; ok && i < len(c.RequestEditors) {
+			c.RequestEditors[i] = editor
+			return nil
+		}
+		if c.requestEditorNames == nil {
+			c.requestEditorNames = make(map[string]int)
+		}
+		c.requestEditorNames[name] = len(c.RequestEditors)
+		c.RequestEditors = append(c.RequestEditors, editor)
+		return nil
+	}
+}
+
+// requestEditorOverrideKey is the context key holding the editor replacing
+// the request editor of the client named name.
+type requestEditorOverrideKey struct {
+	name string
+}
+
+// WithRequestEditorOverride returns a copy of ctx which, for the calls made
+// with it, replaces the request editor registered as name with
+// WithRequestEditorReplacing by fn, or leaves it out when fn is nil.
+func WithRequestEditorOverride(ctx context.Context, name string, fn RequestEditorFn) context.Context {
+	return context.WithValue(ctx, requestEditorOverrideKey{name}, fn)
+}
+
+// namedRequestEditor returns the editor applying fn, unless the context of
+// the request overrides the editor named name.
+func namedRequestEditor(name string, fn RequestEditorFn) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		editor := fn
+		if override, ok := ctx.Value(requestEditorOverrideKey{name}).(RequestEditorFn); ok {
+			editor = override
+		}
+		if editor == nil {
+			return nil
+		}
+		return editor(ctx, req)
+	}
+}
+
+// RequestEditorError is returned by the operations of the client when one of
+// the request editors fails.
+type RequestEditorError struct {
+	// OperationID is the ID of the operation whose request was edited.
+	OperationID string
+	// Index is the position of the editor in RequestEditors, or among the
+	// editors given to the call when PerCall is set.
+	Index   int
+	PerCall bool
+	// Name is the name of an editor registered with
+	// WithRequestEditorReplacing.
+	Name string
+	Err  error
+}
+
+func (e *RequestEditorError) Error() string {
+	editor := fmt.Sprintf("client request editor %d", e.Index)
+	if e.PerCall {
+		editor = fmt.Sprintf("request editor %d of the call", e.Index)
+	}
+	if e.Name != "" {
+		editor += fmt.Sprintf(" (%s)", e.Name)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.OperationID, editor, e.Err)
+}
+
+func (e *RequestEditorError) Unwrap() error {
+	return e.Err
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// JSONExampleWithBody request with any body
 	JSONExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// JSONExampleWithoutBody request without the optional body
+	JSONExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	JSONExample(ctx context.Context, body JSONExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// MultipartExampleWithBody request with any body
 	MultipartExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// MultipartExampleWithoutBody request without the optional body
+	MultipartExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// MultipartRelatedExampleWithBody request with any body
 	MultipartRelatedExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// MultipartRelatedExampleWithoutBody request without the optional body
+	MultipartRelatedExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// MultipleRequestAndResponseTypesWithBody request with any body
 	MultipleRequestAndResponseTypesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// MultipleRequestAndResponseTypesWithoutBody request without the optional body
+	MultipleRequestAndResponseTypesWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	MultipleRequestAndResponseTypes(ctx context.Context, body MultipleRequestAndResponseTypesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	MultipleRequestAndResponseTypesWithFormdataBody(ctx context.Context, body MultipleRequestAndResponseTypesFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -171,32 +275,56 @@ type ClientInterface interface {
 	// ReusableResponsesWithBody request with any body
 	ReusableResponsesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ReusableResponsesWithoutBody request without the optional body
+	ReusableResponsesWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	ReusableResponses(ctx context.Context, body ReusableResponsesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// TextExampleWithBody request with any body
 	TextExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// TextExampleWithoutBody request without the optional body
+	TextExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	TextExampleWithTextBody(ctx context.Context, body TextExampleTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// TypedPathParameters request
+	TypedPathParameters(ctx context.Context, id openapi_types.UUID, date openapi_types.Date, params *TypedPathParametersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UnknownExampleWithBody request with any body
 	UnknownExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UnknownExampleWithoutBody request without the optional body
+	UnknownExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UnspecifiedContentTypeWithBody request with any body
 	UnspecifiedContentTypeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UnspecifiedContentTypeWithoutBody request without the optional body
+	UnspecifiedContentTypeWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// URLEncodedExampleWithBody request with any body
 	URLEncodedExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// URLEncodedExampleWithoutBody request without the optional body
+	URLEncodedExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	URLEncodedExampleWithFormdataBody(ctx context.Context, body URLEncodedExampleFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// HeadersExampleWithBody request with any body
 	HeadersExampleWithBody(ctx context.Context, params *HeadersExampleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// HeadersExampleWithoutBody request without the optional body
+	HeadersExampleWithoutBody(ctx context.Context, params *HeadersExampleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	HeadersExample(ctx context.Context, params *HeadersExampleParams, body HeadersExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UnionExampleWithBody request with any body
 	UnionExampleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UnionExampleWithoutBody request without the optional body
+	UnionExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	UnionExample(ctx context.Context, body UnionExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
@@ -206,7 +334,19 @@ func (c *Client) JSONExampleWithBody(ctx context.Context, contentType string, bo
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "JSONExample", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) JSONExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewJSONExampleRequestWithoutBody(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "JSONExample", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -218,7 +358,7 @@ func (c *Client) JSONExample(ctx context.Context, body JSONExampleJSONRequestBod
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "JSONExample", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -230,7 +370,19 @@ func (c *Client) MultipartExampleWithBody(ctx context.Context, contentType strin
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "MultipartExample", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MultipartExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMultipartExampleRequestWithoutBody(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "MultipartExample", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -242,7 +394,19 @@ func (c *Client) MultipartRelatedExampleWithBody(ctx context.Context, contentTyp
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "MultipartRelatedExample", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MultipartRelatedExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMultipartRelatedExampleRequestWithoutBody(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "MultipartRelatedExample", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -254,7 +418,19 @@ func (c *Client) MultipleRequestAndResponseTypesWithBody(ctx context.Context, co
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "MultipleRequestAndResponseTypes", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MultipleRequestAndResponseTypesWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMultipleRequestAndResponseTypesRequestWithoutBody(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "MultipleRequestAndResponseTypes", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -266,7 +442,7 @@ func (c *Client) MultipleRequestAndResponseTypes(ctx context.Context, body Multi
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "MultipleRequestAndResponseTypes", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -278,7 +454,7 @@ func (c *Client) MultipleRequestAndResponseTypesWithFormdataBody(ctx context.Con
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "MultipleRequestAndResponseTypes", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -290,7 +466,7 @@ func (c *Client) MultipleRequestAndResponseTypesWithTextBody(ctx context.Context
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "MultipleRequestAndResponseTypes", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -302,7 +478,7 @@ func (c *Client) ReservedGoKeywordParameters(ctx context.Context, pType string,
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "ReservedGoKeywordParameters", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -314,7 +490,19 @@ func (c *Client) ReusableResponsesWithBody(ctx context.Context, contentType stri
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "ReusableResponses", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReusableResponsesWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReusableResponsesRequestWithoutBody(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "ReusableResponses", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -326,7 +514,7 @@ func (c *Client) ReusableResponses(ctx context.Context, body ReusableResponsesJS
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "ReusableResponses", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -338,7 +526,19 @@ func (c *Client) TextExampleWithBody(ctx context.Context, contentType string, bo
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "TextExample", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TextExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTextExampleRequestWithoutBody(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "TextExample", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -350,7 +550,19 @@ func (c *Client) TextExampleWithTextBody(ctx context.Context, body TextExampleTe
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "TextExample", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TypedPathParameters(ctx context.Context, id openapi_types.UUID, date openapi_types.Date, params *TypedPathParametersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTypedPathParametersRequest(c.Server, id, date, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "TypedPathParameters", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -362,7 +574,19 @@ func (c *Client) UnknownExampleWithBody(ctx context.Context, contentType string,
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "UnknownExample", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnknownExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnknownExampleRequestWithoutBody(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "UnknownExample", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -374,7 +598,19 @@ func (c *Client) UnspecifiedContentTypeWithBody(ctx context.Context, contentType
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "UnspecifiedContentType", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnspecifiedContentTypeWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnspecifiedContentTypeRequestWithoutBody(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "UnspecifiedContentType", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -386,7 +622,19 @@ func (c *Client) URLEncodedExampleWithBody(ctx context.Context, contentType stri
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "URLEncodedExample", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) URLEncodedExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewURLEncodedExampleRequestWithoutBody(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "URLEncodedExample", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -398,7 +646,7 @@ func (c *Client) URLEncodedExampleWithFormdataBody(ctx context.Context, body URL
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "URLEncodedExample", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -410,7 +658,19 @@ func (c *Client) HeadersExampleWithBody(ctx context.Context, params *HeadersExam
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "HeadersExample", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HeadersExampleWithoutBody(ctx context.Context, params *HeadersExampleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHeadersExampleRequestWithoutBody(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "HeadersExample", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -422,7 +682,7 @@ func (c *Client) HeadersExample(ctx context.Context, params *HeadersExampleParam
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "HeadersExample", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -434,7 +694,19 @@ func (c *Client) UnionExampleWithBody(ctx context.Context, contentType string, b
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "UnionExample", req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnionExampleWithoutBody(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnionExampleRequestWithoutBody(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, "UnionExample", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -446,7 +718,7 @@ func (c *Client) UnionExample(ctx context.Context, body UnionExampleJSONRequestB
 		return nil, err
 	}
 	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	if err := c.applyEditors(ctx, "UnionExample", req, reqEditors); err != nil {
 		return nil, err
 	}
 	return c.Client.Do(req)
@@ -463,6 +735,16 @@ func NewJSONExampleRequest(server string, body JSONExampleJSONRequestBody) (*htt
 	return NewJSONExampleRequestWithBody(server, "application/json", bodyReader)
 }
 
+// NewJSONExampleRequestWithoutBody generates requests for JSONExample without its optional body
+func NewJSONExampleRequestWithoutBody(server string) (*http.Request, error) {
+	req, err := NewJSONExampleRequestWithBody(server, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewJSONExampleRequestWithBody generates requests for JSONExample with any type of body
 func NewJSONExampleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -492,6 +774,16 @@ func NewJSONExampleRequestWithBody(server string, contentType string, body io.Re
 	return req, nil
 }
 
+// NewMultipartExampleRequestWithoutBody generates requests for MultipartExample without its optional body
+func NewMultipartExampleRequestWithoutBody(server string) (*http.Request, error) {
+	req, err := NewMultipartExampleRequestWithBody(server, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewMultipartExampleRequestWithBody generates requests for MultipartExample with any type of body
 func NewMultipartExampleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -521,6 +813,16 @@ func NewMultipartExampleRequestWithBody(server string, contentType string, body
 	return req, nil
 }
 
+// NewMultipartRelatedExampleRequestWithoutBody generates requests for MultipartRelatedExample without its optional body
+func NewMultipartRelatedExampleRequestWithoutBody(server string) (*http.Request, error) {
+	req, err := NewMultipartRelatedExampleRequestWithBody(server, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewMultipartRelatedExampleRequestWithBody generates requests for MultipartRelatedExample with any type of body
 func NewMultipartRelatedExampleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -579,6 +881,16 @@ func NewMultipleRequestAndResponseTypesRequestWithTextBody(server string, body M
 	return NewMultipleRequestAndResponseTypesRequestWithBody(server, "text/plain", bodyReader)
 }
 
+// NewMultipleRequestAndResponseTypesRequestWithoutBody generates requests for MultipleRequestAndResponseTypes without its optional body
+func NewMultipleRequestAndResponseTypesRequestWithoutBody(server string) (*http.Request, error) {
+	req, err := NewMultipleRequestAndResponseTypesRequestWithBody(server, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewMultipleRequestAndResponseTypesRequestWithBody generates requests for MultipleRequestAndResponseTypes with any type of body
 func NewMultipleRequestAndResponseTypesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -653,6 +965,16 @@ func NewReusableResponsesRequest(server string, body ReusableResponsesJSONReques
 	return NewReusableResponsesRequestWithBody(server, "application/json", bodyReader)
 }
 
+// NewReusableResponsesRequestWithoutBody generates requests for ReusableResponses without its optional body
+func NewReusableResponsesRequestWithoutBody(server string) (*http.Request, error) {
+	req, err := NewReusableResponsesRequestWithBody(server, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewReusableResponsesRequestWithBody generates requests for ReusableResponses with any type of body
 func NewReusableResponsesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -689,6 +1011,16 @@ func NewTextExampleRequestWithTextBody(server string, body TextExampleTextReques
 	return NewTextExampleRequestWithBody(server, "text/plain", bodyReader)
 }
 
+// NewTextExampleRequestWithoutBody generates requests for TextExample without its optional body
+func NewTextExampleRequestWithoutBody(server string) (*http.Request, error) {
+	req, err := NewTextExampleRequestWithBody(server, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewTextExampleRequestWithBody generates requests for TextExample with any type of body
 func NewTextExampleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -718,6 +1050,79 @@ func NewTextExampleRequestWithBody(server string, contentType string, body io.Re
 	return req, nil
 }
 
+// NewTypedPathParametersRequest generates requests for TypedPathParameters
+func NewTypedPathParametersRequest(server string, id openapi_types.UUID, date openapi_types.Date, params *TypedPathParametersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "date", runtime.ParamLocationPath, date)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/typed-path-parameters/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnknownExampleRequestWithoutBody generates requests for UnknownExample without its optional body
+func NewUnknownExampleRequestWithoutBody(server string) (*http.Request, error) {
+	req, err := NewUnknownExampleRequestWithBody(server, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewUnknownExampleRequestWithBody generates requests for UnknownExample with any type of body
 func NewUnknownExampleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -747,6 +1152,16 @@ func NewUnknownExampleRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
+// NewUnspecifiedContentTypeRequestWithoutBody generates requests for UnspecifiedContentType without its optional body
+func NewUnspecifiedContentTypeRequestWithoutBody(server string) (*http.Request, error) {
+	req, err := NewUnspecifiedContentTypeRequestWithBody(server, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewUnspecifiedContentTypeRequestWithBody generates requests for UnspecifiedContentType with any type of body
 func NewUnspecifiedContentTypeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -787,6 +1202,16 @@ func NewURLEncodedExampleRequestWithFormdataBody(server string, body URLEncodedE
 	return NewURLEncodedExampleRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
+// NewURLEncodedExampleRequestWithoutBody generates requests for URLEncodedExample without its optional body
+func NewURLEncodedExampleRequestWithoutBody(server string) (*http.Request, error) {
+	req, err := NewURLEncodedExampleRequestWithBody(server, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewURLEncodedExampleRequestWithBody generates requests for URLEncodedExample with any type of body
 func NewURLEncodedExampleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -827,6 +1252,16 @@ func NewHeadersExampleRequest(server string, params *HeadersExampleParams, body
 	return NewHeadersExampleRequestWithBody(server, params, "application/json", bodyReader)
 }
 
+// NewHeadersExampleRequestWithoutBody generates requests for HeadersExample without its optional body
+func NewHeadersExampleRequestWithoutBody(server string, params *HeadersExampleParams) (*http.Request, error) {
+	req, err := NewHeadersExampleRequestWithBody(server, params, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewHeadersExampleRequestWithBody generates requests for HeadersExample with any type of body
 func NewHeadersExampleRequestWithBody(server string, params *HeadersExampleParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -862,6 +1297,9 @@ func NewHeadersExampleRequestWithBody(server string, params *HeadersExampleParam
 			return nil, err
 		}
 
+		if err = validateHeaderValue("header1", headerParam0); err != nil {
+			return nil, err
+		}
 		req.Header.Set("header1", headerParam0)
 
 		if params.Header2 != nil {
@@ -872,7 +1310,10 @@ func NewHeadersExampleRequestWithBody(server string, params *HeadersExampleParam
 				return nil, err
 			}
 
-			req.Header.Set("header2", headerParam1)
+			if err = validateHeaderValue("header2", headerParam1); err != nil {
+				return nil, err
+			}
+			req.Header.Set("header2", headerParam1)
 		}
 
 	}
@@ -891,6 +1332,16 @@ func NewUnionExampleRequest(server string, body UnionExampleJSONRequestBody) (*h
 	return NewUnionExampleRequestWithBody(server, "application/json", bodyReader)
 }
 
+// NewUnionExampleRequestWithoutBody generates requests for UnionExample without its optional body
+func NewUnionExampleRequestWithoutBody(server string) (*http.Request, error) {
+	req, err := NewUnionExampleRequestWithBody(server, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+	return req, nil
+}
+
 // NewUnionExampleRequestWithBody generates requests for UnionExample with any type of body
 func NewUnionExampleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -920,15 +1371,35 @@ func NewUnionExampleRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
+// validateHeaderValue rejects header parameter values with non-ASCII
+// characters, which HTTP doesn't define an encoding for.
+func validateHeaderValue(name string, value string) error {
+	for i := 0; i < len(value); i++ {
+		if value[i] > 0x7f {
+			return fmt.Errorf("header parameter %s has a non-ASCII value %q", name, value)
+		}
+	}
+	return nil
+}
+
+// applyEditors applies the request editors of the client, in the order they
+// were registered in, then the ones given to the call of operationID, in the
+// order they were given in, so that the latter win.
+func (c *Client) applyEditors(ctx context.Context, operationID string, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for i, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			editorErr := &RequestEditorError{OperationID: operationID, Index: i, Err: err}
+			for name, index := range c.requestEditorNames {
+				if index == i {
+					editorErr.Name = name
+				}
+			}
+			return editorErr
 		}
 	}
-	for _, r := range additionalEditors {
+	for i, r := range additionalEditors {
 		if err := r(ctx, req); err != nil {
-			return err
+			return &RequestEditorError{OperationID: operationID, Index: i, PerCall: true, Err: err}
 		}
 	}
 	return nil
@@ -961,22 +1432,88 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// responseBodyParsingKey is the context key of the request of a
+// WithResponse call telling whether its response body is parsed.
+type responseBodyParsingKey struct{}
+
+// WithoutResponseBodyParsing makes a WithResponse call skip reading and
+// parsing the response body, for callers which only look at the status code
+// and headers. The body is drained, up to a limit, and closed, and the typed
+// fields of the response are left nil.
+func WithoutResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(false)
+}
+
+// WithResponseBodyParsing makes a WithResponse call parse the response body,
+// overriding WithoutResponseBodyParsingByDefault.
+func WithResponseBodyParsing() RequestEditorFn {
+	return withResponseBodyParsing(true)
+}
+
+// WithoutResponseBodyParsingByDefault makes every WithResponse call of the
+// client behave as if given WithoutResponseBodyParsing, unless given
+// WithResponseBodyParsing.
+func WithoutResponseBodyParsingByDefault() ClientOption {
+	return WithRequestEditorFn(WithoutResponseBodyParsing())
+}
+
+func withResponseBodyParsing(parse bool) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), responseBodyParsingKey{}, parse))
+		return nil
+	}
+}
+
+// parsesResponseBody returns whether the body of rsp is to be parsed, which
+// is unless its request was made with WithoutResponseBodyParsing.
+func parsesResponseBody(rsp *http.Response) bool {
+	if rsp.Request == nil {
+		return true
+	}
+	parse, ok := rsp.Request.Context().Value(responseBodyParsingKey{}).(bool)
+	return !ok || parse
+}
+
+// discardResponseBody drains the body of rsp, up to 64KiB, so that the
+// connection may be reused, and closes it, replacing it with an empty one.
+// Larger bodies are left unread, and the connection is closed instead.
+func discardResponseBody(rsp *http.Response) {
+	if rsp.Body == nil || rsp.Body == http.NoBody {
+		return
+	}
+	_, _ = io.CopyN(io.Discard, rsp.Body, 64<<10)
+	_ = rsp.Body.Close()
+	rsp.Body = http.NoBody
+}
+
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// JSONExampleWithBodyWithResponse request with any body
 	JSONExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*JSONExampleResponse, error)
 
+	// JSONExampleWithoutBodyWithResponse request without the optional body
+	JSONExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*JSONExampleResponse, error)
+
 	JSONExampleWithResponse(ctx context.Context, body JSONExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*JSONExampleResponse, error)
 
 	// MultipartExampleWithBodyWithResponse request with any body
 	MultipartExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MultipartExampleResponse, error)
 
+	// MultipartExampleWithoutBodyWithResponse request without the optional body
+	MultipartExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MultipartExampleResponse, error)
+
 	// MultipartRelatedExampleWithBodyWithResponse request with any body
 	MultipartRelatedExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MultipartRelatedExampleResponse, error)
 
+	// MultipartRelatedExampleWithoutBodyWithResponse request without the optional body
+	MultipartRelatedExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MultipartRelatedExampleResponse, error)
+
 	// MultipleRequestAndResponseTypesWithBodyWithResponse request with any body
 	MultipleRequestAndResponseTypesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error)
 
+	// MultipleRequestAndResponseTypesWithoutBodyWithResponse request without the optional body
+	MultipleRequestAndResponseTypesWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error)
+
 	MultipleRequestAndResponseTypesWithResponse(ctx context.Context, body MultipleRequestAndResponseTypesJSONRequestBody, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error)
 
 	MultipleRequestAndResponseTypesWithFormdataBodyWithResponse(ctx context.Context, body MultipleRequestAndResponseTypesFormdataRequestBody, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error)
@@ -986,38 +1523,656 @@ type ClientWithResponsesInterface interface {
 	// ReservedGoKeywordParametersWithResponse request
 	ReservedGoKeywordParametersWithResponse(ctx context.Context, pType string, reqEditors ...RequestEditorFn) (*ReservedGoKeywordParametersResponse, error)
 
-	// ReusableResponsesWithBodyWithResponse request with any body
-	ReusableResponsesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReusableResponsesResponse, error)
+	// ReusableResponsesWithBodyWithResponse request with any body
+	ReusableResponsesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReusableResponsesResponse, error)
+
+	// ReusableResponsesWithoutBodyWithResponse request without the optional body
+	ReusableResponsesWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReusableResponsesResponse, error)
+
+	ReusableResponsesWithResponse(ctx context.Context, body ReusableResponsesJSONRequestBody, reqEditors ...RequestEditorFn) (*ReusableResponsesResponse, error)
+
+	// TextExampleWithBodyWithResponse request with any body
+	TextExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TextExampleResponse, error)
+
+	// TextExampleWithoutBodyWithResponse request without the optional body
+	TextExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TextExampleResponse, error)
+
+	TextExampleWithTextBodyWithResponse(ctx context.Context, body TextExampleTextRequestBody, reqEditors ...RequestEditorFn) (*TextExampleResponse, error)
+
+	// TypedPathParametersWithResponse request
+	TypedPathParametersWithResponse(ctx context.Context, id openapi_types.UUID, date openapi_types.Date, params *TypedPathParametersParams, reqEditors ...RequestEditorFn) (*TypedPathParametersResponse, error)
+
+	// UnknownExampleWithBodyWithResponse request with any body
+	UnknownExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnknownExampleResponse, error)
+
+	// UnknownExampleWithoutBodyWithResponse request without the optional body
+	UnknownExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UnknownExampleResponse, error)
+
+	// UnspecifiedContentTypeWithBodyWithResponse request with any body
+	UnspecifiedContentTypeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnspecifiedContentTypeResponse, error)
+
+	// UnspecifiedContentTypeWithoutBodyWithResponse request without the optional body
+	UnspecifiedContentTypeWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UnspecifiedContentTypeResponse, error)
+
+	// URLEncodedExampleWithBodyWithResponse request with any body
+	URLEncodedExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*URLEncodedExampleResponse, error)
+
+	// URLEncodedExampleWithoutBodyWithResponse request without the optional body
+	URLEncodedExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*URLEncodedExampleResponse, error)
+
+	URLEncodedExampleWithFormdataBodyWithResponse(ctx context.Context, body URLEncodedExampleFormdataRequestBody, reqEditors ...RequestEditorFn) (*URLEncodedExampleResponse, error)
+
+	// HeadersExampleWithBodyWithResponse request with any body
+	HeadersExampleWithBodyWithResponse(ctx context.Context, params *HeadersExampleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HeadersExampleResponse, error)
+
+	// HeadersExampleWithoutBodyWithResponse request without the optional body
+	HeadersExampleWithoutBodyWithResponse(ctx context.Context, params *HeadersExampleParams, reqEditors ...RequestEditorFn) (*HeadersExampleResponse, error)
+
+	HeadersExampleWithResponse(ctx context.Context, params *HeadersExampleParams, body HeadersExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*HeadersExampleResponse, error)
+
+	// UnionExampleWithBodyWithResponse request with any body
+	UnionExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnionExampleResponse, error)
+
+	// UnionExampleWithoutBodyWithResponse request without the optional body
+	UnionExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UnionExampleResponse, error)
+
+	UnionExampleWithResponse(ctx context.Context, body UnionExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*UnionExampleResponse, error)
+}
+
+// JSONExampleWithBodyWithResponse request with arbitrary body returning *JSONExampleResponse
+func (c *ClientWithResponses) JSONExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*JSONExampleResponse, error) {
+	rsp, err := c.JSONExampleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseJSONExampleResponseWithoutBody(rsp)
+	}
+	return ParseJSONExampleResponse(rsp)
+}
+
+// JSONExampleWithoutBodyWithResponse request without the optional body returning *JSONExampleResponse
+func (c *ClientWithResponses) JSONExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*JSONExampleResponse, error) {
+	rsp, err := c.JSONExampleWithoutBody(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseJSONExampleResponseWithoutBody(rsp)
+	}
+	return ParseJSONExampleResponse(rsp)
+}
+
+func (c *ClientWithResponses) JSONExampleWithResponse(ctx context.Context, body JSONExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*JSONExampleResponse, error) {
+	rsp, err := c.JSONExample(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseJSONExampleResponseWithoutBody(rsp)
+	}
+	return ParseJSONExampleResponse(rsp)
+}
+
+// parseJSONExampleResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseJSONExampleResponseWithoutBody(rsp *http.Response) (*JSONExampleResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &JSONExampleResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// MultipartExampleWithBodyWithResponse request with arbitrary body returning *MultipartExampleResponse
+func (c *ClientWithResponses) MultipartExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MultipartExampleResponse, error) {
+	rsp, err := c.MultipartExampleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseMultipartExampleResponseWithoutBody(rsp)
+	}
+	return ParseMultipartExampleResponse(rsp)
+}
+
+// MultipartExampleWithoutBodyWithResponse request without the optional body returning *MultipartExampleResponse
+func (c *ClientWithResponses) MultipartExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MultipartExampleResponse, error) {
+	rsp, err := c.MultipartExampleWithoutBody(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseMultipartExampleResponseWithoutBody(rsp)
+	}
+	return ParseMultipartExampleResponse(rsp)
+}
+
+// parseMultipartExampleResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseMultipartExampleResponseWithoutBody(rsp *http.Response) (*MultipartExampleResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &MultipartExampleResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// MultipartRelatedExampleWithBodyWithResponse request with arbitrary body returning *MultipartRelatedExampleResponse
+func (c *ClientWithResponses) MultipartRelatedExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MultipartRelatedExampleResponse, error) {
+	rsp, err := c.MultipartRelatedExampleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseMultipartRelatedExampleResponseWithoutBody(rsp)
+	}
+	return ParseMultipartRelatedExampleResponse(rsp)
+}
+
+// MultipartRelatedExampleWithoutBodyWithResponse request without the optional body returning *MultipartRelatedExampleResponse
+func (c *ClientWithResponses) MultipartRelatedExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MultipartRelatedExampleResponse, error) {
+	rsp, err := c.MultipartRelatedExampleWithoutBody(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseMultipartRelatedExampleResponseWithoutBody(rsp)
+	}
+	return ParseMultipartRelatedExampleResponse(rsp)
+}
+
+// parseMultipartRelatedExampleResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseMultipartRelatedExampleResponseWithoutBody(rsp *http.Response) (*MultipartRelatedExampleResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &MultipartRelatedExampleResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// MultipleRequestAndResponseTypesWithBodyWithResponse request with arbitrary body returning *MultipleRequestAndResponseTypesResponse
+func (c *ClientWithResponses) MultipleRequestAndResponseTypesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error) {
+	rsp, err := c.MultipleRequestAndResponseTypesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseMultipleRequestAndResponseTypesResponseWithoutBody(rsp)
+	}
+	return ParseMultipleRequestAndResponseTypesResponse(rsp)
+}
+
+// MultipleRequestAndResponseTypesWithoutBodyWithResponse request without the optional body returning *MultipleRequestAndResponseTypesResponse
+func (c *ClientWithResponses) MultipleRequestAndResponseTypesWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error) {
+	rsp, err := c.MultipleRequestAndResponseTypesWithoutBody(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseMultipleRequestAndResponseTypesResponseWithoutBody(rsp)
+	}
+	return ParseMultipleRequestAndResponseTypesResponse(rsp)
+}
+
+func (c *ClientWithResponses) MultipleRequestAndResponseTypesWithResponse(ctx context.Context, body MultipleRequestAndResponseTypesJSONRequestBody, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error) {
+	rsp, err := c.MultipleRequestAndResponseTypes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseMultipleRequestAndResponseTypesResponseWithoutBody(rsp)
+	}
+	return ParseMultipleRequestAndResponseTypesResponse(rsp)
+}
+
+func (c *ClientWithResponses) MultipleRequestAndResponseTypesWithFormdataBodyWithResponse(ctx context.Context, body MultipleRequestAndResponseTypesFormdataRequestBody, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error) {
+	rsp, err := c.MultipleRequestAndResponseTypesWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseMultipleRequestAndResponseTypesResponseWithoutBody(rsp)
+	}
+	return ParseMultipleRequestAndResponseTypesResponse(rsp)
+}
+
+func (c *ClientWithResponses) MultipleRequestAndResponseTypesWithTextBodyWithResponse(ctx context.Context, body MultipleRequestAndResponseTypesTextRequestBody, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error) {
+	rsp, err := c.MultipleRequestAndResponseTypesWithTextBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseMultipleRequestAndResponseTypesResponseWithoutBody(rsp)
+	}
+	return ParseMultipleRequestAndResponseTypesResponse(rsp)
+}
+
+// parseMultipleRequestAndResponseTypesResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseMultipleRequestAndResponseTypesResponseWithoutBody(rsp *http.Response) (*MultipleRequestAndResponseTypesResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &MultipleRequestAndResponseTypesResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ReservedGoKeywordParametersWithResponse request returning *ReservedGoKeywordParametersResponse
+func (c *ClientWithResponses) ReservedGoKeywordParametersWithResponse(ctx context.Context, pType string, reqEditors ...RequestEditorFn) (*ReservedGoKeywordParametersResponse, error) {
+	rsp, err := c.ReservedGoKeywordParameters(ctx, pType, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseReservedGoKeywordParametersResponseWithoutBody(rsp)
+	}
+	return ParseReservedGoKeywordParametersResponse(rsp)
+}
+
+// parseReservedGoKeywordParametersResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseReservedGoKeywordParametersResponseWithoutBody(rsp *http.Response) (*ReservedGoKeywordParametersResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &ReservedGoKeywordParametersResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ReusableResponsesWithBodyWithResponse request with arbitrary body returning *ReusableResponsesResponse
+func (c *ClientWithResponses) ReusableResponsesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReusableResponsesResponse, error) {
+	rsp, err := c.ReusableResponsesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseReusableResponsesResponseWithoutBody(rsp)
+	}
+	return ParseReusableResponsesResponse(rsp)
+}
+
+// ReusableResponsesWithoutBodyWithResponse request without the optional body returning *ReusableResponsesResponse
+func (c *ClientWithResponses) ReusableResponsesWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReusableResponsesResponse, error) {
+	rsp, err := c.ReusableResponsesWithoutBody(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseReusableResponsesResponseWithoutBody(rsp)
+	}
+	return ParseReusableResponsesResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReusableResponsesWithResponse(ctx context.Context, body ReusableResponsesJSONRequestBody, reqEditors ...RequestEditorFn) (*ReusableResponsesResponse, error) {
+	rsp, err := c.ReusableResponses(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseReusableResponsesResponseWithoutBody(rsp)
+	}
+	return ParseReusableResponsesResponse(rsp)
+}
+
+// parseReusableResponsesResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseReusableResponsesResponseWithoutBody(rsp *http.Response) (*ReusableResponsesResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &ReusableResponsesResponse{
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 200:
+		var headers ReusableResponses200ResponseHeaders
+		if value := rsp.Header.Get("header1"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "header1", value, &headers.Header1, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header header1: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("header2"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "header2", value, &headers.Header2, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header header2: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	}
+
+	return response, nil
+}
+
+// TextExampleWithBodyWithResponse request with arbitrary body returning *TextExampleResponse
+func (c *ClientWithResponses) TextExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TextExampleResponse, error) {
+	rsp, err := c.TextExampleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseTextExampleResponseWithoutBody(rsp)
+	}
+	return ParseTextExampleResponse(rsp)
+}
+
+// TextExampleWithoutBodyWithResponse request without the optional body returning *TextExampleResponse
+func (c *ClientWithResponses) TextExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TextExampleResponse, error) {
+	rsp, err := c.TextExampleWithoutBody(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseTextExampleResponseWithoutBody(rsp)
+	}
+	return ParseTextExampleResponse(rsp)
+}
+
+func (c *ClientWithResponses) TextExampleWithTextBodyWithResponse(ctx context.Context, body TextExampleTextRequestBody, reqEditors ...RequestEditorFn) (*TextExampleResponse, error) {
+	rsp, err := c.TextExampleWithTextBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseTextExampleResponseWithoutBody(rsp)
+	}
+	return ParseTextExampleResponse(rsp)
+}
+
+// parseTextExampleResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseTextExampleResponseWithoutBody(rsp *http.Response) (*TextExampleResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &TextExampleResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// TypedPathParametersWithResponse request returning *TypedPathParametersResponse
+func (c *ClientWithResponses) TypedPathParametersWithResponse(ctx context.Context, id openapi_types.UUID, date openapi_types.Date, params *TypedPathParametersParams, reqEditors ...RequestEditorFn) (*TypedPathParametersResponse, error) {
+	rsp, err := c.TypedPathParameters(ctx, id, date, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseTypedPathParametersResponseWithoutBody(rsp)
+	}
+	return ParseTypedPathParametersResponse(rsp)
+}
+
+// parseTypedPathParametersResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseTypedPathParametersResponseWithoutBody(rsp *http.Response) (*TypedPathParametersResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &TypedPathParametersResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// UnknownExampleWithBodyWithResponse request with arbitrary body returning *UnknownExampleResponse
+func (c *ClientWithResponses) UnknownExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnknownExampleResponse, error) {
+	rsp, err := c.UnknownExampleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseUnknownExampleResponseWithoutBody(rsp)
+	}
+	return ParseUnknownExampleResponse(rsp)
+}
+
+// UnknownExampleWithoutBodyWithResponse request without the optional body returning *UnknownExampleResponse
+func (c *ClientWithResponses) UnknownExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UnknownExampleResponse, error) {
+	rsp, err := c.UnknownExampleWithoutBody(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseUnknownExampleResponseWithoutBody(rsp)
+	}
+	return ParseUnknownExampleResponse(rsp)
+}
+
+// parseUnknownExampleResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseUnknownExampleResponseWithoutBody(rsp *http.Response) (*UnknownExampleResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &UnknownExampleResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// UnspecifiedContentTypeWithBodyWithResponse request with arbitrary body returning *UnspecifiedContentTypeResponse
+func (c *ClientWithResponses) UnspecifiedContentTypeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnspecifiedContentTypeResponse, error) {
+	rsp, err := c.UnspecifiedContentTypeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseUnspecifiedContentTypeResponseWithoutBody(rsp)
+	}
+	return ParseUnspecifiedContentTypeResponse(rsp)
+}
+
+// UnspecifiedContentTypeWithoutBodyWithResponse request without the optional body returning *UnspecifiedContentTypeResponse
+func (c *ClientWithResponses) UnspecifiedContentTypeWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UnspecifiedContentTypeResponse, error) {
+	rsp, err := c.UnspecifiedContentTypeWithoutBody(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseUnspecifiedContentTypeResponseWithoutBody(rsp)
+	}
+	return ParseUnspecifiedContentTypeResponse(rsp)
+}
+
+// parseUnspecifiedContentTypeResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseUnspecifiedContentTypeResponseWithoutBody(rsp *http.Response) (*UnspecifiedContentTypeResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &UnspecifiedContentTypeResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// URLEncodedExampleWithBodyWithResponse request with arbitrary body returning *URLEncodedExampleResponse
+func (c *ClientWithResponses) URLEncodedExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*URLEncodedExampleResponse, error) {
+	rsp, err := c.URLEncodedExampleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseURLEncodedExampleResponseWithoutBody(rsp)
+	}
+	return ParseURLEncodedExampleResponse(rsp)
+}
+
+// URLEncodedExampleWithoutBodyWithResponse request without the optional body returning *URLEncodedExampleResponse
+func (c *ClientWithResponses) URLEncodedExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*URLEncodedExampleResponse, error) {
+	rsp, err := c.URLEncodedExampleWithoutBody(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseURLEncodedExampleResponseWithoutBody(rsp)
+	}
+	return ParseURLEncodedExampleResponse(rsp)
+}
+
+func (c *ClientWithResponses) URLEncodedExampleWithFormdataBodyWithResponse(ctx context.Context, body URLEncodedExampleFormdataRequestBody, reqEditors ...RequestEditorFn) (*URLEncodedExampleResponse, error) {
+	rsp, err := c.URLEncodedExampleWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseURLEncodedExampleResponseWithoutBody(rsp)
+	}
+	return ParseURLEncodedExampleResponse(rsp)
+}
+
+// parseURLEncodedExampleResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseURLEncodedExampleResponseWithoutBody(rsp *http.Response) (*URLEncodedExampleResponse, error) {
+	discardResponseBody(rsp)
+
+	response := &URLEncodedExampleResponse{
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// HeadersExampleWithBodyWithResponse request with arbitrary body returning *HeadersExampleResponse
+func (c *ClientWithResponses) HeadersExampleWithBodyWithResponse(ctx context.Context, params *HeadersExampleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HeadersExampleResponse, error) {
+	rsp, err := c.HeadersExampleWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseHeadersExampleResponseWithoutBody(rsp)
+	}
+	return ParseHeadersExampleResponse(rsp)
+}
+
+// HeadersExampleWithoutBodyWithResponse request without the optional body returning *HeadersExampleResponse
+func (c *ClientWithResponses) HeadersExampleWithoutBodyWithResponse(ctx context.Context, params *HeadersExampleParams, reqEditors ...RequestEditorFn) (*HeadersExampleResponse, error) {
+	rsp, err := c.HeadersExampleWithoutBody(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseHeadersExampleResponseWithoutBody(rsp)
+	}
+	return ParseHeadersExampleResponse(rsp)
+}
+
+func (c *ClientWithResponses) HeadersExampleWithResponse(ctx context.Context, params *HeadersExampleParams, body HeadersExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*HeadersExampleResponse, error) {
+	rsp, err := c.HeadersExample(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseHeadersExampleResponseWithoutBody(rsp)
+	}
+	return ParseHeadersExampleResponse(rsp)
+}
 
-	ReusableResponsesWithResponse(ctx context.Context, body ReusableResponsesJSONRequestBody, reqEditors ...RequestEditorFn) (*ReusableResponsesResponse, error)
+// parseHeadersExampleResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseHeadersExampleResponseWithoutBody(rsp *http.Response) (*HeadersExampleResponse, error) {
+	discardResponseBody(rsp)
 
-	// TextExampleWithBodyWithResponse request with any body
-	TextExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TextExampleResponse, error)
+	response := &HeadersExampleResponse{
+		HTTPResponse: rsp,
+	}
 
-	TextExampleWithTextBodyWithResponse(ctx context.Context, body TextExampleTextRequestBody, reqEditors ...RequestEditorFn) (*TextExampleResponse, error)
+	switch {
+	case rsp.StatusCode == 200:
+		var headers HeadersExample200ResponseHeaders
+		if value := rsp.Header.Get("header1"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "header1", value, &headers.Header1, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header header1: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("header2"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "header2", value, &headers.Header2, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header header2: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	}
 
-	// UnknownExampleWithBodyWithResponse request with any body
-	UnknownExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnknownExampleResponse, error)
+	return response, nil
+}
 
-	// UnspecifiedContentTypeWithBodyWithResponse request with any body
-	UnspecifiedContentTypeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnspecifiedContentTypeResponse, error)
+// UnionExampleWithBodyWithResponse request with arbitrary body returning *UnionExampleResponse
+func (c *ClientWithResponses) UnionExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnionExampleResponse, error) {
+	rsp, err := c.UnionExampleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseUnionExampleResponseWithoutBody(rsp)
+	}
+	return ParseUnionExampleResponse(rsp)
+}
 
-	// URLEncodedExampleWithBodyWithResponse request with any body
-	URLEncodedExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*URLEncodedExampleResponse, error)
+// UnionExampleWithoutBodyWithResponse request without the optional body returning *UnionExampleResponse
+func (c *ClientWithResponses) UnionExampleWithoutBodyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UnionExampleResponse, error) {
+	rsp, err := c.UnionExampleWithoutBody(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseUnionExampleResponseWithoutBody(rsp)
+	}
+	return ParseUnionExampleResponse(rsp)
+}
 
-	URLEncodedExampleWithFormdataBodyWithResponse(ctx context.Context, body URLEncodedExampleFormdataRequestBody, reqEditors ...RequestEditorFn) (*URLEncodedExampleResponse, error)
+func (c *ClientWithResponses) UnionExampleWithResponse(ctx context.Context, body UnionExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*UnionExampleResponse, error) {
+	rsp, err := c.UnionExample(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if !parsesResponseBody(rsp) {
+		return parseUnionExampleResponseWithoutBody(rsp)
+	}
+	return ParseUnionExampleResponse(rsp)
+}
 
-	// HeadersExampleWithBodyWithResponse request with any body
-	HeadersExampleWithBodyWithResponse(ctx context.Context, params *HeadersExampleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HeadersExampleResponse, error)
+// parseUnionExampleResponseWithoutBody discards the body of rsp, for a call made with
+// WithoutResponseBodyParsing, and parses its headers only.
+func parseUnionExampleResponseWithoutBody(rsp *http.Response) (*UnionExampleResponse, error) {
+	discardResponseBody(rsp)
 
-	HeadersExampleWithResponse(ctx context.Context, params *HeadersExampleParams, body HeadersExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*HeadersExampleResponse, error)
+	response := &UnionExampleResponse{
+		HTTPResponse: rsp,
+	}
 
-	// UnionExampleWithBodyWithResponse request with any body
-	UnionExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnionExampleResponse, error)
+	switch {
+	case rsp.StatusCode == 200:
+		var headers UnionExample200ResponseHeaders
+		if value := rsp.Header.Get("header1"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "header1", value, &headers.Header1, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header header1: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("header2"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "header2", value, &headers.Header2, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header header2: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	}
 
-	UnionExampleWithResponse(ctx context.Context, body UnionExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*UnionExampleResponse, error)
+	return response, nil
 }
 
+// JSONExampleResponse is the response of JSONExample. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type JSONExampleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1040,9 +2195,14 @@ func (r JSONExampleResponse) StatusCode() int {
 	return 0
 }
 
+// MultipartExampleResponse is the response of MultipartExample. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type MultipartExampleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	Body                     []byte
+	HTTPResponse             *http.Response
+	BodyMultipartFormData200 []byte
+	MultipartFormData200     *Example
 }
 
 // Status returns HTTPResponse.Status
@@ -1061,9 +2221,14 @@ func (r MultipartExampleResponse) StatusCode() int {
 	return 0
 }
 
+// MultipartRelatedExampleResponse is the response of MultipartRelatedExample. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type MultipartRelatedExampleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	Body                    []byte
+	HTTPResponse            *http.Response
+	BodyMultipartRelated200 []byte
+	MultipartRelated200     *Example
 }
 
 // Status returns HTTPResponse.Status
@@ -1082,10 +2247,18 @@ func (r MultipartRelatedExampleResponse) StatusCode() int {
 	return 0
 }
 
+// MultipleRequestAndResponseTypesResponse is the response of MultipleRequestAndResponseTypes. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type MultipleRequestAndResponseTypesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Example
+	Body                                 []byte
+	HTTPResponse                         *http.Response
+	JSON200                              *Example
+	BodyApplicationXWwwFormURLencoded200 []byte
+	BodyImagePng200                      []byte
+	BodyMultipartFormData200             []byte
+	MultipartFormData200                 *Example
+	BodyTextPlain200                     []byte
 }
 
 // Status returns HTTPResponse.Status
@@ -1104,9 +2277,13 @@ func (r MultipleRequestAndResponseTypesResponse) StatusCode() int {
 	return 0
 }
 
+// ReservedGoKeywordParametersResponse is the response of ReservedGoKeywordParameters. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type ReservedGoKeywordParametersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
@@ -1125,10 +2302,20 @@ func (r ReservedGoKeywordParametersResponse) StatusCode() int {
 	return 0
 }
 
+// ReusableResponsesResponse is the response of ReusableResponses. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type ReusableResponsesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *Reusableresponse
+	Headers200   *ReusableResponses200ResponseHeaders
+}
+
+// ReusableResponses200ResponseHeaders holds the typed headers of the 200 response of ReusableResponses.
+type ReusableResponses200ResponseHeaders struct {
+	Header1 string
+	Header2 int
 }
 
 // Status returns HTTPResponse.Status
@@ -1147,9 +2334,13 @@ func (r ReusableResponsesResponse) StatusCode() int {
 	return 0
 }
 
+// TextExampleResponse is the response of TextExample. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
 type TextExampleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
@@ -1168,55 +2359,17 @@ func (r TextExampleResponse) StatusCode() int {
 	return 0
 }
 
-type UnknownExampleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
-
-// Status returns HTTPResponse.Status
-func (r UnknownExampleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r UnknownExampleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type UnspecifiedContentTypeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
-
-// Status returns HTTPResponse.Status
-func (r UnspecifiedContentTypeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r UnspecifiedContentTypeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type URLEncodedExampleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// TypedPathParametersResponse is the response of TypedPathParameters. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type TypedPathParametersResponse struct {
+	Body             []byte
+	HTTPResponse     *http.Response
+	BodyTextPlain200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r URLEncodedExampleResponse) Status() string {
+func (r TypedPathParametersResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1224,21 +2377,24 @@ func (r URLEncodedExampleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r URLEncodedExampleResponse) StatusCode() int {
+func (r TypedPathParametersResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type HeadersExampleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Example
+// UnknownExampleResponse is the response of UnknownExample. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type UnknownExampleResponse struct {
+	Body            []byte
+	HTTPResponse    *http.Response
+	BodyVideoMp4200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r HeadersExampleResponse) Status() string {
+func (r UnknownExampleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1246,24 +2402,24 @@ func (r HeadersExampleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r HeadersExampleResponse) StatusCode() int {
+func (r UnknownExampleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UnionExampleResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	ApplicationalternativeJSON200 *Example
-	JSON200                       *struct {
-		union json.RawMessage
-	}
+// UnspecifiedContentTypeResponse is the response of UnspecifiedContentType. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type UnspecifiedContentTypeResponse struct {
+	Body                 []byte
+	HTTPResponse         *http.Response
+	BodyVideoWildcard200 []byte
 }
 
 // Status returns HTTPResponse.Status
-func (r UnionExampleResponse) Status() string {
+func (r UnspecifiedContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1271,197 +2427,111 @@ func (r UnionExampleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UnionExampleResponse) StatusCode() int {
+func (r UnspecifiedContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
-
-// JSONExampleWithBodyWithResponse request with arbitrary body returning *JSONExampleResponse
-func (c *ClientWithResponses) JSONExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*JSONExampleResponse, error) {
-	rsp, err := c.JSONExampleWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseJSONExampleResponse(rsp)
-}
-
-func (c *ClientWithResponses) JSONExampleWithResponse(ctx context.Context, body JSONExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*JSONExampleResponse, error) {
-	rsp, err := c.JSONExample(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseJSONExampleResponse(rsp)
-}
-
-// MultipartExampleWithBodyWithResponse request with arbitrary body returning *MultipartExampleResponse
-func (c *ClientWithResponses) MultipartExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MultipartExampleResponse, error) {
-	rsp, err := c.MultipartExampleWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseMultipartExampleResponse(rsp)
-}
-
-// MultipartRelatedExampleWithBodyWithResponse request with arbitrary body returning *MultipartRelatedExampleResponse
-func (c *ClientWithResponses) MultipartRelatedExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MultipartRelatedExampleResponse, error) {
-	rsp, err := c.MultipartRelatedExampleWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseMultipartRelatedExampleResponse(rsp)
-}
-
-// MultipleRequestAndResponseTypesWithBodyWithResponse request with arbitrary body returning *MultipleRequestAndResponseTypesResponse
-func (c *ClientWithResponses) MultipleRequestAndResponseTypesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error) {
-	rsp, err := c.MultipleRequestAndResponseTypesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseMultipleRequestAndResponseTypesResponse(rsp)
-}
-
-func (c *ClientWithResponses) MultipleRequestAndResponseTypesWithResponse(ctx context.Context, body MultipleRequestAndResponseTypesJSONRequestBody, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error) {
-	rsp, err := c.MultipleRequestAndResponseTypes(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseMultipleRequestAndResponseTypesResponse(rsp)
-}
-
-func (c *ClientWithResponses) MultipleRequestAndResponseTypesWithFormdataBodyWithResponse(ctx context.Context, body MultipleRequestAndResponseTypesFormdataRequestBody, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error) {
-	rsp, err := c.MultipleRequestAndResponseTypesWithFormdataBody(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseMultipleRequestAndResponseTypesResponse(rsp)
-}
-
-func (c *ClientWithResponses) MultipleRequestAndResponseTypesWithTextBodyWithResponse(ctx context.Context, body MultipleRequestAndResponseTypesTextRequestBody, reqEditors ...RequestEditorFn) (*MultipleRequestAndResponseTypesResponse, error) {
-	rsp, err := c.MultipleRequestAndResponseTypesWithTextBody(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseMultipleRequestAndResponseTypesResponse(rsp)
-}
-
-// ReservedGoKeywordParametersWithResponse request returning *ReservedGoKeywordParametersResponse
-func (c *ClientWithResponses) ReservedGoKeywordParametersWithResponse(ctx context.Context, pType string, reqEditors ...RequestEditorFn) (*ReservedGoKeywordParametersResponse, error) {
-	rsp, err := c.ReservedGoKeywordParameters(ctx, pType, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseReservedGoKeywordParametersResponse(rsp)
-}
-
-// ReusableResponsesWithBodyWithResponse request with arbitrary body returning *ReusableResponsesResponse
-func (c *ClientWithResponses) ReusableResponsesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReusableResponsesResponse, error) {
-	rsp, err := c.ReusableResponsesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseReusableResponsesResponse(rsp)
-}
-
-func (c *ClientWithResponses) ReusableResponsesWithResponse(ctx context.Context, body ReusableResponsesJSONRequestBody, reqEditors ...RequestEditorFn) (*ReusableResponsesResponse, error) {
-	rsp, err := c.ReusableResponses(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseReusableResponsesResponse(rsp)
-}
-
-// TextExampleWithBodyWithResponse request with arbitrary body returning *TextExampleResponse
-func (c *ClientWithResponses) TextExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TextExampleResponse, error) {
-	rsp, err := c.TextExampleWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseTextExampleResponse(rsp)
+
+// URLEncodedExampleResponse is the response of URLEncodedExample. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type URLEncodedExampleResponse struct {
+	Body                                 []byte
+	HTTPResponse                         *http.Response
+	BodyApplicationXWwwFormURLencoded200 []byte
 }
 
-func (c *ClientWithResponses) TextExampleWithTextBodyWithResponse(ctx context.Context, body TextExampleTextRequestBody, reqEditors ...RequestEditorFn) (*TextExampleResponse, error) {
-	rsp, err := c.TextExampleWithTextBody(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r URLEncodedExampleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseTextExampleResponse(rsp)
+	return http.StatusText(0)
 }
 
-// UnknownExampleWithBodyWithResponse request with arbitrary body returning *UnknownExampleResponse
-func (c *ClientWithResponses) UnknownExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnknownExampleResponse, error) {
-	rsp, err := c.UnknownExampleWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r URLEncodedExampleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseUnknownExampleResponse(rsp)
+	return 0
 }
 
-// UnspecifiedContentTypeWithBodyWithResponse request with arbitrary body returning *UnspecifiedContentTypeResponse
-func (c *ClientWithResponses) UnspecifiedContentTypeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnspecifiedContentTypeResponse, error) {
-	rsp, err := c.UnspecifiedContentTypeWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUnspecifiedContentTypeResponse(rsp)
+// HeadersExampleResponse is the response of HeadersExample. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type HeadersExampleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Example
+	Headers200   *HeadersExample200ResponseHeaders
 }
 
-// URLEncodedExampleWithBodyWithResponse request with arbitrary body returning *URLEncodedExampleResponse
-func (c *ClientWithResponses) URLEncodedExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*URLEncodedExampleResponse, error) {
-	rsp, err := c.URLEncodedExampleWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseURLEncodedExampleResponse(rsp)
+// HeadersExample200ResponseHeaders holds the typed headers of the 200 response of HeadersExample.
+type HeadersExample200ResponseHeaders struct {
+	Header1 string
+	Header2 int
 }
 
-func (c *ClientWithResponses) URLEncodedExampleWithFormdataBodyWithResponse(ctx context.Context, body URLEncodedExampleFormdataRequestBody, reqEditors ...RequestEditorFn) (*URLEncodedExampleResponse, error) {
-	rsp, err := c.URLEncodedExampleWithFormdataBody(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r HeadersExampleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseURLEncodedExampleResponse(rsp)
+	return http.StatusText(0)
 }
 
-// HeadersExampleWithBodyWithResponse request with arbitrary body returning *HeadersExampleResponse
-func (c *ClientWithResponses) HeadersExampleWithBodyWithResponse(ctx context.Context, params *HeadersExampleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HeadersExampleResponse, error) {
-	rsp, err := c.HeadersExampleWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r HeadersExampleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseHeadersExampleResponse(rsp)
+	return 0
 }
 
-func (c *ClientWithResponses) HeadersExampleWithResponse(ctx context.Context, params *HeadersExampleParams, body HeadersExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*HeadersExampleResponse, error) {
-	rsp, err := c.HeadersExample(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// UnionExampleResponse is the response of UnionExample. When the call is made with
+// WithoutResponseBodyParsing, only HTTPResponse, whose body is then empty, and
+// the Headers fields are set.
+type UnionExampleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationalternativeJSON200 *Example
+	JSON200                       *struct {
+		union json.RawMessage
 	}
-	return ParseHeadersExampleResponse(rsp)
+	Headers200 *UnionExample200ResponseHeaders
 }
 
-// UnionExampleWithBodyWithResponse request with arbitrary body returning *UnionExampleResponse
-func (c *ClientWithResponses) UnionExampleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnionExampleResponse, error) {
-	rsp, err := c.UnionExampleWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// UnionExample200ResponseHeaders holds the typed headers of the 200 response of UnionExample.
+type UnionExample200ResponseHeaders struct {
+	Header1 string
+	Header2 int
+}
+
+// Status returns HTTPResponse.Status
+func (r UnionExampleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseUnionExampleResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) UnionExampleWithResponse(ctx context.Context, body UnionExampleJSONRequestBody, reqEditors ...RequestEditorFn) (*UnionExampleResponse, error) {
-	rsp, err := c.UnionExample(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnionExampleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseUnionExampleResponse(rsp)
+	return 0
 }
 
-// ParseJSONExampleResponse parses an HTTP response from a JSONExampleWithResponse call
+// ParseJSONExampleResponse parses an HTTP response from a JSONExampleWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseJSONExampleResponse(rsp *http.Response) (*JSONExampleResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1484,10 +2554,12 @@ func ParseJSONExampleResponse(rsp *http.Response) (*JSONExampleResponse, error)
 	return response, nil
 }
 
-// ParseMultipartExampleResponse parses an HTTP response from a MultipartExampleWithResponse call
+// ParseMultipartExampleResponse parses an HTTP response from a MultipartExampleWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseMultipartExampleResponse(rsp *http.Response) (*MultipartExampleResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1497,13 +2569,26 @@ func ParseMultipartExampleResponse(rsp *http.Response) (*MultipartExampleRespons
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "multipart/form-data") && rsp.StatusCode == 200:
+		response.BodyMultipartFormData200 = bodyBytes
+		var dest Example
+		if err := readMultipartParts(rsp.Header.Get("Content-Type"), bodyBytes, []multipartPart{{Name: "value", Kind: multipartText, ContentType: "text/plain; charset=utf-8"}}, &dest); err != nil {
+			return nil, err
+		}
+		response.MultipartFormData200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseMultipartRelatedExampleResponse parses an HTTP response from a MultipartRelatedExampleWithResponse call
+// ParseMultipartRelatedExampleResponse parses an HTTP response from a MultipartRelatedExampleWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseMultipartRelatedExampleResponse(rsp *http.Response) (*MultipartRelatedExampleResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1513,13 +2598,26 @@ func ParseMultipartRelatedExampleResponse(rsp *http.Response) (*MultipartRelated
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "multipart/related") && rsp.StatusCode == 200:
+		response.BodyMultipartRelated200 = bodyBytes
+		var dest Example
+		if err := readMultipartParts(rsp.Header.Get("Content-Type"), bodyBytes, []multipartPart{{Name: "value", Kind: multipartText, ContentType: "text/plain; charset=utf-8"}}, &dest); err != nil {
+			return nil, err
+		}
+		response.MultipartRelated200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseMultipleRequestAndResponseTypesResponse parses an HTTP response from a MultipleRequestAndResponseTypesWithResponse call
+// ParseMultipleRequestAndResponseTypesResponse parses an HTTP response from a MultipleRequestAndResponseTypesWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseMultipleRequestAndResponseTypesResponse(rsp *http.Response) (*MultipleRequestAndResponseTypesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1537,18 +2635,34 @@ func ParseMultipleRequestAndResponseTypesResponse(rsp *http.Response) (*Multiple
 		}
 		response.JSON200 = &dest
 
-	case rsp.StatusCode == 200:
-		// Content-type (text/plain) unsupported
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "application/x-www-form-urlencoded") && rsp.StatusCode == 200:
+		response.BodyApplicationXWwwFormURLencoded200 = bodyBytes
+
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "image/png") && rsp.StatusCode == 200:
+		response.BodyImagePng200 = bodyBytes
+
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "multipart/form-data") && rsp.StatusCode == 200:
+		response.BodyMultipartFormData200 = bodyBytes
+		var dest Example
+		if err := readMultipartParts(rsp.Header.Get("Content-Type"), bodyBytes, []multipartPart{{Name: "value", Kind: multipartText, ContentType: "text/plain; charset=utf-8"}}, &dest); err != nil {
+			return nil, err
+		}
+		response.MultipartFormData200 = &dest
+
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
 
 	}
 
 	return response, nil
 }
 
-// ParseReservedGoKeywordParametersResponse parses an HTTP response from a ReservedGoKeywordParametersWithResponse call
+// ParseReservedGoKeywordParametersResponse parses an HTTP response from a ReservedGoKeywordParametersWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseReservedGoKeywordParametersResponse(rsp *http.Response) (*ReservedGoKeywordParametersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1558,13 +2672,21 @@ func ParseReservedGoKeywordParametersResponse(rsp *http.Response) (*ReservedGoKe
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseReusableResponsesResponse parses an HTTP response from a ReusableResponsesWithResponse call
+// ParseReusableResponsesResponse parses an HTTP response from a ReusableResponsesWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseReusableResponsesResponse(rsp *http.Response) (*ReusableResponsesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1584,13 +2706,31 @@ func ParseReusableResponsesResponse(rsp *http.Response) (*ReusableResponsesRespo
 
 	}
 
+	switch {
+	case rsp.StatusCode == 200:
+		var headers ReusableResponses200ResponseHeaders
+		if value := rsp.Header.Get("header1"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "header1", value, &headers.Header1, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header header1: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("header2"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "header2", value, &headers.Header2, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header header2: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	}
+
 	return response, nil
 }
 
-// ParseTextExampleResponse parses an HTTP response from a TextExampleWithResponse call
+// ParseTextExampleResponse parses an HTTP response from a TextExampleWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseTextExampleResponse(rsp *http.Response) (*TextExampleResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1600,13 +2740,45 @@ func ParseTextExampleResponse(rsp *http.Response) (*TextExampleResponse, error)
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
+	return response, nil
+}
+
+// ParseTypedPathParametersResponse parses an HTTP response from a TypedPathParametersWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
+func ParseTypedPathParametersResponse(rsp *http.Response) (*TypedPathParametersResponse, error) {
+	bodyBytes, err := readResponseBody(rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TypedPathParametersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		response.BodyTextPlain200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseUnknownExampleResponse parses an HTTP response from a UnknownExampleWithResponse call
+// ParseUnknownExampleResponse parses an HTTP response from a UnknownExampleWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseUnknownExampleResponse(rsp *http.Response) (*UnknownExampleResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1616,13 +2788,21 @@ func ParseUnknownExampleResponse(rsp *http.Response) (*UnknownExampleResponse, e
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "video/mp4") && rsp.StatusCode == 200:
+		response.BodyVideoMp4200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseUnspecifiedContentTypeResponse parses an HTTP response from a UnspecifiedContentTypeWithResponse call
+// ParseUnspecifiedContentTypeResponse parses an HTTP response from a UnspecifiedContentTypeWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseUnspecifiedContentTypeResponse(rsp *http.Response) (*UnspecifiedContentTypeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1632,13 +2812,21 @@ func ParseUnspecifiedContentTypeResponse(rsp *http.Response) (*UnspecifiedConten
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "video/*") && rsp.StatusCode == 200:
+		response.BodyVideoWildcard200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseURLEncodedExampleResponse parses an HTTP response from a URLEncodedExampleWithResponse call
+// ParseURLEncodedExampleResponse parses an HTTP response from a URLEncodedExampleWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseURLEncodedExampleResponse(rsp *http.Response) (*URLEncodedExampleResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1648,13 +2836,21 @@ func ParseURLEncodedExampleResponse(rsp *http.Response) (*URLEncodedExampleRespo
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case responseHasContentType(rsp.Header.Get("Content-Type"), "application/x-www-form-urlencoded") && rsp.StatusCode == 200:
+		response.BodyApplicationXWwwFormURLencoded200 = bodyBytes
+
+	}
+
 	return response, nil
 }
 
-// ParseHeadersExampleResponse parses an HTTP response from a HeadersExampleWithResponse call
+// ParseHeadersExampleResponse parses an HTTP response from a HeadersExampleWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseHeadersExampleResponse(rsp *http.Response) (*HeadersExampleResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1674,13 +2870,31 @@ func ParseHeadersExampleResponse(rsp *http.Response) (*HeadersExampleResponse, e
 
 	}
 
+	switch {
+	case rsp.StatusCode == 200:
+		var headers HeadersExample200ResponseHeaders
+		if value := rsp.Header.Get("header1"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "header1", value, &headers.Header1, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header header1: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("header2"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "header2", value, &headers.Header2, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header header2: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	}
+
 	return response, nil
 }
 
-// ParseUnionExampleResponse parses an HTTP response from a UnionExampleWithResponse call
+// ParseUnionExampleResponse parses an HTTP response from a UnionExampleWithResponse call,
+// or one got any other way. It reads the body of rsp to its end and closes it,
+// leaving in its place one replaying what was read, so that rsp may be parsed
+// again; a body read beforehand must be put back the same way.
 func ParseUnionExampleResponse(rsp *http.Response) (*UnionExampleResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	bodyBytes, err := readResponseBody(rsp)
 	if err != nil {
 		return nil, err
 	}
@@ -1709,5 +2923,315 @@ func ParseUnionExampleResponse(rsp *http.Response) (*UnionExampleResponse, error
 
 	}
 
+	switch {
+	case rsp.StatusCode == 200:
+		var headers UnionExample200ResponseHeaders
+		if value := rsp.Header.Get("header1"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "header1", value, &headers.Header1, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header header1: %w", err)
+			}
+		}
+		if value := rsp.Header.Get("header2"); value != "" {
+			if err := runtime.BindStyledParameterWithOptions("simple", "header2", value, &headers.Header2, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {
+				return nil, fmt.Errorf("invalid format for header header2: %w", err)
+			}
+		}
+		response.Headers200 = &headers
+	}
+
 	return response, nil
 }
+
+// ParseResponse parses rsp, an HTTP response of the operation named
+// operationID, into its *<operationID>Response type, for callers picking the
+// operation at runtime.
+func ParseResponse(operationID string, rsp *http.Response) (interface{}, error) {
+	switch operationID {
+	case "JSONExample":
+		return ParseJSONExampleResponse(rsp)
+	case "MultipartExample":
+		return ParseMultipartExampleResponse(rsp)
+	case "MultipartRelatedExample":
+		return ParseMultipartRelatedExampleResponse(rsp)
+	case "MultipleRequestAndResponseTypes":
+		return ParseMultipleRequestAndResponseTypesResponse(rsp)
+	case "ReservedGoKeywordParameters":
+		return ParseReservedGoKeywordParametersResponse(rsp)
+	case "ReusableResponses":
+		return ParseReusableResponsesResponse(rsp)
+	case "TextExample":
+		return ParseTextExampleResponse(rsp)
+	case "TypedPathParameters":
+		return ParseTypedPathParametersResponse(rsp)
+	case "UnknownExample":
+		return ParseUnknownExampleResponse(rsp)
+	case "UnspecifiedContentType":
+		return ParseUnspecifiedContentTypeResponse(rsp)
+	case "URLEncodedExample":
+		return ParseURLEncodedExampleResponse(rsp)
+	case "HeadersExample":
+		return ParseHeadersExampleResponse(rsp)
+	case "UnionExample":
+		return ParseUnionExampleResponse(rsp)
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationID)
+}
+
+// replayedResponseBody is the body left in place of the one of a parsed
+// response, which replays what was read from it.
+type replayedResponseBody struct {
+	*bytes.Reader
+	data []byte
+}
+
+// Close implements io.Closer; the body it replaces has already been closed.
+func (b *replayedResponseBody) Close() error {
+	return nil
+}
+
+// readResponseBody returns the body of rsp. The first time, it reads the body
+// to its end and closes it, whether reading succeeds or not, and replaces it
+// with one replaying what was read, so that the response may be parsed again,
+// or its body read by the caller, afterwards. A nil body is read as empty.
+// Callers which read the body themselves before parsing the response must put
+// back one replaying it, eg, io.NopCloser(bytes.NewReader(body)).
+func readResponseBody(rsp *http.Response) ([]byte, error) {
+	if rsp == nil {
+		return nil, errors.New("nil response")
+	}
+	if rsp.Body == nil {
+		return nil, nil
+	}
+	if body, ok := rsp.Body.(*replayedResponseBody); ok {
+		return body.data, nil
+	}
+	data, err := io.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = &replayedResponseBody{Reader: bytes.NewReader(data), data: data}
+	return data, nil
+}
+
+// responseHasContentType reports whether contentType, the Content-Type of a
+// response, has the media type of expected, which may be a wildcard, eg,
+// image/*, and at least its parameters.
+func responseHasContentType(contentType string, expected string) bool {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	expectedMediaType, expectedParams, err := mime.ParseMediaType(expected)
+	if err != nil {
+		return false
+	}
+	if expectedMediaType != "*/*" && expectedMediaType != mediaType &&
+		!(strings.HasSuffix(expectedMediaType, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(expectedMediaType, "*"))) {
+		return false
+	}
+	for name, value := range expectedParams {
+		if params[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// multipartPartKind is how the data of a part of a multipart body whose
+// schema is an object is read into its field, and written from it.
+type multipartPartKind int
+
+const (
+	// multipartJSON parts are the JSON of their field, eg, an object, or a
+	// number, which is written as it is in form data.
+	multipartJSON multipartPartKind = iota
+	// multipartText parts are the text of a string field, as it is.
+	multipartText
+	// multipartBinary parts are files, whose fields are set to their data and
+	// their filename, when they're of a type which has one, such as
+	// openapi_types.File, or to their data, when they're []byte.
+	multipartBinary
+)
+
+// multipartPart describes a part of a multipart body whose schema is an
+// object, which is the property of the same name.
+type multipartPart struct {
+	// Name is the name of the part, which is that of its property, and the
+	// JSON name of its field.
+	Name string
+	Kind multipartPartKind
+	// ContentType is the Content-Type the part is written with. When it's
+	// empty, that's application/json, text/plain or application/octet-stream,
+	// as its kind is multipartJSON, multipartText or multipartBinary.
+	ContentType string
+	Required    bool
+}
+
+// MissingMultipartPartError is the error of a multipart body without a part
+// which its schema requires.
+type MissingMultipartPartError struct {
+	Name string
+}
+
+func (e *MissingMultipartPartError) Error() string {
+	return fmt.Sprintf("multipart body is missing the required part %q", e.Name)
+}
+
+// multipartFile is a field holding the data and the filename of a
+// multipartBinary part, such as openapi_types.File.
+type multipartFile interface {
+	InitFromBytes(data []byte, filename string)
+	Reader() (io.ReadCloser, error)
+	Filename() string
+}
+
+// readMultipartParts reads body, a multipart body of the given Content-Type,
+// into dest, a pointer to a struct. The parts are told apart by the name of
+// their Content-Disposition, or else by their Content-ID, and those which
+// aren't any of parts are ignored. It returns a *MissingMultipartPartError
+// when a required part is missing.
+func readMultipartParts(contentType string, body []byte, parts []multipartPart, dest interface{}) error {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("invalid multipart Content-Type %q: %w", contentType, err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") || params["boundary"] == "" {
+		return fmt.Errorf("Content-Type %q isn't multipart with a boundary", contentType)
+	}
+	fields, err := multipartFields(dest)
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]multipartPart, len(parts))
+	for _, part := range parts {
+		byName[part.Name] = part
+	}
+	values := make(map[string]json.RawMessage)
+	read := make(map[string]bool)
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		p, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error reading multipart body: %w", err)
+		}
+		// The name of a part is that of its Content-Disposition, or else its
+		// Content-ID, without its angle brackets.
+		name := strings.TrimSuffix(strings.TrimPrefix(p.Header.Get("Content-ID"), "<"), ">")
+		if _, dispositionParams, err := mime.ParseMediaType(p.Header.Get("Content-Disposition")); err == nil && dispositionParams["name"] != "" {
+			name = dispositionParams["name"]
+		}
+		part, found := byName[name]
+		if !found {
+			continue
+		}
+		if read[name] {
+			return fmt.Errorf("multipart body has more than one part %q", name)
+		}
+		read[name] = true
+		data, err := io.ReadAll(p)
+		if err != nil {
+			return fmt.Errorf("error reading part %q: %w", name, err)
+		}
+
+		switch part.Kind {
+		case multipartBinary:
+			if field, ok := fields[name]; ok && setMultipartFile(field, data, p.FileName()) {
+				continue
+			}
+			values[name], err = json.Marshal(data)
+		case multipartText:
+			values[name], err = json.Marshal(string(data))
+		default:
+			if !json.Valid(data) {
+				return fmt.Errorf("part %q isn't valid JSON", name)
+			}
+			values[name] = data
+		}
+		if err != nil {
+			return fmt.Errorf("error reading part %q: %w", name, err)
+		}
+	}
+
+	for _, part := range parts {
+		if part.Required && !read[part.Name] {
+			return &MissingMultipartPartError{Name: part.Name}
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	object, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(object, dest); err != nil {
+		return fmt.Errorf("error decoding the parts of the multipart body: %w", err)
+	}
+	return nil
+}
+
+// setMultipartFile sets field to data and filename, allocating it when it's a
+// nil pointer, reporting whether it's of a file type, or []byte.
+func setMultipartFile(field reflect.Value, data []byte, filename string) bool {
+	if !field.CanSet() {
+		return false
+	}
+	if field.Kind() == reflect.Pointer {
+		if _, ok := reflect.New(field.Type().Elem()).Interface().(multipartFile); !ok {
+			return false
+		}
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		field.Interface().(multipartFile).InitFromBytes(data, filename)
+		return true
+	}
+	if f, ok := field.Addr().Interface().(multipartFile); ok {
+		f.InitFromBytes(data, filename)
+		return true
+	}
+	if field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Uint8 {
+		field.SetBytes(data)
+		return true
+	}
+	return false
+}
+
+// multipartFields returns the fields of the struct v is, or points to, by their
+// JSON name, which are addressable.
+func multipartFields(v interface{}) (map[string]reflect.Value, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("multipart parts must be the fields of a struct, not of %T", v)
+	}
+	if !value.CanAddr() {
+		// The file fields are only files through their address.
+		addressable := reflect.New(value.Type()).Elem()
+		addressable.Set(value)
+		value = addressable
+	}
+	fields := make(map[string]reflect.Value, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		fields[name] = value.Field(i)
+	}
+	return fields, nil
+}
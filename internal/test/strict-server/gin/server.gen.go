@@ -14,14 +14,18 @@ import (
 	"mime"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"path"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
 	strictgin "github.com/oapi-codegen/runtime/strictmiddleware/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // ServerInterface represents all server handlers.
@@ -48,6 +52,9 @@ type ServerInterface interface {
 	// (POST /text)
 	TextExample(c *gin.Context)
 
+	// (GET /typed-path-parameters/{id}/{date})
+	TypedPathParameters(c *gin.Context, id openapi_types.UUID, date openapi_types.Date, params TypedPathParametersParams)
+
 	// (POST /unknown)
 	UnknownExample(c *gin.Context)
 
@@ -175,6 +182,50 @@ func (siw *ServerInterfaceWrapper) TextExample(c *gin.Context) {
 	siw.Handler.TextExample(c)
 }
 
+// TypedPathParameters operation middleware
+func (siw *ServerInterfaceWrapper) TypedPathParameters(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	err = runtime.BindStyledParameterWithOptions("simple", "date", c.Param("date"), &date, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TypedPathParametersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TypedPathParameters(c, id, date, params)
+}
+
 // UnknownExample operation middleware
 func (siw *ServerInterfaceWrapper) UnknownExample(c *gin.Context) {
 
@@ -295,38 +346,121 @@ type GinServerOptions struct {
 	ErrorHandler func(*gin.Context, error, int)
 }
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
-
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandler, defaulting to a JSON object with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options GinServerOptions) *ServerInterfaceWrapper {
 	errorHandler := options.ErrorHandler
 	if errorHandler == nil {
 		errorHandler = func(c *gin.Context, err error, statusCode int) {
 			c.JSON(statusCode, gin.H{"msg": err.Error()})
 		}
 	}
-
-	wrapper := ServerInterfaceWrapper{
+	return &ServerInterfaceWrapper{
 		Handler:            si,
 		HandlerMiddlewares: options.Middlewares,
 		ErrorHandler:       errorHandler,
 	}
+}
+
+// NewJSONExampleHandler returns the handler RegisterHandlersWithOptions routes the
+// JSONExample operation to, so that it can be mounted on its own.
+func NewJSONExampleHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).JSONExample
+}
+
+// NewMultipartExampleHandler returns the handler RegisterHandlersWithOptions routes the
+// MultipartExample operation to, so that it can be mounted on its own.
+func NewMultipartExampleHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).MultipartExample
+}
+
+// NewMultipartRelatedExampleHandler returns the handler RegisterHandlersWithOptions routes the
+// MultipartRelatedExample operation to, so that it can be mounted on its own.
+func NewMultipartRelatedExampleHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).MultipartRelatedExample
+}
+
+// NewMultipleRequestAndResponseTypesHandler returns the handler RegisterHandlersWithOptions routes the
+// MultipleRequestAndResponseTypes operation to, so that it can be mounted on its own.
+func NewMultipleRequestAndResponseTypesHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).MultipleRequestAndResponseTypes
+}
+
+// NewReservedGoKeywordParametersHandler returns the handler RegisterHandlersWithOptions routes the
+// ReservedGoKeywordParameters operation to, so that it can be mounted on its own.
+func NewReservedGoKeywordParametersHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ReservedGoKeywordParameters
+}
+
+// NewReusableResponsesHandler returns the handler RegisterHandlersWithOptions routes the
+// ReusableResponses operation to, so that it can be mounted on its own.
+func NewReusableResponsesHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ReusableResponses
+}
+
+// NewTextExampleHandler returns the handler RegisterHandlersWithOptions routes the
+// TextExample operation to, so that it can be mounted on its own.
+func NewTextExampleHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).TextExample
+}
+
+// NewTypedPathParametersHandler returns the handler RegisterHandlersWithOptions routes the
+// TypedPathParameters operation to, so that it can be mounted on its own.
+func NewTypedPathParametersHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).TypedPathParameters
+}
+
+// NewUnknownExampleHandler returns the handler RegisterHandlersWithOptions routes the
+// UnknownExample operation to, so that it can be mounted on its own.
+func NewUnknownExampleHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).UnknownExample
+}
+
+// NewUnspecifiedContentTypeHandler returns the handler RegisterHandlersWithOptions routes the
+// UnspecifiedContentType operation to, so that it can be mounted on its own.
+func NewUnspecifiedContentTypeHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).UnspecifiedContentType
+}
+
+// NewURLEncodedExampleHandler returns the handler RegisterHandlersWithOptions routes the
+// URLEncodedExample operation to, so that it can be mounted on its own.
+func NewURLEncodedExampleHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).URLEncodedExample
+}
+
+// NewHeadersExampleHandler returns the handler RegisterHandlersWithOptions routes the
+// HeadersExample operation to, so that it can be mounted on its own.
+func NewHeadersExampleHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).HeadersExample
+}
+
+// NewUnionExampleHandler returns the handler RegisterHandlersWithOptions routes the
+// UnionExample operation to, so that it can be mounted on its own.
+func NewUnionExampleHandler(si ServerInterface, options GinServerOptions) gin.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).UnionExample
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
 
-	router.POST(options.BaseURL+"/json", wrapper.JSONExample)
-	router.POST(options.BaseURL+"/multipart", wrapper.MultipartExample)
-	router.POST(options.BaseURL+"/multipart-related", wrapper.MultipartRelatedExample)
-	router.POST(options.BaseURL+"/multiple", wrapper.MultipleRequestAndResponseTypes)
-	router.GET(options.BaseURL+"/reserved-go-keyword-parameters/:type", wrapper.ReservedGoKeywordParameters)
-	router.POST(options.BaseURL+"/reusable-responses", wrapper.ReusableResponses)
-	router.POST(options.BaseURL+"/text", wrapper.TextExample)
-	router.POST(options.BaseURL+"/unknown", wrapper.UnknownExample)
-	router.POST(options.BaseURL+"/unspecified-content-type", wrapper.UnspecifiedContentType)
-	router.POST(options.BaseURL+"/urlencoded", wrapper.URLEncodedExample)
-	router.POST(options.BaseURL+"/with-headers", wrapper.HeadersExample)
-	router.POST(options.BaseURL+"/with-union", wrapper.UnionExample)
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	router.POST(options.BaseURL+"/json", NewJSONExampleHandler(si, options))
+	router.POST(options.BaseURL+"/multipart", NewMultipartExampleHandler(si, options))
+	router.POST(options.BaseURL+"/multipart-related", NewMultipartRelatedExampleHandler(si, options))
+	router.POST(options.BaseURL+"/multiple", NewMultipleRequestAndResponseTypesHandler(si, options))
+	router.GET(options.BaseURL+"/reserved-go-keyword-parameters/:type", NewReservedGoKeywordParametersHandler(si, options))
+	router.POST(options.BaseURL+"/reusable-responses", NewReusableResponsesHandler(si, options))
+	router.POST(options.BaseURL+"/text", NewTextExampleHandler(si, options))
+	router.GET(options.BaseURL+"/typed-path-parameters/:id/:date", NewTypedPathParametersHandler(si, options))
+	router.POST(options.BaseURL+"/unknown", NewUnknownExampleHandler(si, options))
+	router.POST(options.BaseURL+"/unspecified-content-type", NewUnspecifiedContentTypeHandler(si, options))
+	router.POST(options.BaseURL+"/urlencoded", NewURLEncodedExampleHandler(si, options))
+	router.POST(options.BaseURL+"/with-headers", NewHeadersExampleHandler(si, options))
+	router.POST(options.BaseURL+"/with-union", NewUnionExampleHandler(si, options))
 }
 
 type BadrequestResponse struct {
@@ -394,6 +528,15 @@ func (response MultipartExample200MultipartResponse) VisitMultipartExampleRespon
 	return response(writer)
 }
 
+// NewMultipartExample200MultipartResponseBody returns the body of MultipartExample200MultipartResponse
+// writing the fields of parts as its parts, each with a Content-Disposition
+// naming it, and the Content-Type of its encoding.
+func NewMultipartExample200MultipartResponseBody(parts Example) func(writer *multipart.Writer) error {
+	return func(writer *multipart.Writer) error {
+		return writeMultipartParts(writer, "multipart/form-data", parts, []multipartPart{{Name: "value", Kind: multipartText, ContentType: "text/plain; charset=utf-8"}})
+	}
+}
+
 type MultipartExample400Response = BadrequestResponse
 
 func (response MultipartExample400Response) VisitMultipartExampleResponse(w http.ResponseWriter) error {
@@ -429,6 +572,15 @@ func (response MultipartRelatedExample200MultipartResponse) VisitMultipartRelate
 	return response(writer)
 }
 
+// NewMultipartRelatedExample200MultipartResponseBody returns the body of MultipartRelatedExample200MultipartResponse
+// writing the fields of parts as its parts, each with a Content-Disposition
+// naming it, and the Content-Type of its encoding.
+func NewMultipartRelatedExample200MultipartResponseBody(parts Example) func(writer *multipart.Writer) error {
+	return func(writer *multipart.Writer) error {
+		return writeMultipartParts(writer, "multipart/related", parts, []multipartPart{{Name: "value", Kind: multipartText, ContentType: "text/plain; charset=utf-8"}})
+	}
+}
+
 type MultipartRelatedExample400Response = BadrequestResponse
 
 func (response MultipartRelatedExample400Response) VisitMultipartRelatedExampleResponse(w http.ResponseWriter) error {
@@ -499,6 +651,15 @@ func (response MultipleRequestAndResponseTypes200ImagepngResponse) VisitMultiple
 	return err
 }
 
+// NewMultipleRequestAndResponseTypes200ImagepngResponse returns the response of body, along with its
+// length.
+func NewMultipleRequestAndResponseTypes200ImagepngResponse(body []byte) MultipleRequestAndResponseTypes200ImagepngResponse {
+	return MultipleRequestAndResponseTypes200ImagepngResponse{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+	}
+}
+
 type MultipleRequestAndResponseTypes200MultipartResponse func(writer *multipart.Writer) error
 
 func (response MultipleRequestAndResponseTypes200MultipartResponse) VisitMultipleRequestAndResponseTypesResponse(w http.ResponseWriter) error {
@@ -510,6 +671,15 @@ func (response MultipleRequestAndResponseTypes200MultipartResponse) VisitMultipl
 	return response(writer)
 }
 
+// NewMultipleRequestAndResponseTypes200MultipartResponseBody returns the body of MultipleRequestAndResponseTypes200MultipartResponse
+// writing the fields of parts as its parts, each with a Content-Disposition
+// naming it, and the Content-Type of its encoding.
+func NewMultipleRequestAndResponseTypes200MultipartResponseBody(parts Example) func(writer *multipart.Writer) error {
+	return func(writer *multipart.Writer) error {
+		return writeMultipartParts(writer, "multipart/form-data", parts, []multipartPart{{Name: "value", Kind: multipartText, ContentType: "text/plain; charset=utf-8"}})
+	}
+}
+
 type MultipleRequestAndResponseTypes200TextResponse string
 
 func (response MultipleRequestAndResponseTypes200TextResponse) VisitMultipleRequestAndResponseTypesResponse(w http.ResponseWriter) error {
@@ -614,6 +784,32 @@ func (response TextExampledefaultResponse) VisitTextExampleResponse(w http.Respo
 	return nil
 }
 
+type TypedPathParametersRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Date openapi_types.Date `json:"date"`
+	TypedPathParametersParams
+}
+
+// Params returns the query, header and cookie parameters of the request,
+// which are embedded in it.
+func (r TypedPathParametersRequestObject) Params() TypedPathParametersParams {
+	return r.TypedPathParametersParams
+}
+
+type TypedPathParametersResponseObject interface {
+	VisitTypedPathParametersResponse(w http.ResponseWriter) error
+}
+
+type TypedPathParameters200TextResponse string
+
+func (response TypedPathParameters200TextResponse) VisitTypedPathParametersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
 type UnknownExampleRequestObject struct {
 	Body io.Reader
 }
@@ -641,6 +837,15 @@ func (response UnknownExample200Videomp4Response) VisitUnknownExampleResponse(w
 	return err
 }
 
+// NewUnknownExample200Videomp4Response returns the response of body, along with its
+// length.
+func NewUnknownExample200Videomp4Response(body []byte) UnknownExample200Videomp4Response {
+	return UnknownExample200Videomp4Response{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+	}
+}
+
 type UnknownExample400Response = BadrequestResponse
 
 func (response UnknownExample400Response) VisitUnknownExampleResponse(w http.ResponseWriter) error {
@@ -686,6 +891,16 @@ func (response UnspecifiedContentType200VideoResponse) VisitUnspecifiedContentTy
 	return err
 }
 
+// NewUnspecifiedContentType200VideoResponse returns the response of body, along with its
+// length, and the content type http.DetectContentType sniffs from it.
+func NewUnspecifiedContentType200VideoResponse(body []byte) UnspecifiedContentType200VideoResponse {
+	return UnspecifiedContentType200VideoResponse{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+		ContentType:   http.DetectContentType(body),
+	}
+}
+
 type UnspecifiedContentType400Response = BadrequestResponse
 
 func (response UnspecifiedContentType400Response) VisitUnspecifiedContentTypeResponse(w http.ResponseWriter) error {
@@ -757,8 +972,14 @@ func (response URLEncodedExampledefaultResponse) VisitURLEncodedExampleResponse(
 }
 
 type HeadersExampleRequestObject struct {
-	Params HeadersExampleParams
-	Body   *HeadersExampleJSONRequestBody
+	HeadersExampleParams
+	Body *HeadersExampleJSONRequestBody
+}
+
+// Params returns the query, header and cookie parameters of the request,
+// which are embedded in it.
+func (r HeadersExampleRequestObject) Params() HeadersExampleParams {
+	return r.HeadersExampleParams
 }
 
 type HeadersExampleResponseObject interface {
@@ -883,6 +1104,9 @@ type StrictServerInterface interface {
 	// (POST /text)
 	TextExample(ctx context.Context, request TextExampleRequestObject) (TextExampleResponseObject, error)
 
+	// (GET /typed-path-parameters/{id}/{date})
+	TypedPathParameters(ctx context.Context, request TypedPathParametersRequestObject) (TypedPathParametersResponseObject, error)
+
 	// (POST /unknown)
 	UnknownExample(ctx context.Context, request UnknownExampleRequestObject) (UnknownExampleResponseObject, error)
 
@@ -915,14 +1139,18 @@ type strictHandler struct {
 func (sh *strictHandler) JSONExample(ctx *gin.Context) {
 	var request JSONExampleRequestObject
 
-	var body JSONExampleJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request.ContentLength != 0 {
+
+		var body JSONExampleJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusBadRequest)
+			ctx.Error(err)
+			return
+		}
+		request.Body = &body
 
+	}
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.JSONExample(ctx, request.(JSONExampleRequestObject))
 	}
@@ -930,6 +1158,7 @@ func (sh *strictHandler) JSONExample(ctx *gin.Context) {
 		handler = middleware(handler, "JSONExample")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "JSONExample")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -948,13 +1177,17 @@ func (sh *strictHandler) JSONExample(ctx *gin.Context) {
 func (sh *strictHandler) MultipartExample(ctx *gin.Context) {
 	var request MultipartExampleRequestObject
 
-	if reader, err := ctx.Request.MultipartReader(); err == nil {
-		request.Body = reader
-	} else {
-		ctx.Error(err)
-		return
-	}
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request.ContentLength != 0 {
+
+		if reader, err := ctx.Request.MultipartReader(); err == nil {
+			request.Body = reader
+		} else {
+			ctx.Error(err)
+			return
+		}
 
+	}
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.MultipartExample(ctx, request.(MultipartExampleRequestObject))
 	}
@@ -962,6 +1195,7 @@ func (sh *strictHandler) MultipartExample(ctx *gin.Context) {
 		handler = middleware(handler, "MultipartExample")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "MultipartExample")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -980,16 +1214,20 @@ func (sh *strictHandler) MultipartExample(ctx *gin.Context) {
 func (sh *strictHandler) MultipartRelatedExample(ctx *gin.Context) {
 	var request MultipartRelatedExampleRequestObject
 
-	if _, params, err := mime.ParseMediaType(ctx.Request.Header.Get("Content-Type")); err != nil {
-		ctx.Error(err)
-		return
-	} else if boundary := params["boundary"]; boundary == "" {
-		ctx.Error(http.ErrMissingBoundary)
-		return
-	} else {
-		request.Body = multipart.NewReader(ctx.Request.Body, boundary)
-	}
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request.ContentLength != 0 {
+
+		if _, params, err := mime.ParseMediaType(ctx.Request.Header.Get("Content-Type")); err != nil {
+			ctx.Error(err)
+			return
+		} else if boundary := params["boundary"]; boundary == "" {
+			ctx.Error(http.ErrMissingBoundary)
+			return
+		} else {
+			request.Body = multipart.NewReader(ctx.Request.Body, boundary)
+		}
 
+	}
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.MultipartRelatedExample(ctx, request.(MultipartRelatedExampleRequestObject))
 	}
@@ -997,6 +1235,7 @@ func (sh *strictHandler) MultipartRelatedExample(ctx *gin.Context) {
 		handler = middleware(handler, "MultipartRelatedExample")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "MultipartRelatedExample")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1015,49 +1254,52 @@ func (sh *strictHandler) MultipartRelatedExample(ctx *gin.Context) {
 func (sh *strictHandler) MultipleRequestAndResponseTypes(ctx *gin.Context) {
 	var request MultipleRequestAndResponseTypesRequestObject
 
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json") {
-
-		var body MultipleRequestAndResponseTypesJSONRequestBody
-		if err := ctx.ShouldBindJSON(&body); err != nil {
-			ctx.Status(http.StatusBadRequest)
-			ctx.Error(err)
-			return
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request.ContentLength != 0 {
+		if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json") {
+
+			var body MultipleRequestAndResponseTypesJSONRequestBody
+			if err := ctx.ShouldBindJSON(&body); err != nil {
+				ctx.Status(http.StatusBadRequest)
+				ctx.Error(err)
+				return
+			}
+			request.JSONBody = &body
 		}
-		request.JSONBody = &body
-	}
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/x-www-form-urlencoded") {
-		if err := ctx.Request.ParseForm(); err != nil {
-			ctx.Error(err)
-			return
+		if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/x-www-form-urlencoded") {
+			if err := ctx.Request.ParseForm(); err != nil {
+				ctx.Error(err)
+				return
+			}
+			var body MultipleRequestAndResponseTypesFormdataRequestBody
+			if err := bindForm(&body, ctx.Request.Form, nil); err != nil {
+				ctx.Error(err)
+				return
+			}
+			request.FormdataBody = &body
 		}
-		var body MultipleRequestAndResponseTypesFormdataRequestBody
-		if err := runtime.BindForm(&body, ctx.Request.Form, nil, nil); err != nil {
-			ctx.Error(err)
-			return
+		if strings.HasPrefix(ctx.GetHeader("Content-Type"), "image/png") {
+			request.Body = ctx.Request.Body
 		}
-		request.FormdataBody = &body
-	}
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "image/png") {
-		request.Body = ctx.Request.Body
-	}
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "multipart/form-data") {
-		if reader, err := ctx.Request.MultipartReader(); err == nil {
-			request.MultipartBody = reader
-		} else {
-			ctx.Error(err)
-			return
+		if strings.HasPrefix(ctx.GetHeader("Content-Type"), "multipart/form-data") {
+			if reader, err := ctx.Request.MultipartReader(); err == nil {
+				request.MultipartBody = reader
+			} else {
+				ctx.Error(err)
+				return
+			}
 		}
-	}
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "text/plain") {
-		data, err := io.ReadAll(ctx.Request.Body)
-		if err != nil {
-			ctx.Error(err)
-			return
+		if strings.HasPrefix(ctx.GetHeader("Content-Type"), "text/plain") {
+			data, err := io.ReadAll(ctx.Request.Body)
+			if err != nil {
+				ctx.Error(err)
+				return
+			}
+			body := MultipleRequestAndResponseTypesTextRequestBody(data)
+			request.TextBody = &body
 		}
-		body := MultipleRequestAndResponseTypesTextRequestBody(data)
-		request.TextBody = &body
-	}
 
+	}
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.MultipleRequestAndResponseTypes(ctx, request.(MultipleRequestAndResponseTypesRequestObject))
 	}
@@ -1065,6 +1307,7 @@ func (sh *strictHandler) MultipleRequestAndResponseTypes(ctx *gin.Context) {
 		handler = middleware(handler, "MultipleRequestAndResponseTypes")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "MultipleRequestAndResponseTypes")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1092,6 +1335,7 @@ func (sh *strictHandler) ReservedGoKeywordParameters(ctx *gin.Context, pType str
 		handler = middleware(handler, "ReservedGoKeywordParameters")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "ReservedGoKeywordParameters")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1110,14 +1354,18 @@ func (sh *strictHandler) ReservedGoKeywordParameters(ctx *gin.Context, pType str
 func (sh *strictHandler) ReusableResponses(ctx *gin.Context) {
 	var request ReusableResponsesRequestObject
 
-	var body ReusableResponsesJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request.ContentLength != 0 {
 
+		var body ReusableResponsesJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusBadRequest)
+			ctx.Error(err)
+			return
+		}
+		request.Body = &body
+
+	}
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.ReusableResponses(ctx, request.(ReusableResponsesRequestObject))
 	}
@@ -1125,6 +1373,7 @@ func (sh *strictHandler) ReusableResponses(ctx *gin.Context) {
 		handler = middleware(handler, "ReusableResponses")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "ReusableResponses")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1143,14 +1392,18 @@ func (sh *strictHandler) ReusableResponses(ctx *gin.Context) {
 func (sh *strictHandler) TextExample(ctx *gin.Context) {
 	var request TextExampleRequestObject
 
-	data, err := io.ReadAll(ctx.Request.Body)
-	if err != nil {
-		ctx.Error(err)
-		return
-	}
-	body := TextExampleTextRequestBody(data)
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request.ContentLength != 0 {
+
+		data, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			ctx.Error(err)
+			return
+		}
+		body := TextExampleTextRequestBody(data)
+		request.Body = &body
 
+	}
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.TextExample(ctx, request.(TextExampleRequestObject))
 	}
@@ -1158,6 +1411,7 @@ func (sh *strictHandler) TextExample(ctx *gin.Context) {
 		handler = middleware(handler, "TextExample")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "TextExample")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1172,12 +1426,46 @@ func (sh *strictHandler) TextExample(ctx *gin.Context) {
 	}
 }
 
+// TypedPathParameters operation middleware
+func (sh *strictHandler) TypedPathParameters(ctx *gin.Context, id openapi_types.UUID, date openapi_types.Date, params TypedPathParametersParams) {
+	var request TypedPathParametersRequestObject
+
+	request.Id = id
+	request.Date = date
+	request.TypedPathParametersParams = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TypedPathParameters(ctx, request.(TypedPathParametersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TypedPathParameters")
+	}
+
+	ctx.Set(StrictOperationIdContextKey, "TypedPathParameters")
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(TypedPathParametersResponseObject); ok {
+		if err := validResponse.VisitTypedPathParametersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // UnknownExample operation middleware
 func (sh *strictHandler) UnknownExample(ctx *gin.Context) {
 	var request UnknownExampleRequestObject
 
-	request.Body = ctx.Request.Body
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request.ContentLength != 0 {
+
+		request.Body = ctx.Request.Body
 
+	}
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.UnknownExample(ctx, request.(UnknownExampleRequestObject))
 	}
@@ -1185,6 +1473,7 @@ func (sh *strictHandler) UnknownExample(ctx *gin.Context) {
 		handler = middleware(handler, "UnknownExample")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "UnknownExample")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1204,9 +1493,12 @@ func (sh *strictHandler) UnspecifiedContentType(ctx *gin.Context) {
 	var request UnspecifiedContentTypeRequestObject
 
 	request.ContentType = ctx.ContentType()
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request.ContentLength != 0 {
 
-	request.Body = ctx.Request.Body
+		request.Body = ctx.Request.Body
 
+	}
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.UnspecifiedContentType(ctx, request.(UnspecifiedContentTypeRequestObject))
 	}
@@ -1214,6 +1506,7 @@ func (sh *strictHandler) UnspecifiedContentType(ctx *gin.Context) {
 		handler = middleware(handler, "UnspecifiedContentType")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "UnspecifiedContentType")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1232,17 +1525,21 @@ func (sh *strictHandler) UnspecifiedContentType(ctx *gin.Context) {
 func (sh *strictHandler) URLEncodedExample(ctx *gin.Context) {
 	var request URLEncodedExampleRequestObject
 
-	if err := ctx.Request.ParseForm(); err != nil {
-		ctx.Error(err)
-		return
-	}
-	var body URLEncodedExampleFormdataRequestBody
-	if err := runtime.BindForm(&body, ctx.Request.Form, nil, nil); err != nil {
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request.ContentLength != 0 {
 
+		if err := ctx.Request.ParseForm(); err != nil {
+			ctx.Error(err)
+			return
+		}
+		var body URLEncodedExampleFormdataRequestBody
+		if err := bindForm(&body, ctx.Request.Form, nil); err != nil {
+			ctx.Error(err)
+			return
+		}
+		request.Body = &body
+
+	}
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.URLEncodedExample(ctx, request.(URLEncodedExampleRequestObject))
 	}
@@ -1250,6 +1547,7 @@ func (sh *strictHandler) URLEncodedExample(ctx *gin.Context) {
 		handler = middleware(handler, "URLEncodedExample")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "URLEncodedExample")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1268,16 +1566,19 @@ func (sh *strictHandler) URLEncodedExample(ctx *gin.Context) {
 func (sh *strictHandler) HeadersExample(ctx *gin.Context, params HeadersExampleParams) {
 	var request HeadersExampleRequestObject
 
-	request.Params = params
+	request.HeadersExampleParams = params
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request.ContentLength != 0 {
 
-	var body HeadersExampleJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+		var body HeadersExampleJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusBadRequest)
+			ctx.Error(err)
+			return
+		}
+		request.Body = &body
 
+	}
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.HeadersExample(ctx, request.(HeadersExampleRequestObject))
 	}
@@ -1285,6 +1586,7 @@ func (sh *strictHandler) HeadersExample(ctx *gin.Context, params HeadersExampleP
 		handler = middleware(handler, "HeadersExample")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "HeadersExample")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1303,14 +1605,18 @@ func (sh *strictHandler) HeadersExample(ctx *gin.Context, params HeadersExampleP
 func (sh *strictHandler) UnionExample(ctx *gin.Context) {
 	var request UnionExampleRequestObject
 
-	var body UnionExampleJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request.ContentLength != 0 {
 
+		var body UnionExampleJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusBadRequest)
+			ctx.Error(err)
+			return
+		}
+		request.Body = &body
+
+	}
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.UnionExample(ctx, request.(UnionExampleRequestObject))
 	}
@@ -1318,6 +1624,7 @@ func (sh *strictHandler) UnionExample(ctx *gin.Context) {
 		handler = middleware(handler, "UnionExample")
 	}
 
+	ctx.Set(StrictOperationIdContextKey, "UnionExample")
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1332,27 +1639,456 @@ func (sh *strictHandler) UnionExample(ctx *gin.Context) {
 	}
 }
 
+// maxFormIndex is the largest index of an array bindForm binds, eg,
+// objects[1000][role]. The runtime allocates an array as long as the largest
+// index of a form, whichever indices it has, so a larger one is an error.
+const maxFormIndex = 1000
+
+// bindForm binds the fields of a form, and the files of a multipart one, to
+// dest, as runtime.BindForm does, once the keys of the fields are checked:
+// bracketed segments may follow their name, eg, objects[0][role], and the
+// indices of arrays can't be larger than maxFormIndex. The runtime panics on
+// some malformed keys, which are errors instead. The indices a form leaves out
+// are zero values, eg, objects[1][role]=admin binds two objects.
+func bindForm(dest interface{}, form map[string][]string, files map[string][]*multipart.FileHeader) error {
+	checkKey := func(key string) error {
+		start := strings.IndexByte(key, '[')
+		if start < 0 {
+			return nil
+		}
+		for rest := key[start:]; rest != ""; {
+			end := strings.IndexByte(rest, ']')
+			if rest[0] != '[' || end < 0 || strings.IndexByte(rest[1:end], '[') >= 0 {
+				return fmt.Errorf("invalid form field '%s'", key)
+			}
+			if segment := rest[1:end]; segment != "" && strings.Trim(segment, "0123456789") == "" {
+				if index, err := strconv.Atoi(segment); err != nil || index > maxFormIndex {
+					return fmt.Errorf("index %s of form field '%s' is out of range", segment, key)
+				}
+			}
+			rest = rest[end+1:]
+		}
+		return nil
+	}
+	for key := range form {
+		if err := checkKey(key); err != nil {
+			return err
+		}
+	}
+	for key := range files {
+		if err := checkKey(key); err != nil {
+			return err
+		}
+	}
+
+	return runtime.BindForm(dest, form, files, nil)
+}
+
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// JSONExampleHandler handles the JSONExample operation with its typed request and response objects.
+type JSONExampleHandler func(ctx context.Context, request JSONExampleRequestObject) (JSONExampleResponseObject, error)
+
+// MultipartExampleHandler handles the MultipartExample operation with its typed request and response objects.
+type MultipartExampleHandler func(ctx context.Context, request MultipartExampleRequestObject) (MultipartExampleResponseObject, error)
+
+// MultipartRelatedExampleHandler handles the MultipartRelatedExample operation with its typed request and response objects.
+type MultipartRelatedExampleHandler func(ctx context.Context, request MultipartRelatedExampleRequestObject) (MultipartRelatedExampleResponseObject, error)
+
+// MultipleRequestAndResponseTypesHandler handles the MultipleRequestAndResponseTypes operation with its typed request and response objects.
+type MultipleRequestAndResponseTypesHandler func(ctx context.Context, request MultipleRequestAndResponseTypesRequestObject) (MultipleRequestAndResponseTypesResponseObject, error)
+
+// ReservedGoKeywordParametersHandler handles the ReservedGoKeywordParameters operation with its typed request and response objects.
+type ReservedGoKeywordParametersHandler func(ctx context.Context, request ReservedGoKeywordParametersRequestObject) (ReservedGoKeywordParametersResponseObject, error)
+
+// ReusableResponsesHandler handles the ReusableResponses operation with its typed request and response objects.
+type ReusableResponsesHandler func(ctx context.Context, request ReusableResponsesRequestObject) (ReusableResponsesResponseObject, error)
+
+// TextExampleHandler handles the TextExample operation with its typed request and response objects.
+type TextExampleHandler func(ctx context.Context, request TextExampleRequestObject) (TextExampleResponseObject, error)
+
+// TypedPathParametersHandler handles the TypedPathParameters operation with its typed request and response objects.
+type TypedPathParametersHandler func(ctx context.Context, request TypedPathParametersRequestObject) (TypedPathParametersResponseObject, error)
+
+// UnknownExampleHandler handles the UnknownExample operation with its typed request and response objects.
+type UnknownExampleHandler func(ctx context.Context, request UnknownExampleRequestObject) (UnknownExampleResponseObject, error)
+
+// UnspecifiedContentTypeHandler handles the UnspecifiedContentType operation with its typed request and response objects.
+type UnspecifiedContentTypeHandler func(ctx context.Context, request UnspecifiedContentTypeRequestObject) (UnspecifiedContentTypeResponseObject, error)
+
+// URLEncodedExampleHandler handles the URLEncodedExample operation with its typed request and response objects.
+type URLEncodedExampleHandler func(ctx context.Context, request URLEncodedExampleRequestObject) (URLEncodedExampleResponseObject, error)
+
+// HeadersExampleHandler handles the HeadersExample operation with its typed request and response objects.
+type HeadersExampleHandler func(ctx context.Context, request HeadersExampleRequestObject) (HeadersExampleResponseObject, error)
+
+// UnionExampleHandler handles the UnionExample operation with its typed request and response objects.
+type UnionExampleHandler func(ctx context.Context, request UnionExampleRequestObject) (UnionExampleResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnJSONExample                     func(next JSONExampleHandler) JSONExampleHandler
+	OnMultipartExample                func(next MultipartExampleHandler) MultipartExampleHandler
+	OnMultipartRelatedExample         func(next MultipartRelatedExampleHandler) MultipartRelatedExampleHandler
+	OnMultipleRequestAndResponseTypes func(next MultipleRequestAndResponseTypesHandler) MultipleRequestAndResponseTypesHandler
+	OnReservedGoKeywordParameters     func(next ReservedGoKeywordParametersHandler) ReservedGoKeywordParametersHandler
+	OnReusableResponses               func(next ReusableResponsesHandler) ReusableResponsesHandler
+	OnTextExample                     func(next TextExampleHandler) TextExampleHandler
+	OnTypedPathParameters             func(next TypedPathParametersHandler) TypedPathParametersHandler
+	OnUnknownExample                  func(next UnknownExampleHandler) UnknownExampleHandler
+	OnUnspecifiedContentType          func(next UnspecifiedContentTypeHandler) UnspecifiedContentTypeHandler
+	OnURLEncodedExample               func(next URLEncodedExampleHandler) URLEncodedExampleHandler
+	OnHeadersExample                  func(next HeadersExampleHandler) HeadersExampleHandler
+	OnUnionExample                    func(next UnionExampleHandler) UnionExampleHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) JSONExample(ctx context.Context, request JSONExampleRequestObject) (JSONExampleResponseObject, error) {
+	handler := JSONExampleHandler(s.ssi.JSONExample)
+	if s.middlewares.OnJSONExample != nil {
+		handler = s.middlewares.OnJSONExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) MultipartExample(ctx context.Context, request MultipartExampleRequestObject) (MultipartExampleResponseObject, error) {
+	handler := MultipartExampleHandler(s.ssi.MultipartExample)
+	if s.middlewares.OnMultipartExample != nil {
+		handler = s.middlewares.OnMultipartExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) MultipartRelatedExample(ctx context.Context, request MultipartRelatedExampleRequestObject) (MultipartRelatedExampleResponseObject, error) {
+	handler := MultipartRelatedExampleHandler(s.ssi.MultipartRelatedExample)
+	if s.middlewares.OnMultipartRelatedExample != nil {
+		handler = s.middlewares.OnMultipartRelatedExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) MultipleRequestAndResponseTypes(ctx context.Context, request MultipleRequestAndResponseTypesRequestObject) (MultipleRequestAndResponseTypesResponseObject, error) {
+	handler := MultipleRequestAndResponseTypesHandler(s.ssi.MultipleRequestAndResponseTypes)
+	if s.middlewares.OnMultipleRequestAndResponseTypes != nil {
+		handler = s.middlewares.OnMultipleRequestAndResponseTypes(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) ReservedGoKeywordParameters(ctx context.Context, request ReservedGoKeywordParametersRequestObject) (ReservedGoKeywordParametersResponseObject, error) {
+	handler := ReservedGoKeywordParametersHandler(s.ssi.ReservedGoKeywordParameters)
+	if s.middlewares.OnReservedGoKeywordParameters != nil {
+		handler = s.middlewares.OnReservedGoKeywordParameters(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) ReusableResponses(ctx context.Context, request ReusableResponsesRequestObject) (ReusableResponsesResponseObject, error) {
+	handler := ReusableResponsesHandler(s.ssi.ReusableResponses)
+	if s.middlewares.OnReusableResponses != nil {
+		handler = s.middlewares.OnReusableResponses(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) TextExample(ctx context.Context, request TextExampleRequestObject) (TextExampleResponseObject, error) {
+	handler := TextExampleHandler(s.ssi.TextExample)
+	if s.middlewares.OnTextExample != nil {
+		handler = s.middlewares.OnTextExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) TypedPathParameters(ctx context.Context, request TypedPathParametersRequestObject) (TypedPathParametersResponseObject, error) {
+	handler := TypedPathParametersHandler(s.ssi.TypedPathParameters)
+	if s.middlewares.OnTypedPathParameters != nil {
+		handler = s.middlewares.OnTypedPathParameters(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) UnknownExample(ctx context.Context, request UnknownExampleRequestObject) (UnknownExampleResponseObject, error) {
+	handler := UnknownExampleHandler(s.ssi.UnknownExample)
+	if s.middlewares.OnUnknownExample != nil {
+		handler = s.middlewares.OnUnknownExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) UnspecifiedContentType(ctx context.Context, request UnspecifiedContentTypeRequestObject) (UnspecifiedContentTypeResponseObject, error) {
+	handler := UnspecifiedContentTypeHandler(s.ssi.UnspecifiedContentType)
+	if s.middlewares.OnUnspecifiedContentType != nil {
+		handler = s.middlewares.OnUnspecifiedContentType(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) URLEncodedExample(ctx context.Context, request URLEncodedExampleRequestObject) (URLEncodedExampleResponseObject, error) {
+	handler := URLEncodedExampleHandler(s.ssi.URLEncodedExample)
+	if s.middlewares.OnURLEncodedExample != nil {
+		handler = s.middlewares.OnURLEncodedExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) HeadersExample(ctx context.Context, request HeadersExampleRequestObject) (HeadersExampleResponseObject, error) {
+	handler := HeadersExampleHandler(s.ssi.HeadersExample)
+	if s.middlewares.OnHeadersExample != nil {
+		handler = s.middlewares.OnHeadersExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) UnionExample(ctx context.Context, request UnionExampleRequestObject) (UnionExampleResponseObject, error) {
+	handler := UnionExampleHandler(s.ssi.UnionExample)
+	if s.middlewares.OnUnionExample != nil {
+		handler = s.middlewares.OnUnionExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+// multipartPartKind is how the data of a part of a multipart body whose
+// schema is an object is read into its field, and written from it.
+type multipartPartKind int
+
+const (
+	// multipartJSON parts are the JSON of their field, eg, an object, or a
+	// number, which is written as it is in form data.
+	multipartJSON multipartPartKind = iota
+	// multipartText parts are the text of a string field, as it is.
+	multipartText
+	// multipartBinary parts are files, whose fields are set to their data and
+	// their filename, when they're of a type which has one, such as
+	// openapi_types.File, or to their data, when they're []byte.
+	multipartBinary
+)
+
+// multipartPart describes a part of a multipart body whose schema is an
+// object, which is the property of the same name.
+type multipartPart struct {
+	// Name is the name of the part, which is that of its property, and the
+	// JSON name of its field.
+	Name string
+	Kind multipartPartKind
+	// ContentType is the Content-Type the part is written with. When it's
+	// empty, that's application/json, text/plain or application/octet-stream,
+	// as its kind is multipartJSON, multipartText or multipartBinary.
+	ContentType string
+	Required    bool
+}
+
+// MissingMultipartPartError is the error of a multipart body without a part
+// which its schema requires.
+type MissingMultipartPartError struct {
+	Name string
+}
+
+func (e *MissingMultipartPartError) Error() string {
+	return fmt.Sprintf("multipart body is missing the required part %q", e.Name)
+}
+
+// multipartFile is a field holding the data and the filename of a
+// multipartBinary part, such as openapi_types.File.
+type multipartFile interface {
+	InitFromBytes(data []byte, filename string)
+	Reader() (io.ReadCloser, error)
+	Filename() string
+}
+
+// writeMultipartParts writes v, a struct or a pointer to one, as the parts of
+// writer, the writer of a multipart body of mediaType, eg, multipart/mixed.
+// Each part has a Content-Disposition, of form-data for multipart/form-data
+// and inline otherwise, naming it, and the filename of a multipartBinary part
+// which has one, and the Content-Type of its multipartPart. The parts are
+// written in their order, and those whose field is empty or nil and omitted
+// from its JSON are left out, unless they're required, which is a
+// *MissingMultipartPartError.
+func writeMultipartParts(writer *multipart.Writer, mediaType string, v interface{}, parts []multipartPart) error {
+	fields, err := multipartFields(v)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	disposition := "inline"
+	if mediaType == "multipart/form-data" {
+		disposition = "form-data"
+	}
+	for _, part := range parts {
+		params := map[string]string{"name": part.Name}
+		var body io.Reader
+		if f, ok := multipartFileOf(fields[part.Name]); ok && part.Kind == multipartBinary {
+			reader, err := f.Reader()
+			if err != nil {
+				return fmt.Errorf("error writing part %q: %w", part.Name, err)
+			}
+			defer reader.Close()
+			if filename := f.Filename(); filename != "" {
+				params["filename"] = filename
+			}
+			body = reader
+		} else if value, ok := values[part.Name]; ok && string(value) != "null" {
+			content, err := multipartPartContent(part, value)
+			if err != nil {
+				return fmt.Errorf("error writing part %q: %w", part.Name, err)
+			}
+			body = bytes.NewReader(content)
+		} else if part.Required {
+			return &MissingMultipartPartError{Name: part.Name}
+		} else {
+			continue
+		}
+
+		contentType := part.ContentType
+		switch {
+		case contentType != "":
+		case part.Kind == multipartText:
+			contentType = "text/plain; charset=utf-8"
+		case part.Kind == multipartBinary:
+			contentType = "application/octet-stream"
+		default:
+			contentType = "application/json"
+		}
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", mime.FormatMediaType(disposition, params))
+		header.Set("Content-Type", contentType)
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(w, body); err != nil {
+			return fmt.Errorf("error writing part %q: %w", part.Name, err)
+		}
+	}
+	return nil
+}
+
+// multipartPartContent returns the content of part, whose field's JSON is
+// value.
+func multipartPartContent(part multipartPart, value json.RawMessage) ([]byte, error) {
+	switch part.Kind {
+	case multipartBinary:
+		var data []byte
+		if err := json.Unmarshal(value, &data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	case multipartText:
+		var text string
+		if err := json.Unmarshal(value, &text); err != nil {
+			// The field isn't a string in JSON, eg, a number of a type
+			// the schema's string maps to, whose JSON is its text.
+			return value, nil
+		}
+		return []byte(text), nil
+	}
+	return value, nil
+}
+
+// multipartFileOf returns the file field is, or points to, and whether it's a
+// file.
+func multipartFileOf(field reflect.Value) (multipartFile, bool) {
+	if !field.IsValid() || (field.Kind() == reflect.Pointer && field.IsNil()) {
+		return nil, false
+	}
+	if f, ok := field.Interface().(multipartFile); ok {
+		return f, true
+	}
+	if field.CanAddr() {
+		if f, ok := field.Addr().Interface().(multipartFile); ok {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// multipartFields returns the fields of the struct v is, or points to, by their
+// JSON name, which are addressable.
+func multipartFields(v interface{}) (map[string]reflect.Value, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("multipart parts must be the fields of a struct, not of %T", v)
+	}
+	if !value.CanAddr() {
+		// The file fields are only files through their address.
+		addressable := reflect.New(value.Type()).Elem()
+		addressable.Set(value)
+		value = addressable
+	}
+	fields := make(map[string]reflect.Value, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		fields[name] = value.Field(i)
+	}
+	return fields, nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYS3PbNhD+Kxi0p5QUZccn3hpPJm3T1h3ZPnV8gIilhIQE0MVStEaj/94BQb0sWpUS",
-	"PTqZ3PhY7C6+b3ex2BnPTGmNBk2OpzOO4KzRDpqXoZAI/1TgyL9JcBkqS8ponvJ3Qg7af/OII1RODAtY",
-	"LPfymdEEulkqrC1UJvzS5JPz62fcZWMohX/6ESHnKf8hWbmShL8ugWdR2gL4fD6PXnhw95FHfAxCAjbe",
-	"hserTd00tcBT7giVHnGvJIhdd4opTTAC9Na8aOuEF1j4kc64RWMBSQWMJqKooNtS+8UMP0FGYQdK52Yb",
-	"y1ujSSjtmFR5DgiaWAse8zocc5W1BgkkG06Zt5ARc4ATQB5xUuQd4/fr31nrsOMRnwC6YOiq1+/1PV/G",
-	"ghZW8ZS/bT5F3AoaNxtaEmRNF++/3d/9yZRjoiJTClKZKIopKwW6sShAMqXJeBerjFyPN5awIf5X2a5+",
-	"30Lpo6YJoHdGTk8RME1croXzdb9/pricR/wmGOvSsXQqWUuwRk0uqqID80f9WZtaM0A02O4sKauClBVI",
-	"61xtov3HQmQfyJf6ktxgGUtB4kSoH8vSpYGPEQpBIPcgYBAkD+NhTf1JWfgaOxfloK3HnXXqfmxqx8am",
-	"ZmSYBFGwWtGYLRa+KLBKM8Gc0qMC2MKpqJPMAtpj72ctB+1eHryOk9ezaEPLc1zXddwkUIUF6MzIL6Mw",
-	"4qoUI0isHm0u97oF8ZQPp+RDdvuAO1IiR5zgmRJbCKV3n95nKunfkT5aYod0RWi6EhmPTPwZprVBGVuB",
-	"ogQCdMnMW597xSPoSOW/lpIsE5oNgWlRgmQiJ0D2wbBWpdtK2UFr94P5GERWqpqWZ/mS/j3jHpKmDeIR",
-	"9wZ4GlAJea3Qk05YQbQDtqf/jM+vImCBZmi24w1T3WVwUaKW0CHkzpfELuY68AuWBmsSl2nadkfc1vXj",
-	"HGeQZ/L1o/8Bnvdqu45Y+s6d24cCVoWPr2PWrtoHti+spHugOFESTFLamwM1XwxUZyFTuQIZt7uIg2+v",
-	"lYRbozME2myB/JVOG2JLZf6mSWNgAYGIOcNqYGXliFnhHFPUVJFChduqhK3i8bjy7DZYeliV012svjkR",
-	"p28uxehN/+rwJW9PHDcbrcwr+Tj4/X2QOfTOfrSe6cCO73h2L5TO/pISrw21ulP4lyCwOtMzUBPfEWnJ",
-	"EKhCDZJNlFgMYrZys1WworWrFwpurLqhxYDtkIYo2qnrmke7hnBP3/CI6JSjy3PFaaXVrlHho//N2h76",
-	"5dmgjP6fDgJFQYBakJrAT8e5QW5rMRru8ibTXrAc7Wnh6duLqnnEw+w6lKAKC18niGyaJGHm3XO1GI0A",
-	"e8okwiqPwr8BAAD//4h9qqfAGAAA",
+	"H4sIAAAAAAAC/+xZzZLbNgx+FQ7bUypZzmZPujU7mbRN2+14d0+dHGgRsphIJANC9no8fvcORflvLbt2",
+	"4p9Opre1BH6AAHwAiJ3xzFTWaNDkeDrjCM4a7aD5MRQS4UsNjvwvCS5DZUkZzVP+VshB+24ecYTaiWEJ",
+	"i+NePjOaQDdHhbWlyoQ/mnxy/vyMu6yASvi/fkTIecp/SFamJOGtS+BZVLYEPp/PoxcW3H/gES9ASMDG",
+	"2vDn601smlrgKXeESo+4BwliN51iShOMAL02L9oa4QUWdqQzbtFYQFLBR2NR1tCtqX1ihp8go/AFSudm",
+	"25d3RpNQ2jGp8hwQNLHWecxjOOZqaw0SSDacMq8hI+YAx4A84qTIG8Yf1p+z1mDHIz4GdEHR616/1/fx",
+	"Mha0sIqn/E3zKOJWUNF80DJA1nTF/beH+z+ZckzUZCpBKhNlOWWVQFeIEiRTmow3sc7I9XijCZvA/yrb",
+	"0+9aV/qsaRLorZHTcyRMk5dr6XzT718oL+cRv+33d2EsjUrWCNbA5KIuO3z+pD9rM9EMEA22X5ZUdUnK",
+	"CqT1WG16+4+FyCEuX+IlucEqloLEmbx+Kk3XdnyMUAoCeUAABkHyuDiswZ81Ct+i56oxKGHd9ZvHHgoz",
+	"cawwE0aGSRAlmygq2OLgiwKrNBPMKT0qgS2MijqDWULb9n7WctB+y6PHOHs9izZQnuPJZBI3BKqxBJ0Z",
+	"+XUhjLiqxAgSq0ebxz22IJ7y4ZSARx0N7kREjjjBMyW2FErv794XKun/e/pkxA50RWimEhmPTPwZphOD",
+	"MrYCRQUE6JKZ1z73wCPooPJfS0mWCc2GwLSoQDKREyB7b1gL6bYoO2j1vjcfgsgKqhl5lj/Sv2fcu6QZ",
+	"g3jEvQKeBq8EXiv0QSesIdrjto//mp/fFICFN8OwHW+o6i6DixK1dB1C7nxJ7Ipch/+CpsGaxHWGtv0Z",
+	"t3X9uEQP8pHc3fof4fmgseuEpe/S3D7aYVMLnvdUbJBfyXkyk4L2VgAq2OoMEwhsaGotfSZTAQqbPi5Z",
+	"cxtr2jmMAaeLy1BeirHBrfT2fVt67CPLgpJ7i8KynNd1I7lVzjtBvQcOg20ld8F+qQGnK9xSVYp4tO+y",
+	"e5GqVYeU2M2YNmcOIc1X9tEDODRWEkxS2dsjka9EqVo7C5nKFci4/Yo42LarIdwZnSHQ5gDsL/TaEFuC",
+	"+T0DFcCCByLmDJsAq2pHzArnmKKmh5Qq7CokbHHraWXZXdD0OLWHRPXVmWL66loRve2/Pv7ImzPnzcYg",
+	"u4OPg9/fBZljNzYnm5iPnPdPp/dKdPZX1HhtpdlN4V+CwGqiy0CN/TysJUOgGrXvhEos1nBb3GwBVmHt",
+	"annBjFUfWaxXjxmHo71YNwd0pe90QXjOxfWl8rTWat+i+Mm/Zu0N6mVvUEb/R9fAoiRALUiN4afT7A+2",
+	"UYyG+7xh2osoRwdq+Pj9ZdU84mFYDyWoxpKnvCCyaZKE/3j03ESMRoA9ZRJhlffCPwMAbejYZr4aAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
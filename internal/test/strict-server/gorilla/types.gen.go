@@ -17,6 +17,11 @@ type MultipleRequestAndResponseTypesTextBody = string
 // TextExampleTextBody defines parameters for TextExample.
 type TextExampleTextBody = string
 
+// TypedPathParametersParams defines parameters for TypedPathParameters.
+type TypedPathParametersParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // HeadersExampleParams defines parameters for HeadersExample.
 type HeadersExampleParams struct {
 	Header1 string `json:"header1"`
@@ -14,14 +14,18 @@ import (
 	"mime"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"path"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/kataras/iris/v12"
 	"github.com/oapi-codegen/runtime"
 	strictiris "github.com/oapi-codegen/runtime/strictmiddleware/iris"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // ServerInterface represents all server handlers.
@@ -48,6 +52,9 @@ type ServerInterface interface {
 	// (POST /text)
 	TextExample(ctx iris.Context)
 
+	// (GET /typed-path-parameters/{id}/{date})
+	TypedPathParameters(ctx iris.Context, id openapi_types.UUID, date openapi_types.Date, params TypedPathParametersParams)
+
 	// (POST /unknown)
 	UnknownExample(ctx iris.Context)
 
@@ -132,6 +139,46 @@ func (w *ServerInterfaceWrapper) TextExample(ctx iris.Context) {
 	w.Handler.TextExample(ctx)
 }
 
+// TypedPathParameters converts iris context to params.
+func (w *ServerInterfaceWrapper) TypedPathParameters(ctx iris.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Params().Get("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.Writef("Invalid format for parameter id: %s", err)
+		return
+	}
+
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	err = runtime.BindStyledParameterWithOptions("simple", "date", ctx.Params().Get("date"), &date, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.Writef("Invalid format for parameter date: %s", err)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TypedPathParametersParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.Request().URL.Query(), &params.Limit)
+	if err != nil {
+		ctx.StatusCode(http.StatusBadRequest)
+		ctx.Writef("Invalid format for parameter limit: %s", err)
+		return
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	w.Handler.TypedPathParameters(ctx, id, date, params)
+}
+
 // UnknownExample converts iris context to params.
 func (w *ServerInterfaceWrapper) UnknownExample(ctx iris.Context) {
 
@@ -222,6 +269,123 @@ type IrisServerOptions struct {
 	Middlewares []MiddlewareFunc
 }
 
+// NewJSONExampleHandler returns the handler RegisterHandlersWithOptions
+// routes the JSONExample operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewJSONExampleHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.JSONExample
+}
+
+// NewMultipartExampleHandler returns the handler RegisterHandlersWithOptions
+// routes the MultipartExample operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewMultipartExampleHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.MultipartExample
+}
+
+// NewMultipartRelatedExampleHandler returns the handler RegisterHandlersWithOptions
+// routes the MultipartRelatedExample operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewMultipartRelatedExampleHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.MultipartRelatedExample
+}
+
+// NewMultipleRequestAndResponseTypesHandler returns the handler RegisterHandlersWithOptions
+// routes the MultipleRequestAndResponseTypes operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewMultipleRequestAndResponseTypesHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.MultipleRequestAndResponseTypes
+}
+
+// NewReservedGoKeywordParametersHandler returns the handler RegisterHandlersWithOptions
+// routes the ReservedGoKeywordParameters operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewReservedGoKeywordParametersHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.ReservedGoKeywordParameters
+}
+
+// NewReusableResponsesHandler returns the handler RegisterHandlersWithOptions
+// routes the ReusableResponses operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewReusableResponsesHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.ReusableResponses
+}
+
+// NewTextExampleHandler returns the handler RegisterHandlersWithOptions
+// routes the TextExample operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewTextExampleHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.TextExample
+}
+
+// NewTypedPathParametersHandler returns the handler RegisterHandlersWithOptions
+// routes the TypedPathParameters operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewTypedPathParametersHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.TypedPathParameters
+}
+
+// NewUnknownExampleHandler returns the handler RegisterHandlersWithOptions
+// routes the UnknownExample operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewUnknownExampleHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.UnknownExample
+}
+
+// NewUnspecifiedContentTypeHandler returns the handler RegisterHandlersWithOptions
+// routes the UnspecifiedContentType operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewUnspecifiedContentTypeHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.UnspecifiedContentType
+}
+
+// NewURLEncodedExampleHandler returns the handler RegisterHandlersWithOptions
+// routes the URLEncodedExample operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewURLEncodedExampleHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.URLEncodedExample
+}
+
+// NewHeadersExampleHandler returns the handler RegisterHandlersWithOptions
+// routes the HeadersExample operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewHeadersExampleHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.HeadersExample
+}
+
+// NewUnionExampleHandler returns the handler RegisterHandlersWithOptions
+// routes the UnionExample operation to, so that it can be mounted on its
+// own. The middlewares of the options are those of the router, so they
+// aren't part of it.
+func NewUnionExampleHandler(si ServerInterface) iris.Handler {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.UnionExample
+}
+
 // RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
 func RegisterHandlers(router *iris.Application, si ServerInterface) {
 	RegisterHandlersWithOptions(router, si, IrisServerOptions{})
@@ -230,22 +394,23 @@ func RegisterHandlers(router *iris.Application, si ServerInterface) {
 // RegisterHandlersWithOptions creates http.Handler with additional options
 func RegisterHandlersWithOptions(router *iris.Application, si ServerInterface, options IrisServerOptions) {
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	for _, m := range options.Middlewares {
+		router.Use(iris.Handler(m))
 	}
 
-	router.Post(options.BaseURL+"/json", wrapper.JSONExample)
-	router.Post(options.BaseURL+"/multipart", wrapper.MultipartExample)
-	router.Post(options.BaseURL+"/multipart-related", wrapper.MultipartRelatedExample)
-	router.Post(options.BaseURL+"/multiple", wrapper.MultipleRequestAndResponseTypes)
-	router.Get(options.BaseURL+"/reserved-go-keyword-parameters/:type", wrapper.ReservedGoKeywordParameters)
-	router.Post(options.BaseURL+"/reusable-responses", wrapper.ReusableResponses)
-	router.Post(options.BaseURL+"/text", wrapper.TextExample)
-	router.Post(options.BaseURL+"/unknown", wrapper.UnknownExample)
-	router.Post(options.BaseURL+"/unspecified-content-type", wrapper.UnspecifiedContentType)
-	router.Post(options.BaseURL+"/urlencoded", wrapper.URLEncodedExample)
-	router.Post(options.BaseURL+"/with-headers", wrapper.HeadersExample)
-	router.Post(options.BaseURL+"/with-union", wrapper.UnionExample)
+	router.Post(options.BaseURL+"/json", NewJSONExampleHandler(si))
+	router.Post(options.BaseURL+"/multipart", NewMultipartExampleHandler(si))
+	router.Post(options.BaseURL+"/multipart-related", NewMultipartRelatedExampleHandler(si))
+	router.Post(options.BaseURL+"/multiple", NewMultipleRequestAndResponseTypesHandler(si))
+	router.Get(options.BaseURL+"/reserved-go-keyword-parameters/:type", NewReservedGoKeywordParametersHandler(si))
+	router.Post(options.BaseURL+"/reusable-responses", NewReusableResponsesHandler(si))
+	router.Post(options.BaseURL+"/text", NewTextExampleHandler(si))
+	router.Get(options.BaseURL+"/typed-path-parameters/:id/:date", NewTypedPathParametersHandler(si))
+	router.Post(options.BaseURL+"/unknown", NewUnknownExampleHandler(si))
+	router.Post(options.BaseURL+"/unspecified-content-type", NewUnspecifiedContentTypeHandler(si))
+	router.Post(options.BaseURL+"/urlencoded", NewURLEncodedExampleHandler(si))
+	router.Post(options.BaseURL+"/with-headers", NewHeadersExampleHandler(si))
+	router.Post(options.BaseURL+"/with-union", NewUnionExampleHandler(si))
 
 	router.Build()
 }
@@ -315,6 +480,15 @@ func (response MultipartExample200MultipartResponse) VisitMultipartExampleRespon
 	return response(writer)
 }
 
+// NewMultipartExample200MultipartResponseBody returns the body of MultipartExample200MultipartResponse
+// writing the fields of parts as its parts, each with a Content-Disposition
+// naming it, and the Content-Type of its encoding.
+func NewMultipartExample200MultipartResponseBody(parts Example) func(writer *multipart.Writer) error {
+	return func(writer *multipart.Writer) error {
+		return writeMultipartParts(writer, "multipart/form-data", parts, []multipartPart{{Name: "value", Kind: multipartText, ContentType: "text/plain; charset=utf-8"}})
+	}
+}
+
 type MultipartExample400Response = BadrequestResponse
 
 func (response MultipartExample400Response) VisitMultipartExampleResponse(ctx iris.Context) error {
@@ -350,6 +524,15 @@ func (response MultipartRelatedExample200MultipartResponse) VisitMultipartRelate
 	return response(writer)
 }
 
+// NewMultipartRelatedExample200MultipartResponseBody returns the body of MultipartRelatedExample200MultipartResponse
+// writing the fields of parts as its parts, each with a Content-Disposition
+// naming it, and the Content-Type of its encoding.
+func NewMultipartRelatedExample200MultipartResponseBody(parts Example) func(writer *multipart.Writer) error {
+	return func(writer *multipart.Writer) error {
+		return writeMultipartParts(writer, "multipart/related", parts, []multipartPart{{Name: "value", Kind: multipartText, ContentType: "text/plain; charset=utf-8"}})
+	}
+}
+
 type MultipartRelatedExample400Response = BadrequestResponse
 
 func (response MultipartRelatedExample400Response) VisitMultipartRelatedExampleResponse(ctx iris.Context) error {
@@ -420,6 +603,15 @@ func (response MultipleRequestAndResponseTypes200ImagepngResponse) VisitMultiple
 	return err
 }
 
+// NewMultipleRequestAndResponseTypes200ImagepngResponse returns the response of body, along with its
+// length.
+func NewMultipleRequestAndResponseTypes200ImagepngResponse(body []byte) MultipleRequestAndResponseTypes200ImagepngResponse {
+	return MultipleRequestAndResponseTypes200ImagepngResponse{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+	}
+}
+
 type MultipleRequestAndResponseTypes200MultipartResponse func(writer *multipart.Writer) error
 
 func (response MultipleRequestAndResponseTypes200MultipartResponse) VisitMultipleRequestAndResponseTypesResponse(ctx iris.Context) error {
@@ -431,6 +623,15 @@ func (response MultipleRequestAndResponseTypes200MultipartResponse) VisitMultipl
 	return response(writer)
 }
 
+// NewMultipleRequestAndResponseTypes200MultipartResponseBody returns the body of MultipleRequestAndResponseTypes200MultipartResponse
+// writing the fields of parts as its parts, each with a Content-Disposition
+// naming it, and the Content-Type of its encoding.
+func NewMultipleRequestAndResponseTypes200MultipartResponseBody(parts Example) func(writer *multipart.Writer) error {
+	return func(writer *multipart.Writer) error {
+		return writeMultipartParts(writer, "multipart/form-data", parts, []multipartPart{{Name: "value", Kind: multipartText, ContentType: "text/plain; charset=utf-8"}})
+	}
+}
+
 type MultipleRequestAndResponseTypes200TextResponse string
 
 func (response MultipleRequestAndResponseTypes200TextResponse) VisitMultipleRequestAndResponseTypesResponse(ctx iris.Context) error {
@@ -535,6 +736,32 @@ func (response TextExampledefaultResponse) VisitTextExampleResponse(ctx iris.Con
 	return nil
 }
 
+type TypedPathParametersRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Date openapi_types.Date `json:"date"`
+	TypedPathParametersParams
+}
+
+// Params returns the query, header and cookie parameters of the request,
+// which are embedded in it.
+func (r TypedPathParametersRequestObject) Params() TypedPathParametersParams {
+	return r.TypedPathParametersParams
+}
+
+type TypedPathParametersResponseObject interface {
+	VisitTypedPathParametersResponse(ctx iris.Context) error
+}
+
+type TypedPathParameters200TextResponse string
+
+func (response TypedPathParameters200TextResponse) VisitTypedPathParametersResponse(ctx iris.Context) error {
+	ctx.ResponseWriter().Header().Set("Content-Type", "text/plain")
+	ctx.StatusCode(200)
+
+	_, err := ctx.WriteString(string(response))
+	return err
+}
+
 type UnknownExampleRequestObject struct {
 	Body io.Reader
 }
@@ -562,6 +789,15 @@ func (response UnknownExample200Videomp4Response) VisitUnknownExampleResponse(ct
 	return err
 }
 
+// NewUnknownExample200Videomp4Response returns the response of body, along with its
+// length.
+func NewUnknownExample200Videomp4Response(body []byte) UnknownExample200Videomp4Response {
+	return UnknownExample200Videomp4Response{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+	}
+}
+
 type UnknownExample400Response = BadrequestResponse
 
 func (response UnknownExample400Response) VisitUnknownExampleResponse(ctx iris.Context) error {
@@ -607,6 +843,16 @@ func (response UnspecifiedContentType200VideoResponse) VisitUnspecifiedContentTy
 	return err
 }
 
+// NewUnspecifiedContentType200VideoResponse returns the response of body, along with its
+// length, and the content type http.DetectContentType sniffs from it.
+func NewUnspecifiedContentType200VideoResponse(body []byte) UnspecifiedContentType200VideoResponse {
+	return UnspecifiedContentType200VideoResponse{
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+		ContentType:   http.DetectContentType(body),
+	}
+}
+
 type UnspecifiedContentType400Response = BadrequestResponse
 
 func (response UnspecifiedContentType400Response) VisitUnspecifiedContentTypeResponse(ctx iris.Context) error {
@@ -678,8 +924,14 @@ func (response URLEncodedExampledefaultResponse) VisitURLEncodedExampleResponse(
 }
 
 type HeadersExampleRequestObject struct {
-	Params HeadersExampleParams
-	Body   *HeadersExampleJSONRequestBody
+	HeadersExampleParams
+	Body *HeadersExampleJSONRequestBody
+}
+
+// Params returns the query, header and cookie parameters of the request,
+// which are embedded in it.
+func (r HeadersExampleRequestObject) Params() HeadersExampleParams {
+	return r.HeadersExampleParams
 }
 
 type HeadersExampleResponseObject interface {
@@ -804,6 +1056,9 @@ type StrictServerInterface interface {
 	// (POST /text)
 	TextExample(ctx context.Context, request TextExampleRequestObject) (TextExampleResponseObject, error)
 
+	// (GET /typed-path-parameters/{id}/{date})
+	TypedPathParameters(ctx context.Context, request TypedPathParametersRequestObject) (TypedPathParametersResponseObject, error)
+
 	// (POST /unknown)
 	UnknownExample(ctx context.Context, request UnknownExampleRequestObject) (UnknownExampleResponseObject, error)
 
@@ -836,13 +1091,17 @@ type strictHandler struct {
 func (sh *strictHandler) JSONExample(ctx iris.Context) {
 	var request JSONExampleRequestObject
 
-	var body JSONExampleJSONRequestBody
-	if err := ctx.ReadJSON(&body); err != nil {
-		ctx.StopWithError(http.StatusBadRequest, err)
-		return
-	}
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request().ContentLength != 0 {
+
+		var body JSONExampleJSONRequestBody
+		if err := ctx.ReadJSON(&body); err != nil {
+			ctx.StopWithError(http.StatusBadRequest, err)
+			return
+		}
+		request.Body = &body
 
+	}
 	handler := func(ctx iris.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.JSONExample(ctx, request.(JSONExampleRequestObject))
 	}
@@ -850,6 +1109,7 @@ func (sh *strictHandler) JSONExample(ctx iris.Context) {
 		handler = middleware(handler, "JSONExample")
 	}
 
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "JSONExample")))
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -870,13 +1130,17 @@ func (sh *strictHandler) JSONExample(ctx iris.Context) {
 func (sh *strictHandler) MultipartExample(ctx iris.Context) {
 	var request MultipartExampleRequestObject
 
-	if reader, err := ctx.Request().MultipartReader(); err == nil {
-		request.Body = reader
-	} else {
-		ctx.StopWithError(http.StatusBadRequest, err)
-		return
-	}
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request().ContentLength != 0 {
+
+		if reader, err := ctx.Request().MultipartReader(); err == nil {
+			request.Body = reader
+		} else {
+			ctx.StopWithError(http.StatusBadRequest, err)
+			return
+		}
 
+	}
 	handler := func(ctx iris.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.MultipartExample(ctx, request.(MultipartExampleRequestObject))
 	}
@@ -884,6 +1148,7 @@ func (sh *strictHandler) MultipartExample(ctx iris.Context) {
 		handler = middleware(handler, "MultipartExample")
 	}
 
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "MultipartExample")))
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -904,16 +1169,20 @@ func (sh *strictHandler) MultipartExample(ctx iris.Context) {
 func (sh *strictHandler) MultipartRelatedExample(ctx iris.Context) {
 	var request MultipartRelatedExampleRequestObject
 
-	if _, params, err := mime.ParseMediaType(ctx.Request().Header.Get("Content-Type")); err != nil {
-		ctx.StopWithError(http.StatusBadRequest, err)
-		return
-	} else if boundary := params["boundary"]; boundary == "" {
-		ctx.StopWithError(http.StatusBadRequest, http.ErrMissingBoundary)
-		return
-	} else {
-		request.Body = multipart.NewReader(ctx.Request().Body, boundary)
-	}
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request().ContentLength != 0 {
+
+		if _, params, err := mime.ParseMediaType(ctx.Request().Header.Get("Content-Type")); err != nil {
+			ctx.StopWithError(http.StatusBadRequest, err)
+			return
+		} else if boundary := params["boundary"]; boundary == "" {
+			ctx.StopWithError(http.StatusBadRequest, http.ErrMissingBoundary)
+			return
+		} else {
+			request.Body = multipart.NewReader(ctx.Request().Body, boundary)
+		}
 
+	}
 	handler := func(ctx iris.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.MultipartRelatedExample(ctx, request.(MultipartRelatedExampleRequestObject))
 	}
@@ -921,6 +1190,7 @@ func (sh *strictHandler) MultipartRelatedExample(ctx iris.Context) {
 		handler = middleware(handler, "MultipartRelatedExample")
 	}
 
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "MultipartRelatedExample")))
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -941,48 +1211,51 @@ func (sh *strictHandler) MultipartRelatedExample(ctx iris.Context) {
 func (sh *strictHandler) MultipleRequestAndResponseTypes(ctx iris.Context) {
 	var request MultipleRequestAndResponseTypesRequestObject
 
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json") {
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request().ContentLength != 0 {
+		if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json") {
 
-		var body MultipleRequestAndResponseTypesJSONRequestBody
-		if err := ctx.ReadJSON(&body); err != nil {
-			ctx.StopWithError(http.StatusBadRequest, err)
-			return
+			var body MultipleRequestAndResponseTypesJSONRequestBody
+			if err := ctx.ReadJSON(&body); err != nil {
+				ctx.StopWithError(http.StatusBadRequest, err)
+				return
+			}
+			request.JSONBody = &body
 		}
-		request.JSONBody = &body
-	}
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/x-www-form-urlencoded") {
-		if err := ctx.Request().ParseForm(); err != nil {
-			ctx.StopWithError(http.StatusBadRequest, err)
-			return
+		if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/x-www-form-urlencoded") {
+			if err := ctx.Request().ParseForm(); err != nil {
+				ctx.StopWithError(http.StatusBadRequest, err)
+				return
+			}
+			var body MultipleRequestAndResponseTypesFormdataRequestBody
+			if err := bindForm(&body, ctx.Request().Form, nil); err != nil {
+				ctx.StopWithError(http.StatusBadRequest, err)
+				return
+			}
+			request.FormdataBody = &body
 		}
-		var body MultipleRequestAndResponseTypesFormdataRequestBody
-		if err := runtime.BindForm(&body, ctx.Request().Form, nil, nil); err != nil {
-			ctx.StopWithError(http.StatusBadRequest, err)
-			return
+		if strings.HasPrefix(ctx.GetHeader("Content-Type"), "image/png") {
+			request.Body = ctx.Request().Body
 		}
-		request.FormdataBody = &body
-	}
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "image/png") {
-		request.Body = ctx.Request().Body
-	}
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "multipart/form-data") {
-		if reader, err := ctx.Request().MultipartReader(); err == nil {
-			request.MultipartBody = reader
-		} else {
-			ctx.StopWithError(http.StatusBadRequest, err)
-			return
+		if strings.HasPrefix(ctx.GetHeader("Content-Type"), "multipart/form-data") {
+			if reader, err := ctx.Request().MultipartReader(); err == nil {
+				request.MultipartBody = reader
+			} else {
+				ctx.StopWithError(http.StatusBadRequest, err)
+				return
+			}
 		}
-	}
-	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "text/plain") {
-		data, err := io.ReadAll(ctx.Request().Body)
-		if err != nil {
-			ctx.StopWithError(http.StatusBadRequest, err)
-			return
+		if strings.HasPrefix(ctx.GetHeader("Content-Type"), "text/plain") {
+			data, err := io.ReadAll(ctx.Request().Body)
+			if err != nil {
+				ctx.StopWithError(http.StatusBadRequest, err)
+				return
+			}
+			body := MultipleRequestAndResponseTypesTextRequestBody(data)
+			request.TextBody = &body
 		}
-		body := MultipleRequestAndResponseTypesTextRequestBody(data)
-		request.TextBody = &body
-	}
 
+	}
 	handler := func(ctx iris.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.MultipleRequestAndResponseTypes(ctx, request.(MultipleRequestAndResponseTypesRequestObject))
 	}
@@ -990,6 +1263,7 @@ func (sh *strictHandler) MultipleRequestAndResponseTypes(ctx iris.Context) {
 		handler = middleware(handler, "MultipleRequestAndResponseTypes")
 	}
 
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "MultipleRequestAndResponseTypes")))
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1019,6 +1293,7 @@ func (sh *strictHandler) ReservedGoKeywordParameters(ctx iris.Context, pType str
 		handler = middleware(handler, "ReservedGoKeywordParameters")
 	}
 
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "ReservedGoKeywordParameters")))
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1039,13 +1314,17 @@ func (sh *strictHandler) ReservedGoKeywordParameters(ctx iris.Context, pType str
 func (sh *strictHandler) ReusableResponses(ctx iris.Context) {
 	var request ReusableResponsesRequestObject
 
-	var body ReusableResponsesJSONRequestBody
-	if err := ctx.ReadJSON(&body); err != nil {
-		ctx.StopWithError(http.StatusBadRequest, err)
-		return
-	}
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request().ContentLength != 0 {
+
+		var body ReusableResponsesJSONRequestBody
+		if err := ctx.ReadJSON(&body); err != nil {
+			ctx.StopWithError(http.StatusBadRequest, err)
+			return
+		}
+		request.Body = &body
 
+	}
 	handler := func(ctx iris.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.ReusableResponses(ctx, request.(ReusableResponsesRequestObject))
 	}
@@ -1053,6 +1332,7 @@ func (sh *strictHandler) ReusableResponses(ctx iris.Context) {
 		handler = middleware(handler, "ReusableResponses")
 	}
 
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "ReusableResponses")))
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1073,14 +1353,18 @@ func (sh *strictHandler) ReusableResponses(ctx iris.Context) {
 func (sh *strictHandler) TextExample(ctx iris.Context) {
 	var request TextExampleRequestObject
 
-	data, err := io.ReadAll(ctx.Request().Body)
-	if err != nil {
-		ctx.StopWithError(http.StatusBadRequest, err)
-		return
-	}
-	body := TextExampleTextRequestBody(data)
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request().ContentLength != 0 {
+
+		data, err := io.ReadAll(ctx.Request().Body)
+		if err != nil {
+			ctx.StopWithError(http.StatusBadRequest, err)
+			return
+		}
+		body := TextExampleTextRequestBody(data)
+		request.Body = &body
 
+	}
 	handler := func(ctx iris.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.TextExample(ctx, request.(TextExampleRequestObject))
 	}
@@ -1088,6 +1372,7 @@ func (sh *strictHandler) TextExample(ctx iris.Context) {
 		handler = middleware(handler, "TextExample")
 	}
 
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "TextExample")))
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1104,12 +1389,48 @@ func (sh *strictHandler) TextExample(ctx iris.Context) {
 	}
 }
 
+// TypedPathParameters operation middleware
+func (sh *strictHandler) TypedPathParameters(ctx iris.Context, id openapi_types.UUID, date openapi_types.Date, params TypedPathParametersParams) {
+	var request TypedPathParametersRequestObject
+
+	request.Id = id
+	request.Date = date
+	request.TypedPathParametersParams = params
+
+	handler := func(ctx iris.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TypedPathParameters(ctx, request.(TypedPathParametersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TypedPathParameters")
+	}
+
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "TypedPathParameters")))
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.StopWithError(http.StatusBadRequest, err)
+		return
+	} else if validResponse, ok := response.(TypedPathParametersResponseObject); ok {
+		if err := validResponse.VisitTypedPathParametersResponse(ctx); err != nil {
+			ctx.StopWithError(http.StatusBadRequest, err)
+			return
+		}
+	} else if response != nil {
+		ctx.Writef("Unexpected response type: %T", response)
+		return
+	}
+}
+
 // UnknownExample operation middleware
 func (sh *strictHandler) UnknownExample(ctx iris.Context) {
 	var request UnknownExampleRequestObject
 
-	request.Body = ctx.Request().Body
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request().ContentLength != 0 {
+
+		request.Body = ctx.Request().Body
 
+	}
 	handler := func(ctx iris.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.UnknownExample(ctx, request.(UnknownExampleRequestObject))
 	}
@@ -1117,6 +1438,7 @@ func (sh *strictHandler) UnknownExample(ctx iris.Context) {
 		handler = middleware(handler, "UnknownExample")
 	}
 
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "UnknownExample")))
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1138,9 +1460,12 @@ func (sh *strictHandler) UnspecifiedContentType(ctx iris.Context) {
 	var request UnspecifiedContentTypeRequestObject
 
 	request.ContentType = ctx.GetContentTypeRequested()
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request().ContentLength != 0 {
 
-	request.Body = ctx.Request().Body
+		request.Body = ctx.Request().Body
 
+	}
 	handler := func(ctx iris.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.UnspecifiedContentType(ctx, request.(UnspecifiedContentTypeRequestObject))
 	}
@@ -1148,6 +1473,7 @@ func (sh *strictHandler) UnspecifiedContentType(ctx iris.Context) {
 		handler = middleware(handler, "UnspecifiedContentType")
 	}
 
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "UnspecifiedContentType")))
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1168,17 +1494,21 @@ func (sh *strictHandler) UnspecifiedContentType(ctx iris.Context) {
 func (sh *strictHandler) URLEncodedExample(ctx iris.Context) {
 	var request URLEncodedExampleRequestObject
 
-	if err := ctx.Request().ParseForm(); err != nil {
-		ctx.StopWithError(http.StatusBadRequest, err)
-		return
-	}
-	var body URLEncodedExampleFormdataRequestBody
-	if err := runtime.BindForm(&body, ctx.Request().Form, nil, nil); err != nil {
-		ctx.StopWithError(http.StatusBadRequest, err)
-		return
-	}
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request().ContentLength != 0 {
 
+		if err := ctx.Request().ParseForm(); err != nil {
+			ctx.StopWithError(http.StatusBadRequest, err)
+			return
+		}
+		var body URLEncodedExampleFormdataRequestBody
+		if err := bindForm(&body, ctx.Request().Form, nil); err != nil {
+			ctx.StopWithError(http.StatusBadRequest, err)
+			return
+		}
+		request.Body = &body
+
+	}
 	handler := func(ctx iris.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.URLEncodedExample(ctx, request.(URLEncodedExampleRequestObject))
 	}
@@ -1186,6 +1516,7 @@ func (sh *strictHandler) URLEncodedExample(ctx iris.Context) {
 		handler = middleware(handler, "URLEncodedExample")
 	}
 
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "URLEncodedExample")))
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1206,15 +1537,18 @@ func (sh *strictHandler) URLEncodedExample(ctx iris.Context) {
 func (sh *strictHandler) HeadersExample(ctx iris.Context, params HeadersExampleParams) {
 	var request HeadersExampleRequestObject
 
-	request.Params = params
+	request.HeadersExampleParams = params
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request().ContentLength != 0 {
 
-	var body HeadersExampleJSONRequestBody
-	if err := ctx.ReadJSON(&body); err != nil {
-		ctx.StopWithError(http.StatusBadRequest, err)
-		return
-	}
-	request.Body = &body
+		var body HeadersExampleJSONRequestBody
+		if err := ctx.ReadJSON(&body); err != nil {
+			ctx.StopWithError(http.StatusBadRequest, err)
+			return
+		}
+		request.Body = &body
 
+	}
 	handler := func(ctx iris.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.HeadersExample(ctx, request.(HeadersExampleRequestObject))
 	}
@@ -1222,6 +1556,7 @@ func (sh *strictHandler) HeadersExample(ctx iris.Context, params HeadersExampleP
 		handler = middleware(handler, "HeadersExample")
 	}
 
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "HeadersExample")))
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1242,13 +1577,17 @@ func (sh *strictHandler) HeadersExample(ctx iris.Context, params HeadersExampleP
 func (sh *strictHandler) UnionExample(ctx iris.Context) {
 	var request UnionExampleRequestObject
 
-	var body UnionExampleJSONRequestBody
-	if err := ctx.ReadJSON(&body); err != nil {
-		ctx.StopWithError(http.StatusBadRequest, err)
-		return
-	}
-	request.Body = &body
+	// The body is optional, so it's left nil when the request has none.
+	if ctx.Request().ContentLength != 0 {
 
+		var body UnionExampleJSONRequestBody
+		if err := ctx.ReadJSON(&body); err != nil {
+			ctx.StopWithError(http.StatusBadRequest, err)
+			return
+		}
+		request.Body = &body
+
+	}
 	handler := func(ctx iris.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.UnionExample(ctx, request.(UnionExampleRequestObject))
 	}
@@ -1256,6 +1595,7 @@ func (sh *strictHandler) UnionExample(ctx iris.Context) {
 		handler = middleware(handler, "UnionExample")
 	}
 
+	ctx.ResetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), StrictOperationIdContextKey, "UnionExample")))
 	response, err := handler(ctx, request)
 
 	if err != nil {
@@ -1272,27 +1612,456 @@ func (sh *strictHandler) UnionExample(ctx iris.Context) {
 	}
 }
 
+// maxFormIndex is the largest index of an array bindForm binds, eg,
+// objects[1000][role]. The runtime allocates an array as long as the largest
+// index of a form, whichever indices it has, so a larger one is an error.
+const maxFormIndex = 1000
+
+// bindForm binds the fields of a form, and the files of a multipart one, to
+// dest, as runtime.BindForm does, once the keys of the fields are checked:
+// bracketed segments may follow their name, eg, objects[0][role], and the
+// indices of arrays can't be larger than maxFormIndex. The runtime panics on
+// some malformed keys, which are errors instead. The indices a form leaves out
+// are zero values, eg, objects[1][role]=admin binds two objects.
+func bindForm(dest interface{}, form map[string][]string, files map[string][]*multipart.FileHeader) error {
+	checkKey := func(key string) error {
+		start := strings.IndexByte(key, '[')
+		if start < 0 {
+			return nil
+		}
+		for rest := key[start:]; rest != ""; {
+			end := strings.IndexByte(rest, ']')
+			if rest[0] != '[' || end < 0 || strings.IndexByte(rest[1:end], '[') >= 0 {
+				return fmt.Errorf("invalid form field '%s'", key)
+			}
+			if segment := rest[1:end]; segment != "" && strings.Trim(segment, "0123456789") == "" {
+				if index, err := strconv.Atoi(segment); err != nil || index > maxFormIndex {
+					return fmt.Errorf("index %s of form field '%s' is out of range", segment, key)
+				}
+			}
+			rest = rest[end+1:]
+		}
+		return nil
+	}
+	for key := range form {
+		if err := checkKey(key); err != nil {
+			return err
+		}
+	}
+	for key := range files {
+		if err := checkKey(key); err != nil {
+			return err
+		}
+	}
+
+	return runtime.BindForm(dest, form, files, nil)
+}
+
+// StrictOperationIdContextKey is the key of the context value holding the
+// operationId of the operation being handled, which is set before the
+// StrictMiddlewareFunc chain runs.
+const StrictOperationIdContextKey = "StrictOperationId"
+
+// StrictOperationIdFromContext returns the operationId of the operation being
+// handled, or an empty string outside of a strict handler.
+func StrictOperationIdFromContext(ctx context.Context) string {
+	operationId, _ := ctx.Value(StrictOperationIdContextKey).(string)
+	return operationId
+}
+
+// JSONExampleHandler handles the JSONExample operation with its typed request and response objects.
+type JSONExampleHandler func(ctx context.Context, request JSONExampleRequestObject) (JSONExampleResponseObject, error)
+
+// MultipartExampleHandler handles the MultipartExample operation with its typed request and response objects.
+type MultipartExampleHandler func(ctx context.Context, request MultipartExampleRequestObject) (MultipartExampleResponseObject, error)
+
+// MultipartRelatedExampleHandler handles the MultipartRelatedExample operation with its typed request and response objects.
+type MultipartRelatedExampleHandler func(ctx context.Context, request MultipartRelatedExampleRequestObject) (MultipartRelatedExampleResponseObject, error)
+
+// MultipleRequestAndResponseTypesHandler handles the MultipleRequestAndResponseTypes operation with its typed request and response objects.
+type MultipleRequestAndResponseTypesHandler func(ctx context.Context, request MultipleRequestAndResponseTypesRequestObject) (MultipleRequestAndResponseTypesResponseObject, error)
+
+// ReservedGoKeywordParametersHandler handles the ReservedGoKeywordParameters operation with its typed request and response objects.
+type ReservedGoKeywordParametersHandler func(ctx context.Context, request ReservedGoKeywordParametersRequestObject) (ReservedGoKeywordParametersResponseObject, error)
+
+// ReusableResponsesHandler handles the ReusableResponses operation with its typed request and response objects.
+type ReusableResponsesHandler func(ctx context.Context, request ReusableResponsesRequestObject) (ReusableResponsesResponseObject, error)
+
+// TextExampleHandler handles the TextExample operation with its typed request and response objects.
+type TextExampleHandler func(ctx context.Context, request TextExampleRequestObject) (TextExampleResponseObject, error)
+
+// TypedPathParametersHandler handles the TypedPathParameters operation with its typed request and response objects.
+type TypedPathParametersHandler func(ctx context.Context, request TypedPathParametersRequestObject) (TypedPathParametersResponseObject, error)
+
+// UnknownExampleHandler handles the UnknownExample operation with its typed request and response objects.
+type UnknownExampleHandler func(ctx context.Context, request UnknownExampleRequestObject) (UnknownExampleResponseObject, error)
+
+// UnspecifiedContentTypeHandler handles the UnspecifiedContentType operation with its typed request and response objects.
+type UnspecifiedContentTypeHandler func(ctx context.Context, request UnspecifiedContentTypeRequestObject) (UnspecifiedContentTypeResponseObject, error)
+
+// URLEncodedExampleHandler handles the URLEncodedExample operation with its typed request and response objects.
+type URLEncodedExampleHandler func(ctx context.Context, request URLEncodedExampleRequestObject) (URLEncodedExampleResponseObject, error)
+
+// HeadersExampleHandler handles the HeadersExample operation with its typed request and response objects.
+type HeadersExampleHandler func(ctx context.Context, request HeadersExampleRequestObject) (HeadersExampleResponseObject, error)
+
+// UnionExampleHandler handles the UnionExample operation with its typed request and response objects.
+type UnionExampleHandler func(ctx context.Context, request UnionExampleRequestObject) (UnionExampleResponseObject, error)
+
+// StrictOperationMiddlewares wraps the handling of individual operations, with
+// access to their typed request and response objects. Operations without a
+// middleware are handled as is.
+type StrictOperationMiddlewares struct {
+	OnJSONExample                     func(next JSONExampleHandler) JSONExampleHandler
+	OnMultipartExample                func(next MultipartExampleHandler) MultipartExampleHandler
+	OnMultipartRelatedExample         func(next MultipartRelatedExampleHandler) MultipartRelatedExampleHandler
+	OnMultipleRequestAndResponseTypes func(next MultipleRequestAndResponseTypesHandler) MultipleRequestAndResponseTypesHandler
+	OnReservedGoKeywordParameters     func(next ReservedGoKeywordParametersHandler) ReservedGoKeywordParametersHandler
+	OnReusableResponses               func(next ReusableResponsesHandler) ReusableResponsesHandler
+	OnTextExample                     func(next TextExampleHandler) TextExampleHandler
+	OnTypedPathParameters             func(next TypedPathParametersHandler) TypedPathParametersHandler
+	OnUnknownExample                  func(next UnknownExampleHandler) UnknownExampleHandler
+	OnUnspecifiedContentType          func(next UnspecifiedContentTypeHandler) UnspecifiedContentTypeHandler
+	OnURLEncodedExample               func(next URLEncodedExampleHandler) URLEncodedExampleHandler
+	OnHeadersExample                  func(next HeadersExampleHandler) HeadersExampleHandler
+	OnUnionExample                    func(next UnionExampleHandler) UnionExampleHandler
+}
+
+// WithStrictOperationMiddlewares returns a StrictServerInterface which handles
+// operations through the given middlewares before calling ssi. Passed to
+// NewStrictHandler, the middlewares run inside the StrictMiddlewareFunc chain,
+// right around the typed call.
+func WithStrictOperationMiddlewares(ssi StrictServerInterface, middlewares StrictOperationMiddlewares) StrictServerInterface {
+	return &strictOperationMiddlewares{ssi: ssi, middlewares: middlewares}
+}
+
+type strictOperationMiddlewares struct {
+	ssi         StrictServerInterface
+	middlewares StrictOperationMiddlewares
+}
+
+func (s *strictOperationMiddlewares) JSONExample(ctx context.Context, request JSONExampleRequestObject) (JSONExampleResponseObject, error) {
+	handler := JSONExampleHandler(s.ssi.JSONExample)
+	if s.middlewares.OnJSONExample != nil {
+		handler = s.middlewares.OnJSONExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) MultipartExample(ctx context.Context, request MultipartExampleRequestObject) (MultipartExampleResponseObject, error) {
+	handler := MultipartExampleHandler(s.ssi.MultipartExample)
+	if s.middlewares.OnMultipartExample != nil {
+		handler = s.middlewares.OnMultipartExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) MultipartRelatedExample(ctx context.Context, request MultipartRelatedExampleRequestObject) (MultipartRelatedExampleResponseObject, error) {
+	handler := MultipartRelatedExampleHandler(s.ssi.MultipartRelatedExample)
+	if s.middlewares.OnMultipartRelatedExample != nil {
+		handler = s.middlewares.OnMultipartRelatedExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) MultipleRequestAndResponseTypes(ctx context.Context, request MultipleRequestAndResponseTypesRequestObject) (MultipleRequestAndResponseTypesResponseObject, error) {
+	handler := MultipleRequestAndResponseTypesHandler(s.ssi.MultipleRequestAndResponseTypes)
+	if s.middlewares.OnMultipleRequestAndResponseTypes != nil {
+		handler = s.middlewares.OnMultipleRequestAndResponseTypes(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) ReservedGoKeywordParameters(ctx context.Context, request ReservedGoKeywordParametersRequestObject) (ReservedGoKeywordParametersResponseObject, error) {
+	handler := ReservedGoKeywordParametersHandler(s.ssi.ReservedGoKeywordParameters)
+	if s.middlewares.OnReservedGoKeywordParameters != nil {
+		handler = s.middlewares.OnReservedGoKeywordParameters(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) ReusableResponses(ctx context.Context, request ReusableResponsesRequestObject) (ReusableResponsesResponseObject, error) {
+	handler := ReusableResponsesHandler(s.ssi.ReusableResponses)
+	if s.middlewares.OnReusableResponses != nil {
+		handler = s.middlewares.OnReusableResponses(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) TextExample(ctx context.Context, request TextExampleRequestObject) (TextExampleResponseObject, error) {
+	handler := TextExampleHandler(s.ssi.TextExample)
+	if s.middlewares.OnTextExample != nil {
+		handler = s.middlewares.OnTextExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) TypedPathParameters(ctx context.Context, request TypedPathParametersRequestObject) (TypedPathParametersResponseObject, error) {
+	handler := TypedPathParametersHandler(s.ssi.TypedPathParameters)
+	if s.middlewares.OnTypedPathParameters != nil {
+		handler = s.middlewares.OnTypedPathParameters(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) UnknownExample(ctx context.Context, request UnknownExampleRequestObject) (UnknownExampleResponseObject, error) {
+	handler := UnknownExampleHandler(s.ssi.UnknownExample)
+	if s.middlewares.OnUnknownExample != nil {
+		handler = s.middlewares.OnUnknownExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) UnspecifiedContentType(ctx context.Context, request UnspecifiedContentTypeRequestObject) (UnspecifiedContentTypeResponseObject, error) {
+	handler := UnspecifiedContentTypeHandler(s.ssi.UnspecifiedContentType)
+	if s.middlewares.OnUnspecifiedContentType != nil {
+		handler = s.middlewares.OnUnspecifiedContentType(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) URLEncodedExample(ctx context.Context, request URLEncodedExampleRequestObject) (URLEncodedExampleResponseObject, error) {
+	handler := URLEncodedExampleHandler(s.ssi.URLEncodedExample)
+	if s.middlewares.OnURLEncodedExample != nil {
+		handler = s.middlewares.OnURLEncodedExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) HeadersExample(ctx context.Context, request HeadersExampleRequestObject) (HeadersExampleResponseObject, error) {
+	handler := HeadersExampleHandler(s.ssi.HeadersExample)
+	if s.middlewares.OnHeadersExample != nil {
+		handler = s.middlewares.OnHeadersExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+func (s *strictOperationMiddlewares) UnionExample(ctx context.Context, request UnionExampleRequestObject) (UnionExampleResponseObject, error) {
+	handler := UnionExampleHandler(s.ssi.UnionExample)
+	if s.middlewares.OnUnionExample != nil {
+		handler = s.middlewares.OnUnionExample(handler)
+	}
+	return handler(ctx, request)
+}
+
+// multipartPartKind is how the data of a part of a multipart body whose
+// schema is an object is read into its field, and written from it.
+type multipartPartKind int
+
+const (
+	// multipartJSON parts are the JSON of their field, eg, an object, or a
+	// number, which is written as it is in form data.
+	multipartJSON multipartPartKind = iota
+	// multipartText parts are the text of a string field, as it is.
+	multipartText
+	// multipartBinary parts are files, whose fields are set to their data and
+	// their filename, when they're of a type which has one, such as
+	// openapi_types.File, or to their data, when they're []byte.
+	multipartBinary
+)
+
+// multipartPart describes a part of a multipart body whose schema is an
+// object, which is the property of the same name.
+type multipartPart struct {
+	// Name is the name of the part, which is that of its property, and the
+	// JSON name of its field.
+	Name string
+	Kind multipartPartKind
+	// ContentType is the Content-Type the part is written with. When it's
+	// empty, that's application/json, text/plain or application/octet-stream,
+	// as its kind is multipartJSON, multipartText or multipartBinary.
+	ContentType string
+	Required    bool
+}
+
+// MissingMultipartPartError is the error of a multipart body without a part
+// which its schema requires.
+type MissingMultipartPartError struct {
+	Name string
+}
+
+func (e *MissingMultipartPartError) Error() string {
+	return fmt.Sprintf("multipart body is missing the required part %q", e.Name)
+}
+
+// multipartFile is a field holding the data and the filename of a
+// multipartBinary part, such as openapi_types.File.
+type multipartFile interface {
+	InitFromBytes(data []byte, filename string)
+	Reader() (io.ReadCloser, error)
+	Filename() string
+}
+
+// writeMultipartParts writes v, a struct or a pointer to one, as the parts of
+// writer, the writer of a multipart body of mediaType, eg, multipart/mixed.
+// Each part has a Content-Disposition, of form-data for multipart/form-data
+// and inline otherwise, naming it, and the filename of a multipartBinary part
+// which has one, and the Content-Type of its multipartPart. The parts are
+// written in their order, and those whose field is empty or nil and omitted
+// from its JSON are left out, unless they're required, which is a
+// *MissingMultipartPartError.
+func writeMultipartParts(writer *multipart.Writer, mediaType string, v interface{}, parts []multipartPart) error {
+	fields, err := multipartFields(v)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	disposition := "inline"
+	if mediaType == "multipart/form-data" {
+		disposition = "form-data"
+	}
+	for _, part := range parts {
+		params := map[string]string{"name": part.Name}
+		var body io.Reader
+		if f, ok := multipartFileOf(fields[part.Name]); ok && part.Kind == multipartBinary {
+			reader, err := f.Reader()
+			if err != nil {
+				return fmt.Errorf("error writing part %q: %w", part.Name, err)
+			}
+			defer reader.Close()
+			if filename := f.Filename(); filename != "" {
+				params["filename"] = filename
+			}
+			body = reader
+		} else if value, ok := values[part.Name]; ok && string(value) != "null" {
+			content, err := multipartPartContent(part, value)
+			if err != nil {
+				return fmt.Errorf("error writing part %q: %w", part.Name, err)
+			}
+			body = bytes.NewReader(content)
+		} else if part.Required {
+			return &MissingMultipartPartError{Name: part.Name}
+		} else {
+			continue
+		}
+
+		contentType := part.ContentType
+		switch {
+		case contentType != "":
+		case part.Kind == multipartText:
+			contentType = "text/plain; charset=utf-8"
+		case part.Kind == multipartBinary:
+			contentType = "application/octet-stream"
+		default:
+			contentType = "application/json"
+		}
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", mime.FormatMediaType(disposition, params))
+		header.Set("Content-Type", contentType)
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(w, body); err != nil {
+			return fmt.Errorf("error writing part %q: %w", part.Name, err)
+		}
+	}
+	return nil
+}
+
+// multipartPartContent returns the content of part, whose field's JSON is
+// value.
+func multipartPartContent(part multipartPart, value json.RawMessage) ([]byte, error) {
+	switch part.Kind {
+	case multipartBinary:
+		var data []byte
+		if err := json.Unmarshal(value, &data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	case multipartText:
+		var text string
+		if err := json.Unmarshal(value, &text); err != nil {
+			// The field isn't a string in JSON, eg, a number of a type
+			// the schema's string maps to, whose JSON is its text.
+			return value, nil
+		}
+		return []byte(text), nil
+	}
+	return value, nil
+}
+
+// multipartFileOf returns the file field is, or points to, and whether it's a
+// file.
+func multipartFileOf(field reflect.Value) (multipartFile, bool) {
+	if !field.IsValid() || (field.Kind() == reflect.Pointer && field.IsNil()) {
+		return nil, false
+	}
+	if f, ok := field.Interface().(multipartFile); ok {
+		return f, true
+	}
+	if field.CanAddr() {
+		if f, ok := field.Addr().Interface().(multipartFile); ok {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// multipartFields returns the fields of the struct v is, or points to, by their
+// JSON name, which are addressable.
+func multipartFields(v interface{}) (map[string]reflect.Value, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("multipart parts must be the fields of a struct, not of %T", v)
+	}
+	if !value.CanAddr() {
+		// The file fields are only files through their address.
+		addressable := reflect.New(value.Type()).Elem()
+		addressable.Set(value)
+		value = addressable
+	}
+	fields := make(map[string]reflect.Value, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		fields[name] = value.Field(i)
+	}
+	return fields, nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYS3PbNhD+Kxi0p5QUZccn3hpPJm3T1h3ZPnV8gIilhIQE0MVStEaj/94BQb0sWpUS",
-	"PTqZ3PhY7C6+b3ex2BnPTGmNBk2OpzOO4KzRDpqXoZAI/1TgyL9JcBkqS8ponvJ3Qg7af/OII1RODAtY",
-	"LPfymdEEulkqrC1UJvzS5JPz62fcZWMohX/6ESHnKf8hWbmShL8ugWdR2gL4fD6PXnhw95FHfAxCAjbe",
-	"hserTd00tcBT7giVHnGvJIhdd4opTTAC9Na8aOuEF1j4kc64RWMBSQWMJqKooNtS+8UMP0FGYQdK52Yb",
-	"y1ujSSjtmFR5DgiaWAse8zocc5W1BgkkG06Zt5ARc4ATQB5xUuQd4/fr31nrsOMRnwC6YOiq1+/1PV/G",
-	"ghZW8ZS/bT5F3AoaNxtaEmRNF++/3d/9yZRjoiJTClKZKIopKwW6sShAMqXJeBerjFyPN5awIf5X2a5+",
-	"30Lpo6YJoHdGTk8RME1croXzdb9/pricR/wmGOvSsXQqWUuwRk0uqqID80f9WZtaM0A02O4sKauClBVI",
-	"61xtov3HQmQfyJf6ktxgGUtB4kSoH8vSpYGPEQpBIPcgYBAkD+NhTf1JWfgaOxfloK3HnXXqfmxqx8am",
-	"ZmSYBFGwWtGYLRa+KLBKM8Gc0qMC2MKpqJPMAtpj72ctB+1eHryOk9ezaEPLc1zXddwkUIUF6MzIL6Mw",
-	"4qoUI0isHm0u97oF8ZQPp+RDdvuAO1IiR5zgmRJbCKV3n95nKunfkT5aYod0RWi6EhmPTPwZprVBGVuB",
-	"ogQCdMnMW597xSPoSOW/lpIsE5oNgWlRgmQiJ0D2wbBWpdtK2UFr94P5GERWqpqWZ/mS/j3jHpKmDeIR",
-	"9wZ4GlAJea3Qk05YQbQDtqf/jM+vImCBZmi24w1T3WVwUaKW0CHkzpfELuY68AuWBmsSl2nadkfc1vXj",
-	"HGeQZ/L1o/8Bnvdqu45Y+s6d24cCVoWPr2PWrtoHti+spHugOFESTFLamwM1XwxUZyFTuQIZt7uIg2+v",
-	"lYRbozME2myB/JVOG2JLZf6mSWNgAYGIOcNqYGXliFnhHFPUVJFChduqhK3i8bjy7DZYeliV012svjkR",
-	"p28uxehN/+rwJW9PHDcbrcwr+Tj4/X2QOfTOfrSe6cCO73h2L5TO/pISrw21ulP4lyCwOtMzUBPfEWnJ",
-	"EKhCDZJNlFgMYrZys1WworWrFwpurLqhxYDtkIYo2qnrmke7hnBP3/CI6JSjy3PFaaXVrlHho//N2h76",
-	"5dmgjP6fDgJFQYBakJrAT8e5QW5rMRru8ibTXrAc7Wnh6duLqnnEw+w6lKAKC18niGyaJGHm3XO1GI0A",
-	"e8okwiqPwr8BAAD//4h9qqfAGAAA",
+	"H4sIAAAAAAAC/+xZzZLbNgx+FQ7bUypZzmZPujU7mbRN2+14d0+dHGgRsphIJANC9no8fvcORflvLbt2",
+	"4p9Opre1BH6AAHwAiJ3xzFTWaNDkeDrjCM4a7aD5MRQS4UsNjvwvCS5DZUkZzVP+VshB+24ecYTaiWEJ",
+	"i+NePjOaQDdHhbWlyoQ/mnxy/vyMu6yASvi/fkTIecp/SFamJOGtS+BZVLYEPp/PoxcW3H/gES9ASMDG",
+	"2vDn601smlrgKXeESo+4BwliN51iShOMAL02L9oa4QUWdqQzbtFYQFLBR2NR1tCtqX1ihp8go/AFSudm",
+	"25d3RpNQ2jGp8hwQNLHWecxjOOZqaw0SSDacMq8hI+YAx4A84qTIG8Yf1p+z1mDHIz4GdEHR616/1/fx",
+	"Mha0sIqn/E3zKOJWUNF80DJA1nTF/beH+z+ZckzUZCpBKhNlOWWVQFeIEiRTmow3sc7I9XijCZvA/yrb",
+	"0+9aV/qsaRLorZHTcyRMk5dr6XzT718oL+cRv+33d2EsjUrWCNbA5KIuO3z+pD9rM9EMEA22X5ZUdUnK",
+	"CqT1WG16+4+FyCEuX+IlucEqloLEmbx+Kk3XdnyMUAoCeUAABkHyuDiswZ81Ct+i56oxKGHd9ZvHHgoz",
+	"cawwE0aGSRAlmygq2OLgiwKrNBPMKT0qgS2MijqDWULb9n7WctB+y6PHOHs9izZQnuPJZBI3BKqxBJ0Z",
+	"+XUhjLiqxAgSq0ebxz22IJ7y4ZSARx0N7kREjjjBMyW2FErv794XKun/e/pkxA50RWimEhmPTPwZphOD",
+	"MrYCRQUE6JKZ1z73wCPooPJfS0mWCc2GwLSoQDKREyB7b1gL6bYoO2j1vjcfgsgKqhl5lj/Sv2fcu6QZ",
+	"g3jEvQKeBq8EXiv0QSesIdrjto//mp/fFICFN8OwHW+o6i6DixK1dB1C7nxJ7Ipch/+CpsGaxHWGtv0Z",
+	"t3X9uEQP8pHc3fof4fmgseuEpe/S3D7aYVMLnvdUbJBfyXkyk4L2VgAq2OoMEwhsaGotfSZTAQqbPi5Z",
+	"cxtr2jmMAaeLy1BeirHBrfT2fVt67CPLgpJ7i8KynNd1I7lVzjtBvQcOg20ld8F+qQGnK9xSVYp4tO+y",
+	"e5GqVYeU2M2YNmcOIc1X9tEDODRWEkxS2dsjka9EqVo7C5nKFci4/Yo42LarIdwZnSHQ5gDsL/TaEFuC",
+	"+T0DFcCCByLmDJsAq2pHzArnmKKmh5Qq7CokbHHraWXZXdD0OLWHRPXVmWL66loRve2/Pv7ImzPnzcYg",
+	"u4OPg9/fBZljNzYnm5iPnPdPp/dKdPZX1HhtpdlN4V+CwGqiy0CN/TysJUOgGrXvhEos1nBb3GwBVmHt",
+	"annBjFUfWaxXjxmHo71YNwd0pe90QXjOxfWl8rTWat+i+Mm/Zu0N6mVvUEb/R9fAoiRALUiN4afT7A+2",
+	"UYyG+7xh2osoRwdq+Pj9ZdU84mFYDyWoxpKnvCCyaZKE/3j03ESMRoA9ZRJhlffCPwMAbejYZr4aAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
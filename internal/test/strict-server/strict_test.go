@@ -2,6 +2,7 @@ package strictserver
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"mime"
@@ -15,6 +16,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/gorilla/mux"
 	"github.com/kataras/iris/v12"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -24,6 +26,7 @@ import (
 	echoAPI "github.com/deepmap/oapi-codegen/v2/internal/test/strict-server/echo"
 	fiberAPI "github.com/deepmap/oapi-codegen/v2/internal/test/strict-server/fiber"
 	ginAPI "github.com/deepmap/oapi-codegen/v2/internal/test/strict-server/gin"
+	gorillaAPI "github.com/deepmap/oapi-codegen/v2/internal/test/strict-server/gorilla"
 	irisAPI "github.com/deepmap/oapi-codegen/v2/internal/test/strict-server/iris"
 
 	"github.com/oapi-codegen/runtime"
@@ -71,6 +74,71 @@ func TestFiberServer(t *testing.T) {
 	testImpl(t, adaptor.FiberApp(r))
 }
 
+func TestGorillaServer(t *testing.T) {
+	server := gorillaAPI.StrictServer{}
+	strictHandler := gorillaAPI.NewStrictHandler(server, nil)
+	r := mux.NewRouter()
+	handler := gorillaAPI.HandlerFromMux(strictHandler, r)
+	testImpl(t, handler)
+}
+
+func TestChiServerOperationMiddlewares(t *testing.T) {
+	var calls []string
+	generic := func(f chiAPI.StrictHandlerFunc, operationID string) chiAPI.StrictHandlerFunc {
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+			calls = append(calls, "generic "+chiAPI.StrictOperationIdFromContext(ctx))
+			return f(ctx, w, r, request)
+		}
+	}
+	typed := chiAPI.StrictOperationMiddlewares{
+		OnJSONExample: func(next chiAPI.JSONExampleHandler) chiAPI.JSONExampleHandler {
+			return func(ctx context.Context, request chiAPI.JSONExampleRequestObject) (chiAPI.JSONExampleResponseObject, error) {
+				calls = append(calls, "typed "+*request.Body.Value+" "+chiAPI.StrictOperationIdFromContext(ctx))
+				return next(ctx, request)
+			}
+		},
+	}
+	strictHandler := chiAPI.NewStrictHandler(chiAPI.WithStrictOperationMiddlewares(chiAPI.StrictServer{}, typed), []chiAPI.StrictMiddlewareFunc{generic})
+	handler := chiAPI.HandlerFromMux(strictHandler, chi.NewRouter())
+
+	value := "123"
+	rr := testutil.NewRequest().Post("/json").WithJsonBody(clientAPI.Example{Value: &value}).GoWithHTTPHandler(t, handler).Recorder
+	assert.Equal(t, http.StatusOK, rr.Code)
+	rr = testutil.NewRequest().Post("/text").WithContentType("text/plain").WithBody([]byte("text")).GoWithHTTPHandler(t, handler).Recorder
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	assert.Equal(t, []string{"generic JSONExample", "typed 123 JSONExample", "generic TextExample"}, calls)
+}
+
+func TestEchoServerOperationMiddlewares(t *testing.T) {
+	var calls []string
+	generic := func(f echoAPI.StrictHandlerFunc, operationID string) echoAPI.StrictHandlerFunc {
+		return func(ctx echo.Context, request interface{}) (interface{}, error) {
+			calls = append(calls, "generic "+echoAPI.StrictOperationIdFromContext(ctx.Request().Context()))
+			return f(ctx, request)
+		}
+	}
+	typed := echoAPI.StrictOperationMiddlewares{
+		OnJSONExample: func(next echoAPI.JSONExampleHandler) echoAPI.JSONExampleHandler {
+			return func(ctx context.Context, request echoAPI.JSONExampleRequestObject) (echoAPI.JSONExampleResponseObject, error) {
+				calls = append(calls, "typed "+*request.Body.Value+" "+echoAPI.StrictOperationIdFromContext(ctx))
+				return next(ctx, request)
+			}
+		},
+	}
+	strictHandler := echoAPI.NewStrictHandler(echoAPI.WithStrictOperationMiddlewares(echoAPI.StrictServer{}, typed), []echoAPI.StrictMiddlewareFunc{generic})
+	e := echo.New()
+	echoAPI.RegisterHandlers(e, strictHandler)
+
+	value := "123"
+	rr := testutil.NewRequest().Post("/json").WithJsonBody(clientAPI.Example{Value: &value}).GoWithHTTPHandler(t, e).Recorder
+	assert.Equal(t, http.StatusOK, rr.Code)
+	rr = testutil.NewRequest().Post("/text").WithContentType("text/plain").WithBody([]byte("text")).GoWithHTTPHandler(t, e).Recorder
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	assert.Equal(t, []string{"generic JSONExample", "typed 123 JSONExample", "generic TextExample"}, calls)
+}
+
 func testImpl(t *testing.T, handler http.Handler) {
 	t.Run("JSONExample", func(t *testing.T) {
 		value := "123"
@@ -258,6 +326,17 @@ func testImpl(t *testing.T, handler http.Handler) {
 		assert.NoError(t, err)
 		assert.Equal(t, requestBody, responseBody)
 	})
+	t.Run("TypedPathParameters", func(t *testing.T) {
+		rr := testutil.NewRequest().Get("/typed-path-parameters/9a4f5c8e-2d1b-4f3a-8c6e-0b7d9e1f2a3c/2023-04-05?limit=3").GoWithHTTPHandler(t, handler).Recorder
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "9a4f5c8e-2d1b-4f3a-8c6e-0b7d9e1f2a3c 2023-04-05 3", rr.Body.String())
+
+		rr = testutil.NewRequest().Get("/typed-path-parameters/not-a-uuid/2023-04-05").GoWithHTTPHandler(t, handler).Recorder
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = testutil.NewRequest().Get("/typed-path-parameters/9a4f5c8e-2d1b-4f3a-8c6e-0b7d9e1f2a3c/yesterday").GoWithHTTPHandler(t, handler).Recorder
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
 	t.Run("UnionResponses", func(t *testing.T) {
 		value := "union"
 		requestBody := clientAPI.Example{Value: &value}
@@ -0,0 +1,172 @@
+package strictdates
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct{}
+
+func (s *server) GetDay(ctx context.Context, request GetDayRequestObject) (GetDayResponseObject, error) {
+	until := request.XUntil
+	return GetDay200JSONResponse{Day: request.Day, From: request.From, Until: &until}, nil
+}
+
+func TestDateRoundTrip(t *testing.T) {
+	ts := httptest.NewServer(Handler(NewStrictHandler(&server{}, nil)))
+	defer ts.Close()
+
+	var sent *http.Request
+	client, err := NewClientWithResponses(ts.URL, WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		sent = req
+		return nil
+	}))
+	require.NoError(t, err)
+
+	// Shortly after midnight in Auckland, it's still the previous day in UTC.
+	auckland := time.FixedZone("NZDT", 13*60*60)
+	now := time.Date(2024, time.March, 31, 0, 30, 0, 0, auckland)
+	day := StrictDateOf(now, auckland)
+	from := StrictDate{Time: now}
+	rsp, err := client.GetDayWithResponse(context.Background(), day, &GetDayParams{From: &from, XUntil: day})
+	require.NoError(t, err)
+	assert.Equal(t, "/days/2024-03-31", sent.URL.Path)
+	assert.Equal(t, "2024-03-31", sent.URL.Query().Get("from"))
+	assert.Equal(t, "2024-03-31", sent.Header.Get("X-Until"))
+
+	require.Equal(t, http.StatusOK, rsp.StatusCode())
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, day, rsp.JSON200.Day)
+	require.NotNil(t, rsp.JSON200.From)
+	assert.Equal(t, "2024-03-31", rsp.JSON200.From.String())
+	assert.Equal(t, time.Date(2024, time.March, 31, 0, 0, 0, 0, auckland), rsp.JSON200.Day.In(auckland))
+
+	// The dates of the runtime convert to and from them.
+	assert.Equal(t, "2024-03-31", openapi_types.Date(day).Format(openapi_types.DateFormat))
+}
+
+func TestDateRejectsTimes(t *testing.T) {
+	h := Handler(NewStrictHandler(&server{}, nil))
+
+	for name, target := range map[string]string{
+		"path":  "/days/2024-03-31T00:00:00Z",
+		"query": "/days/2024-03-31?from=2024-03-31%2B13:00",
+	} {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		req.Header.Set("X-Until", "2024-03-31")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, name)
+		assert.Contains(t, rec.Body.String(), "invalid date", name)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/days/2024-03-31", nil)
+	req.Header.Set("X-Until", "2024-03-31 10:00")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "it has a time component")
+
+	var d Day
+	err := json.Unmarshal([]byte(`{"day":"2024-03-31T00:00:00Z"}`), &d)
+	var parseErr *StrictDateError
+	require.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, "it has a time component", parseErr.Reason)
+}
+
+func TestBindStrictDate(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		reason string
+	}{
+		{name: "date", value: "2024-03-31"},
+		{name: "time", value: "2024-03-31T00:00:00Z", reason: "it has a time component"},
+		{name: "lower-case time", value: "2024-03-31t10:00:00", reason: "it has a time component"},
+		{name: "space-separated time", value: "2024-03-31 10:00", reason: "it has a time component"},
+		{name: "zone", value: "2024-03-31Z", reason: "it has a zone component"},
+		{name: "offset", value: "2024-03-31+13:00", reason: "it has a zone component"},
+		{name: "unpadded", value: "2024-3-31", reason: "it isn't formatted as 2006-01-02"},
+		{name: "invalid day", value: "2024-02-30", reason: "it isn't formatted as 2006-01-02"},
+		{name: "empty", value: "", reason: "it isn't formatted as 2006-01-02"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var d StrictDate
+			err := bindStrictDate(test.value, &d)
+			if test.reason == "" {
+				require.NoError(t, err)
+				assert.Equal(t, time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC), d.Time)
+				return
+			}
+			var parseErr *StrictDateError
+			require.ErrorAs(t, err, &parseErr)
+			assert.Equal(t, test.value, parseErr.Value)
+			assert.Equal(t, test.reason, parseErr.Reason)
+			assert.True(t, d.IsZero())
+		})
+	}
+}
+
+func TestStrictDateLocations(t *testing.T) {
+	auckland := time.FixedZone("NZDT", 13*60*60)
+	instant := time.Date(2024, time.March, 31, 1, 30, 0, 0, auckland)
+
+	// The instant is still March 30 in UTC.
+	assert.Equal(t, "2024-03-31", StrictDateOf(instant, auckland).String())
+	assert.Equal(t, "2024-03-30", StrictDateOf(instant, time.UTC).String())
+
+	d := StrictDateOf(instant, auckland)
+	assert.Equal(t, time.Date(2024, time.March, 31, 0, 0, 0, 0, auckland), d.In(auckland))
+	assert.True(t, d.In(auckland).Before(instant))
+}
+
+func TestStrictDateJSON(t *testing.T) {
+	var v struct {
+		Due  StrictDate  `json:"due"`
+		Skip *StrictDate `json:"skip"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"due":"2024-03-31","skip":null}`), &v))
+	assert.Equal(t, "2024-03-31", v.Due.String())
+	assert.Nil(t, v.Skip)
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"due":"2024-03-31","skip":null}`, string(data))
+
+	err = json.Unmarshal([]byte(`{"due":"2024-03-31T00:00:00Z"}`), &v)
+	var parseErr *StrictDateError
+	assert.ErrorAs(t, err, &parseErr)
+}
+
+type dueDate StrictDate
+
+func TestBindStrictDateQueryParam(t *testing.T) {
+	query := url.Values{"due": {"2024-03-31"}, "from": {"2024-03-31T00:00:00Z"}, "to": {"2024-03-30", "2024-03-31"}}
+
+	var due dueDate
+	require.NoError(t, bindStrictDateQueryParam(query, "due", &due))
+	assert.Equal(t, "2024-03-31", StrictDate(due).String())
+	assert.Error(t, bindStrictDateQueryParam(query, "missing", &due))
+	assert.Error(t, bindStrictDateQueryParam(query, "to", &due))
+
+	var from *StrictDate
+	var parseErr *StrictDateError
+	assert.ErrorAs(t, bindOptionalStrictDateQueryParam(query, "from", &from), &parseErr)
+	assert.Nil(t, from)
+
+	require.NoError(t, bindOptionalStrictDateQueryParam(query, "missing", &from))
+	assert.Nil(t, from)
+	require.NoError(t, bindOptionalStrictDateQueryParam(query, "due", &from))
+	require.NotNil(t, from)
+	assert.Equal(t, "2024-03-31", from.String())
+}
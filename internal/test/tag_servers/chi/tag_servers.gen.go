@@ -0,0 +1,434 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+)
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /health)
+	Health(w http.ResponseWriter, r *http.Request)
+
+	// (POST /orders)
+	PlaceOrder(w http.ResponseWriter, r *http.Request)
+
+	// (GET /pets)
+	ListPets(w http.ResponseWriter, r *http.Request)
+
+	// (GET /pets/{id})
+	GetPet(w http.ResponseWriter, r *http.Request, id int)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /health)
+func (_ Unimplemented) Health(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /orders)
+func (_ Unimplemented) PlaceOrder(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /pets)
+func (_ Unimplemented) ListPets(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /pets/{id})
+func (_ Unimplemented) GetPet(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// Health operation middleware
+func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Health(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PlaceOrder operation middleware
+func (siw *ServerInterfaceWrapper) PlaceOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PlaceOrder(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListPets operation middleware
+func (siw *ServerInterfaceWrapper) ListPets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPets(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPet operation middleware
+func (siw *ServerInterfaceWrapper) GetPet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPet(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   errorHandlerFunc,
+	}
+}
+
+// NewHealthHandler returns the handler HandlerWithOptions routes the
+// Health operation to, so that it can be mounted on its own.
+func NewHealthHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).Health
+}
+
+// NewPlaceOrderHandler returns the handler HandlerWithOptions routes the
+// PlaceOrder operation to, so that it can be mounted on its own.
+func NewPlaceOrderHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).PlaceOrder
+}
+
+// NewListPetsHandler returns the handler HandlerWithOptions routes the
+// ListPets operation to, so that it can be mounted on its own.
+func NewListPetsHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ListPets
+}
+
+// NewGetPetHandler returns the handler HandlerWithOptions routes the
+// GetPet operation to, so that it can be mounted on its own.
+// Its path parameters are read with chi.URLParam though.
+func NewGetPetHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).GetPet
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", NewHealthHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/orders", NewPlaceOrderHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pets", NewListPetsHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pets/{id}", NewGetPetHandler(si, options))
+	})
+
+	return r
+}
+
+// PetsServerInterface represents the handlers of the operations tagged "pets".
+type PetsServerInterface interface {
+
+	// (GET /pets)
+	ListPets(w http.ResponseWriter, r *http.Request)
+
+	// (GET /pets/{id})
+	GetPet(w http.ResponseWriter, r *http.Request, id int)
+}
+
+// StoreOrdersServerInterface represents the handlers of the operations tagged "store orders".
+type StoreOrdersServerInterface interface {
+
+	// (POST /orders)
+	PlaceOrder(w http.ResponseWriter, r *http.Request)
+}
+
+// UntaggedServerInterface represents the handlers of the operations without tags.
+type UntaggedServerInterface interface {
+
+	// (GET /health)
+	Health(w http.ResponseWriter, r *http.Request)
+}
+
+// NewServerFromTags returns a ServerInterface handing the operations of each
+// tag to its implementation. The operations of a tag whose implementation is
+// nil respond with http.StatusNotImplemented.
+func NewServerFromTags(pets PetsServerInterface, storeOrders StoreOrdersServerInterface, untagged UntaggedServerInterface) ServerInterface {
+	if pets == nil {
+		pets = Unimplemented{}
+	}
+	if storeOrders == nil {
+		storeOrders = Unimplemented{}
+	}
+	if untagged == nil {
+		untagged = Unimplemented{}
+	}
+	return &tagServers{
+		pets:        pets,
+		storeOrders: storeOrders,
+		untagged:    untagged,
+	}
+}
+
+// tagServers composes the per tag server interfaces into a ServerInterface.
+type tagServers struct {
+	pets        PetsServerInterface
+	storeOrders StoreOrdersServerInterface
+	untagged    UntaggedServerInterface
+}
+
+func (s *tagServers) ListPets(w http.ResponseWriter, r *http.Request) {
+	s.pets.ListPets(w, r)
+}
+
+func (s *tagServers) GetPet(w http.ResponseWriter, r *http.Request, id int) {
+	s.pets.GetPet(w, r, id)
+}
+
+func (s *tagServers) PlaceOrder(w http.ResponseWriter, r *http.Request) {
+	s.storeOrders.PlaceOrder(w, r)
+}
+
+func (s *tagServers) Health(w http.ResponseWriter, r *http.Request) {
+	s.untagged.Health(w, r)
+}
+
+// PetsHandlerFromMux adds the routes of the operations tagged "pets" to the
+// provided mux, leaving out the routes of every other tag.
+func PetsHandlerFromMux(si PetsServerInterface, r chi.Router) http.Handler {
+	return PetsHandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+// PetsHandlerWithOptions creates http.Handler serving the operations
+// tagged "pets", with additional options.
+func PetsHandlerWithOptions(si PetsServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	server := &tagServers{pets: si}
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pets", NewListPetsHandler(server, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pets/{id}", NewGetPetHandler(server, options))
+	})
+
+	return r
+}
+
+// StoreOrdersHandlerFromMux adds the routes of the operations tagged "store orders" to the
+// provided mux, leaving out the routes of every other tag.
+func StoreOrdersHandlerFromMux(si StoreOrdersServerInterface, r chi.Router) http.Handler {
+	return StoreOrdersHandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+// StoreOrdersHandlerWithOptions creates http.Handler serving the operations
+// tagged "store orders", with additional options.
+func StoreOrdersHandlerWithOptions(si StoreOrdersServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	server := &tagServers{storeOrders: si}
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/orders", NewPlaceOrderHandler(server, options))
+	})
+
+	return r
+}
+
+// UntaggedHandlerFromMux adds the routes of the operations without tags to the
+// provided mux, leaving out the routes of every other tag.
+func UntaggedHandlerFromMux(si UntaggedServerInterface, r chi.Router) http.Handler {
+	return UntaggedHandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+// UntaggedHandlerWithOptions creates http.Handler serving the operations
+// without tags, with additional options.
+func UntaggedHandlerWithOptions(si UntaggedServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	server := &tagServers{untagged: si}
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", NewHealthHandler(server, options))
+	})
+
+	return r
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type pets struct{}
+
+func (pets) ListPets(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func (pets) GetPet(w http.ResponseWriter, r *http.Request, id int) {
+	fmt.Fprint(w, id)
+}
+
+type orders struct{}
+
+func (orders) PlaceOrder(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func serve(h http.Handler, method, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	return rec
+}
+
+func TestNewServerFromTags(t *testing.T) {
+	h := Handler(NewServerFromTags(pets{}, orders{}, nil))
+
+	rec := serve(h, http.MethodGet, "/pets/7")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "7", rec.Body.String())
+	assert.Equal(t, http.StatusNoContent, serve(h, http.MethodPost, "/orders").Code)
+	// The untagged operations have no implementation.
+	assert.Equal(t, http.StatusNotImplemented, serve(h, http.MethodGet, "/health").Code)
+}
+
+func TestTagHandlers(t *testing.T) {
+	r := chi.NewRouter()
+	PetsHandlerFromMux(pets{}, r)
+	StoreOrdersHandlerWithOptions(orders{}, ChiServerOptions{BaseRouter: r, BaseURL: "/store"})
+
+	assert.Equal(t, http.StatusOK, serve(r, http.MethodGet, "/pets").Code)
+	assert.Equal(t, "7", serve(r, http.MethodGet, "/pets/7").Body.String())
+	assert.Equal(t, http.StatusNoContent, serve(r, http.MethodPost, "/store/orders").Code)
+	// The routes of the tags which weren't registered aren't added.
+	assert.Equal(t, http.StatusNotFound, serve(r, http.MethodGet, "/health").Code)
+	assert.Equal(t, http.StatusNotFound, serve(r, http.MethodPost, "/orders").Code)
+}
@@ -0,0 +1,244 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+)
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /health)
+	Health(ctx echo.Context) error
+
+	// (POST /orders)
+	PlaceOrder(ctx echo.Context) error
+
+	// (GET /pets)
+	ListPets(ctx echo.Context) error
+
+	// (GET /pets/{id})
+	GetPet(ctx echo.Context, id int) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// Health converts echo context to params.
+func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Health(ctx)
+	return err
+}
+
+// PlaceOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) PlaceOrder(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PlaceOrder(ctx)
+	return err
+}
+
+// ListPets converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPets(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPets(ctx)
+	return err
+}
+
+// GetPet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPet(ctx, id)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// NewHealthHandler returns the handler RegisterHandlers routes the
+// Health operation to, so that it can be mounted on its own.
+func NewHealthHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.Health
+}
+
+// NewPlaceOrderHandler returns the handler RegisterHandlers routes the
+// PlaceOrder operation to, so that it can be mounted on its own.
+func NewPlaceOrderHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.PlaceOrder
+}
+
+// NewListPetsHandler returns the handler RegisterHandlers routes the
+// ListPets operation to, so that it can be mounted on its own.
+func NewListPetsHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.ListPets
+}
+
+// NewGetPetHandler returns the handler RegisterHandlers routes the
+// GetPet operation to, so that it can be mounted on its own.
+func NewGetPetHandler(si ServerInterface) echo.HandlerFunc {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+	return wrapper.GetPet
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	router.GET(baseURL+"/health", NewHealthHandler(si))
+	router.POST(baseURL+"/orders", NewPlaceOrderHandler(si))
+	router.GET(baseURL+"/pets", NewListPetsHandler(si))
+	router.GET(baseURL+"/pets/:id", NewGetPetHandler(si))
+
+}
+
+// PetsServerInterface represents the handlers of the operations tagged "pets".
+type PetsServerInterface interface {
+
+	// (GET /pets)
+	ListPets(ctx echo.Context) error
+
+	// (GET /pets/{id})
+	GetPet(ctx echo.Context, id int) error
+}
+
+// StoreOrdersServerInterface represents the handlers of the operations tagged "store orders".
+type StoreOrdersServerInterface interface {
+
+	// (POST /orders)
+	PlaceOrder(ctx echo.Context) error
+}
+
+// UntaggedServerInterface represents the handlers of the operations without tags.
+type UntaggedServerInterface interface {
+
+	// (GET /health)
+	Health(ctx echo.Context) error
+}
+
+// NewServerFromTags returns a ServerInterface handing the operations of each
+// tag to its implementation. The operations of a tag whose implementation is
+// nil respond with http.StatusNotImplemented.
+func NewServerFromTags(pets PetsServerInterface, storeOrders StoreOrdersServerInterface, untagged UntaggedServerInterface) ServerInterface {
+	return &tagServers{
+		pets:        pets,
+		storeOrders: storeOrders,
+		untagged:    untagged,
+	}
+}
+
+// tagServers composes the per tag server interfaces into a ServerInterface.
+type tagServers struct {
+	pets        PetsServerInterface
+	storeOrders StoreOrdersServerInterface
+	untagged    UntaggedServerInterface
+}
+
+func (s *tagServers) ListPets(ctx echo.Context) error {
+	if s.pets == nil {
+		return echo.NewHTTPError(http.StatusNotImplemented)
+	}
+	return s.pets.ListPets(ctx)
+}
+
+func (s *tagServers) GetPet(ctx echo.Context, id int) error {
+	if s.pets == nil {
+		return echo.NewHTTPError(http.StatusNotImplemented)
+	}
+	return s.pets.GetPet(ctx, id)
+}
+
+func (s *tagServers) PlaceOrder(ctx echo.Context) error {
+	if s.storeOrders == nil {
+		return echo.NewHTTPError(http.StatusNotImplemented)
+	}
+	return s.storeOrders.PlaceOrder(ctx)
+}
+
+func (s *tagServers) Health(ctx echo.Context) error {
+	if s.untagged == nil {
+		return echo.NewHTTPError(http.StatusNotImplemented)
+	}
+	return s.untagged.Health(ctx)
+}
+
+// RegisterPetsHandlers adds the routes of the operations tagged "pets" to
+// the EchoRouter, leaving out the routes of every other tag.
+func RegisterPetsHandlers(router EchoRouter, si PetsServerInterface) {
+	RegisterPetsHandlersWithBaseURL(router, si, "")
+}
+
+// RegisterPetsHandlersWithBaseURL is like RegisterPetsHandlers, and
+// prepends baseURL to the paths.
+func RegisterPetsHandlersWithBaseURL(router EchoRouter, si PetsServerInterface, baseURL string) {
+	server := &tagServers{pets: si}
+	router.GET(baseURL+"/pets", NewListPetsHandler(server))
+	router.GET(baseURL+"/pets/:id", NewGetPetHandler(server))
+}
+
+// RegisterStoreOrdersHandlers adds the routes of the operations tagged "store orders" to
+// the EchoRouter, leaving out the routes of every other tag.
+func RegisterStoreOrdersHandlers(router EchoRouter, si StoreOrdersServerInterface) {
+	RegisterStoreOrdersHandlersWithBaseURL(router, si, "")
+}
+
+// RegisterStoreOrdersHandlersWithBaseURL is like RegisterStoreOrdersHandlers, and
+// prepends baseURL to the paths.
+func RegisterStoreOrdersHandlersWithBaseURL(router EchoRouter, si StoreOrdersServerInterface, baseURL string) {
+	server := &tagServers{storeOrders: si}
+	router.POST(baseURL+"/orders", NewPlaceOrderHandler(server))
+}
+
+// RegisterUntaggedHandlers adds the routes of the operations without tags to
+// the EchoRouter, leaving out the routes of every other tag.
+func RegisterUntaggedHandlers(router EchoRouter, si UntaggedServerInterface) {
+	RegisterUntaggedHandlersWithBaseURL(router, si, "")
+}
+
+// RegisterUntaggedHandlersWithBaseURL is like RegisterUntaggedHandlers, and
+// prepends baseURL to the paths.
+func RegisterUntaggedHandlersWithBaseURL(router EchoRouter, si UntaggedServerInterface, baseURL string) {
+	server := &tagServers{untagged: si}
+	router.GET(baseURL+"/health", NewHealthHandler(server))
+}
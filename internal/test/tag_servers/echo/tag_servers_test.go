@@ -0,0 +1,57 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type pets struct{}
+
+func (pets) ListPets(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, []string{"Fido"})
+}
+
+func (pets) GetPet(ctx echo.Context, id int) error {
+	return ctx.JSON(http.StatusOK, id)
+}
+
+type orders struct{}
+
+func (orders) PlaceOrder(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func serve(e *echo.Echo, method, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	return rec
+}
+
+func TestNewServerFromTags(t *testing.T) {
+	e := echo.New()
+	RegisterHandlers(e, NewServerFromTags(pets{}, orders{}, nil))
+
+	rec := serve(e, http.MethodGet, "/pets/7")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, "7", rec.Body.String())
+	assert.Equal(t, http.StatusNoContent, serve(e, http.MethodPost, "/orders").Code)
+	// The untagged operations have no implementation.
+	assert.Equal(t, http.StatusNotImplemented, serve(e, http.MethodGet, "/health").Code)
+}
+
+func TestTagHandlers(t *testing.T) {
+	e := echo.New()
+	RegisterPetsHandlers(e, pets{})
+	RegisterStoreOrdersHandlersWithBaseURL(e, orders{}, "/store")
+
+	assert.JSONEq(t, `["Fido"]`, serve(e, http.MethodGet, "/pets").Body.String())
+	assert.JSONEq(t, "7", serve(e, http.MethodGet, "/pets/7").Body.String())
+	assert.Equal(t, http.StatusNoContent, serve(e, http.MethodPost, "/store/orders").Code)
+	// The routes of the tags which weren't registered aren't added.
+	assert.Equal(t, http.StatusNotFound, serve(e, http.MethodGet, "/health").Code)
+	assert.Equal(t, http.StatusNotFound, serve(e, http.MethodPost, "/orders").Code)
+}
@@ -0,0 +1,237 @@
+package testharness
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofiber/fiber/v2"
+	"github.com/kataras/iris/v12"
+	"github.com/labstack/echo/v4"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/v2/internal/test/test_harness/chi"
+	echoapi "github.com/deepmap/oapi-codegen/v2/internal/test/test_harness/echo"
+	fiberapi "github.com/deepmap/oapi-codegen/v2/internal/test/test_harness/fiber"
+	ginapi "github.com/deepmap/oapi-codegen/v2/internal/test/test_harness/gin"
+	"github.com/deepmap/oapi-codegen/v2/internal/test/test_harness/gorilla"
+	irisapi "github.com/deepmap/oapi-codegen/v2/internal/test/test_harness/iris"
+)
+
+type chiServer struct{}
+
+func (chiServer) GetPet(ctx context.Context, request chi.GetPetRequestObject) (chi.GetPetResponseObject, error) {
+	if request.Id == 0 {
+		return chi.GetPet404JSONResponse{Message: "no such pet"}, nil
+	}
+	name := "pet"
+	if request.Verbose != nil && *request.Verbose {
+		name = "verbose pet"
+	}
+	return chi.GetPet200JSONResponse{Id: request.Id, Name: name}, nil
+}
+
+func (chiServer) AddPet(ctx context.Context, request chi.AddPetRequestObject) (chi.AddPetResponseObject, error) {
+	return chi.AddPet201JSONResponse(*request.Body), nil
+}
+
+func TestChiTestClient(t *testing.T) {
+	ctx := context.Background()
+	var operations []string
+	client := chi.NewTestClient(chiServer{},
+		chi.WithTestServerStrictMiddlewares(func(f strictnethttp.StrictHTTPHandlerFunc, operationID string) strictnethttp.StrictHTTPHandlerFunc {
+			operations = append(operations, operationID)
+			return f
+		}),
+		chi.WithTestServerMiddlewares(func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Middleware", "chi")
+				next.ServeHTTP(w, r)
+			})
+		}),
+	)
+
+	verbose := true
+	rsp, err := client.GetPetWithResponse(ctx, 1, &chi.GetPetParams{Verbose: &verbose})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, chi.Pet{Id: 1, Name: "verbose pet"}, *rsp.JSON200)
+	assert.Equal(t, "chi", rsp.HTTPResponse.Header.Get("X-Middleware"))
+
+	rsp, err = client.GetPetWithResponse(ctx, 0, nil)
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON404)
+	assert.Equal(t, "no such pet", rsp.JSON404.Message)
+
+	added, err := client.AddPetWithResponse(ctx, chi.Pet{Id: 2, Name: "new pet"})
+	require.NoError(t, err)
+	require.NotNil(t, added.JSON201)
+	assert.Equal(t, chi.Pet{Id: 2, Name: "new pet"}, *added.JSON201)
+
+	assert.Equal(t, []string{"GetPet", "GetPet", "AddPet"}, operations)
+}
+
+func TestChiTestClientErrorHandler(t *testing.T) {
+	client := chi.NewTestClient(chiServer{},
+		chi.WithTestServerErrorHandler(func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusTeapot)
+		}),
+		chi.WithTestClientOptions(chi.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			req.URL.RawQuery = "verbose=maybe"
+			return nil
+		})),
+	)
+
+	rsp, err := client.GetPetWithResponse(context.Background(), 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTeapot, rsp.StatusCode())
+}
+
+type gorillaServer struct{}
+
+func (gorillaServer) GetPet(ctx context.Context, request gorilla.GetPetRequestObject) (gorilla.GetPetResponseObject, error) {
+	return gorilla.GetPet200JSONResponse{Id: request.Id, Name: "pet"}, nil
+}
+
+func (gorillaServer) AddPet(ctx context.Context, request gorilla.AddPetRequestObject) (gorilla.AddPetResponseObject, error) {
+	return gorilla.AddPet201JSONResponse(*request.Body), nil
+}
+
+func TestGorillaTestClient(t *testing.T) {
+	client := gorilla.NewTestClient(gorillaServer{})
+
+	rsp, err := client.GetPetWithResponse(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, gorilla.Pet{Id: 1, Name: "pet"}, *rsp.JSON200)
+
+	added, err := client.AddPetWithResponse(context.Background(), gorilla.Pet{Id: 2, Name: "new pet"})
+	require.NoError(t, err)
+	require.NotNil(t, added.JSON201)
+	assert.Equal(t, gorilla.Pet{Id: 2, Name: "new pet"}, *added.JSON201)
+}
+
+type echoServer struct{}
+
+func (echoServer) GetPet(ctx context.Context, request echoapi.GetPetRequestObject) (echoapi.GetPetResponseObject, error) {
+	return echoapi.GetPet200JSONResponse{Id: request.Id, Name: "pet"}, nil
+}
+
+func (echoServer) AddPet(ctx context.Context, request echoapi.AddPetRequestObject) (echoapi.AddPetResponseObject, error) {
+	return echoapi.AddPet201JSONResponse(*request.Body), nil
+}
+
+func TestEchoTestClient(t *testing.T) {
+	client := echoapi.NewTestClient(echoServer{},
+		echoapi.WithTestServerMiddlewares(func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(ctx echo.Context) error {
+				ctx.Response().Header().Set("X-Middleware", "echo")
+				return next(ctx)
+			}
+		}),
+	)
+
+	rsp, err := client.GetPetWithResponse(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, echoapi.Pet{Id: 1, Name: "pet"}, *rsp.JSON200)
+	assert.Equal(t, "echo", rsp.HTTPResponse.Header.Get("X-Middleware"))
+
+	added, err := client.AddPetWithResponse(context.Background(), echoapi.Pet{Id: 2, Name: "new pet"})
+	require.NoError(t, err)
+	require.NotNil(t, added.JSON201)
+	assert.Equal(t, echoapi.Pet{Id: 2, Name: "new pet"}, *added.JSON201)
+}
+
+type ginServer struct{}
+
+func (ginServer) GetPet(ctx context.Context, request ginapi.GetPetRequestObject) (ginapi.GetPetResponseObject, error) {
+	return ginapi.GetPet200JSONResponse{Id: request.Id, Name: "pet"}, nil
+}
+
+func (ginServer) AddPet(ctx context.Context, request ginapi.AddPetRequestObject) (ginapi.AddPetResponseObject, error) {
+	return ginapi.AddPet201JSONResponse(*request.Body), nil
+}
+
+func TestGinTestClient(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+	client := ginapi.NewTestClient(ginServer{},
+		ginapi.WithTestServerMiddlewares(func(c *gin.Context) {
+			c.Header("X-Middleware", "gin")
+		}),
+	)
+
+	rsp, err := client.GetPetWithResponse(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, ginapi.Pet{Id: 1, Name: "pet"}, *rsp.JSON200)
+	assert.Equal(t, "gin", rsp.HTTPResponse.Header.Get("X-Middleware"))
+
+	added, err := client.AddPetWithResponse(context.Background(), ginapi.Pet{Id: 2, Name: "new pet"})
+	require.NoError(t, err)
+	require.NotNil(t, added.JSON201)
+	assert.Equal(t, ginapi.Pet{Id: 2, Name: "new pet"}, *added.JSON201)
+}
+
+type fiberServer struct{}
+
+func (fiberServer) GetPet(ctx context.Context, request fiberapi.GetPetRequestObject) (fiberapi.GetPetResponseObject, error) {
+	return fiberapi.GetPet200JSONResponse{Id: request.Id, Name: "pet"}, nil
+}
+
+func (fiberServer) AddPet(ctx context.Context, request fiberapi.AddPetRequestObject) (fiberapi.AddPetResponseObject, error) {
+	return fiberapi.AddPet201JSONResponse(*request.Body), nil
+}
+
+func TestFiberTestClient(t *testing.T) {
+	client := fiberapi.NewTestClient(fiberServer{},
+		fiberapi.WithTestServerMiddlewares(func(c *fiber.Ctx) error {
+			c.Set("X-Middleware", "fiber")
+			return c.Next()
+		}),
+	)
+
+	rsp, err := client.GetPetWithResponse(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, fiberapi.Pet{Id: 1, Name: "pet"}, *rsp.JSON200)
+	assert.Equal(t, "fiber", rsp.HTTPResponse.Header.Get("X-Middleware"))
+
+	added, err := client.AddPetWithResponse(context.Background(), fiberapi.Pet{Id: 2, Name: "new pet"})
+	require.NoError(t, err)
+	require.NotNil(t, added.JSON201)
+	assert.Equal(t, fiberapi.Pet{Id: 2, Name: "new pet"}, *added.JSON201)
+}
+
+type irisServer struct{}
+
+func (irisServer) GetPet(ctx context.Context, request irisapi.GetPetRequestObject) (irisapi.GetPetResponseObject, error) {
+	return irisapi.GetPet200JSONResponse{Id: request.Id, Name: "pet"}, nil
+}
+
+func (irisServer) AddPet(ctx context.Context, request irisapi.AddPetRequestObject) (irisapi.AddPetResponseObject, error) {
+	return irisapi.AddPet201JSONResponse(*request.Body), nil
+}
+
+func TestIrisTestClient(t *testing.T) {
+	client := irisapi.NewTestClient(irisServer{},
+		irisapi.WithTestServerMiddlewares(func(ctx iris.Context) {
+			ctx.Header("X-Middleware", "iris")
+			ctx.Next()
+		}),
+	)
+
+	rsp, err := client.GetPetWithResponse(context.Background(), 1, nil)
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, irisapi.Pet{Id: 1, Name: "pet"}, *rsp.JSON200)
+	assert.Equal(t, "iris", rsp.HTTPResponse.Header.Get("X-Middleware"))
+
+	added, err := client.AddPetWithResponse(context.Background(), irisapi.Pet{Id: 2, Name: "new pet"})
+	require.NoError(t, err)
+	require.NotNil(t, added.JSON201)
+	assert.Equal(t, irisapi.Pet{Id: 2, Name: "new pet"}, *added.JSON201)
+}
@@ -0,0 +1,58 @@
+package typeinference
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInferredTypes(t *testing.T) {
+	// Each of these is inferred from the keywords of a schema lacking a type.
+	var thing Thing
+	var _ string = thing.Id
+	var _ *[]string = thing.Tags
+	var _ *ThingColor = thing.Color
+	var _ *map[string]string = thing.Labels
+	var _ *[]interface{} = thing.Point
+	var _ []Thing = Things{}
+	var _ []int = Sizes{}
+	var _ Shade = Dark
+	var _ string = NamedThing{}.Name
+
+	var decoded NamedThing
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"1","name":"one","owner":{"name":"me"},"color":null,"labels":{"a":"b"},"point":[1,2]}`), &decoded))
+	assert.Equal(t, "one", decoded.Name)
+	assert.Equal(t, "me", *decoded.Owner.Name)
+	assert.Nil(t, decoded.Color)
+	assert.Equal(t, map[string]string{"a": "b"}, *decoded.Labels)
+	assert.True(t, ThingColorGreen.IsValid())
+}
+
+type recordingDoer struct {
+	req *http.Request
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.req = req
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func TestInferredParameterTypes(t *testing.T) {
+	doer := &recordingDoer{}
+	client, err := NewClient("https://api.example.com", WithHTTPClient(doer))
+	require.NoError(t, err)
+
+	tags := []string{"a", "b"}
+	name := "widget"
+	filter := struct {
+		Name *string `json:"name,omitempty"`
+	}{Name: &name}
+	_, err = client.ListThings(context.Background(), &ListThingsParams{Tags: &tags, Filter: &filter})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, doer.req.URL.Query()["tags"])
+	assert.Equal(t, "widget", doer.req.URL.Query().Get("name"))
+}
@@ -0,0 +1,536 @@
+// Package uniqueitems provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package uniqueitems
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for Color.
+const (
+	Blue  Color = "blue"
+	Green Color = "green"
+	Red   Color = "red"
+)
+
+// IsValid reports whether v is one of the values of Color.
+func (v Color) IsValid() bool {
+	switch v {
+	case Blue, Green, Red:
+		return true
+	default:
+		return false
+	}
+}
+
+// Color defines model for Color.
+type Color string
+
+// Owner defines model for Owner.
+type Owner struct {
+	Phones *[]string `json:"phones,omitempty"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Colors    []Color             `json:"colors"`
+	Name      string              `json:"name"`
+	Nicknames *OrderedSet[string] `json:"nicknames,omitempty"`
+	Owner     *Owner              `json:"owner,omitempty"`
+	Tags      *Tags               `json:"tags,omitempty"`
+	Toys      *[]Toy              `json:"toys,omitempty"`
+	Vet       *struct {
+		Specialties *[]string `json:"specialties,omitempty"`
+	} `json:"vet,omitempty"`
+}
+
+// Tags defines model for Tags.
+type Tags = []string
+
+// Toy defines model for Toy.
+type Toy struct {
+	Name string `json:"name"`
+	Size *int   `json:"size,omitempty"`
+}
+
+// ListPetsParams defines parameters for ListPets.
+type ListPetsParams struct {
+	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+	Ids  []int     `form:"ids" json:"ids"`
+}
+
+// AddPetJSONRequestBody defines body for AddPet for application/json ContentType.
+type AddPetJSONRequestBody = Pet
+
+// Validate checks the constraints of the spec which the Owner type
+// doesn't enforce by itself.
+func (t Owner) Validate() error {
+	if t.Phones != nil {
+		if err := uniqueItems("phones", *t.Phones); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the constraints of the spec which the Pet type
+// doesn't enforce by itself.
+func (t Pet) Validate() error {
+	if err := uniqueItems("colors", t.Colors); err != nil {
+		return err
+	}
+	if t.Owner != nil {
+		if err := t.Owner.Validate(); err != nil {
+			return fmt.Errorf("owner: %w", err)
+		}
+	}
+	if t.Tags != nil {
+		if err := uniqueItems("tags", *t.Tags); err != nil {
+			return err
+		}
+	}
+	if t.Toys != nil {
+		if err := uniqueJSONItems("toys", *t.Toys); err != nil {
+			return err
+		}
+	}
+	if t.Vet != nil {
+		if t.Vet.Specialties != nil {
+			if err := uniqueItems("vet.specialties", *t.Vet.Specialties); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the constraints of the spec which the ListPetsParams type
+// doesn't enforce by itself.
+func (t ListPetsParams) Validate() error {
+	if t.Tags != nil {
+		if err := uniqueItems("tags", *t.Tags); err != nil {
+			return err
+		}
+	}
+	if err := uniqueItems("ids", t.Ids); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// uniqueItems returns an error naming the first item of the array called
+// name which repeats a previous one.
+func uniqueItems[T comparable](name string, items []T) error {
+	seen := make(map[T]struct{}, len(items))
+	for _, item := range items {
+		if _, found := seen[item]; found {
+			buf, _ := json.Marshal(item)
+			return fmt.Errorf("%s has duplicate item %s", name, buf)
+		}
+		seen[item] = struct{}{}
+	}
+	return nil
+}
+
+// uniqueJSONItems is like uniqueItems for items which aren't comparable,
+// which are compared by their JSON encoding instead.
+func uniqueJSONItems[T any](name string, items []T) error {
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		buf, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if _, found := seen[string(buf)]; found {
+			return fmt.Errorf("%s has duplicate item %s", name, buf)
+		}
+		seen[string(buf)] = struct{}{}
+	}
+	return nil
+}
+
+// IsZero reports whether every field of the Owner is unset.
+func (t Owner) IsZero() bool {
+	return t.Phones == nil
+}
+
+// IsZero reports whether every field of the Pet is unset.
+func (t Pet) IsZero() bool {
+	return len(t.Colors) == 0 &&
+		t.Name == "" &&
+		t.Nicknames == nil &&
+		t.Owner == nil &&
+		t.Tags == nil &&
+		t.Toys == nil &&
+		t.Vet == nil
+}
+
+// IsZero reports whether every field of the Toy is unset.
+func (t Toy) IsZero() bool {
+	return t.Name == "" &&
+		t.Size == nil
+}
+
+// IsZero reports whether every field of the ListPetsParams is unset.
+func (t ListPetsParams) IsZero() bool {
+	return t.Tags == nil &&
+		len(t.Ids) == 0
+}
+
+// OrderedSet holds the unique items of an array generated with x-go-set. It
+// keeps the order items were added in, which is the order they're encoded
+// in, so that it round-trips through JSON. The zero value is an empty set.
+type OrderedSet[T comparable] struct {
+	index map[T]int
+	items []T
+}
+
+// NewOrderedSet returns a set of the given items, ignoring repeated ones.
+func NewOrderedSet[T comparable](items ...T) OrderedSet[T] {
+	var s OrderedSet[T]
+	for _, item := range items {
+		s.Add(item)
+	}
+	return s
+}
+
+// Add adds item to the end of the set, reporting whether it wasn't present.
+func (s *OrderedSet[T]) Add(item T) bool {
+	if s.Has(item) {
+		return false
+	}
+	if s.index == nil {
+		s.index = make(map[T]int)
+	}
+	s.index[item] = len(s.items)
+	s.items = append(s.items, item)
+	return true
+}
+
+// Has reports whether item is in the set.
+func (s OrderedSet[T]) Has(item T) bool {
+	_, found := s.index[item]
+	return found
+}
+
+// Remove removes item from the set, reporting whether it was present.
+func (s *OrderedSet[T]) Remove(item T) bool {
+	i, found := s.index[item]
+	if !found {
+		return false
+	}
+	delete(s.index, item)
+	s.items = append(s.items[:i], s.items[i+1:]...)
+	for j := i; j < len(s.items); j++ {
+		s.index[s.items[j]] = j
+	}
+	return true
+}
+
+// Len returns the number of items in the set.
+func (s OrderedSet[T]) Len() int {
+	return len(s.items)
+}
+
+// Items returns the items of the set, in the order they were added in.
+func (s OrderedSet[T]) Items() []T {
+	return append([]T(nil), s.items...)
+}
+
+// MarshalJSON encodes the set as an array.
+func (s OrderedSet[T]) MarshalJSON() ([]byte, error) {
+	if s.items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.items)
+}
+
+// UnmarshalJSON decodes an array into the set, failing when an item is
+// repeated, since the schema requires unique items.
+func (s *OrderedSet[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	*s = OrderedSet[T]{}
+	for _, item := range items {
+		if !s.Add(item) {
+			buf, _ := json.Marshal(item)
+			return fmt.Errorf("duplicate item %s", buf)
+		}
+	}
+	return nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /pets)
+	ListPets(w http.ResponseWriter, r *http.Request, params ListPetsParams)
+
+	// (POST /pets)
+	AddPet(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /pets)
+func (_ Unimplemented) ListPets(w http.ResponseWriter, r *http.Request, params ListPetsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /pets)
+func (_ Unimplemented) AddPet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListPets operation middleware
+func (siw *ServerInterfaceWrapper) ListPets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPetsParams
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	if params.Tags != nil {
+		seen := make(map[string]bool)
+		for _, item := range *params.Tags {
+			key := fmt.Sprint(item)
+			if seen[key] {
+				err = fmt.Errorf("duplicate value %q", key)
+				break
+			}
+			seen[key] = true
+		}
+	}
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "ids" -------------
+
+	if paramValue := r.URL.Query().Get("ids"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ids"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", false, true, "ids", r.URL.Query(), &params.Ids)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ids", Err: err})
+		return
+	}
+
+	{
+		seen := make(map[string]bool)
+		for _, item := range params.Ids {
+			key := fmt.Sprint(item)
+			if seen[key] {
+				err = fmt.Errorf("duplicate value %q", key)
+				break
+			}
+			seen[key] = true
+		}
+	}
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ids", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPets(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddPet operation middleware
+func (siw *ServerInterfaceWrapper) AddPet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddPet(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// NewServerInterfaceWrapper returns the wrapper converting the requests of
+// the operations of si to parameters, with options.Middlewares and
+// options.ErrorHandlerFunc, defaulting to a 400 with the error message.
+func NewServerInterfaceWrapper(si ServerInterface, options ChiServerOptions) *ServerInterfaceWrapper {
+	errorHandlerFunc := options.ErrorHandlerFunc
+	if errorHandlerFunc == nil {
+		errorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	return &ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   errorHandlerFunc,
+	}
+}
+
+// NewListPetsHandler returns the handler HandlerWithOptions routes the
+// ListPets operation to, so that it can be mounted on its own.
+func NewListPetsHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).ListPets
+}
+
+// NewAddPetHandler returns the handler HandlerWithOptions routes the
+// AddPet operation to, so that it can be mounted on its own.
+func NewAddPetHandler(si ServerInterface, options ChiServerOptions) http.HandlerFunc {
+	return NewServerInterfaceWrapper(si, options).AddPet
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pets", NewListPetsHandler(si, options))
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pets", NewAddPetHandler(si, options))
+	})
+
+	return r
+}
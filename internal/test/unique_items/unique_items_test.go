@@ -0,0 +1,113 @@
+package uniqueitems
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	valid := func() Pet {
+		return Pet{
+			Name:   "Fido",
+			Colors: []Color{Red, Blue},
+			Tags:   &Tags{"good", "boy"},
+			Toys:   &[]Toy{{Name: "ball"}, {Name: "bone"}},
+			Owner:  &Owner{Phones: &[]string{"1", "2"}},
+		}
+	}
+	require.NoError(t, valid().Validate())
+
+	pet := valid()
+	pet.Colors = []Color{Red, Blue, Red}
+	assert.EqualError(t, pet.Validate(), `colors has duplicate item "red"`)
+
+	pet = valid()
+	pet.Tags = &Tags{"good", "good"}
+	assert.EqualError(t, pet.Validate(), `tags has duplicate item "good"`)
+
+	pet = valid()
+	pet.Toys = &[]Toy{{Name: "ball"}, {Name: "ball"}}
+	assert.EqualError(t, pet.Validate(), `toys has duplicate item {"name":"ball"}`)
+
+	pet = valid()
+	pet.Owner.Phones = &[]string{"1", "1"}
+	assert.EqualError(t, pet.Validate(), `owner: phones has duplicate item "1"`)
+
+	pet = valid()
+	specialties := []string{"dogs", "dogs"}
+	pet.Vet = &struct {
+		Specialties *[]string `json:"specialties,omitempty"`
+	}{Specialties: &specialties}
+	assert.EqualError(t, pet.Validate(), `vet.specialties has duplicate item "dogs"`)
+}
+
+func TestOrderedSet(t *testing.T) {
+	s := NewOrderedSet("b", "a", "b")
+	assert.Equal(t, []string{"b", "a"}, s.Items())
+	assert.False(t, s.Add("a"))
+	assert.True(t, s.Add("c"))
+	assert.True(t, s.Has("c"))
+	assert.True(t, s.Remove("b"))
+	assert.False(t, s.Remove("b"))
+	assert.False(t, s.Has("b"))
+	assert.Equal(t, 2, s.Len())
+
+	pet := Pet{Name: "Fido", Colors: []Color{}, Nicknames: &s}
+	buf, err := json.Marshal(pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Fido","colors":[],"nicknames":["a","c"]}`, string(buf))
+
+	var decoded Pet
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"Fido","colors":[],"nicknames":["z","y","x"]}`), &decoded))
+	require.NotNil(t, decoded.Nicknames)
+	assert.Equal(t, []string{"z", "y", "x"}, decoded.Nicknames.Items())
+
+	err = json.Unmarshal([]byte(`{"name":"Fido","colors":[],"nicknames":["z","z"]}`), &decoded)
+	assert.ErrorContains(t, err, `duplicate item "z"`)
+
+	var empty OrderedSet[int]
+	buf, err = json.Marshal(empty)
+	require.NoError(t, err)
+	assert.Equal(t, "[]", string(buf))
+}
+
+type server struct {
+	Unimplemented
+	params *ListPetsParams
+}
+
+func (s *server) ListPets(w http.ResponseWriter, r *http.Request, params ListPetsParams) {
+	s.params = &params
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestUniqueQueryParameters(t *testing.T) {
+	s := &server{}
+	h := Handler(s)
+
+	get := func(query string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets?"+query, nil))
+		return rec
+	}
+
+	rec := get("ids=1,2&tags=a&tags=b")
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, []int{1, 2}, s.params.Ids)
+	assert.Equal(t, &[]string{"a", "b"}, s.params.Tags)
+
+	rec = get("ids=1,1")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "ids")
+	assert.Contains(t, rec.Body.String(), `duplicate value "1"`)
+
+	rec = get("ids=1&tags=a&tags=a")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "tags")
+	assert.Contains(t, rec.Body.String(), `duplicate value "a"`)
+}
@@ -0,0 +1,76 @@
+package unknownfields
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnknownFieldsAreRejected(t *testing.T) {
+	var pet Pet
+	err := json.Unmarshal([]byte(`{"id": 1, "name": "Rex", "size": "L", "color": "brown"}`), &pet)
+	assert.EqualError(t, err, "unknown fields in Pet: color, size")
+
+	// allOf disallows additional properties when any of its schemas does.
+	var newPet NewPet
+	err = json.Unmarshal([]byte(`{"name": "Rex", "tag": "dog", "size": "L"}`), &newPet)
+	assert.EqualError(t, err, "unknown fields in NewPet: size")
+
+	var body SearchPetsJSONRequestBody
+	err = json.Unmarshal([]byte(`{"name": "Rex", "size": "L"}`), &body)
+	assert.EqualError(t, err, "unknown fields in SearchPetsJSONRequestBody: size")
+}
+
+func TestKnownFieldsAreDecoded(t *testing.T) {
+	var pet Pet
+	err := json.Unmarshal([]byte(`{"id": 1, "name": "Rex", "owner": {"name": "Ann", "age": 30}}`), &pet)
+	require.NoError(t, err)
+	assert.Equal(t, Pet{Id: 1, Name: "Rex", Owner: &Owner{Name: ptr("Ann")}}, pet)
+
+	var newPet NewPet
+	err = json.Unmarshal([]byte(`{"name": "Rex", "tag": "dog"}`), &newPet)
+	require.NoError(t, err)
+	assert.Equal(t, NewPet{Name: "Rex", Tag: ptr("dog")}, newPet)
+}
+
+type server struct{}
+
+func (server) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	return AddPet200JSONResponse{Id: 1, Name: request.Body.Name}, nil
+}
+
+func (server) SearchPets(ctx context.Context, request SearchPetsRequestObject) (SearchPetsResponseObject, error) {
+	return SearchPets204Response{}, nil
+}
+
+func TestStrictServerRejectsUnknownFields(t *testing.T) {
+	handler := Handler(NewStrictHandler(server{}, nil))
+
+	for path, body := range map[string]string{
+		"/pets":        `{"name": "Rex", "tag": "dog", "size": "L", "color": "brown"}`,
+		"/pets/search": `{"name": "Rex", "size": "L", "color": "brown"}`,
+	} {
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code, path)
+		assert.Contains(t, rr.Body.String(), "color, size", path)
+	}
+
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"name": "Rex"}`))
+	req.Header.Set("Content-Type", "application/json")
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
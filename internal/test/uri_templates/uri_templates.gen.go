@@ -0,0 +1,247 @@
+// Package uritemplates provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.0.0-00010101000000-000000000000 DO NOT EDIT.
+package uritemplates
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListPetPhotosParams defines parameters for ListPetPhotos.
+type ListPetPhotosParams struct {
+	Limit *int32    `form:"limit,omitempty" json:"limit,omitempty"`
+	Tags  *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// ExpandListPetPhotosNextLink expands the x-next-link URI template of ListPetPhotos,
+// "{+base}/pets/{petId}/photos{?limit}{&cursor}".
+// Nil pointers are undefined variables, left out of the expansion.
+func ExpandListPetPhotosNextLink(base string, petId int64, limit *int32, cursor string) string {
+	// The template was validated when generating this function.
+	uri, _ := expandURITemplate("{+base}/pets/{petId}/photos{?limit}{&cursor}", map[string]interface{}{
+		"base":   base,
+		"petId":  petId,
+		"limit":  limit,
+		"cursor": cursor,
+	})
+	return uri
+}
+
+// ExpandListPetPhotosSelfLink expands the x-self-link URI template of ListPetPhotos,
+// "/pets/{petId}/photos{?limit,tags}".
+// Nil pointers are undefined variables, left out of the expansion.
+func ExpandListPetPhotosSelfLink(petId int64, limit *int32, tags *[]string) string {
+	// The template was validated when generating this function.
+	uri, _ := expandURITemplate("/pets/{petId}/photos{?limit,tags}", map[string]interface{}{
+		"petId": petId,
+		"limit": limit,
+		"tags":  tags,
+	})
+	return uri
+}
+
+// uriTemplateOperator describes the expansion of the expressions of a URI
+// template starting with an operator, as listed in appendix A of RFC 6570.
+type uriTemplateOperator struct {
+	first    string
+	sep      string
+	named    bool
+	ifEmpty  string
+	reserved bool
+}
+
+var uriTemplateOperators = map[byte]uriTemplateOperator{
+	'+': {first: "", sep: ",", reserved: true},
+	'#': {first: "#", sep: ",", reserved: true},
+	'.': {first: ".", sep: "."},
+	'/': {first: "/", sep: "/"},
+	';': {first: ";", sep: ";", named: true},
+	'?': {first: "?", sep: "&", named: true, ifEmpty: "="},
+	'&': {first: "&", sep: "&", named: true, ifEmpty: "="},
+}
+
+// expandURITemplate expands template, an RFC 6570 URI template of level 3,
+// along with the prefix and explode modifiers of level 4, with values, which
+// are either nil, or pointers and slices to them, which are undefined,
+// strings, or values formatted as fmt.Sprint would, except times, formatted
+// as RFC 3339. An expression which can't be expanded is copied as is, and the
+// first such error returned.
+func expandURITemplate(template string, values map[string]interface{}) (string, error) {
+	var b strings.Builder
+	var firstErr error
+	for {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			break
+		}
+		b.WriteString(encodeURITemplateValue(template[:start], true))
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			template = template[start:]
+			if firstErr == nil {
+				firstErr = fmt.Errorf("unterminated expression in URI template")
+			}
+			b.WriteString(template)
+			return b.String(), firstErr
+		}
+		expression := template[start+1 : start+end]
+		template = template[start+end+1:]
+
+		expanded, err := expandURITemplateExpression(expression, values)
+		if err != nil {
+			expanded = "{" + expression + "}"
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		b.WriteString(expanded)
+	}
+	b.WriteString(encodeURITemplateValue(template, true))
+	return b.String(), firstErr
+}
+
+// expandURITemplateExpression expands expression, the content of the braces
+// of an expression of a URI template, with values.
+func expandURITemplateExpression(expression string, values map[string]interface{}) (string, error) {
+	op := uriTemplateOperator{sep: ","}
+	if expression != "" {
+		if o, ok := uriTemplateOperators[expression[0]]; ok {
+			op = o
+			expression = expression[1:]
+		}
+	}
+
+	var b strings.Builder
+	first := true
+	for _, varspec := range strings.Split(expression, ",") {
+		name, explode, prefix := varspec, false, 0
+		if strings.HasSuffix(name, "*") {
+			name, explode = strings.TrimSuffix(name, "*"), true
+		} else if i := strings.IndexByte(name, ':'); i >= 0 {
+			n, err := strconv.Atoi(name[i+1:])
+			if err != nil || n < 1 || n > 9999 {
+				return "", fmt.Errorf("invalid prefix modifier in URI template expression {%s}", expression)
+			}
+			name, prefix = name[:i], n
+		}
+		if name == "" || strings.Trim(name, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_.%") != "" {
+			return "", fmt.Errorf("invalid URI template expression {%s}", expression)
+		}
+
+		items, isList, defined := uriTemplateValue(values[name])
+		if !defined {
+			continue
+		}
+		if first {
+			b.WriteString(op.first)
+			first = false
+		} else {
+			b.WriteString(op.sep)
+		}
+
+		switch {
+		case !isList:
+			value := items[0]
+			if runes := []rune(value); prefix > 0 && len(runes) > prefix {
+				value = string(runes[:prefix])
+			}
+			if op.named {
+				b.WriteString(name)
+				if value == "" {
+					b.WriteString(op.ifEmpty)
+					continue
+				}
+				b.WriteByte('=')
+			}
+			b.WriteString(encodeURITemplateValue(value, op.reserved))
+		case explode:
+			for i, item := range items {
+				if i > 0 {
+					b.WriteString(op.sep)
+				}
+				if op.named {
+					b.WriteString(name)
+					if item == "" {
+						b.WriteString(op.ifEmpty)
+						continue
+					}
+					b.WriteByte('=')
+				}
+				b.WriteString(encodeURITemplateValue(item, op.reserved))
+			}
+		default:
+			if op.named {
+				b.WriteString(name + "=")
+			}
+			for i, item := range items {
+				if i > 0 {
+					b.WriteByte(',')
+				}
+				b.WriteString(encodeURITemplateValue(item, op.reserved))
+			}
+		}
+	}
+	return b.String(), nil
+}
+
+// uriTemplateValue returns value as strings, along with whether it's a list,
+// and whether it's defined, which nil values, nil pointers and empty lists
+// aren't.
+func uriTemplateValue(value interface{}) ([]string, bool, bool) {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, false, false
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil, false, false
+	}
+	if (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type().Elem().Kind() != reflect.Uint8 {
+		if v.Len() == 0 {
+			return nil, true, false
+		}
+		items := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			item, _, defined := uriTemplateValue(v.Index(i).Interface())
+			if defined {
+				items = append(items, item[0])
+			}
+		}
+		return items, true, len(items) != 0
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return []string{t.Format(time.RFC3339Nano)}, false, true
+	}
+	return []string{fmt.Sprint(v.Interface())}, false, true
+}
+
+// encodeURITemplateValue percent-encodes the characters of s other than the
+// unreserved ones, and, when reserved is set, the reserved ones and the
+// percent-encoded triplets, as the "+" and "#" operators allow them.
+func encodeURITemplateValue(s string, reserved bool) string {
+	const hex = "0123456789ABCDEFabcdef"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || strings.IndexByte("-._~", c) >= 0:
+			b.WriteByte(c)
+		case reserved && strings.IndexByte(":/?#[]@!$&'()*+,;=", c) >= 0:
+			b.WriteByte(c)
+		case reserved && c == '%' && i+2 < len(s) && strings.IndexByte(hex, s[i+1]) >= 0 && strings.IndexByte(hex, s[i+2]) >= 0:
+			b.WriteString(s[i : i+3])
+			i += 2
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&15])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,160 @@
+package uritemplates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestExpandHelpers(t *testing.T) {
+	assert.Equal(t, "/pets/12/photos", ExpandListPetPhotosSelfLink(12, nil, nil))
+	assert.Equal(t, "/pets/12/photos?limit=5&tags=a,b%20c", ExpandListPetPhotosSelfLink(12, ptr(int32(5)), &[]string{"a", "b c"}))
+
+	assert.Equal(t, "https://example.com/api/pets/12/photos?limit=5&cursor=x%2Fy",
+		ExpandListPetPhotosNextLink("https://example.com/api", 12, ptr(int32(5)), "x/y"))
+	assert.Equal(t, "/pets/12/photos&cursor=", ExpandListPetPhotosNextLink("", 12, nil, ""))
+}
+
+// The examples of section 3.2 of RFC 6570.
+func TestExpandURITemplate(t *testing.T) {
+	values := map[string]interface{}{
+		"count": []string{"one", "two", "three"},
+		"dom":   []string{"example", "com"},
+		"dub":   "me/too",
+		"hello": "Hello World!",
+		"half":  "50%",
+		"var":   "value",
+		"who":   "fred",
+		"base":  "http://example.com/home/",
+		"path":  "/foo/bar",
+		"list":  []string{"red", "green", "blue"},
+		"v":     6,
+		"x":     1024,
+		"y":     768,
+		"empty": "",
+		"undef": nil,
+		"nil":   (*string)(nil),
+		"none":  []string{},
+		"when":  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	for template, expected := range map[string]string{
+		// Level 1
+		"{var}":       "value",
+		"{hello}":     "Hello%20World%21",
+		"{half}":      "50%25",
+		"O{empty}X":   "OX",
+		"O{undef}X":   "OX",
+		"{x,y}":       "1024,768",
+		"{x,hello,y}": "1024,Hello%20World%21,768",
+		"?{x,empty}":  "?1024,",
+		"?{x,undef}":  "?1024",
+		"?{undef,y}":  "?768",
+		"{var:3}":     "val",
+		"{var:30}":    "value",
+		"{list}":      "red,green,blue",
+		"{list*}":     "red,green,blue",
+		"{nil}{none}": "",
+		"{when}":      "2024-01-02T03%3A04%3A05Z",
+		// Reserved expansion
+		"{+var}":              "value",
+		"{+hello}":            "Hello%20World!",
+		"{+half}":             "50%25",
+		"{base}index":         "http%3A%2F%2Fexample.com%2Fhome%2Findex",
+		"{+base}index":        "http://example.com/home/index",
+		"O{+empty}X":          "OX",
+		"{+path}/here":        "/foo/bar/here",
+		"here?ref={+path}":    "here?ref=/foo/bar",
+		"up{+path}{var}/here": "up/foo/barvalue/here",
+		"{+x,hello,y}":        "1024,Hello%20World!,768",
+		"{+path,x}/here":      "/foo/bar,1024/here",
+		"{+path:6}/here":      "/foo/b/here",
+		"{+list*}":            "red,green,blue",
+		// Fragment expansion
+		"{#var}":         "#value",
+		"{#hello}":       "#Hello%20World!",
+		"{#half}":        "#50%25",
+		"foo{#empty}":    "foo#",
+		"foo{#undef}":    "foo",
+		"{#x,hello,y}":   "#1024,Hello%20World!,768",
+		"{#path,x}/here": "#/foo/bar,1024/here",
+		"{#path:6}/here": "#/foo/b/here",
+		// Label expansion
+		"{.who}":      ".fred",
+		"{.who,who}":  ".fred.fred",
+		"{.half,who}": ".50%25.fred",
+		"www{.dom*}":  "www.example.com",
+		"X{.var}":     "X.value",
+		"X{.empty}":   "X.",
+		"X{.undef}":   "X",
+		"X{.var:3}":   "X.val",
+		"X{.list}":    "X.red,green,blue",
+		"X{.list*}":   "X.red.green.blue",
+		// Path segment expansion
+		"{/who}":          "/fred",
+		"{/who,who}":      "/fred/fred",
+		"{/half,who}":     "/50%25/fred",
+		"{/who,dub}":      "/fred/me%2Ftoo",
+		"{/var}":          "/value",
+		"{/var,empty}":    "/value/",
+		"{/var,undef}":    "/value",
+		"{/var,x}/here":   "/value/1024/here",
+		"{/var:1,var}":    "/v/value",
+		"{/list}":         "/red,green,blue",
+		"{/list*}":        "/red/green/blue",
+		"{/list*,path:4}": "/red/green/blue/%2Ffoo",
+		// Path-style parameter expansion
+		"{;who}":         ";who=fred",
+		"{;half}":        ";half=50%25",
+		"{;empty}":       ";empty",
+		"{;v,empty,who}": ";v=6;empty;who=fred",
+		"{;v,bar,who}":   ";v=6;who=fred",
+		"{;x,y}":         ";x=1024;y=768",
+		"{;x,y,empty}":   ";x=1024;y=768;empty",
+		"{;x,y,undef}":   ";x=1024;y=768",
+		"{;hello:5}":     ";hello=Hello",
+		"{;list}":        ";list=red,green,blue",
+		"{;list*}":       ";list=red;list=green;list=blue",
+		// Form-style query expansion
+		"{?who}":       "?who=fred",
+		"{?half}":      "?half=50%25",
+		"{?x,y}":       "?x=1024&y=768",
+		"{?x,y,empty}": "?x=1024&y=768&empty=",
+		"{?x,y,undef}": "?x=1024&y=768",
+		"{?var:3}":     "?var=val",
+		"{?list}":      "?list=red,green,blue",
+		"{?list*}":     "?list=red&list=green&list=blue",
+		"{?count*}":    "?count=one&count=two&count=three",
+		// Form-style query continuation
+		"{&who}":         "&who=fred",
+		"{&half}":        "&half=50%25",
+		"?fixed=yes{&x}": "?fixed=yes&x=1024",
+		"{&x,y,empty}":   "&x=1024&y=768&empty=",
+		"{&var:3}":       "&var=val",
+		"{&list}":        "&list=red,green,blue",
+		"{&list*}":       "&list=red&list=green&list=blue",
+	} {
+		actual, err := expandURITemplate(template, values)
+		require.NoError(t, err, template)
+		assert.Equal(t, expected, actual, template)
+	}
+}
+
+func TestExpandURITemplateErrors(t *testing.T) {
+	for template, expected := range map[string]string{
+		"/a{=var}/b":   "/a{=var}/b",
+		"/a{var:0}":    "/a{var:0}",
+		"/a{var}{b c}": "/avalue{b c}",
+		"/a{var":       "/a{var",
+	} {
+		actual, err := expandURITemplate(template, map[string]interface{}{"var": "value"})
+		assert.Error(t, err, template)
+		assert.Equal(t, expected, actual, template)
+	}
+}
@@ -0,0 +1,273 @@
+package codegen
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"sort"
+	"strings"
+)
+
+// APIDecl describes an exported declaration of generated code.
+type APIDecl struct {
+	// Kind is one of const, var, func, type, field, method, and interface
+	// method.
+	Kind string
+	// Signature is the part of the declaration its users depend on, eg, the
+	// type of a field, or the parameter and result types of a function,
+	// without the names of the parameters.
+	Signature string
+}
+
+func (d APIDecl) String() string {
+	return d.Kind + " " + d.Signature
+}
+
+// APIModel is the exported API of generated code, keyed by the name of the
+// declarations, qualified with the name of their type for fields and
+// methods, eg, Pet, Pet.Name, or Client.FindPets.
+type APIModel map[string]APIDecl
+
+// NewAPIModel builds the APIModel of the given Go files, keyed by their name.
+func NewAPIModel(files map[string][]byte) (APIModel, error) {
+	model := make(APIModel)
+	fset := token.NewFileSet()
+	for name, code := range files {
+		file, err := parser.ParseFile(fset, name, code, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		walkAPI(file, func(name string, decl APIDecl) {
+			model[name] = decl
+		})
+	}
+	return model, nil
+}
+
+// walkAPI calls add for the exported declarations of file, in the order they
+// are declared, the fields and methods of interfaces right after their type.
+func walkAPI(file *ast.File, add func(name string, decl APIDecl)) {
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			walkGenDeclAPI(decl, add)
+		case *ast.FuncDecl:
+			walkFuncDeclAPI(decl, add)
+		}
+	}
+}
+
+func walkGenDeclAPI(decl *ast.GenDecl, add func(name string, decl APIDecl)) {
+	for _, spec := range decl.Specs {
+		switch spec := spec.(type) {
+		case *ast.ValueSpec:
+			kind := "const"
+			if decl.Tok == token.VAR {
+				kind = "var"
+			}
+			for i, name := range spec.Names {
+				if !name.IsExported() {
+					continue
+				}
+				var signature []string
+				if spec.Type != nil {
+					signature = append(signature, apiTypeString(spec.Type))
+				}
+				// The values of constants are part of their API, the ones of
+				// enums going over the wire.
+				if kind == "const" && i < len(spec.Values) {
+					signature = append(signature, "= "+types.ExprString(spec.Values[i]))
+				}
+				add(name.Name, APIDecl{Kind: kind, Signature: strings.Join(signature, " ")})
+			}
+		case *ast.TypeSpec:
+			if !spec.Name.IsExported() {
+				continue
+			}
+			typeName := spec.Name.Name
+			var signature string
+			switch spec.Type.(type) {
+			case *ast.StructType:
+				signature = "struct"
+			case *ast.InterfaceType:
+				signature = "interface"
+			default:
+				signature = apiTypeString(spec.Type)
+			}
+			if spec.Assign.IsValid() {
+				signature = "= " + signature
+			}
+			add(typeName, APIDecl{Kind: "type", Signature: signature})
+
+			switch t := spec.Type.(type) {
+			case *ast.StructType:
+				for _, field := range t.Fields.List {
+					for _, name := range apiFieldNames(field) {
+						add(typeName+"."+name, APIDecl{Kind: "field", Signature: apiTypeString(field.Type)})
+					}
+				}
+			case *ast.InterfaceType:
+				for _, method := range t.Methods.List {
+					for _, name := range apiFieldNames(method) {
+						add(typeName+"."+name, APIDecl{Kind: "interface method", Signature: apiTypeString(method.Type)})
+					}
+				}
+			}
+		}
+	}
+}
+
+func walkFuncDeclAPI(decl *ast.FuncDecl, add func(name string, decl APIDecl)) {
+	if !decl.Name.IsExported() {
+		return
+	}
+	if decl.Recv == nil {
+		add(decl.Name.Name, APIDecl{Kind: "func", Signature: apiTypeString(decl.Type)})
+		return
+	}
+	receiver, pointer, ok := apiReceiver(decl)
+	if !ok {
+		return
+	}
+	add(receiver+"."+decl.Name.Name, APIDecl{
+		Kind:      "method",
+		Signature: "(" + pointer + receiver + ") " + apiTypeString(decl.Type),
+	})
+}
+
+// apiReceiver returns the name of the type of the receiver of the method
+// decl, and * when it's a pointer, unless the type isn't exported.
+func apiReceiver(decl *ast.FuncDecl) (name string, pointer string, ok bool) {
+	receiver := decl.Recv.List[0].Type
+	if star, isStar := receiver.(*ast.StarExpr); isStar {
+		receiver, pointer = star.X, "*"
+	}
+	ident, isIdent := receiver.(*ast.Ident)
+	if !isIdent || !ident.IsExported() {
+		return "", "", false
+	}
+	return ident.Name, pointer, true
+}
+
+// apiFieldNames returns the exported names of field, which, when it's
+// embedded, is named after its type.
+func apiFieldNames(field *ast.Field) []string {
+	var names []string
+	if len(field.Names) == 0 {
+		t := field.Type
+		if star, ok := t.(*ast.StarExpr); ok {
+			t = star.X
+		}
+		if sel, ok := t.(*ast.SelectorExpr); ok {
+			t = sel.Sel
+		}
+		if ident, ok := t.(*ast.Ident); ok && ident.IsExported() {
+			names = append(names, ident.Name)
+		}
+		return names
+	}
+	for _, name := range field.Names {
+		if name.IsExported() {
+			names = append(names, name.Name)
+		}
+	}
+	return names
+}
+
+// apiTypeString formats expr, leaving out the names of the parameters and
+// results of functions, which users don't depend on.
+func apiTypeString(expr ast.Expr) string {
+	if f, ok := expr.(*ast.FuncType); ok {
+		return types.ExprString(&ast.FuncType{
+			Params:  unnamedFields(f.Params),
+			Results: unnamedFields(f.Results),
+		})
+	}
+	return types.ExprString(expr)
+}
+
+// unnamedFields returns fields with one unnamed field per name.
+func unnamedFields(fields *ast.FieldList) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	unnamed := &ast.FieldList{}
+	for _, field := range fields.List {
+		for i := 0; i < len(field.Names) || i == 0; i++ {
+			unnamed.List = append(unnamed.List, &ast.Field{Type: field.Type})
+		}
+	}
+	return unnamed
+}
+
+// APIChange is a difference between the exported APIs of two generations.
+type APIChange struct {
+	Name string
+	// Old and New are nil when the declaration is added or removed.
+	Old, New *APIDecl
+	// Breaking is set for the changes which may break the code using the
+	// API: the removed and changed declarations, and the methods added to
+	// interfaces, which their implementations lack.
+	Breaking bool
+}
+
+func (c APIChange) String() string {
+	switch {
+	case c.Old == nil:
+		return fmt.Sprintf("added %s (%s)", c.Name, c.New)
+	case c.New == nil:
+		return fmt.Sprintf("removed %s (%s)", c.Name, c.Old)
+	}
+	return fmt.Sprintf("changed %s (%s, was %s)", c.Name, c.New, c.Old)
+}
+
+// CompareAPIs returns the changes from the old API to the new one, sorted by
+// name.
+func CompareAPIs(old, new APIModel) []APIChange {
+	var changes []APIChange
+	for name, oldDecl := range old {
+		oldDecl := oldDecl
+		newDecl, found := new[name]
+		switch {
+		case !found:
+			changes = append(changes, APIChange{Name: name, Old: &oldDecl, Breaking: true})
+		case newDecl != oldDecl:
+			changes = append(changes, APIChange{Name: name, Old: &oldDecl, New: &newDecl, Breaking: true})
+		}
+	}
+	for name, newDecl := range new {
+		newDecl := newDecl
+		if _, found := old[name]; !found {
+			changes = append(changes, APIChange{Name: name, New: &newDecl, Breaking: newDecl.Kind == "interface method"})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+// ReadAPIAllowlist reads the names of the declarations whose breaking changes
+// are intended from the file at path, one per line. Blank lines, and the ones
+// starting with #, are ignored.
+func ReadAPIAllowlist(path string) (map[string]bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	allowed := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			allowed[line] = true
+		}
+	}
+	return allowed, scanner.Err()
+}
@@ -0,0 +1,144 @@
+package codegen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const apiCheckOld = `package api
+
+import "context"
+
+const (
+	Available PetStatus = "available"
+	Sold      PetStatus = "sold"
+)
+
+type PetStatus string
+
+type Pet struct {
+	Name   string     ` + "`json:\"name\"`" + `
+	Tag    *string    ` + "`json:\"tag,omitempty\"`" + `
+	Status *PetStatus ` + "`json:\"status,omitempty\"`" + `
+	secret string
+}
+
+type ServerInterface interface {
+	FindPets(ctx context.Context, limit int) ([]Pet, error)
+}
+
+type Client struct {
+	Server string
+}
+
+func NewClient(server string) (*Client, error) {
+	return &Client{Server: server}, nil
+}
+
+func (c *Client) FindPets(ctx context.Context, limit int) error {
+	return nil
+}
+
+func (c *Client) unexported() {}
+
+type internal struct {
+	Exported string
+}
+`
+
+const apiCheckNew = `package api
+
+import "context"
+
+const (
+	Available PetStatus = "available"
+	Sold      PetStatus = "sold-out"
+	Pending   PetStatus = "pending"
+)
+
+type PetStatus string
+
+type Pet struct {
+	Name   string     ` + "`json:\"name\"`" + `
+	Tag    string     ` + "`json:\"tag\"`" + `
+	Status *PetStatus ` + "`json:\"status,omitempty\"`" + `
+	Age    *int       ` + "`json:\"age,omitempty\"`" + `
+}
+
+type ServerInterface interface {
+	FindPets(c context.Context, max int) ([]Pet, error)
+	AddPet(ctx context.Context, pet Pet) error
+}
+
+type Client struct {
+	Server string
+}
+
+func NewClient(url string) (*Client, error) {
+	return &Client{Server: url}, nil
+}
+
+type internal struct {
+	Renamed string
+}
+`
+
+func TestNewAPIModel(t *testing.T) {
+	model, err := NewAPIModel(map[string][]byte{"api.gen.go": []byte(apiCheckOld)})
+	require.NoError(t, err)
+
+	assert.Equal(t, APIModel{
+		"Available":                {Kind: "const", Signature: `PetStatus = "available"`},
+		"Sold":                     {Kind: "const", Signature: `PetStatus = "sold"`},
+		"PetStatus":                {Kind: "type", Signature: "string"},
+		"Pet":                      {Kind: "type", Signature: "struct"},
+		"Pet.Name":                 {Kind: "field", Signature: "string"},
+		"Pet.Tag":                  {Kind: "field", Signature: "*string"},
+		"Pet.Status":               {Kind: "field", Signature: "*PetStatus"},
+		"ServerInterface":          {Kind: "type", Signature: "interface"},
+		"ServerInterface.FindPets": {Kind: "interface method", Signature: "func(context.Context, int) ([]Pet, error)"},
+		"Client":                   {Kind: "type", Signature: "struct"},
+		"Client.Server":            {Kind: "field", Signature: "string"},
+		"NewClient":                {Kind: "func", Signature: "func(string) (*Client, error)"},
+		"Client.FindPets":          {Kind: "method", Signature: "(*Client) func(context.Context, int) error"},
+	}, model)
+}
+
+func TestCompareAPIs(t *testing.T) {
+	old, err := NewAPIModel(map[string][]byte{"api.gen.go": []byte(apiCheckOld)})
+	require.NoError(t, err)
+	new, err := NewAPIModel(map[string][]byte{"api.gen.go": []byte(apiCheckNew)})
+	require.NoError(t, err)
+
+	var breaking, additive []string
+	for _, change := range CompareAPIs(old, new) {
+		if change.Breaking {
+			breaking = append(breaking, change.String())
+		} else {
+			additive = append(additive, change.String())
+		}
+	}
+	assert.Equal(t, []string{
+		"removed Client.FindPets (method (*Client) func(context.Context, int) error)",
+		"changed Pet.Tag (field string, was field *string)",
+		"added ServerInterface.AddPet (interface method func(context.Context, Pet) error)",
+		`changed Sold (const PetStatus = "sold-out", was const PetStatus = "sold")`,
+	}, breaking)
+	assert.Equal(t, []string{
+		"added Pending (const PetStatus = \"pending\")",
+		"added Pet.Age (field *int)",
+	}, additive)
+}
+
+func TestReadAPIAllowlist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "allowlist")
+	require.NoError(t, os.WriteFile(path, []byte("# Renamed in v2\nPet.Tag\n\n  Client.FindPets  \n"), 0o644))
+
+	allowed, err := ReadAPIAllowlist(path)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"Pet.Tag": true, "Client.FindPets": true}, allowed)
+}
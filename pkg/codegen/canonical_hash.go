@@ -0,0 +1,86 @@
+package codegen
+
+import (
+	"text/template"
+)
+
+// CanonicalHashDefinition describes the MarshalCanonicalJSON and
+// CanonicalHash methods generated for a type with canonical-hash.
+type CanonicalHashDefinition struct {
+	TypeName string
+	// Properties are walked by the encoding of a struct, along with its
+	// additional properties when AdditionalProperties is set.
+	Properties           []CanonicalHashProperty
+	AdditionalProperties bool
+	// JSON is set for the structs which are encoded as their JSON made
+	// canonical, the unions, whose value is raw JSON, and the structs with
+	// properties encoded by x-go-json-codec.
+	JSON bool
+	// Underlying is the type the types which aren't structs are defined as,
+	// and are encoded as.
+	Underlying string
+}
+
+// CanonicalHashProperty is a property walked by the canonical encoding of a
+// struct.
+type CanonicalHashProperty struct {
+	JsonFieldName string
+	GoFieldName   string
+	Nullable      bool
+}
+
+// GenerateCanonicalHashes generates the MarshalCanonicalJSON and
+// CanonicalHash methods of the given type definitions, those of the structs
+// walking the properties of their schema, leaving out the unset ones unless
+// they're nullable, along with the unexported functions encoding the rest as
+// canonical JSON.
+func GenerateCanonicalHashes(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	var definitions []CanonicalHashDefinition
+	m := map[string]bool{}
+	for _, td := range typeDefs {
+		if m[td.TypeName] || td.IsAlias() {
+			continue
+		}
+		m[td.TypeName] = true
+
+		definition := CanonicalHashDefinition{TypeName: td.TypeName}
+		switch {
+		case td.Schema.IsRef() || !isStructType(td.Schema.GoType):
+			definition.Underlying = td.Schema.TypeDecl()
+		case len(td.Schema.UnionElements) != 0:
+			definition.JSON = true
+		default:
+			definition.AdditionalProperties = td.Schema.HasAdditionalProperties
+			for _, p := range td.Schema.Properties {
+				if p.JSONCodec != nil {
+					definition.JSON = true
+					break
+				}
+				if isJSONIgnored(p) {
+					continue
+				}
+				definition.Properties = append(definition.Properties, CanonicalHashProperty{
+					JsonFieldName: p.JsonFieldName,
+					GoFieldName:   p.GoFieldName(),
+					Nullable:      p.Nullable,
+				})
+			}
+		}
+		definitions = append(definitions, definition)
+	}
+	if len(definitions) == 0 {
+		return "", nil
+	}
+	return GenerateTemplates([]string{"canonical-hash.tmpl"}, t, definitions)
+}
+
+// isJSONIgnored returns whether property p has x-go-json-ignore, leaving its
+// field out of the JSON encoding.
+func isJSONIgnored(p Property) bool {
+	extension, ok := p.Extensions[extPropGoJsonIgnore]
+	if !ok {
+		return false
+	}
+	ignore, err := extParseGoJsonIgnore(extension)
+	return err == nil && ignore
+}
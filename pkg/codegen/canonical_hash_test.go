@@ -0,0 +1,80 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalHash(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: canonical-hash
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        tag:
+          type: string
+          nullable: true
+        internal:
+          type: string
+          x-go-json-ignore: true
+      additionalProperties:
+        type: integer
+    Pets:
+      type: array
+      items:
+        $ref: '#/components/schemas/Pet'
+    Status:
+      type: string
+      enum: [available, sold]
+    Animal:
+      oneOf:
+        - $ref: '#/components/schemas/Pet'
+        - type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	generate := func(canonicalHash bool) string {
+		code, err := Generate(swagger, Configuration{
+			PackageName:   "api",
+			Generate:      GenerateOptions{Models: true},
+			OutputOptions: OutputOptions{SkipPrune: true, CanonicalHash: canonicalHash},
+		})
+		require.NoError(t, err)
+		return code
+	}
+
+	code := generate(true)
+	assert.Contains(t, code, "func marshalCanonicalJSON(v interface{}) ([]byte, error) {")
+	assert.Contains(t, code, `func (t Pet) MarshalCanonicalJSON() ([]byte, error) {
+	var o canonicalJSONObject
+	o.property("name", t.Name, false)
+	o.property("tag", t.Tag, true)
+	o.additionalProperties(t.AdditionalProperties)
+	return o.marshal()
+}`)
+	assert.Contains(t, code, "return marshalCanonicalJSON((string)(t))")
+	// Aliases have the methods of their type.
+	assert.NotContains(t, code, "func (t Pets)")
+	assert.Contains(t, code, `func (t Animal) MarshalCanonicalJSON() ([]byte, error) {
+	data, err := json.Marshal(t)`)
+	for _, typeName := range []string{"Pet", "Status", "Animal"} {
+		assert.Contains(t, code, "func (t "+typeName+") CanonicalHash() ([32]byte, error) {")
+	}
+
+	code = generate(false)
+	assert.NotContains(t, code, "CanonicalHash")
+	assert.NotContains(t, code, "canonicalJSON")
+}
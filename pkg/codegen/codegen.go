@@ -27,6 +27,7 @@ import (
 	"runtime/debug"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"text/template"
 	"time"
 
@@ -41,12 +42,48 @@ import (
 //go:embed templates
 var templates embed.FS
 
-// globalState stores all global state. Please don't put global state anywhere
-// else so that we can easily track it.
-var globalState struct {
+// generator holds the state of a code generation run. Each run has its own,
+// passed through the functions generating the code, so that runs can go on
+// concurrently.
+type generator struct {
 	options       Configuration
 	spec          *openapi3.T
 	importMapping importMap
+	warnings      []Warning
+	routePrefix   string
+	// responseTypeSuffix is appended to the names of the response types of
+	// the client with responses.
+	responseTypeSuffix string
+	// operationIDs lists the operations the generated code was produced for.
+	operationIDs []string
+	// usesOrderedSet is set when a schema is generated as an OrderedSet, whose
+	// definition then comes with the models.
+	usesOrderedSet bool
+	// usesJSONCodec is set when a property has x-go-json-codec, whose
+	// helpers are then generated.
+	usesJSONCodec bool
+	// usesStrictDate is set when a schema is generated as a StrictDate, whose
+	// definition then comes with the models.
+	usesStrictDate bool
+	// readsMultipartParts and writesMultipartParts are set when the client
+	// reads the parts of a multipart response, and when the strict server
+	// writes them, whose helpers are then generated, see
+	// generateMultipartHelpers.
+	readsMultipartParts  bool
+	writesMultipartParts bool
+	// paramExampleTests is the test file of param-example-tests, which
+	// GenerateFiles returns apart from the code.
+	paramExampleTests string
+	// allOfHeights and allOfMerges memoize the allOfs already checked and
+	// merged, see checkAllOf and mergeAllOf.
+	allOfHeights map[string]int
+	allOfMerges  map[string]allOfMerge
+	// propertyOrders are the names of the properties of the schemas of the
+	// documents loaded, in their declared order, keyed by the location of
+	// their schema, and propertyPositions the positions of the properties
+	// of the schemas loaded and merged, see orderedPropertyNames.
+	propertyOrders    map[string][]string
+	propertyPositions map[*openapi3.SchemaRef]int
 }
 
 // goImport represents a go package to be imported in the generated code
@@ -75,6 +112,22 @@ func (im importMap) GoImports() []string {
 	return goImports
 }
 
+// lookup returns the import of the document remoteComponent, matching the keys
+// by document identity, so that `./spec.yaml` and `spec.yaml` are the same
+// document.
+func (im importMap) lookup(remoteComponent string) (goImport, bool) {
+	if goImport, ok := im[remoteComponent]; ok {
+		return goImport, true
+	}
+	document := documentIdentity(remoteComponent)
+	for specPath, goImport := range im {
+		if documentIdentity(specPath) == document {
+			return goImport, true
+		}
+	}
+	return goImport{}, false
+}
+
 func constructImportMapping(importMapping map[string]string) importMap {
 	var (
 		pathToName = map[string]string{}
@@ -102,30 +155,52 @@ func constructImportMapping(importMapping map[string]string) importMap {
 
 // Generate uses the Go templating engine to generate all of our server wrappers from
 // the descriptions we've built up above from the schema objects.
-// opts defines
+// opts defines the generation options. Warnings are printed to stderr, use
+// GenerateFiles to get them instead. The exported functions generating parts
+// of the code by themselves, such as GenerateGoSchema, and TemplateFunctions
+// use spec and opts until the next call.
 func Generate(spec *openapi3.T, opts Configuration) (string, error) {
-	// This is global state
-	globalState.options = opts
-	globalState.spec = spec
-	globalState.importMapping = constructImportMapping(opts.ImportMapping)
+	exportedSpec.Store(spec)
+	exportedOptions.Store(&opts)
 
-	filterOperationsByTag(spec, opts)
-	if !opts.OutputOptions.SkipPrune {
-		pruneUnusedComponents(spec)
+	// The order of the properties is only known to the specs GenerateFiles
+	// loads.
+	g := newGenerator(spec, opts)
+	code, err := g.generate(context.Background())
+	for _, w := range g.warnings {
+		fmt.Fprintf(os.Stderr, "WARNING: %s\n", w)
 	}
+	return code, err
+}
 
+// newGenerator returns the state of a run generating code for spec with opts.
+func newGenerator(spec *openapi3.T, opts Configuration) *generator {
+	g := &generator{
+		options:            opts,
+		spec:               spec,
+		importMapping:      constructImportMapping(opts.ImportMapping),
+		responseTypeSuffix: defaultResponseTypeSuffix,
+	}
 	// if we are provided an override for the response type suffix update it
 	if opts.OutputOptions.ResponseTypeSuffix != "" {
-		responseTypeSuffix = opts.OutputOptions.ResponseTypeSuffix
+		g.responseTypeSuffix = opts.OutputOptions.ResponseTypeSuffix
 	}
+	if g.options.OutputOptions.ClientTypeName == "" {
+		g.options.OutputOptions.ClientTypeName = defaultClientTypeName
+	}
+	return g
+}
 
-	if globalState.options.OutputOptions.ClientTypeName == "" {
-		globalState.options.OutputOptions.ClientTypeName = defaultClientTypeName
+// generate does the work of Generate, giving up with ctx.Err() once ctx is
+// done.
+func (g *generator) generate(ctx context.Context) (string, error) {
+	if err := g.prepare(); err != nil {
+		return "", err
 	}
+	spec, opts := g.spec, g.options
 
 	// This creates the golang templates text package
-	TemplateFunctions["opts"] = func() Configuration { return globalState.options }
-	t := template.New("oapi-codegen").Funcs(TemplateFunctions)
+	t := template.New("oapi-codegen").Funcs(g.templateFunctions())
 	// This parses all of our own template files into the template object
 	// above
 	err := LoadTemplates(templates, t)
@@ -148,10 +223,18 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		}
 	}
 
-	ops, err := OperationDefinitions(spec, opts.OutputOptions.InitialismOverrides)
+	endOperations := startPhase(opts.Timing, "operations")
+	ops, err := g.operationDefinitions(ctx, spec, opts.OutputOptions.InitialismOverrides)
+	endOperations()
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
 	if err != nil {
 		return "", fmt.Errorf("error creating operation definitions: %w", err)
 	}
+	for _, op := range ops {
+		g.operationIDs = append(g.operationIDs, op.OperationId)
+	}
 
 	xGoTypeImports, err := OperationImports(ops)
 	if err != nil {
@@ -160,12 +243,14 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 
 	var typeDefinitions, constantDefinitions string
 	if opts.Generate.Models {
-		typeDefinitions, err = GenerateTypeDefinitions(t, spec, ops, opts.OutputOptions.ExcludeSchemas)
+		g.reportProgress("schemas", 0, 1)
+		endSchemas := startPhase(opts.Timing, "schemas")
+		typeDefinitions, err = g.generateTypeDefinitions(t, spec, ops, opts.OutputOptions.ExcludeSchemas)
 		if err != nil {
 			return "", fmt.Errorf("error generating type definitions: %w", err)
 		}
 
-		constantDefinitions, err = GenerateConstants(t, ops)
+		constantDefinitions, err = g.generateConstants(t, ops)
 		if err != nil {
 			return "", fmt.Errorf("error generating constants: %w", err)
 		}
@@ -175,8 +260,16 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 			return "", fmt.Errorf("error getting type definition imports: %w", err)
 		}
 		MergeImports(xGoTypeImports, imprts)
+		endSchemas()
+		g.reportProgress("schemas", 1, 1)
+
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
 	}
 
+	endTemplates := startPhase(opts.Timing, "templates")
+
 	var irisServerOut string
 	if opts.Generate.IrisServer {
 		irisServerOut, err = GenerateIrisServer(t, ops)
@@ -217,6 +310,14 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		}
 	}
 
+	var tagServersOut string
+	if opts.OutputOptions.ServerInterfacePerTag {
+		tagServersOut, err = GenerateTagServers(t, ops, opts)
+		if err != nil {
+			return "", fmt.Errorf("error generating per tag server interfaces: %w", err)
+		}
+	}
+
 	var gorillaServerOut string
 	if opts.Generate.GorillaServer {
 		gorillaServerOut, err = GenerateGorillaServer(t, ops)
@@ -225,11 +326,82 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		}
 	}
 
+	var corsOut string
+	if opts.Generate.CORS {
+		corsOut, err = g.generateCORS(t, ops, opts)
+		if err != nil {
+			return "", fmt.Errorf("error generating CORS policy: %w", err)
+		}
+	}
+
+	var routeTableOut string
+	if opts.Generate.RouteTable {
+		routeTableOut, err = g.generateRouteTable(t, spec, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating route table: %w", err)
+		}
+	}
+
+	var operationSchemasOut string
+	if opts.Generate.OperationSchemas {
+		operationSchemasOut, err = GenerateOperationSchemas(t, ops, opts)
+		if err != nil {
+			return "", fmt.Errorf("error generating operation schemas: %w", err)
+		}
+	}
+
+	var operationExtensionsOut string
+	if opts.OutputOptions.RuntimeExtensions {
+		operationExtensionsOut, err = GenerateOperationExtensions(t, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating operation extensions: %w", err)
+		}
+	}
+
+	var scopeChecksOut string
+	if opts.OutputOptions.ScopeChecks {
+		scopeChecksOut, err = GenerateScopeChecks(t, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating scope checks: %w", err)
+		}
+	}
+
+	var testHarnessOut string
+	if opts.Generate.TestHarness {
+		testHarnessOut, err = GenerateTemplates([]string{"test-harness.tmpl"}, t, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating test harness: %w", err)
+		}
+	}
+
+	var uriTemplatesOut string
+	if len(opts.OutputOptions.URITemplateExtensions) != 0 {
+		uriTemplatesOut, err = GenerateURITemplates(t, ops, opts.OutputOptions.URITemplateExtensions)
+		if err != nil {
+			return "", fmt.Errorf("error generating URI template helpers: %w", err)
+		}
+	}
+
+	if opts.Generate.ParamExampleTests {
+		testsOut, err := g.generateParameterExampleTests(t, ops, opts.PackageName, opts.NoVCSVersionOverride)
+		if err != nil {
+			return "", fmt.Errorf("error generating parameter example tests: %w", err)
+		}
+		if !opts.OutputOptions.SkipFmt {
+			testsBytes, err := imports.Process(opts.PackageName+"_test.go", []byte(testsOut), nil)
+			if err != nil {
+				return "", fmt.Errorf("error formatting parameter example tests %s: %w", testsOut, err)
+			}
+			testsOut = string(testsBytes)
+		}
+		g.paramExampleTests = testsOut
+	}
+
 	var strictServerOut string
 	if opts.Generate.Strict {
 		var responses []ResponseDefinition
 		if spec.Components != nil {
-			responses, err = GenerateResponseDefinitions("", spec.Components.Responses)
+			responses, err = g.generateResponseDefinitions("", spec.Components.Responses)
 			if err != nil {
 				return "", fmt.Errorf("error generation response definitions for schema: %w", err)
 			}
@@ -245,6 +417,14 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		strictServerOut = strictServerResponses + strictServerOut
 	}
 
+	var instrumentationOut string
+	if instrumented(opts) {
+		instrumentationOut, err = GenerateInstrumentation(t, opts)
+		if err != nil {
+			return "", fmt.Errorf("error generating instrumentation: %w", err)
+		}
+	}
+
 	var clientOut string
 	if opts.Generate.Client {
 		clientOut, err = GenerateClient(t, ops)
@@ -261,19 +441,41 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		}
 	}
 
+	var responseParsersOut string
+	if opts.Generate.Client || opts.Generate.ResponseParsers {
+		responseParsersOut, err = GenerateResponseParsers(t, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating response parsers: %w", err)
+		}
+	}
+
+	var multipartHelpersOut string
+	if g.readsMultipartParts || g.writesMultipartParts {
+		multipartHelpersOut, err = g.generateMultipartHelpers(t)
+		if err != nil {
+			return "", fmt.Errorf("error generating multipart helpers: %w", err)
+		}
+	}
+
 	var inlinedSpec string
 	if opts.Generate.EmbeddedSpec {
-		inlinedSpec, err = GenerateInlinedSpec(t, globalState.importMapping, spec)
+		inlinedSpec, err = GenerateInlinedSpec(t, g.importMapping, spec)
 		if err != nil {
 			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
+	endTemplates()
+
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
 	var buf bytes.Buffer
 	w := bufio.NewWriter(&buf)
 
-	externalImports := append(globalState.importMapping.GoImports(), importMap(xGoTypeImports).GoImports()...)
-	importsOut, err := GenerateImports(
+	externalImports := append(g.importMapping.GoImports(), importMap(xGoTypeImports).GoImports()...)
+	importsOut, err := g.generateImports(
 		t,
 		externalImports,
 		opts.PackageName,
@@ -298,6 +500,13 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		return "", fmt.Errorf("error writing type definitions: %w", err)
 	}
 
+	if instrumented(opts) {
+		_, err = w.WriteString(instrumentationOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing instrumentation: %w", err)
+		}
+	}
+
 	if opts.Generate.Client {
 		_, err = w.WriteString(clientOut)
 		if err != nil {
@@ -309,6 +518,13 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		}
 	}
 
+	if opts.Generate.Client || opts.Generate.ResponseParsers {
+		_, err = w.WriteString(responseParsersOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing response parsers: %w", err)
+		}
+	}
+
 	if opts.Generate.IrisServer {
 		_, err = w.WriteString(irisServerOut)
 		if err != nil {
@@ -352,6 +568,55 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		}
 	}
 
+	if opts.OutputOptions.ServerInterfacePerTag {
+		_, err = w.WriteString(tagServersOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing per tag server interfaces: %w", err)
+		}
+	}
+
+	if opts.Generate.CORS {
+		_, err = w.WriteString(corsOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing CORS policy: %w", err)
+		}
+	}
+
+	if opts.Generate.RouteTable {
+		_, err = w.WriteString(routeTableOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing route table: %w", err)
+		}
+	}
+
+	if opts.Generate.OperationSchemas {
+		_, err = w.WriteString(operationSchemasOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing operation schemas: %w", err)
+		}
+	}
+
+	if opts.OutputOptions.RuntimeExtensions {
+		_, err = w.WriteString(operationExtensionsOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing operation extensions: %w", err)
+		}
+	}
+
+	if opts.OutputOptions.ScopeChecks {
+		_, err = w.WriteString(scopeChecksOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing scope checks: %w", err)
+		}
+	}
+
+	if len(opts.OutputOptions.URITemplateExtensions) != 0 {
+		_, err = w.WriteString(uriTemplatesOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing URI template helpers: %w", err)
+		}
+	}
+
 	if opts.Generate.Strict {
 		_, err = w.WriteString(strictServerOut)
 		if err != nil {
@@ -359,6 +624,18 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		}
 	}
 
+	_, err = w.WriteString(multipartHelpersOut)
+	if err != nil {
+		return "", fmt.Errorf("error writing multipart helpers: %w", err)
+	}
+
+	if opts.Generate.TestHarness {
+		_, err = w.WriteString(testHarnessOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing test harness: %w", err)
+		}
+	}
+
 	if opts.Generate.EmbeddedSpec {
 		_, err = w.WriteString(inlinedSpec)
 		if err != nil {
@@ -380,38 +657,64 @@ func Generate(spec *openapi3.T, opts Configuration) (string, error) {
 		return goCode, nil
 	}
 
+	g.reportProgress("format", 0, 1)
+	endFormat := startPhase(opts.Timing, "format")
 	outBytes, err := imports.Process(opts.PackageName+".go", []byte(goCode), nil)
 	if err != nil {
 		return "", fmt.Errorf("error formatting Go code %s: %w", goCode, err)
 	}
+	endFormat()
+	g.reportProgress("format", 1, 1)
+
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
 	return string(outBytes), nil
 }
 
-func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []OperationDefinition, excludeSchemas []string) (string, error) {
-	var allTypes []TypeDefinition
-	if swagger.Components != nil {
-		schemaTypes, err := GenerateTypesForSchemas(t, swagger.Components.Schemas, excludeSchemas)
-		if err != nil {
-			return "", fmt.Errorf("error generating Go types for component schemas: %w", err)
-		}
+// prepare filters and prunes the spec of g as its options ask for, and
+// checks what is left of it.
+func (g *generator) prepare() error {
+	spec, opts := g.spec, g.options
 
-		paramTypes, err := GenerateTypesForParameters(t, swagger.Components.Parameters)
-		if err != nil {
-			return "", fmt.Errorf("error generating Go types for component parameters: %w", err)
-		}
-		allTypes = append(schemaTypes, paramTypes...)
+	endPrune := startPhase(opts.Timing, "prune")
+	filterOperationsByTag(spec, opts)
+	if !opts.OutputOptions.SkipPrune {
+		pruneUnusedComponents(spec)
+	}
+	endPrune()
 
-		responseTypes, err := GenerateTypesForResponses(t, swagger.Components.Responses)
-		if err != nil {
-			return "", fmt.Errorf("error generating Go types for component responses: %w", err)
-		}
-		allTypes = append(allTypes, responseTypes...)
+	// The components pruned aren't generated, so they're left unchecked.
+	if err := g.checkConsistency(spec); err != nil {
+		return fmt.Errorf("inconsistent spec:\n%w", err)
+	}
+
+	routePrefix, err := resolveRoutePrefix(spec, opts.OutputOptions)
+	if err != nil {
+		return err
+	}
+	g.routePrefix = routePrefix
+	return nil
+}
+
+func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []OperationDefinition, excludeSchemas []string) (string, error) {
+	return exportedGenerator().generateTypeDefinitions(t, swagger, ops, excludeSchemas)
+}
+
+// generateTypeDefinitions does the work of GenerateTypeDefinitions.
+func (g *generator) generateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []OperationDefinition, excludeSchemas []string) (string, error) {
+	types, err := g.resolveComponentTypes(swagger, excludeSchemas)
+	if err != nil {
+		return "", err
+	}
+	allTypes := types.all
 
-		bodyTypes, err := GenerateTypesForRequestBodies(t, swagger.Components.RequestBodies)
+	var inheritedDiscriminatorsOut string
+	if swagger.Components != nil {
+		inheritedDiscriminatorsOut, err = g.generateInheritedDiscriminators(t, swagger, types.schemas)
 		if err != nil {
-			return "", fmt.Errorf("error generating Go types for component request bodies: %w", err)
+			return "", fmt.Errorf("error generating inherited discriminators: %w", err)
 		}
-		allTypes = append(allTypes, bodyTypes...)
 	}
 
 	// Go through all operations, and add their types to allTypes, so that we can
@@ -428,7 +731,7 @@ func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []Op
 		return "", fmt.Errorf("error generating Go types for component request bodies: %w", err)
 	}
 
-	enumsOut, err := GenerateEnums(t, enumTypes)
+	enumsOut, err := g.generateEnums(t, enumTypes)
 	if err != nil {
 		return "", fmt.Errorf("error generating code for type enums: %w", err)
 	}
@@ -453,12 +756,154 @@ func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []Op
 		return "", fmt.Errorf("error generating boilerplate for union types with additionalProperties: %w", err)
 	}
 
-	typeDefinitions := strings.Join([]string{enumsOut, typesOut, operationsOut, allOfBoilerplate, unionBoilerplate, unionAndAdditionalBoilerplate}, "")
+	// Inline request bodies are defined from the type of their schema, whose
+	// methods they don't inherit, so they need their own.
+	bodyTypes := append([]TypeDefinition{}, enumTypes...)
+	for _, op := range ops {
+		for _, body := range op.Bodies {
+			if td := body.TypeDef(op.OperationId); !td.IsAlias() && td.Schema.IsRef() {
+				td.Schema.RefType = ""
+				bodyTypes = append(bodyTypes, *td)
+			}
+		}
+	}
+
+	var unknownFieldsBoilerplate string
+	if g.options.OutputOptions.DisallowUnknownFields {
+		unknownFieldsBoilerplate, err = g.generateUnknownFieldsBoilerplate(t, bodyTypes)
+		if err != nil {
+			return "", fmt.Errorf("error generating boilerplate for types disallowing unknown fields: %w", err)
+		}
+	}
+
+	var validateBoilerplate string
+	if g.options.OutputOptions.ModelValidation {
+		validateBoilerplate, err = g.generateValidateBoilerplate(t, bodyTypes)
+		if err != nil {
+			return "", fmt.Errorf("error generating Validate boilerplate: %w", err)
+		}
+	}
+
+	jsonCodecBoilerplate, err := g.generateJSONCodecBoilerplate(t, bodyTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating boilerplate for x-go-json-codec: %w", err)
+	}
+
+	var orderedSetBoilerplate string
+	if g.usesOrderedSet {
+		orderedSetBoilerplate, err = GenerateTemplates([]string{"ordered-set.tmpl"}, t, nil)
+		if err != nil {
+			return "", fmt.Errorf("error generating OrderedSet boilerplate: %w", err)
+		}
+	}
+
+	var strictDateBoilerplate string
+	if g.usesStrictDate {
+		strictDateBoilerplate, err = GenerateTemplates([]string{"strict-dates.tmpl"}, t, nil)
+		if err != nil {
+			return "", fmt.Errorf("error generating StrictDate boilerplate: %w", err)
+		}
+	}
+
+	var isZeroBoilerplate string
+	if g.options.OutputOptions.IsZeroMethods {
+		isZeroBoilerplate, err = GenerateIsZeroBoilerplate(t, enumTypes)
+		if err != nil {
+			return "", fmt.Errorf("error generating IsZero boilerplate: %w", err)
+		}
+	}
+
+	var fieldExtensionsOut string
+	if g.options.OutputOptions.RuntimeExtensions {
+		fieldExtensionsOut, err = GenerateFieldExtensions(t, enumTypes)
+		if err != nil {
+			return "", fmt.Errorf("error generating field extensions: %w", err)
+		}
+	}
+
+	var schemaNamesOut string
+	if g.options.OutputOptions.SchemaNames {
+		schemaNamesOut, err = g.generateSchemaNames(t, bodyTypes)
+		if err != nil {
+			return "", fmt.Errorf("error generating schema names: %w", err)
+		}
+	}
+
+	comparableKeysOut, err := GenerateComparableKeys(t, bodyTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating boilerplate for x-go-comparable: %w", err)
+	}
+
+	var canonicalHashesOut string
+	if g.options.OutputOptions.CanonicalHash {
+		canonicalHashesOut, err = GenerateCanonicalHashes(t, bodyTypes)
+		if err != nil {
+			return "", fmt.Errorf("error generating canonical hashes: %w", err)
+		}
+	}
+
+	interfacesOut, err := g.generateInterfaces(t, enumTypes, g.options.OutputOptions.InterfacesFor)
+	if err != nil {
+		return "", fmt.Errorf("error generating interfaces: %w", err)
+	}
+
+	typeDefinitions := strings.Join([]string{enumsOut, typesOut, operationsOut, allOfBoilerplate, unionBoilerplate, unionAndAdditionalBoilerplate, inheritedDiscriminatorsOut, unknownFieldsBoilerplate, jsonCodecBoilerplate, validateBoilerplate, isZeroBoilerplate, comparableKeysOut, canonicalHashesOut, fieldExtensionsOut, interfacesOut, schemaNamesOut, orderedSetBoilerplate, strictDateBoilerplate}, "")
 	return typeDefinitions, nil
 }
 
+// componentTypes are the types defined for the components of a spec.
+type componentTypes struct {
+	// schemas are the types of components/schemas, which all starts with.
+	schemas []TypeDefinition
+	all     []TypeDefinition
+}
+
+// resolveComponentTypes resolves the types defined for the components of
+// swagger, but for the schemas in excludeSchemas.
+func (g *generator) resolveComponentTypes(swagger *openapi3.T, excludeSchemas []string) (componentTypes, error) {
+	var types componentTypes
+	if swagger.Components == nil {
+		return types, nil
+	}
+	schemaTypes, err := g.generateTypesForSchemas(nil, swagger.Components.Schemas, excludeSchemas)
+	if err != nil {
+		return types, fmt.Errorf("error generating Go types for component schemas: %w", err)
+	}
+	types.schemas = schemaTypes
+
+	paramTypes, err := g.generateTypesForParameters(nil, swagger.Components.Parameters)
+	if err != nil {
+		return types, fmt.Errorf("error generating Go types for component parameters: %w", err)
+	}
+	types.all = append(schemaTypes, paramTypes...)
+
+	responseTypes, err := g.generateTypesForResponses(nil, swagger.Components.Responses)
+	if err != nil {
+		return types, fmt.Errorf("error generating Go types for component responses: %w", err)
+	}
+	types.all = append(types.all, responseTypes...)
+
+	bodyTypes, err := g.generateTypesForRequestBodies(nil, swagger.Components.RequestBodies)
+	if err != nil {
+		return types, fmt.Errorf("error generating Go types for component request bodies: %w", err)
+	}
+	types.all = append(types.all, bodyTypes...)
+
+	headerTypes, err := g.generateTypesForHeaders(nil, swagger.Components.Headers)
+	if err != nil {
+		return types, fmt.Errorf("error generating Go types for component headers: %w", err)
+	}
+	types.all = append(types.all, headerTypes...)
+	return types, nil
+}
+
 // GenerateConstants generates operation ids, context keys, paths, etc. to be exported as constants
 func GenerateConstants(t *template.Template, ops []OperationDefinition) (string, error) {
+	return exportedGenerator().generateConstants(t, ops)
+}
+
+// generateConstants does the work of GenerateConstants.
+func (g *generator) generateConstants(t *template.Template, ops []OperationDefinition) (string, error) {
 	constants := Constants{
 		SecuritySchemeProviderNames: []string{},
 	}
@@ -480,12 +925,24 @@ func GenerateConstants(t *template.Template, ops []OperationDefinition) (string,
 
 	constants.SecuritySchemeProviderNames = append(constants.SecuritySchemeProviderNames, providerNames...)
 
+	for _, op := range ops {
+		if op.HasCorrelationHeader() {
+			constants.CorrelationHeader = g.options.OutputOptions.CorrelationHeader
+			break
+		}
+	}
+
 	return GenerateTemplates([]string{"constants.tmpl"}, t, constants)
 }
 
 // GenerateTypesForSchemas generates type definitions for any custom types defined in the
 // components/schemas section of the Swagger spec.
 func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef, excludeSchemas []string) ([]TypeDefinition, error) {
+	return exportedGenerator().generateTypesForSchemas(t, schemas, excludeSchemas)
+}
+
+// generateTypesForSchemas does the work of GenerateTypesForSchemas.
+func (g *generator) generateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef, excludeSchemas []string) ([]TypeDefinition, error) {
 	excludeSchemasMap := make(map[string]bool)
 	for _, schema := range excludeSchemas {
 		excludeSchemasMap[schema] = true
@@ -498,7 +955,9 @@ func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.
 		}
 		schemaRef := schemas[schemaName]
 
-		goSchema, err := GenerateGoSchema(schemaRef, []string{schemaName})
+		endSchema := g.startStep("schema", schemaName)
+		goSchema, err := g.generateGoSchema(schemaRef, []string{schemaName})
+		endSchema()
 		if err != nil {
 			return nil, fmt.Errorf("error converting Schema %s to Go type: %w", schemaName, err)
 		}
@@ -522,11 +981,16 @@ func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.
 // GenerateTypesForParameters generates type definitions for any custom types defined in the
 // components/parameters section of the Swagger spec.
 func GenerateTypesForParameters(t *template.Template, params map[string]*openapi3.ParameterRef) ([]TypeDefinition, error) {
+	return exportedGenerator().generateTypesForParameters(t, params)
+}
+
+// generateTypesForParameters does the work of GenerateTypesForParameters.
+func (g *generator) generateTypesForParameters(t *template.Template, params map[string]*openapi3.ParameterRef) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 	for _, paramName := range SortedParameterKeys(params) {
 		paramOrRef := params[paramName]
 
-		goType, err := paramToGoType(paramOrRef.Value, nil)
+		goType, err := g.paramToGoType(paramOrRef.Value, nil)
 		if err != nil {
 			return nil, fmt.Errorf("error generating Go type for schema in parameter %s: %w", paramName, err)
 		}
@@ -544,7 +1008,7 @@ func GenerateTypesForParameters(t *template.Template, params map[string]*openapi
 
 		if paramOrRef.Ref != "" {
 			// Generate a reference type for referenced parameters
-			refType, err := RefPathToGoType(paramOrRef.Ref)
+			refType, err := g.refPathToGoType(paramOrRef.Ref, true)
 			if err != nil {
 				return nil, fmt.Errorf("error generating Go type for (%s) in parameter %s: %w", paramOrRef.Ref, paramName, err)
 			}
@@ -559,6 +1023,11 @@ func GenerateTypesForParameters(t *template.Template, params map[string]*openapi
 // GenerateTypesForResponses generates type definitions for any custom types defined in the
 // components/responses section of the Swagger spec.
 func GenerateTypesForResponses(t *template.Template, responses openapi3.ResponseBodies) ([]TypeDefinition, error) {
+	return exportedGenerator().generateTypesForResponses(t, responses)
+}
+
+// generateTypesForResponses does the work of GenerateTypesForResponses.
+func (g *generator) generateTypesForResponses(t *template.Template, responses openapi3.ResponseBodies) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 
 	for _, responseName := range SortedResponsesKeys(responses) {
@@ -583,7 +1052,7 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 				continue
 			}
 
-			goType, err := GenerateGoSchema(response.Schema, []string{responseName})
+			goType, err := g.generateGoSchema(response.Schema, []string{responseName})
 			if err != nil {
 				return nil, fmt.Errorf("error generating Go type for schema in response %s: %w", responseName, err)
 			}
@@ -601,7 +1070,7 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 
 			if responseOrRef.Ref != "" {
 				// Generate a reference type for referenced parameters
-				refType, err := RefPathToGoType(responseOrRef.Ref)
+				refType, err := g.refPathToGoType(responseOrRef.Ref, true)
 				if err != nil {
 					return nil, fmt.Errorf("error generating Go type for (%s) in parameter %s: %w", responseOrRef.Ref, responseName, err)
 				}
@@ -618,9 +1087,52 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 	return types, nil
 }
 
+// generateTypesForHeaders generates type definitions for any custom types defined in the
+// components/headers section of the Swagger spec. Response headers which $ref one of them
+// use this type, so that it is shared by all the responses declaring the header.
+func (g *generator) generateTypesForHeaders(t *template.Template, headers openapi3.Headers) ([]TypeDefinition, error) {
+	var types []TypeDefinition
+	for _, headerName := range SortedHeadersKeys(headers) {
+		headerOrRef := headers[headerName]
+
+		goTypeName, err := renameHeader(headerName, headerOrRef)
+		if err != nil {
+			return nil, fmt.Errorf("error making name for components/headers/%s: %w", headerName, err)
+		}
+
+		typeDef := TypeDefinition{
+			JsonName: headerName,
+			TypeName: goTypeName,
+		}
+
+		if headerOrRef.Ref != "" {
+			// A header referencing another one is an alias of its type
+			refType, err := g.refPathToGoType(headerOrRef.Ref, true)
+			if err != nil {
+				return nil, fmt.Errorf("error generating Go type for (%s) in header %s: %w", headerOrRef.Ref, headerName, err)
+			}
+			typeDef.Schema = Schema{GoType: refType, DefineViaAlias: g.definesViaAlias()}
+		} else {
+			goType, err := g.generateGoSchema(headerOrRef.Value.Schema, []string{headerName})
+			if err != nil {
+				return nil, fmt.Errorf("error generating Go type for schema in header %s: %w", headerName, err)
+			}
+			typeDef.Schema = goType
+		}
+
+		types = append(types, typeDef)
+	}
+	return types, nil
+}
+
 // GenerateTypesForRequestBodies generates type definitions for any custom types defined in the
 // components/requestBodies section of the Swagger spec.
 func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*openapi3.RequestBodyRef) ([]TypeDefinition, error) {
+	return exportedGenerator().generateTypesForRequestBodies(t, bodies)
+}
+
+// generateTypesForRequestBodies does the work of GenerateTypesForRequestBodies.
+func (g *generator) generateTypesForRequestBodies(t *template.Template, bodies map[string]*openapi3.RequestBodyRef) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 
 	for _, requestBodyName := range SortedRequestBodyKeys(bodies) {
@@ -634,7 +1146,7 @@ func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*open
 				continue
 			}
 
-			goType, err := GenerateGoSchema(body.Schema, []string{requestBodyName})
+			goType, err := g.generateGoSchema(body.Schema, []string{requestBodyName})
 			if err != nil {
 				return nil, fmt.Errorf("error generating Go type for schema in body %s: %w", requestBodyName, err)
 			}
@@ -652,7 +1164,7 @@ func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*open
 
 			if requestBodyRef.Ref != "" {
 				// Generate a reference type for referenced bodies
-				refType, err := RefPathToGoType(requestBodyRef.Ref)
+				refType, err := g.refPathToGoType(requestBodyRef.Ref, true)
 				if err != nil {
 					return nil, fmt.Errorf("error generating Go type for (%s) in body %s: %w", requestBodyRef.Ref, requestBodyName, err)
 				}
@@ -667,6 +1179,23 @@ func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*open
 // GenerateTypes passes a bunch of types to the template engine, and buffers
 // its output into a string.
 func GenerateTypes(t *template.Template, types []TypeDefinition) (string, error) {
+	ts, err := uniqueTypes(types)
+	if err != nil {
+		return "", err
+	}
+
+	context := struct {
+		Types []TypeDefinition
+	}{
+		Types: ts,
+	}
+
+	return GenerateTemplates([]string{"typedef.tmpl"}, t, context)
+}
+
+// uniqueTypes returns types without the later definitions of a type name,
+// which must be equivalent to the first one.
+func uniqueTypes(types []TypeDefinition) ([]TypeDefinition, error) {
 	m := map[string]TypeDefinition{}
 	var ts []TypeDefinition
 
@@ -679,7 +1208,7 @@ func GenerateTypes(t *template.Template, types []TypeDefinition) (string, error)
 				continue
 			}
 			// We want to create an error when we try to define the same type twice.
-			return "", fmt.Errorf("duplicate typename '%s' detected, can't auto-rename, "+
+			return nil, fmt.Errorf("duplicate typename '%s' detected, can't auto-rename, "+
 				"please use x-go-name to specify your own name for one of them", typ.TypeName)
 		}
 
@@ -687,17 +1216,25 @@ func GenerateTypes(t *template.Template, types []TypeDefinition) (string, error)
 
 		ts = append(ts, typ)
 	}
+	return ts, nil
+}
 
-	context := struct {
-		Types []TypeDefinition
-	}{
-		Types: ts,
-	}
+func GenerateEnums(t *template.Template, types []TypeDefinition) (string, error) {
+	return exportedGenerator().generateEnums(t, types)
+}
 
-	return GenerateTemplates([]string{"typedef.tmpl"}, t, context)
+// generateEnums does the work of GenerateEnums.
+func (g *generator) generateEnums(t *template.Template, types []TypeDefinition) (string, error) {
+	enums, err := g.enumDefinitions(types)
+	if err != nil {
+		return "", err
+	}
+	return GenerateTemplates([]string{"constants.tmpl"}, t, Constants{EnumDefinitions: enums})
 }
 
-func GenerateEnums(t *template.Template, types []TypeDefinition) (string, error) {
+// enumDefinitions returns the enums of types, named so that their constants
+// don't conflict.
+func (g *generator) enumDefinitions(types []TypeDefinition) ([]EnumDefinition, error) {
 	enums := []EnumDefinition{}
 
 	// Keep track of which enums we've generated
@@ -716,11 +1253,18 @@ func GenerateEnums(t *template.Template, types []TypeDefinition) (string, error)
 			if tp.Schema.GoType == "string" {
 				wrapper = `"`
 			}
+			compact, err := g.compactEnum(tp.Schema)
+			if err != nil {
+				return nil, fmt.Errorf("error generating enum %s: %w", tp.TypeName, err)
+			}
 			enums = append(enums, EnumDefinition{
 				Schema:         tp.Schema,
 				TypeName:       tp.TypeName,
 				ValueWrapper:   wrapper,
-				PrefixTypeName: globalState.options.Compatibility.AlwaysPrefixEnumValues,
+				PrefixTypeName: g.options.Compatibility.AlwaysPrefixEnumValues,
+				Compact:        compact,
+
+				nullable: g.isNullableEnum(tp.Schema.OAPISchema),
 			})
 		}
 	}
@@ -770,17 +1314,16 @@ func GenerateEnums(t *template.Template, types []TypeDefinition) (string, error)
 
 	// Now see if enums conflict with any non-enum typenames
 
-	return GenerateTemplates([]string{"constants.tmpl"}, t, Constants{EnumDefinitions: enums})
+	return enums, nil
 }
 
-// GenerateImports generates our import statements and package definition.
-func GenerateImports(t *template.Template, externalImports []string, packageName string, versionOverride *string) (string, error) {
-	// Read build version for incorporating into generated files
+// toolVersion returns the module path and version of oapi-codegen, for
+// incorporating into generated files.
+func toolVersion(versionOverride *string) (modulePath string, moduleVersion string) {
 	// Unit tests have ok=false, so we'll just use "unknown" for the
 	// version if we can't read this.
-
-	modulePath := "unknown module path"
-	moduleVersion := "unknown version"
+	modulePath = "unknown module path"
+	moduleVersion = "unknown version"
 	if bi, ok := debug.ReadBuildInfo(); ok {
 		if bi.Main.Path != "" {
 			modulePath = bi.Main.Path
@@ -792,6 +1335,17 @@ func GenerateImports(t *template.Template, externalImports []string, packageName
 			moduleVersion = *versionOverride
 		}
 	}
+	return modulePath, moduleVersion
+}
+
+// GenerateImports generates our import statements and package definition.
+func GenerateImports(t *template.Template, externalImports []string, packageName string, versionOverride *string) (string, error) {
+	return exportedGenerator().generateImports(t, externalImports, packageName, versionOverride)
+}
+
+// generateImports does the work of GenerateImports.
+func (g *generator) generateImports(t *template.Template, externalImports []string, packageName string, versionOverride *string) (string, error) {
+	modulePath, moduleVersion := toolVersion(versionOverride)
 
 	context := struct {
 		ExternalImports   []string
@@ -804,7 +1358,7 @@ func GenerateImports(t *template.Template, externalImports []string, packageName
 		PackageName:       packageName,
 		ModuleName:        modulePath,
 		Version:           moduleVersion,
-		AdditionalImports: globalState.options.AdditionalImports,
+		AdditionalImports: g.options.AdditionalImports,
 	}
 
 	return GenerateTemplates([]string{"imports.tmpl"}, t, context)
@@ -879,6 +1433,48 @@ func GenerateUnionAndAdditionalProopertiesBoilerplate(t *template.Template, type
 	return GenerateTemplates([]string{"union-and-additional-properties.tmpl"}, t, context)
 }
 
+// generateUnknownFieldsBoilerplate generates an UnmarshalJSON method for the
+// structs which disallow additional properties, rejecting the fields their
+// schema doesn't define. Structs which already decode themselves, to handle
+// additional properties or unions, are left alone.
+func (g *generator) generateUnknownFieldsBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	var filteredTypes []TypeDefinition
+
+	m := map[string]bool{}
+
+	for _, t := range typeDefs {
+		if found := m[t.TypeName]; found {
+			continue
+		}
+
+		m[t.TypeName] = true
+
+		if g.disallowsUnknownFields(t) {
+			filteredTypes = append(filteredTypes, t)
+		}
+	}
+
+	if len(filteredTypes) == 0 {
+		return "", nil
+	}
+	context := struct {
+		Types []TypeDefinition
+	}{
+		Types: filteredTypes,
+	}
+
+	return GenerateTemplates([]string{"unknown-fields.tmpl"}, t, context)
+}
+
+// disallowsUnknownFields reports whether an UnmarshalJSON method rejecting
+// unknown fields is generated for t.
+func (g *generator) disallowsUnknownFields(t TypeDefinition) bool {
+	s := t.Schema
+	return g.options.OutputOptions.DisallowUnknownFields &&
+		s.NoAdditionalProperties && !t.IsAlias() && !s.IsRef() && isStructType(s.GoType) &&
+		!s.HasAdditionalProperties && len(s.UnionElements) == 0
+}
+
 // SanitizeCode runs sanitizers across the generated Go code to ensure the
 // generated code will be able to compile.
 func SanitizeCode(goCode string) string {
@@ -1062,9 +1658,18 @@ func GoSchemaImports(schemas ...*openapi3.SchemaRef) (map[string]goImport, error
 				res[gi.String()] = *gi
 			}
 		}
+		if extension, ok := sref.Value.Extensions[extPropGoJSONCodec]; ok {
+			codec, err := extParseGoJSONCodec(extension)
+			if err != nil {
+				return nil, err
+			}
+			if codec.Import != nil {
+				res[codec.Import.String()] = *codec.Import
+			}
+		}
 		schemaVal := sref.Value
 
-		t := schemaVal.Type
+		t := schemaType(schemaVal)
 		switch t {
 		case "", "object":
 			for _, v := range schemaVal.Properties {
@@ -1158,6 +1763,27 @@ func GetParametersImports(params map[string]*openapi3.ParameterRef) (map[string]
 	return res, nil
 }
 
+// exportedSpec and exportedOptions are the spec and the options of the last
+// call to Generate, which the exported functions generating parts of the code
+// by themselves, outside of a generation run, use. SetGlobalStateSpec sets
+// the spec too.
+var (
+	exportedSpec    atomic.Pointer[openapi3.T]
+	exportedOptions atomic.Pointer[Configuration]
+)
+
 func SetGlobalStateSpec(spec *openapi3.T) {
-	globalState.spec = spec
+	exportedSpec.Store(spec)
+}
+
+// exportedGenerator returns a run of its own for the exported functions
+// generating parts of the code by themselves, with the spec and the options
+// of the last call to Generate, the default configuration before it's
+// called.
+func exportedGenerator() *generator {
+	var opts Configuration
+	if last := exportedOptions.Load(); last != nil {
+		opts = *last
+	}
+	return newGenerator(exportedSpec.Load(), opts)
 }
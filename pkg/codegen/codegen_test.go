@@ -2,13 +2,22 @@ package codegen
 
 import (
 	_ "embed"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/lint"
+	"gopkg.in/yaml.v2"
 
 	"github.com/deepmap/oapi-codegen/v2/pkg/util"
 )
@@ -91,6 +100,12 @@ type GetTestByNameResponse struct {
 	assert.Contains(t, code, "type EnumTestEnumNames int")
 	assert.Contains(t, code, "Two  EnumTestEnumNames = 2")
 	assert.Contains(t, code, "Double EnumTestEnumVarnames = 2")
+	assert.Contains(t, code, "func (v EnumTestEnumDescriptions) Description() string {")
+	assert.Contains(t, code, `Low:  "Lowest priority",`)
+	assert.Contains(t, code, `High: "Highest \"priority\"",`)
+	assert.NotContains(t, code, "\tMedium:")
+	assert.Contains(t, code, "func (v EnumTestEnumDescriptions) IsValid() bool {")
+	assert.Contains(t, code, "case High, Low, Medium:")
 
 	// Make sure the generated code is valid:
 	checkLint(t, "test.gen.go", []byte(code))
@@ -246,5 +261,212 @@ func (t *ExampleSchema_Item) FromExternalRef0NewPet(v externalRef0.NewPet) error
 
 }
 
+func TestEnumDescriptionsLengthMismatch(t *testing.T) {
+	spec := `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: enum descriptions
+paths: {}
+components:
+  schemas:
+    Status:
+      type: string
+      enum: [active, inactive]
+      x-enum-descriptions:
+        - Active
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	_, err = Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			SkipPrune: true,
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"x-enum-descriptions" has 1 entries, but the enum has 2 values`)
+}
+
+func TestNullableUnionMember(t *testing.T) {
+	null := &openapi3.SchemaRef{Value: &openapi3.Schema{Type: "null"}}
+	pet := &openapi3.SchemaRef{Ref: "#/components/schemas/Pet", Value: openapi3.NewObjectSchema()}
+
+	assert.Equal(t, pet, nullableUnionMember(&openapi3.Schema{OneOf: openapi3.SchemaRefs{pet, null}}))
+	assert.Equal(t, pet, nullableUnionMember(&openapi3.Schema{AnyOf: openapi3.SchemaRefs{null, pet}}))
+
+	// Three members remain a union.
+	assert.Nil(t, nullableUnionMember(&openapi3.Schema{OneOf: openapi3.SchemaRefs{pet, openapi3.NewStringSchema().NewRef(), null}}))
+	// So does an inline member with a discriminator, unlike a referenced one.
+	tagged := openapi3.NewObjectSchema()
+	tagged.Discriminator = &openapi3.Discriminator{PropertyName: "kind"}
+	assert.Nil(t, nullableUnionMember(&openapi3.Schema{OneOf: openapi3.SchemaRefs{tagged.NewRef(), null}}))
+	assert.NotNil(t, nullableUnionMember(&openapi3.Schema{OneOf: openapi3.SchemaRefs{{Ref: "#/components/schemas/Tagged", Value: tagged}, null}}))
+	// And unions without a null member, or of two null members.
+	assert.Nil(t, nullableUnionMember(&openapi3.Schema{OneOf: openapi3.SchemaRefs{pet, openapi3.NewStringSchema().NewRef()}}))
+	assert.Nil(t, nullableUnionMember(&openapi3.Schema{OneOf: openapi3.SchemaRefs{null, null}}))
+	// Or which are objects with properties of their own.
+	withProperties := openapi3.NewObjectSchema().WithProperty("id", openapi3.NewStringSchema())
+	withProperties.OneOf = openapi3.SchemaRefs{pet, null}
+	assert.Nil(t, nullableUnionMember(withProperties))
+}
+
 //go:embed test_spec.yaml
 var testOpenAPIDefinition string
+
+// shuffleYAML shuffles the keys of the mappings of the YAML document doc
+// found at paths, and the items of the sequences.
+func shuffleYAML(t *testing.T, doc []byte, paths ...string) []byte {
+	var root yaml.MapSlice
+	require.NoError(t, yaml.Unmarshal(doc, &root))
+	r := rand.New(rand.NewSource(1))
+	for _, path := range paths {
+		var node interface{} = root
+		for _, key := range strings.Split(path, ".") {
+			require.IsType(t, yaml.MapSlice{}, node, path)
+			var found bool
+			for _, item := range node.(yaml.MapSlice) {
+				if item.Key == key {
+					node, found = item.Value, true
+				}
+			}
+			require.True(t, found, path)
+		}
+		switch node := node.(type) {
+		case yaml.MapSlice:
+			r.Shuffle(len(node), func(i, j int) { node[i], node[j] = node[j], node[i] })
+		case []interface{}:
+			r.Shuffle(len(node), func(i, j int) { node[i], node[j] = node[j], node[i] })
+		default:
+			t.Fatalf("%s is neither a mapping nor a sequence", path)
+		}
+	}
+	shuffled, err := yaml.Marshal(root)
+	require.NoError(t, err)
+	return shuffled
+}
+
+// generatedIdentifiers returns the names of the top level declarations and
+// methods of code.
+func generatedIdentifiers(t *testing.T, code string) []string {
+	file, err := parser.ParseFile(token.NewFileSet(), "", code, parser.SkipObjectResolution)
+	require.NoError(t, err)
+	var names []string
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			name := decl.Name.Name
+			if decl.Recv != nil {
+				name = types.ExprString(decl.Recv.List[0].Type) + "." + name
+			}
+			names = append(names, name)
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names = append(names, spec.Name.Name)
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						names = append(names, name.Name)
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestGeneratedIdentifiersIgnoreOrder(t *testing.T) {
+	generate := func(spec []byte, compatibility ...CompatibilityOptions) []string {
+		swagger, err := openapi3.NewLoader().LoadFromData(spec)
+		require.NoError(t, err)
+		cfg := Configuration{
+			PackageName: "api",
+			Generate: GenerateOptions{
+				ChiServer: true,
+				Client:    true,
+				Models:    true,
+			},
+			OutputOptions: OutputOptions{SkipPrune: true},
+		}
+		if len(compatibility) > 0 {
+			cfg.Compatibility = compatibility[0]
+		}
+		code, err := Generate(swagger, cfg)
+		require.NoError(t, err)
+		return generatedIdentifiers(t, code)
+	}
+
+	t.Run("petstore", func(t *testing.T) {
+		spec, err := os.ReadFile("../../examples/petstore-expanded/petstore-expanded.yaml")
+		require.NoError(t, err)
+		shuffled := shuffleYAML(t, spec, "paths", "components.schemas", "components.schemas.NewPet.properties")
+		require.NotEqual(t, string(spec), string(shuffled))
+
+		assert.Equal(t, generate(spec), generate(shuffled))
+	})
+
+	t.Run("union elements", func(t *testing.T) {
+		spec := []byte(`
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: unions
+paths: {}
+components:
+  schemas:
+    Value:
+      oneOf:
+        - type: string
+        - type: string
+          format: date
+        - type: number
+        - type: boolean
+        - type: array
+          items:
+            $ref: '#/components/schemas/Pair'
+        - title: Tagged pair
+          type: object
+          properties:
+            tag:
+              type: string
+        - x-go-name: NamedPair
+          type: object
+          properties:
+            name:
+              type: string
+    Pair:
+      type: object
+      properties:
+        left:
+          type: string
+`)
+		stable := CompatibilityOptions{StableUnionElementNames: true}
+		identifiers := generate(spec, stable)
+		assert.Equal(t, identifiers, generate(shuffleYAML(t, spec, "components.schemas.Value.oneOf"), stable))
+		for _, name := range []string{"ValueString", "ValueStringDate", "ValueNumber", "ValueBoolean", "ValuePairArray", "ValueTaggedPair", "NamedPair"} {
+			assert.Contains(t, identifiers, name)
+		}
+
+		identifiers = generate(spec)
+		assert.Contains(t, identifiers, "Value0")
+		assert.NotContains(t, identifiers, "ValueString")
+	})
+}
+
+func TestInstrumentationServers(t *testing.T) {
+	cfg := Configuration{
+		PackageName:   "api",
+		OutputOptions: OutputOptions{Instrumentation: true},
+	}
+	for _, generate := range []GenerateOptions{{Client: true, ChiServer: true}, {EchoServer: true}, {FiberServer: true}, {IrisServer: true}} {
+		cfg.Generate = generate
+		assert.NoError(t, cfg.Validate())
+		assert.True(t, instrumented(cfg))
+	}
+}
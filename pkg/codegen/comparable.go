@@ -0,0 +1,109 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// applyGoComparable makes the struct generated for the object schema s
+// comparable, when its x-go-comparable, given as extension, is true, so that
+// it can be used as a map key: its optional properties are values rather
+// than pointers, and properties of incomparable types, such as slices or
+// maps, are an error naming them. It reports whether it did.
+func applyGoComparable(s *Schema, extension interface{}) (bool, error) {
+	comparable, _, err := extParseGoComparable(extension)
+	if err != nil {
+		return false, fmt.Errorf("invalid value for %q: %w", extGoComparable, err)
+	}
+	if !comparable {
+		return false, nil
+	}
+	if len(s.Properties) == 0 {
+		return false, fmt.Errorf("%s requires an object with properties", extGoComparable)
+	}
+	if s.HasAdditionalProperties {
+		return false, fmt.Errorf("%s requires an object without additionalProperties, which are held in a map", extGoComparable)
+	}
+	if len(s.UnionElements) != 0 {
+		return false, fmt.Errorf("%s requires an object without anyOf or oneOf, which are held as raw JSON", extGoComparable)
+	}
+
+	var incomparable []string
+	for i, p := range s.Properties {
+		if !isComparableGoSchema(p.Schema) {
+			incomparable = append(incomparable, fmt.Sprintf("'%s' (%s)", p.JsonFieldName, p.Schema.TypeDecl()))
+		}
+		s.Properties[i].Schema.SkipOptionalPointer = true
+	}
+	if len(incomparable) != 0 {
+		return false, fmt.Errorf("%s requires properties of comparable types, which %s aren't",
+			extGoComparable, strings.Join(incomparable, ", "))
+	}
+	return true, nil
+}
+
+// isComparableGoSchema reports whether the Go type of s can be compared by
+// value: a primitive type, or one defined from one, such as an enum, or an
+// object which is itself x-go-comparable. The types of x-go-type are trusted,
+// unless they are obviously slices or maps.
+func isComparableGoSchema(s Schema) bool {
+	if s.OAPISchema == nil {
+		return false
+	}
+	if extension, found := s.OAPISchema.Extensions[extGoComparable]; found {
+		comparable, _, err := extParseGoComparable(extension)
+		return err == nil && comparable
+	}
+	if _, found := s.OAPISchema.Extensions[extPropGoType]; found {
+		return !strings.HasPrefix(s.GoType, "[]") && !strings.HasPrefix(s.GoType, "map[")
+	}
+	if len(s.UnionElements) != 0 || s.HasAdditionalProperties {
+		return false
+	}
+	switch schemaType(s.OAPISchema) {
+	case "integer", "number", "boolean":
+		return true
+	case "string":
+		switch s.OAPISchema.Format {
+		case "binary", "byte", "json":
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// hasGoComparableKey reports whether schema has x-go-comparable set to "key".
+func hasGoComparableKey(schema *openapi3.Schema) bool {
+	if schema == nil {
+		return false
+	}
+	extension, found := schema.Extensions[extGoComparable]
+	if !found {
+		return false
+	}
+	_, key, err := extParseGoComparable(extension)
+	return err == nil && key
+}
+
+// GenerateComparableKeys generates the Key methods of the types whose schema
+// has x-go-comparable set to "key", returning a canonical string of their
+// value, for use as a map key where the types themselves aren't comparable.
+func GenerateComparableKeys(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	var typeNames []string
+	m := map[string]bool{}
+	for _, td := range typeDefs {
+		if m[td.TypeName] || td.IsAlias() || td.Schema.IsRef() || !hasGoComparableKey(td.Schema.OAPISchema) {
+			continue
+		}
+		m[td.TypeName] = true
+		typeNames = append(typeNames, td.TypeName)
+	}
+	if len(typeNames) == 0 {
+		return "", nil
+	}
+	return GenerateTemplates([]string{"comparable-key.tmpl"}, t, typeNames)
+}
@@ -0,0 +1,147 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoComparable(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: x-go-comparable
+paths: {}
+components:
+  schemas:
+    Zone:
+      type: string
+      enum: [a, b]
+    Location:
+      type: object
+      x-go-comparable: true
+      required: [region]
+      properties:
+        region:
+          type: string
+        zone:
+          $ref: '#/components/schemas/Zone'
+        rack:
+          type: integer
+          nullable: true
+    Placement:
+      x-go-comparable: true
+      allOf:
+        - type: object
+          properties:
+            location:
+              $ref: '#/components/schemas/Location'
+        - type: object
+          properties:
+            weight:
+              type: number
+    Tagged:
+      type: object
+      x-go-comparable: true
+      properties:
+        name:
+          type: string
+        tags:
+          type: array
+          items:
+            type: string
+        labels:
+          type: object
+          additionalProperties:
+            type: string
+        raw:
+          type: string
+          format: byte
+    Nested:
+      type: object
+      x-go-comparable: true
+      properties:
+        inner:
+          type: object
+          properties:
+            name:
+              type: string
+    Map:
+      type: object
+      x-go-comparable: true
+      additionalProperties:
+        type: string
+    Invalid:
+      type: object
+      x-go-comparable: sometimes
+      properties:
+        name:
+          type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	g := newGenerator(swagger, Configuration{})
+
+	location, err := g.generateGoSchema(swagger.Components.Schemas["Location"], []string{"Location"})
+	require.NoError(t, err)
+	assert.Regexp(t, `Rack\s+int`+"`", location.GoType)
+	assert.Regexp(t, `Region\s+string`+"`", location.GoType)
+	assert.Regexp(t, `Zone\s+Zone`+"`", location.GoType)
+
+	placement, err := g.generateGoSchema(swagger.Components.Schemas["Placement"], []string{"Placement"})
+	require.NoError(t, err)
+	assert.Regexp(t, `Location\s+Location`+"`", placement.GoType)
+	assert.Regexp(t, `Weight\s+float32`+"`", placement.GoType)
+
+	_, err = g.generateGoSchema(swagger.Components.Schemas["Tagged"], []string{"Tagged"})
+	assert.EqualError(t, err, "x-go-comparable requires properties of comparable types, which "+
+		"'labels' (map[string]string), 'raw' ([]byte), 'tags' ([]string) aren't")
+
+	_, err = g.generateGoSchema(swagger.Components.Schemas["Nested"], []string{"Nested"})
+	assert.ErrorContains(t, err, "'inner' (struct {")
+
+	_, err = g.generateGoSchema(swagger.Components.Schemas["Map"], []string{"Map"})
+	assert.EqualError(t, err, "x-go-comparable requires an object with properties")
+
+	_, err = g.generateGoSchema(swagger.Components.Schemas["Invalid"], []string{"Invalid"})
+	assert.EqualError(t, err, `invalid value for "x-go-comparable": invalid value: "sometimes", expected true, false or "key"`)
+}
+
+func TestGoComparableKey(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: x-go-comparable
+paths: {}
+components:
+  schemas:
+    Filter:
+      type: object
+      x-go-comparable: key
+      properties:
+        key:
+          type: string
+        tags:
+          type: array
+          items:
+            type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "func (t Filter) Key() string {")
+	// The fields keep their usual pointers, and the property named key is
+	// renamed, not to collide with the method.
+	assert.Regexp(t, `Key1\s+\*string\s+`+"`json:\"key,omitempty\"`", code)
+	assert.Regexp(t, `Tags\s+\*\[\]string\s`, code)
+}
@@ -0,0 +1,340 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"golang.org/x/tools/imports"
+	"gopkg.in/yaml.v2"
+)
+
+// namingOptions are the options of a configuration which change the names of
+// the generated declarations, and which compat-aliases maps from.
+type namingOptions struct {
+	ResponseTypeSuffix     string
+	ClientTypeName         string
+	InitialismOverrides    bool
+	AlwaysPrefixEnumValues bool
+	OldEnumConflicts       bool
+}
+
+func namingOptionsOf(cfg Configuration) namingOptions {
+	n := namingOptions{
+		ResponseTypeSuffix:     cfg.OutputOptions.ResponseTypeSuffix,
+		ClientTypeName:         cfg.OutputOptions.ClientTypeName,
+		InitialismOverrides:    cfg.OutputOptions.InitialismOverrides,
+		AlwaysPrefixEnumValues: cfg.Compatibility.AlwaysPrefixEnumValues,
+		OldEnumConflicts:       cfg.Compatibility.OldEnumConflicts,
+	}
+	if n.ResponseTypeSuffix == "" {
+		n.ResponseTypeSuffix = defaultResponseTypeSuffix
+	}
+	if n.ClientTypeName == "" {
+		n.ClientTypeName = defaultClientTypeName
+	}
+	return n
+}
+
+// apply returns cfg with the naming options n.
+func (n namingOptions) apply(cfg Configuration) Configuration {
+	cfg.OutputOptions.ResponseTypeSuffix = n.ResponseTypeSuffix
+	cfg.OutputOptions.ClientTypeName = n.ClientTypeName
+	cfg.OutputOptions.InitialismOverrides = n.InitialismOverrides
+	cfg.Compatibility.AlwaysPrefixEnumValues = n.AlwaysPrefixEnumValues
+	cfg.Compatibility.OldEnumConflicts = n.OldEnumConflicts
+	return cfg
+}
+
+// compatBaseline returns the configuration, as recorded by the previous
+// manifest for the file called name, whose names compat-aliases maps to the
+// current ones: the baseline recorded by a previous run of compat-aliases, or
+// else the configuration the file was generated with. It's nil when the
+// manifest doesn't describe the file.
+func compatBaseline(previous *Manifest, name string) map[string]interface{} {
+	if previous == nil {
+		return nil
+	}
+	for _, f := range previous.Files {
+		if f.Name != name {
+			continue
+		}
+		if f.CompatBaseline != nil {
+			return f.CompatBaseline
+		}
+		return f.Configuration
+	}
+	return nil
+}
+
+// configurationFromManifest decodes a configuration recorded in a manifest.
+func configurationFromManifest(m map[string]interface{}) (Configuration, error) {
+	var cfg Configuration
+	buf, err := yaml.Marshal(m)
+	if err != nil {
+		return cfg, err
+	}
+	err = yaml.Unmarshal(buf, &cfg)
+	return cfg, err
+}
+
+// compatAliasesFileName returns the name of the file of compat-aliases, for
+// the code written to the file called name, eg, api_compat.gen.go for
+// api.gen.go.
+func compatAliasesFileName(name string) string {
+	base := strings.TrimSuffix(strings.TrimSuffix(name, ".go"), ".gen")
+	return base + "_compat.gen.go"
+}
+
+// CompatAlias describes a declaration of the file of compat-aliases, giving
+// a declaration its previous name.
+type CompatAlias struct {
+	// Kind is one of type, const, var, func and method.
+	Kind    string
+	OldName string
+	NewName string
+	// Receiver, ReceiverType, Params, Args and Results make up the wrappers
+	// of functions and methods, eg, "c", "*Client", "ctx context.Context, id
+	// string", "ctx, id" and "(*http.Response, error)".
+	Receiver     string
+	ReceiverType string
+	Params       string
+	Args         string
+	Results      string
+}
+
+// apiEntry is an exported declaration, named as in an APIModel.
+type apiEntry struct {
+	Name string
+	Decl APIDecl
+}
+
+// GenerateCompatAliases generates the file declaring the names of oldCode,
+// generated with other naming options, for the declarations of newCode
+// which were renamed: aliases of types, constants and variables, and
+// wrappers of functions and methods, all deprecated. The changes which can't
+// be expressed this way, such as renamed fields and interface methods, or
+// changed signatures, are returned rather than generated.
+func GenerateCompatAliases(t *template.Template, oldCode, newCode []byte, packageName string, versionOverride *string) (string, []string, error) {
+	fset := token.NewFileSet()
+	oldFile, err := parser.ParseFile(fset, "old.go", oldCode, parser.SkipObjectResolution)
+	if err != nil {
+		return "", nil, fmt.Errorf("error parsing the code with the previous names: %w", err)
+	}
+	newFile, err := parser.ParseFile(fset, "new.go", newCode, parser.SkipObjectResolution)
+	if err != nil {
+		return "", nil, fmt.Errorf("error parsing the code: %w", err)
+	}
+
+	aliases, unrepresentable := compatAliases(oldFile, newFile)
+
+	var imports []string
+	for _, spec := range newFile.Imports {
+		if spec.Name != nil {
+			imports = append(imports, spec.Name.Name+" "+spec.Path.Value)
+		} else {
+			imports = append(imports, spec.Path.Value)
+		}
+	}
+
+	modulePath, moduleVersion := toolVersion(versionOverride)
+	context := struct {
+		PackageName     string
+		ModuleName      string
+		Version         string
+		Imports         []string
+		Aliases         []CompatAlias
+		Unrepresentable []string
+	}{
+		PackageName:     packageName,
+		ModuleName:      modulePath,
+		Version:         moduleVersion,
+		Imports:         imports,
+		Aliases:         aliases,
+		Unrepresentable: unrepresentable,
+	}
+	code, err := GenerateTemplates([]string{"compat-aliases.tmpl"}, t, context)
+	return code, unrepresentable, err
+}
+
+// compatAliases pairs the exported declarations of oldFile and newFile, which
+// come in the same order, their names aside, and returns the aliases of those
+// which were renamed, and the changes which can't be aliased.
+func compatAliases(oldFile, newFile *ast.File) ([]CompatAlias, []string) {
+	collect := func(file *ast.File) []apiEntry {
+		var entries []apiEntry
+		walkAPI(file, func(name string, decl APIDecl) {
+			entries = append(entries, apiEntry{Name: name, Decl: decl})
+		})
+		return entries
+	}
+	olds, news := collect(oldFile), collect(newFile)
+	if len(olds) != len(news) {
+		return nil, []string{fmt.Sprintf("the previous names declare %d exported identifiers, and the current ones %d, so they can't be told apart", len(olds), len(news))}
+	}
+
+	renames := make(map[string]string)
+	current := make(map[string]bool)
+	for i, old := range olds {
+		n := news[i]
+		oldParent, _, oldMember := strings.Cut(old.Name, ".")
+		newParent, _, newMember := strings.Cut(n.Name, ".")
+		if old.Decl.Kind != n.Decl.Kind || oldMember != newMember {
+			return nil, []string{fmt.Sprintf("%s %s doesn't correspond to %s %s, so the previous names can't be told apart", old.Decl.Kind, old.Name, n.Decl.Kind, n.Name)}
+		}
+		if !oldMember && oldParent != newParent {
+			renames[oldParent] = newParent
+		}
+		current[n.Name] = true
+	}
+
+	funcs := make(map[string]*ast.FuncDecl)
+	for _, decl := range newFile.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			if fn.Recv == nil {
+				funcs[fn.Name.Name] = fn
+			} else if receiver, _, ok := apiReceiver(fn); ok {
+				funcs[receiver+"."+fn.Name.Name] = fn
+			}
+		}
+	}
+
+	var aliases []CompatAlias
+	var unrepresentable []string
+	for i, old := range olds {
+		n := news[i]
+		if renameIdentifiers(old.Decl.Signature, renames) != n.Decl.Signature {
+			unrepresentable = append(unrepresentable, fmt.Sprintf("%s changed from %s to %s", n.Name, old.Decl, n.Decl))
+			continue
+		}
+
+		oldName, newName := old.Name, n.Name
+		if i := strings.IndexByte(old.Name, '.'); i != -1 {
+			oldName, newName = old.Name[i+1:], n.Name[strings.IndexByte(n.Name, '.')+1:]
+		}
+		if oldName == newName {
+			continue
+		}
+		parent, _, _ := strings.Cut(n.Name, ".")
+		switch {
+		case n.Decl.Kind == "field" || n.Decl.Kind == "interface method":
+			unrepresentable = append(unrepresentable, fmt.Sprintf("%s %s was renamed to %s, which can't be aliased", n.Decl.Kind, old.Name, n.Name))
+			continue
+		case n.Decl.Kind == "method" && current[parent+"."+oldName]:
+			unrepresentable = append(unrepresentable, fmt.Sprintf("method %s was renamed to %s, and %s declares another %s", old.Name, n.Name, parent, oldName))
+			continue
+		case n.Decl.Kind != "method" && current[oldName]:
+			unrepresentable = append(unrepresentable, fmt.Sprintf("%s was renamed to %s, and %s now names another declaration", old.Name, n.Name, oldName))
+			continue
+		}
+
+		alias := CompatAlias{Kind: n.Decl.Kind, OldName: oldName, NewName: newName}
+		if alias.Kind == "func" || alias.Kind == "method" {
+			if !wrapFunc(&alias, funcs[n.Name]) {
+				unrepresentable = append(unrepresentable, fmt.Sprintf("%s %s was renamed to %s, which is generic", n.Decl.Kind, old.Name, n.Name))
+				continue
+			}
+		}
+		aliases = append(aliases, alias)
+	}
+	return aliases, unrepresentable
+}
+
+var identifierPattern = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+// renameIdentifiers renames the identifiers of the signature s, leaving
+// alone those qualified by a package, eg, the UUID of openapi_types.UUID.
+func renameIdentifiers(s string, renames map[string]string) string {
+	var b strings.Builder
+	last := 0
+	for _, match := range identifierPattern.FindAllStringIndex(s, -1) {
+		start, end := match[0], match[1]
+		qualified := start > 0 && s[start-1] == '.' && (start < 2 || s[start-2] != '.')
+		if renamed, ok := renames[s[start:end]]; ok && !qualified {
+			b.WriteString(s[last:start])
+			b.WriteString(renamed)
+			last = end
+		}
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// wrapFunc fills in the receiver, parameters, arguments and results of the
+// wrapper of fn in alias, unless fn is generic.
+func wrapFunc(alias *CompatAlias, fn *ast.FuncDecl) bool {
+	if fn == nil || fn.Type.TypeParams != nil {
+		return false
+	}
+	if fn.Recv != nil {
+		field := fn.Recv.List[0]
+		name := "recv"
+		if len(field.Names) != 0 && field.Names[0].Name != "_" {
+			name = field.Names[0].Name
+		}
+		alias.Receiver, alias.ReceiverType = name, types.ExprString(field.Type)
+	}
+
+	var params, args []string
+	for _, field := range fn.Type.Params.List {
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{{Name: "_"}}
+		}
+		for _, name := range names {
+			paramName := name.Name
+			if paramName == "_" {
+				paramName = fmt.Sprintf("p%d", len(params))
+			}
+			params = append(params, paramName+" "+types.ExprString(field.Type))
+			if _, variadic := field.Type.(*ast.Ellipsis); variadic {
+				paramName += "..."
+			}
+			args = append(args, paramName)
+		}
+	}
+	alias.Params = strings.Join(params, ", ")
+	alias.Args = strings.Join(args, ", ")
+
+	var results []string
+	if fn.Type.Results != nil {
+		for _, field := range unnamedFields(fn.Type.Results).List {
+			results = append(results, types.ExprString(field.Type))
+		}
+	}
+	switch len(results) {
+	case 0:
+	case 1:
+		alias.Results = results[0]
+	default:
+		alias.Results = "(" + strings.Join(results, ", ") + ")"
+	}
+	return true
+}
+
+// compatAliasesFile generates the file of compat-aliases, formatted unless
+// cfg skips it, mapping the names of previousCode to those of code.
+func (g *generator) compatAliasesFile(previousCode, code string, cfg Configuration) (string, []string, error) {
+	t := template.New("oapi-codegen").Funcs(g.templateFunctions())
+	if err := LoadTemplates(templates, t); err != nil {
+		return "", nil, fmt.Errorf("error parsing oapi-codegen templates: %w", err)
+	}
+
+	out, unrepresentable, err := GenerateCompatAliases(t, []byte(previousCode), []byte(code), cfg.PackageName, cfg.NoVCSVersionOverride)
+	if err != nil {
+		return "", nil, err
+	}
+	if cfg.OutputOptions.SkipFmt {
+		return out, unrepresentable, nil
+	}
+	formatted, err := imports.Process(cfg.PackageName+"_compat.gen.go", []byte(out), nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("error formatting compat aliases %s: %w", out, err)
+	}
+	return string(formatted), unrepresentable, nil
+}
@@ -0,0 +1,98 @@
+package codegen
+
+import (
+	"context"
+	"encoding/json"
+	"go/parser"
+	"go/token"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// generateWithManifest runs GenerateFiles with compat-aliases, returning the
+// files and the manifest they include.
+func generateWithManifest(t *testing.T, cfg Configuration, previous *Manifest) (map[string][]byte, *Manifest, []Warning) {
+	t.Helper()
+	cfg.PackageName = "api"
+	cfg.Generate = GenerateOptions{Models: true, Client: true}
+	cfg.OutputOptions.Manifest = true
+	cfg.OutputOptions.CompatAliases = true
+	cfg.PreviousManifest = previous
+	files, warnings, err := GenerateFiles(context.Background(), []byte(testOpenAPIDefinition), cfg)
+	require.NoError(t, err)
+
+	var m Manifest
+	require.NoError(t, json.Unmarshal(files[ManifestFileName], &m))
+	return files, &m, warnings
+}
+
+func TestGenerateFilesCompatAliases(t *testing.T) {
+	_, first, _ := generateWithManifest(t, Configuration{}, nil)
+
+	renamed := Configuration{OutputOptions: OutputOptions{ClientTypeName: "APIClient", ResponseTypeSuffix: "Reply"}}
+	files, second, warnings := generateWithManifest(t, renamed, first)
+	assert.Empty(t, warnings)
+	compat := string(files["api_compat.gen.go"])
+	_, err := parser.ParseFile(token.NewFileSet(), "api_compat.gen.go", compat, 0)
+	require.NoError(t, err)
+	assert.Contains(t, compat, "type Client = APIClient")
+	assert.Contains(t, compat, "type GetTestByNameResponse = GetTestByNameReply")
+	assert.Contains(t, compat, "func ParseGetTestByNameResponse(rsp *http.Response) (*GetTestByNameReply, error) {\n\treturn ParseGetTestByNameReply(rsp)\n}")
+	require.Len(t, second.Files, 1)
+	assert.Equal(t, first.Files[0].Configuration, second.Files[0].CompatBaseline)
+
+	// The aliases are kept by later generations with the same options, and
+	// dropped once the options are set back.
+	files, third, _ := generateWithManifest(t, renamed, second)
+	assert.Equal(t, compat, string(files["api_compat.gen.go"]))
+	assert.Equal(t, second.Files[0].CompatBaseline, third.Files[0].CompatBaseline)
+
+	files, fourth, _ := generateWithManifest(t, Configuration{}, third)
+	assert.NotContains(t, string(files["api_compat.gen.go"]), "Deprecated")
+	assert.Nil(t, fourth.Files[0].CompatBaseline)
+}
+
+func TestGenerateCompatAliasesUnrepresentable(t *testing.T) {
+	oldCode := `package api
+
+type PetId string
+
+type Pet struct {
+	PetId PetId
+}
+
+type Store interface {
+	GetPetId() PetId
+}
+
+func (p Pet) GetPetId() PetId { return p.PetId }
+`
+	newCode := `package api
+
+type PetID string
+
+type Pet struct {
+	PetID PetID
+}
+
+type Store interface {
+	GetPetID() PetID
+}
+
+func (p Pet) GetPetID() PetID { return p.PetID }
+`
+	tmpl := template.New("oapi-codegen").Funcs(TemplateFunctions)
+	require.NoError(t, LoadTemplates(templates, tmpl))
+	code, unrepresentable, err := GenerateCompatAliases(tmpl, []byte(oldCode), []byte(newCode), "api", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"field Pet.PetId was renamed to Pet.PetID, which can't be aliased",
+		"interface method Store.GetPetId was renamed to Store.GetPetID, which can't be aliased",
+	}, unrepresentable)
+	assert.Contains(t, code, "type PetId = PetID")
+	assert.Contains(t, code, "func (p Pet) GetPetId() PetID {\n\treturn p.GetPetID()\n}")
+	assert.Contains(t, code, "//   - field Pet.PetId was renamed to Pet.PetID, which can't be aliased")
+}
@@ -2,6 +2,8 @@ package codegen
 
 import (
 	"errors"
+	"fmt"
+	"path/filepath"
 	"reflect"
 )
 
@@ -21,6 +23,22 @@ type Configuration struct {
 	// NoVCSVersionOverride allows overriding the version of the application for cases where no Version Control System (VCS) is available when building, for instance when using a Nix derivation.
 	// See documentation for how to use it in examples/no-vcs-version-override/README.md
 	NoVCSVersionOverride *string `yaml:"-"`
+	// SpecLocation is the path or URL of the spec passed to GenerateFiles,
+	// against which its relative references are resolved.
+	SpecLocation string `yaml:"-"`
+	// Loader reads the documents referenced by the spec passed to
+	// GenerateFiles. They are read from the file system or network when nil.
+	Loader DocumentLoader `yaml:"-"`
+	// OutputFileName is the name GenerateFiles gives to the generated code,
+	// <package>.gen.go when empty.
+	OutputFileName string `yaml:"-"`
+	// Progress, when set, is called as generation moves through its phases.
+	Progress ProgressFunc `yaml:"-"`
+	// Timing, when set, receives the timings of the steps of generation.
+	Timing TimingFunc `yaml:"-"`
+	// PreviousManifest is the manifest of the previous generation, which
+	// compat-aliases reads the naming options of the previous names from.
+	PreviousManifest *Manifest `yaml:"-"`
 }
 
 // GenerateOptions specifies which supported output formats to generate.
@@ -35,6 +53,16 @@ type GenerateOptions struct {
 	Client        bool `yaml:"client,omitempty"`         // Client specifies whether to generate client boilerplate
 	Models        bool `yaml:"models,omitempty"`         // Models specifies whether to generate type definitions
 	EmbeddedSpec  bool `yaml:"embedded-spec,omitempty"`  // Whether to embed the swagger spec in the generated code
+	CORS          bool `yaml:"cors,omitempty"`           // CORS specifies whether to generate the CORS policy table and preflight middleware
+	RouteTable    bool `yaml:"route-table,omitempty"`    // RouteTable specifies whether to generate the table of operation routes and their matcher
+	// OperationSchemas specifies whether to generate accessors returning the JSON Schema of the request bodies and responses of operations
+	OperationSchemas bool `yaml:"operation-schemas,omitempty"`
+	// ResponseParsers specifies whether to generate the response types and their Parse functions without the client, which generates them too
+	ResponseParsers bool `yaml:"response-parsers,omitempty"`
+	// ParamExampleTests specifies whether to generate a test file round-tripping the examples of the parameters through the client styling and the server binding
+	ParamExampleTests bool `yaml:"param-example-tests,omitempty"`
+	// TestHarness specifies whether to generate NewTestClient, returning a client of a strict server served in process by the generated server
+	TestHarness bool `yaml:"test-harness,omitempty"`
 }
 
 // CompatibilityOptions specifies backward compatibility settings for the
@@ -79,6 +107,38 @@ type CompatibilityOptions struct {
 	// This resolves the behavior such that middlewares are chained in the order they are invoked.
 	// Please see https://github.com/deepmap/oapi-codegen/issues/841
 	ApplyGorillaMiddlewareFirstToLast bool `yaml:"apply-gorilla-middleware-first-to-last,omitempty"`
+	// AllOfMergeSemantics selects how the constraints of allOf members are
+	// merged. "union", the default, unions their enums and errors out when
+	// their uniqueItems differ. "intersect" follows JSON Schema, where every
+	// member has to hold: enums are intersected, and uniqueItems is kept when
+	// any member sets it. Either way, the tightest bounds are kept.
+	AllOfMergeSemantics AllOfMergeSemantics `yaml:"allof-merge-semantics,omitempty"`
+	// AllOfNullableResolution selects how allOf members which disagree on
+	// nullable, readOnly or writeOnly are merged. "error", the default, fails
+	// the generation. "strictest" keeps the value allowing the least, as JSON
+	// Schema does: the merged schema isn't nullable, but is read-only, or
+	// write-only. "loosest" keeps the other one: the merged schema is
+	// nullable, but neither read-only nor write-only.
+	AllOfNullableResolution AllOfNullableResolution `yaml:"allof-nullable-resolution,omitempty"`
+	// In the past, the AdditionalProperties field of the structs generated for
+	// objects with additionalProperties held interface{} values, whatever the
+	// schema of those properties. Set OldAdditionalPropertiesType to true to
+	// keep it that way while migrating to the typed Get and Set accessors,
+	// which are generated either way.
+	OldAdditionalPropertiesType bool `yaml:"old-additional-properties-type,omitempty"`
+	// The types of the inline elements of a oneOf or anyOf are named after
+	// their position, eg, Pet0, so that reordering the elements renames them,
+	// along with the helpers of the union, eg, AsPet0. Set
+	// StableUnionElementNames to true to name them after their title, or their
+	// type when it isn't an object, eg, PetString, instead.
+	StableUnionElementNames bool `yaml:"stable-union-element-names,omitempty"`
+	// Properties and parameters of `format: date` are generated as
+	// openapi_types.Date, which the runtime parses with errors which can't be
+	// told apart from others. Set StrictDates to true to generate them as the
+	// StrictDate type generated along with the models instead, which rejects
+	// dates with a time or zone component with a *StrictDateError, and which
+	// the clients format without converting it to any location.
+	StrictDates bool `yaml:"strict-dates,omitempty"`
 	// CircularReferenceLimit allows controlling the limit for circular reference checking.
 	// In some OpenAPI specifications, we have a higher number of circular
 	// references than is allowed out-of-the-box, but can be tuned to allow
@@ -86,6 +146,59 @@ type CompatibilityOptions struct {
 	CircularReferenceLimit int `yaml:"circular-reference-limit"`
 }
 
+// AllOfMergeSemantics is the way allOf members are merged, see
+// CompatibilityOptions.AllOfMergeSemantics.
+type AllOfMergeSemantics string
+
+const (
+	AllOfMergeUnion     AllOfMergeSemantics = "union"
+	AllOfMergeIntersect AllOfMergeSemantics = "intersect"
+)
+
+// AllOfNullableResolution is the way allOf members disagreeing on nullable,
+// readOnly or writeOnly are merged, see
+// CompatibilityOptions.AllOfNullableResolution.
+type AllOfNullableResolution string
+
+const (
+	AllOfNullableError     AllOfNullableResolution = "error"
+	AllOfNullableStrictest AllOfNullableResolution = "strictest"
+	AllOfNullableLoosest   AllOfNullableResolution = "loosest"
+)
+
+// EnumMergeStrategy is the way the enums of allOf members are merged, see
+// OutputOptions.EnumMergeStrategy.
+type EnumMergeStrategy string
+
+const (
+	EnumMergeUnion     EnumMergeStrategy = "union"
+	EnumMergeIntersect EnumMergeStrategy = "intersect"
+)
+
+// The default limits of the allOf compositions, see OutputOptions.AllOfMaxDepth.
+const (
+	DefaultAllOfMaxDepth = 32
+	DefaultAllOfMaxWidth = 1024
+)
+
+// AllOfDocStrategy is the way the descriptions and titles of allOf members
+// are merged, see OutputOptions.AllOfDocStrategy.
+type AllOfDocStrategy string
+
+const (
+	AllOfDocMostSpecific AllOfDocStrategy = "most-specific"
+	AllOfDocConcatenate  AllOfDocStrategy = "concatenate"
+)
+
+// PropertyOrder is the order of the fields of the generated structs, see
+// OutputOptions.PropertyOrder.
+type PropertyOrder string
+
+const (
+	PropertyOrderSorted   PropertyOrder = "sorted"
+	PropertyOrderDeclared PropertyOrder = "declared"
+)
+
 // OutputOptions are used to modify the output code in some way.
 type OutputOptions struct {
 	SkipFmt       bool              `yaml:"skip-fmt,omitempty"`       // Whether to skip go imports on the generated code
@@ -98,6 +211,204 @@ type OutputOptions struct {
 	ResponseTypeSuffix  string   `yaml:"response-type-suffix,omitempty"` // The suffix used for responses types
 	ClientTypeName      string   `yaml:"client-type-name,omitempty"`     // Override the default generated client type with the value
 	InitialismOverrides bool     `yaml:"initialism-overrides,omitempty"` // Whether to use the initialism overrides
+
+	// Manifest additionally generates a gen-manifest.json describing the
+	// generated code, and the spec and configuration it was generated from.
+	Manifest bool `yaml:"manifest,omitempty"`
+
+	// DisallowUnknownFields generates an UnmarshalJSON method for the structs
+	// whose schema sets additionalProperties to false, rejecting the fields
+	// they don't define.
+	DisallowUnknownFields bool `yaml:"disallow-unknown-fields,omitempty"`
+
+	// NormalizeEmptyCollections keeps absent arrays and maps nil, and present
+	// ones non-nil even when empty, both ways: the AdditionalProperties of a
+	// decoded object are never nil, and the arrays and maps held by fields
+	// which aren't pointers, but are omitted when empty, are only omitted when
+	// nil, an empty one being encoded as [] or {}.
+	NormalizeEmptyCollections bool `yaml:"normalize-empty-collections,omitempty"`
+
+	// CompatAliases additionally generates <file>_compat.gen.go, declaring
+	// the previous names of the declarations renamed since the naming
+	// options recorded in the manifest changed, which are the
+	// response-type-suffix, client-type-name and initialism-overrides output
+	// options, and the always-prefix-enum-values and old-enum-conflicts
+	// compatibility options. It requires manifest.
+	CompatAliases bool `yaml:"compat-aliases,omitempty"`
+
+	// ServerInterfacePerTag additionally generates a server interface per tag,
+	// NewServerFromTags composing them into a ServerInterface, and functions
+	// registering the routes of a single tag. Only chi and echo servers are
+	// supported.
+	ServerInterfacePerTag bool `yaml:"server-interface-per-tag,omitempty"`
+
+	// ModelValidation generates a Validate method for the structs whose
+	// schema has constraints their Go type doesn't enforce, which are the
+	// uniqueItems, minItems and maxItems of arrays and the minProperties and
+	// maxProperties of objects, and for arrays and maps with such constraints.
+	ModelValidation bool `yaml:"model-validation,omitempty"`
+
+	// StrictItemCounts makes the strict server check the minItems and
+	// maxItems of array parameters and JSON request bodies before calling
+	// the handler, failing requests with an *ItemCountError.
+	StrictItemCounts bool `yaml:"strict-item-counts,omitempty"`
+
+	// ScopeChecks generates RequiredScopes, returning the security
+	// requirements of the operations as the scopes they require, and
+	// CheckScopes, checking granted scopes against them. The strict server
+	// checks the scopes put in the context of requests with WithGrantedScopes
+	// before binding them, failing those without with a 403 listing the
+	// missing scopes.
+	ScopeChecks bool `yaml:"scope-checks,omitempty"`
+
+	// ParamDefaults makes the servers set the optional parameters a request
+	// leaves out to the defaults of their schemas, with the ApplyDefaults
+	// method of the Params types, which records in a <Param>Set field whether
+	// the request carried them. The defaults are checked against their
+	// schemas, failing the generation when they don't satisfy them.
+	ParamDefaults bool `yaml:"param-defaults,omitempty"`
+
+	// AllOfDocStrategy selects the doc comment of the types merged from an
+	// allOf. "most-specific", the default, takes the description and title of
+	// the schema itself, or else of the last inline member having them, or
+	// else of the last member. "concatenate" joins the descriptions of the
+	// schema and of its members, in order, skipping repeated ones, with blank
+	// lines, and takes the first title.
+	AllOfDocStrategy AllOfDocStrategy `yaml:"allof-doc-strategy,omitempty"`
+
+	// EnumTextInterfaces generates MarshalText and UnmarshalText methods for
+	// the enum types, the latter failing for values which aren't one of the
+	// enum's, and makes the servers bind enum parameters through them. Their
+	// JSON encoding is left unchanged.
+	EnumTextInterfaces bool `yaml:"enum-text-interfaces,omitempty"`
+
+	// CompactEnumThreshold is the number of values above which an enum is
+	// generated in a compact form, its values listed in a slice, which
+	// IsValid looks them up in, rather than as constants and a switch, unless
+	// it has x-enum-varnames. Zero leaves all enums in the usual form.
+	CompactEnumThreshold int `yaml:"compact-enum-threshold,omitempty"`
+
+	// EnumMergeStrategy selects how the enums of allOf members are merged,
+	// "union", the default, or "intersect", which keeps the values allowed by
+	// every member, an empty intersection being an error. The other
+	// constraints are merged as the allof-merge-semantics compatibility option
+	// says. Left unset, the enums are merged as that option says too, its
+	// "intersect" intersecting them; when set, it takes precedence over it.
+	EnumMergeStrategy EnumMergeStrategy `yaml:"enum-merge-strategy,omitempty"`
+
+	// PropertyOrder selects the order of the fields of the generated structs.
+	// "sorted", the default, sorts them by the names of their properties.
+	// "declared" keeps the order the spec declares the properties in, those of
+	// an allOf in the order they're first declared by its members, a member
+	// overriding a property leaving it where it was first declared.
+	PropertyOrder PropertyOrder `yaml:"property-order,omitempty"`
+
+	// AllOfMaxDepth is the number of levels allOfs may be nested, through
+	// their members or the schemas these reference, and AllOfMaxWidth the
+	// number of members an allOf may have, beyond which generation fails,
+	// naming the allOf, rather than taking ever longer to merge them. Zero
+	// leaves the defaults, DefaultAllOfMaxDepth and DefaultAllOfMaxWidth.
+	AllOfMaxDepth int `yaml:"allof-max-depth,omitempty"`
+	AllOfMaxWidth int `yaml:"allof-max-width,omitempty"`
+
+	// PreferEmbeddedStructsForAllOf generates the structs merged from an
+	// allOf of references and at most one inline object as embedding the
+	// referenced types, rather than copying their fields, so that their
+	// methods are promoted. The allOfs which embedding can't generate like
+	// flattening are flattened still.
+	PreferEmbeddedStructsForAllOf bool `yaml:"prefer-embedded-structs-for-allof,omitempty"`
+
+	// FlattenAnyOfObjects generates the anyOfs of objects as a single struct
+	// with the properties of all the members, all optional, as for an allOf,
+	// rather than as a union. The anyOfs whose members aren't all objects, or
+	// declare the same property differently, are generated as unions still.
+	FlattenAnyOfObjects bool `yaml:"flatten-anyof-objects,omitempty"`
+
+	// OperationSchemaRefDepth is the number of nested references inlined in
+	// the schemas generated for operation-schemas, beyond which they point to
+	// the $defs of the schema. All of them are inlined when it's 0, except
+	// those making cycles.
+	OperationSchemaRefDepth int `yaml:"operation-schema-ref-depth,omitempty"`
+
+	// IsZeroMethods generates an IsZero method for every generated struct,
+	// reporting whether all of its fields are unset.
+	IsZeroMethods bool `yaml:"is-zero-methods,omitempty"`
+
+	// CanonicalHash generates, for every generated type, a
+	// MarshalCanonicalJSON method, encoding it as canonical JSON, the same
+	// for equal values, and a CanonicalHash method, returning the SHA-256
+	// hash of that encoding, eg, to deduplicate payloads.
+	CanonicalHash bool `yaml:"canonical-hash,omitempty"`
+
+	// SkipUnionMergeMethods leaves out the Merge<Member> methods of unions,
+	// the only helpers of the models which need the runtime package, so that
+	// models generated on their own only import the standard library, unless
+	// their types come from elsewhere, such as dates or UUIDs.
+	SkipUnionMergeMethods bool `yaml:"skip-union-merge-methods,omitempty"`
+
+	// RuntimeExtensions generates OperationExtensions, returning the vendor
+	// extensions of the operations, and a FieldExtensions method for the
+	// structs whose properties have some, with their values decoded at
+	// generation time.
+	RuntimeExtensions bool `yaml:"runtime-extensions,omitempty"`
+
+	// URITemplateExtensions names the vendor extensions of operations holding
+	// RFC 6570 URI templates, eg, x-self-link, for which an expansion helper
+	// is generated, taking the variables named after a parameter of the
+	// operation with the type of that parameter, and the others as strings.
+	URITemplateExtensions []string `yaml:"uri-template-extensions,omitempty"`
+
+	// CorrelationHeader names a header, eg, X-Request-Id, carrying an ID
+	// correlating requests. When operations declare it, as a header parameter
+	// or a response header, WithCorrelationID and CorrelationID are generated
+	// to hold it in a context, from which the client sends it, in which the
+	// server wrappers put the one received, and from which the strict server
+	// fills in the responses declaring it.
+	CorrelationHeader string `yaml:"correlation-header,omitempty"`
+
+	// Instrumentation generates the Instrumentation interface, told when
+	// each operation starts and how it ends, which the client and the servers
+	// take in their options, so that metrics can be gathered without
+	// depending on a particular library. The echo server gets the
+	// EchoServerOptions taking it.
+	Instrumentation bool `yaml:"instrumentation,omitempty"`
+
+	// SchemaNames generates SchemaName and SchemaPointer methods for the types
+	// generated from schemas, returning the name of the component schema, or
+	// the path synthesized for an inline one, and its JSON pointer in the spec.
+	SchemaNames bool `yaml:"schema-names,omitempty"`
+
+	// InterfacesFor lists the schemas, by name, whose struct gets a getter for
+	// each of its fields, and a <Name>Like interface of these getters, as do
+	// the schemas setting x-go-interface.
+	InterfacesFor []string `yaml:"interfaces-for,omitempty"`
+
+	// RoutePrefix is prepended to the path of every operation when registering
+	// server routes, building client requests and in the CORS policy table. The
+	// embedded spec is left untouched, but GetSwagger reports the prefixed paths.
+	RoutePrefix string `yaml:"route-prefix,omitempty"`
+	// RoutePrefixFromVersion derives the route prefix from info.version instead,
+	// eg, "major" turns version 1.2.3 into /v1.
+	RoutePrefixFromVersion string `yaml:"route-prefix-from-version,omitempty"`
+
+	// EmbeddedSpecPackage generates the embedded spec in a package of its
+	// own, so that only the programs calling GetSwagger link it in. The
+	// embedded-spec target is still required.
+	EmbeddedSpecPackage *EmbeddedSpecPackage `yaml:"embedded-spec-package,omitempty"`
+}
+
+// EmbeddedSpecPackage describes the package the embedded spec is generated in,
+// apart from the rest of the code.
+type EmbeddedSpecPackage struct {
+	// Package is the name of the package.
+	Package string `yaml:"package"`
+	// Output is the path of its file, relative to the directory of the rest
+	// of the code, eg, spec/spec.gen.go.
+	Output string `yaml:"output"`
+	// ImportMapping maps the external references of the spec to the packages
+	// holding their embedded spec, which are those of import-mapping unless
+	// they split it out too.
+	ImportMapping map[string]string `yaml:"import-mapping,omitempty"`
 }
 
 // UpdateDefaults sets reasonable default values for unset fields in Configuration
@@ -138,5 +449,83 @@ func (o Configuration) Validate() error {
 	if nServers > 1 {
 		return errors.New("only one server type is supported at a time")
 	}
+
+	switch o.Compatibility.AllOfMergeSemantics {
+	case "", AllOfMergeUnion, AllOfMergeIntersect:
+	default:
+		return fmt.Errorf("unsupported allof-merge-semantics %q, use %q or %q",
+			o.Compatibility.AllOfMergeSemantics, AllOfMergeUnion, AllOfMergeIntersect)
+	}
+	switch o.Compatibility.AllOfNullableResolution {
+	case "", AllOfNullableError, AllOfNullableStrictest, AllOfNullableLoosest:
+	default:
+		return fmt.Errorf("unsupported allof-nullable-resolution %q, use %q, %q or %q",
+			o.Compatibility.AllOfNullableResolution, AllOfNullableError, AllOfNullableStrictest, AllOfNullableLoosest)
+	}
+
+	switch o.OutputOptions.EnumMergeStrategy {
+	case "", EnumMergeUnion, EnumMergeIntersect:
+	default:
+		return fmt.Errorf("unsupported enum-merge-strategy %q, use %q or %q",
+			o.OutputOptions.EnumMergeStrategy, EnumMergeUnion, EnumMergeIntersect)
+	}
+
+	switch o.OutputOptions.AllOfDocStrategy {
+	case "", AllOfDocMostSpecific, AllOfDocConcatenate:
+	default:
+		return fmt.Errorf("unsupported allof-doc-strategy %q, use %q or %q",
+			o.OutputOptions.AllOfDocStrategy, AllOfDocMostSpecific, AllOfDocConcatenate)
+	}
+
+	switch o.OutputOptions.PropertyOrder {
+	case "", PropertyOrderSorted, PropertyOrderDeclared:
+	default:
+		return fmt.Errorf("unsupported property-order %q, use %q or %q",
+			o.OutputOptions.PropertyOrder, PropertyOrderSorted, PropertyOrderDeclared)
+	}
+
+	if o.OutputOptions.AllOfMaxDepth < 0 || o.OutputOptions.AllOfMaxWidth < 0 {
+		return errors.New("allof-max-depth and allof-max-width can't be negative")
+	}
+
+	if o.OutputOptions.ServerInterfacePerTag && !o.Generate.ChiServer && !o.Generate.EchoServer {
+		return errors.New("server-interface-per-tag requires chi-server or echo-server")
+	}
+
+	if o.OutputOptions.RoutePrefix != "" && o.OutputOptions.RoutePrefixFromVersion != "" {
+		return errors.New("route-prefix and route-prefix-from-version can't be used together")
+	}
+	if v := o.OutputOptions.RoutePrefixFromVersion; v != "" && v != "major" {
+		return fmt.Errorf("unsupported route-prefix-from-version %q, only \"major\" is supported", v)
+	}
+
+	if o.OutputOptions.CompatAliases && !o.OutputOptions.Manifest {
+		return errors.New("compat-aliases requires manifest")
+	}
+
+	if o.Generate.ParamExampleTests && !o.Generate.Models {
+		return errors.New("param-example-tests requires models")
+	}
+
+	if o.Generate.TestHarness {
+		if !o.Generate.Client || !o.Generate.Strict {
+			return errors.New("test-harness requires client and strict-server")
+		}
+		if nServers == 0 && !o.Generate.GorillaServer {
+			return errors.New("test-harness requires a server")
+		}
+	}
+
+	if p := o.OutputOptions.EmbeddedSpecPackage; p != nil {
+		if !o.Generate.EmbeddedSpec {
+			return errors.New("embedded-spec-package requires embedded-spec")
+		}
+		if p.Package == "" || p.Output == "" {
+			return errors.New("embedded-spec-package requires a package and an output")
+		}
+		if filepath.IsAbs(p.Output) {
+			return errors.New("the output of embedded-spec-package must be relative to the output of the code")
+		}
+	}
 	return nil
 }
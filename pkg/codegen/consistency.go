@@ -0,0 +1,421 @@
+package codegen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// checkConsistency checks the schemas of spec for the inconsistencies the
+// loader lets through, but which the generated code can't follow: required
+// properties which aren't declared, discriminators whose property the
+// schemas they map to don't declare, examples of the wrong kind, and
+// properties whose names differ only by case. Those the generated code
+// can't be right with are returned as errors, naming where they are, the
+// others are recorded as warnings.
+func (g *generator) checkConsistency(spec *openapi3.T) error {
+	c := consistencyCheck{g: g, spec: spec, visited: make(map[*openapi3.Schema]bool)}
+	if components := spec.Components; components != nil {
+		for _, name := range SortedSchemaKeys(components.Schemas) {
+			if StringInArray(name, g.options.OutputOptions.ExcludeSchemas) {
+				continue
+			}
+			c.checkSchemaRef(components.Schemas[name], "components.schemas."+name, false)
+		}
+		for _, name := range SortedParameterKeys(components.Parameters) {
+			if parameter := components.Parameters[name]; !isLocalRef(parameter.Ref) && parameter.Value != nil {
+				c.checkSchemaRef(parameter.Value.Schema, "components.parameters."+name, false)
+				c.checkContent(parameter.Value.Content, "components.parameters."+name)
+			}
+		}
+		for _, name := range SortedHeadersKeys(components.Headers) {
+			c.checkHeader(components.Headers[name], "components.headers."+name)
+		}
+		for _, name := range SortedRequestBodyKeys(components.RequestBodies) {
+			c.checkRequestBody(components.RequestBodies[name], "components.requestBodies."+name)
+		}
+		for _, name := range SortedResponsesKeys(components.Responses) {
+			c.checkResponse(components.Responses[name], "components.responses."+name)
+		}
+	}
+	if spec.Paths != nil {
+		paths := spec.Paths.Map()
+		for _, path := range SortedPathsKeys(paths) {
+			item := paths[path]
+			for _, parameter := range item.Parameters {
+				c.checkParameter(parameter, "paths."+path+".parameters")
+			}
+			operations := item.Operations()
+			for _, method := range SortedOperationsKeys(operations) {
+				op := operations[method]
+				location := operationLocation(path, method)
+				for _, parameter := range op.Parameters {
+					c.checkParameter(parameter, location+".parameters")
+				}
+				c.checkRequestBody(op.RequestBody, location+".requestBody")
+				if op.Responses != nil {
+					responses := op.Responses.Map()
+					for _, status := range SortedResponsesKeys(responses) {
+						c.checkResponse(responses[status], location+".responses."+status)
+					}
+				}
+			}
+		}
+	}
+	return errors.Join(c.errs...)
+}
+
+// consistencyCheck is the state of checkConsistency.
+type consistencyCheck struct {
+	g    *generator
+	spec *openapi3.T
+	// visited are the schemas checked already, each of which is checked
+	// once, where it's first found.
+	visited map[*openapi3.Schema]bool
+	errs    []error
+}
+
+// fail records the inconsistency at location as an error.
+func (c *consistencyCheck) fail(location string, format string, args ...interface{}) {
+	c.errs = append(c.errs, fmt.Errorf("%s: %s", location, fmt.Sprintf(format, args...)))
+}
+
+// checkParameter checks the parameter of ref, one of the parameters at
+// location, which it's named after.
+func (c *consistencyCheck) checkParameter(ref *openapi3.ParameterRef, location string) {
+	if ref == nil || ref.Value == nil || isLocalRef(ref.Ref) {
+		return
+	}
+	location += "." + ref.Value.Name
+	c.checkSchemaRef(ref.Value.Schema, location, false)
+	c.checkContent(ref.Value.Content, location)
+}
+
+func (c *consistencyCheck) checkHeader(ref *openapi3.HeaderRef, location string) {
+	if ref == nil || ref.Value == nil || isLocalRef(ref.Ref) {
+		return
+	}
+	c.checkSchemaRef(ref.Value.Schema, location, false)
+	c.checkContent(ref.Value.Content, location)
+}
+
+func (c *consistencyCheck) checkRequestBody(ref *openapi3.RequestBodyRef, location string) {
+	if ref == nil || ref.Value == nil || isLocalRef(ref.Ref) {
+		return
+	}
+	c.checkContent(ref.Value.Content, location)
+}
+
+func (c *consistencyCheck) checkResponse(ref *openapi3.ResponseRef, location string) {
+	if ref == nil || ref.Value == nil || isLocalRef(ref.Ref) {
+		return
+	}
+	for _, name := range SortedHeadersKeys(ref.Value.Headers) {
+		c.checkHeader(ref.Value.Headers[name], location+".headers."+name)
+	}
+	c.checkContent(ref.Value.Content, location)
+}
+
+func (c *consistencyCheck) checkContent(content openapi3.Content, location string) {
+	for _, contentType := range SortedContentKeys(content) {
+		if mediaType := content[contentType]; mediaType != nil {
+			c.checkSchemaRef(mediaType.Schema, location+".content."+contentType, false)
+		}
+	}
+}
+
+// checkSchemaRef checks the schema of ref, found at location, and the schemas
+// it's made of. A schema the spec references is checked where it's defined,
+// among the components, and one of another document where it's first
+// found. The properties of an allOf member are checked along with those of
+// the other members, in the allOf.
+func (c *consistencyCheck) checkSchemaRef(ref *openapi3.SchemaRef, location string, allOfMember bool) {
+	c.checkSchemaRefIn(ref, location, allOfMember, nil)
+}
+
+// checkSchemaRefIn checks the schema of ref as checkSchemaRef does, the
+// schema being a member of a union of a schema declaring the properties
+// outer, which the member may require without declaring them.
+func (c *consistencyCheck) checkSchemaRefIn(ref *openapi3.SchemaRef, location string, allOfMember bool, outer map[string]bool) {
+	if ref == nil || ref.Value == nil || isLocalRef(ref.Ref) || c.visited[ref.Value] {
+		return
+	}
+	c.visited[ref.Value] = true
+	schema := ref.Value
+
+	if !allOfMember {
+		c.checkRequired(schema, location, outer)
+		c.checkCaseCollisions(schema, location)
+	}
+	c.checkDiscriminator(schema, location)
+	c.checkExample(schema, location)
+
+	for _, name := range SortedSchemaKeys(schema.Properties) {
+		c.checkSchemaRef(schema.Properties[name], location+".properties."+name, false)
+	}
+	c.checkSchemaRef(schema.Items, location+".items", false)
+	c.checkSchemaRef(schema.AdditionalProperties.Schema, location+".additionalProperties", false)
+	c.checkSchemaRef(schema.Not, location+".not", false)
+	for i, member := range schema.AllOf {
+		c.checkSchemaRef(member, fmt.Sprintf("%s.allOf[%d]", location, i), true)
+	}
+	declared := declaredProperties(schema, false, make(map[*openapi3.Schema]bool))
+	for i, member := range schema.OneOf {
+		c.checkSchemaRefIn(member, fmt.Sprintf("%s.oneOf[%d]", location, i), false, declared)
+	}
+	for i, member := range schema.AnyOf {
+		c.checkSchemaRefIn(member, fmt.Sprintf("%s.anyOf[%d]", location, i), false, declared)
+	}
+}
+
+// checkRequired checks that the properties schema requires, along with its
+// allOf members, are declared by them, by the members of its unions, which
+// any of them may be, or by the schema of the union schema is a member of,
+// whose properties are outer. An undeclared property which additionalProperties
+// forbids can never be given, which is an error; any other is warned about,
+// as it has no field.
+func (c *consistencyCheck) checkRequired(schema *openapi3.Schema, location string, outer map[string]bool) {
+	required := requiredProperties(schema, make(map[*openapi3.Schema]bool))
+	if len(required) == 0 {
+		return
+	}
+	declared := declaredProperties(schema, true, make(map[*openapi3.Schema]bool))
+	closed := forbidsAdditionalProperties(schema, make(map[*openapi3.Schema]bool))
+	for _, name := range required {
+		if declared[name] || outer[name] {
+			continue
+		}
+		if closed {
+			c.fail(location, "required property %q isn't declared in properties, and additionalProperties is false, so no value is valid; declare it, or remove it from required", name)
+			continue
+		}
+		c.g.addWarning(location, "required property %q isn't declared in properties, so no field is generated for it; declare it, or remove it from required", name)
+	}
+}
+
+// checkCaseCollisions warns about the properties of schema, along with those
+// of its allOf members, whose names differ only by case, which Go names
+// don't always tell apart. The collisions of a referenced member are left to
+// it.
+func (c *consistencyCheck) checkCaseCollisions(schema *openapi3.Schema, location string) {
+	var names []string
+	for name := range declaredProperties(schema, false, make(map[*openapi3.Schema]bool)) {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var folds []string
+	byFold := make(map[string][]string)
+	for _, name := range names {
+		fold := strings.ToLower(name)
+		if byFold[fold] == nil {
+			folds = append(folds, fold)
+		}
+		byFold[fold] = append(byFold[fold], name)
+	}
+	for _, fold := range folds {
+		names := byFold[fold]
+		if len(names) < 2 || declaredByMember(schema, names) {
+			continue
+		}
+		c.g.addWarning(location, "properties %s differ only by case, so their Go names may collide; use %s to tell them apart",
+			quotedList(names), extGoName)
+	}
+}
+
+// declaredByMember returns whether all the names are declared by a single
+// referenced allOf member of schema.
+func declaredByMember(schema *openapi3.Schema, names []string) bool {
+	for _, member := range schema.AllOf {
+		if member == nil || member.Ref == "" || member.Value == nil {
+			continue
+		}
+		declared := declaredProperties(member.Value, false, make(map[*openapi3.Schema]bool))
+		all := true
+		for _, name := range names {
+			all = all && declared[name]
+		}
+		if all {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDiscriminator checks that the schemas the discriminator of schema
+// maps to, and the members of its unions, declare the discriminator's
+// property, which identifies them, unless schema declares it for all of
+// them.
+func (c *consistencyCheck) checkDiscriminator(schema *openapi3.Schema, location string) {
+	d := schema.Discriminator
+	if d == nil || d.PropertyName == "" || declaredProperties(schema, false, make(map[*openapi3.Schema]bool))[d.PropertyName] {
+		return
+	}
+	check := func(name string, variant *openapi3.Schema) {
+		if !declaredProperties(variant, false, make(map[*openapi3.Schema]bool))[d.PropertyName] {
+			c.fail(location, "discriminator property %q isn't declared by %s, so it can't be identified; declare it there, or in a schema it has in allOf",
+				d.PropertyName, name)
+		}
+	}
+	checked := map[*openapi3.Schema]bool{schema: true}
+	for _, value := range SortedStringKeys(d.Mapping) {
+		ref := d.Mapping[value]
+		variant := c.componentSchema(ref)
+		if variant == nil || checked[variant] {
+			continue
+		}
+		checked[variant] = true
+		check(fmt.Sprintf("%s, which %q maps to", ref, value), variant)
+	}
+	for _, members := range [][]*openapi3.SchemaRef{schema.OneOf, schema.AnyOf} {
+		for i, member := range members {
+			if member == nil || member.Value == nil || checked[member.Value] {
+				continue
+			}
+			checked[member.Value] = true
+			name := member.Ref
+			if name == "" {
+				name = fmt.Sprintf("the inline member %d", i)
+			}
+			check(name, member.Value)
+		}
+	}
+}
+
+// componentSchema returns the schema of the components of the spec which a
+// discriminator mapping refers to, by reference or by name, or nil for the
+// schemas of other documents, and those which don't exist.
+func (c *consistencyCheck) componentSchema(ref string) *openapi3.Schema {
+	if c.spec.Components == nil {
+		return nil
+	}
+	name := strings.TrimPrefix(ref, "#/components/schemas/")
+	if strings.Contains(name, "/") || strings.Contains(name, "#") {
+		return nil
+	}
+	if schema := c.spec.Components.Schemas[name]; schema != nil {
+		return schema.Value
+	}
+	return nil
+}
+
+// checkExample warns when the example of schema isn't a JSON value of the
+// kind its type says.
+func (c *consistencyCheck) checkExample(schema *openapi3.Schema, location string) {
+	if schema.Example == nil || schema.Type == "" || isKind(schema.Example, schema.Type) {
+		return
+	}
+	example, err := json.Marshal(schema.Example)
+	if err != nil {
+		return
+	}
+	c.g.addWarning(location, "example %s isn't of type %s, as the schema's type says", example, schema.Type)
+}
+
+// isKind returns whether the JSON value v, as decoded by encoding/json, is of
+// the JSON Schema type t.
+func isKind(v interface{}, t string) bool {
+	switch v := v.(type) {
+	case string:
+		return t == "string"
+	case bool:
+		return t == "boolean"
+	case float64:
+		return t == "number" || (t == "integer" && v == math.Trunc(v))
+	case int, int64:
+		return t == "number" || t == "integer"
+	case []interface{}:
+		return t == "array"
+	case map[string]interface{}:
+		return t == "object"
+	}
+	return true
+}
+
+// requiredProperties returns the names of the properties schema requires,
+// along with its allOf members, in order.
+func requiredProperties(schema *openapi3.Schema, visited map[*openapi3.Schema]bool) []string {
+	if schema == nil || visited[schema] {
+		return nil
+	}
+	visited[schema] = true
+	required := append([]string{}, schema.Required...)
+	for _, member := range schema.AllOf {
+		if member == nil {
+			continue
+		}
+		for _, name := range requiredProperties(member.Value, visited) {
+			if !StringInArray(name, required) {
+				required = append(required, name)
+			}
+		}
+	}
+	return required
+}
+
+// declaredProperties returns the names of the properties schema declares,
+// along with its allOf members, and those of its unions' members when
+// unions is set.
+func declaredProperties(schema *openapi3.Schema, unions bool, visited map[*openapi3.Schema]bool) map[string]bool {
+	declared := make(map[string]bool)
+	if schema == nil || visited[schema] {
+		return declared
+	}
+	visited[schema] = true
+	for name := range schema.Properties {
+		declared[name] = true
+	}
+	members := schema.AllOf
+	if unions {
+		members = append(append(members[:len(members):len(members)], schema.OneOf...), schema.AnyOf...)
+	}
+	for _, member := range members {
+		if member == nil {
+			continue
+		}
+		for name := range declaredProperties(member.Value, unions, visited) {
+			declared[name] = true
+		}
+	}
+	return declared
+}
+
+// forbidsAdditionalProperties returns whether schema, or one of its allOf
+// members, sets additionalProperties to false.
+func forbidsAdditionalProperties(schema *openapi3.Schema, visited map[*openapi3.Schema]bool) bool {
+	if schema == nil || visited[schema] {
+		return false
+	}
+	visited[schema] = true
+	if isAdditionalPropertiesExplicitFalse(schema) {
+		return true
+	}
+	for _, member := range schema.AllOf {
+		if member != nil && forbidsAdditionalProperties(member.Value, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLocalRef returns whether ref refers to the spec itself, rather than to
+// another document.
+func isLocalRef(ref string) bool {
+	return strings.HasPrefix(ref, "#")
+}
+
+// quotedList returns the names quoted, and joined with commas and "and".
+func quotedList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " and " + quoted[len(quoted)-1]
+}
@@ -0,0 +1,158 @@
+package codegen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// consistencySpec is a spec whose schemas are the given components.
+func consistencySpec(schemas string) string {
+	return `
+openapi: "3.0.3"
+info:
+  version: 1.0.0
+  title: consistency
+paths: {}
+components:
+  schemas:
+` + schemas
+}
+
+func TestCheckConsistency(t *testing.T) {
+	tests := []struct {
+		name    string
+		schemas string
+		err     string
+		warning string
+	}{
+		{
+			name: "consistent",
+			schemas: `
+    Pet:
+      type: object
+      required: [id, kind]
+      properties:
+        id: {type: integer, example: 42}
+      oneOf:
+        - properties: {kind: {type: string}}
+    Cat:
+      allOf:
+        - $ref: '#/components/schemas/Pet'
+        - required: [name]
+        - properties: {name: {type: string}}
+    Either:
+      properties:
+        a: {type: string}
+        b: {type: string}
+      oneOf:
+        - required: [a]
+        - required: [b]`,
+		},
+		{
+			name: "renamed required property",
+			schemas: `
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        petName: {type: string}`,
+			warning: `components.schemas.Pet: required property "name" isn't declared in properties, so no field is generated for it; declare it, or remove it from required`,
+		},
+		{
+			name: "required property additionalProperties forbids",
+			schemas: `
+    Pet:
+      type: object
+      additionalProperties: false
+      required: [name]
+      properties:
+        petName: {type: string}`,
+			err: `components.schemas.Pet: required property "name" isn't declared in properties, and additionalProperties is false`,
+		},
+		{
+			name: "required property of an inline property",
+			schemas: `
+    Pet:
+      type: object
+      properties:
+        owner:
+          type: object
+          required: [id]
+          properties:
+            name: {type: string}`,
+			warning: `components.schemas.Pet.properties.owner: required property "id"`,
+		},
+		{
+			name: "undeclared discriminator property",
+			schemas: `
+    Pet:
+      oneOf:
+        - $ref: '#/components/schemas/Cat'
+        - $ref: '#/components/schemas/Dog'
+      discriminator:
+        propertyName: petType
+        mapping:
+          cat: '#/components/schemas/Cat'
+          dog: Dog
+    Cat:
+      properties:
+        petType: {type: string}
+    Dog:
+      properties:
+        kind: {type: string}`,
+			err: `components.schemas.Pet: discriminator property "petType" isn't declared by Dog, which "dog" maps to, so it can't be identified`,
+		},
+		{
+			name: "example of the wrong kind",
+			schemas: `
+    Pet:
+      properties:
+        age:
+          type: integer
+          example: "three"`,
+			warning: `components.schemas.Pet.properties.age: example "three" isn't of type integer, as the schema's type says`,
+		},
+		{
+			name: "properties differing by case",
+			schemas: `
+    Base:
+      properties:
+        userId: {type: string}
+    Pet:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - properties:
+            userID: {type: string}`,
+			warning: `components.schemas.Pet: properties "userID" and "userId" differ only by case, so their Go names may collide; use x-go-name to tell them apart`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := Configuration{
+				PackageName:   "api",
+				Generate:      GenerateOptions{Models: true},
+				OutputOptions: OutputOptions{SkipPrune: true},
+			}
+			_, warnings, err := GenerateFiles(context.Background(), []byte(consistencySpec(test.schemas)), cfg)
+			if test.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.err)
+				return
+			}
+			require.NoError(t, err)
+			var messages []string
+			for _, w := range warnings {
+				messages = append(messages, w.String())
+			}
+			if test.warning == "" {
+				assert.Empty(t, messages)
+				return
+			}
+			require.Len(t, messages, 1)
+			assert.Contains(t, messages[0], test.warning)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// embedAllOf returns merged, the schema flattened from the allOf members at
+// path, with a struct embedding the types of the members which reference
+// one, as prefer-embedded-structs-for-allof asks, so that their methods are
+// promoted. Only allOfs of local references to structs and at most one
+// inline object are embedded, the others being returned flattened. So are
+// those which embedding would give a different type than flattening: when
+// two members share a property, when merging changes a property of a
+// member, as the required list of another member does, and when a member's
+// struct has its own JSON methods, which would take over the encoding of the
+// embedding struct.
+func (g *generator) embedAllOf(allOf []*openapi3.SchemaRef, path []string, merged Schema) (Schema, error) {
+	if !isStructType(merged.GoType) || merged.HasAdditionalProperties || len(merged.UnionElements) != 0 {
+		return merged, nil
+	}
+
+	var fields []string
+	var properties []Property
+	var additionalTypes []TypeDefinition
+	names := map[string]bool{}
+	addName := func(name string) bool {
+		if names[name] {
+			return false
+		}
+		names[name] = true
+		return true
+	}
+	addProperties := func(props []Property) bool {
+		for _, p := range props {
+			if !addName(p.GoFieldName()) || !addName("json:"+p.JsonFieldName) {
+				return false
+			}
+		}
+		properties = append(properties, props...)
+		return true
+	}
+
+	inline := false
+	for _, member := range allOf {
+		if member.Value == nil {
+			return merged, nil
+		}
+		if isEmptyMember(member) {
+			continue
+		}
+
+		if member.Ref == "" {
+			if inline {
+				return merged, nil
+			}
+			inline = true
+			s, err := g.generateGoSchema(member, path)
+			if err != nil {
+				return Schema{}, err
+			}
+			if !isStructType(s.GoType) || s.HasAdditionalProperties || len(s.UnionElements) != 0 ||
+				!addProperties(s.Properties) {
+				return merged, nil
+			}
+			fields = append(fields, GenFieldsFromProperties(s.Properties)...)
+			additionalTypes = append(additionalTypes, s.AdditionalTypes...)
+			continue
+		}
+
+		if !strings.HasPrefix(member.Ref, componentSchemaPrefix) {
+			return merged, nil
+		}
+		goType, err := g.refPathToGoType(member.Ref, true)
+		if err != nil {
+			return Schema{}, fmt.Errorf("error turning reference (%s) into a Go type: %w", member.Ref, err)
+		}
+		// The properties are those of the referenced type, as generated for
+		// its component.
+		name := strings.TrimPrefix(member.Ref, componentSchemaPrefix)
+		s, err := g.generateGoSchema(openapi3.NewSchemaRef("", member.Value), []string{name})
+		if err != nil {
+			return Schema{}, err
+		}
+		if !isStructType(s.GoType) || g.encodesItself(s) || !addName(goType) || !addProperties(s.Properties) {
+			return merged, nil
+		}
+		fields = append(fields, fmt.Sprintf("%s `yaml:\",inline\"`", goType))
+	}
+
+	if len(properties) != len(merged.Properties) {
+		return merged, nil
+	}
+	for _, p := range merged.Properties {
+		if !samePropertyField(p, properties) {
+			return merged, nil
+		}
+	}
+
+	embedded := merged
+	embedded.GoType = "struct {\n" + strings.Join(fields, "\n") + "\n}"
+	embedded.Properties = properties
+	embedded.AdditionalTypes = additionalTypes
+	return embedded, nil
+}
+
+// samePropertyField reports whether the property p, merged from an allOf, is
+// generated like the one of the same name among the properties of the
+// members.
+func samePropertyField(p Property, properties []Property) bool {
+	for _, q := range properties {
+		if q.JsonFieldName == p.JsonFieldName {
+			return q.GoFieldName() == p.GoFieldName() && q.Required == p.Required &&
+				q.Nullable == p.Nullable && q.ReadOnly == p.ReadOnly && q.WriteOnly == p.WriteOnly
+		}
+	}
+	return false
+}
+
+// encodesItself reports whether the struct generated for s has its own JSON
+// methods.
+func (g *generator) encodesItself(s Schema) bool {
+	if s.HasAdditionalProperties || len(s.UnionElements) != 0 {
+		return true
+	}
+	if g.options.OutputOptions.DisallowUnknownFields && s.NoAdditionalProperties {
+		return true
+	}
+	for _, p := range s.Properties {
+		if p.JSONCodec != nil || g.normalizedCollection(p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package codegen
+
+import (
+	"strings"
+)
+
+// isCollectionSchema reports whether s is generated as a slice or a map, which
+// may be nil or empty, as opposed to the []byte of binary strings, the
+// OrderedSet of x-go-set and the types of x-go-type.
+func (g *generator) isCollectionSchema(s Schema) bool {
+	o := s.OAPISchema
+	if o == nil {
+		return (strings.HasPrefix(s.GoType, "[]") && s.GoType != "[]byte") || strings.HasPrefix(s.GoType, "map[")
+	}
+	if _, ok := o.Extensions[extPropGoType]; ok {
+		return false
+	}
+	if _, ok := o.Extensions[extGoSet]; ok {
+		return false
+	}
+	switch schemaType(o) {
+	case "array":
+		return true
+	case "object":
+		// Objects with properties, or combining others, are structs, as are
+		// those with only additional properties when they aren't flattened.
+		if len(o.Properties) != 0 || o.AllOf != nil || o.AnyOf != nil || o.OneOf != nil {
+			return false
+		}
+		return !SchemaHasAdditionalProperties(o) || !g.options.Compatibility.DisableFlattenAdditionalProperties
+	}
+	return false
+}
+
+// normalizedCollection reports whether p is a collection whose field, not
+// being a pointer, encoding/json would omit when empty, which, with the
+// normalize-empty-collections output option, is only omitted when nil.
+func (g *generator) normalizedCollection(p Property) bool {
+	return g.options.OutputOptions.NormalizeEmptyCollections &&
+		p.omitEmpty() && !isPointerField(p) && g.isCollectionSchema(p.Schema)
+}
@@ -0,0 +1,57 @@
+package codegen
+
+import "strings"
+
+// TextKind returns how the values of the enum are written as text by its
+// MarshalText and UnmarshalText methods, for the enum-text-interfaces output
+// option: "string", "int", "uint", "float" or "bool", or "" when its Go type
+// is none of those, and it has none.
+func (e *EnumDefinition) TextKind() string {
+	switch goType := e.Schema.GoType; {
+	case goType == "string" || goType == "bool":
+		return goType
+	case strings.HasPrefix(goType, "uint"):
+		return "uint"
+	case strings.HasPrefix(goType, "int"):
+		return "int"
+	case strings.HasPrefix(goType, "float"):
+		return "float"
+	}
+	return ""
+}
+
+// TextBitSize returns the bit size the numbers of the enum are parsed and
+// formatted with by strconv, which is 0 for int and uint.
+func (e *EnumDefinition) TextBitSize() string {
+	size := strings.TrimLeft(e.Schema.GoType, "uintfloat")
+	if size == "" {
+		return "0"
+	}
+	return size
+}
+
+// IsEnumText reports whether the parameter is an enum, or an array of enums,
+// which the servers bind through the UnmarshalText of its type, for the
+// enum-text-interfaces output option.
+func (pd *ParameterDefinition) IsEnumText() bool {
+	if !pd.enumText || !pd.IsStyled() || pd.Spec.Schema.Value == nil {
+		return false
+	}
+	schema := pd.Spec.Schema.Value
+	if schemaType(schema) == "array" && schema.Items != nil && schema.Items.Value != nil {
+		schema = schema.Items.Value
+	}
+	return len(schema.Enum) != 0
+}
+
+// EnumTextParams returns the parameters of the operation which are bound
+// through the UnmarshalText of their enum type, see IsEnumText.
+func (o OperationDefinition) EnumTextParams() []ParameterDefinition {
+	var params []ParameterDefinition
+	for _, param := range o.AllParams() {
+		if param.IsEnumText() {
+			params = append(params, param)
+		}
+	}
+	return params
+}
@@ -0,0 +1,114 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// normalizeExclusiveBounds rewrites the exclusiveMinimum and exclusiveMaximum
+// of the schemas of the document data which are numbers, as OpenAPI 3.1 writes
+// them, to the boolean form of OpenAPI 3.0, the only one kin-openapi reads, so
+// that they're merged like any other bound. An exclusive bound becomes the
+// minimum or maximum, unless the inclusive one next to it is stricter, in
+// which case it's dropped. Documents without numeric exclusive bounds are
+// returned as they are.
+func normalizeExclusiveBounds(data []byte) ([]byte, error) {
+	if !bytes.Contains(data, []byte("exclusiveM")) {
+		return data, nil
+	}
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		// The loader reports the error, in its own words.
+		return data, nil
+	}
+	changed, err := normalizeExclusiveBoundsOf(&document)
+	if err != nil || !changed {
+		return data, err
+	}
+	return yaml.Marshal(&document)
+}
+
+// normalizeExclusiveBoundsOf rewrites the numeric exclusive bounds of node
+// and its descendants, reporting whether there were any. The values of
+// examples, defaults, enums and constants aren't schemas, and are skipped.
+func normalizeExclusiveBoundsOf(node *yaml.Node) (bool, error) {
+	changed := false
+	if node.Kind == yaml.MappingNode {
+		for _, bound := range []struct{ exclusive, inclusive string }{
+			{"exclusiveMinimum", "minimum"},
+			{"exclusiveMaximum", "maximum"},
+		} {
+			rewritten, err := normalizeExclusiveBound(node, bound.exclusive, bound.inclusive)
+			if err != nil {
+				return false, err
+			}
+			changed = changed || rewritten
+		}
+	}
+	for i, child := range node.Content {
+		if node.Kind == yaml.MappingNode && i%2 == 1 {
+			switch node.Content[i-1].Value {
+			case "example", "examples", "default", "enum", "const":
+				continue
+			}
+		}
+		rewritten, err := normalizeExclusiveBoundsOf(child)
+		if err != nil {
+			return false, err
+		}
+		changed = changed || rewritten
+	}
+	return changed, nil
+}
+
+// normalizeExclusiveBound rewrites the exclusive bound of the mapping node,
+// when it's a number, along with the inclusive bound next to it.
+func normalizeExclusiveBound(node *yaml.Node, exclusive, inclusive string) (bool, error) {
+	exclusiveIndex, inclusiveIndex := -1, -1
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		switch node.Content[i].Value {
+		case exclusive:
+			exclusiveIndex = i
+		case inclusive:
+			inclusiveIndex = i
+		}
+	}
+	if exclusiveIndex < 0 {
+		return false, nil
+	}
+	value := node.Content[exclusiveIndex+1]
+	if value.Kind != yaml.ScalarNode || (value.Tag != "!!int" && value.Tag != "!!float") {
+		return false, nil
+	}
+	bound, err := strconv.ParseFloat(value.Value, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s %q at line %d: %w", exclusive, value.Value, value.Line, err)
+	}
+
+	if inclusiveIndex >= 0 {
+		current := node.Content[inclusiveIndex+1]
+		limit, err := strconv.ParseFloat(current.Value, 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid %s %q at line %d: %w", inclusive, current.Value, current.Line, err)
+		}
+		stricter := limit > bound
+		if inclusive == "maximum" {
+			stricter = limit < bound
+		}
+		if stricter {
+			// The exclusive bound allows values the inclusive one doesn't.
+			node.Content = append(node.Content[:exclusiveIndex], node.Content[exclusiveIndex+2:]...)
+			return true, nil
+		}
+		*current = *value
+	} else {
+		key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: inclusive}
+		limit := *value
+		node.Content = append(node.Content, key, &limit)
+	}
+	*value = yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"}
+	return true, nil
+}
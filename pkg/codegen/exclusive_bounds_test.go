@@ -0,0 +1,154 @@
+package codegen
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exclusiveBoundsSpec is a 3.1 spec whose schema Merged is the allOf of the
+// schemas A and B, with the given fields.
+func exclusiveBoundsSpec(a, b string) string {
+	return fmt.Sprintf(`
+openapi: "3.1.0"
+info:
+  version: 1.0.0
+  title: exclusive bounds
+paths: {}
+components:
+  schemas:
+    A:
+      type: number
+%s
+    B:
+      type: number
+%s
+    Merged:
+      allOf:
+        - $ref: '#/components/schemas/A'
+        - $ref: '#/components/schemas/B'
+`, a, b)
+}
+
+// mergeExclusiveBounds loads spec, and merges the allOf of its schema Merged.
+func mergeExclusiveBounds(t *testing.T, spec string) (openapi3.Schema, error) {
+	t.Helper()
+	g := newGenerator(nil, Configuration{})
+	swagger, err := g.loadSpec(context.Background(), []byte(spec), g.options)
+	require.NoError(t, err)
+	merged := swagger.Components.Schemas["Merged"].Value
+	return g.mergeOpenapiSchemas(*merged.AllOf[0].Value, *merged.AllOf[1].Value, true)
+}
+
+func TestMergeExclusiveBounds(t *testing.T) {
+	tests := []struct {
+		name         string
+		a, b         string
+		min, max     *float64
+		exclusiveMin bool
+		exclusiveMax bool
+	}{
+		{
+			name:         "number and number",
+			a:            "      exclusiveMinimum: 0\n      exclusiveMaximum: 100",
+			b:            "      exclusiveMinimum: 5\n      exclusiveMaximum: 200",
+			min:          openapi3.Float64Ptr(5),
+			exclusiveMin: true,
+			max:          openapi3.Float64Ptr(100),
+			exclusiveMax: true,
+		},
+		{
+			name:         "boolean and number",
+			a:            "      minimum: 5\n      exclusiveMinimum: true",
+			b:            "      exclusiveMinimum: 5",
+			min:          openapi3.Float64Ptr(5),
+			exclusiveMin: true,
+		},
+		{
+			name:         "inclusive bound stricter than the exclusive one",
+			a:            "      minimum: 5",
+			b:            "      minimum: 10\n      exclusiveMinimum: 3",
+			min:          openapi3.Float64Ptr(10),
+			exclusiveMin: false,
+		},
+		{
+			name:         "inclusive bound looser than the exclusive one",
+			a:            "      maximum: 50\n      exclusiveMaximum: 20",
+			b:            "      maximum: 30",
+			max:          openapi3.Float64Ptr(20),
+			exclusiveMax: true,
+		},
+		{
+			name:         "minimum and maximum",
+			a:            "      exclusiveMinimum: 1.5",
+			b:            "      exclusiveMaximum: 10",
+			min:          openapi3.Float64Ptr(1.5),
+			exclusiveMin: true,
+			max:          openapi3.Float64Ptr(10),
+			exclusiveMax: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, err := mergeExclusiveBounds(t, exclusiveBoundsSpec(tt.a, tt.b))
+			require.NoError(t, err)
+			assert.Equal(t, tt.min, merged.Min)
+			assert.Equal(t, tt.exclusiveMin, merged.ExclusiveMin)
+			assert.Equal(t, tt.max, merged.Max)
+			assert.Equal(t, tt.exclusiveMax, merged.ExclusiveMax)
+		})
+	}
+
+	// A maximum on one side excluding the minimum on the other.
+	_, err := mergeExclusiveBounds(t, exclusiveBoundsSpec("      exclusiveMinimum: 10", "      exclusiveMaximum: 10"))
+	assert.EqualError(t, err, "merging two schemas whose bounds contradict each other: minimum 10, maximum 10")
+}
+
+func TestGenerateFilesExclusiveBounds(t *testing.T) {
+	spec := `
+openapi: "3.1.0"
+info:
+  version: 1.0.0
+  title: exclusive bounds
+paths: {}
+components:
+  schemas:
+    Price:
+      allOf:
+        - $ref: './models.yaml#/components/schemas/Amount'
+        - type: number
+          maximum: 0
+          examples: [{exclusiveMinimum: 1}]
+`
+	models := `
+components:
+  schemas:
+    Amount:
+      type: number
+      exclusiveMinimum: 0
+`
+	_, _, err := GenerateFiles(context.Background(), []byte(spec), Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		SpecLocation:  "specs/api.yaml",
+		Loader:        mapLoader{"specs/models.yaml": models},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	assert.ErrorContains(t, err, "merging two schemas whose bounds contradict each other: minimum 0, maximum 0")
+}
+
+func TestNormalizeExclusiveBoundsUnchanged(t *testing.T) {
+	for _, data := range []string{
+		"type: number\nminimum: 1\n",
+		"type: number\nminimum: 1\nexclusiveMinimum: true\n",
+		"example:\n  exclusiveMaximum: 3\n",
+	} {
+		normalized, err := normalizeExclusiveBounds([]byte(data))
+		require.NoError(t, err)
+		assert.Equal(t, data, string(normalized))
+	}
+}
@@ -22,6 +22,31 @@ const (
 	extEnumVarNames      = "x-enum-varnames"
 	extEnumNames         = "x-enumNames"
 	extDeprecationReason = "x-deprecated-reason"
+	// extEnumDescriptions supplies a human-readable description for each of
+	// the enum values, in the same order as the values.
+	extEnumDescriptions = "x-enum-descriptions"
+	// extGoSet generates an array of unique items as an OrderedSet rather than
+	// a slice.
+	extGoSet = "x-go-set"
+	// extGoInterface generates a <Name>Like interface of the getters of a
+	// struct, as if the schema was listed in the interfaces-for output option.
+	extGoInterface = "x-go-interface"
+	// extPropGoPreserveZero generates an optional property as a field which
+	// is neither a pointer nor omitempty, so that its zero value is encoded.
+	extPropGoPreserveZero = "x-go-preserve-zero"
+	// extGoEnumSkipConstants generates an enum in the compact form of the
+	// compact-enum-threshold output option, whatever its size.
+	extGoEnumSkipConstants = "x-go-enum-skip-constants"
+	// extPropGoJSONCodec routes the JSON encoding of a property through the
+	// given functions, keeping the Go type of its field.
+	extPropGoJSONCodec = "x-go-json-codec"
+	// extGoComparable generates a struct usable as a map key, whose fields
+	// are values rather than pointers, when true, or a Key method returning a
+	// canonical string of its value, when "key".
+	extGoComparable = "x-go-comparable"
+	// extGoQueryStyle styles a query parameter in a way OpenAPI doesn't
+	// define, such as "indexed-deep-object" for arrays of objects.
+	extGoQueryStyle = "x-go-query-style"
 )
 
 func extString(extPropValue interface{}) (string, error) {
@@ -44,6 +69,53 @@ func extParsePropGoTypeSkipOptionalPointer(extPropValue interface{}) (bool, erro
 	return goTypeSkipOptionalPointer, nil
 }
 
+func extParseGoInterface(extPropValue interface{}) (bool, error) {
+	goInterface, ok := extPropValue.(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+	return goInterface, nil
+}
+
+func extParseGoSet(extPropValue interface{}) (bool, error) {
+	goSet, ok := extPropValue.(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+	return goSet, nil
+}
+
+func extParseGoPreserveZero(extPropValue interface{}) (bool, error) {
+	preserveZero, ok := extPropValue.(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+	return preserveZero, nil
+}
+
+func extParseGoEnumSkipConstants(extPropValue interface{}) (bool, error) {
+	skipConstants, ok := extPropValue.(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+	return skipConstants, nil
+}
+
+// extParseGoComparable returns whether x-go-comparable asks for a comparable
+// struct, and whether it asks for a Key method instead.
+func extParseGoComparable(extPropValue interface{}) (comparable bool, key bool, err error) {
+	switch value := extPropValue.(type) {
+	case bool:
+		return value, false, nil
+	case string:
+		if value == "key" {
+			return false, true, nil
+		}
+		return false, false, fmt.Errorf("invalid value: %q, expected true, false or \"key\"", value)
+	}
+	return false, false, fmt.Errorf("failed to convert type: %T", extPropValue)
+}
+
 func extParseGoFieldName(extPropValue interface{}) (string, error) {
 	return extString(extPropValue)
 }
@@ -99,3 +171,63 @@ func extParseEnumVarNames(extPropValue interface{}) ([]string, error) {
 func extParseDeprecationReason(extPropValue interface{}) (string, error) {
 	return extString(extPropValue)
 }
+
+func extParseEnumDescriptions(extPropValue interface{}) ([]string, error) {
+	return extParseEnumVarNames(extPropValue)
+}
+
+// jsonCodec holds the functions encoding and decoding the field of a property
+// with x-go-json-codec, either of which may be left to encoding/json.
+type jsonCodec struct {
+	// Marshal is called as func(T) ([]byte, error), T being the type of the
+	// field, less the pointer of optional fields.
+	Marshal string
+	// Unmarshal is called as func([]byte, *T) error.
+	Unmarshal string
+	// Import is the package providing the functions, if any.
+	Import *goImport
+}
+
+func extParseGoJSONCodec(extPropValue interface{}) (*jsonCodec, error) {
+	codecI, ok := extPropValue.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+	codec := &jsonCodec{}
+	for k, v := range codecI {
+		switch k {
+		case "marshal", "unmarshal":
+			vs, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("failed to convert type: %T", v)
+			}
+			if k == "marshal" {
+				codec.Marshal = vs
+			} else {
+				codec.Unmarshal = vs
+			}
+		case "import":
+			// Either the path of the package, or its path and name, like
+			// x-go-type-import.
+			switch v := v.(type) {
+			case string:
+				codec.Import = &goImport{Path: v}
+			case map[string]interface{}:
+				path, _ := v["path"].(string)
+				name, _ := v["name"].(string)
+				if path == "" {
+					return nil, fmt.Errorf("missing import path")
+				}
+				codec.Import = &goImport{Name: name, Path: path}
+			default:
+				return nil, fmt.Errorf("failed to convert type: %T", v)
+			}
+		default:
+			return nil, fmt.Errorf("unknown key %q", k)
+		}
+	}
+	if codec.Marshal == "" && codec.Unmarshal == "" {
+		return nil, fmt.Errorf("neither marshal nor unmarshal is set")
+	}
+	return codec, nil
+}
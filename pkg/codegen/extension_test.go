@@ -108,3 +108,56 @@ func Test_extParsePropGoTypeSkipOptionalPointer(t *testing.T) {
 		})
 	}
 }
+
+func Test_extParseGoJSONCodec(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    *jsonCodec
+		wantErr bool
+	}{
+		{
+			name:  "import path",
+			value: `{"marshal": "codecs.Marshal", "unmarshal": "codecs.Unmarshal", "import": "example.com/codecs"}`,
+			want:  &jsonCodec{Marshal: "codecs.Marshal", Unmarshal: "codecs.Unmarshal", Import: &goImport{Path: "example.com/codecs"}},
+		},
+		{
+			name:  "named import",
+			value: `{"marshal": "c.Marshal", "import": {"name": "c", "path": "example.com/codecs"}}`,
+			want:  &jsonCodec{Marshal: "c.Marshal", Import: &goImport{Name: "c", Path: "example.com/codecs"}},
+		},
+		{
+			name:  "no import",
+			value: `{"unmarshal": "unmarshalThing"}`,
+			want:  &jsonCodec{Unmarshal: "unmarshalThing"},
+		},
+		{
+			name:    "no function",
+			value:   `{"import": "example.com/codecs"}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			value:   `{"marshal": "codecs.Marshal", "encode": "codecs.Encode"}`,
+			wantErr: true,
+		},
+		{
+			name:    "type conversion error",
+			value:   `"codecs.Marshal"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var extPropValue interface{}
+			assert.NoError(t, json.Unmarshal([]byte(tt.value), &extPropValue))
+			got, err := extParseGoJSONCodec(extPropValue)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package codegen
+
+import (
+	"reflect"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// flattenAnyOfObjects returns the object schema merging the anyOf members of
+// schema, for flatten-anyof-objects, with all of their properties optional,
+// as any of the members may be given. It returns nil when schema isn't an
+// anyOf of objects which merge as is: those whose members have unions or
+// additional properties, or declare the same property differently, are left
+// to unions, as are the anyOfs next to properties of their own.
+func (g *generator) flattenAnyOfObjects(schema *openapi3.Schema) *openapi3.Schema {
+	if len(schema.AnyOf) < 2 || schema.OneOf != nil || schema.AllOf != nil || schema.Discriminator != nil ||
+		len(schema.Properties) != 0 || SchemaHasAdditionalProperties(schema) {
+		return nil
+	}
+
+	var merged openapi3.Schema
+	properties := make(map[string]*openapi3.SchemaRef)
+	for _, member := range schema.AnyOf {
+		if member == nil || member.Value == nil {
+			return nil
+		}
+		value, err := valueWithPropagatedRef(member, "")
+		if err != nil {
+			return nil
+		}
+		// The allOf of a member is flattened first, for its properties.
+		value, err = g.mergeOpenapiSchemas(openapi3.Schema{}, value, true)
+		if err != nil || schemaType(&value) != "object" || value.OneOf != nil || value.AnyOf != nil ||
+			value.AdditionalProperties.Has != nil || value.AdditionalProperties.Schema != nil {
+			return nil
+		}
+		for name, property := range value.Properties {
+			if other, found := properties[name]; found && !reflect.DeepEqual(other, property) {
+				return nil
+			}
+			properties[name] = property
+		}
+		if merged, err = g.mergeOpenapiSchemas(merged, value, true); err != nil {
+			return nil
+		}
+	}
+
+	// The flattened type is that of the schema itself, with its documentation
+	// and extensions.
+	merged.Required = nil
+	merged.Title, merged.Description = schema.Title, schema.Description
+	merged.Extensions = schema.Extensions
+	merged.Nullable = schema.Nullable
+	return &merged
+}
@@ -0,0 +1,84 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenAnyOfObjects(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: flatten-anyof-objects
+paths: {}
+components:
+  schemas:
+    Address:
+      type: object
+      required: [street]
+      properties:
+        street:
+          type: string
+        id:
+          type: string
+    Contact:
+      description: The ways to reach a customer.
+      anyOf:
+        - $ref: '#/components/schemas/Address'
+        - type: object
+          required: [email]
+          properties:
+            email:
+              type: string
+            id:
+              type: string
+        - allOf:
+            - type: object
+              properties:
+                phone:
+                  type: string
+    Conflicting:
+      anyOf:
+        - $ref: '#/components/schemas/Address'
+        - type: object
+          properties:
+            id:
+              type: integer
+    Mixed:
+      anyOf:
+        - $ref: '#/components/schemas/Address'
+        - type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	generate := func(flatten bool) string {
+		code, err := Generate(swagger, Configuration{
+			PackageName:   "api",
+			Generate:      GenerateOptions{Models: true},
+			OutputOptions: OutputOptions{SkipPrune: true, FlattenAnyOfObjects: flatten},
+		})
+		require.NoError(t, err)
+		return code
+	}
+
+	code := generate(true)
+	assert.Contains(t, code, "// Contact The ways to reach a customer.\ntype Contact struct {")
+	assert.Regexp(t, "Email\\s+\\*string\\s+`json:\"email,omitempty\"`", code)
+	assert.Regexp(t, "Id\\s+\\*string\\s+`json:\"id,omitempty\"`", code)
+	assert.Regexp(t, "Phone\\s+\\*string\\s+`json:\"phone,omitempty\"`", code)
+	assert.Regexp(t, "Street\\s+\\*string\\s+`json:\"street,omitempty\"`", code)
+	assert.NotContains(t, code, "func (t Contact) AsAddress()")
+
+	// The members declaring id differently, or which aren't all objects,
+	// are unions still.
+	assert.Contains(t, code, "func (t Conflicting) AsAddress()")
+	assert.Contains(t, code, "func (t Mixed) AsAddress()")
+
+	code = generate(false)
+	assert.Contains(t, code, "func (t Contact) AsAddress()")
+}
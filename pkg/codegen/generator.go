@@ -0,0 +1,276 @@
+package codegen
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// DocumentLoader reads the documents referenced by a spec through $ref, so
+// that they can come from somewhere other than the file system or network.
+type DocumentLoader interface {
+	// LoadDocument returns the contents of the document at location, which
+	// is resolved against the location of the referencing document.
+	LoadDocument(ctx context.Context, location *url.URL) ([]byte, error)
+}
+
+// Warning describes a problem with the spec which didn't prevent generating
+// code, but likely needs attention.
+type Warning struct {
+	Location string // Where in the spec the problem is, eg, paths./pets.get
+	Message  string
+}
+
+func (w Warning) String() string {
+	if w.Location == "" {
+		return w.Message
+	}
+	return w.Location + ": " + w.Message
+}
+
+// addWarning records a warning for the current generation run.
+func (g *generator) addWarning(location string, format string, args ...interface{}) {
+	g.warnings = append(g.warnings, Warning{
+		Location: location,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// operationLocation returns the location of an operation in the spec, for
+// use in warnings.
+func operationLocation(path string, method string) string {
+	return fmt.Sprintf("paths.%s.%s", path, strings.ToLower(method))
+}
+
+// ProgressFunc receives the progress of a generation run: done out of total
+// steps of phase are complete. The phases are, in order, "parse", "schemas",
+// "operations" and "format", some of which are skipped depending on the
+// configuration.
+type ProgressFunc func(phase string, done, total int)
+
+// reportProgress passes the progress of the current generation run to the
+// configured ProgressFunc, if any.
+func (g *generator) reportProgress(phase string, done, total int) {
+	if progress := g.options.Progress; progress != nil {
+		progress(phase, done, total)
+	}
+}
+
+// GenerateFiles loads the spec and generates code for it as described by
+// cfg, returning the generated files keyed by name, along with the warnings
+// found along the way. The code is named after cfg.OutputFileName, and comes
+// with a manifest describing it when cfg.OutputOptions.Manifest is set.
+// Relative references are resolved against cfg.SpecLocation and read through
+// cfg.Loader when it is set.
+//
+// Unlike Generate, it doesn't print anything. It is safe to call from multiple
+// goroutines, each run having its own state, except for the circular
+// reference limit of cfg.Compatibility, a package variable of kin-openapi,
+// which the specs using it are loaded one at a time for. ctx is checked
+// between the phases of generation and between operations; once it is done,
+// GenerateFiles returns ctx.Err(). Progress is reported to cfg.Progress when
+// it is set, and timings to cfg.Timing.
+func GenerateFiles(ctx context.Context, spec []byte, cfg Configuration) (map[string][]byte, []Warning, error) {
+	// The spec is loaded by a run of its own, which records the positions
+	// of its properties, passed on to the runs generating the code, which
+	// add those of the schemas they merge.
+	loading := &generator{options: cfg}
+	loading.reportProgress("parse", 0, 1)
+	endParse := startPhase(cfg.Timing, "parse")
+	swagger, err := loading.loadSpec(ctx, spec, cfg)
+	endParse()
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("error loading spec: %w", err)
+	}
+	loading.reportProgress("parse", 1, 1)
+	newRun := func(cfg Configuration) *generator {
+		g := newGenerator(swagger, cfg)
+		if loading.propertyPositions != nil {
+			g.propertyPositions = make(map[*openapi3.SchemaRef]int, len(loading.propertyPositions))
+			for property, position := range loading.propertyPositions {
+				g.propertyPositions[property] = position
+			}
+		}
+		return g
+	}
+
+	specPackage := cfg.OutputOptions.EmbeddedSpecPackage
+	codeCfg := cfg
+	if specPackage != nil {
+		codeCfg.Generate.EmbeddedSpec = false
+	}
+	g := newRun(codeCfg)
+	code, err := g.generate(ctx)
+	warnings := g.warnings
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	name := cfg.OutputFileName
+	if name == "" {
+		name = cfg.PackageName + ".gen.go"
+	}
+	files := map[string][]byte{name: []byte(code)}
+	if cfg.Generate.ParamExampleTests {
+		files[paramExampleTestsFileName(name)] = []byte(g.paramExampleTests)
+	}
+
+	// The previous names are those of the baseline recorded by the previous
+	// manifest, as long as the naming options differ from it.
+	var baseline map[string]interface{}
+	var previousNaming namingOptions
+	if cfg.OutputOptions.CompatAliases {
+		if b := compatBaseline(cfg.PreviousManifest, name); b != nil {
+			baselineCfg, err := configurationFromManifest(b)
+			if err != nil {
+				return nil, warnings, fmt.Errorf("error reading the configuration of the previous manifest: %w", err)
+			}
+			previousNaming = namingOptionsOf(baselineCfg)
+			if previousNaming != namingOptionsOf(cfg) {
+				baseline = b
+			}
+		}
+	}
+
+	if cfg.OutputOptions.Manifest {
+		manifest, err := g.newManifest(name, files[name], spec, cfg, baseline)
+		if err != nil {
+			return nil, warnings, fmt.Errorf("error generating manifest: %w", err)
+		}
+		files[ManifestFileName] = manifest
+	}
+
+	if cfg.OutputOptions.CompatAliases {
+		previousCode := code
+		if baseline != nil {
+			// The warnings of the spec were reported by the first generation.
+			previousCfg := previousNaming.apply(codeCfg)
+			previousCfg.Generate.ParamExampleTests = false
+			previousCode, err = newRun(previousCfg).generate(ctx)
+			if err != nil {
+				return nil, warnings, fmt.Errorf("error generating the code with the previous names: %w", err)
+			}
+		}
+		compatCode, unrepresentable, err := g.compatAliasesFile(previousCode, code, cfg)
+		if err != nil {
+			return nil, warnings, fmt.Errorf("error generating compat aliases: %w", err)
+		}
+		for _, u := range unrepresentable {
+			warnings = append(warnings, Warning{Message: "compat-aliases: " + u})
+		}
+		files[compatAliasesFileName(name)] = []byte(compatCode)
+	}
+
+	if specPackage != nil {
+		// The warnings of the spec were reported by the first generation.
+		specCode, err := newRun(embeddedSpecConfiguration(cfg)).generate(ctx)
+		if err != nil {
+			return nil, warnings, fmt.Errorf("error generating embedded spec package: %w", err)
+		}
+		files[filepath.ToSlash(filepath.Clean(specPackage.Output))] = []byte(specCode)
+	}
+	return files, warnings, nil
+}
+
+// paramExampleTestsFileName returns the name of the test file of
+// param-example-tests, param_examples_test.go next to the code written to the
+// file called name.
+func paramExampleTestsFileName(name string) string {
+	return path.Join(path.Dir(name), "param_examples_test.go")
+}
+
+// embeddedSpecConfiguration returns the configuration generating only the
+// embedded spec, in the package cfg.OutputOptions.EmbeddedSpecPackage names.
+func embeddedSpecConfiguration(cfg Configuration) Configuration {
+	specPackage := cfg.OutputOptions.EmbeddedSpecPackage
+	cfg.PackageName = specPackage.Package
+	cfg.Generate = GenerateOptions{EmbeddedSpec: true}
+	if specPackage.ImportMapping != nil {
+		cfg.ImportMapping = specPackage.ImportMapping
+	}
+	return cfg
+}
+
+// circularReferenceMu guards openapi3.CircularReferenceCounter, which the
+// loader reads, and which loadSpec sets for the specs with a circular
+// reference limit, loading them one at a time.
+var circularReferenceMu sync.RWMutex
+
+// loadSpec parses spec, following its references, with the numeric exclusive
+// bounds and the type arrays of OpenAPI 3.1 rewritten to those of 3.0, and
+// the order of the properties recorded when the property-order output option
+// asks for it.
+func (g *generator) loadSpec(ctx context.Context, spec []byte, cfg Configuration) (*openapi3.T, error) {
+	loader := openapi3.NewLoader()
+	loader.Context = ctx
+	loader.IsExternalRefsAllowed = true
+	read := openapi3.DefaultReadFromURI
+	if cfg.Loader != nil {
+		read = func(_ *openapi3.Loader, location *url.URL) ([]byte, error) {
+			return cfg.Loader.LoadDocument(ctx, location)
+		}
+	}
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, location *url.URL) ([]byte, error) {
+		data, err := read(loader, location)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.OutputOptions.PropertyOrder == PropertyOrderDeclared {
+			g.recordPropertyOrders(location.String(), data)
+		}
+		return normalizeDocument(data)
+	}
+
+	if limit := cfg.Compatibility.CircularReferenceLimit; limit > 0 {
+		circularReferenceMu.Lock()
+		defer circularReferenceMu.Unlock()
+		existing := openapi3.CircularReferenceCounter
+		openapi3.CircularReferenceCounter = limit
+		defer func() {
+			openapi3.CircularReferenceCounter = existing
+		}()
+	} else {
+		circularReferenceMu.RLock()
+		defer circularReferenceMu.RUnlock()
+	}
+
+	location, err := url.Parse(cfg.SpecLocation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec location %q: %w", cfg.SpecLocation, err)
+	}
+	if cfg.OutputOptions.PropertyOrder == PropertyOrderDeclared {
+		g.recordPropertyOrders(cfg.SpecLocation, spec)
+	}
+	spec, err = normalizeDocument(spec)
+	if err != nil {
+		return nil, err
+	}
+	swagger, err := loader.LoadFromDataWithPath(spec, location)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.OutputOptions.PropertyOrder == PropertyOrderDeclared {
+		g.indexPropertyOrders(swagger, cfg.SpecLocation)
+	}
+	return swagger, nil
+}
+
+// normalizeDocument rewrites the constructs of OpenAPI 3.1 which kin-openapi
+// doesn't read in the document data, its numeric exclusive bounds and its
+// type arrays, to those of 3.0.
+func normalizeDocument(data []byte) ([]byte, error) {
+	data, err := normalizeExclusiveBounds(data)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeTypeArrays(data)
+}
@@ -0,0 +1,541 @@
+package codegen
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mapLoader map[string]string
+
+func (l mapLoader) LoadDocument(_ context.Context, location *url.URL) ([]byte, error) {
+	doc, found := l[location.Path]
+	if !found {
+		return nil, fmt.Errorf("document %s not found", location)
+	}
+	return []byte(doc), nil
+}
+
+const generatorSpec = `
+openapi: "3.0.0"
+info:
+  title: generator
+  version: "1"
+paths:
+  /things/{id}:
+    get:
+      operationId: getThing
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+        - name: id
+          in: query
+          schema:
+            type: string
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                $ref: "./models.yaml#/components/schemas/Thing"
+`
+
+const generatorModels = `
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        name:
+          type: string
+`
+
+func TestGenerateFiles(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+			Client: true,
+		},
+		ImportMapping: map[string]string{
+			"./models.yaml": "example.com/models",
+		},
+		SpecLocation: "specs/api.yaml",
+		Loader:       mapLoader{"specs/models.yaml": generatorModels},
+	}
+
+	files, warnings, err := GenerateFiles(context.Background(), []byte(generatorSpec), cfg)
+	require.NoError(t, err)
+
+	code := string(files["api.gen.go"])
+	assert.Contains(t, code, `externalRef0 "example.com/models"`)
+	assert.Contains(t, code, "JSON200      *externalRef0.Thing")
+	assert.Contains(t, code, "IdQuery *string")
+
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "paths./things/{id}.get", warnings[0].Location)
+	assert.Contains(t, warnings[0].Message, "generating it as IdQuery")
+}
+
+func TestGenerateFilesWarnsOfBodiesOfGet(t *testing.T) {
+	spec := `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Bodies of GET
+paths:
+  /search:
+    get:
+      operationId: search
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+      responses:
+        '204':
+          description: Found
+`
+	files, warnings, err := GenerateFiles(context.Background(), []byte(spec), Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Client: true},
+	})
+	require.NoError(t, err)
+
+	code := string(files["api.gen.go"])
+	assert.Contains(t, code, "func (c *Client) Search(ctx context.Context, body SearchJSONRequestBody")
+	assert.Contains(t, code, "func (c *Client) SearchWithoutBody(ctx context.Context")
+
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "paths./search.get", warnings[0].Location)
+	assert.Contains(t, warnings[0].Message, "GET operation has a request body")
+}
+
+func TestGenerateFilesWarnsOfRequiredPreserveZero(t *testing.T) {
+	spec := `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Preserving zero values
+paths: {}
+components:
+  schemas:
+    Metrics:
+      type: object
+      required: [count, id]
+      properties:
+        count:
+          type: integer
+          x-go-preserve-zero: true
+        id:
+          type: integer
+          readOnly: true
+          x-go-preserve-zero: true
+        total:
+          type: integer
+          x-go-preserve-zero: true
+`
+	files, warnings, err := GenerateFiles(context.Background(), []byte(spec), Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.NoError(t, err)
+
+	code := string(files["api.gen.go"])
+	assert.Regexp(t, "Count +int +`json:\"count\"`", code)
+	assert.Regexp(t, "Id +int +`json:\"id\"`", code)
+	assert.Regexp(t, "Total +int +`json:\"total\"`", code)
+
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "Metrics", warnings[0].Location)
+	assert.Contains(t, warnings[0].Message, `x-go-preserve-zero has no effect on property "count"`)
+}
+
+func TestGenerateFilesExternalDocumentIdentity(t *testing.T) {
+	// Both partners define Error and Page, which our spec defines too, and
+	// partner A refers to a document next to it.
+	spec := `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Partners
+paths: {}
+components:
+  schemas:
+    Page:
+      type: object
+      x-go-name: LocalPage
+      properties:
+        next:
+          type: string
+    PartnerAError:
+      allOf:
+        - $ref: "./partner-a/api.yaml#/components/schemas/Error"
+        - type: object
+          properties:
+            retry:
+              type: boolean
+    PartnerBError:
+      allOf:
+        - $ref: "partner-b/api.yaml#/components/schemas/Error"
+        - type: object
+          properties:
+            retry:
+              type: boolean
+    Failure:
+      $ref: "./partner-b/api.yaml#/components/schemas/Error"
+`
+	partnerA := `
+components:
+  schemas:
+    Error:
+      type: object
+      properties:
+        page:
+          $ref: "#/components/schemas/Page"
+        meta:
+          $ref: "../common/types.yaml#/components/schemas/Meta"
+    Page:
+      type: object
+      properties:
+        cursor:
+          type: string
+`
+	partnerB := `
+components:
+  schemas:
+    Error:
+      type: object
+      properties:
+        page:
+          $ref: "#/components/schemas/Page"
+    Page:
+      type: object
+      properties:
+        offset:
+          type: integer
+`
+	common := `
+components:
+  schemas:
+    Meta:
+      type: object
+`
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true},
+		ImportMapping: map[string]string{
+			"./partner-a/api.yaml": "example.com/partnera",
+			"./partner-b/api.yaml": "example.com/partnerb",
+			"./common/types.yaml":  "example.com/common",
+		},
+		SpecLocation: "specs/api.yaml",
+		Loader: mapLoader{
+			"specs/partner-a/api.yaml": partnerA,
+			"specs/partner-b/api.yaml": partnerB,
+			"specs/common/types.yaml":  common,
+		},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	}
+
+	files, _, err := GenerateFiles(context.Background(), []byte(spec), cfg)
+	require.NoError(t, err)
+
+	code := string(files["api.gen.go"])
+	assert.Contains(t, code, `externalRef0 "example.com/common"`)
+	assert.Contains(t, code, `externalRef1 "example.com/partnera"`)
+	assert.Contains(t, code, `externalRef2 "example.com/partnerb"`)
+	assert.Regexp(t, `type PartnerAError struct {\s+Meta\s+\*externalRef0\.Meta .*\s+Page\s+\*externalRef1\.Page `, code)
+	assert.Regexp(t, `type PartnerBError struct {\s+Page\s+\*externalRef2\.Page `, code)
+	assert.Contains(t, code, "type Failure = externalRef2.Error")
+	assert.NotContains(t, code, "externalRef1.LocalPage")
+}
+
+func TestGenerateFilesExternalComponents(t *testing.T) {
+	spec := `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: external components
+paths:
+  /pets:
+    post:
+      operationId: addPet
+      parameters:
+        - $ref: 'https://schemas.example.com/common.yaml#/components/parameters/TenantHeader'
+      requestBody:
+        $ref: 'https://schemas.example.com/common.yaml#/components/requestBodies/PetBody'
+      responses:
+        "200":
+          $ref: 'https://schemas.example.com/common.yaml#/components/responses/PetResponse'
+        "201":
+          $ref: 'https://schemas.example.com/common.yaml#/components/responses/PetResponse'
+components:
+  schemas:
+    Pet:
+      type: object
+`
+	common := `
+components:
+  parameters:
+    TenantHeader:
+      name: X-Tenant
+      in: header
+      required: true
+      schema:
+        $ref: '#/components/schemas/Tenant'
+  requestBodies:
+    PetBody:
+      content:
+        application/json:
+          schema:
+            $ref: '#/components/schemas/Pet'
+  responses:
+    PetResponse:
+      description: The pet.
+      headers:
+        X-Rate-Limit:
+          $ref: '#/components/headers/RateLimit'
+      content:
+        application/json:
+          schema:
+            $ref: '#/components/schemas/Pet'
+  headers:
+    RateLimit:
+      schema:
+        type: integer
+  schemas:
+    Tenant:
+      type: string
+    Pet:
+      type: object
+`
+	cfg := Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true, Client: true, ChiServer: true, Strict: true},
+		ImportMapping: map[string]string{"https://schemas.example.com/common.yaml": "example.com/common"},
+		SpecLocation:  "specs/api.yaml",
+		Loader:        mapLoader{"/common.yaml": common},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	}
+
+	files, _, err := GenerateFiles(context.Background(), []byte(spec), cfg)
+	require.NoError(t, err)
+	code := string(files["api.gen.go"])
+	assert.Contains(t, code, "XTenant externalRef0.TenantHeader `json:\"X-Tenant\"`")
+	assert.Contains(t, code, "type AddPetJSONRequestBody = externalRef0.Pet\n")
+	assert.Regexp(t, `type AddPet200JSONResponse struct {\s+externalRef0\.PetResponseJSONResponse\s+}`, code)
+	// The second response referring to the same component has types of its
+	// own, which its schemas are resolved against the common spec for.
+	assert.Regexp(t, `type AddPet201ResponseHeaders struct {\s+XRateLimit externalRef0\.RateLimit\s+}`, code)
+	assert.Regexp(t, `type AddPet201JSONResponse struct {\s+Body\s+externalRef0\.Pet\s`, code)
+
+	// Components of a document which isn't mapped to a package, or can't be
+	// read, fail the generation rather than being left out.
+	unmapped := cfg
+	unmapped.ImportMapping = nil
+	_, _, err = GenerateFiles(context.Background(), []byte(spec), unmapped)
+	assert.ErrorContains(t, err, "unrecognized external reference 'https://schemas.example.com/common.yaml'")
+
+	missing := cfg
+	missing.Loader = mapLoader{}
+	_, _, err = GenerateFiles(context.Background(), []byte(spec), missing)
+	assert.ErrorContains(t, err, "document https://schemas.example.com/common.yaml not found")
+}
+
+func TestGenerateFilesEmbeddedSpecPackage(t *testing.T) {
+	files, _, err := GenerateFiles(context.Background(), []byte(testOpenAPIDefinition), Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true, EmbeddedSpec: true},
+		OutputOptions: OutputOptions{
+			EmbeddedSpecPackage: &EmbeddedSpecPackage{Package: "spec", Output: "spec/spec.gen.go"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	code := string(files["api.gen.go"])
+	assert.Contains(t, code, "package api")
+	assert.NotContains(t, code, "func GetSwagger()")
+
+	spec := string(files["spec/spec.gen.go"])
+	assert.Contains(t, spec, "package spec")
+	assert.Contains(t, spec, "func GetSwagger()")
+	assert.NotContains(t, spec, "type Test struct")
+}
+
+func TestGenerateFilesConcurrently(t *testing.T) {
+	cfgs := []Configuration{
+		{
+			PackageName: "models",
+			Generate:    GenerateOptions{Models: true},
+			OutputOptions: OutputOptions{
+				SkipPrune:          true,
+				ResponseTypeSuffix: "Reply",
+			},
+		},
+		{
+			PackageName: "client",
+			Generate:    GenerateOptions{Models: true, Client: true},
+		},
+	}
+	specs := []string{generatorModels + "openapi: \"3.0.0\"\ninfo: {title: models, version: \"1\"}\npaths: {}\n", testOpenAPIDefinition}
+
+	// Generate each spec on its own first, to compare with the concurrent
+	// results.
+	want := make([]string, len(specs))
+	for i := range specs {
+		files, _, err := GenerateFiles(context.Background(), []byte(specs[i]), cfgs[i])
+		require.NoError(t, err)
+		want[i] = string(files[cfgs[i].PackageName+".gen.go"])
+	}
+
+	// Each run waits, once it starts generating the schemas, for all the
+	// others to get there too, which they only do when they all go on at the
+	// same time.
+	const runs = 5
+	var arrived sync.WaitGroup
+	arrived.Add(len(specs) * runs)
+	overlapping := make(chan struct{})
+	go func() {
+		arrived.Wait()
+		close(overlapping)
+	}()
+	for i := range cfgs {
+		cfgs[i].Progress = func(phase string, done, total int) {
+			if phase != "schemas" || done != 0 {
+				return
+			}
+			arrived.Done()
+			select {
+			case <-overlapping:
+			case <-time.After(10 * time.Second):
+				t.Error("the generation runs didn't go on concurrently")
+			}
+		}
+	}
+
+	var wg sync.WaitGroup
+	got := make([][]string, len(specs))
+	for i := range specs {
+		got[i] = make([]string, runs)
+		for j := range got[i] {
+			wg.Add(1)
+			go func(i, j int) {
+				defer wg.Done()
+				files, _, err := GenerateFiles(context.Background(), []byte(specs[i]), cfgs[i])
+				if assert.NoError(t, err) {
+					got[i][j] = string(files[cfgs[i].PackageName+".gen.go"])
+				}
+			}(i, j)
+		}
+	}
+	wg.Wait()
+
+	for i := range specs {
+		for j := range got[i] {
+			assert.Equal(t, want[i], got[i][j])
+		}
+	}
+}
+
+func TestExportedFunctionsUseLastGenerate(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(generatorModels + "openapi: \"3.0.0\"\ninfo: {title: models, version: \"1\"}\npaths: {}\n"))
+	require.NoError(t, err)
+	_, err = Generate(spec, Configuration{
+		PackageName:   "exported",
+		Generate:      GenerateOptions{Models: true},
+		ImportMapping: map[string]string{"exported.yaml": "github.com/example/exported"},
+	})
+	require.NoError(t, err)
+
+	// The exported functions, and the template functions depending on the
+	// run, use the options Generate was last called with.
+	goType, err := RefPathToGoType("exported.yaml#/components/schemas/Thing")
+	require.NoError(t, err)
+	assert.Equal(t, "externalRef0.Thing", goType)
+	opts := TemplateFunctions["opts"].(func() Configuration)()
+	assert.Equal(t, "exported", opts.PackageName)
+	assert.Equal(t, defaultClientTypeName, opts.OutputOptions.ClientTypeName)
+}
+
+func TestGenerateFilesProgress(t *testing.T) {
+	var phases []string
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true, Client: true},
+		ImportMapping: map[string]string{
+			"./models.yaml": "example.com/models",
+		},
+		SpecLocation: "specs/api.yaml",
+		Loader:       mapLoader{"specs/models.yaml": generatorModels},
+		Progress: func(phase string, done, total int) {
+			phases = append(phases, fmt.Sprintf("%s %d/%d", phase, done, total))
+		},
+	}
+
+	_, _, err := GenerateFiles(context.Background(), []byte(generatorSpec), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"parse 0/1",
+		"parse 1/1",
+		"operations 0/1",
+		"operations 1/1",
+		"schemas 0/1",
+		"schemas 1/1",
+		"format 0/1",
+		"format 1/1",
+	}, phases)
+}
+
+func TestGenerateFilesCanceled(t *testing.T) {
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true, Client: true},
+		ImportMapping: map[string]string{
+			"./models.yaml": "example.com/models",
+		},
+		SpecLocation: "specs/api.yaml",
+		Loader:       mapLoader{"specs/models.yaml": generatorModels},
+	}
+
+	t.Run("before generating", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		files, _, err := GenerateFiles(ctx, []byte(generatorSpec), cfg)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, files)
+	})
+
+	t.Run("between operations", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var phases []string
+		cfg := cfg
+		cfg.Progress = func(phase string, done, total int) {
+			phases = append(phases, phase)
+			if phase == "operations" {
+				cancel()
+			}
+		}
+
+		files, _, err := GenerateFiles(ctx, []byte(generatorSpec), cfg)
+		assert.Equal(t, context.Canceled, err)
+		assert.Nil(t, files)
+		assert.Equal(t, []string{"parse", "parse", "operations"}, phases)
+	})
+}
@@ -0,0 +1,200 @@
+package codegen
+
+import (
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// componentSchemaPrefix starts the references to the schemas of the spec
+// itself.
+const componentSchemaPrefix = "#/components/schemas/"
+
+// DiscriminatedChild describes a component schema inheriting, through allOf,
+// from a parent schema having a discriminator but no union, whose
+// Discriminator method returns the value identifying the child.
+type DiscriminatedChild struct {
+	TypeName   string
+	ParentType string
+	Property   string
+	Value      string
+}
+
+// DiscriminatedParent describes a component schema having a discriminator
+// but no union, whose Unmarshal<TypeName> function decodes the schemas
+// inheriting from it, or mapped by its discriminator, by their value.
+type DiscriminatedParent struct {
+	TypeName string
+	Property string
+	Cases    []DiscriminatorCase
+}
+
+// DiscriminatorCase is a discriminator value, and the type it decodes to.
+type DiscriminatorCase struct {
+	Value    string
+	TypeName string
+}
+
+// generateInheritedDiscriminators generates the Discriminator methods of the
+// component schemas inheriting from a schema with a discriminator, the
+// classic polymorphism pattern of OpenAPI, where the parent has no oneOf of
+// its children, and the Unmarshal function of each parent dispatching to
+// them. The value of a child is the one its parent maps to it, or else its
+// schema name. types are the type definitions of the component schemas.
+func (g *generator) generateInheritedDiscriminators(t *template.Template, spec *openapi3.T, types []TypeDefinition) (string, error) {
+	if spec.Components == nil {
+		return "", nil
+	}
+	schemas := spec.Components.Schemas
+
+	// The structs generated for the component schemas, by schema name.
+	structs := make(map[string]TypeDefinition)
+	for _, td := range types {
+		if schemas[td.JsonName] != nil && !td.IsAlias() && isStructType(td.Schema.GoType) {
+			structs[td.JsonName] = td
+		}
+	}
+
+	parents := make(map[string]*DiscriminatedParent)
+	var children []DiscriminatedChild
+	for _, name := range SortedSchemaKeys(schemas) {
+		td, ok := structs[name]
+		if !ok {
+			continue
+		}
+		ancestors := discriminatedAncestors(schemas, schemas[name].Value, map[string]bool{name: true})
+		if len(ancestors) == 0 {
+			continue
+		}
+		for i, ancestor := range ancestors {
+			parentType, ok := structs[ancestor]
+			if !ok {
+				continue
+			}
+			discriminator := schemas[ancestor].Value.Discriminator
+			value := inheritedDiscriminatorValue(discriminator, name)
+			parent := parents[ancestor]
+			if parent == nil {
+				parent = &DiscriminatedParent{TypeName: parentType.TypeName, Property: discriminator.PropertyName}
+				parents[ancestor] = parent
+			}
+			parent.Cases = append(parent.Cases, DiscriminatorCase{Value: value, TypeName: td.TypeName})
+
+			// A struct can only have one Discriminator method, that of its
+			// first discriminated ancestor, unless it has union methods.
+			if len(td.Schema.UnionElements) != 0 {
+				continue
+			}
+			if i != 0 {
+				g.addWarning(name, "schema inherits discriminators from both %s and %s, its Discriminator method returns the value of %s",
+					ancestors[0], ancestor, ancestors[0])
+				continue
+			}
+			children = append(children, DiscriminatedChild{
+				TypeName:   td.TypeName,
+				ParentType: parentType.TypeName,
+				Property:   discriminator.PropertyName,
+				Value:      value,
+			})
+		}
+	}
+
+	// The explicit mapping of a parent may also name schemas which don't
+	// inherit from it.
+	var parentList []DiscriminatedParent
+	for _, name := range SortedSchemaKeys(schemas) {
+		parent := parents[name]
+		if parent == nil {
+			continue
+		}
+		mapping := schemas[name].Value.Discriminator.Mapping
+		for _, value := range SortedStringKeys(mapping) {
+			td, ok := structs[discriminatorMappingSchema(mapping[value])]
+			if ok && !hasDiscriminatorCase(parent.Cases, value) {
+				parent.Cases = append(parent.Cases, DiscriminatorCase{Value: value, TypeName: td.TypeName})
+			}
+		}
+		sort.Slice(parent.Cases, func(i, j int) bool { return parent.Cases[i].Value < parent.Cases[j].Value })
+		parentList = append(parentList, *parent)
+	}
+
+	if len(children) == 0 && len(parentList) == 0 {
+		return "", nil
+	}
+	context := struct {
+		Children []DiscriminatedChild
+		Parents  []DiscriminatedParent
+	}{
+		Children: children,
+		Parents:  parentList,
+	}
+	return GenerateTemplates([]string{"inherited-discriminators.tmpl"}, t, context)
+}
+
+// discriminatedAncestors returns the names of the nearest component schemas
+// which schema inherits from through allOf, directly or through other
+// schemas, and which have a discriminator but no union. visited holds the
+// schemas already walked, to stop at cycles.
+func discriminatedAncestors(schemas openapi3.Schemas, schema *openapi3.Schema, visited map[string]bool) []string {
+	if schema == nil {
+		return nil
+	}
+	var ancestors []string
+	for _, member := range schema.AllOf {
+		if member == nil {
+			continue
+		}
+		if !strings.HasPrefix(member.Ref, componentSchemaPrefix) {
+			ancestors = append(ancestors, discriminatedAncestors(schemas, member.Value, visited)...)
+			continue
+		}
+		name := strings.TrimPrefix(member.Ref, componentSchemaPrefix)
+		if visited[name] || schemas[name] == nil || schemas[name].Value == nil {
+			continue
+		}
+		visited[name] = true
+		parent := schemas[name].Value
+		if parent.Discriminator != nil && parent.OneOf == nil && parent.AnyOf == nil {
+			ancestors = append(ancestors, name)
+			continue
+		}
+		ancestors = append(ancestors, discriminatedAncestors(schemas, parent, visited)...)
+	}
+	return ancestors
+}
+
+// inheritedDiscriminatorValue returns the value of discriminator identifying
+// the component schema name, the first one its mapping maps to it, or else
+// its name.
+func inheritedDiscriminatorValue(discriminator *openapi3.Discriminator, name string) string {
+	for _, value := range SortedStringKeys(discriminator.Mapping) {
+		if discriminatorMappingSchema(discriminator.Mapping[value]) == name {
+			return value
+		}
+	}
+	return name
+}
+
+// discriminatorMappingSchema returns the name of the component schema a
+// discriminator maps a value to, given as a reference or as a bare name, or
+// "" for the schemas of other documents.
+func discriminatorMappingSchema(target string) string {
+	if strings.HasPrefix(target, componentSchemaPrefix) {
+		return strings.TrimPrefix(target, componentSchemaPrefix)
+	}
+	if strings.ContainsAny(target, "#/") {
+		return ""
+	}
+	return target
+}
+
+func hasDiscriminatorCase(cases []DiscriminatorCase, value string) bool {
+	for _, c := range cases {
+		if c.Value == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInheritedDiscriminators(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: inherited discriminators
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [petType]
+      properties:
+        petType:
+          type: string
+      discriminator:
+        propertyName: petType
+        mapping:
+          dog: '#/components/schemas/Dog'
+          robot: RoboDog
+    Dog:
+      allOf:
+        - $ref: '#/components/schemas/Pet'
+        - type: object
+          properties:
+            bark:
+              type: boolean
+    Cat:
+      allOf:
+        - $ref: '#/components/schemas/Pet'
+        - type: object
+          properties:
+            meow:
+              type: boolean
+    Puppy:
+      allOf:
+        - $ref: '#/components/schemas/Dog'
+        - type: object
+          properties:
+            age:
+              type: integer
+    RoboDog:
+      type: object
+      properties:
+        petType:
+          type: string
+    Plain:
+      type: object
+      properties:
+        name:
+          type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.NoError(t, err)
+
+	// The explicit mapping, the default schema name, and inheritance through
+	// another child.
+	assert.Regexp(t, `func \(t Dog\) Discriminator\(\) string {\s+return "dog"`, code)
+	assert.Regexp(t, `func \(t Cat\) Discriminator\(\) string {\s+return "Cat"`, code)
+	assert.Regexp(t, `func \(t Puppy\) Discriminator\(\) string {\s+return "Puppy"`, code)
+	// Schemas only named by the mapping are decoded, but have no method.
+	assert.NotContains(t, code, "func (t RoboDog) Discriminator()")
+	assert.NotContains(t, code, "func (t Pet) Discriminator()")
+	assert.NotContains(t, code, "func (t Plain) Discriminator()")
+
+	assert.Contains(t, code, "func UnmarshalPet(data []byte) (interface{}, error) {")
+	assert.Regexp(t, `case "Cat":\s+var v Cat`, code)
+	assert.Regexp(t, `case "Puppy":\s+var v Puppy`, code)
+	assert.Regexp(t, `case "dog":\s+var v Dog`, code)
+	assert.Regexp(t, `case "robot":\s+var v RoboDog`, code)
+	assert.NotContains(t, code, "func UnmarshalDog(")
+}
+
+func TestInheritedDiscriminatorsFromUnion(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: inherited discriminators
+paths: {}
+components:
+  schemas:
+    Shape:
+      oneOf:
+        - $ref: '#/components/schemas/Circle'
+      discriminator:
+        propertyName: kind
+    Circle:
+      type: object
+      properties:
+        kind:
+          type: string
+        radius:
+          type: number
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	// Parents with a union already dispatch on their discriminator.
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, code, "func UnmarshalShape(")
+	assert.NotContains(t, code, "func (t Circle) Discriminator()")
+}
@@ -0,0 +1,18 @@
+package codegen
+
+import "text/template"
+
+// GenerateInstrumentation generates the Instrumentation interface, along with
+// the helpers through which the servers generated with it tell it about the
+// requests of their operations.
+func GenerateInstrumentation(t *template.Template, opts Configuration) (string, error) {
+	return GenerateTemplates([]string{"instrumentation.tmpl"}, t, opts)
+}
+
+// instrumented reports whether the Instrumentation interface is generated,
+// which it is for the client and the servers taking it in their options.
+func instrumented(opts Configuration) bool {
+	return opts.OutputOptions.Instrumentation &&
+		(opts.Generate.Client || opts.Generate.ChiServer || opts.Generate.GorillaServer || opts.Generate.GinServer ||
+			opts.Generate.EchoServer || opts.Generate.FiberServer || opts.Generate.IrisServer)
+}
@@ -0,0 +1,114 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// InterfaceDefinition describes the <Name>Like interface generated for a
+// struct type, along with the getters it's made of.
+type InterfaceDefinition struct {
+	TypeName string
+	Getters  []GetterDefinition
+}
+
+// GetterDefinition describes the getter of a field.
+type GetterDefinition struct {
+	Name  string
+	Field string
+	// Type is the type the getter returns, which is the type of the field, or
+	// the Like interface of the struct it holds when there is one.
+	Type string
+	// Nilable is set when the field is a pointer returned as an interface,
+	// which has to be nil rather than hold a nil pointer.
+	Nilable bool
+}
+
+// generateInterfaces generates getters, and a <Name>Like interface of them,
+// for the struct types among the given type definitions whose schema is listed
+// in interfacesFor, or sets x-go-interface.
+func (g *generator) generateInterfaces(t *template.Template, typeDefs []TypeDefinition, interfacesFor []string) (string, error) {
+	listed := make(map[string]bool, len(interfacesFor))
+	for _, name := range interfacesFor {
+		listed[name] = true
+	}
+
+	types := make(map[string]bool)
+	var selected []TypeDefinition
+	for _, td := range typeDefs {
+		if types[td.TypeName] {
+			continue
+		}
+		types[td.TypeName] = true
+
+		wanted := listed[td.JsonName]
+		delete(listed, td.JsonName)
+		if td.Schema.OAPISchema != nil {
+			if extension, ok := td.Schema.OAPISchema.Extensions[extGoInterface]; ok {
+				goInterface, err := extParseGoInterface(extension)
+				if err != nil {
+					return "", fmt.Errorf("%s: invalid value for %q: %w", td.TypeName, extGoInterface, err)
+				}
+				wanted = wanted || goInterface
+			}
+		}
+		if !wanted {
+			continue
+		}
+		if td.IsAlias() || td.Schema.IsRef() || !isStructType(td.Schema.GoType) {
+			g.addWarning(td.TypeName, "%s isn't a struct, so no %sLike interface is generated", td.TypeName, td.TypeName)
+			continue
+		}
+		selected = append(selected, td)
+	}
+	for _, name := range interfacesFor {
+		if listed[name] {
+			g.addWarning(name, "schema %q, listed in interfaces-for, isn't generated", name)
+			delete(listed, name)
+		}
+	}
+	if len(selected) == 0 {
+		return "", nil
+	}
+
+	interfaces := make(map[string]bool, len(selected))
+	for _, td := range selected {
+		if types[td.TypeName+"Like"] {
+			return "", fmt.Errorf("%s: the %sLike interface collides with a generated type", td.TypeName, td.TypeName)
+		}
+		interfaces[td.TypeName] = true
+	}
+
+	definitions := make([]InterfaceDefinition, 0, len(selected))
+	for _, td := range selected {
+		fields := make(map[string]string, len(td.Schema.Properties))
+		for _, p := range td.Schema.Properties {
+			fields[p.GoFieldName()] = p.JsonFieldName
+		}
+
+		getters := make([]GetterDefinition, 0, len(td.Schema.Properties))
+		for _, p := range td.Schema.Properties {
+			getter := GetterDefinition{
+				Name:  "Get" + p.GoFieldName(),
+				Field: p.GoFieldName(),
+				Type:  fieldTypeDef(p),
+			}
+			if other, found := fields[getter.Name]; found {
+				return "", fmt.Errorf("%s: the getter of property %q, %s, collides with the field of property %q; use %s to rename one of them",
+					td.TypeName, p.JsonFieldName, getter.Name, other, extGoName)
+			}
+			if elem := strings.TrimPrefix(getter.Type, "*"); interfaces[elem] {
+				getter.Nilable = elem != getter.Type
+				getter.Type = elem + "Like"
+			}
+			getters = append(getters, getter)
+		}
+		definitions = append(definitions, InterfaceDefinition{
+			TypeName: td.TypeName,
+			Getters:  getters,
+		})
+	}
+
+	return GenerateTemplates([]string{"interfaces.tmpl"}, t, definitions)
+}
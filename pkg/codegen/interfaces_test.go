@@ -0,0 +1,23 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateInterfacesGetterCollision(t *testing.T) {
+	pet := TypeDefinition{
+		TypeName: "Pet",
+		JsonName: "Pet",
+		Schema: Schema{
+			GoType: "struct {}",
+			Properties: []Property{
+				{JsonFieldName: "getName", Required: true, Schema: Schema{GoType: "string"}},
+				{JsonFieldName: "name", Required: true, Schema: Schema{GoType: "string"}},
+			},
+		},
+	}
+	_, err := newGenerator(nil, Configuration{}).generateInterfaces(nil, []TypeDefinition{pet}, []string{"Pet"})
+	assert.EqualError(t, err, `Pet: the getter of property "name", GetName, collides with the field of property "getName"; use x-go-name to rename one of them`)
+}
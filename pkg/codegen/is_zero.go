@@ -0,0 +1,157 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// IsZeroDefinition describes the IsZero method generated for a struct type.
+type IsZeroDefinition struct {
+	TypeName string
+	// Checks are the conditions which all hold when the struct is unset.
+	Checks []string
+}
+
+// isZeroGenerator builds the IsZero conditions of the generated structs,
+// resolving the types their fields refer to among the generated ones.
+type isZeroGenerator struct {
+	types   map[string]TypeDefinition
+	structs map[string]bool
+	// usesHelper is set when a field's type is unknown to the generator, and
+	// it has to rely on the generated isZeroValue helper.
+	usesHelper bool
+}
+
+// GenerateIsZeroBoilerplate generates an IsZero method for every struct among
+// the given type definitions, reporting whether all of its fields are unset.
+func GenerateIsZeroBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	g := isZeroGenerator{
+		types:   make(map[string]TypeDefinition),
+		structs: make(map[string]bool),
+	}
+
+	var structTypes []TypeDefinition
+	for _, td := range typeDefs {
+		if _, found := g.types[td.TypeName]; found {
+			continue
+		}
+		g.types[td.TypeName] = td
+		if !td.IsAlias() && !td.Schema.IsRef() && isStructType(td.Schema.GoType) {
+			g.structs[td.TypeName] = true
+			structTypes = append(structTypes, td)
+		}
+	}
+
+	if len(structTypes) == 0 {
+		return "", nil
+	}
+
+	definitions := make([]IsZeroDefinition, 0, len(structTypes))
+	for _, td := range structTypes {
+		definitions = append(definitions, IsZeroDefinition{
+			TypeName: td.TypeName,
+			Checks:   g.structChecks("t", td.Schema),
+		})
+	}
+
+	context := struct {
+		Types      []IsZeroDefinition
+		UsesHelper bool
+	}{
+		Types:      definitions,
+		UsesHelper: g.usesHelper,
+	}
+
+	return GenerateTemplates([]string{"is-zero.tmpl"}, t, context)
+}
+
+func isStructType(goType string) bool {
+	return strings.HasPrefix(goType, "struct {") || strings.HasPrefix(goType, "struct{")
+}
+
+// structChecks returns the conditions under which every field of the struct
+// held in v is unset.
+func (g *isZeroGenerator) structChecks(v string, s Schema) []string {
+	var checks []string
+	for _, p := range s.Properties {
+		field := v + "." + p.GoFieldName()
+
+		// Mirror GenFieldsFromProperties, which lets
+		// x-go-type-skip-optional-pointer decide on pointers.
+		if extension, ok := p.Extensions[extPropGoTypeSkipOptionalPointer]; ok {
+			if skipOptionalPointer, err := extParsePropGoTypeSkipOptionalPointer(extension); err == nil {
+				p.Schema.SkipOptionalPointer = skipOptionalPointer
+			}
+		}
+
+		if strings.HasPrefix(p.GoTypeDef(), "*") {
+			checks = append(checks, field+" == nil")
+			continue
+		}
+		checks = append(checks, g.check(field, p.Schema, 0))
+	}
+	if s.HasAdditionalProperties {
+		checks = append(checks, fmt.Sprintf("len(%s.AdditionalProperties) == 0", v))
+	}
+	if len(s.UnionElements) != 0 {
+		checks = append(checks, fmt.Sprintf("len(%s.union) == 0", v))
+	}
+	return checks
+}
+
+// check returns the condition under which the value v of the given schema is
+// the zero value of its type.
+func (g *isZeroGenerator) check(v string, s Schema, depth int) string {
+	goType := s.TypeDecl()
+
+	if g.structs[goType] {
+		return v + ".IsZero()"
+	}
+	// Types such as enums or aliases are checked like the type they're
+	// defined as. The depth guards against aliases referring to each other.
+	if td, found := g.types[goType]; found && depth < 8 {
+		return g.check(v, td.Schema, depth+1)
+	}
+
+	switch {
+	case strings.HasPrefix(goType, "[]"), strings.HasPrefix(goType, "map["), goType == "json.RawMessage":
+		return fmt.Sprintf("len(%s) == 0", v)
+	case goType == "interface{}":
+		return v + " == nil"
+	case goType == "string", goType == "openapi_types.Email":
+		return v + ` == ""`
+	case goType == "bool":
+		return "!" + v
+	case isNumericGoType(goType):
+		return v + " == 0"
+	case goType == "time.Time", goType == "openapi_types.Date", goType == "StrictDate":
+		return v + ".IsZero()"
+	case goType == "openapi_types.UUID":
+		return v + " == (openapi_types.UUID{})"
+	case strings.HasPrefix(goType, "OrderedSet["):
+		return v + ".Len() == 0"
+	case goType == "openapi_types.File":
+		return fmt.Sprintf(`(%s.Filename() == "" && %s.FileSize() == 0)`, v, v)
+	case isStructType(goType):
+		checks := g.structChecks(v, s)
+		if len(checks) == 0 {
+			return "true"
+		}
+		return "(" + strings.Join(checks, " && ") + ")"
+	}
+
+	// Types substituted via x-go-type or coming from another package.
+	g.usesHelper = true
+	return fmt.Sprintf("isZeroValue(%s)", v)
+}
+
+func isNumericGoType(goType string) bool {
+	switch goType {
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64", "byte":
+		return true
+	}
+	return false
+}
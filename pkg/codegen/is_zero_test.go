@@ -0,0 +1,29 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsZeroCheck(t *testing.T) {
+	g := isZeroGenerator{
+		types: map[string]TypeDefinition{
+			"Pet":    {TypeName: "Pet", Schema: Schema{GoType: "struct {\n}"}},
+			"Status": {TypeName: "Status", Schema: Schema{GoType: "string"}},
+			"Pets":   {TypeName: "Pets", Schema: Schema{GoType: "[]Pet"}},
+		},
+		structs: map[string]bool{"Pet": true},
+	}
+
+	assert.Equal(t, "v.IsZero()", g.check("v", Schema{RefType: "Pet"}, 0))
+	assert.Equal(t, `v == ""`, g.check("v", Schema{RefType: "Status"}, 0))
+	assert.Equal(t, "len(v) == 0", g.check("v", Schema{RefType: "Pets"}, 0))
+	assert.Equal(t, "v == 0", g.check("v", Schema{GoType: "float32"}, 0))
+	assert.Equal(t, "!v", g.check("v", Schema{GoType: "bool"}, 0))
+	assert.Equal(t, "v == (openapi_types.UUID{})", g.check("v", Schema{GoType: "openapi_types.UUID"}, 0))
+	assert.False(t, g.usesHelper)
+
+	assert.Equal(t, "isZeroValue(v)", g.check("v", Schema{GoType: "decimal.Decimal"}, 0))
+	assert.True(t, g.usesHelper)
+}
@@ -0,0 +1,115 @@
+package codegen
+
+import (
+	"fmt"
+	"text/template"
+)
+
+// JSONCodecDefinition describes the JSON methods generated for a struct some
+// of whose properties have x-go-json-codec, or are collections normalized by
+// normalize-empty-collections.
+type JSONCodecDefinition struct {
+	TypeName string
+	// Marshaled are the properties whose codec has a marshal function, and
+	// the normalized collections, which are only omitted when nil.
+	Marshaled []Property
+	// Unmarshaled are the properties whose codec has an unmarshal function.
+	Unmarshaled []Property
+	// DisallowUnknownFields is set when the UnmarshalJSON method rejecting
+	// unknown fields is generated for the struct, which then hands over to
+	// unmarshalJSONCodecs rather than encoding/json.
+	DisallowUnknownFields bool
+}
+
+// generateJSONCodecBoilerplate generates the MarshalJSON and UnmarshalJSON
+// methods of the structs some of whose properties have x-go-json-codec,
+// routing them through their functions, or, with normalize-empty-collections,
+// are collections which encoding/json would omit when empty. Structs which
+// already encode themselves, to handle additional properties or unions, do so
+// in their own methods.
+func (g *generator) generateJSONCodecBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	if !g.usesJSONCodec && !g.options.OutputOptions.NormalizeEmptyCollections {
+		return "", nil
+	}
+
+	var definitions []JSONCodecDefinition
+
+	m := map[string]bool{}
+
+	for _, t := range typeDefs {
+		if found := m[t.TypeName]; found {
+			continue
+		}
+
+		m[t.TypeName] = true
+
+		s := t.Schema
+		if t.IsAlias() || s.IsRef() || !isStructType(s.GoType) ||
+			s.HasAdditionalProperties || len(s.UnionElements) != 0 {
+			continue
+		}
+		d := JSONCodecDefinition{
+			TypeName:              t.TypeName,
+			DisallowUnknownFields: g.disallowsUnknownFields(t),
+		}
+		for _, p := range s.Properties {
+			if (p.JSONCodec != nil && p.JSONCodec.Marshal != "") || g.normalizedCollection(p) {
+				d.Marshaled = append(d.Marshaled, p)
+			}
+			if p.JSONCodec != nil && p.JSONCodec.Unmarshal != "" {
+				d.Unmarshaled = append(d.Unmarshaled, p)
+			}
+		}
+		if len(d.Marshaled) != 0 || len(d.Unmarshaled) != 0 {
+			definitions = append(definitions, d)
+		}
+	}
+
+	context := struct {
+		Types         []JSONCodecDefinition
+		UsesJSONCodec bool
+	}{
+		Types:         definitions,
+		UsesJSONCodec: g.usesJSONCodec,
+	}
+
+	return GenerateTemplates([]string{"json-codec.tmpl"}, t, context)
+}
+
+// unmarshalsWithJSONCodecs reports whether some properties of s are decoded
+// with the unmarshal function of their x-go-json-codec.
+func unmarshalsWithJSONCodecs(s Schema) bool {
+	for _, p := range s.Properties {
+		if p.JSONCodec != nil && p.JSONCodec.Unmarshal != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// marshalProperty returns the call encoding the field of property p of the
+// struct v, through the marshal function of its x-go-json-codec if any.
+func marshalProperty(v string, p Property) string {
+	field := v + "." + p.GoFieldName()
+	if p.JSONCodec == nil || p.JSONCodec.Marshal == "" {
+		return fmt.Sprintf("json.Marshal(%s)", field)
+	}
+	if isPointerField(p) {
+		return fmt.Sprintf("marshalJSONCodec(%s, %s)", field, p.JSONCodec.Marshal)
+	}
+	return fmt.Sprintf("%s(%s)", p.JSONCodec.Marshal, field)
+}
+
+// unmarshalProperty returns the call decoding raw into the field of property
+// p of the struct v, through the unmarshal function of its x-go-json-codec if
+// any.
+func unmarshalProperty(raw, v string, p Property) string {
+	field := v + "." + p.GoFieldName()
+	if p.JSONCodec == nil || p.JSONCodec.Unmarshal == "" {
+		return fmt.Sprintf("json.Unmarshal(%s, &%s)", raw, field)
+	}
+	if isPointerField(p) {
+		return fmt.Sprintf("unmarshalJSONCodec(%s, &%s, %s)", raw, field, p.JSONCodec.Unmarshal)
+	}
+	return fmt.Sprintf("%s(%s, &%s)", p.JSONCodec.Unmarshal, raw, field)
+}
@@ -0,0 +1,312 @@
+package codegen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ManifestFileName is the name of the manifest describing the generated files
+// of a directory, which GenerateFiles returns when OutputOptions.Manifest is
+// set.
+const ManifestFileName = "gen-manifest.json"
+
+// Manifest describes how the generated files of a directory were produced and
+// what they contain, so that build tooling doesn't need to parse Go for it.
+type Manifest struct {
+	ToolVersion string         `json:"toolVersion"`
+	Files       []ManifestFile `json:"files"`
+}
+
+// ManifestFile describes one generated file.
+type ManifestFile struct {
+	Name   string       `json:"name"`
+	SHA256 string       `json:"sha256"`
+	Spec   ManifestSpec `json:"spec"`
+	// Configuration is the configuration the file was generated with, after
+	// defaults were applied, keyed like the configuration file.
+	Configuration map[string]interface{} `json:"configuration"`
+	Generate      []string               `json:"generate"`
+	Types         []string               `json:"types"`
+	Operations    []string               `json:"operations"`
+	// CompatBaseline is the configuration whose names compat-aliases
+	// declares, kept across generations until the naming options are set
+	// back to its own.
+	CompatBaseline map[string]interface{} `json:"compatBaseline,omitempty"`
+}
+
+// ManifestSpec identifies the spec a file was generated from.
+type ManifestSpec struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+}
+
+// newManifest describes the code generated from spec with cfg, written to the
+// file called name, with compatBaseline, if any, the configuration of the
+// names of compat-aliases.
+func (g *generator) newManifest(name string, code []byte, spec []byte, cfg Configuration, compatBaseline map[string]interface{}) ([]byte, error) {
+	configuration, err := manifestConfiguration(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error describing configuration: %w", err)
+	}
+
+	types, err := declaredTypes(code)
+	if err != nil {
+		return nil, fmt.Errorf("error listing types: %w", err)
+	}
+
+	generate := generateTargets(cfg.Generate)
+
+	// Models and the embedded spec are the only targets generating no code
+	// per operation.
+	operations := []string{}
+	for _, target := range generate {
+		if target != "models" && target != "embedded-spec" {
+			operations = append(operations, g.operationIDs...)
+			sort.Strings(operations)
+			break
+		}
+	}
+
+	_, version := toolVersion(cfg.NoVCSVersionOverride)
+	return marshalManifest(Manifest{
+		ToolVersion: version,
+		Files: []ManifestFile{{
+			Name:          name,
+			SHA256:        sha256Hex(code),
+			Spec:          ManifestSpec{Path: cfg.SpecLocation, SHA256: sha256Hex(spec)},
+			Configuration: configuration,
+			Generate:      generate,
+			Types:         types,
+			Operations:    operations,
+
+			CompatBaseline: compatBaseline,
+		}},
+	})
+}
+
+func marshalManifest(m Manifest) ([]byte, error) {
+	sort.Slice(m.Files, func(i, j int) bool {
+		return m.Files[i].Name < m.Files[j].Name
+	})
+	buf, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, '\n'), nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// manifestConfiguration returns cfg as it would be written in a configuration
+// file.
+func manifestConfiguration(cfg Configuration) (map[string]interface{}, error) {
+	buf, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var m map[interface{}]interface{}
+	if err := yaml.Unmarshal(buf, &m); err != nil {
+		return nil, err
+	}
+	return stringKeys(m).(map[string]interface{}), nil
+}
+
+// stringKeys converts the maps decoded from YAML into ones encoding/json can
+// marshal.
+func stringKeys(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = stringKeys(value)
+		}
+		return m
+	case []interface{}:
+		for i, value := range v {
+			v[i] = stringKeys(value)
+		}
+	}
+	return v
+}
+
+// generateTargets returns the names of the enabled generate options.
+func generateTargets(opts GenerateOptions) []string {
+	targets := []string{}
+	value := reflect.ValueOf(opts)
+	for i := 0; i < value.NumField(); i++ {
+		if value.Field(i).Bool() {
+			name, _, _ := strings.Cut(value.Type().Field(i).Tag.Get("yaml"), ",")
+			targets = append(targets, name)
+		}
+	}
+	sort.Strings(targets)
+	return targets
+}
+
+// declaredTypes returns the names of the types declared in code.
+func declaredTypes(code []byte) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", code, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	types := []string{}
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+			for _, spec := range gen.Specs {
+				types = append(types, spec.(*ast.TypeSpec).Name.Name)
+			}
+		}
+	}
+	sort.Strings(types)
+	return types, nil
+}
+
+// WriteFiles writes the files returned by GenerateFiles to dir. Each file is
+// written to a temporary file first and then renamed, the manifest last, so
+// that readers never see a partially written file or a manifest describing
+// files which weren't written yet. A manifest already present in dir keeps
+// describing the files other than the written ones, so that several outputs
+// can share a directory.
+func WriteFiles(dir string, files map[string][]byte) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		if name != ManifestFileName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		// Files such as the embedded spec package live in a subdirectory.
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755); err != nil {
+			return err
+		}
+		if err := writeFileAtomically(filepath.Join(dir, name), files[name]); err != nil {
+			return err
+		}
+	}
+
+	manifest, ok := files[ManifestFileName]
+	if !ok {
+		return nil
+	}
+	manifest, err := mergeManifest(filepath.Join(dir, ManifestFileName), manifest)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomically(filepath.Join(dir, ManifestFileName), manifest)
+}
+
+// mergeManifest adds the entries of the manifest found at path, for the files
+// not described by manifest, to it.
+func mergeManifest(path string, manifest []byte) ([]byte, error) {
+	existing, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var m, previous Manifest
+	if err := json.Unmarshal(manifest, &m); err != nil {
+		return nil, fmt.Errorf("error parsing manifest: %w", err)
+	}
+	if err := json.Unmarshal(existing, &previous); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	described := make(map[string]bool)
+	for _, f := range m.Files {
+		described[f.Name] = true
+	}
+	for _, f := range previous.Files {
+		if !described[f.Name] {
+			m.Files = append(m.Files, f)
+		}
+	}
+	return marshalManifest(m)
+}
+
+func writeFileAtomically(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// ReadManifest reads the manifest of the generated files of dir, returning
+// nil when there's none.
+func ReadManifest(dir string) (*Manifest, error) {
+	buf, err := os.ReadFile(filepath.Join(dir, ManifestFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(buf, &m); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", ManifestFileName, err)
+	}
+	return &m, nil
+}
+
+// VerifyManifest checks that the files described by the manifest in dir are
+// present and unchanged since they were generated.
+func VerifyManifest(dir string) error {
+	buf, err := os.ReadFile(filepath.Join(dir, ManifestFileName))
+	if err != nil {
+		return err
+	}
+	var m Manifest
+	if err := json.Unmarshal(buf, &m); err != nil {
+		return fmt.Errorf("error parsing %s: %w", ManifestFileName, err)
+	}
+
+	var problems []string
+	for _, f := range m.Files {
+		data, err := os.ReadFile(filepath.Join(dir, f.Name))
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		if sha256Hex(data) != f.SHA256 {
+			problems = append(problems, fmt.Sprintf("%s was modified after being generated", f.Name))
+		}
+	}
+	if len(problems) != 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
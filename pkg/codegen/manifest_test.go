@@ -0,0 +1,84 @@
+package codegen
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifest(t *testing.T) {
+	version := "v2.0.0"
+	cfg := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+			Client: true,
+		},
+		OutputOptions: OutputOptions{
+			Manifest: true,
+		},
+		ImportMapping: map[string]string{
+			"./models.yaml": "example.com/models",
+		},
+		SpecLocation:         "specs/api.yaml",
+		Loader:               mapLoader{"specs/models.yaml": generatorModels},
+		NoVCSVersionOverride: &version,
+		OutputFileName:       "client.gen.go",
+	}
+
+	files, _, err := GenerateFiles(context.Background(), []byte(generatorSpec), cfg)
+	require.NoError(t, err)
+	require.Contains(t, files, "client.gen.go")
+	require.Contains(t, files, ManifestFileName)
+
+	var manifest Manifest
+	require.NoError(t, json.Unmarshal(files[ManifestFileName], &manifest))
+	assert.Equal(t, "v2.0.0", manifest.ToolVersion)
+	require.Len(t, manifest.Files, 1)
+
+	file := manifest.Files[0]
+	assert.Equal(t, "client.gen.go", file.Name)
+	assert.Equal(t, sha256Hex(files["client.gen.go"]), file.SHA256)
+	assert.Equal(t, ManifestSpec{Path: "specs/api.yaml", SHA256: sha256Hex([]byte(generatorSpec))}, file.Spec)
+	assert.Equal(t, "api", file.Configuration["package"])
+	assert.Equal(t, []string{"client", "models"}, file.Generate)
+	assert.Contains(t, file.Types, "Client")
+	assert.Contains(t, file.Types, "GetThingParams")
+	assert.Equal(t, []string{"GetThing"}, file.Operations)
+
+	// Generating again gives the same manifest.
+	again, _, err := GenerateFiles(context.Background(), []byte(generatorSpec), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, string(files[ManifestFileName]), string(again[ManifestFileName]))
+
+	dir := t.TempDir()
+	require.NoError(t, WriteFiles(dir, files))
+	require.NoError(t, VerifyManifest(dir))
+
+	// Writing another output to the same directory keeps describing both.
+	cfg.Generate = GenerateOptions{Models: true}
+	cfg.OutputFileName = "models.gen.go"
+	models, _, err := GenerateFiles(context.Background(), []byte(generatorSpec), cfg)
+	require.NoError(t, err)
+	require.NoError(t, WriteFiles(dir, models))
+
+	buf, err := os.ReadFile(filepath.Join(dir, ManifestFileName))
+	require.NoError(t, err)
+	manifest = Manifest{}
+	require.NoError(t, json.Unmarshal(buf, &manifest))
+	require.Len(t, manifest.Files, 2)
+	assert.Equal(t, "client.gen.go", manifest.Files[0].Name)
+	assert.Equal(t, "models.gen.go", manifest.Files[1].Name)
+	assert.Empty(t, manifest.Files[1].Operations)
+	require.NoError(t, VerifyManifest(dir))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "client.gen.go"), []byte("package api\n"), 0o644))
+	err = VerifyManifest(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "client.gen.go was modified")
+}
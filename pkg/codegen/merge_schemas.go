@@ -3,6 +3,8 @@ package codegen
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -11,80 +13,446 @@ import (
 // MergeSchemas merges all the fields in the schemas supplied into one giant schema.
 // The idea is that we merge all fields together into one schema.
 func MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
+	return exportedGenerator().mergeAllOfSchemas(allOf, path)
+}
+
+// mergeAllOfSchemas does the work of MergeSchemas.
+func (g *generator) mergeAllOfSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
+	// The empty members add nothing to the merge, so that the allOf of a
+	// schema and empty ones is just that schema. Otherwise, they're merged
+	// along with the others, which keeps the indexes of the members in the
+	// errors those of the spec.
+	if members := nonEmptyMembers(allOf); len(members) == 1 {
+		allOf = members
+	}
 	// If someone asked for the old way, for backward compatibility, return the
 	// old style result.
-	if globalState.options.Compatibility.OldMergeSchemas {
-		return mergeSchemasV1(allOf, path)
+	if g.options.Compatibility.OldMergeSchemas {
+		return g.mergeSchemasV1(allOf, path)
 	}
-	return mergeSchemas(allOf, path)
+	return g.mergeSchemas(allOf, path)
 }
 
-func mergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
+func (g *generator) mergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 	n := len(allOf)
 
 	if n == 1 {
-		return GenerateGoSchema(allOf[0], path)
+		return g.generateGoSchema(allOf[0], path)
+	}
+
+	// An enum from an import-mapped document can only be annotated by the
+	// other allOf members, so refer to the mapped type instead of
+	// regenerating its constants locally.
+	if ref := externalEnumRef(allOf); ref != nil {
+		return g.generateGoSchema(ref, path)
 	}
 
-	schema, err := valueWithPropagatedRef(allOf[0])
+	if _, err := g.checkAllOf(allOf, path, "", g.componentAllOfChain(allOf, path), 1); err != nil {
+		return Schema{}, err
+	}
+
+	schema, err := valueWithPropagatedRef(allOf[0], allOfPath(path, 0))
 	if err != nil {
 		return Schema{}, err
 	}
 
 	for i := 1; i < n; i++ {
 		var err error
-		oneOfSchema, err := valueWithPropagatedRef(allOf[i])
+		oneOfSchema, err := valueWithPropagatedRef(allOf[i], allOfPath(path, i))
 		if err != nil {
 			return Schema{}, err
 		}
-		schema, err = mergeOpenapiSchemas(schema, oneOfSchema, true)
+		// Merging flattens the allOf of the members, so only the first one
+		// can still have its own when merged into.
+		schema, err = g.mergeOpenapiSchemasAt(schema, oneOfSchema, true, allOfMemberPath(path, 0), allOfMemberPath(path, i))
 		if err != nil {
-			return Schema{}, fmt.Errorf("error merging schemas for AllOf: %w", err)
+			return Schema{}, fmt.Errorf("error merging schemas for AllOf at %s: %w", allOfPath(path, i), err)
 		}
 	}
-	return GenerateGoSchema(openapi3.NewSchemaRef("", &schema), path)
+	if g.options.OutputOptions.AllOfDocStrategy == AllOfDocConcatenate {
+		concatenateDocs(&schema, "", allOf)
+	} else {
+		preferInlineDocs(&schema, allOf)
+	}
+	merged, err := g.generateGoSchema(openapi3.NewSchemaRef("", &schema), path)
+	if err != nil || !g.options.OutputOptions.PreferEmbeddedStructsForAllOf {
+		return merged, err
+	}
+	return g.embedAllOf(allOf, path, merged)
 }
 
-// valueWithPropagatedRef returns a copy of ref schema with its Properties refs
-// updated if ref itself is external. Otherwise, return ref.Value as-is.
-func valueWithPropagatedRef(ref *openapi3.SchemaRef) (openapi3.Schema, error) {
+// concatenateDocs gives schema, merged from allOf, the description joining
+// description, that of the schema having the allOf, if any, and those of the
+// members, in order, with blank lines, skipping the repeated ones, and the
+// first title of the members, for the concatenate allof-doc-strategy.
+func concatenateDocs(schema *openapi3.Schema, description string, allOf []*openapi3.SchemaRef) {
+	var descriptions []string
+	seen := make(map[string]bool)
+	for _, d := range append([]string{description}, memberDocs(allOf, func(s *openapi3.Schema) string { return s.Description })...) {
+		d = strings.TrimSpace(d)
+		if d != "" && !seen[d] {
+			seen[d] = true
+			descriptions = append(descriptions, d)
+		}
+	}
+	schema.Description = strings.Join(descriptions, "\n\n")
+
+	schema.Title = ""
+	for _, title := range memberDocs(allOf, func(s *openapi3.Schema) string { return s.Title }) {
+		if title != "" {
+			schema.Title = title
+			break
+		}
+	}
+}
+
+// memberDocs returns the doc, as returned by doc, of each allOf member.
+func memberDocs(allOf []*openapi3.SchemaRef, doc func(*openapi3.Schema) string) []string {
+	docs := make([]string, 0, len(allOf))
+	for _, member := range allOf {
+		if member != nil && member.Value != nil {
+			docs = append(docs, doc(member.Value))
+		}
+	}
+	return docs
+}
+
+// allOfPath returns the path of the i-th allOf member of the schema at path,
+// as it is given in the merge errors, eg, Order/allOf[2].
+func allOfPath(path []string, i int) string {
+	return strings.Join(allOfMemberPath(path, i), "/")
+}
+
+// allOfMemberPath returns the path of the i-th allOf member of the schema at
+// path.
+func allOfMemberPath(path []string, i int) []string {
+	return append(path[:len(path):len(path)], fmt.Sprintf("allOf[%d]", i))
+}
+
+// preferInlineDocs gives schema, merged from allOf, the title and description
+// of the last inline member having them, as the inline members are usually
+// what refines the referenced, more generic, ones into this specific type.
+// Otherwise, mergeOpenapiSchemas already picked those of the last member
+// having them.
+func preferInlineDocs(schema *openapi3.Schema, allOf []*openapi3.SchemaRef) {
+	var title, description string
+	for _, member := range allOf {
+		if member.Ref != "" || member.Value == nil {
+			continue
+		}
+		if member.Value.Title != "" {
+			title = member.Value.Title
+		}
+		if member.Value.Description != "" {
+			description = member.Value.Description
+		}
+	}
+	if title != "" {
+		schema.Title = title
+	}
+	if description != "" {
+		schema.Description = description
+	}
+}
+
+// externalEnumRef returns the allOf member referencing an enum in another
+// document, provided it is the only member which declares enum values.
+func externalEnumRef(allOf []*openapi3.SchemaRef) *openapi3.SchemaRef {
+	var found *openapi3.SchemaRef
+	for _, ref := range allOf {
+		if ref.Value == nil || len(ref.Value.Enum) == 0 {
+			continue
+		}
+		if found != nil || len(ref.Ref) == 0 || ref.Ref[0] == '#' {
+			return nil
+		}
+		found = ref
+	}
+	return found
+}
+
+// valueWithPropagatedRef returns a copy of ref schema with its refs resolved
+// against the document it comes from if ref itself is external. Otherwise,
+// return ref.Value as-is. path is that of ref, for the errors.
+func valueWithPropagatedRef(ref *openapi3.SchemaRef, path string) (openapi3.Schema, error) {
+	if ref.Value == nil {
+		return openapi3.Schema{}, fmt.Errorf("reference %q at %s could not be resolved", ref.Ref, path)
+	}
 	if len(ref.Ref) == 0 || ref.Ref[0] == '#' {
 		return *ref.Value, nil
 	}
 
 	pathParts := strings.Split(ref.Ref, "#")
 	if len(pathParts) < 1 || len(pathParts) > 2 {
-		return openapi3.Schema{}, fmt.Errorf("unsupported reference: %s", ref.Ref)
+		return openapi3.Schema{}, fmt.Errorf("unsupported reference %q at %s", ref.Ref, path)
 	}
 	remoteComponent := pathParts[0]
 
 	// remote ref
-	schema := *ref.Value
-	for _, value := range schema.Properties {
-		if len(value.Ref) > 0 && value.Ref[0] == '#' {
-			// local reference, should propagate remote
-			value.Ref = remoteComponent + value.Ref
+	return propagateRemoteRefs(*ref.Value, remoteComponent), nil
+}
+
+// propagateRemoteRefs rewrites the references found in the properties, items,
+// additional properties, union members and discriminator mapping of schema,
+// which are relative to the document remoteComponent, so that they resolve
+// to that document's types rather than to ours. Inline schemas are copied
+// rather than modified, since they belong to the loaded remote document.
+func propagateRemoteRefs(schema openapi3.Schema, remoteComponent string) openapi3.Schema {
+	propagate := func(ref *openapi3.SchemaRef) *openapi3.SchemaRef {
+		return propagateRemoteRef(ref, remoteComponent)
+	}
+	propagateAll := func(refs openapi3.SchemaRefs) openapi3.SchemaRefs {
+		if refs == nil {
+			return nil
 		}
+		result := make(openapi3.SchemaRefs, len(refs))
+		for i, ref := range refs {
+			result[i] = propagate(ref)
+		}
+		return result
 	}
 
-	return schema, nil
+	if schema.Properties != nil {
+		properties := make(openapi3.Schemas, len(schema.Properties))
+		for name, value := range schema.Properties {
+			properties[name] = propagate(value)
+		}
+		schema.Properties = properties
+	}
+	schema.Items = propagate(schema.Items)
+	schema.AdditionalProperties.Schema = propagate(schema.AdditionalProperties.Schema)
+	schema.OneOf = propagateAll(schema.OneOf)
+	schema.AnyOf = propagateAll(schema.AnyOf)
+	schema.AllOf = propagateAll(schema.AllOf)
+
+	if schema.Discriminator != nil && len(schema.Discriminator.Mapping) != 0 {
+		discriminator := *schema.Discriminator
+		discriminator.Mapping = make(map[string]string, len(schema.Discriminator.Mapping))
+		for value, ref := range schema.Discriminator.Mapping {
+			discriminator.Mapping[value] = resolveDocumentRef(remoteComponent, ref)
+		}
+		schema.Discriminator = &discriminator
+	}
+
+	return schema
+}
+
+// propagateRemoteRef returns ref, found in the document remoteComponent, with
+// its ref, or those of its inline schema, resolved against that document.
+func propagateRemoteRef(ref *openapi3.SchemaRef, remoteComponent string) *openapi3.SchemaRef {
+	if ref == nil {
+		return nil
+	}
+	if len(ref.Ref) > 0 {
+		// The refs are relative to the remote document, whether local to
+		// it or to another document next to it.
+		return &openapi3.SchemaRef{Ref: resolveDocumentRef(remoteComponent, ref.Ref), Value: ref.Value}
+	}
+	if ref.Value == nil {
+		return ref
+	}
+	value := propagateRemoteRefs(*ref.Value, remoteComponent)
+	return &openapi3.SchemaRef{Value: &value}
 }
 
-func mergeAllOf(allOf []*openapi3.SchemaRef) (openapi3.Schema, error) {
+// mergeAllOf merges the allOf members of the schema at path, which is
+// relative to the schema they are merged into when they're nested. The
+// merges are memoized by members, as the allOf of a base schema is flattened
+// again into each schema inheriting from it, at every level.
+func (g *generator) mergeAllOf(allOf []*openapi3.SchemaRef, path []string) (openapi3.Schema, error) {
+	key := allOfMembersKey(allOf)
+	if memo, found := g.allOfMerges[key]; found {
+		return memo.schema, nil
+	}
+	if _, err := g.checkAllOf(allOf, path, "", nil, 1); err != nil {
+		return openapi3.Schema{}, err
+	}
+
 	var schema openapi3.Schema
-	for _, schemaRef := range allOf {
-		var err error
-		schema, err = mergeOpenapiSchemas(schema, *schemaRef.Value, true)
+	for i, schemaRef := range allOf {
+		// A member of a remote schema may be a ref local to its document,
+		// which its own refs are relative to.
+		member, err := valueWithPropagatedRef(schemaRef, allOfPath(path, i))
+		if err != nil {
+			return openapi3.Schema{}, err
+		}
+		schema, err = g.mergeOpenapiSchemasAt(schema, member, true, nil, allOfMemberPath(path, i))
 		if err != nil {
-			return openapi3.Schema{}, fmt.Errorf("error merging schemas for AllOf: %w", err)
+			return openapi3.Schema{}, fmt.Errorf("error merging schemas for AllOf at %s: %w", allOfPath(path, i), err)
 		}
 	}
+
+	// The slices of the memoized schema are appended to by the merges using
+	// it, which mustn't write into each other's.
+	schema.Enum = schema.Enum[:len(schema.Enum):len(schema.Enum)]
+	schema.Required = schema.Required[:len(schema.Required):len(schema.Required)]
+	schema.OneOf = schema.OneOf[:len(schema.OneOf):len(schema.OneOf)]
+	schema.AnyOf = schema.AnyOf[:len(schema.AnyOf):len(schema.AnyOf)]
+	schema.AllOf = schema.AllOf[:len(schema.AllOf):len(schema.AllOf)]
+	if g.allOfMerges == nil {
+		g.allOfMerges = make(map[string]allOfMerge)
+	}
+	g.allOfMerges[key] = allOfMerge{members: allOf, schema: schema}
 	return schema, nil
 }
 
+// nonEmptyMembers returns the members of allOf which aren't empty inline
+// schemas, `{}`, which some generators emit.
+func nonEmptyMembers(allOf []*openapi3.SchemaRef) []*openapi3.SchemaRef {
+	members := make([]*openapi3.SchemaRef, 0, len(allOf))
+	for _, member := range allOf {
+		if !isEmptyMember(member) {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// isEmptyMember returns whether member is an inline schema without any
+// keyword, or extension.
+func isEmptyMember(member *openapi3.SchemaRef) bool {
+	if member == nil || member.Ref != "" || member.Value == nil {
+		return false
+	}
+	schema := *member.Value
+	if len(schema.Extensions) == 0 {
+		schema.Extensions = nil
+	}
+	return reflect.DeepEqual(schema, openapi3.Schema{})
+}
+
+// allOfMerge is a memoized merge of the allOf members, which it keeps so that
+// their addresses, which its key is made of, aren't reused.
+type allOfMerge struct {
+	members []*openapi3.SchemaRef
+	schema  openapi3.Schema
+}
+
+// allOfMembersKey returns the key of the merge of the allOf members, the same
+// for the allOf of a schema however many times it's merged.
+func allOfMembersKey(allOf []*openapi3.SchemaRef) string {
+	keys := make([]string, len(allOf))
+	for i, member := range allOf {
+		keys[i] = fmt.Sprintf("%p", member)
+	}
+	return strings.Join(keys, ",")
+}
+
+// checkAllOf returns an error if the allOf of the schema at path, or those of
+// its members transitively, nest deeper than allof-max-depth, depth being
+// the level of allOf, have more members than allof-max-width, or reference a
+// schema which is already being merged, chain, as flattening them would never
+// end. The refs are resolved against document, that of the schema holding
+// allOf, so that the same local ref in two documents isn't taken for a cycle.
+// A schema referenced by two members isn't a cycle, as only the refs of the
+// members being flattened are in chain. It returns the number of levels of
+// allOf, the heights of the referenced schemas being memoized, as the
+// schemas checked once are checked again whenever they're merged.
+func (g *generator) checkAllOf(allOf []*openapi3.SchemaRef, path []string, document string, chain []string, depth int) (int, error) {
+	maxDepth, maxWidth := g.allOfLimits()
+	if len(allOf) > maxWidth {
+		return 0, fmt.Errorf("allOf at %s has %d members, more than allof-max-width %d",
+			strings.Join(path, "/"), len(allOf), maxWidth)
+	}
+	if depth > maxDepth {
+		return 0, fmt.Errorf("allOf at %s is nested %d levels deep, more than allof-max-depth %d",
+			strings.Join(path, "/"), depth, maxDepth)
+	}
+
+	height := 1
+	for i, member := range allOf {
+		if member == nil || member.Value == nil {
+			continue
+		}
+		memberDocument, memberChain := document, chain
+		var ref string
+		if member.Ref != "" {
+			ref = member.Ref
+			if document != "" {
+				ref = resolveDocumentRef(document, ref)
+			}
+			memberChain = append(chain[:len(chain):len(chain)], ref)
+			for i, seen := range chain {
+				if seen == ref {
+					return 0, fmt.Errorf("circular allOf detected: %s", allOfCycleNames(memberChain[i:]))
+				}
+			}
+			// The schemas too deep from here are walked again, to name
+			// the allOf which is.
+			if memberHeight, found := g.allOfHeights[ref]; found && depth+memberHeight <= maxDepth {
+				if memberHeight+1 > height {
+					height = memberHeight + 1
+				}
+				continue
+			}
+			memberDocument, _, _ = strings.Cut(ref, "#")
+		}
+		var memberHeight int
+		if len(member.Value.AllOf) != 0 {
+			var err error
+			memberHeight, err = g.checkAllOf(member.Value.AllOf, allOfMemberPath(path, i), memberDocument, memberChain, depth+1)
+			if err != nil {
+				return 0, err
+			}
+		}
+		if ref != "" {
+			if g.allOfHeights == nil {
+				g.allOfHeights = make(map[string]int)
+			}
+			g.allOfHeights[ref] = memberHeight
+		}
+		if memberHeight+1 > height {
+			height = memberHeight + 1
+		}
+	}
+	return height, nil
+}
+
+// componentAllOfChain returns the chain checkAllOf starts from for the allOf
+// at path, the ref of the component schema holding it when path is one, so
+// that its cycles are named from it.
+func (g *generator) componentAllOfChain(allOf []*openapi3.SchemaRef, path []string) []string {
+	if len(path) != 1 || len(allOf) == 0 || g.spec == nil || g.spec.Components == nil {
+		return nil
+	}
+	component := g.spec.Components.Schemas[path[0]]
+	if component == nil || component.Value == nil || len(component.Value.AllOf) == 0 || component.Value.AllOf[0] != allOf[0] {
+		return nil
+	}
+	return []string{componentSchemaPrefix + path[0]}
+}
+
+// allOfLimits returns allof-max-depth and allof-max-width, or their defaults.
+func (g *generator) allOfLimits() (maxDepth, maxWidth int) {
+	maxDepth, maxWidth = DefaultAllOfMaxDepth, DefaultAllOfMaxWidth
+	if depth := g.options.OutputOptions.AllOfMaxDepth; depth > 0 {
+		maxDepth = depth
+	}
+	if width := g.options.OutputOptions.AllOfMaxWidth; width > 0 {
+		maxWidth = width
+	}
+	return maxDepth, maxWidth
+}
+
+// allOfCycleNames returns the names of the schemas referenced by refs, as
+// given in the circular allOf errors, eg, A -> B -> A.
+func allOfCycleNames(refs []string) string {
+	names := make([]string, len(refs))
+	for i, ref := range refs {
+		names[i] = ref[strings.LastIndex(ref, "/")+1:]
+	}
+	return strings.Join(names, " -> ")
+}
+
 // mergeOpenapiSchemas merges two openAPI schemas and returns the schema
 // all of whose fields are composed.
-func mergeOpenapiSchemas(s1, s2 openapi3.Schema, allOf bool) (openapi3.Schema, error) {
+func (g *generator) mergeOpenapiSchemas(s1, s2 openapi3.Schema, allOf bool) (openapi3.Schema, error) {
+	return g.mergeOpenapiSchemasAt(s1, s2, allOf, nil, nil)
+}
+
+// mergeOpenapiSchemasAt merges s1 and s2, found at path1 and path2, which
+// the errors of their own allOf members are given relative to.
+func (g *generator) mergeOpenapiSchemasAt(s1, s2 openapi3.Schema, allOf bool, path1, path2 []string) (openapi3.Schema, error) {
 	var result openapi3.Schema
 	if s1.Extensions != nil || s2.Extensions != nil {
 		result.Extensions = make(map[string]interface{})
@@ -94,101 +462,222 @@ func mergeOpenapiSchemas(s1, s2 openapi3.Schema, allOf bool) (openapi3.Schema, e
 			}
 		}
 		if s2.Extensions != nil {
-			for k, v := range s2.Extensions {
-				// TODO: Check for collisions
+			keys := make([]string, 0, len(s2.Extensions))
+			for k := range s2.Extensions {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				v := s2.Extensions[k]
+				existing, found := result.Extensions[k]
+				// Which member sets an extension mustn't depend on their
+				// order, so they have to agree on its value. The patterns
+				// merged so far are merged again below.
+				if found && k != extMergedPatterns && !reflect.DeepEqual(existing, v) {
+					return openapi3.Schema{}, fmt.Errorf("merging two schemas with different %s: %v vs %v", k, existing, v)
+				}
 				result.Extensions[k] = v
 			}
 		}
 	}
 
-	result.OneOf = append(s1.OneOf, s2.OneOf...)
-
 	// We are going to make AllOf transitive, so that merging an AllOf that
-	// contains AllOf's will result in a flat object.
+	// contains AllOf's will result in a flat object. The unions of the
+	// members, along with their discriminator, are generated alongside the
+	// merged properties, including those their own allOf brings.
 	var err error
+	unions := []openapi3.Schema{s1, s2}
 	if s1.AllOf != nil {
 		var merged openapi3.Schema
-		merged, err = mergeAllOf(s1.AllOf)
+		merged, err = g.mergeAllOf(s1.AllOf, path1)
 		if err != nil {
-			return openapi3.Schema{}, fmt.Errorf("error transitive merging AllOf on schema 1")
+			return openapi3.Schema{}, fmt.Errorf("error transitive merging AllOf on schema 1: %w", err)
 		}
 		s1 = merged
+		unions = append(unions, merged)
 	}
 	if s2.AllOf != nil {
 		var merged openapi3.Schema
-		merged, err = mergeAllOf(s2.AllOf)
+		merged, err = g.mergeAllOf(s2.AllOf, path2)
 		if err != nil {
-			return openapi3.Schema{}, fmt.Errorf("error transitive merging AllOf on schema 2")
+			return openapi3.Schema{}, fmt.Errorf("error transitive merging AllOf on schema 2: %w", err)
 		}
 		s2 = merged
+		unions = append(unions, merged)
+	}
+	for _, union := range unions {
+		if result.OneOf, err = mergeOneOf(result.OneOf, union.OneOf); err != nil {
+			return openapi3.Schema{}, err
+		}
+		result.AnyOf = append(result.AnyOf, union.AnyOf...)
+		d := unionDiscriminator(union)
+		if d != nil && result.Discriminator != nil && !reflect.DeepEqual(d, result.Discriminator) {
+			return openapi3.Schema{}, errors.New("merging two unions with different discriminators is not supported")
+		}
+		if result.Discriminator == nil {
+			result.Discriminator = d
+		}
 	}
 
 	result.AllOf = append(s1.AllOf, s2.AllOf...)
 
-	if s1.Type != "" && s2.Type != "" && s1.Type != s2.Type {
-		return openapi3.Schema{}, errors.New("can not merge incompatible types")
+	// The properties keep the order of their first declaration, which is
+	// taken before any is replaced below.
+	propertyOrder := append(g.orderedPropertyNames(&s1), g.orderedPropertyNames(&s2)...)
+
+	// An explicit type merges with the one inferred from the other schema.
+	t1, t2 := schemaType(&s1), schemaType(&s2)
+	if t1 != "" && t2 != "" && t1 != t2 {
+		return openapi3.Schema{}, fmt.Errorf("can not merge incompatible types %s vs %s", t1, t2)
+	}
+	result.Type = t1
+	if result.Type == "" {
+		result.Type = t2
+	}
+
+	// The items of arrays are those of the member having them, or else merge
+	// like a property both members declare, so that annotating referenced
+	// items keeps their type.
+	result.Items = s1.Items
+	if s1.Items == nil {
+		result.Items = s2.Items
+	} else if s2.Items != nil {
+		if result.Items, err = g.mergeProperty(s1.Items, s2.Items, allOf); err != nil {
+			return openapi3.Schema{}, fmt.Errorf("error merging items: %w", err)
+		}
 	}
-	result.Type = s1.Type
 
 	if s1.Format != s2.Format {
-		return openapi3.Schema{}, errors.New("can not merge incompatible formats")
+		return openapi3.Schema{}, fmt.Errorf("can not merge incompatible formats %q vs %q", s1.Format, s2.Format)
 	}
 	result.Format = s1.Format
 
-	// For Enums, do we union, or intersect? This is a bit vague. I choose
-	// to be more permissive and union.
-	result.Enum = append(s1.Enum, s2.Enum...)
+	intersect := allOf && g.options.Compatibility.AllOfMergeSemantics == AllOfMergeIntersect
+	// An explicit enum merge strategy takes precedence over the semantics.
+	intersectEnum := intersect
+	if allOf && g.options.OutputOptions.EnumMergeStrategy != "" {
+		intersectEnum = g.options.OutputOptions.EnumMergeStrategy == EnumMergeIntersect
+	}
 
-	// I don't know how to handle two different defaults.
-	if s1.Default != nil || s2.Default != nil {
-		return openapi3.Schema{}, errors.New("merging two sets of defaults is undefined")
+	// For Enums, do we union, or intersect? This is a bit vague. I choose
+	// to be more permissive and union, unless intersecting was asked for.
+	if intersectEnum {
+		result.Enum, err = intersectEnums(s1.Enum, s2.Enum)
+		if err != nil {
+			return openapi3.Schema{}, err
+		}
+	} else {
+		result.Enum = append(s1.Enum, s2.Enum...)
 	}
-	if s1.Default != nil {
-		result.Default = s1.Default
+
+	// Spec generators often repeat the default of a base schema in its
+	// refinements, which merges fine as long as they agree.
+	if s1.Default != nil && s2.Default != nil && !reflect.DeepEqual(s1.Default, s2.Default) {
+		return openapi3.Schema{}, errors.New("merging two different defaults is undefined")
 	}
-	if s2.Default != nil {
+	result.Default = s1.Default
+	if result.Default == nil {
 		result.Default = s2.Default
 	}
 
+	// A value must match the patterns of all the members, which the single
+	// pattern of the merged schema can't express when they differ, so the
+	// others are recorded alongside it.
+	if allOf {
+		setSchemaPatterns(&result, appendPatterns(schemaPatterns(&s1), schemaPatterns(&s2)...))
+	} else if s1.Pattern == s2.Pattern {
+		result.Pattern = s1.Pattern
+	}
+
+	// The later schema refines the earlier one, so its title and description
+	// win when it has them.
+	result.Title, result.Description = s1.Title, s1.Description
+	if s2.Title != "" {
+		result.Title = s2.Title
+	}
+	if s2.Description != "" {
+		result.Description = s2.Description
+	}
+
 	// We skip Example
 	// We skip ExternalDocs
 
-	// If two schemas disagree on any of these flags, we error out.
-	if s1.UniqueItems != s2.UniqueItems {
-		return openapi3.Schema{}, errors.New("merging two schemas with different UniqueItems")
+	if allOf {
+		// Every allOf member has to hold, so keep the tightest bounds, as
+		// long as some value still satisfies them.
+		if err := intersectBounds(&result, s1, s2); err != nil {
+			return openapi3.Schema{}, err
+		}
+	} else {
+		// If two schemas disagree on any of these flags, we error out.
+		if s1.ExclusiveMin != s2.ExclusiveMin {
+			return openapi3.Schema{}, errors.New("merging two schemas with different ExclusiveMin")
 
-	}
-	result.UniqueItems = s1.UniqueItems
+		}
+		result.ExclusiveMin = s1.ExclusiveMin
 
-	if s1.ExclusiveMin != s2.ExclusiveMin {
-		return openapi3.Schema{}, errors.New("merging two schemas with different ExclusiveMin")
+		if s1.ExclusiveMax != s2.ExclusiveMax {
+			return openapi3.Schema{}, errors.New("merging two schemas with different ExclusiveMax")
 
+		}
+		result.ExclusiveMax = s1.ExclusiveMax
 	}
-	result.ExclusiveMin = s1.ExclusiveMin
 
-	if s1.ExclusiveMax != s2.ExclusiveMax {
-		return openapi3.Schema{}, errors.New("merging two schemas with different ExclusiveMax")
+	if intersect {
+		result.UniqueItems = s1.UniqueItems || s2.UniqueItems
+	} else {
+		if s1.UniqueItems != s2.UniqueItems {
+			return openapi3.Schema{}, errors.New("merging two schemas with different UniqueItems")
 
+		}
+		result.UniqueItems = s1.UniqueItems
 	}
-	result.ExclusiveMax = s1.ExclusiveMax
-
-	if s1.Nullable != s2.Nullable {
-		return openapi3.Schema{}, errors.New("merging two schemas with different Nullable")
 
+	if len(s1.Enum) != 0 && len(s2.Enum) != 0 {
+		// An enum with a null member is nullable, so that the union of two
+		// enums is nullable when either one is, and their intersection when
+		// both are.
+		if intersectEnum {
+			result.Nullable = g.isNullableEnum(&s1) && g.isNullableEnum(&s2)
+		} else {
+			result.Nullable = g.isNullableEnum(&s1) || g.isNullableEnum(&s2)
+		}
+	} else if s1.Nullable != s2.Nullable {
+		if result.Nullable, err = g.resolveAllOfFlag("Nullable", false, allOf); err != nil {
+			return openapi3.Schema{}, err
+		}
+	} else {
+		result.Nullable = s1.Nullable
 	}
-	result.Nullable = s1.Nullable
 
+	result.ReadOnly = s1.ReadOnly
 	if s1.ReadOnly != s2.ReadOnly {
-		return openapi3.Schema{}, errors.New("merging two schemas with different ReadOnly")
-
+		// An object which is read-only as a whole merges with one which
+		// isn't by having each of its properties read-only instead, unless
+		// the loosest resolution drops it.
+		readOnly, other := &s1, &s2
+		if s2.ReadOnly {
+			readOnly, other = &s2, &s1
+		}
+		if allOf && schemaType(readOnly) == "object" && g.options.Compatibility.AllOfNullableResolution != AllOfNullableLoosest {
+			if err := pushDownReadOnly(readOnly, other); err != nil {
+				return openapi3.Schema{}, err
+			}
+			result.ReadOnly = false
+		} else if result.ReadOnly, err = g.resolveAllOfFlag("ReadOnly", true, allOf); err != nil {
+			return openapi3.Schema{}, err
+		}
 	}
-	result.ReadOnly = s1.ReadOnly
 
+	result.WriteOnly = s1.WriteOnly
 	if s1.WriteOnly != s2.WriteOnly {
-		return openapi3.Schema{}, errors.New("merging two schemas with different WriteOnly")
-
+		if result.WriteOnly, err = g.resolveAllOfFlag("WriteOnly", true, allOf); err != nil {
+			return openapi3.Schema{}, err
+		}
+	}
+	if (s1.ReadOnly != s2.ReadOnly || s1.WriteOnly != s2.WriteOnly) && result.ReadOnly && result.WriteOnly {
+		return openapi3.Schema{}, errors.New("merging a read-only schema with a write-only one")
 	}
-	result.WriteOnly = s1.WriteOnly
 
 	if s1.AllowEmptyValue != s2.AllowEmptyValue {
 		return openapi3.Schema{}, errors.New("merging two schemas with different AllowEmptyValue")
@@ -196,24 +685,43 @@ func mergeOpenapiSchemas(s1, s2 openapi3.Schema, allOf bool) (openapi3.Schema, e
 	}
 	result.AllowEmptyValue = s1.AllowEmptyValue
 
-	// Required. We merge these.
-	result.Required = append(s1.Required, s2.Required...)
+	// Required. We merge these, each property once, in the order they're
+	// first required.
+	for _, name := range append(s1.Required[:len(s1.Required):len(s1.Required)], s2.Required...) {
+		if !StringInArray(name, result.Required) {
+			result.Required = append(result.Required, name)
+		}
+	}
 
 	// We merge all properties
 	result.Properties = make(map[string]*openapi3.SchemaRef)
 	for k, v := range s1.Properties {
 		result.Properties[k] = v
 	}
-	for k, v := range s2.Properties {
-		// TODO: detect conflicts
+	for _, k := range SortedSchemaKeys(s2.Properties) {
+		v := s2.Properties[k]
+		if overridden, found := result.Properties[k]; found {
+			if v, err = g.mergeProperty(overridden, v, allOf); err != nil {
+				return openapi3.Schema{}, fmt.Errorf("error merging property '%s': %w", k, err)
+			}
+		}
 		result.Properties[k] = v
 	}
+	if g.options.OutputOptions.PropertyOrder == PropertyOrderDeclared {
+		g.setPropertyOrder(result.Properties, propertyOrder)
+	}
 
 	if isAdditionalPropertiesExplicitFalse(&s1) || isAdditionalPropertiesExplicitFalse(&s2) {
 		result.WithoutAdditionalProperties()
 	} else if s1.AdditionalProperties.Schema != nil {
 		if s2.AdditionalProperties.Schema != nil {
-			return openapi3.Schema{}, errors.New("merging two schemas with additional properties, this is unhandled")
+			// Each additional property has to satisfy both schemas, which are
+			// merged like those of a property both declare.
+			merged, err := g.mergeProperty(s1.AdditionalProperties.Schema, s2.AdditionalProperties.Schema, allOf)
+			if err != nil {
+				return openapi3.Schema{}, fmt.Errorf("error merging additional properties: %w", err)
+			}
+			result.AdditionalProperties.Schema = merged
 		} else {
 			result.AdditionalProperties.Schema = s1.AdditionalProperties.Schema
 		}
@@ -234,3 +742,331 @@ func mergeOpenapiSchemas(s1, s2 openapi3.Schema, allOf bool) (openapi3.Schema, e
 
 	return result, nil
 }
+
+// extMergedPatterns records, in the extensions of a schema merged from
+// allOf, all the distinct patterns of its members when there are several.
+const extMergedPatterns = "x-oapi-codegen-merged-patterns"
+
+// schemaPatterns returns the patterns a string of schema must all match.
+func schemaPatterns(s *openapi3.Schema) []string {
+	if patterns, ok := s.Extensions[extMergedPatterns].([]string); ok {
+		return patterns
+	}
+	if s.Pattern == "" {
+		return nil
+	}
+	return []string{s.Pattern}
+}
+
+// setSchemaPatterns sets the patterns of schema, the first one as its
+// pattern, and all of them in its extensions when there are several.
+func setSchemaPatterns(s *openapi3.Schema, patterns []string) {
+	delete(s.Extensions, extMergedPatterns)
+	s.Pattern = ""
+	if len(patterns) == 0 {
+		return
+	}
+	s.Pattern = patterns[0]
+	if len(patterns) > 1 {
+		if s.Extensions == nil {
+			s.Extensions = make(map[string]interface{})
+		}
+		s.Extensions[extMergedPatterns] = patterns
+	}
+}
+
+// appendPatterns appends to patterns those of others it doesn't have yet.
+func appendPatterns(patterns []string, others ...string) []string {
+	result := append([]string{}, patterns...)
+	for _, pattern := range others {
+		if !StringInArray(pattern, result) {
+			result = append(result, pattern)
+		}
+	}
+	return result
+}
+
+// mergeOneOf merges the oneOf of a schema with the one merged so far. The
+// merged schema holds a single union, so both having one is ambiguous, and an
+// error, unless it's the same union, eg, reached through two members sharing
+// an allOf member.
+func mergeOneOf(merged, oneOf openapi3.SchemaRefs) (openapi3.SchemaRefs, error) {
+	if oneOf == nil || sameSchemaRefs(merged, oneOf) {
+		return merged, nil
+	}
+	if merged != nil {
+		return nil, errors.New("merging two schemas which both have a oneOf is ambiguous, as the merged type holds a single union; list their members in a single oneOf instead")
+	}
+	return oneOf, nil
+}
+
+// sameSchemaRefs reports whether refs1 and refs2 are the same schemas, in the
+// same order, either the same references or the same inline schemas.
+func sameSchemaRefs(refs1, refs2 openapi3.SchemaRefs) bool {
+	if len(refs1) != len(refs2) {
+		return false
+	}
+	for i := range refs1 {
+		if refs1[i] == refs2[i] {
+			continue
+		}
+		if refs1[i] == nil || refs2[i] == nil {
+			return false
+		}
+		if refs1[i].Ref != "" || refs2[i].Ref != "" {
+			if refs1[i].Ref != refs2[i].Ref {
+				return false
+			}
+			continue
+		}
+		if refs1[i].Value != refs2[i].Value {
+			return false
+		}
+	}
+	return true
+}
+
+// unionDiscriminator returns the discriminator of the union of schema, if
+// any. A discriminator without a union, eg, on the base schema of the members
+// of a union, doesn't apply to the schemas merging it.
+func unionDiscriminator(s openapi3.Schema) *openapi3.Discriminator {
+	if s.OneOf == nil && s.AnyOf == nil {
+		return nil
+	}
+	return s.Discriminator
+}
+
+// mergeProperty merges property with overridden, the property of the same
+// name of the schema merged so far. A reference stays one when the other
+// property is the same reference, or an inline schema merely annotating it;
+// otherwise, both are merged into an inline schema.
+func (g *generator) mergeProperty(overridden, property *openapi3.SchemaRef, allOf bool) (*openapi3.SchemaRef, error) {
+	if property == nil || property.Value == nil || overridden == nil || overridden.Value == nil {
+		return withFallbackDescription(property, overridden), nil
+	}
+	s1, s2, err := g.refineProperties(*overridden.Value, *property.Value, allOf)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case property.Ref == overridden.Ref && (property.Ref != "" || reflect.DeepEqual(s1, s2)):
+		return withFallbackDescription(withValue(property, s2), overridden), nil
+	case property.Ref == "" && overridden.Ref != "" && isAnnotation(property.Value):
+		if s2.Description != "" {
+			s1.Description = s2.Description
+		}
+		return withValue(overridden, s1), nil
+	case overridden.Ref == "" && property.Ref != "" && isAnnotation(overridden.Value):
+		return withFallbackDescription(withValue(property, s2), overridden), nil
+	}
+
+	merged, err := g.mergeOpenapiSchemas(s1, s2, allOf)
+	if err != nil {
+		return nil, err
+	}
+	return openapi3.NewSchemaRef("", &merged), nil
+}
+
+// refineProperties returns s1 and s2, two schemas of the same property, with
+// the flags either sets, and the format only one has, set on both, since a
+// property refining another one with them doesn't conflict with it. The
+// allof-nullable-resolution of allOf merges keeps the flags both set instead
+// when it has them allow less, or more.
+func (g *generator) refineProperties(s1, s2 openapi3.Schema, allOf bool) (openapi3.Schema, openapi3.Schema, error) {
+	if s1.Format == "" {
+		s1.Format = s2.Format
+	} else if s2.Format == "" {
+		s2.Format = s1.Format
+	}
+	resolution := g.options.Compatibility.AllOfNullableResolution
+	if allOf && resolution == AllOfNullableLoosest {
+		s1.ReadOnly = s1.ReadOnly && s2.ReadOnly
+		s1.WriteOnly = s1.WriteOnly && s2.WriteOnly
+	} else {
+		s1.ReadOnly = s1.ReadOnly || s2.ReadOnly
+		s1.WriteOnly = s1.WriteOnly || s2.WriteOnly
+	}
+	if allOf && resolution == AllOfNullableStrictest {
+		s1.Nullable = s1.Nullable && s2.Nullable
+	} else {
+		s1.Nullable = s1.Nullable || s2.Nullable
+	}
+	s2.ReadOnly, s2.WriteOnly, s2.Nullable = s1.ReadOnly, s1.WriteOnly, s1.Nullable
+	if s1.ReadOnly && s1.WriteOnly {
+		return s1, s2, errors.New("merging a read-only property with a write-only one")
+	}
+	return s1, s2, nil
+}
+
+// resolveAllOfFlag returns the value of flag, nullable, readOnly or
+// writeOnly, of two schemas which disagree on it, by the
+// allof-nullable-resolution of allOf merges, strict being the value allowing
+// the least. The other merges, and the default resolution, fail.
+func (g *generator) resolveAllOfFlag(flag string, strict, allOf bool) (bool, error) {
+	if allOf {
+		switch g.options.Compatibility.AllOfNullableResolution {
+		case AllOfNullableStrictest:
+			return strict, nil
+		case AllOfNullableLoosest:
+			return !strict, nil
+		}
+	}
+	return false, fmt.Errorf("merging two schemas with different %s", flag)
+}
+
+// isAnnotation returns whether schema only annotates another one, with
+// documentation and flags, having neither a constraint nor a property of its
+// own.
+func isAnnotation(schema *openapi3.Schema) bool {
+	s := *schema
+	s.ReadOnly, s.WriteOnly, s.Nullable = false, false, false
+	return s.IsEmpty() && len(s.Properties) == 0 && len(s.Extensions) == 0
+}
+
+// withValue returns ref with value, which is ref's own value when they're
+// equal, keeping its reference.
+func withValue(ref *openapi3.SchemaRef, value openapi3.Schema) *openapi3.SchemaRef {
+	if reflect.DeepEqual(*ref.Value, value) {
+		return ref
+	}
+	return &openapi3.SchemaRef{Ref: ref.Ref, Value: &value}
+}
+
+// withFallbackDescription returns property, which overrides the property of
+// the same name of another schema, with the description of overridden when it
+// has none of its own.
+func withFallbackDescription(property, overridden *openapi3.SchemaRef) *openapi3.SchemaRef {
+	if property == nil || property.Value == nil || property.Value.Description != "" ||
+		overridden == nil || overridden.Value == nil || overridden.Value.Description == "" {
+		return property
+	}
+	value := *property.Value
+	value.Description = overridden.Value.Description
+	return &openapi3.SchemaRef{Ref: property.Ref, Value: &value}
+}
+
+// pushDownReadOnly marks the properties of the object readOnly, which is
+// read-only as a whole, read-only rather than it, along with those of other
+// which readOnly has too, since they are the same properties once merged. A
+// property which is write-only in either of them can't be read-only though.
+func pushDownReadOnly(readOnly, other *openapi3.Schema) error {
+	properties := make(openapi3.Schemas, len(readOnly.Properties))
+	otherProperties := make(openapi3.Schemas, len(other.Properties))
+	for name, property := range other.Properties {
+		otherProperties[name] = property
+	}
+	for name, property := range readOnly.Properties {
+		overridden, found := other.Properties[name]
+		if isWriteOnly(property) || (found && isWriteOnly(overridden)) {
+			return fmt.Errorf("merging a read-only schema with one whose property '%s' is write-only", name)
+		}
+		properties[name] = withReadOnly(property)
+		if found {
+			otherProperties[name] = withReadOnly(overridden)
+		}
+	}
+	readOnly.Properties, readOnly.ReadOnly = properties, false
+	if other.Properties != nil {
+		other.Properties = otherProperties
+	}
+	return nil
+}
+
+// isWriteOnly returns whether property is write-only.
+func isWriteOnly(property *openapi3.SchemaRef) bool {
+	return property != nil && property.Value != nil && property.Value.WriteOnly
+}
+
+// withReadOnly returns property, made read-only, keeping its reference.
+func withReadOnly(property *openapi3.SchemaRef) *openapi3.SchemaRef {
+	if property == nil || property.Value == nil || property.Value.ReadOnly {
+		return property
+	}
+	value := *property.Value
+	value.ReadOnly = true
+	return &openapi3.SchemaRef{Ref: property.Ref, Value: &value}
+}
+
+// intersectEnums returns the values allowed by both enums, an enum left empty
+// allowing any value.
+func intersectEnums(e1, e2 []interface{}) ([]interface{}, error) {
+	if len(e1) == 0 {
+		return e2, nil
+	}
+	if len(e2) == 0 {
+		return e1, nil
+	}
+	var result []interface{}
+	for _, v1 := range e1 {
+		for _, v2 := range e2 {
+			if reflect.DeepEqual(v1, v2) {
+				result = append(result, v1)
+				break
+			}
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("merging two schemas with enums %v and %v, which have no value in common", e1, e2)
+	}
+	return result, nil
+}
+
+// intersectBounds sets the numeric, length, item and property count bounds
+// of result to the tightest of those of s1 and s2, which is an error when no
+// value satisfies them.
+func intersectBounds(result *openapi3.Schema, s1, s2 openapi3.Schema) error {
+	result.Min, result.ExclusiveMin = s1.Min, s1.ExclusiveMin
+	if s2.Min != nil {
+		switch {
+		case result.Min == nil || *s2.Min > *result.Min:
+			result.Min, result.ExclusiveMin = s2.Min, s2.ExclusiveMin
+		case *s2.Min == *result.Min:
+			result.ExclusiveMin = result.ExclusiveMin || s2.ExclusiveMin
+		}
+	}
+
+	result.Max, result.ExclusiveMax = s1.Max, s1.ExclusiveMax
+	if s2.Max != nil {
+		switch {
+		case result.Max == nil || *s2.Max < *result.Max:
+			result.Max, result.ExclusiveMax = s2.Max, s2.ExclusiveMax
+		case *s2.Max == *result.Max:
+			result.ExclusiveMax = result.ExclusiveMax || s2.ExclusiveMax
+		}
+	}
+
+	if result.Min != nil && result.Max != nil &&
+		(*result.Min > *result.Max || (*result.Min == *result.Max && (result.ExclusiveMin || result.ExclusiveMax))) {
+		return fmt.Errorf("merging two schemas whose bounds contradict each other: minimum %v, maximum %v", *result.Min, *result.Max)
+	}
+
+	var err error
+	if result.MinLength, result.MaxLength, err = intersectCounts("length", s1.MinLength, s1.MaxLength, s2.MinLength, s2.MaxLength); err != nil {
+		return err
+	}
+	if result.MinItems, result.MaxItems, err = intersectCounts("items", s1.MinItems, s1.MaxItems, s2.MinItems, s2.MaxItems); err != nil {
+		return err
+	}
+	if result.MinProps, result.MaxProps, err = intersectCounts("properties", s1.MinProps, s1.MaxProps, s2.MinProps, s2.MaxProps); err != nil {
+		return err
+	}
+	return nil
+}
+
+// intersectCounts returns the larger of the minimums min1 and min2, and the
+// smaller of the maximums max1 and max2, of the lengths, items or properties,
+// as given by what, which is an error when the minimum exceeds the maximum.
+func intersectCounts(what string, min1 uint64, max1 *uint64, min2 uint64, max2 *uint64) (uint64, *uint64, error) {
+	min, max := min1, max1
+	if min2 > min {
+		min = min2
+	}
+	if max2 != nil && (max == nil || *max2 < *max) {
+		max = max2
+	}
+	if max != nil && min > *max {
+		return 0, nil, fmt.Errorf("merging two schemas whose bounds contradict each other: minimum %s %d, maximum %s %d", what, min, what, *max)
+	}
+	return min, max, nil
+}
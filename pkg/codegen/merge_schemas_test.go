@@ -0,0 +1,1490 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/v2/pkg/util"
+)
+
+func TestMergeOpenapiSchemasIntersect(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	g.options.Compatibility.AllOfMergeSemantics = AllOfMergeIntersect
+
+	s1 := openapi3.Schema{
+		Type:      "string",
+		Enum:      []interface{}{"a", "b", "c"},
+		Min:       openapi3.Float64Ptr(1),
+		Max:       openapi3.Float64Ptr(10),
+		MinLength: 2,
+		MaxLength: openapi3.Uint64Ptr(20),
+		Required:  []string{"x", "y"},
+	}
+	s2 := openapi3.Schema{
+		Enum:         []interface{}{"c", "b", "d"},
+		Min:          openapi3.Float64Ptr(1),
+		ExclusiveMin: true,
+		Max:          openapi3.Float64Ptr(5),
+		MinLength:    1,
+		MaxLength:    openapi3.Uint64Ptr(8),
+		UniqueItems:  true,
+		Required:     []string{"y", "z"},
+	}
+
+	merged, err := g.mergeOpenapiSchemas(s1, s2, true)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"b", "c"}, merged.Enum)
+	assert.Equal(t, 1.0, *merged.Min)
+	assert.True(t, merged.ExclusiveMin)
+	assert.Equal(t, 5.0, *merged.Max)
+	assert.EqualValues(t, 2, merged.MinLength)
+	assert.EqualValues(t, 8, *merged.MaxLength)
+	assert.True(t, merged.UniqueItems)
+	assert.Equal(t, []string{"x", "y", "z"}, merged.Required)
+
+	_, err = g.mergeOpenapiSchemas(s1, openapi3.Schema{Enum: []interface{}{"d"}}, true)
+	assert.Error(t, err)
+}
+
+func TestMergeOpenapiSchemasEnumIntersect(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	g.options.OutputOptions.EnumMergeStrategy = EnumMergeIntersect
+
+	s1 := openapi3.Schema{Type: "string", Enum: []interface{}{"a", "b", "c", nil}, Nullable: true}
+	s2 := openapi3.Schema{Enum: []interface{}{"b", "a"}}
+
+	merged, err := g.mergeOpenapiSchemas(s1, s2, true)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"a", "b"}, merged.Enum)
+	assert.False(t, merged.Nullable)
+
+	// The other constraints are still merged as unions.
+	_, err = g.mergeOpenapiSchemas(s1, openapi3.Schema{UniqueItems: true}, true)
+	assert.EqualError(t, err, "merging two schemas with different UniqueItems")
+
+	_, err = g.mergeOpenapiSchemas(s1, openapi3.Schema{Enum: []interface{}{"d"}}, true)
+	assert.EqualError(t, err, "merging two schemas with enums [a b c <nil>] and [d], which have no value in common")
+}
+
+func TestMergeOpenapiSchemasEnumStrategyPrecedence(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	g.options.Compatibility.AllOfMergeSemantics = AllOfMergeIntersect
+	g.options.OutputOptions.EnumMergeStrategy = EnumMergeUnion
+
+	s1 := openapi3.Schema{Type: "string", Enum: []interface{}{"a", "b"}}
+	s2 := openapi3.Schema{Enum: []interface{}{"c"}, UniqueItems: true}
+
+	// The enums are unioned, the other constraints still intersected.
+	merged, err := g.mergeOpenapiSchemas(s1, s2, true)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"a", "b", "c"}, merged.Enum)
+	assert.True(t, merged.UniqueItems)
+}
+
+func TestMergeOpenapiSchemasUnion(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	s1 := openapi3.Schema{Type: "string", Enum: []interface{}{"a", "b"}}
+	s2 := openapi3.Schema{Enum: []interface{}{"c"}}
+
+	merged, err := g.mergeOpenapiSchemas(s1, s2, true)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"a", "b", "c"}, merged.Enum)
+
+	_, err = g.mergeOpenapiSchemas(s1, openapi3.Schema{UniqueItems: true}, true)
+	assert.Error(t, err)
+}
+
+func TestMergeOpenapiSchemasBounds(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	s1 := openapi3.Schema{
+		Type:     "integer",
+		Min:      openapi3.Float64Ptr(0),
+		MinItems: 1,
+		MaxItems: openapi3.Uint64Ptr(10),
+		MaxProps: openapi3.Uint64Ptr(4),
+	}
+	s2 := openapi3.Schema{
+		Max:          openapi3.Float64Ptr(10),
+		ExclusiveMax: true,
+		MinLength:    3,
+		MaxItems:     openapi3.Uint64Ptr(5),
+		MinProps:     2,
+	}
+
+	for _, merge := range []struct{ s1, s2 openapi3.Schema }{{s1, s2}, {s2, s1}} {
+		merged, err := g.mergeOpenapiSchemas(merge.s1, merge.s2, true)
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, *merged.Min)
+		assert.False(t, merged.ExclusiveMin)
+		assert.Equal(t, 10.0, *merged.Max)
+		assert.True(t, merged.ExclusiveMax)
+		assert.EqualValues(t, 3, merged.MinLength)
+		assert.Nil(t, merged.MaxLength)
+		assert.EqualValues(t, 1, merged.MinItems)
+		assert.EqualValues(t, 5, *merged.MaxItems)
+		assert.EqualValues(t, 2, merged.MinProps)
+		assert.EqualValues(t, 4, *merged.MaxProps)
+	}
+
+	// Equal bounds are tightened by either side being exclusive.
+	merged, err := g.mergeOpenapiSchemas(
+		openapi3.Schema{Min: openapi3.Float64Ptr(1), ExclusiveMin: true},
+		openapi3.Schema{Min: openapi3.Float64Ptr(1)}, true)
+	require.NoError(t, err)
+	assert.True(t, merged.ExclusiveMin)
+
+	tests := []struct {
+		name string
+		s2   openapi3.Schema
+		err  string
+	}{
+		{
+			name: "minimum above maximum",
+			s2:   openapi3.Schema{Min: openapi3.Float64Ptr(12)},
+			err:  "merging two schemas whose bounds contradict each other: minimum 12, maximum 10",
+		},
+		{
+			name: "exclusive maximum equal to minimum",
+			s2:   openapi3.Schema{Min: openapi3.Float64Ptr(10)},
+			err:  "merging two schemas whose bounds contradict each other: minimum 10, maximum 10",
+		},
+		{
+			name: "minimum items above maximum items",
+			s2:   openapi3.Schema{MinItems: 6},
+			err:  "merging two schemas whose bounds contradict each other: minimum items 6, maximum items 5",
+		},
+		{
+			name: "minimum length above maximum length",
+			s2:   openapi3.Schema{MaxLength: openapi3.Uint64Ptr(2)},
+			err:  "merging two schemas whose bounds contradict each other: minimum length 3, maximum length 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, err := g.mergeOpenapiSchemas(s1, s2, true)
+			require.NoError(t, err)
+			_, err = g.mergeOpenapiSchemas(base, tt.s2, true)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestMergeOpenapiSchemasDocs(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	base := openapi3.NewObjectSchema().
+		WithProperty("id", &openapi3.Schema{Type: "string", Description: "Base id"}).
+		WithProperty("name", &openapi3.Schema{Type: "string", Description: "Base name"})
+	base.Title, base.Description = "Base", "Generic base"
+	refinement := openapi3.NewObjectSchema().
+		WithProperty("id", &openapi3.Schema{Type: "string", Description: "Refined id"}).
+		WithProperty("name", &openapi3.Schema{Type: "string"})
+	refinement.Description = "Refined"
+
+	merged, err := g.mergeOpenapiSchemas(*base, *refinement, true)
+	require.NoError(t, err)
+	assert.Equal(t, "Base", merged.Title)
+	assert.Equal(t, "Refined", merged.Description)
+	assert.Equal(t, "Refined id", merged.Properties["id"].Value.Description)
+	// The overriding property has no description, so it keeps the one it
+	// overrides, without touching the schema it comes from.
+	assert.Equal(t, "Base name", merged.Properties["name"].Value.Description)
+	assert.Empty(t, refinement.Properties["name"].Value.Description)
+}
+
+func TestAllOfDocComments(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: allOf doc comments
+paths: {}
+components:
+  schemas:
+    Base:
+      type: object
+      description: Generic base
+      properties:
+        id:
+          type: string
+          description: Base id
+        name:
+          type: string
+          description: Base name
+    Mixin:
+      type: object
+      description: Mixin
+      properties:
+        color:
+          type: string
+    Refined:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          description: Refined by the inline member
+          properties:
+            id:
+              type: string
+              description: Refined id
+            name:
+              type: string
+    RefinedFirst:
+      allOf:
+        - type: object
+          description: Refined by the first, inline, member
+          properties:
+            size:
+              type: integer
+        - $ref: '#/components/schemas/Base'
+    LastRef:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - $ref: '#/components/schemas/Mixin'
+    FirstRef:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          properties:
+            size:
+              type: integer
+    Own:
+      description: Described next to its allOf
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          description: Refined by the inline member
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			SkipPrune: true,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "// Refined Refined by the inline member\ntype Refined struct {")
+	assert.Contains(t, code, "// RefinedFirst Refined by the first, inline, member\ntype RefinedFirst struct {")
+	assert.Contains(t, code, "// LastRef Mixin\ntype LastRef struct {")
+	assert.Contains(t, code, "// FirstRef Generic base\ntype FirstRef struct {")
+	assert.Contains(t, code, "// Own Described next to its allOf\ntype Own struct {")
+	assert.Regexp(t, `type Refined struct \{[^}]*// Id Refined id\n[^}]*// Name Base name\n`, code)
+}
+
+func TestAllOfConcatenatedDocComments(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: allOf doc comments
+paths: {}
+components:
+  schemas:
+    Base:
+      type: object
+      title: Base
+      description: Generic base.
+      properties:
+        id:
+          type: string
+    Mixin:
+      type: object
+      title: Mixin
+      description: |
+        Mixin.
+    Refined:
+      description: Described next to its allOf.
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - $ref: '#/components/schemas/Mixin'
+        - type: object
+          description: Refined by the inline member.
+          properties:
+            size:
+              type: integer
+    Repeated:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          description: Generic base.
+          properties:
+            size:
+              type: integer
+    Undocumented:
+      allOf:
+        - type: object
+          properties:
+            size:
+              type: integer
+        - type: object
+          properties:
+            name:
+              type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models: true,
+		},
+		OutputOptions: OutputOptions{
+			SkipPrune:        true,
+			AllOfDocStrategy: AllOfDocConcatenate,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "// Refined Described next to its allOf.\n//\n// Generic base.\n//\n// Mixin.\n//\n// Refined by the inline member.\ntype Refined struct {")
+	assert.Contains(t, code, "// Repeated Generic base.\ntype Repeated struct {")
+	assert.Contains(t, code, "// Undocumented defines model for Undocumented.\ntype Undocumented struct {")
+
+	// The first title of the members is kept.
+	merged := openapi3.Schema{}
+	concatenateDocs(&merged, "", swagger.Components.Schemas["Refined"].Value.AllOf)
+	assert.Equal(t, "Base", merged.Title)
+}
+
+func TestAllOfUnion(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: allOf with a union
+paths: {}
+components:
+  schemas:
+    Base:
+      type: object
+      properties:
+        id:
+          type: string
+        kind:
+          type: string
+    Envelope:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - oneOf:
+            - $ref: '#/components/schemas/Text'
+          discriminator:
+            propertyName: kind
+            mapping:
+              text: '#/components/schemas/Text'
+    Text:
+      type: object
+      properties:
+        kind:
+          type: string
+        %s:
+          type: string
+`
+	generate := func(property string) (string, error) {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(fmt.Sprintf(spec, property)))
+		require.NoError(t, err)
+		return Generate(swagger, Configuration{
+			PackageName:   "api",
+			Generate:      GenerateOptions{Models: true},
+			OutputOptions: OutputOptions{SkipPrune: true},
+		})
+	}
+
+	code, err := generate("text")
+	require.NoError(t, err)
+	assert.Regexp(t, `type Envelope struct \{\s*Id\s+\*string[^}]*Kind\s+\*string[^}]*union json.RawMessage`, code)
+	assert.Contains(t, code, "func (t Envelope) ValueByDiscriminator() (interface{}, error) {")
+
+	_, err = generate("id")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "property 'id' of union #/components/schemas/Text collides")
+}
+
+func TestMergeOpenapiSchemasDiscriminators(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	members := openapi3.SchemaRefs{openapi3.NewSchemaRef("", openapi3.NewObjectSchema())}
+	union := func(property string) openapi3.Schema {
+		return openapi3.Schema{
+			AnyOf:         members,
+			Discriminator: &openapi3.Discriminator{PropertyName: property},
+		}
+	}
+	base := openapi3.Schema{Discriminator: &openapi3.Discriminator{PropertyName: "type"}}
+
+	merged, err := g.mergeOpenapiSchemas(base, union("kind"), true)
+	require.NoError(t, err)
+	assert.Equal(t, "kind", merged.Discriminator.PropertyName)
+
+	merged, err = g.mergeOpenapiSchemas(union("kind"), union("kind"), true)
+	require.NoError(t, err)
+	assert.Len(t, merged.AnyOf, 2)
+
+	_, err = g.mergeOpenapiSchemas(union("kind"), union("type"), true)
+	assert.EqualError(t, err, "merging two unions with different discriminators is not supported")
+}
+
+func TestMergeOpenapiSchemasOneOf(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	card := &openapi3.SchemaRef{Ref: "#/components/schemas/Card", Value: openapi3.NewObjectSchema()}
+	bank := &openapi3.SchemaRef{Ref: "#/components/schemas/Bank", Value: openapi3.NewObjectSchema()}
+	common := *openapi3.NewObjectSchema().WithProperty("id", openapi3.NewStringSchema())
+
+	merged, err := g.mergeOpenapiSchemas(common, openapi3.Schema{OneOf: openapi3.SchemaRefs{card, bank}}, true)
+	require.NoError(t, err)
+	assert.Equal(t, openapi3.SchemaRefs{card, bank}, merged.OneOf)
+
+	// The same union, reached through both schemas, is kept once.
+	merged, err = g.mergeOpenapiSchemas(
+		openapi3.Schema{OneOf: openapi3.SchemaRefs{card, bank}},
+		openapi3.Schema{OneOf: openapi3.SchemaRefs{{Ref: card.Ref, Value: card.Value}, {Ref: bank.Ref, Value: bank.Value}}},
+		true)
+	require.NoError(t, err)
+	assert.Len(t, merged.OneOf, 2)
+
+	_, err = g.mergeOpenapiSchemas(
+		openapi3.Schema{OneOf: openapi3.SchemaRefs{card}},
+		openapi3.Schema{OneOf: openapi3.SchemaRefs{bank}},
+		true)
+	assert.EqualError(t, err, "merging two schemas which both have a oneOf is ambiguous, as the merged type holds a single union; list their members in a single oneOf instead")
+}
+
+func TestMergeOpenapiSchemasInferredTypes(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	object := openapi3.NewObjectSchema().WithProperty("id", openapi3.NewStringSchema())
+	inferredObject := openapi3.Schema{Properties: openapi3.Schemas{"name": openapi3.NewStringSchema().NewRef()}}
+
+	merged, err := g.mergeOpenapiSchemas(inferredObject, *object, true)
+	require.NoError(t, err)
+	assert.Equal(t, "object", merged.Type)
+	assert.Len(t, merged.Properties, 2)
+
+	array := openapi3.NewArraySchema().WithItems(openapi3.NewIntegerSchema())
+	inferredArray := openapi3.Schema{Items: openapi3.NewIntegerSchema().NewRef()}
+	merged, err = g.mergeOpenapiSchemas(*array, inferredArray, true)
+	require.NoError(t, err)
+	assert.Equal(t, "array", merged.Type)
+	assert.Equal(t, "integer", merged.Items.Value.Type)
+
+	// A member only requiring properties is an object too, in either order.
+	constraint := openapi3.Schema{Required: []string{"id"}}
+	for _, merge := range []struct{ s1, s2 openapi3.Schema }{{*object, constraint}, {constraint, *object}} {
+		merged, err = g.mergeOpenapiSchemas(merge.s1, merge.s2, true)
+		require.NoError(t, err)
+		assert.Equal(t, "object", merged.Type)
+		assert.Equal(t, []string{"id"}, merged.Required)
+		assert.Len(t, merged.Properties, 1)
+	}
+
+	_, err = g.mergeOpenapiSchemas(inferredObject, inferredArray, true)
+	assert.EqualError(t, err, "can not merge incompatible types object vs array")
+	_, err = g.mergeOpenapiSchemas(*openapi3.NewStringSchema(), inferredObject, true)
+	assert.EqualError(t, err, "can not merge incompatible types string vs object")
+}
+
+func TestMergeSchemasErrorPaths(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	object := openapi3.NewObjectSchema().WithProperty("id", openapi3.NewStringSchema())
+	allOf := []*openapi3.SchemaRef{
+		object.NewRef(),
+		openapi3.NewObjectSchema().NewRef(),
+		openapi3.NewStringSchema().NewRef(),
+	}
+	_, err := g.mergeAllOfSchemas(allOf, []string{"Order"})
+	assert.EqualError(t, err, "error merging schemas for AllOf at Order/allOf[2]: can not merge incompatible types object vs string")
+
+	// The path of nested members goes through the members holding them.
+	nested := &openapi3.Schema{AllOf: openapi3.SchemaRefs{
+		openapi3.NewObjectSchema().NewRef(),
+		openapi3.NewDateTimeSchema().NewRef(),
+	}}
+	allOf = []*openapi3.SchemaRef{object.NewRef(), nested.NewRef()}
+	_, err = g.mergeAllOfSchemas(allOf, []string{"Order", "Items"})
+	assert.EqualError(t, err, "error merging schemas for AllOf at Order/Items/allOf[1]: error transitive merging AllOf on schema 2: "+
+		"error merging schemas for AllOf at Order/Items/allOf[1]/allOf[1]: can not merge incompatible types object vs string")
+
+	deeper := &openapi3.Schema{AllOf: openapi3.SchemaRefs{openapi3.NewObjectSchema().NewRef(), nested.NewRef()}}
+	allOf = []*openapi3.SchemaRef{deeper.NewRef(), object.NewRef()}
+	_, err = g.mergeAllOfSchemas(allOf, []string{"Order"})
+	assert.EqualError(t, err, "error merging schemas for AllOf at Order/allOf[1]: error transitive merging AllOf on schema 1: "+
+		"error merging schemas for AllOf at Order/allOf[0]/allOf[1]: error transitive merging AllOf on schema 2: "+
+		"error merging schemas for AllOf at Order/allOf[0]/allOf[1]/allOf[1]: can not merge incompatible types object vs string")
+
+	allOf = []*openapi3.SchemaRef{object.NewRef(), {Ref: "#/components/schemas/Missing"}}
+	_, err = g.mergeAllOfSchemas(allOf, []string{"Order"})
+	assert.EqualError(t, err, `reference "#/components/schemas/Missing" at Order/allOf[1] could not be resolved`)
+}
+
+func TestAllOfUntypedMembers(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: allOf with untyped members
+paths: {}
+components:
+  schemas:
+    Base:
+      type: object
+      properties:
+        id:
+          type: string
+        name:
+          type: string
+    TypedFirst:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - required: [id]
+    UntypedFirst:
+      allOf:
+        - required: [id]
+        - $ref: '#/components/schemas/Base'
+    PropertiesFirst:
+      allOf:
+        - properties:
+            size:
+              type: integer
+        - type: object
+          required: [size]
+          properties:
+            name:
+              type: string
+    PropertiesLast:
+      allOf:
+        - type: object
+          required: [size]
+          properties:
+            name:
+              type: string
+        - properties:
+            size:
+              type: integer
+    AllUntyped:
+      allOf:
+        - required: [size]
+        - description: Only documents it.
+        - properties:
+            size:
+              type: integer
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	g := newGenerator(swagger, Configuration{})
+
+	goType := func(name string) string {
+		t.Helper()
+		s, err := g.generateGoSchema(swagger.Components.Schemas[name], []string{name})
+		require.NoError(t, err)
+		return s.GoType
+	}
+
+	typed := goType("TypedFirst")
+	assert.Regexp(t, `Id\s+string`, typed)
+	assert.Regexp(t, `Name\s+\*string`, typed)
+	assert.Equal(t, typed, goType("UntypedFirst"))
+
+	properties := goType("PropertiesFirst")
+	assert.Regexp(t, `Size\s+int`+"`", properties)
+	assert.Regexp(t, `Name\s+\*string`, properties)
+	assert.Equal(t, properties, goType("PropertiesLast"))
+
+	assert.Regexp(t, `^struct \{\s*Size\s+int`+"`", goType("AllUntyped"))
+}
+
+func TestMergeOpenapiSchemasProperties(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	base := openapi3.NewObjectSchema().
+		WithProperty("id", openapi3.NewStringSchema()).
+		WithProperty("metadata", openapi3.NewObjectSchema().WithProperty("owner", openapi3.NewStringSchema()))
+	refinement := openapi3.NewObjectSchema().
+		WithProperty("id", &openapi3.Schema{ReadOnly: true, Format: "uuid", Nullable: true}).
+		WithProperty("metadata", openapi3.NewObjectSchema().WithProperty("size", openapi3.NewIntegerSchema()))
+
+	merged, err := g.mergeOpenapiSchemas(*base, *refinement, true)
+	require.NoError(t, err)
+	id := merged.Properties["id"].Value
+	assert.Equal(t, "string", id.Type)
+	assert.Equal(t, "uuid", id.Format)
+	assert.True(t, id.ReadOnly)
+	assert.True(t, id.Nullable)
+	assert.ElementsMatch(t, []string{"owner", "size"}, SortedSchemaKeys(merged.Properties["metadata"].Value.Properties))
+
+	// The override is an annotation of the base property, the other way round.
+	merged, err = g.mergeOpenapiSchemas(*refinement, *base, true)
+	require.NoError(t, err)
+	assert.True(t, merged.Properties["id"].Value.ReadOnly)
+
+	conflict := openapi3.NewObjectSchema().WithProperty("metadata", openapi3.NewStringSchema())
+	_, err = g.mergeOpenapiSchemas(*base, *conflict, true)
+	assert.EqualError(t, err, "error merging property 'metadata': can not merge incompatible types object vs string")
+
+	writeOnly := openapi3.NewObjectSchema().WithProperty("id", &openapi3.Schema{WriteOnly: true})
+	_, err = g.mergeOpenapiSchemas(*refinement, *writeOnly, true)
+	assert.EqualError(t, err, "error merging property 'id': merging a read-only property with a write-only one")
+}
+
+func TestMergeOpenapiSchemasAdditionalProperties(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	s1 := openapi3.NewObjectSchema()
+	s1.AdditionalProperties.Schema = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+	s2 := openapi3.NewObjectSchema()
+	s2.AdditionalProperties.Schema = openapi3.NewSchemaRef("", openapi3.NewStringSchema().WithMaxLength(64))
+
+	merged, err := g.mergeOpenapiSchemas(*s1, *s2, true)
+	require.NoError(t, err)
+	require.NotNil(t, merged.AdditionalProperties.Schema)
+	assert.Equal(t, "string", merged.AdditionalProperties.Schema.Value.Type)
+	assert.EqualValues(t, 64, *merged.AdditionalProperties.Schema.Value.MaxLength)
+
+	// The same reference on both sides is kept as is.
+	label := openapi3.NewSchemaRef("#/components/schemas/Label", openapi3.NewStringSchema())
+	s1.AdditionalProperties.Schema, s2.AdditionalProperties.Schema = label, label
+	merged, err = g.mergeOpenapiSchemas(*s1, *s2, true)
+	require.NoError(t, err)
+	assert.Equal(t, "#/components/schemas/Label", merged.AdditionalProperties.Schema.Ref)
+
+	// Only one side having a schema keeps it.
+	merged, err = g.mergeOpenapiSchemas(*s1, *openapi3.NewObjectSchema(), true)
+	require.NoError(t, err)
+	assert.Equal(t, label, merged.AdditionalProperties.Schema)
+
+	s2.AdditionalProperties.Schema = openapi3.NewSchemaRef("", openapi3.NewIntegerSchema())
+	_, err = g.mergeOpenapiSchemas(*s1, *s2, true)
+	assert.EqualError(t, err, "error merging additional properties: can not merge incompatible types string vs integer")
+}
+
+func TestAllOfMergedProperties(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: allOf with properties in several members
+paths: {}
+components:
+  schemas:
+    Metadata:
+      type: object
+      properties:
+        owner:
+          type: string
+    Base:
+      type: object
+      properties:
+        metadata:
+          type: object
+        owner:
+          $ref: '#/components/schemas/Metadata'
+    Derived:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          properties:
+            metadata:
+              type: object
+              properties:
+                labels:
+                  type: array
+                  items:
+                    type: string
+            owner:
+              description: who owns it
+              readOnly: true
+    Extended:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          properties:
+            owner:
+              type: object
+              properties:
+                team:
+                  type: string
+    Conflict:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          properties:
+            metadata:
+              type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	g := newGenerator(swagger, Configuration{})
+
+	derived, err := g.generateGoSchema(swagger.Components.Schemas["Derived"], []string{"Derived"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(derived.GoType, "Metadata "))
+	assert.Regexp(t, `Metadata \*struct \{\s*Labels \*\[\]string`, derived.GoType)
+	// Annotating the referenced property keeps its type.
+	assert.Contains(t, derived.GoType, "Owner *Metadata")
+	for _, p := range derived.Properties {
+		if p.JsonFieldName == "owner" {
+			assert.True(t, p.ReadOnly)
+			assert.Equal(t, "who owns it", p.Description)
+		}
+	}
+
+	// Refining the referenced property merges it inline.
+	extended, err := g.generateGoSchema(swagger.Components.Schemas["Extended"], []string{"Extended"})
+	require.NoError(t, err)
+	assert.Regexp(t, `Owner \*struct \{\s*Owner \*string[^}]*Team \*string`, extended.GoType)
+
+	_, err = g.generateGoSchema(swagger.Components.Schemas["Conflict"], []string{"Conflict"})
+	assert.ErrorContains(t, err, "error merging schemas for AllOf at Conflict/allOf[1]: "+
+		"error merging property 'metadata': can not merge incompatible types object vs string")
+}
+
+func TestAllOfArrays(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: allOf of arrays
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+    PagedList:
+      type: array
+      items:
+        $ref: '#/components/schemas/Pet'
+    LimitedList:
+      type: array
+      maxItems: 100
+    Pets:
+      allOf:
+        - $ref: '#/components/schemas/LimitedList'
+        - $ref: '#/components/schemas/PagedList'
+    AnnotatedPets:
+      allOf:
+        - $ref: '#/components/schemas/PagedList'
+        - type: array
+          items:
+            description: a pet of the page
+    Tags:
+      allOf:
+        - type: array
+          items:
+            type: string
+            maxLength: 10
+        - type: array
+          items:
+            type: string
+            minLength: 1
+    Conflict:
+      type: object
+      properties:
+        page:
+          allOf:
+            - $ref: '#/components/schemas/PagedList'
+            - type: array
+              items:
+                type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	g := newGenerator(swagger, Configuration{})
+
+	// The items of one member are kept, and the bounds of the other.
+	pets, err := g.generateGoSchema(swagger.Components.Schemas["Pets"], []string{"Pets"})
+	require.NoError(t, err)
+	assert.Equal(t, "[]Pet", pets.GoType)
+	merged, err := g.mergeAllOf(swagger.Components.Schemas["Pets"].Value.AllOf, []string{"Pets"})
+	require.NoError(t, err)
+	require.NotNil(t, merged.MaxItems)
+	assert.Equal(t, uint64(100), *merged.MaxItems)
+
+	// Annotating referenced items keeps their type.
+	annotated, err := g.generateGoSchema(swagger.Components.Schemas["AnnotatedPets"], []string{"AnnotatedPets"})
+	require.NoError(t, err)
+	assert.Equal(t, "[]Pet", annotated.GoType)
+
+	tags, err := g.generateGoSchema(swagger.Components.Schemas["Tags"], []string{"Tags"})
+	require.NoError(t, err)
+	assert.Equal(t, "[]string", tags.GoType)
+	merged, err = g.mergeAllOf(swagger.Components.Schemas["Tags"].Value.AllOf, []string{"Tags"})
+	require.NoError(t, err)
+	items := merged.Items.Value
+	assert.Equal(t, uint64(1), items.MinLength)
+	require.NotNil(t, items.MaxLength)
+	assert.Equal(t, uint64(10), *items.MaxLength)
+
+	_, err = g.generateGoSchema(swagger.Components.Schemas["Conflict"], []string{"Conflict"})
+	assert.ErrorContains(t, err, "error merging schemas for AllOf at Conflict/page/allOf[1]: "+
+		"error merging items: can not merge incompatible types object vs string")
+}
+
+func TestAllOfEmptyMembers(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: allOf with empty members
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+    PetRef:
+      allOf:
+        - $ref: '#/components/schemas/Pet'
+        - {}
+    NamedPet:
+      allOf:
+        - {}
+        - $ref: '#/components/schemas/Pet'
+        - type: object
+          properties:
+            nickname:
+              type: string
+    Anything:
+      allOf:
+        - {}
+        - {}
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	g := newGenerator(swagger, Configuration{})
+	generate := func(name string) (Schema, error) {
+		return g.generateGoSchema(swagger.Components.Schemas[name], []string{name})
+	}
+
+	// A ref and an empty member are just the referenced schema.
+	petRef, err := generate("PetRef")
+	require.NoError(t, err)
+	assert.Equal(t, "Pet", petRef.GoType)
+
+	namedPet, err := generate("NamedPet")
+	require.NoError(t, err)
+	var names []string
+	for _, property := range namedPet.Properties {
+		names = append(names, property.JsonFieldName)
+	}
+	assert.Equal(t, []string{"name", "nickname"}, names)
+
+	// Members which are all empty are still merged, into anything.
+	anything, err := generate("Anything")
+	require.NoError(t, err)
+	assert.Equal(t, "interface{}", anything.GoType)
+
+	// The index of a member is that of the spec, empty members included.
+	allOf := []*openapi3.SchemaRef{{Value: &openapi3.Schema{}}, swagger.Components.Schemas["Pet"], {Ref: "#/components/schemas/Pets"}}
+	_, err = g.mergeAllOfSchemas(allOf, []string{"Pets"})
+	assert.EqualError(t, err, `reference "#/components/schemas/Pets" at Pets/allOf[2] could not be resolved`)
+}
+
+func TestMergeOpenapiSchemasDefaults(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	withDefault := func(value interface{}) openapi3.Schema {
+		return *openapi3.NewIntegerSchema().WithDefault(value)
+	}
+
+	merged, err := g.mergeOpenapiSchemas(withDefault(float64(0)), *openapi3.NewIntegerSchema(), true)
+	require.NoError(t, err)
+	assert.Equal(t, float64(0), merged.Default)
+
+	merged, err = g.mergeOpenapiSchemas(*openapi3.NewIntegerSchema(), withDefault(float64(0)), true)
+	require.NoError(t, err)
+	assert.Equal(t, float64(0), merged.Default)
+
+	merged, err = g.mergeOpenapiSchemas(withDefault(float64(0)), withDefault(float64(0)), true)
+	require.NoError(t, err)
+	assert.Equal(t, float64(0), merged.Default)
+
+	_, err = g.mergeOpenapiSchemas(withDefault(float64(0)), withDefault(float64(1)), true)
+	assert.EqualError(t, err, "merging two different defaults is undefined")
+}
+
+func TestMergeOpenapiSchemasPatterns(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	withPattern := func(pattern string) openapi3.Schema {
+		return *openapi3.NewStringSchema().WithPattern(pattern)
+	}
+
+	merged, err := g.mergeOpenapiSchemas(withPattern("^[a-z]+$"), *openapi3.NewStringSchema(), true)
+	require.NoError(t, err)
+	assert.Equal(t, "^[a-z]+$", merged.Pattern)
+	assert.Equal(t, []string{"^[a-z]+$"}, schemaPatterns(&merged))
+
+	merged, err = g.mergeOpenapiSchemas(*openapi3.NewStringSchema(), withPattern("^[a-z]+$"), true)
+	require.NoError(t, err)
+	assert.Equal(t, "^[a-z]+$", merged.Pattern)
+
+	merged, err = g.mergeOpenapiSchemas(withPattern("^[a-z]+$"), withPattern("^[a-z]+$"), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"^[a-z]+$"}, schemaPatterns(&merged))
+
+	merged, err = g.mergeOpenapiSchemas(withPattern("^[a-z]+$"), withPattern("^.{3}$"), true)
+	require.NoError(t, err)
+	assert.Equal(t, "^[a-z]+$", merged.Pattern)
+	assert.Equal(t, []string{"^[a-z]+$", "^.{3}$"}, schemaPatterns(&merged))
+
+	merged, err = g.mergeOpenapiSchemas(merged, withPattern("^a"), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"^[a-z]+$", "^.{3}$", "^a"}, schemaPatterns(&merged))
+}
+
+func TestAllOfPatterns(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: allOf with patterns
+paths: {}
+components:
+  schemas:
+    Lowercase:
+      type: string
+      pattern: '^[a-z]+$'
+    Code:
+      allOf:
+        - $ref: '#/components/schemas/Lowercase'
+        - pattern: '^.{3}$'
+    Word:
+      allOf:
+        - $ref: '#/components/schemas/Lowercase'
+        - description: a word
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	g := newGenerator(swagger, Configuration{})
+
+	code, err := g.generateGoSchema(swagger.Components.Schemas["Code"], []string{"Code"})
+	require.NoError(t, err)
+	assert.Equal(t, "string", code.GoType)
+	assert.Equal(t, []string{"^[a-z]+$", "^.{3}$"}, code.Patterns)
+
+	word, err := g.generateGoSchema(swagger.Components.Schemas["Word"], []string{"Word"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"^[a-z]+$"}, word.Patterns)
+
+	// The referenced schema itself is left untouched.
+	assert.Equal(t, "^[a-z]+$", swagger.Components.Schemas["Lowercase"].Value.Pattern)
+	assert.NotContains(t, swagger.Components.Schemas["Lowercase"].Value.Extensions, extMergedPatterns)
+}
+
+func TestAllOfReadOnlyMember(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: allOf with a read-only member
+paths: {}
+components:
+  schemas:
+    Audit:
+      type: object
+      readOnly: true
+      required: [createdAt]
+      properties:
+        createdAt:
+          type: string
+          format: date-time
+        owner:
+          $ref: '#/components/schemas/Owner'
+    Owner:
+      type: object
+      properties:
+        name:
+          type: string
+    Thing:
+      allOf:
+        - $ref: '#/components/schemas/Audit'
+        - type: object
+          required: [name]
+          properties:
+            name:
+              type: string
+            owner:
+              description: who owns the thing
+              $ref: '#/components/schemas/Owner'
+    Conflict:
+      allOf:
+        - $ref: '#/components/schemas/Audit'
+        - type: object
+          properties:
+            createdAt:
+              type: string
+              writeOnly: true
+    Scalar:
+      allOf:
+        - type: string
+          readOnly: true
+        - type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	g := newGenerator(swagger, Configuration{})
+
+	thing, err := g.generateGoSchema(swagger.Components.Schemas["Thing"], []string{"Thing"})
+	require.NoError(t, err)
+	readOnly := map[string]bool{}
+	for _, p := range thing.Properties {
+		readOnly[p.JsonFieldName] = p.ReadOnly
+	}
+	assert.Equal(t, map[string]bool{"createdAt": true, "owner": true, "name": false}, readOnly)
+	assert.Contains(t, thing.GoType, "CreatedAt *time.Time")
+	assert.Contains(t, thing.GoType, "Owner *Owner")
+
+	// The referenced schemas themselves are left untouched.
+	assert.True(t, swagger.Components.Schemas["Audit"].Value.ReadOnly)
+	assert.False(t, swagger.Components.Schemas["Audit"].Value.Properties["createdAt"].Value.ReadOnly)
+
+	_, err = g.generateGoSchema(swagger.Components.Schemas["Conflict"], []string{"Conflict"})
+	assert.ErrorContains(t, err, "merging a read-only schema with one whose property 'createdAt' is write-only")
+
+	_, err = g.generateGoSchema(swagger.Components.Schemas["Scalar"], []string{"Scalar"})
+	assert.ErrorContains(t, err, "merging two schemas with different ReadOnly")
+}
+
+func TestMergeOpenapiSchemasExtensions(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	withExtensions := func(extensions map[string]interface{}) openapi3.Schema {
+		schema := openapi3.NewStringSchema()
+		schema.Extensions = extensions
+		return *schema
+	}
+
+	merged, err := g.mergeOpenapiSchemas(
+		withExtensions(map[string]interface{}{extPropGoType: "time.Time", "x-order": float64(1)}),
+		withExtensions(map[string]interface{}{extPropGoType: "time.Time", extPropGoImport: map[string]interface{}{"path": "time"}}),
+		true)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		extPropGoType:   "time.Time",
+		extPropGoImport: map[string]interface{}{"path": "time"},
+		"x-order":       float64(1),
+	}, merged.Extensions)
+
+	_, err = g.mergeOpenapiSchemas(
+		withExtensions(map[string]interface{}{extPropGoType: "time.Time"}),
+		withExtensions(map[string]interface{}{extPropGoType: "CustomTime"}),
+		true)
+	assert.EqualError(t, err, "merging two schemas with different x-go-type: time.Time vs CustomTime")
+
+	_, err = g.mergeOpenapiSchemas(
+		withExtensions(map[string]interface{}{"x-order": float64(1)}),
+		withExtensions(map[string]interface{}{"x-order": float64(2)}),
+		true)
+	assert.EqualError(t, err, "merging two schemas with different x-order: 1 vs 2")
+
+	// Nor are the names of the members' own types dropped.
+	_, err = g.mergeOpenapiSchemas(
+		withExtensions(map[string]interface{}{extGoName: "Base"}),
+		withExtensions(map[string]interface{}{extGoName: "Refinement"}),
+		true)
+	assert.EqualError(t, err, "merging two schemas with different x-go-name: Base vs Refinement")
+
+	_, err = g.mergeOpenapiSchemas(
+		withExtensions(map[string]interface{}{extGoTypeName: "Base"}),
+		withExtensions(map[string]interface{}{extGoTypeName: "Refinement"}),
+		true)
+	assert.EqualError(t, err, "merging two schemas with different x-go-type-name: Base vs Refinement")
+}
+
+func TestAllOfCycle(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: circular allOf
+paths: {}
+components:
+  schemas:
+    A:
+      allOf:
+        - $ref: '#/components/schemas/B'
+        - type: object
+          properties:
+            a:
+              type: string
+    B:
+      allOf:
+        - $ref: '#/components/schemas/A'
+        - type: object
+          properties:
+            b:
+              type: string
+    Self:
+      allOf:
+        - type: object
+          properties:
+            id:
+              type: string
+        - allOf:
+            - $ref: '#/components/schemas/Self'
+    Base:
+      type: object
+      properties:
+        id:
+          type: string
+    Left:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          properties:
+            left:
+              type: string
+    Right:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          properties:
+            right:
+              type: string
+    Diamond:
+      allOf:
+        - $ref: '#/components/schemas/Left'
+        - $ref: '#/components/schemas/Right'
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	g := newGenerator(swagger, Configuration{})
+
+	_, err = g.generateGoSchema(swagger.Components.Schemas["A"], []string{"A"})
+	assert.ErrorContains(t, err, "circular allOf detected: A -> B -> A")
+
+	_, err = g.generateGoSchema(swagger.Components.Schemas["Self"], []string{"Self"})
+	assert.ErrorContains(t, err, "circular allOf detected: Self -> Self")
+
+	// A schema reached through two members is merged, not a cycle.
+	diamond, err := g.generateGoSchema(swagger.Components.Schemas["Diamond"], []string{"Diamond"})
+	require.NoError(t, err)
+	var names []string
+	for _, p := range diamond.Properties {
+		names = append(names, p.JsonFieldName)
+	}
+	assert.ElementsMatch(t, []string{"id", "left", "right"}, names)
+}
+
+func TestAllOfCycleAcrossDocuments(t *testing.T) {
+	// other.yaml's X inherits from other.yaml's A, not from ours, which
+	// inherits from X.
+	remoteA := openapi3.NewSchemaRef("#/components/schemas/A", openapi3.NewObjectSchema())
+	remoteX := &openapi3.Schema{AllOf: openapi3.SchemaRefs{remoteA}}
+	allOf := openapi3.SchemaRefs{openapi3.NewSchemaRef("other.yaml#/components/schemas/X", remoteX)}
+
+	g := newGenerator(nil, Configuration{})
+	_, err := g.checkAllOf(allOf, []string{"A"}, "", []string{"#/components/schemas/A"}, 1)
+	assert.NoError(t, err)
+
+	// A ref of other.yaml back to itself is still a cycle.
+	remoteX.AllOf = openapi3.SchemaRefs{openapi3.NewSchemaRef("#/components/schemas/X", remoteX)}
+	g = newGenerator(nil, Configuration{})
+	_, err = g.checkAllOf(allOf, []string{"A"}, "", []string{"#/components/schemas/A"}, 1)
+	assert.ErrorContains(t, err, "circular allOf detected: X -> X")
+}
+func TestAllOfLimits(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: allOf limits
+paths: {}
+components:
+  schemas:
+    Base:
+      type: object
+      properties:
+        id:
+          type: string
+    Middle:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          properties:
+            middle:
+              type: string
+    Top:
+      allOf:
+        - $ref: '#/components/schemas/Middle'
+        - allOf:
+            - $ref: '#/components/schemas/Middle'
+            - type: object
+              properties:
+                top:
+                  type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	generate := func(depth, width int) (string, error) {
+		return Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate:    GenerateOptions{Models: true},
+			OutputOptions: OutputOptions{
+				SkipPrune:     true,
+				AllOfMaxDepth: depth,
+				AllOfMaxWidth: width,
+			},
+		})
+	}
+
+	// Top nests three levels of allOf, through its inline member and Middle.
+	code, err := generate(3, 2)
+	require.NoError(t, err)
+	assert.Regexp(t, `Top\s+\*string`, code)
+
+	_, err = generate(2, 0)
+	assert.ErrorContains(t, err, "allOf at Top/allOf[1]/allOf[0] is nested 3 levels deep, more than allof-max-depth 2")
+
+	_, err = generate(0, 1)
+	assert.ErrorContains(t, err, "allOf at Middle has 2 members, more than allof-max-width 1")
+
+	cfg := Configuration{PackageName: "api", OutputOptions: OutputOptions{AllOfMaxDepth: -1}}
+	assert.EqualError(t, cfg.Validate(), "allof-max-depth and allof-max-width can't be negative")
+}
+
+func TestAllOfStress(t *testing.T) {
+	swagger, err := util.LoadSwagger("test_specs/allof-stress.yaml")
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.NoError(t, err)
+	level := code[strings.Index(code, "type Level14A struct {"):]
+	level = level[:strings.Index(level, "\n}\n")]
+	assert.Contains(t, level, "Mixin000 ")
+	assert.Contains(t, level, "Mixin199 ")
+	assert.Contains(t, level, "Level01a ")
+	assert.Contains(t, level, "Level13b ")
+	assert.Equal(t, 200+2*13+1, strings.Count(level, "`json:"))
+
+	// Base nests the mixins one level below Level14A.
+	_, err = Generate(swagger, Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true, AllOfMaxDepth: 14},
+	})
+	assert.ErrorContains(t, err, "is nested 15 levels deep, more than allof-max-depth 14")
+}
+
+func BenchmarkAllOfStress(b *testing.B) {
+	swagger, err := util.LoadSwagger("test_specs/allof-stress.yaml")
+	require.NoError(b, err)
+	opts := Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Generate(swagger, opts); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestMergeOpenapiSchemasNullableResolution(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+
+	nullable := openapi3.Schema{Type: "string", Nullable: true}
+	readOnly := openapi3.Schema{Type: "string", ReadOnly: true}
+	writeOnly := openapi3.Schema{Type: "string", WriteOnly: true}
+	plain := openapi3.Schema{Type: "string"}
+	object := func(property openapi3.Schema) openapi3.Schema {
+		return *openapi3.NewObjectSchema().WithProperty("name", &property)
+	}
+
+	for _, resolution := range []AllOfNullableResolution{"", AllOfNullableError} {
+		g.options.Compatibility.AllOfNullableResolution = resolution
+		_, err := g.mergeOpenapiSchemas(nullable, plain, true)
+		assert.EqualError(t, err, "merging two schemas with different Nullable")
+		_, err = g.mergeOpenapiSchemas(plain, readOnly, true)
+		assert.EqualError(t, err, "merging two schemas with different ReadOnly")
+		_, err = g.mergeOpenapiSchemas(writeOnly, plain, true)
+		assert.EqualError(t, err, "merging two schemas with different WriteOnly")
+
+		// The flags of the properties are those either sets.
+		merged, err := g.mergeOpenapiSchemas(object(nullable), object(plain), true)
+		require.NoError(t, err)
+		assert.True(t, merged.Properties["name"].Value.Nullable)
+	}
+
+	g.options.Compatibility.AllOfNullableResolution = AllOfNullableStrictest
+	merged, err := g.mergeOpenapiSchemas(nullable, plain, true)
+	require.NoError(t, err)
+	assert.False(t, merged.Nullable)
+	merged, err = g.mergeOpenapiSchemas(plain, readOnly, true)
+	require.NoError(t, err)
+	assert.True(t, merged.ReadOnly)
+	merged, err = g.mergeOpenapiSchemas(writeOnly, plain, true)
+	require.NoError(t, err)
+	assert.True(t, merged.WriteOnly)
+	_, err = g.mergeOpenapiSchemas(readOnly, writeOnly, true)
+	assert.EqualError(t, err, "merging a read-only schema with a write-only one")
+	merged, err = g.mergeOpenapiSchemas(object(nullable), object(readOnly), true)
+	require.NoError(t, err)
+	assert.False(t, merged.Properties["name"].Value.Nullable)
+	assert.True(t, merged.Properties["name"].Value.ReadOnly)
+	// Only allOf merges are resolved.
+	_, err = g.mergeOpenapiSchemas(nullable, plain, false)
+	assert.EqualError(t, err, "merging two schemas with different Nullable")
+
+	g.options.Compatibility.AllOfNullableResolution = AllOfNullableLoosest
+	merged, err = g.mergeOpenapiSchemas(nullable, plain, true)
+	require.NoError(t, err)
+	assert.True(t, merged.Nullable)
+	merged, err = g.mergeOpenapiSchemas(readOnly, writeOnly, true)
+	require.NoError(t, err)
+	assert.False(t, merged.ReadOnly)
+	assert.False(t, merged.WriteOnly)
+	merged, err = g.mergeOpenapiSchemas(object(plain), object(readOnly), true)
+	require.NoError(t, err)
+	assert.False(t, merged.Properties["name"].Value.ReadOnly)
+	// An object read-only as a whole isn't any more, nor are its properties.
+	whole := object(plain)
+	whole.ReadOnly = true
+	merged, err = g.mergeOpenapiSchemas(whole, object(plain), true)
+	require.NoError(t, err)
+	assert.False(t, merged.ReadOnly)
+	assert.False(t, merged.Properties["name"].Value.ReadOnly)
+}
+
+func TestAllOfNullableResolutionFields(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: allOf nullable resolution
+paths: {}
+components:
+  schemas:
+    Name:
+      type: string
+      nullable: true
+    Person:
+      type: object
+      required: [name]
+      properties:
+        name:
+          allOf:
+            - $ref: '#/components/schemas/Name'
+            - type: string
+              maxLength: 10
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	generate := func(resolution AllOfNullableResolution) (string, error) {
+		return Generate(swagger, Configuration{
+			PackageName:   "api",
+			Generate:      GenerateOptions{Models: true},
+			Compatibility: CompatibilityOptions{AllOfNullableResolution: resolution},
+			OutputOptions: OutputOptions{SkipPrune: true},
+		})
+	}
+
+	_, err = generate(AllOfNullableError)
+	assert.ErrorContains(t, err, "merging two schemas with different Nullable")
+
+	code, err := generate(AllOfNullableStrictest)
+	require.NoError(t, err)
+	assert.Regexp(t, "Name\\s+string\\s+`json:\"name\"`", code)
+
+	code, err = generate(AllOfNullableLoosest)
+	require.NoError(t, err)
+	assert.Regexp(t, "Name\\s+\\*string\\s+`json:\"name\"`", code)
+}
+
+func TestMergeSchemasRequiredOnce(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: required once
+paths: {}
+components:
+  schemas:
+    Base:
+      type: object
+      required: [id, name]
+      properties:
+        id:
+          type: string
+        name:
+          type: string
+    Override:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          required: [name, id]
+          properties:
+            name:
+              type: string
+              maxLength: 10
+            tag:
+              type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	for _, semantics := range []AllOfMergeSemantics{AllOfMergeUnion, AllOfMergeIntersect} {
+		g := newGenerator(swagger, Configuration{Compatibility: CompatibilityOptions{AllOfMergeSemantics: semantics}})
+
+		override := swagger.Components.Schemas["Override"].Value
+		merged, err := g.mergeOpenapiSchemas(*override.AllOf[0].Value, *override.AllOf[1].Value, true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"id", "name"}, merged.Required)
+
+		schema, err := g.generateGoSchema(swagger.Components.Schemas["Override"], []string{"Override"})
+		require.NoError(t, err)
+		required := map[string]bool{}
+		for _, p := range schema.Properties {
+			required[p.JsonFieldName] = p.Required
+		}
+		assert.Equal(t, map[string]bool{"id": true, "name": true, "tag": false}, required)
+	}
+}
+
+func TestPropagateRemoteRefs(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+	tag := openapi3.NewObjectSchema().WithProperty("label", openapi3.NewStringSchema())
+	tagRef := func() *openapi3.SchemaRef {
+		return &openapi3.SchemaRef{Ref: "#/components/schemas/Tag", Value: tag}
+	}
+	named := openapi3.NewObjectSchema().WithPropertyRef("primaryTag", tagRef())
+	inline := openapi3.NewObjectSchema().WithPropertyRef("tags", openapi3.NewSchemaRef("", &openapi3.Schema{Type: "array", Items: tagRef()}))
+	inline.AdditionalProperties.Schema = tagRef()
+	base := openapi3.Schema{
+		AllOf: openapi3.SchemaRefs{
+			{Ref: "#/components/schemas/Named", Value: named},
+			openapi3.NewSchemaRef("", inline),
+		},
+	}
+
+	value, err := valueWithPropagatedRef(&openapi3.SchemaRef{Ref: "other.yaml#/components/schemas/Base", Value: &base}, "Item/allOf[0]")
+	require.NoError(t, err)
+	assert.Equal(t, "other.yaml#/components/schemas/Named", value.AllOf[0].Ref)
+	propagated := value.AllOf[1].Value
+	assert.Equal(t, "other.yaml#/components/schemas/Tag", propagated.Properties["tags"].Value.Items.Ref)
+	assert.Equal(t, "other.yaml#/components/schemas/Tag", propagated.AdditionalProperties.Schema.Ref)
+	// The remote document itself is left as it is.
+	assert.Equal(t, "#/components/schemas/Tag", inline.Properties["tags"].Value.Items.Ref)
+
+	// The refs of the nested member, local to the remote document, are
+	// propagated when it's merged.
+	merged, err := g.mergeAllOf(value.AllOf, []string{"Item"})
+	require.NoError(t, err)
+	assert.Equal(t, "other.yaml#/components/schemas/Tag", merged.Properties["primaryTag"].Ref)
+	assert.Equal(t, "other.yaml#/components/schemas/Tag", merged.Properties["tags"].Value.Items.Ref)
+	assert.Equal(t, "other.yaml#/components/schemas/Tag", merged.AdditionalProperties.Schema.Ref)
+}
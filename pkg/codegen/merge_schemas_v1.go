@@ -8,7 +8,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
-func mergeSchemasV1(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
+func (g *generator) mergeSchemasV1(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 	var outSchema Schema
 	for _, schemaOrRef := range allOf {
 		ref := schemaOrRef.Ref
@@ -16,13 +16,13 @@ func mergeSchemasV1(allOf []*openapi3.SchemaRef, path []string) (Schema, error)
 		var refType string
 		var err error
 		if IsGoTypeReference(ref) {
-			refType, err = RefPathToGoType(ref)
+			refType, err = g.refPathToGoType(ref, true)
 			if err != nil {
 				return Schema{}, fmt.Errorf("error converting reference path to a go type: %w", err)
 			}
 		}
 
-		schema, err := GenerateGoSchema(schemaOrRef, path)
+		schema, err := g.generateGoSchema(schemaOrRef, path)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error generating Go schema in allOf: %w", err)
 		}
@@ -46,6 +46,7 @@ func mergeSchemasV1(allOf []*openapi3.SchemaRef, path []string) (Schema, error)
 				// We're switching from having no additional properties to having
 				// them
 				outSchema.HasAdditionalProperties = true
+				outSchema.untypedAdditionalProperties = schema.untypedAdditionalProperties
 				outSchema.AdditionalPropertiesType = schema.AdditionalPropertiesType
 			}
 		}
@@ -53,7 +54,7 @@ func mergeSchemasV1(allOf []*openapi3.SchemaRef, path []string) (Schema, error)
 
 	// Now, we generate the struct which merges together all the fields.
 	var err error
-	outSchema.GoType, err = GenStructFromAllOf(allOf, path)
+	outSchema.GoType, err = g.genStructFromAllOf(allOf, path)
 	if err != nil {
 		return Schema{}, fmt.Errorf("unable to generate aggregate type for AllOf: %w", err)
 	}
@@ -64,6 +65,11 @@ func mergeSchemasV1(allOf []*openapi3.SchemaRef, path []string) (Schema, error)
 // input array. In the case of Ref objects, we use an embedded struct, otherwise,
 // we inline the fields.
 func GenStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, error) {
+	return exportedGenerator().genStructFromAllOf(allOf, path)
+}
+
+// genStructFromAllOf does the work of GenStructFromAllOf.
+func (g *generator) genStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, error) {
 	// Start out with struct {
 	objectParts := []string{"struct {"}
 	for _, schemaOrRef := range allOf {
@@ -75,7 +81,7 @@ func GenStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, err
 			//   InlinedMember
 			//   ...
 			// }
-			goType, err := RefPathToGoType(ref)
+			goType, err := g.refPathToGoType(ref, true)
 			if err != nil {
 				return "", err
 			}
@@ -86,7 +92,7 @@ func GenStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, err
 		} else {
 			// Inline all the fields from the schema into the output struct,
 			// just like in the simple case of generating an object.
-			goSchema, err := GenerateGoSchema(schemaOrRef, path)
+			goSchema, err := g.generateGoSchema(schemaOrRef, path)
 			if err != nil {
 				return "", err
 			}
@@ -98,6 +104,9 @@ func GenStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, err
 				if goSchema.AdditionalPropertiesType.RefType != "" {
 					addPropsType = goSchema.AdditionalPropertiesType.RefType
 				}
+				if goSchema.UntypedAdditionalProperties() {
+					addPropsType = "interface{}"
+				}
 
 				additionalPropertiesPart := fmt.Sprintf("AdditionalProperties map[string]%s `json:\"-\"`", addPropsType)
 				if !StringInArray(additionalPropertiesPart, objectParts) {
@@ -0,0 +1,215 @@
+package codegen
+
+import (
+	"context"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/deepmap/oapi-codegen/v2/pkg/codegen/model"
+)
+
+// BuildModel resolves spec with opts as Generate does, and returns the
+// resulting model, without generating any code. Its types are those of the
+// models, whether or not opts generates them. Like Generate, it filters and
+// prunes spec as opts asks for. The warnings of the spec aren't reported.
+func BuildModel(spec *openapi3.T, opts Configuration) (*model.Model, error) {
+	g := newGenerator(spec, opts)
+	if err := g.prepare(); err != nil {
+		return nil, err
+	}
+	ops, err := g.operationDefinitions(context.Background(), spec, opts.OutputOptions.InitialismOverrides)
+	if err != nil {
+		return nil, fmt.Errorf("error creating operation definitions: %w", err)
+	}
+	types, err := g.resolveComponentTypes(spec, opts.OutputOptions.ExcludeSchemas)
+	if err != nil {
+		return nil, err
+	}
+	return g.newModel(types.all, ops)
+}
+
+// newModel returns the model of types, those of the components of a spec,
+// and ops, its operations, which the code is generated from.
+func (g *generator) newModel(types []TypeDefinition, ops []OperationDefinition) (*model.Model, error) {
+	// As GenerateTypeDefinitions does, the enums are named among the types of
+	// the components and the operations, and the request bodies are defined
+	// on their own.
+	enumTypes := append([]TypeDefinition{}, types...)
+	for _, op := range ops {
+		enumTypes = append(enumTypes, op.TypeDefinitions...)
+	}
+	enums, err := g.enumDefinitions(enumTypes)
+	if err != nil {
+		return nil, err
+	}
+	enumsByName := make(map[string]EnumDefinition, len(enums))
+	for _, enum := range enums {
+		enumsByName[enum.TypeName] = enum
+	}
+
+	defined := enumTypes
+	for _, op := range ops {
+		for _, body := range op.Bodies {
+			if body.IsSupported() {
+				defined = append(defined, *body.TypeDef(op.OperationId))
+			}
+		}
+	}
+	defined, err = uniqueTypes(defined)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &model.Model{
+		Types:      make([]model.Type, 0, len(defined)),
+		Operations: make([]model.Operation, 0, len(ops)),
+	}
+	for _, td := range defined {
+		m.Types = append(m.Types, modelType(td, enumsByName))
+	}
+	sort.SliceStable(m.Types, func(i, j int) bool {
+		return m.Types[i].Name < m.Types[j].Name
+	})
+	for _, op := range ops {
+		m.Operations = append(m.Operations, modelOperation(op))
+	}
+	return m, nil
+}
+
+// modelType returns the model of td, whose enum, if any, is in enums.
+func modelType(td TypeDefinition, enums map[string]EnumDefinition) model.Type {
+	t := model.Type{
+		Name:        td.TypeName,
+		JSONName:    td.JsonName,
+		GoType:      formatGoType(td.Schema.TypeDecl()),
+		Alias:       td.IsAlias(),
+		Description: td.Schema.Description,
+		Schema:      td.Schema.OAPISchema,
+	}
+	for _, p := range td.Schema.Properties {
+		goType := fieldTypeDef(p)
+		t.Fields = append(t.Fields, model.Field{
+			Name:        p.GoFieldName(),
+			JSONName:    p.JsonFieldName,
+			GoType:      goType,
+			Pointer:     goType != p.Schema.TypeDecl(),
+			Required:    p.Required,
+			Nullable:    p.Nullable,
+			ReadOnly:    p.ReadOnly,
+			WriteOnly:   p.WriteOnly,
+			Deprecated:  p.Deprecated,
+			Description: p.Description,
+			Schema:      p.Schema.OAPISchema,
+		})
+	}
+	if td.Schema.HasAdditionalProperties && td.Schema.AdditionalPropertiesType != nil {
+		t.AdditionalProperties = td.Schema.AdditionalPropertiesType.TypeDecl()
+	}
+
+	if enum, ok := enums[td.TypeName]; ok {
+		values := enum.GetValues()
+		constants := enum.HasConstants()
+		for _, name := range SortedStringKeys(values) {
+			value := model.EnumValue{
+				Value:       values[name],
+				Literal:     enum.ValueLiteral(values[name]),
+				Description: td.Schema.EnumDescriptions[values[name]],
+			}
+			if constants {
+				value.Name = name
+			}
+			t.Enum = append(t.Enum, value)
+		}
+	}
+
+	discriminator := td.Schema.Discriminator
+	for _, element := range td.Schema.UnionElements {
+		variant := model.Variant{
+			GoType: string(element),
+			Method: element.Method(),
+		}
+		if discriminator != nil {
+			for _, value := range SortedStringKeys(discriminator.Mapping) {
+				if discriminator.Mapping[value] == string(element) {
+					variant.DiscriminatorValues = append(variant.DiscriminatorValues, value)
+				}
+			}
+		}
+		t.Variants = append(t.Variants, variant)
+	}
+	if discriminator != nil {
+		t.Discriminator = &model.Discriminator{
+			Property: discriminator.Property,
+			Mapping:  make(map[string]string, len(discriminator.Mapping)),
+		}
+		for value, goType := range discriminator.Mapping {
+			t.Discriminator.Mapping[value] = goType
+		}
+	}
+	return t
+}
+
+// modelOperation returns the model of op.
+func modelOperation(op OperationDefinition) model.Operation {
+	o := model.Operation{
+		ID:      op.OperationId,
+		Method:  op.Method,
+		Path:    op.Path,
+		Summary: op.Summary,
+		Spec:    op.Spec,
+	}
+	params := append([]ParameterDefinition{}, op.PathParams...)
+	params = append(params, op.Params()...)
+	for _, pd := range params {
+		o.Parameters = append(o.Parameters, model.Parameter{
+			Name:     pd.ParamName,
+			GoName:   pd.GoName(),
+			In:       pd.In,
+			Required: pd.Required,
+			GoType:   pd.TypeDef(),
+			Schema:   pd.Schema.OAPISchema,
+		})
+	}
+	for _, body := range op.Bodies {
+		b := model.Body{
+			ContentType: body.ContentType,
+			Required:    body.Required,
+			GoType:      body.Schema.TypeDecl(),
+			Schema:      body.Schema.OAPISchema,
+		}
+		if body.IsSupported() {
+			b.TypeName = body.TypeDef(op.OperationId).TypeName
+		}
+		o.Bodies = append(o.Bodies, b)
+	}
+	for _, response := range op.Responses {
+		r := model.Response{
+			StatusCode:  response.StatusCode,
+			Description: response.Description,
+		}
+		for _, content := range response.Contents {
+			r.Contents = append(r.Contents, model.Content{
+				ContentType: content.ContentType,
+				GoType:      content.Schema.TypeDecl(),
+				Schema:      content.Schema.OAPISchema,
+			})
+		}
+		o.Responses = append(o.Responses, r)
+	}
+	return o
+}
+
+// formatGoType returns goType, a type the templates declare, as gofmt
+// formats it, eg, a struct type with its fields on their own lines.
+func formatGoType(goType string) string {
+	const prefix = "package p\n\ntype T "
+	formatted, err := format.Source([]byte(prefix + goType))
+	if err != nil {
+		return goType
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(string(formatted), prefix), "\n")
+}
@@ -0,0 +1,204 @@
+// Package model is the resolved model of a spec, which code is generated
+// from: the Go types defined for its schemas, with their fields, enum values
+// and union variants, and its operations, with their parameters, bodies and
+// responses. codegen.BuildModel produces it, without generating any code, for
+// tools checking the decisions of the generator, eg, that no type exposes
+// interface{}, rather than parsing the generated Go. The code is generated
+// from the same resolution of the spec, so the two can't disagree.
+//
+// The model is kept compatible: its types and fields are only ever added to,
+// and their meaning doesn't change. Those of the codegen package make no such
+// promise.
+package model
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Model is the resolved model of a spec.
+type Model struct {
+	// Types are the Go types defined for the spec, sorted by name.
+	Types []Type
+	// Operations are the operations of the spec, in the order the generated
+	// code lists them.
+	Operations []Operation
+}
+
+// Type returns the type of the model called name, or nil when there's none.
+func (m *Model) Type(name string) *Type {
+	for i := range m.Types {
+		if m.Types[i].Name == name {
+			return &m.Types[i]
+		}
+	}
+	return nil
+}
+
+// Operation returns the operation of the model whose id is id, or nil when
+// there's none.
+func (m *Model) Operation(id string) *Operation {
+	for i := range m.Operations {
+		if m.Operations[i].ID == id {
+			return &m.Operations[i]
+		}
+	}
+	return nil
+}
+
+// Type is a Go type defined for a schema, eg, `type Pet struct {...}`.
+type Type struct {
+	// Name is the name of the Go type.
+	Name string
+	// JSONName is the name of the schema in the spec, eg, the key of a
+	// component, or the path to an inline schema, eg, Cat.Color for the
+	// enum of the color property of Cat, or empty for the types of the
+	// parameters and bodies of the operations.
+	JSONName string
+	// GoType is the Go type the type is defined as, eg, `string`, `[]Pet`,
+	// or, for a struct, the struct type with its fields.
+	GoType string
+	// Alias is set when the type is an alias, eg, `type Pets = []Pet`.
+	Alias       bool
+	Description string
+	// Schema is the schema of the spec the type is generated for.
+	Schema *openapi3.Schema
+
+	// Fields are the fields of a struct, in their order.
+	Fields []Field
+	// AdditionalProperties is the Go type of the additional properties of a
+	// struct having them, or empty.
+	AdditionalProperties string
+	// Enum are the values of an enum, sorted by the names of their constants.
+	Enum []EnumValue
+	// Variants are the members of a oneOf or anyOf union, in their order.
+	Variants []Variant
+	// Discriminator is the discriminator of a union having one.
+	Discriminator *Discriminator
+}
+
+// Field is a field of a struct.
+type Field struct {
+	// Name is the name of the Go field.
+	Name string
+	// JSONName is the name of the property, which the field is marshaled
+	// under, unless x-go-json-ignore leaves it out.
+	JSONName string
+	// GoType is the Go type of the field, eg, `*string` for an optional
+	// string.
+	GoType string
+	// Pointer is set when GoType is a pointer to the type of the schema, as
+	// the property is optional or nullable.
+	Pointer     bool
+	Required    bool
+	Nullable    bool
+	ReadOnly    bool
+	WriteOnly   bool
+	Deprecated  bool
+	Description string
+	// Schema is the schema of the property.
+	Schema *openapi3.Schema
+}
+
+// EnumValue is a value of an enum.
+type EnumValue struct {
+	// Name is the name of the constant of the value, or empty when the
+	// values of the enum aren't generated as constants.
+	Name string
+	// Value is the value as the spec gives it, eg, `cat` or `1`.
+	Value string
+	// Literal is the Go literal of the value, eg, `"cat"` or `1`.
+	Literal     string
+	Description string
+}
+
+// Variant is a member of a union.
+type Variant struct {
+	// GoType is the Go type of the member.
+	GoType string
+	// Method is the suffix of the methods of the union for the member, eg,
+	// Cat for AsCat, FromCat and MergeCat.
+	Method string
+	// DiscriminatorValues are the values of the discriminator identifying
+	// the member, sorted, when the union has a discriminator.
+	DiscriminatorValues []string
+}
+
+// Discriminator is the discriminator of a union.
+type Discriminator struct {
+	// Property is the name of the discriminator property.
+	Property string
+	// Mapping maps the discriminator values to the Go types of the members,
+	// including those only implied by the names of their schemas.
+	Mapping map[string]string
+}
+
+// Operation is an operation of the spec.
+type Operation struct {
+	// ID is the operation id, which the methods of the operation are named
+	// after.
+	ID      string
+	Method  string
+	Path    string
+	Summary string
+	// Spec is the operation of the spec.
+	Spec *openapi3.Operation
+
+	// Parameters are the parameters of the operation, those in the path
+	// first, in their order, then those in the query, headers and cookies.
+	Parameters []Parameter
+	// Bodies are the request bodies of the operation, one per content type.
+	Bodies []Body
+	// Responses are the responses of the operation, sorted by status code,
+	// but for those referencing the same response as an earlier one.
+	Responses []Response
+}
+
+// Parameter is a parameter of an operation.
+type Parameter struct {
+	// Name is the name of the parameter in the spec.
+	Name string
+	// GoName is the name of its field in the parameters struct of the
+	// operation, or of its argument for a path parameter.
+	GoName string
+	// In is where the parameter is: path, query, header or cookie.
+	In       string
+	Required bool
+	// GoType is the Go type of the parameter, without the pointer of an
+	// optional one.
+	GoType string
+	// Schema is the schema of the parameter, or that of its content.
+	Schema *openapi3.Schema
+}
+
+// Body is a request body of an operation, for a content type.
+type Body struct {
+	ContentType string
+	Required    bool
+	// TypeName is the name of the Go type of the body, eg,
+	// AddPetJSONRequestBody, or empty when none is generated for its
+	// content type.
+	TypeName string
+	// GoType is the Go type the body is defined as.
+	GoType string
+	// Schema is the schema of the body.
+	Schema *openapi3.Schema
+}
+
+// Response is a response of an operation.
+type Response struct {
+	// StatusCode is the status code of the response, eg, 200, 2XX or
+	// default.
+	StatusCode  string
+	Description string
+	// Contents are the contents of the response, one per content type.
+	Contents []Content
+}
+
+// Content is the content of a response, for a content type.
+type Content struct {
+	ContentType string
+	// GoType is the Go type of the content.
+	GoType string
+	// Schema is the schema of the content.
+	Schema *openapi3.Schema
+}
@@ -0,0 +1,378 @@
+package codegen
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/v2/pkg/codegen/model"
+)
+
+const petstoreExpanded = "../../examples/petstore-expanded/petstore-expanded.yaml"
+
+func TestBuildModel(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromFile(petstoreExpanded)
+	require.NoError(t, err)
+	m, err := BuildModel(swagger, Configuration{PackageName: "api"})
+	require.NoError(t, err)
+
+	// The schemas are those of the spec.
+	schemas := swagger.Components.Schemas
+	assert.Same(t, schemas["Pet"].Value, m.Type("Pet").Schema)
+	assert.Same(t, schemas["NewPet"].Value.Properties["tag"].Value, m.Type("NewPet").Fields[1].Schema)
+	assert.Same(t, schemas["Error"].Value, m.Operation("FindPets").Responses[1].Contents[0].Schema)
+	assert.Same(t, swagger.Paths.Find("/pets").Post, m.Operation("AddPet").Spec)
+	assert.Same(t, schemas["NewPet"].Value, m.Operation("AddPet").Bodies[0].Schema)
+	assert.Nil(t, m.Type("Dog"))
+	assert.Nil(t, m.Operation("findPets"))
+
+	clearSchemas(m)
+	assert.Equal(t, &model.Model{
+		Types: []model.Type{
+			{Name: "AddPetJSONRequestBody", GoType: "NewPet", Alias: true},
+			{
+				Name:     "Error",
+				JSONName: "Error",
+				GoType:   "struct {\n\t// Code Error code\n\tCode int32 `json:\"code\"`\n\n\t// Message Error message\n\tMessage string `json:\"message\"`\n}",
+				Fields: []model.Field{
+					{Name: "Code", JSONName: "code", GoType: "int32", Required: true, Description: "Error code"},
+					{Name: "Message", JSONName: "message", GoType: "string", Required: true, Description: "Error message"},
+				},
+			},
+			{
+				Name:        "FindPetsParams",
+				GoType:      "struct {\n\t// Tags tags to filter by\n\tTags *[]string `form:\"tags,omitempty\" json:\"tags,omitempty\"`\n\n\t// Limit maximum number of results to return\n\tLimit *int32 `form:\"limit,omitempty\" json:\"limit,omitempty\"`\n}",
+				Description: swagger.Paths.Find("/pets").Get.Description,
+				Fields: []model.Field{
+					{Name: "Tags", JSONName: "tags", GoType: "*[]string", Pointer: true, Description: "tags to filter by"},
+					{Name: "Limit", JSONName: "limit", GoType: "*int32", Pointer: true, Description: "maximum number of results to return"},
+				},
+			},
+			{
+				Name:     "NewPet",
+				JSONName: "NewPet",
+				GoType:   "struct {\n\t// Name Name of the pet\n\tName string `json:\"name\"`\n\n\t// Tag Type of the pet\n\tTag *string `json:\"tag,omitempty\"`\n}",
+				Fields: []model.Field{
+					{Name: "Name", JSONName: "name", GoType: "string", Required: true, Description: "Name of the pet"},
+					{Name: "Tag", JSONName: "tag", GoType: "*string", Pointer: true, Description: "Type of the pet"},
+				},
+			},
+			{
+				Name:     "Pet",
+				JSONName: "Pet",
+				GoType:   "struct {\n\t// Id Unique id of the pet\n\tId int64 `json:\"id\"`\n\n\t// Name Name of the pet\n\tName string `json:\"name\"`\n\n\t// Tag Type of the pet\n\tTag *string `json:\"tag,omitempty\"`\n}",
+				Fields: []model.Field{
+					{Name: "Id", JSONName: "id", GoType: "int64", Required: true, Description: "Unique id of the pet"},
+					{Name: "Name", JSONName: "name", GoType: "string", Required: true, Description: "Name of the pet"},
+					{Name: "Tag", JSONName: "tag", GoType: "*string", Pointer: true, Description: "Type of the pet"},
+				},
+			},
+		},
+		Operations: []model.Operation{
+			{
+				ID: "FindPets", Method: "GET", Path: "/pets", Summary: "Returns all pets",
+				Parameters: []model.Parameter{
+					{Name: "tags", GoName: "Tags", In: "query", GoType: "[]string"},
+					{Name: "limit", GoName: "Limit", In: "query", GoType: "int32"},
+				},
+				Responses: []model.Response{
+					{StatusCode: "200", Description: "pet response", Contents: []model.Content{{ContentType: "application/json", GoType: "[]Pet"}}},
+					{StatusCode: "default", Description: "unexpected error", Contents: []model.Content{{ContentType: "application/json", GoType: "Error"}}},
+				},
+			},
+			{
+				ID: "AddPet", Method: "POST", Path: "/pets", Summary: "Creates a new pet",
+				Bodies: []model.Body{
+					{ContentType: "application/json", Required: true, TypeName: "AddPetJSONRequestBody", GoType: "NewPet"},
+				},
+				Responses: []model.Response{
+					{StatusCode: "200", Description: "pet response", Contents: []model.Content{{ContentType: "application/json", GoType: "Pet"}}},
+					{StatusCode: "default", Description: "unexpected error", Contents: []model.Content{{ContentType: "application/json", GoType: "Error"}}},
+				},
+			},
+			{
+				ID: "DeletePet", Method: "DELETE", Path: "/pets/{id}", Summary: "Deletes a pet by ID",
+				Parameters: []model.Parameter{
+					{Name: "id", GoName: "Id", In: "path", Required: true, GoType: "int64"},
+				},
+				Responses: []model.Response{
+					{StatusCode: "204", Description: "pet deleted"},
+					{StatusCode: "default", Description: "unexpected error", Contents: []model.Content{{ContentType: "application/json", GoType: "Error"}}},
+				},
+			},
+			{
+				ID: "FindPetByID", Method: "GET", Path: "/pets/{id}", Summary: "Returns a pet by ID",
+				Parameters: []model.Parameter{
+					{Name: "id", GoName: "Id", In: "path", Required: true, GoType: "int64"},
+				},
+				Responses: []model.Response{
+					{StatusCode: "200", Description: "pet response", Contents: []model.Content{{ContentType: "application/json", GoType: "Pet"}}},
+					{StatusCode: "default", Description: "unexpected error", Contents: []model.Content{{ContentType: "application/json", GoType: "Error"}}},
+				},
+			},
+		},
+	}, m)
+}
+
+// unionsAndEnumsSpec has a union of two structs, one of them with an enum.
+const unionsAndEnumsSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: model
+paths: {}
+components:
+  schemas:
+    Cat:
+      type: object
+      properties:
+        kind:
+          type: string
+        color:
+          type: string
+          enum: [black, white]
+          x-enum-descriptions: [Like the night, Like the snow]
+    Dog:
+      type: object
+      properties:
+        kind:
+          type: string
+        tags:
+          type: object
+          additionalProperties:
+            type: string
+    Pet:
+      oneOf:
+        - $ref: "#/components/schemas/Cat"
+        - $ref: "#/components/schemas/Dog"
+      discriminator:
+        propertyName: kind
+        mapping:
+          cat: "#/components/schemas/Cat"
+`
+
+func TestBuildModelUnionsAndEnums(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(unionsAndEnumsSpec))
+	require.NoError(t, err)
+	m, err := BuildModel(swagger, Configuration{PackageName: "api", OutputOptions: OutputOptions{SkipPrune: true}})
+	require.NoError(t, err)
+
+	pet := m.Type("Pet")
+	require.NotNil(t, pet)
+	assert.Equal(t, []model.Variant{
+		{GoType: "Cat", Method: "Cat", DiscriminatorValues: []string{"cat"}},
+		{GoType: "Dog", Method: "Dog", DiscriminatorValues: []string{"Dog"}},
+	}, pet.Variants)
+	assert.Equal(t, &model.Discriminator{Property: "kind", Mapping: map[string]string{"cat": "Cat", "Dog": "Dog"}}, pet.Discriminator)
+
+	color := m.Type("CatColor")
+	require.NotNil(t, color)
+	assert.Equal(t, "Cat.Color", color.JSONName)
+	assert.Equal(t, []model.EnumValue{
+		{Name: "Black", Value: "black", Literal: `"black"`, Description: "Like the night"},
+		{Name: "White", Value: "white", Literal: `"white"`, Description: "Like the snow"},
+	}, color.Enum)
+	assert.Equal(t, "*CatColor", m.Type("Cat").Fields[0].GoType)
+
+	assert.Equal(t, "*map[string]string", m.Type("Dog").Fields[1].GoType)
+}
+
+// TestModelMatchesCode checks that the code GenerateFiles generates for the
+// petstore, and for a spec with unions and enums, declares what their models
+// say: the types, field for field, the constants of the enums, the methods of
+// the union variants, and the parameters and JSON responses of the
+// operations.
+func TestModelMatchesCode(t *testing.T) {
+	petstore, err := os.ReadFile(petstoreExpanded)
+	require.NoError(t, err)
+	for name, spec := range map[string]string{
+		"petstore":         string(petstore),
+		"unions and enums": unionsAndEnumsSpec,
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := Configuration{
+				PackageName:   "api",
+				Generate:      GenerateOptions{Models: true, ChiServer: true, Client: true},
+				OutputOptions: OutputOptions{SkipPrune: true},
+			}
+			swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+			require.NoError(t, err)
+			m, err := BuildModel(swagger, cfg)
+			require.NoError(t, err)
+			files, _, err := GenerateFiles(context.Background(), []byte(spec), cfg)
+			require.NoError(t, err)
+			code := string(files["api.gen.go"])
+			assertModelMatchesCode(t, m, code)
+		})
+	}
+}
+
+// assertModelMatchesCode asserts that code declares what m says.
+func assertModelMatchesCode(t *testing.T, m *model.Model, code string) {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "api.gen.go", code, 0)
+	require.NoError(t, err)
+	source := func(node ast.Node) string {
+		return code[node.Pos()-1 : node.End()-1]
+	}
+
+	types := make(map[string]*ast.TypeSpec)
+	constants := make(map[string]*ast.ValueSpec)
+	methods := make(map[string]bool)
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					types[spec.Name.Name] = spec
+				case *ast.ValueSpec:
+					if decl.Tok == token.CONST {
+						constants[spec.Names[0].Name] = spec
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv != nil {
+				receiver := strings.TrimPrefix(source(decl.Recv.List[0].Type), "*")
+				methods[receiver+"."+decl.Name.Name] = true
+			}
+		}
+	}
+	// fields returns the types of the fields of the struct name, by name.
+	fields := func(name string) map[string]string {
+		spec, ok := types[name]
+		if !assert.True(t, ok, "%s isn't declared", name) {
+			return nil
+		}
+		structType, ok := spec.Type.(*ast.StructType)
+		if !assert.True(t, ok, "%s isn't a struct", name) {
+			return nil
+		}
+		fields := make(map[string]string)
+		for _, field := range structType.Fields.List {
+			for _, name := range field.Names {
+				fields[name.Name] = source(field.Type)
+			}
+		}
+		return fields
+	}
+
+	for _, typ := range m.Types {
+		spec, ok := types[typ.Name]
+		if !assert.True(t, ok, "%s isn't declared", typ.Name) {
+			continue
+		}
+		assert.Equal(t, typ.Alias, spec.Assign.IsValid(), typ.Name)
+		if structType, ok := spec.Type.(*ast.StructType); ok && len(typ.Variants) == 0 {
+			require.Len(t, structType.Fields.List, len(typ.Fields), typ.Name)
+			for i, field := range structType.Fields.List {
+				tag, err := strconv.Unquote(field.Tag.Value)
+				require.NoError(t, err)
+				jsonName, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+				assert.Equal(t, typ.Fields[i].Name, field.Names[0].Name)
+				assert.Equal(t, typ.Fields[i].JSONName, jsonName)
+				assert.Equal(t, typ.Fields[i].GoType, source(field.Type))
+			}
+		} else if len(typ.Variants) == 0 {
+			assert.Empty(t, typ.Fields, typ.Name)
+		}
+		for _, value := range typ.Enum {
+			if value.Name == "" {
+				continue
+			}
+			constant, ok := constants[value.Name]
+			if assert.True(t, ok, "%s isn't declared", value.Name) {
+				assert.Equal(t, typ.Name, source(constant.Type), value.Name)
+				assert.Equal(t, value.Literal, source(constant.Values[0]), value.Name)
+			}
+		}
+		for _, variant := range typ.Variants {
+			assert.True(t, methods[typ.Name+".As"+variant.Method], "%s.As%s isn't declared", typ.Name, variant.Method)
+			assert.True(t, methods[typ.Name+".From"+variant.Method], "%s.From%s isn't declared", typ.Name, variant.Method)
+		}
+	}
+
+	// The arguments of the methods of the server interface, after the
+	// response writer and the request, are the path parameters.
+	arguments := make(map[string][]string)
+	if spec, ok := types["ServerInterface"]; assert.True(t, ok, "ServerInterface isn't declared") {
+		for _, method := range spec.Type.(*ast.InterfaceType).Methods.List {
+			for _, argument := range method.Type.(*ast.FuncType).Params.List[2:] {
+				for range argument.Names {
+					arguments[method.Names[0].Name] = append(arguments[method.Names[0].Name], source(argument.Type))
+				}
+			}
+		}
+	}
+
+	for _, op := range m.Operations {
+		var params map[string]string
+		pathParams := 0
+		for _, param := range op.Parameters {
+			if param.In == "path" {
+				if assert.Greater(t, len(arguments[op.ID]), pathParams, "%s parameter %s", op.ID, param.Name) {
+					assert.Equal(t, param.GoType, arguments[op.ID][pathParams], "%s parameter %s", op.ID, param.Name)
+				}
+				pathParams++
+				continue
+			}
+			if params == nil {
+				params = fields(op.ID + "Params")
+			}
+			goType := param.GoType
+			if !param.Required {
+				goType = "*" + goType
+			}
+			assert.Equal(t, goType, params[param.GoName], "%s parameter %s", op.ID, param.Name)
+		}
+		var responses map[string]string
+		for _, response := range op.Responses {
+			for _, content := range response.Contents {
+				if content.ContentType != "application/json" {
+					continue
+				}
+				if responses == nil {
+					responses = fields(op.ID + "Response")
+				}
+				field := "JSON" + UppercaseFirstCharacter(response.StatusCode)
+				assert.Equal(t, "*"+content.GoType, responses[field], "%s response %s", op.ID, response.StatusCode)
+			}
+		}
+	}
+}
+
+// clearSchemas clears the schemas of m, which point into the spec.
+func clearSchemas(m *model.Model) {
+	for i := range m.Types {
+		m.Types[i].Schema = nil
+		for j := range m.Types[i].Fields {
+			m.Types[i].Fields[j].Schema = nil
+		}
+	}
+	for i := range m.Operations {
+		op := &m.Operations[i]
+		op.Spec = nil
+		for j := range op.Parameters {
+			op.Parameters[j].Schema = nil
+		}
+		for j := range op.Bodies {
+			op.Bodies[j].Schema = nil
+		}
+		for j := range op.Responses {
+			for k := range op.Responses[j].Contents {
+				op.Responses[j].Contents[k].Schema = nil
+			}
+		}
+	}
+}
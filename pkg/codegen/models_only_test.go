@@ -0,0 +1,116 @@
+package codegen
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/imports"
+)
+
+// generateModels generates the models of the spec at path on their own.
+func generateModels(t *testing.T, path string, opts OutputOptions) string {
+	t.Helper()
+	spec, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return generateModelsFromData(t, spec, opts)
+}
+
+func generateModelsFromData(t *testing.T, spec []byte, opts OutputOptions) string {
+	t.Helper()
+	swagger, err := openapi3.NewLoader().LoadFromData(spec)
+	require.NoError(t, err)
+	code, err := Generate(swagger, Configuration{
+		PackageName:   "models",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: opts,
+	})
+	require.NoError(t, err)
+	return code
+}
+
+// checkModelsOnly checks that code, generated models, compiles on its own,
+// importing only the standard library, none of it for HTTP, and that
+// goimports leaves it as it is, having no unused or missing imports.
+func checkModelsOnly(t *testing.T, code string) {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "models.gen.go", code, 0)
+	require.NoError(t, err)
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		require.NoError(t, err)
+		assert.NotContains(t, path, ".", "models import %s", path)
+		assert.False(t, strings.HasPrefix(path, "net/"), "models import %s", path)
+	}
+
+	formatted, err := imports.Process("models.gen.go", []byte(code), nil)
+	require.NoError(t, err)
+	assert.Equal(t, code, string(formatted))
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = conf.Check("models", fset, []*ast.File{file}, nil)
+	require.NoError(t, err)
+}
+
+func TestModelsOnly(t *testing.T) {
+	t.Run("petstore-expanded", func(t *testing.T) {
+		code := generateModels(t, "../../examples/petstore-expanded/petstore-expanded.yaml", OutputOptions{})
+		assert.Contains(t, code, "type NewPet struct {")
+		checkModelsOnly(t, code)
+	})
+
+	t.Run("unions", func(t *testing.T) {
+		// The Merge methods of the unions are the only helpers needing the
+		// runtime.
+		code := generateModels(t, "../../internal/test/components/components.yaml", OutputOptions{SkipPrune: true})
+		assert.Contains(t, code, `"github.com/oapi-codegen/runtime"`)
+		assert.Contains(t, code, "func (t *OneOfObject1) MergeOneOfVariant1(v OneOfVariant1) error {")
+
+		code = generateModels(t, "../../internal/test/components/components.yaml", OutputOptions{SkipPrune: true, SkipUnionMergeMethods: true})
+		assert.NotContains(t, code, "runtime.JSONMerge")
+		assert.Contains(t, code, "func (t OneOfObject1) AsOneOfVariant1() (OneOfVariant1, error) {")
+		assert.Contains(t, code, "func (t *OneOfObject1) FromOneOfVariant1(v OneOfVariant1) error {")
+		assert.Contains(t, code, "func (a AdditionalPropertiesObject1) MarshalJSON() ([]byte, error) {")
+		checkModelsOnly(t, code)
+	})
+	t.Run("validation", func(t *testing.T) {
+		code := generateModelsFromData(t, []byte(`
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: models-only
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        tags:
+          type: array
+          uniqueItems: true
+          minItems: 1
+          items:
+            type: string
+        labels:
+          type: object
+          maxProperties: 3
+          additionalProperties:
+            type: string
+`), OutputOptions{SkipPrune: true, ModelValidation: true})
+		assert.Contains(t, code, "func (t Pet) Validate() error {")
+		checkModelsOnly(t, code)
+	})
+}
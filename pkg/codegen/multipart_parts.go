@@ -0,0 +1,117 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/deepmap/oapi-codegen/v2/pkg/util"
+)
+
+// generateMultipartParts returns the parts of content, a multipart content,
+// when its schema is an object with properties. Otherwise there are none, and
+// its body is only kept as it is.
+func (g *generator) generateMultipartParts(content *openapi3.MediaType) ([]MultipartPartDefinition, error) {
+	if content.Schema == nil || content.Schema.Value == nil {
+		return nil, nil
+	}
+	schema := *content.Schema.Value
+	if len(schema.AllOf) != 0 {
+		merged, err := g.mergeAllOf(schema.AllOf, nil)
+		if err != nil {
+			return nil, err
+		}
+		schema = merged
+	}
+	if len(schema.OneOf) != 0 || len(schema.AnyOf) != 0 || len(schema.Properties) == 0 {
+		return nil, nil
+	}
+
+	var parts []MultipartPartDefinition
+	for _, name := range g.orderedPropertyNames(&schema) {
+		var property *openapi3.Schema
+		if ref := schema.Properties[name]; ref != nil {
+			property = ref.Value
+		}
+		part := MultipartPartDefinition{
+			Name:     name,
+			Kind:     "JSON",
+			Required: StringInArray(name, schema.Required),
+		}
+		switch {
+		case property == nil:
+		case property.Type == "string" && property.Format == "binary":
+			part.Kind = "Binary"
+			part.ContentType = "application/octet-stream"
+		case property.Type == "string":
+			part.Kind = "Text"
+			part.ContentType = "text/plain; charset=utf-8"
+		case property.Type == "object" || property.Type == "array" || property.Type == "":
+			part.ContentType = "application/json"
+		default:
+			// The primitives are written as their text, which is their JSON.
+			part.ContentType = "text/plain; charset=utf-8"
+		}
+		if encoding := content.Encoding[name]; encoding != nil && encoding.ContentType != "" {
+			// The encoding may list alternatives, of which the first is the
+			// one written.
+			contentType := strings.TrimSpace(strings.Split(encoding.ContentType, ",")[0])
+			if util.IsMediaTypeJson(contentType) {
+				part.Kind = "JSON"
+			}
+			part.ContentType = contentType
+		}
+		parts = append(parts, part)
+	}
+	return parts, nil
+}
+
+// genMultipartParts generates the literal of the []multipartPart describing
+// parts.
+func genMultipartParts(parts []MultipartPartDefinition) string {
+	literals := make([]string, 0, len(parts))
+	for _, part := range parts {
+		literal := fmt.Sprintf("{Name: %q, Kind: multipart%s", part.Name, part.Kind)
+		if part.ContentType != "" {
+			literal += fmt.Sprintf(", ContentType: %q", part.ContentType)
+		}
+		if part.Required {
+			literal += ", Required: true"
+		}
+		literals = append(literals, literal+"}")
+	}
+	return "[]multipartPart{" + strings.Join(literals, ", ") + "}"
+}
+
+// genReadMultipartParts generates the call reading the parts of the multipart
+// body bodyBytes of a response into dest.
+func (g *generator) genReadMultipartParts(parts []MultipartPartDefinition) string {
+	g.readsMultipartParts = true
+	return fmt.Sprintf("readMultipartParts(rsp.Header.Get(\"Content-Type\"), bodyBytes, %s, &dest)", genMultipartParts(parts))
+}
+
+// genWriteMultipartParts generates the call writing the fields of the value
+// parts as the parts of writer, that of a multipart body of contentType.
+func (g *generator) genWriteMultipartParts(contentType string, parts []MultipartPartDefinition) string {
+	g.writesMultipartParts = true
+	return fmt.Sprintf("writeMultipartParts(writer, %q, parts, %s)", contentType, genMultipartParts(parts))
+}
+
+// MultipartHelpers tells which of the helpers reading and writing the parts
+// of multipart bodies multipart-parts.tmpl generates.
+type MultipartHelpers struct {
+	Read  bool
+	Write bool
+}
+
+// generateMultipartHelpers generates the helpers reading the parts of
+// multipart responses into the fields of their type, and writing them from
+// them, which the clients and the strict servers generated so far need.
+func (g *generator) generateMultipartHelpers(t *template.Template) (string, error) {
+	return GenerateTemplates([]string{"multipart-parts.tmpl"}, t, MultipartHelpers{
+		Read:  g.readsMultipartParts,
+		Write: g.writesMultipartParts,
+	})
+}
@@ -0,0 +1,238 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// OperationSchemaDefinition describes an accessor returning the JSON Schema of
+// a request body or response of an operation.
+type OperationSchemaDefinition struct {
+	Name        string // The name of the accessor, eg, GetCreatePetJSONRequestSchema
+	OperationId string
+	ContentType string
+	StatusCode  string // The status code of responses, empty for request bodies
+	Schema      string // The JSON encoding of the schema, with its references resolved
+}
+
+// SchemaLiteral returns Schema as a Go string literal, raw unless the schema
+// holds a backquote.
+func (d OperationSchemaDefinition) SchemaLiteral() string {
+	if strings.Contains(d.Schema, "`") {
+		return strconv.Quote(d.Schema)
+	}
+	return "`" + d.Schema + "`"
+}
+
+// OperationSchemaDefinitions lists the accessors of the schemas of the request
+// bodies and responses of the given operations, for the content types which
+// are named in the generated code. References are inlined up to refDepth
+// nested ones, or all of them when it's 0, and the remaining ones, along with
+// those making cycles, point to the $defs of the schema.
+func OperationSchemaDefinitions(operations []OperationDefinition, refDepth int) ([]OperationSchemaDefinition, error) {
+	var definitions []OperationSchemaDefinition
+
+	add := func(op OperationDefinition, statusCode string, content openapi3.Content, suffix string) error {
+		contentTypes := SortedContentKeys(content)
+		tags, err := contentTypeNames(contentTypes, contentTypeTag)
+		if err != nil {
+			return err
+		}
+		for _, contentType := range contentTypes {
+			tag, found := tags[contentType]
+			if !found || content[contentType].Schema == nil {
+				continue
+			}
+			schema, err := resolveOperationSchema(content[contentType].Schema, refDepth)
+			if err != nil {
+				return fmt.Errorf("error resolving the schema of %s: %w", contentType, err)
+			}
+			definitions = append(definitions, OperationSchemaDefinition{
+				Name:        "Get" + op.OperationId + ToCamelCase(statusCode) + tag + suffix,
+				OperationId: op.OperationId,
+				ContentType: contentType,
+				StatusCode:  statusCode,
+				Schema:      schema,
+			})
+		}
+		return nil
+	}
+
+	for _, op := range operations {
+		if op.Spec == nil {
+			continue
+		}
+		if body := op.Spec.RequestBody; body != nil && body.Value != nil {
+			if err := add(op, "", body.Value.Content, "RequestSchema"); err != nil {
+				return nil, fmt.Errorf("error generating the request schemas of %s: %w", op.OperationId, err)
+			}
+		}
+		if op.Spec.Responses == nil {
+			continue
+		}
+		responses := op.Spec.Responses.Map()
+		for _, statusCode := range SortedResponsesKeys(responses) {
+			response := responses[statusCode]
+			if response == nil || response.Value == nil {
+				continue
+			}
+			if err := add(op, statusCode, response.Value.Content, "ResponseSchema"); err != nil {
+				return nil, fmt.Errorf("error generating the schemas of response %s of %s: %w", statusCode, op.OperationId, err)
+			}
+		}
+	}
+	return definitions, nil
+}
+
+// GenerateOperationSchemas generates the accessors returning the JSON Schema
+// of the request bodies and responses of the operations.
+func GenerateOperationSchemas(t *template.Template, operations []OperationDefinition, opts Configuration) (string, error) {
+	definitions, err := OperationSchemaDefinitions(operations, opts.OutputOptions.OperationSchemaRefDepth)
+	if err != nil {
+		return "", err
+	}
+	return GenerateTemplates([]string{"operation-schemas.tmpl"}, t, definitions)
+}
+
+// schemaResolver inlines the references of a schema, collecting the schemas
+// which aren't inlined under $defs.
+type schemaResolver struct {
+	refDepth int
+	// defNames maps the references which are put under $defs to their name
+	// there.
+	defNames map[string]string
+	defs     map[string]interface{}
+}
+
+// resolveOperationSchema returns the JSON encoding of the given schema with
+// its references resolved, as described in OperationSchemaDefinitions.
+func resolveOperationSchema(sref *openapi3.SchemaRef, refDepth int) (string, error) {
+	r := schemaResolver{
+		refDepth: refDepth,
+		defNames: make(map[string]string),
+		defs:     make(map[string]interface{}),
+	}
+	resolved, err := r.resolve(sref, nil)
+	if err != nil {
+		return "", err
+	}
+	if len(r.defs) != 0 {
+		resolved["$defs"] = r.defs
+	}
+	buf, err := json.Marshal(resolved)
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+// resolve returns the given schema as a JSON object, where refs holds the
+// references which were inlined to get to it.
+func (r *schemaResolver) resolve(sref *openapi3.SchemaRef, refs []string) (map[string]interface{}, error) {
+	if sref.Ref != "" {
+		inline := !StringInArray(sref.Ref, refs) && (r.refDepth == 0 || len(refs) < r.refDepth)
+		if !inline {
+			name, err := r.def(sref)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{"$ref": "#/$defs/" + name}, nil
+		}
+		refs = append(refs, sref.Ref)
+	}
+	if sref.Value == nil {
+		return nil, fmt.Errorf("unresolved reference %s", sref.Ref)
+	}
+	return r.resolveValue(sref.Value, refs)
+}
+
+// def returns the name of the given reference under $defs, resolving it there
+// the first time it's met.
+func (r *schemaResolver) def(sref *openapi3.SchemaRef) (string, error) {
+	if name, found := r.defNames[sref.Ref]; found {
+		return name, nil
+	}
+	base := sref.Ref[strings.LastIndex(sref.Ref, "/")+1:]
+	name := base
+	for i := 2; r.defs[name] != nil; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	r.defNames[sref.Ref] = name
+	// Reserve the name while the definition is resolved, as it may refer to
+	// itself.
+	r.defs[name] = struct{}{}
+	def, err := r.resolve(&openapi3.SchemaRef{Value: sref.Value}, []string{sref.Ref})
+	if err != nil {
+		return "", err
+	}
+	r.defs[name] = def
+	return name, nil
+}
+
+// resolveValue returns the JSON encoding of the given schema as an object,
+// with its subschemas resolved.
+func (r *schemaResolver) resolveValue(s *openapi3.Schema, refs []string) (map[string]interface{}, error) {
+	buf, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]interface{}
+	if err := json.Unmarshal(buf, &out); err != nil {
+		return nil, err
+	}
+
+	resolveList := func(key string, srefs openapi3.SchemaRefs) error {
+		if len(srefs) == 0 {
+			return nil
+		}
+		list := make([]interface{}, len(srefs))
+		for i, sref := range srefs {
+			resolved, err := r.resolve(sref, refs)
+			if err != nil {
+				return err
+			}
+			list[i] = resolved
+		}
+		out[key] = list
+		return nil
+	}
+	// Subschemas are resolved in a fixed order, which decides the names of
+	// the $defs when they clash.
+	if err := resolveList("allOf", s.AllOf); err != nil {
+		return nil, err
+	}
+	if err := resolveList("anyOf", s.AnyOf); err != nil {
+		return nil, err
+	}
+	if err := resolveList("oneOf", s.OneOf); err != nil {
+		return nil, err
+	}
+
+	for _, sub := range []struct {
+		key  string
+		sref *openapi3.SchemaRef
+	}{{"not", s.Not}, {"items", s.Items}, {"additionalProperties", s.AdditionalProperties.Schema}} {
+		if sub.sref == nil {
+			continue
+		}
+		if out[sub.key], err = r.resolve(sub.sref, refs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(s.Properties) != 0 {
+		properties := make(map[string]interface{}, len(s.Properties))
+		for _, name := range SortedSchemaKeys(s.Properties) {
+			if properties[name], err = r.resolve(s.Properties[name], refs); err != nil {
+				return nil, fmt.Errorf("error resolving property %s: %w", name, err)
+			}
+		}
+		out["properties"] = properties
+	}
+	return out, nil
+}
@@ -0,0 +1,41 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveOperationSchema(t *testing.T) {
+	owner := &openapi3.SchemaRef{Ref: "#/components/schemas/Owner", Value: openapi3.NewStringSchema()}
+	otherOwner := &openapi3.SchemaRef{Ref: "other.yaml#/components/schemas/Owner", Value: openapi3.NewIntegerSchema()}
+	newPet := &openapi3.SchemaRef{Ref: "#/components/schemas/NewPet", Value: openapi3.NewObjectSchema().
+		WithPropertyRef("owner", owner).
+		WithPropertyRef("previousOwner", otherOwner)}
+	pet := &openapi3.SchemaRef{Ref: "#/components/schemas/Pet", Value: openapi3.NewAllOfSchema(newPet.Value)}
+	pet.Value.AllOf[0] = newPet
+
+	got, err := resolveOperationSchema(pet, 0)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"allOf":[{"type":"object","properties":{"owner":{"type":"string"},"previousOwner":{"type":"integer"}}}]}`, got)
+
+	got, err = resolveOperationSchema(pet, 2)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"allOf":[{"type":"object","properties":{"owner":{"$ref":"#/$defs/Owner"},"previousOwner":{"$ref":"#/$defs/Owner2"}}}],
+		"$defs":{"Owner":{"type":"string"},"Owner2":{"type":"integer"}}
+	}`, got)
+
+	// The $defs don't inline references any deeper.
+	got, err = resolveOperationSchema(pet, 1)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"allOf":[{"$ref":"#/$defs/NewPet"}],
+		"$defs":{
+			"NewPet":{"type":"object","properties":{"owner":{"$ref":"#/$defs/Owner"},"previousOwner":{"$ref":"#/$defs/Owner2"}}},
+			"Owner":{"type":"string"},"Owner2":{"type":"integer"}
+		}
+	}`, got)
+}
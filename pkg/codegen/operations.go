@@ -16,6 +16,7 @@ package codegen
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
@@ -34,6 +35,25 @@ type ParameterDefinition struct {
 	Required  bool   // Is this a required parameter?
 	Spec      *openapi3.Parameter
 	Schema    Schema
+
+	// goNameSuffix disambiguates the Go name of a parameter which has the
+	// same name as another parameter of the operation, in another location.
+	goNameSuffix string
+	// routeName is the name of a path parameter in the path the servers
+	// route the operation under, when it isn't its own, see
+	// canonicalizeRoutePaths.
+	routeName string
+	// defaultValue is the Go expression of the default of an optional
+	// parameter, for the param-defaults output option.
+	defaultValue string
+	// strictDates and enumText are set with the strict-dates compatibility
+	// option, and the enum-text-interfaces output option, see IsDate and
+	// IsEnumText.
+	strictDates bool
+	enumText    bool
+	// correlationHeader is set on the header parameter named by the
+	// correlation-header output option.
+	correlationHeader bool
 }
 
 // TypeDef is here as an adapter after a large refactoring so that I don't
@@ -80,7 +100,108 @@ func (pd *ParameterDefinition) IsPassThrough() bool {
 
 func (pd *ParameterDefinition) IsStyled() bool {
 	p := pd.Spec
-	return p.Schema != nil
+	return p.Schema != nil && !pd.IsHTTPDate() && !pd.IsDate()
+}
+
+// HasUniqueItems reports whether the parameter is an array whose schema
+// requires its items to be unique.
+func (pd *ParameterDefinition) HasUniqueItems() bool {
+	p := pd.Spec
+	return p.Schema != nil && p.Schema.Value != nil && schemaType(p.Schema.Value) == "array" && p.Schema.Value.UniqueItems
+}
+
+// IsFormObject reports whether the parameter is an object in the query
+// styled as a non-exploded form, eg, color=R,100,G,200, which is styled and
+// bound by the generated code, as the runtime can't bind it back. Objects with
+// nested objects or arrays are left to the runtime, since OpenAPI doesn't
+// define how to style them.
+func (pd *ParameterDefinition) IsFormObject() bool {
+	return pd.isNonExplodedFormObject() && !hasNestedProperties(pd.Spec.Schema.Value)
+}
+
+func (pd *ParameterDefinition) isNonExplodedFormObject() bool {
+	p := pd.Spec
+	return pd.In == "query" && p.Schema != nil && p.Schema.Value != nil && schemaType(p.Schema.Value) == "object" &&
+		pd.Style() == "form" && !pd.Explode()
+}
+
+// IsExplodedFormObject reports whether the parameter is an object in the
+// query styled as an exploded form, eg, limit=10&offset=20, which is bound by
+// the generated code, so that the properties present are bound even when
+// others are missing, and required properties are checked. Objects with
+// nested objects or arrays, or with additional properties, are left to the
+// runtime.
+func (pd *ParameterDefinition) IsExplodedFormObject() bool {
+	p := pd.Spec
+	if pd.In != "query" || p.Schema == nil || p.Schema.Value == nil || pd.Style() != "form" || !pd.Explode() {
+		return false
+	}
+	s := p.Schema.Value
+	return schemaType(s) == "object" && len(s.Properties) != 0 &&
+		!SchemaHasAdditionalProperties(s) && !hasNestedProperties(s)
+}
+
+// IsDeepObject reports whether the parameter is in the query styled as a
+// deepObject, eg, filter[name]=Alex, other than with the indexed-deep-object
+// query style. Its keys are checked by the generated code before the runtime
+// binds it, as the runtime panics on some malformed ones.
+func (pd *ParameterDefinition) IsDeepObject() bool {
+	return pd.In == "query" && pd.Style() == "deepObject" && !pd.IsIndexedDeepObject()
+}
+
+// RequiredProperties returns the names of the required properties of an
+// object parameter.
+func (pd *ParameterDefinition) RequiredProperties() []string {
+	p := pd.Spec
+	if p.Schema == nil || p.Schema.Value == nil {
+		return nil
+	}
+	return p.Schema.Value.Required
+}
+
+// hasNestedProperties returns whether the given object schema has properties,
+// or additional ones, which are objects or arrays.
+func hasNestedProperties(s *openapi3.Schema) bool {
+	nested := func(sref *openapi3.SchemaRef) bool {
+		return sref != nil && sref.Value != nil &&
+			(schemaType(sref.Value) == "object" || schemaType(sref.Value) == "array")
+	}
+	for _, property := range s.Properties {
+		if nested(property) {
+			return true
+		}
+	}
+	return nested(s.AdditionalProperties.Schema)
+}
+
+// IsHTTPDate reports whether the parameter is a date-time header, such as
+// If-Modified-Since, which HTTP formats as an HTTP-date rather than RFC 3339.
+func (pd *ParameterDefinition) IsHTTPDate() bool {
+	return pd.In == "header" && pd.Spec.Schema != nil && pd.Schema.GoType == "time.Time"
+}
+
+// IsDate reports whether the parameter is a date which, with the
+// strict-dates compatibility option, the generated code formats and parses
+// itself, rather than the runtime, so that the date is never moved by a
+// location, and dates with a time or zone component are rejected with a
+// *StrictDateError. Only the styles a date is written as it is with are, ie,
+// form for queries, and simple for paths and headers.
+func (pd *ParameterDefinition) IsDate() bool {
+	p := pd.Spec
+	if !pd.strictDates || p.Schema == nil || p.Schema.Value == nil || len(p.Content) != 0 {
+		return false
+	}
+	s := p.Schema.Value
+	if _, ok := s.Extensions[extPropGoType]; ok || schemaType(s) != "string" || s.Format != "date" {
+		return false
+	}
+	switch pd.In {
+	case "query":
+		return pd.Style() == "form"
+	case "path", "header":
+		return pd.Style() == "simple"
+	}
+	return false
 }
 
 func (pd *ParameterDefinition) Style() string {
@@ -132,7 +253,17 @@ func (pd ParameterDefinition) GoName() string {
 			goName = extGoFieldName
 		}
 	}
-	return SchemaNameToTypeName(goName)
+	return SchemaNameToTypeName(goName) + pd.goNameSuffix
+}
+
+// RouteName returns the name the servers look the path parameter up under in
+// the route, which is its own, unless the operation is routed under the path
+// of another.
+func (pd ParameterDefinition) RouteName() string {
+	if pd.routeName != "" {
+		return pd.routeName
+	}
+	return pd.ParamName
 }
 
 func (pd ParameterDefinition) IndirectOptional() bool {
@@ -154,11 +285,16 @@ func (p ParameterDefinitions) FindByName(name string) *ParameterDefinition {
 // descriptors into a flat list. This makes it a lot easier to traverse the
 // data in the template engine.
 func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterDefinition, error) {
+	return exportedGenerator().describeParameters(params, path)
+}
+
+// describeParameters does the work of DescribeParameters.
+func (g *generator) describeParameters(params openapi3.Parameters, path []string) ([]ParameterDefinition, error) {
 	outParams := make([]ParameterDefinition, 0)
 	for _, paramOrRef := range params {
-		param := paramOrRef.Value
+		param := parameterOfRef(paramOrRef)
 
-		goType, err := paramToGoType(param, append(path, param.Name))
+		goType, err := g.paramToGoType(param, append(path, param.Name))
 		if err != nil {
 			return nil, fmt.Errorf("error generating type for param (%s): %s",
 				param.Name, err)
@@ -170,13 +306,17 @@ func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterD
 			Required:  param.Required,
 			Spec:      param,
 			Schema:    goType,
+
+			strictDates:       g.options.Compatibility.StrictDates,
+			enumText:          g.options.OutputOptions.EnumTextInterfaces,
+			correlationHeader: param.In == "header" && g.isCorrelationHeader(param.Name),
 		}
 
 		// If this is a reference to a predefined type, simply use the reference
 		// name as the type. $ref: "#/components/schemas/custom_type" becomes
 		// "CustomType".
 		if IsGoTypeReference(paramOrRef.Ref) {
-			goType, err := RefPathToGoType(paramOrRef.Ref)
+			goType, err := g.refPathToGoType(paramOrRef.Ref, true)
 			if err != nil {
 				return nil, fmt.Errorf("error dereferencing (%s) for param (%s): %s",
 					paramOrRef.Ref, param.Name, err)
@@ -188,6 +328,66 @@ func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterD
 	return outParams, nil
 }
 
+// disambiguateParameterNames suffixes the Go name of a parameter with its
+// location when another parameter of the operation already has that name, eg,
+// an "id" query parameter next to an "id" path parameter becomes IdQuery. Path
+// parameters keep their name first, then query, header and cookie ones.
+func (g *generator) disambiguateParameterNames(location string, params []ParameterDefinition) {
+	seen := make(map[string]bool)
+	for _, in := range []string{"path", "query", "header", "cookie"} {
+		for i := range params {
+			if params[i].In != in {
+				continue
+			}
+			if name := params[i].GoName(); seen[name] {
+				params[i].goNameSuffix = UppercaseFirstCharacter(in)
+				g.addWarning(location, "%s parameter %q has the same name as another parameter, generating it as %s; use %s to choose its name",
+					in, params[i].ParamName, params[i].GoName(), extGoName)
+			}
+			seen[params[i].GoName()] = true
+		}
+	}
+}
+
+// disambiguateRequestObjectNames suffixes the Go name of a path parameter with
+// Path when it collides with another field of the request object of the
+// strict server, which are Params, ContentType and those of the bodies, eg, a
+// "body" path parameter of an operation with a body becomes BodyPath.
+func (g *generator) disambiguateRequestObjectNames(location string, op *OperationDefinition) {
+	taken := make(map[string]bool)
+	if op.RequiresParamObject() {
+		taken["Params"] = true
+	}
+	if op.HasMaskedRequestContentTypes() {
+		taken["ContentType"] = true
+	}
+	for _, body := range op.Bodies {
+		if len(op.Bodies) > 1 {
+			taken[body.NameTag+"Body"] = true
+		} else {
+			taken["Body"] = true
+		}
+	}
+	for i := range op.PathParams {
+		if name := op.PathParams[i].GoName(); taken[name] {
+			op.PathParams[i].goNameSuffix = "Path"
+			g.addWarning(location, "path parameter %q has the same name as a field of the strict request object, generating it as %s; use %s to choose its name",
+				op.PathParams[i].ParamName, op.PathParams[i].GoName(), extGoName)
+		}
+	}
+}
+
+// warnNestedFormObjects warns about the query parameters which are objects
+// styled as a non-exploded form, but hold nested objects or arrays.
+func (g *generator) warnNestedFormObjects(location string, params []ParameterDefinition) {
+	for _, param := range params {
+		if param.isNonExplodedFormObject() && !param.IsFormObject() {
+			g.addWarning(location, "query parameter %q is a non-exploded form object with nested objects or arrays, which OpenAPI doesn't define a style for; it's left to the runtime, which can't bind it back",
+				param.ParamName)
+		}
+	}
+}
+
 type SecurityDefinition struct {
 	ProviderName string
 	Scopes       []string
@@ -223,6 +423,23 @@ type OperationDefinition struct {
 	Method              string                  // GET, POST, DELETE, etc.
 	Path                string                  // The Swagger path for the operation, like /resource/{id}
 	Spec                *openapi3.Operation
+
+	// SecurityRequirements are the security requirements of the operation,
+	// or else of the spec, any one of which must be met.
+	SecurityRequirements openapi3.SecurityRequirements
+
+	// routePath is the path the servers route the operation under, when it
+	// isn't its own, see canonicalizeRoutePaths.
+	routePath string
+}
+
+// RoutePath returns the path the servers route the operation under, which is
+// its own, unless an earlier operation has an equivalent one.
+func (o OperationDefinition) RoutePath() string {
+	if o.routePath != "" {
+		return o.routePath
+	}
+	return o.Path
 }
 
 // Params returns the list of all parameters except Path parameters. Path parameters
@@ -248,6 +465,21 @@ func (o *OperationDefinition) RequiresParamObject() bool {
 	return len(o.Params()) > 0
 }
 
+// HasParamsAccessor is called by the template engine to determine whether the
+// strict request object gets a Params method returning its embedded
+// parameters, which it doesn't when a path parameter is already named Params.
+func (o *OperationDefinition) HasParamsAccessor() bool {
+	if !o.RequiresParamObject() {
+		return false
+	}
+	for _, param := range o.PathParams {
+		if param.GoName() == "Params" {
+			return false
+		}
+	}
+	return true
+}
+
 // HasBody is called by the template engine to determine whether to generate body
 // marshaling code on the client. This is true for all body types, whether
 // we generate types for them.
@@ -255,6 +487,12 @@ func (o *OperationDefinition) HasBody() bool {
 	return o.Spec.RequestBody != nil
 }
 
+// HasOptionalBody returns whether the request body of the operation isn't
+// required, in which case the client can send the request without one.
+func (o *OperationDefinition) HasOptionalBody() bool {
+	return o.HasBody() && !o.BodyRequired
+}
+
 // SummaryAsComment returns the Operations summary as a multi line comment
 func (o *OperationDefinition) SummaryAsComment() string {
 	if o.Summary == "" {
@@ -273,6 +511,11 @@ func (o *OperationDefinition) SummaryAsComment() string {
 // response object for automatic deserialization of responses in the generated
 // Client code. See "client-with-responses.tmpl".
 func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefinition, error) {
+	return exportedGenerator().responseTypeDefinitions(o)
+}
+
+// responseTypeDefinitions does the work of GetResponseTypeDefinitions.
+func (g *generator) responseTypeDefinitions(o *OperationDefinition) ([]ResponseTypeDefinition, error) {
 	var tds []ResponseTypeDefinition
 
 	if o.Spec == nil || o.Spec.Responses == nil {
@@ -284,58 +527,44 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 		responseRef := o.Spec.Responses.Value(responseName)
 
 		// We can only generate a type if we have a value:
-		if responseRef.Value != nil {
+		if response := responseOfRef(responseRef); response != nil {
 			jsonCount := 0
-			for mediaType := range responseRef.Value.Content {
+			for mediaType := range response.Content {
 				if util.IsMediaTypeJson(mediaType) {
 					jsonCount++
 				}
 			}
 
-			sortedContentKeys := SortedContentKeys(responseRef.Value.Content)
+			sortedContentKeys := SortedContentKeys(response.Content)
+			names, err := contentTypeNames(sortedContentKeys, responseFieldPrefix)
+			if err != nil {
+				return nil, fmt.Errorf("error naming the contents of response %s of %s: %w", responseName, o.OperationId, err)
+			}
+
 			for _, contentTypeName := range sortedContentKeys {
-				contentType := responseRef.Value.Content[contentTypeName]
+				contentType := response.Content[contentTypeName]
 				// We can only generate a type if we have a schema:
 				if contentType.Schema != nil {
-					responseSchema, err := GenerateGoSchema(contentType.Schema, []string{responseName})
+					responseSchema, err := g.generateGoSchema(contentType.Schema, []string{responseName})
 					if err != nil {
 						return nil, fmt.Errorf("Unable to determine Go type for %s.%s: %w", o.OperationId, contentTypeName, err)
 					}
 
-					var typeName string
-					switch {
-
-					// HAL+JSON:
-					case StringInArray(contentTypeName, contentTypesHalJSON):
-						typeName = fmt.Sprintf("HALJSON%s", ToCamelCase(responseName))
-					case "application/json" == contentTypeName:
-						// if it's the standard application/json
-						typeName = fmt.Sprintf("JSON%s", ToCamelCase(responseName))
-					// Vendored JSON
-					case StringInArray(contentTypeName, contentTypesJSON) || util.IsMediaTypeJson(contentTypeName):
-						baseTypeName := fmt.Sprintf("%s%s", ToCamelCase(contentTypeName), ToCamelCase(responseName))
-
-						typeName = strings.ReplaceAll(baseTypeName, "Json", "JSON")
-					// YAML:
-					case StringInArray(contentTypeName, contentTypesYAML):
-						typeName = fmt.Sprintf("YAML%s", ToCamelCase(responseName))
-					// XML:
-					case StringInArray(contentTypeName, contentTypesXML):
-						typeName = fmt.Sprintf("XML%s", ToCamelCase(responseName))
-					default:
+					name, found := names[contentTypeName]
+					if !found {
 						continue
 					}
 
 					td := ResponseTypeDefinition{
 						TypeDefinition: TypeDefinition{
-							TypeName: typeName,
+							TypeName: name + ToCamelCase(responseName),
 							Schema:   responseSchema,
 						},
 						ResponseName:    responseName,
 						ContentTypeName: contentTypeName,
 					}
 					if IsGoTypeReference(responseRef.Ref) {
-						refType, err := RefPathToGoType(responseRef.Ref)
+						refType, err := g.refPathToGoType(responseRef.Ref, true)
 						if err != nil {
 							return nil, fmt.Errorf("error dereferencing response Ref: %w", err)
 						}
@@ -352,6 +581,96 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 	return tds, nil
 }
 
+// RawResponseContent is a content type of a response of an operation, eg,
+// image/png, which the client has no type to decode, and whose body it holds
+// as is instead.
+type RawResponseContent struct {
+	FieldName    string // The name of the field holding the body, eg, BodyImagePng200
+	ResponseName string // The status code of the response, or default
+	ContentType  string
+
+	// PartsFieldName is the name of the field holding the parts of a
+	// multipart content whose schema is an object, eg, MultipartMixed200,
+	// which are decoded into Schema as Parts describe them.
+	PartsFieldName string
+	Schema         Schema
+	Parts          []MultipartPartDefinition
+}
+
+// GetRawResponseContents returns the content types of the responses of the
+// operation which GetResponseTypeDefinitions has no type for, being either
+// of a media type the client can't decode, or without a schema.
+func (o *OperationDefinition) GetRawResponseContents() ([]RawResponseContent, error) {
+	var contents []RawResponseContent
+
+	if o.Spec == nil || o.Spec.Responses == nil {
+		return contents, nil
+	}
+
+	for _, responseName := range SortedResponsesKeys(o.Spec.Responses.Map()) {
+		responseRef := o.Spec.Responses.Value(responseName)
+		if responseRef.Value == nil {
+			continue
+		}
+		sortedContentKeys := SortedContentKeys(responseRef.Value.Content)
+		var untyped []string
+		for _, contentTypeName := range sortedContentKeys {
+			mediaType, _ := splitContentType(contentTypeName)
+			if responseFieldPrefix(mediaType) == "" || responseRef.Value.Content[contentTypeName].Schema == nil {
+				untyped = append(untyped, contentTypeName)
+			}
+		}
+		names, err := contentTypeNames(untyped, func(mediaType string) string {
+			return "Body" + mediaTypeToCamelCase(mediaType)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error naming the contents of response %s of %s: %w", responseName, o.OperationId, err)
+		}
+		multiparts := o.multipartResponseContents(responseName)
+		var multipartTypes []string
+		for _, contentTypeName := range untyped {
+			if _, found := multiparts[contentTypeName]; found {
+				multipartTypes = append(multipartTypes, contentTypeName)
+			}
+		}
+		partsNames, err := contentTypeNames(multipartTypes, mediaTypeToCamelCase)
+		if err != nil {
+			return nil, fmt.Errorf("error naming the contents of response %s of %s: %w", responseName, o.OperationId, err)
+		}
+		for _, contentTypeName := range untyped {
+			content := RawResponseContent{
+				FieldName:    names[contentTypeName] + ToCamelCase(responseName),
+				ResponseName: responseName,
+				ContentType:  contentTypeName,
+			}
+			if multipart, found := multiparts[contentTypeName]; found {
+				content.PartsFieldName = partsNames[contentTypeName] + ToCamelCase(responseName)
+				content.Schema = multipart.Schema
+				content.Parts = multipart.Parts
+			}
+			contents = append(contents, content)
+		}
+	}
+	return contents, nil
+}
+
+// multipartResponseContents returns the multipart contents of the response
+// of the given name which have parts, by content type.
+func (o *OperationDefinition) multipartResponseContents(responseName string) map[string]ResponseContentDefinition {
+	contents := make(map[string]ResponseContentDefinition)
+	for _, response := range o.Responses {
+		if response.StatusCode != responseName {
+			continue
+		}
+		for _, content := range response.Contents {
+			if len(content.Parts) != 0 {
+				contents[content.ContentType] = content
+			}
+		}
+	}
+	return contents
+}
+
 func (o OperationDefinition) HasMaskedRequestContentTypes() bool {
 	for _, body := range o.Bodies {
 		if !body.IsFixedContentType() {
@@ -361,6 +680,200 @@ func (o OperationDefinition) HasMaskedRequestContentTypes() bool {
 	return false
 }
 
+// HasFormObjectQueryParams returns whether any query parameter is styled as a
+// non-exploded form object, see ParameterDefinition.IsFormObject.
+func (o OperationDefinition) HasFormObjectQueryParams() bool {
+	for _, param := range o.QueryParams {
+		if param.IsFormObject() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDeepObjectQueryParams returns whether any query parameter is styled as
+// a deepObject, see ParameterDefinition.IsDeepObject.
+func (o OperationDefinition) HasDeepObjectQueryParams() bool {
+	for _, param := range o.QueryParams {
+		if param.IsDeepObject() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasExplodedFormObjectQueryParams returns whether any query parameter is
+// styled as an exploded form object, see
+// ParameterDefinition.IsExplodedFormObject.
+func (o OperationDefinition) HasExplodedFormObjectQueryParams() bool {
+	for _, param := range o.QueryParams {
+		if param.IsExplodedFormObject() {
+			return true
+		}
+	}
+	return false
+}
+
+// OperationServer is one of the servers declared by an operation, or by its
+// path, which the client sends its requests to instead of its own server.
+type OperationServer struct {
+	ConstName   string // The name of the constant holding the URL, eg, UploadFileServer1
+	URL         string // The URL, with its variables replaced by their defaults
+	Description string
+}
+
+// Servers returns the servers declared by the operation, or by its path, in
+// the order they're declared.
+func (o OperationDefinition) Servers() []OperationServer {
+	if o.Spec == nil || o.Spec.Servers == nil {
+		return nil
+	}
+	var servers []OperationServer
+	for i, server := range *o.Spec.Servers {
+		if server == nil {
+			continue
+		}
+		serverURL := server.URL
+		for name, variable := range server.Variables {
+			if variable != nil {
+				serverURL = strings.ReplaceAll(serverURL, "{"+name+"}", variable.Default)
+			}
+		}
+		servers = append(servers, OperationServer{
+			ConstName:   fmt.Sprintf("%sServer%d", o.OperationId, i+1),
+			URL:         serverURL,
+			Description: server.Description,
+		})
+	}
+	return servers
+}
+
+// isCorrelationHeader returns whether name is the header named by the
+// correlation-header output option, which HTTP compares case-insensitively.
+func (g *generator) isCorrelationHeader(name string) bool {
+	header := g.options.OutputOptions.CorrelationHeader
+	return header != "" && strings.EqualFold(name, header)
+}
+
+// CorrelationHeaderParam returns the header parameter of the operation named
+// by the correlation-header output option, if any, which the client sends
+// from the context when it isn't given.
+func (o OperationDefinition) CorrelationHeaderParam() *ParameterDefinition {
+	for i, param := range o.HeaderParams {
+		if param.correlationHeader {
+			return &o.HeaderParams[i]
+		}
+	}
+	return nil
+}
+
+// HasCorrelationResponseHeader returns whether one of the responses of the
+// operation declares the header named by the correlation-header output option.
+func (o OperationDefinition) HasCorrelationResponseHeader() bool {
+	for _, response := range o.Responses {
+		if response.CorrelationHeader() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasContentDispositionHeader returns whether one of the responses of the
+// operation declares a Content-Disposition header, whose filename the client
+// response exposes.
+func (o OperationDefinition) HasContentDispositionHeader() bool {
+	for _, response := range o.Responses {
+		for _, header := range response.Headers {
+			if strings.EqualFold(header.Name, "Content-Disposition") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasCorrelationHeader returns whether the operation declares the header named
+// by the correlation-header output option, as a header parameter or a
+// response header, which the server wrappers put in the context.
+func (o OperationDefinition) HasCorrelationHeader() bool {
+	return o.CorrelationHeaderParam() != nil || o.HasCorrelationResponseHeader()
+}
+
+// ItemCountCheck describes the check of the minItems and maxItems of an array
+// request body or parameter, which the strict server makes before calling
+// the handler when the strict-item-counts output option is set.
+type ItemCountCheck struct {
+	Name     string // The name of the parameter, or "body"
+	Guard    string // The condition under which the value is set, when it's optional
+	Count    string // The expression counting the items of the value
+	MinItems uint64
+	MaxItems int64 // -1 when there's no maximum
+}
+
+// ItemCountChecks returns the checks of the minItems and maxItems of the
+// array parameters and JSON request bodies of the operation, as held in its
+// request object.
+func (o OperationDefinition) ItemCountChecks() []ItemCountCheck {
+	var checks []ItemCountCheck
+	add := func(name string, value string, optional bool, s *openapi3.Schema) {
+		if s == nil || schemaType(s) != "array" || (s.MinItems == 0 && s.MaxItems == nil) {
+			return
+		}
+		check := ItemCountCheck{
+			Name:     name,
+			MinItems: s.MinItems,
+			MaxItems: -1,
+		}
+		if s.MaxItems != nil {
+			check.MaxItems = int64(*s.MaxItems)
+		}
+		if optional {
+			check.Guard = value + " != nil"
+			value = "*" + value
+		}
+		check.Count = "len(" + value + ")"
+		if _, found := s.Extensions[extGoSet]; found {
+			check.Count = "(" + value + ").Len()"
+		}
+		checks = append(checks, check)
+	}
+
+	for _, param := range o.PathParams {
+		add(param.ParamName, "request."+param.GoName(), false, param.Schema.OAPISchema)
+	}
+	for _, params := range [][]ParameterDefinition{o.QueryParams, o.HeaderParams, o.CookieParams} {
+		for _, param := range params {
+			add(param.ParamName, "request."+o.OperationId+"Params."+param.GoName(), param.IndirectOptional(), param.Schema.OAPISchema)
+		}
+	}
+	multipleBodies := len(o.Bodies) > 1
+	for _, body := range o.Bodies {
+		if !body.IsJSON() {
+			continue
+		}
+		field := "request.Body"
+		if multipleBodies {
+			field = "request." + body.NameTag + "Body"
+		}
+		add("body", field, true, body.Schema.OAPISchema)
+	}
+	return checks
+}
+
+// HasRequestContentTypeParams returns whether the request bodies have content
+// types with parameters, like "application/json; version=2", which the strict
+// server tells apart by parsing the Content-Type of requests.
+func (o OperationDefinition) HasRequestContentTypeParams() bool {
+	if len(o.Bodies) < 2 {
+		return false
+	}
+	contentTypes := make([]string, 0, len(o.Bodies))
+	for _, body := range o.Bodies {
+		contentTypes = append(contentTypes, body.ContentType)
+	}
+	return hasContentTypeParams(contentTypes)
+}
+
 // RequestBodyDefinition describes a request body
 type RequestBodyDefinition struct {
 	// Is this body required, or optional?
@@ -467,6 +980,38 @@ func (r ResponseDefinition) IsExternalRef() bool {
 	return strings.Contains(r.Ref, ".")
 }
 
+// CorrelationHeader returns the header of the response named by the
+// correlation-header output option, if any. It must be a string, as the
+// strict server fills it in from the context, and declared by the response
+// itself, rather than by the one it refers to.
+func (r ResponseDefinition) CorrelationHeader() *ResponseHeaderDefinition {
+	if r.IsRef() {
+		return nil
+	}
+	for i, header := range r.Headers {
+		if header.correlationHeader && header.Schema.GoType == "string" {
+			return &r.Headers[i]
+		}
+	}
+	return nil
+}
+
+// ContentDispositionHeader returns the Content-Disposition header of the
+// response, if it declares one, which the strict server sets from the
+// filename given to the constructors of its file downloads. Like the
+// correlation header, it must be a string declared by the response itself.
+func (r ResponseDefinition) ContentDispositionHeader() *ResponseHeaderDefinition {
+	if r.IsRef() {
+		return nil
+	}
+	for i, header := range r.Headers {
+		if strings.EqualFold(header.Name, "Content-Disposition") && header.Schema.GoType == "string" {
+			return &r.Headers[i]
+		}
+	}
+	return nil
+}
+
 type ResponseContentDefinition struct {
 	// This is the schema describing this content
 	Schema Schema
@@ -477,6 +1022,24 @@ type ResponseContentDefinition struct {
 	// When we generate type names, we need a Tag for it, such as JSON, in
 	// which case we will produce "Response200JSONContent".
 	NameTag string
+
+	// Parts are the parts of a multipart content whose schema is an object,
+	// which are read into and written from the fields of its type.
+	Parts []MultipartPartDefinition
+}
+
+// MultipartPartDefinition describes a part of a multipart body whose schema
+// is an object, which is the property of the same name.
+type MultipartPartDefinition struct {
+	Name string
+	// Kind is how the part is read into its field and written from it, as
+	// the generated multipartPartKind constants name it, less their
+	// multipart prefix: JSON, Text or Binary.
+	Kind string
+	// ContentType is the Content-Type of the part, which is that of its
+	// encoding, or else the default OpenAPI gives its schema.
+	ContentType string
+	Required    bool
 }
 
 // TypeDef returns the Go type definition for a request body
@@ -515,6 +1078,16 @@ type ResponseHeaderDefinition struct {
 	Name   string
 	GoName string
 	Schema Schema
+
+	// correlationHeader is set on the header named by the correlation-header
+	// output option.
+	correlationHeader bool
+}
+
+// IsHTTPDate reports whether the header is a date-time, such as
+// Last-Modified, which HTTP formats as an HTTP-date rather than RFC 3339.
+func (h ResponseHeaderDefinition) IsHTTPDate() bool {
+	return h.Schema.GoType == "time.Time"
 }
 
 // FilterParameterDefinitionByType returns the subset of the specified parameters which are of the
@@ -531,6 +1104,12 @@ func FilterParameterDefinitionByType(params []ParameterDefinition, in string) []
 
 // OperationDefinitions returns all operations for a swagger definition.
 func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]OperationDefinition, error) {
+	return exportedGenerator().operationDefinitions(context.Background(), swagger, initialismOverrides)
+}
+
+// operationDefinitions does the work of OperationDefinitions, reporting its
+// progress per operation, and giving up with ctx.Err() once ctx is done.
+func (g *generator) operationDefinitions(ctx context.Context, swagger *openapi3.T, initialismOverrides bool) ([]OperationDefinition, error) {
 	var operations []OperationDefinition
 
 	var toCamelCaseFunc func(string) string
@@ -544,11 +1123,17 @@ func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]Oper
 		return operations, nil
 	}
 
+	total := 0
+	for _, pathItem := range swagger.Paths.Map() {
+		total += len(pathItem.Operations())
+	}
+	g.reportProgress("operations", 0, total)
+
 	for _, requestPath := range SortedPathsKeys(swagger.Paths.Map()) {
 		pathItem := swagger.Paths.Value(requestPath)
 		// These are parameters defined for all methods on a given path. They
 		// are shared by all methods.
-		globalParams, err := DescribeParameters(pathItem.Parameters, nil)
+		globalParams, err := g.describeParameters(pathItem.Parameters, nil)
 		if err != nil {
 			return nil, fmt.Errorf("error describing global parameters for %s: %s",
 				requestPath, err)
@@ -557,7 +1142,12 @@ func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]Oper
 		// Each path can have a number of operations, POST, GET, OPTIONS, etc.
 		pathOps := pathItem.Operations()
 		for _, opName := range SortedOperationsKeys(pathOps) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
 			op := pathOps[opName]
+			endOperation := g.startStep("operation", operationLocation(requestPath, opName))
 			if pathItem.Servers != nil {
 				op.Servers = &pathItem.Servers
 			}
@@ -575,7 +1165,7 @@ func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]Oper
 
 			// These are parameters defined for the specific path method that
 			// we're iterating over.
-			localParams, err := DescribeParameters(op.Parameters, []string{op.OperationID + "Params"})
+			localParams, err := g.describeParameters(op.Parameters, []string{op.OperationID + "Params"})
 			if err != nil {
 				return nil, fmt.Errorf("error describing global parameters for %s/%s: %s",
 					opName, requestPath, err)
@@ -586,6 +1176,16 @@ func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]Oper
 			if err != nil {
 				return nil, err
 			}
+			g.disambiguateParameterNames(operationLocation(requestPath, opName), allParams)
+			g.warnNestedFormObjects(operationLocation(requestPath, opName), allParams)
+			if err := checkQueryStyles(allParams); err != nil {
+				return nil, fmt.Errorf("error checking query styles for %s/%s: %w", opName, requestPath, err)
+			}
+			if g.options.OutputOptions.ParamDefaults {
+				if err := g.describeParamDefaults(operationLocation(requestPath, opName), allParams); err != nil {
+					return nil, fmt.Errorf("error describing parameter defaults for %s/%s: %w", opName, requestPath, err)
+				}
+			}
 
 			// Order the path parameters to match the order as specified in
 			// the path, not in the swagger spec, and validate that the parameter
@@ -596,12 +1196,12 @@ func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]Oper
 				return nil, err
 			}
 
-			bodyDefinitions, typeDefinitions, err := GenerateBodyDefinitions(op.OperationID, op.RequestBody)
+			bodyDefinitions, typeDefinitions, err := g.generateBodyDefinitions(op.OperationID, op.RequestBody)
 			if err != nil {
 				return nil, fmt.Errorf("error generating body definitions: %w", err)
 			}
 
-			responseDefinitions, err := GenerateResponseDefinitions(op.OperationID, op.Responses.Map())
+			responseDefinitions, err := g.generateResponseDefinitions(op.OperationID, op.Responses.Map())
 			if err != nil {
 				return nil, fmt.Errorf("error generating response definitions: %w", err)
 			}
@@ -627,25 +1227,41 @@ func OperationDefinitions(swagger *openapi3.T, initialismOverrides bool) ([]Oper
 			// https://swagger.io/docs/specification/authentication/
 			if op.Security != nil {
 				opDef.SecurityDefinitions = DescribeSecurityDefinition(*op.Security)
+				opDef.SecurityRequirements = *op.Security
 			} else {
 				// use global securityDefinitions
 				// globalSecurityDefinitions contains the top-level securityDefinitions.
 				// They are the default securityPermissions which are injected into each
 				// path, except for the case where a path explicitly overrides them.
 				opDef.SecurityDefinitions = DescribeSecurityDefinition(swagger.Security)
+				opDef.SecurityRequirements = swagger.Security
 
 			}
 
 			if op.RequestBody != nil {
 				opDef.BodyRequired = op.RequestBody.Value.Required
+
+				// The body is sent and bound all the same, but it's likely to
+				// be dropped on the way.
+				switch opName {
+				case "GET", "HEAD", "DELETE":
+					g.addWarning(operationLocation(requestPath, opName), "%s operation has a request body, which HTTP doesn't define the semantics of, and which proxies and servers may drop", opName)
+				}
+			}
+
+			if g.options.Generate.Strict {
+				g.disambiguateRequestObjectNames(operationLocation(requestPath, opName), &opDef)
 			}
 
 			// Generate all the type definitions needed for this operation
 			opDef.TypeDefinitions = append(opDef.TypeDefinitions, GenerateTypeDefsForOperation(opDef)...)
 
 			operations = append(operations, opDef)
+			endOperation()
+			g.reportProgress("operations", len(operations), total)
 		}
 	}
+	g.canonicalizeRoutePaths(operations)
 	return operations, nil
 }
 
@@ -672,32 +1288,29 @@ func generateDefaultOperationID(opName string, requestPath string, toCamelCaseFu
 // GenerateBodyDefinitions turns the Swagger body definitions into a list of our body
 // definitions which will be used for code generation.
 func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBodyRef) ([]RequestBodyDefinition, []TypeDefinition, error) {
+	return exportedGenerator().generateBodyDefinitions(operationID, bodyOrRef)
+}
+
+// generateBodyDefinitions does the work of GenerateBodyDefinitions.
+func (g *generator) generateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBodyRef) ([]RequestBodyDefinition, []TypeDefinition, error) {
 	if bodyOrRef == nil {
 		return nil, nil, nil
 	}
-	body := bodyOrRef.Value
+	body := requestBodyOfRef(bodyOrRef)
 
 	var bodyDefinitions []RequestBodyDefinition
 	var typeDefinitions []TypeDefinition
 
-	for _, contentType := range SortedContentKeys(body.Content) {
+	contentTypes := SortedContentKeys(body.Content)
+	tags, err := contentTypeNames(contentTypes, contentTypeTag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error naming request bodies: %w", err)
+	}
+
+	for _, contentType := range contentTypes {
 		content := body.Content[contentType]
-		var tag string
-		var defaultBody bool
-
-		switch {
-		case contentType == "application/json":
-			tag = "JSON"
-			defaultBody = true
-		case util.IsMediaTypeJson(contentType):
-			tag = mediaTypeToCamelCase(contentType)
-		case strings.HasPrefix(contentType, "multipart/"):
-			tag = "Multipart"
-		case contentType == "application/x-www-form-urlencoded":
-			tag = "Formdata"
-		case contentType == "text/plain":
-			tag = "Text"
-		default:
+		tag, found := tags[contentType]
+		if !found {
 			bd := RequestBodyDefinition{
 				Required:    body.Required,
 				ContentType: contentType,
@@ -705,9 +1318,10 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 			bodyDefinitions = append(bodyDefinitions, bd)
 			continue
 		}
+		defaultBody := tag == "JSON"
 
 		bodyTypeName := operationID + tag + "Body"
-		bodySchema, err := GenerateGoSchema(content.Schema, []string{bodyTypeName})
+		bodySchema, err := g.generateGoSchema(content.Schema, []string{bodyTypeName})
 		if err != nil {
 			return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
 		}
@@ -715,7 +1329,7 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 		// If the body is a pre-defined type
 		if content.Schema != nil && IsGoTypeReference(content.Schema.Ref) {
 			// Convert the reference path to Go type
-			refType, err := RefPathToGoType(content.Schema.Ref)
+			refType, err := g.refPathToGoType(content.Schema.Ref, true)
 			if err != nil {
 				return nil, nil, fmt.Errorf("error turning reference (%s) into a Go type: %w", content.Schema.Ref, err)
 			}
@@ -770,7 +1384,54 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 	return bodyDefinitions, typeDefinitions, nil
 }
 
+// responseFieldPrefix returns the prefix of the fields holding the responses
+// of the given media type in the client, which the status code follows, or ""
+// for the media types which aren't supported.
+func responseFieldPrefix(mediaType string) string {
+	switch {
+	// HAL+JSON:
+	case StringInArray(mediaType, contentTypesHalJSON):
+		return "HALJSON"
+	case mediaType == "application/json":
+		// if it's the standard application/json
+		return "JSON"
+	// Vendored JSON
+	case StringInArray(mediaType, contentTypesJSON) || util.IsMediaTypeJson(mediaType):
+		return strings.ReplaceAll(ToCamelCase(mediaType), "Json", "JSON")
+	// YAML:
+	case StringInArray(mediaType, contentTypesYAML):
+		return "YAML"
+	// XML:
+	case StringInArray(mediaType, contentTypesXML):
+		return "XML"
+	}
+	return ""
+}
+
+// contentTypeTag returns the tag naming the request bodies and responses of
+// the given media type, or "" for the media types which aren't supported.
+func contentTypeTag(mediaType string) string {
+	switch {
+	case mediaType == "application/json":
+		return "JSON"
+	case util.IsMediaTypeJson(mediaType):
+		return mediaTypeToCamelCase(mediaType)
+	case strings.HasPrefix(mediaType, "multipart/"):
+		return "Multipart"
+	case mediaType == "application/x-www-form-urlencoded":
+		return "Formdata"
+	case mediaType == "text/plain":
+		return "Text"
+	}
+	return ""
+}
+
 func GenerateResponseDefinitions(operationID string, responses map[string]*openapi3.ResponseRef) ([]ResponseDefinition, error) {
+	return exportedGenerator().generateResponseDefinitions(operationID, responses)
+}
+
+// generateResponseDefinitions does the work of GenerateResponseDefinitions.
+func (g *generator) generateResponseDefinitions(operationID string, responses map[string]*openapi3.ResponseRef) ([]ResponseDefinition, error) {
 	var responseDefinitions []ResponseDefinition
 	// do not let multiple status codes ref to same response, it will break the type switch
 	refSet := make(map[string]struct{})
@@ -780,25 +1441,20 @@ func GenerateResponseDefinitions(operationID string, responses map[string]*opena
 		if responseOrRef == nil {
 			continue
 		}
-		response := responseOrRef.Value
+		response := responseOfRef(responseOrRef)
 
 		var responseContentDefinitions []ResponseContentDefinition
 
-		for _, contentType := range SortedContentKeys(response.Content) {
+		contentTypes := SortedContentKeys(response.Content)
+		tags, err := contentTypeNames(contentTypes, contentTypeTag)
+		if err != nil {
+			return nil, fmt.Errorf("error naming the contents of response %s: %w", statusCode, err)
+		}
+
+		for _, contentType := range contentTypes {
 			content := response.Content[contentType]
-			var tag string
-			switch {
-			case contentType == "application/json":
-				tag = "JSON"
-			case util.IsMediaTypeJson(contentType):
-				tag = mediaTypeToCamelCase(contentType)
-			case contentType == "application/x-www-form-urlencoded":
-				tag = "Formdata"
-			case strings.HasPrefix(contentType, "multipart/"):
-				tag = "Multipart"
-			case contentType == "text/plain":
-				tag = "Text"
-			default:
+			tag, found := tags[contentType]
+			if !found {
 				rcd := ResponseContentDefinition{
 					ContentType: contentType,
 				}
@@ -807,7 +1463,7 @@ func GenerateResponseDefinitions(operationID string, responses map[string]*opena
 			}
 
 			responseTypeName := operationID + statusCode + tag + "Response"
-			contentSchema, err := GenerateGoSchema(content.Schema, []string{responseTypeName})
+			contentSchema, err := g.generateGoSchema(content.Schema, []string{responseTypeName})
 			if err != nil {
 				return nil, fmt.Errorf("error generating request body definition: %w", err)
 			}
@@ -817,17 +1473,43 @@ func GenerateResponseDefinitions(operationID string, responses map[string]*opena
 				NameTag:     tag,
 				Schema:      contentSchema,
 			}
+			if mediaType, _ := splitContentType(contentType); strings.HasPrefix(mediaType, "multipart/") {
+				rcd.Parts, err = g.generateMultipartParts(content)
+				if err != nil {
+					return nil, fmt.Errorf("error generating the parts of response %s: %w", statusCode, err)
+				}
+			}
 			responseContentDefinitions = append(responseContentDefinitions, rcd)
 		}
 
 		var responseHeaderDefinitions []ResponseHeaderDefinition
 		for _, headerName := range SortedHeadersKeys(response.Headers) {
 			header := response.Headers[headerName]
-			contentSchema, err := GenerateGoSchema(header.Value.Schema, []string{})
+			// The inline enums of the headers of the responses of operations
+			// get a type of their own, named after the struct of the headers.
+			var path []string
+			if operationID != "" && !IsGoTypeReference(responseOrRef.Ref) && !IsGoTypeReference(header.Ref) {
+				path = []string{operationID + statusCode + "ResponseHeaders", headerName}
+			}
+			contentSchema, err := g.generateGoSchema(headerOfRef(header).Schema, path)
 			if err != nil {
 				return nil, fmt.Errorf("error generating response header definition: %w", err)
 			}
-			headerDefinition := ResponseHeaderDefinition{Name: headerName, GoName: SchemaNameToTypeName(headerName), Schema: contentSchema}
+			// Headers declared in components/headers share the type generated
+			// for them, rather than repeating their schema in every response.
+			if IsGoTypeReference(header.Ref) {
+				refType, err := g.refPathToGoType(header.Ref, true)
+				if err != nil {
+					return nil, fmt.Errorf("error dereferencing (%s) for response header (%s): %w", header.Ref, headerName, err)
+				}
+				contentSchema.RefType = refType
+			}
+			headerDefinition := ResponseHeaderDefinition{
+				Name:              headerName,
+				GoName:            SchemaNameToTypeName(headerName),
+				Schema:            contentSchema,
+				correlationHeader: g.isCorrelationHeader(headerName),
+			}
 			responseHeaderDefinitions = append(responseHeaderDefinitions, headerDefinition)
 		}
 
@@ -841,7 +1523,7 @@ func GenerateResponseDefinitions(operationID string, responses map[string]*opena
 		}
 		if IsGoTypeReference(responseOrRef.Ref) {
 			// Convert the reference path to Go type
-			refType, err := RefPathToGoType(responseOrRef.Ref)
+			refType, err := g.refPathToGoType(responseOrRef.Ref, true)
 			if err != nil {
 				return nil, fmt.Errorf("error turning reference (%s) into a Go type: %w", responseOrRef.Ref, err)
 			}
@@ -874,6 +1556,12 @@ func GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
 	for _, body := range op.Bodies {
 		typeDefs = append(typeDefs, body.Schema.GetAdditionalTypeDefs()...)
 	}
+
+	for _, response := range op.Responses {
+		for _, header := range response.Headers {
+			typeDefs = append(typeDefs, header.Schema.GetAdditionalTypeDefs()...)
+		}
+	}
 	return typeDefs
 }
 
@@ -889,6 +1577,7 @@ func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
 	typeName := op.OperationId + "Params"
 
 	s := Schema{}
+	jsonNames := make(map[string]bool)
 	for _, param := range objectParams {
 		pSchema := param.Schema
 		param.Style()
@@ -908,7 +1597,33 @@ func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
 			NeedsFormTag:  param.Style() == "form",
 			Extensions:    param.Spec.Extensions,
 		}
+		if param.goNameSuffix != "" {
+			// Name the field as the disambiguated parameter, without touching
+			// the extensions of the spec itself. When another field already
+			// has its json name, it's left out of json.
+			prop.Extensions = make(map[string]interface{}, len(param.Spec.Extensions)+2)
+			for k, v := range param.Spec.Extensions {
+				prop.Extensions[k] = v
+			}
+			prop.Extensions[extGoName] = param.GoName()
+			if jsonNames[param.ParamName] {
+				prop.Extensions[extPropGoJsonIgnore] = true
+			}
+		}
+		jsonNames[param.ParamName] = true
 		s.Properties = append(s.Properties, prop)
+		if param.defaultValue != "" {
+			s.Properties = append(s.Properties, Property{
+				Description:   fmt.Sprintf("reports whether the request carried %s, which ApplyDefaults otherwise sets to its default.", param.GoName()),
+				JsonFieldName: param.ParamName + "Set",
+				Required:      true,
+				Schema:        Schema{GoType: "bool"},
+				Extensions: map[string]interface{}{
+					extGoName:           param.GoName() + "Set",
+					extPropGoJsonIgnore: true,
+				},
+			})
+		}
 	}
 
 	s.Description = op.Spec.Description
@@ -963,37 +1678,37 @@ func GenerateTypesForOperations(t *template.Template, ops []OperationDefinition)
 // GenerateIrisServer generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateIrisServer(t *template.Template, operations []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"iris/iris-interface.tmpl", "iris/iris-middleware.tmpl", "iris/iris-handler.tmpl"}, t, operations)
+	return GenerateTemplates([]string{"iris/iris-interface.tmpl", "iris/iris-middleware.tmpl", "iris/iris-handler.tmpl", "form-object-bind.tmpl", "deep-object-bind.tmpl", "indexed-deep-object-bind.tmpl", "enum-text-bind.tmpl"}, t, operations)
 }
 
 // GenerateChiServer generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateChiServer(t *template.Template, operations []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"chi/chi-interface.tmpl", "chi/chi-middleware.tmpl", "chi/chi-handler.tmpl"}, t, operations)
+	return GenerateTemplates([]string{"chi/chi-interface.tmpl", "chi/chi-middleware.tmpl", "chi/chi-handler.tmpl", "form-object-bind.tmpl", "deep-object-bind.tmpl", "indexed-deep-object-bind.tmpl", "enum-text-bind.tmpl"}, t, operations)
 }
 
 // GenerateFiberServer generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateFiberServer(t *template.Template, operations []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"fiber/fiber-interface.tmpl", "fiber/fiber-middleware.tmpl", "fiber/fiber-handler.tmpl"}, t, operations)
+	return GenerateTemplates([]string{"fiber/fiber-interface.tmpl", "fiber/fiber-middleware.tmpl", "fiber/fiber-handler.tmpl", "form-object-bind.tmpl", "deep-object-bind.tmpl", "indexed-deep-object-bind.tmpl", "enum-text-bind.tmpl"}, t, operations)
 }
 
 // GenerateEchoServer generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateEchoServer(t *template.Template, operations []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"echo/echo-interface.tmpl", "echo/echo-wrappers.tmpl", "echo/echo-register.tmpl"}, t, operations)
+	return GenerateTemplates([]string{"echo/echo-interface.tmpl", "echo/echo-wrappers.tmpl", "echo/echo-register.tmpl", "form-object-bind.tmpl", "deep-object-bind.tmpl", "indexed-deep-object-bind.tmpl", "enum-text-bind.tmpl"}, t, operations)
 }
 
 // GenerateGinServer generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateGinServer(t *template.Template, operations []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"gin/gin-interface.tmpl", "gin/gin-wrappers.tmpl", "gin/gin-register.tmpl"}, t, operations)
+	return GenerateTemplates([]string{"gin/gin-interface.tmpl", "gin/gin-wrappers.tmpl", "gin/gin-register.tmpl", "form-object-bind.tmpl", "deep-object-bind.tmpl", "indexed-deep-object-bind.tmpl", "enum-text-bind.tmpl"}, t, operations)
 }
 
 // GenerateGorillaServer generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateGorillaServer(t *template.Template, operations []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"gorilla/gorilla-interface.tmpl", "gorilla/gorilla-middleware.tmpl", "gorilla/gorilla-register.tmpl"}, t, operations)
+	return GenerateTemplates([]string{"gorilla/gorilla-interface.tmpl", "gorilla/gorilla-middleware.tmpl", "gorilla/gorilla-register.tmpl", "form-object-bind.tmpl", "deep-object-bind.tmpl", "indexed-deep-object-bind.tmpl", "enum-text-bind.tmpl"}, t, operations)
 }
 
 func GenerateStrictServer(t *template.Template, operations []OperationDefinition, opts Configuration) (string, error) {
@@ -1015,10 +1730,216 @@ func GenerateStrictServer(t *template.Template, operations []OperationDefinition
 	if opts.Generate.IrisServer {
 		templates = append(templates, "strict/strict-iris-interface.tmpl", "strict/strict-iris.tmpl")
 	}
+	if opts.Generate.ChiServer || opts.Generate.GorillaServer || opts.Generate.EchoServer || opts.Generate.GinServer || opts.Generate.IrisServer {
+		templates = append(templates, "strict/strict-form-bind.tmpl")
+	}
+	if opts.Generate.ChiServer || opts.Generate.GorillaServer || opts.Generate.EchoServer || opts.Generate.GinServer {
+		templates = append(templates, "strict/strict-content-disposition.tmpl")
+	}
+	for _, op := range operations {
+		if op.HasRequestContentTypeParams() {
+			templates = append(templates, "strict/strict-content-type.tmpl")
+			break
+		}
+	}
+	for _, op := range operations {
+		if len(templates) != 0 && op.PreferParam() != nil {
+			templates = append(templates, "strict/strict-preferences.tmpl")
+			break
+		}
+	}
+	if len(templates) != 0 && opts.OutputOptions.StrictItemCounts {
+		for _, op := range operations {
+			if len(op.ItemCountChecks()) != 0 {
+				templates = append(templates, "strict/strict-item-counts.tmpl")
+				break
+			}
+		}
+	}
+	if len(templates) != 0 {
+		templates = append(templates, "strict/strict-operation-middlewares.tmpl")
+	}
 
 	return GenerateTemplates(templates, t, operations)
 }
 
+// canonicalizeRoutePaths routes the operations whose path differs from the
+// one of an earlier operation only by the names of its parameters, eg,
+// /items/{itemId} after /items/{id}, under the path of that operation, which
+// routers would otherwise reject as conflicting or bind under the other names.
+// Their path parameters are looked up in the route under the names of that
+// path, while the clients keep substituting them in their own.
+func (g *generator) canonicalizeRoutePaths(operations []OperationDefinition) {
+	canonical := make(map[string]string)
+	warned := make(map[string]bool)
+	for i := range operations {
+		op := &operations[i]
+		key := ReplacePathParamsWithStr(op.Path)
+		path, found := canonical[key]
+		if !found {
+			canonical[key] = op.Path
+			continue
+		}
+		if path == op.Path {
+			continue
+		}
+
+		op.routePath = path
+		names := OrderedParamsFromUri(path)
+		var renames []string
+		for j := range op.PathParams {
+			op.PathParams[j].routeName = names[j]
+			if names[j] != op.PathParams[j].ParamName {
+				renames = append(renames, fmt.Sprintf("'%s' as '%s'", op.PathParams[j].ParamName, names[j]))
+			}
+		}
+		if !warned[op.Path] {
+			warned[op.Path] = true
+			g.addWarning("paths."+op.Path, "the path names its parameters differently from the equivalent path %s, which servers route it as, capturing %s",
+				path, strings.Join(renames, ", "))
+		}
+	}
+}
+
+// CORSPathDefinition describes the methods and request headers used by the
+// operations on a single path, from which CORS preflight requests are answered.
+type CORSPathDefinition struct {
+	Path    string   // The Swagger path, like /resource/{id}
+	Methods []string // GET, POST, DELETE, etc.
+	Headers []string // Request headers referenced by header parameters
+}
+
+// corsPathDefinitions groups the operations by path, collecting the methods
+// and the request headers each path uses. Operations which take a request body
+// also allow the Content-Type header.
+func (g *generator) corsPathDefinitions(operations []OperationDefinition) []CORSPathDefinition {
+	var paths []CORSPathDefinition
+	index := make(map[string]int)
+	for _, op := range operations {
+		i, found := index[op.RoutePath()]
+		if !found {
+			i = len(paths)
+			index[op.RoutePath()] = i
+			paths = append(paths, CORSPathDefinition{Path: g.routePath(op.RoutePath())})
+		}
+		def := &paths[i]
+		def.Methods = appendUnique(def.Methods, op.Method)
+		for _, param := range op.HeaderParams {
+			def.Headers = appendUnique(def.Headers, param.ParamName)
+		}
+		if len(op.Bodies) != 0 {
+			def.Headers = appendUnique(def.Headers, "Content-Type")
+		}
+	}
+	for i := range paths {
+		sort.Strings(paths[i].Methods)
+		sort.Strings(paths[i].Headers)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		return paths[i].Path < paths[j].Path
+	})
+	return paths
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+// TagServerDefinition describes the server interface generated for the
+// operations sharing a tag, when OutputOptions.ServerInterfacePerTag is set.
+type TagServerDefinition struct {
+	Tag        string                // The tag as written in the spec, empty for untagged operations
+	Name       string                // The Go name of the tag, which prefixes its interface and functions
+	ParamName  string                // The name of the argument taking the tag's implementation
+	Operations []OperationDefinition // The operations whose first tag is Tag
+}
+
+// TagServerDefinitions groups the operations by their first tag, sorted by
+// name. Untagged operations are grouped under "Untagged".
+func TagServerDefinitions(operations []OperationDefinition) ([]TagServerDefinition, error) {
+	var tags []TagServerDefinition
+	index := make(map[string]int)
+	names := make(map[string]string)
+	for _, op := range operations {
+		var tag string
+		if op.Spec != nil && len(op.Spec.Tags) != 0 {
+			tag = op.Spec.Tags[0]
+		}
+		i, found := index[tag]
+		if !found {
+			name := "Untagged"
+			if tag != "" {
+				name = SchemaNameToTypeName(tag)
+			}
+			if other, found := names[name]; found {
+				return nil, fmt.Errorf("tags %q and %q would both be named %s", other, tag, name)
+			}
+			names[name] = tag
+			i = len(tags)
+			index[tag] = i
+			tags = append(tags, TagServerDefinition{
+				Tag:       tag,
+				Name:      name,
+				ParamName: SanitizeGoIdentity(LowercaseFirstCharacter(name)),
+			})
+		}
+		tags[i].Operations = append(tags[i].Operations, op)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Name < tags[j].Name
+	})
+	return tags, nil
+}
+
+// GenerateTagServers generates an interface per tag for the server being
+// generated, along with NewServerFromTags composing them into a
+// ServerInterface, and functions registering the routes of a single tag.
+func GenerateTagServers(t *template.Template, operations []OperationDefinition, opts Configuration) (string, error) {
+	var templates []string
+
+	if opts.Generate.ChiServer {
+		templates = append(templates, "chi/chi-tags.tmpl")
+	}
+	if opts.Generate.EchoServer {
+		templates = append(templates, "echo/echo-tags.tmpl")
+	}
+
+	tags, err := TagServerDefinitions(operations)
+	if err != nil {
+		return "", err
+	}
+	return GenerateTemplates(templates, t, tags)
+}
+
+// generateCORS generates the CORSPolicy table for the operations, along with
+// a middleware answering preflight requests for the server being generated.
+func (g *generator) generateCORS(t *template.Template, operations []OperationDefinition, opts Configuration) (string, error) {
+	templates := []string{"cors/cors.tmpl"}
+
+	if opts.Generate.ChiServer || opts.Generate.GorillaServer {
+		templates = append(templates, "cors/cors-http.tmpl")
+	}
+	if opts.Generate.EchoServer {
+		templates = append(templates, "cors/cors-echo.tmpl")
+	}
+	if opts.Generate.GinServer {
+		templates = append(templates, "cors/cors-gin.tmpl")
+	}
+	if opts.Generate.FiberServer {
+		templates = append(templates, "cors/cors-fiber.tmpl")
+	}
+	if opts.Generate.IrisServer {
+		templates = append(templates, "cors/cors-iris.tmpl")
+	}
+
+	return GenerateTemplates(templates, t, g.corsPathDefinitions(operations))
+}
+
 func GenerateStrictResponses(t *template.Template, responses []ResponseDefinition) (string, error) {
 	return GenerateTemplates([]string{"strict/strict-responses.tmpl"}, t, responses)
 }
@@ -1035,6 +1956,12 @@ func GenerateClientWithResponses(t *template.Template, ops []OperationDefinition
 	return GenerateTemplates([]string{"client-with-responses.tmpl"}, t, ops)
 }
 
+// GenerateResponseParsers generates the response types of the operations and
+// the functions parsing HTTP responses into them.
+func GenerateResponseParsers(t *template.Template, ops []OperationDefinition) (string, error) {
+	return GenerateTemplates([]string{"response-parsers.tmpl", "content-disposition.tmpl"}, t, ops)
+}
+
 // GenerateTemplates used to generate templates
 func GenerateTemplates(templates []string, t *template.Template, ops interface{}) (string, error) {
 	var generatedTemplates []string
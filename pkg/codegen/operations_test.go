@@ -15,6 +15,7 @@ package codegen
 
 import (
 	"net/http"
+	"reflect"
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -85,6 +86,51 @@ func TestIsJson(t *testing.T) {
 	}
 }
 
+func TestIsDate(t *testing.T) {
+	date := &openapi3.Schema{Type: "string", Format: "date"}
+	goTyped := &openapi3.Schema{Type: "string", Format: "date", Extensions: map[string]interface{}{"x-go-type": "civil.Date"}}
+	suite := []struct {
+		name   string
+		in     string
+		style  string
+		schema *openapi3.Schema
+		want   bool
+	}{
+		{name: "query", in: "query", schema: date, want: true},
+		{name: "path", in: "path", schema: date, want: true},
+		{name: "header", in: "header", schema: date, want: true},
+		{name: "cookie", in: "cookie", schema: date, want: false},
+		{name: "labeled path", in: "path", style: "label", schema: date, want: false},
+		{name: "date-time", in: "query", schema: &openapi3.Schema{Type: "string", Format: "date-time"}, want: false},
+		{name: "x-go-type", in: "query", schema: goTyped, want: false},
+	}
+	for _, test := range suite {
+		t.Run(test.name, func(t *testing.T) {
+			pd := ParameterDefinition{
+				In:          test.in,
+				Spec:        &openapi3.Parameter{In: test.in, Style: test.style, Schema: openapi3.NewSchemaRef("", test.schema)},
+				strictDates: true,
+			}
+			if got := pd.IsDate(); got != test.want {
+				t.Fatalf("IsDate validation failed. Want [%v] Got [%v]", test.want, got)
+			}
+			if pd.IsDate() && pd.IsStyled() {
+				t.Fatalf("date parameters aren't styled by the runtime")
+			}
+		})
+	}
+}
+
+func TestIsDateWithoutStrictDates(t *testing.T) {
+	pd := ParameterDefinition{
+		In:   "query",
+		Spec: &openapi3.Parameter{In: "query", Schema: openapi3.NewSchemaRef("", &openapi3.Schema{Type: "string", Format: "date"})},
+	}
+	if pd.IsDate() || !pd.IsStyled() {
+		t.Fatalf("date parameters are styled by the runtime without strict-dates")
+	}
+}
+
 func TestGenerateDefaultOperationID(t *testing.T) {
 	type test struct {
 		op      string
@@ -146,3 +192,90 @@ func TestGenerateDefaultOperationID(t *testing.T) {
 		}
 	}
 }
+
+func TestCORSPathDefinitions(t *testing.T) {
+	ops := []OperationDefinition{
+		{Path: "/things/{id}", Method: http.MethodDelete, HeaderParams: []ParameterDefinition{{ParamName: "X-Trace"}}},
+		{Path: "/things", Method: http.MethodPost, Bodies: []RequestBodyDefinition{{ContentType: "application/json"}}},
+		{Path: "/things", Method: http.MethodGet, HeaderParams: []ParameterDefinition{{ParamName: "X-Request-Id"}, {ParamName: "content-type"}}},
+	}
+
+	want := []CORSPathDefinition{
+		{Path: "/things", Methods: []string{"GET", "POST"}, Headers: []string{"Content-Type", "X-Request-Id"}},
+		{Path: "/things/{id}", Methods: []string{"DELETE"}, Headers: []string{"X-Trace"}},
+	}
+	got := newGenerator(nil, Configuration{}).corsPathDefinitions(ops)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("CORSPathDefinitions() = %v, want %v", got, want)
+	}
+}
+
+func TestCanonicalizeRoutePaths(t *testing.T) {
+	g := newGenerator(nil, Configuration{})
+
+	ops := []OperationDefinition{
+		{Path: "/items/{id}", Method: http.MethodGet, PathParams: []ParameterDefinition{{ParamName: "id"}}},
+		{Path: "/items/{itemId}", Method: http.MethodPut, PathParams: []ParameterDefinition{{ParamName: "itemId"}}},
+		{Path: "/items/{itemId}", Method: http.MethodDelete, PathParams: []ParameterDefinition{{ParamName: "itemId"}}},
+		{Path: "/items/{itemId}/tags", Method: http.MethodGet, PathParams: []ParameterDefinition{{ParamName: "itemId"}}},
+	}
+	g.canonicalizeRoutePaths(ops)
+
+	for i, want := range []string{"/items/{id}", "/items/{id}", "/items/{id}", "/items/{itemId}/tags"} {
+		if got := ops[i].RoutePath(); got != want {
+			t.Errorf("%s %s: RoutePath() = %q, want %q", ops[i].Method, ops[i].Path, got, want)
+		}
+	}
+	for i, want := range []string{"id", "id", "id", "itemId"} {
+		if got := ops[i].PathParams[0].RouteName(); got != want {
+			t.Errorf("%s %s: RouteName() = %q, want %q", ops[i].Method, ops[i].Path, got, want)
+		}
+	}
+	// The clients keep substituting the parameters in their own paths.
+	if ops[1].Path != "/items/{itemId}" || ops[1].PathParams[0].ParamName != "itemId" {
+		t.Errorf("the path of PUT was changed to %s", ops[1].Path)
+	}
+
+	wantWarnings := []Warning{{
+		Location: "paths./items/{itemId}",
+		Message:  "the path names its parameters differently from the equivalent path /items/{id}, which servers route it as, capturing 'itemId' as 'id'",
+	}}
+	if !reflect.DeepEqual(wantWarnings, g.warnings) {
+		t.Errorf("warnings = %v, want %v", g.warnings, wantWarnings)
+	}
+}
+
+func TestTagServerDefinitions(t *testing.T) {
+	ops := []OperationDefinition{
+		{OperationId: "ListPets", Spec: &openapi3.Operation{Tags: []string{"pets"}}},
+		{OperationId: "Health", Spec: &openapi3.Operation{}},
+		{OperationId: "PlaceOrder", Spec: &openapi3.Operation{Tags: []string{"store orders", "pets"}}},
+		{OperationId: "GetPet", Spec: &openapi3.Operation{Tags: []string{"pets"}}},
+	}
+
+	tags, err := TagServerDefinitions(ops)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got [][]string
+	for _, tag := range tags {
+		names := []string{tag.Tag, tag.Name, tag.ParamName}
+		for _, op := range tag.Operations {
+			names = append(names, op.OperationId)
+		}
+		got = append(got, names)
+	}
+	want := [][]string{
+		{"pets", "Pets", "pets", "ListPets", "GetPet"},
+		{"store orders", "StoreOrders", "storeOrders", "PlaceOrder"},
+		{"", "Untagged", "untagged", "Health"},
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("TagServerDefinitions() = %v, want %v", got, want)
+	}
+
+	ops = append(ops, OperationDefinition{OperationId: "ListPetsAgain", Spec: &openapi3.Operation{Tags: []string{"Pets"}}})
+	if _, err := TagServerDefinitions(ops); err == nil {
+		t.Error("TagServerDefinitions() didn't fail for tags with the same name")
+	}
+}
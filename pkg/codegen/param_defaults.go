@@ -0,0 +1,95 @@
+package codegen
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// describeParamDefaults checks the defaults of params against their schemas,
+// and, for the optional ones whose Go type has a literal, records the Go
+// expression of their default, which ApplyDefaults sets them to.
+func (g *generator) describeParamDefaults(location string, params []ParameterDefinition) error {
+	for i, param := range params {
+		if param.Spec == nil || param.Spec.Schema == nil || param.Spec.Schema.Value == nil {
+			continue
+		}
+		schema := param.Spec.Schema.Value
+		if schema.Default == nil {
+			continue
+		}
+		if err := schema.VisitJSON(schema.Default); err != nil {
+			return fmt.Errorf("the default of %s parameter '%s' doesn't satisfy its schema: %w", param.In, param.ParamName, err)
+		}
+		if param.Required || param.In == "path" {
+			continue
+		}
+		value, ok := paramDefaultValue(param)
+		if !ok {
+			g.addWarning(location, "the default of optional %s parameter %q isn't applied, as its Go type %s has no literal",
+				param.In, param.ParamName, param.TypeDef())
+			continue
+		}
+		params[i].defaultValue = value
+	}
+	return nil
+}
+
+// paramDefaultValue returns the Go expression of the default of param, which
+// only the numbers, booleans and strings without a Go type of their own have.
+func paramDefaultValue(param ParameterDefinition) (string, bool) {
+	schema := param.Spec.Schema.Value
+	if !param.IndirectOptional() {
+		return "", false
+	}
+	if _, ok := param.Spec.Extensions[extPropGoType]; ok {
+		return "", false
+	}
+	if _, ok := schema.Extensions[extPropGoType]; ok {
+		return "", false
+	}
+
+	var literal, untyped string
+	switch value := schema.Default.(type) {
+	case bool:
+		literal, untyped = strconv.FormatBool(value), "bool"
+	case float64:
+		if schemaType(schema) == "integer" {
+			literal, untyped = strconv.FormatFloat(value, 'f', -1, 64), "int"
+		} else {
+			literal = strconv.FormatFloat(value, 'g', -1, 64)
+		}
+	case string:
+		switch schema.Format {
+		case "byte", "date", "date-time", "json", "uuid", "binary":
+			return "", false
+		}
+		literal, untyped = strconv.Quote(value), "string"
+	default:
+		return "", false
+	}
+
+	typeDef := param.TypeDef()
+	if typeDef == untyped {
+		return literal, true
+	}
+	return typeDef + "(" + literal + ")", true
+}
+
+// DefaultValue returns the Go expression of the default of an optional
+// parameter, which the servers set it to when a request leaves it out, for
+// the param-defaults output option.
+func (pd ParameterDefinition) DefaultValue() string {
+	return pd.defaultValue
+}
+
+// ParamDefaults returns the parameters of the Params type with a default
+// value, set by its ApplyDefaults method.
+func (o OperationDefinition) ParamDefaults() []ParameterDefinition {
+	var params []ParameterDefinition
+	for _, param := range o.Params() {
+		if param.defaultValue != "" {
+			params = append(params, param)
+		}
+	}
+	return params
+}
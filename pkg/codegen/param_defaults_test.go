@@ -0,0 +1,78 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamDefaultsOutOfBounds(t *testing.T) {
+	spec := `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: parameter defaults
+paths:
+  /pets:
+    get:
+      operationId: findPets
+      parameters:
+        - name: status
+          in: query
+          schema:
+            type: string
+            enum: [available, sold]
+            default: pending
+      responses:
+        '200':
+          description: ok
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	opts := Configuration{
+		PackageName: "api",
+		Generate: GenerateOptions{
+			Models:    true,
+			ChiServer: true,
+		},
+	}
+	_, err = Generate(swagger, opts)
+	require.NoError(t, err, "defaults are only checked when they're applied")
+
+	opts.OutputOptions.ParamDefaults = true
+	_, err = Generate(swagger, opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the default of query parameter 'status' doesn't satisfy its schema")
+}
+
+func TestParamDefaultValue(t *testing.T) {
+	param := func(schema *openapi3.Schema, goType string) ParameterDefinition {
+		return ParameterDefinition{
+			ParamName: "p",
+			In:        "query",
+			Spec:      &openapi3.Parameter{Schema: schema.NewRef()},
+			Schema:    Schema{GoType: goType, OAPISchema: schema},
+		}
+	}
+	tests := []struct {
+		schema *openapi3.Schema
+		goType string
+		value  string
+	}{
+		{openapi3.NewIntegerSchema().WithDefault(float64(20)), "int", "20"},
+		{openapi3.NewInt32Schema().WithDefault(float64(20)), "int32", "int32(20)"},
+		{openapi3.NewFloat64Schema().WithDefault(float64(2)), "float32", "float32(2)"},
+		{openapi3.NewBoolSchema().WithDefault(true), "bool", "true"},
+		{openapi3.NewStringSchema().WithDefault("a \"b\""), "string", `"a \"b\""`},
+		{openapi3.NewDateTimeSchema().WithDefault("2020-01-01T00:00:00Z"), "time.Time", ""},
+		{openapi3.NewArraySchema().WithItems(openapi3.NewStringSchema()).WithDefault([]interface{}{"a"}), "[]string", ""},
+	}
+	for _, tt := range tests {
+		value, ok := paramDefaultValue(param(tt.schema, tt.goType))
+		assert.Equal(t, tt.value != "", ok, tt.goType)
+		assert.Equal(t, tt.value, value, tt.goType)
+	}
+}
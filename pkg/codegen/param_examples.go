@@ -0,0 +1,173 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// extInvalid marks an example of a parameter as one which must fail to bind.
+const extInvalid = "x-invalid"
+
+// ParameterExampleDefinition describes an example of a parameter, round-tripped
+// through the styling of the client and the binding of the server by the
+// tests of param-example-tests.
+type ParameterExampleDefinition struct {
+	OperationId string
+	Param       ParameterDefinition
+	Name        string
+	// Value is the example, in JSON.
+	Value string
+	// Invalid is set by x-invalid, for the examples which must fail to bind.
+	Invalid bool
+	// Unsupported is the reason the parameter can't be round-tripped, for
+	// which the test is skipped.
+	Unsupported string
+}
+
+// TestName returns the name of the subtest of the example.
+func (e ParameterExampleDefinition) TestName() string {
+	return e.OperationId + "/" + e.Param.In + "/" + e.Param.ParamName + "/" + e.Name
+}
+
+// Style returns the style the parameter is encoded with, which is always
+// simple for cookies, as the client and servers style them.
+func (e ParameterExampleDefinition) Style() string {
+	if e.Param.In == "cookie" {
+		return "simple"
+	}
+	return e.Param.Style()
+}
+
+// parameterExampleDefinitions lists the examples of the parameters of the
+// operations, in the order of the operations and their parameters, and of the
+// names of the examples. The examples of parameters which can't be
+// round-tripped are reported as warnings.
+func (g *generator) parameterExampleDefinitions(operations []OperationDefinition) ([]ParameterExampleDefinition, error) {
+	var definitions []ParameterExampleDefinition
+	for _, op := range operations {
+		var params []ParameterDefinition
+		params = append(params, op.PathParams...)
+		params = append(params, op.QueryParams...)
+		params = append(params, op.HeaderParams...)
+		params = append(params, op.CookieParams...)
+
+		for _, param := range params {
+			examples, err := parameterExamples(param.Spec)
+			if err != nil {
+				return nil, fmt.Errorf("error reading the examples of parameter '%s' of %s: %w", param.ParamName, op.OperationId, err)
+			}
+			if len(examples) == 0 {
+				continue
+			}
+			unsupported := unsupportedParameterExample(param)
+			if unsupported != "" {
+				g.addWarning(operationLocation(op.Path, op.Method),
+					"the examples of %s parameter '%s' aren't tested, since %s", param.In, param.ParamName, unsupported)
+			}
+			for i := range examples {
+				examples[i].OperationId = op.OperationId
+				examples[i].Param = param
+				examples[i].Unsupported = unsupported
+			}
+			definitions = append(definitions, examples...)
+		}
+	}
+	return definitions, nil
+}
+
+// parameterExamples returns the examples of p, named after the key of
+// examples, or example for the one of example.
+func parameterExamples(p *openapi3.Parameter) ([]ParameterExampleDefinition, error) {
+	var examples []ParameterExampleDefinition
+	if p.Example != nil {
+		value, err := json.Marshal(p.Example)
+		if err != nil {
+			return nil, err
+		}
+		examples = append(examples, ParameterExampleDefinition{Name: "example", Value: string(value)})
+	}
+
+	names := make([]string, 0, len(p.Examples))
+	for name := range p.Examples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		example := p.Examples[name]
+		if example == nil || example.Value == nil || example.Value.Value == nil {
+			continue
+		}
+		value, err := json.Marshal(example.Value.Value)
+		if err != nil {
+			return nil, fmt.Errorf("example '%s': %w", name, err)
+		}
+		var invalid bool
+		if ext, ok := example.Value.Extensions[extInvalid]; ok {
+			if invalid, ok = ext.(bool); !ok {
+				return nil, fmt.Errorf("example '%s': %s must be a boolean, not %T", name, extInvalid, ext)
+			}
+		}
+		examples = append(examples, ParameterExampleDefinition{Name: name, Value: string(value), Invalid: invalid})
+	}
+	return examples, nil
+}
+
+// unsupportedParameterExample returns why the examples of p can't be
+// round-tripped through the runtime, if they can't.
+func unsupportedParameterExample(p ParameterDefinition) string {
+	switch {
+	case p.IsHTTPDate():
+		return "HTTP-date headers aren't styled"
+	case !p.IsStyled():
+		return "parameters with content aren't styled"
+	case p.IsFormObject() || p.IsExplodedFormObject():
+		return "form objects are bound by the generated server rather than the runtime"
+	case p.IsIndexedDeepObject():
+		return "indexed deep objects are bound by the generated server rather than the runtime"
+	}
+	style := p.Style()
+	switch p.In {
+	case "path":
+		if style == "simple" || style == "label" || style == "matrix" {
+			return ""
+		}
+	case "query":
+		if style == "form" || style == "deepObject" {
+			return ""
+		}
+	case "header":
+		if style == "simple" {
+			return ""
+		}
+	case "cookie":
+		return ""
+	}
+	return fmt.Sprintf("the runtime doesn't bind %s parameters of style %s", p.In, style)
+}
+
+// generateParameterExampleTests generates the test file of param-example-tests
+// for package packageName, with a subtest per example of a parameter.
+func (g *generator) generateParameterExampleTests(t *template.Template, operations []OperationDefinition, packageName string, versionOverride *string) (string, error) {
+	examples, err := g.parameterExampleDefinitions(operations)
+	if err != nil {
+		return "", err
+	}
+	modulePath, moduleVersion := toolVersion(versionOverride)
+
+	context := struct {
+		PackageName string
+		ModuleName  string
+		Version     string
+		Examples    []ParameterExampleDefinition
+	}{
+		PackageName: packageName,
+		ModuleName:  modulePath,
+		Version:     moduleVersion,
+		Examples:    examples,
+	}
+	return GenerateTemplates([]string{"param-example-tests.tmpl"}, t, context)
+}
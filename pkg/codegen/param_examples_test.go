@@ -0,0 +1,89 @@
+package codegen
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const paramExamplesSpec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: Parameter examples
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+          example: 10
+        - name: tags
+          in: query
+          style: spaceDelimited
+          schema:
+            type: array
+            items:
+              type: string
+          examples:
+            two:
+              value: [cat, dog]
+        - name: since
+          in: header
+          schema:
+            type: string
+            format: date-time
+          examples:
+            now:
+              value: Mon, 02 Jan 2006 15:04:05 GMT
+        - name: offset
+          in: query
+          schema:
+            type: integer
+          examples:
+            negative:
+              value: -1
+              x-invalid: %s
+      responses:
+        '204':
+          description: Found
+`
+
+func TestGenerateFilesParamExampleTests(t *testing.T) {
+	files, warnings, err := GenerateFiles(context.Background(), []byte(fmt.Sprintf(paramExamplesSpec, "true")), Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true, ParamExampleTests: true},
+	})
+	require.NoError(t, err)
+
+	tests := string(files["param_examples_test.go"])
+	assert.Contains(t, tests, "package api")
+	assert.Contains(t, tests, `testParameterExample[int](t, "query", "form", true, "limit", "10", false)`)
+	assert.Contains(t, tests, `testParameterExample[int](t, "query", "form", true, "offset", "-1", true)`)
+	assert.Contains(t, tests, `t.Skip("the runtime doesn't bind query parameters of style spaceDelimited")`)
+	assert.Contains(t, tests, `t.Skip("HTTP-date headers aren't styled")`)
+
+	var messages []string
+	for _, w := range warnings {
+		assert.Equal(t, "paths./pets.get", w.Location)
+		messages = append(messages, w.Message)
+	}
+	assert.Equal(t, []string{
+		"the examples of query parameter 'tags' aren't tested, since the runtime doesn't bind query parameters of style spaceDelimited",
+		"the examples of header parameter 'since' aren't tested, since HTTP-date headers aren't styled",
+	}, messages)
+}
+
+func TestGenerateFilesParamExampleTestsInvalidExtension(t *testing.T) {
+	_, _, err := GenerateFiles(context.Background(), []byte(fmt.Sprintf(paramExamplesSpec, "yes please")), Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true, ParamExampleTests: true},
+	})
+	assert.ErrorContains(t, err, "error reading the examples of parameter 'offset' of ListPets: example 'negative': x-invalid must be a boolean, not string")
+}
@@ -0,0 +1,21 @@
+package codegen
+
+import "strings"
+
+// PreferParam returns the Prefer header parameter of the operation, of RFC
+// 7240, when its schema is a plain string, which may hold several
+// preferences, eg, `respond-async, wait=100`. The strict request object of the
+// operation then has a Preferences method parsing them. It's nil otherwise,
+// in particular for an enum, which gets constants of its own.
+func (o OperationDefinition) PreferParam() *ParameterDefinition {
+	for _, param := range o.HeaderParams {
+		if !strings.EqualFold(param.ParamName, "Prefer") || !param.IsStyled() || param.TypeDef() != "string" {
+			continue
+		}
+		if param.Spec.Schema != nil && param.Spec.Schema.Value != nil && len(param.Spec.Schema.Value.Enum) != 0 {
+			continue
+		}
+		return &param
+	}
+	return nil
+}
@@ -0,0 +1,318 @@
+package codegen
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"gopkg.in/yaml.v3"
+)
+
+// recordPropertyOrders records, in g.propertyOrders, the names of the
+// properties of the schemas of the document data, found at the location
+// document, in the order the document declares them, which kin-openapi
+// doesn't keep. They're keyed by the location of the document and the JSON
+// pointer of the schema, eg, "specs/api.yaml#/components/schemas/Pet". The
+// document itself is left as it is.
+func (g *generator) recordPropertyOrders(document string, data []byte) {
+	if !bytes.Contains(data, []byte("properties")) {
+		return
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		// The loader reports the error, in its own words.
+		return
+	}
+	if g.propertyOrders == nil {
+		g.propertyOrders = make(map[string][]string)
+	}
+	g.recordPropertyOrdersOf(&node, documentIdentity(document)+"#", false)
+}
+
+// recordPropertyOrdersOf records the order of the properties of node, at
+// pointer, and of its descendants. The properties of a schema are a mapping
+// of names, which is never one itself, though its values are. The values of
+// examples, defaults, enums and constants aren't schemas, and are skipped.
+func (g *generator) recordPropertyOrdersOf(node *yaml.Node, pointer string, isProperties bool) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			g.recordPropertyOrdersOf(child, pointer, false)
+		}
+	case yaml.AliasNode:
+		if node.Alias != nil {
+			g.recordPropertyOrdersOf(node.Alias, pointer, isProperties)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			g.recordPropertyOrdersOf(child, pointer+"/"+strconv.Itoa(i), false)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, child := node.Content[i].Value, node.Content[i+1]
+			childIsProperties := false
+			if !isProperties {
+				switch key {
+				case "example", "examples", "default", "enum", "const":
+					continue
+				case "properties":
+					childIsProperties = isPropertiesNode(child)
+					if childIsProperties && len(child.Content) != 0 {
+						g.propertyOrders[pointer] = mappingKeys(child)
+					}
+				}
+			}
+			g.recordPropertyOrdersOf(child, pointer+"/"+escapeJSONPointer(key), childIsProperties)
+		}
+	}
+}
+
+// mappingKeys returns the keys of the mapping node, in order.
+func mappingKeys(node *yaml.Node) []string {
+	keys := make([]string, 0, len(node.Content)/2)
+	for i := 0; i < len(node.Content); i += 2 {
+		keys = append(keys, node.Content[i].Value)
+	}
+	return keys
+}
+
+// isPropertiesNode returns whether node is the properties of a schema, a
+// mapping of names to schemas, rather than, eg, a schema called properties.
+func isPropertiesNode(node *yaml.Node) bool {
+	if node.Kind != yaml.MappingNode {
+		return false
+	}
+	for i := 1; i < len(node.Content); i += 2 {
+		if node.Content[i].Kind != yaml.MappingNode {
+			return false
+		}
+	}
+	return true
+}
+
+// indexPropertyOrders walks the schemas of swagger, loaded from the
+// document at location, along with their JSON pointers, recording in
+// g.propertyPositions the position of each of their properties among those
+// g.propertyOrders recorded for them. The positions are keyed by the refs
+// holding the properties, which the copies of a schema, and of the map of its
+// properties, share.
+func (g *generator) indexPropertyOrders(swagger *openapi3.T, location string) {
+	g.propertyPositions = make(map[*openapi3.SchemaRef]int)
+	x := propertyIndexer{g: g, visited: make(map[*openapi3.Schema]bool)}
+	document := documentIdentity(location)
+	if swagger.Paths != nil {
+		for path, item := range swagger.Paths.Map() {
+			x.pathItem(item, document, "/paths/"+escapeJSONPointer(path))
+		}
+	}
+	components := swagger.Components
+	if components == nil {
+		return
+	}
+	for name, schema := range components.Schemas {
+		x.schema(schema, document, "/components/schemas/"+escapeJSONPointer(name))
+	}
+	for name, param := range components.Parameters {
+		x.parameter(param, document, "/components/parameters/"+escapeJSONPointer(name))
+	}
+	for name, header := range components.Headers {
+		x.header(header, document, "/components/headers/"+escapeJSONPointer(name))
+	}
+	for name, requestBody := range components.RequestBodies {
+		x.requestBody(requestBody, document, "/components/requestBodies/"+escapeJSONPointer(name))
+	}
+	for name, response := range components.Responses {
+		x.response(response, document, "/components/responses/"+escapeJSONPointer(name))
+	}
+	for name, callback := range components.Callbacks {
+		x.callback(callback, document, "/components/callbacks/"+escapeJSONPointer(name))
+	}
+}
+
+// propertyIndexer walks a loaded spec for indexPropertyOrders. Each node is
+// given the document declaring it and its JSON pointer in that document,
+// which are those its ref points to when it has one.
+type propertyIndexer struct {
+	g       *generator
+	visited map[*openapi3.Schema]bool
+}
+
+// target returns the document and the JSON pointer ref, found in document,
+// points to.
+func (x *propertyIndexer) target(document string, ref string) (string, string) {
+	target := resolveDocumentRef(document, ref)
+	targetDocument, pointer, _ := strings.Cut(target, "#")
+	return documentIdentity(targetDocument), pointer
+}
+
+func (x *propertyIndexer) schema(ref *openapi3.SchemaRef, document string, pointer string) {
+	if ref == nil || ref.Value == nil {
+		return
+	}
+	if ref.Ref != "" {
+		document, pointer = x.target(document, ref.Ref)
+	}
+	schema := ref.Value
+	if x.visited[schema] {
+		return
+	}
+	x.visited[schema] = true
+
+	for i, name := range x.g.propertyOrders[document+"#"+pointer] {
+		if property := schema.Properties[name]; property != nil {
+			x.g.propertyPositions[property] = i
+		}
+	}
+	for name, property := range schema.Properties {
+		x.schema(property, document, pointer+"/properties/"+escapeJSONPointer(name))
+	}
+	x.schema(schema.Items, document, pointer+"/items")
+	x.schema(schema.AdditionalProperties.Schema, document, pointer+"/additionalProperties")
+	x.schema(schema.Not, document, pointer+"/not")
+	for keyword, members := range map[string]openapi3.SchemaRefs{"allOf": schema.AllOf, "anyOf": schema.AnyOf, "oneOf": schema.OneOf} {
+		for i, member := range members {
+			x.schema(member, document, pointer+"/"+keyword+"/"+strconv.Itoa(i))
+		}
+	}
+}
+
+func (x *propertyIndexer) content(content openapi3.Content, document string, pointer string) {
+	for mediaType, media := range content {
+		if media != nil {
+			x.schema(media.Schema, document, pointer+"/content/"+escapeJSONPointer(mediaType)+"/schema")
+		}
+	}
+}
+
+func (x *propertyIndexer) parameter(ref *openapi3.ParameterRef, document string, pointer string) {
+	if ref == nil || ref.Value == nil {
+		return
+	}
+	if ref.Ref != "" {
+		document, pointer = x.target(document, ref.Ref)
+	}
+	x.schema(ref.Value.Schema, document, pointer+"/schema")
+	x.content(ref.Value.Content, document, pointer)
+}
+
+func (x *propertyIndexer) header(ref *openapi3.HeaderRef, document string, pointer string) {
+	if ref == nil || ref.Value == nil {
+		return
+	}
+	if ref.Ref != "" {
+		document, pointer = x.target(document, ref.Ref)
+	}
+	x.schema(ref.Value.Schema, document, pointer+"/schema")
+	x.content(ref.Value.Content, document, pointer)
+}
+
+func (x *propertyIndexer) requestBody(ref *openapi3.RequestBodyRef, document string, pointer string) {
+	if ref == nil || ref.Value == nil {
+		return
+	}
+	if ref.Ref != "" {
+		document, pointer = x.target(document, ref.Ref)
+	}
+	x.content(ref.Value.Content, document, pointer)
+}
+
+func (x *propertyIndexer) response(ref *openapi3.ResponseRef, document string, pointer string) {
+	if ref == nil || ref.Value == nil {
+		return
+	}
+	if ref.Ref != "" {
+		document, pointer = x.target(document, ref.Ref)
+	}
+	for name, header := range ref.Value.Headers {
+		x.header(header, document, pointer+"/headers/"+escapeJSONPointer(name))
+	}
+	x.content(ref.Value.Content, document, pointer)
+}
+
+func (x *propertyIndexer) callback(ref *openapi3.CallbackRef, document string, pointer string) {
+	if ref == nil || ref.Value == nil {
+		return
+	}
+	if ref.Ref != "" {
+		document, pointer = x.target(document, ref.Ref)
+	}
+	for expression, item := range ref.Value.Map() {
+		x.pathItem(item, document, pointer+"/"+escapeJSONPointer(expression))
+	}
+}
+
+func (x *propertyIndexer) pathItem(item *openapi3.PathItem, document string, pointer string) {
+	if item == nil {
+		return
+	}
+	for i, param := range item.Parameters {
+		x.parameter(param, document, pointer+"/parameters/"+strconv.Itoa(i))
+	}
+	for method, op := range item.Operations() {
+		opPointer := pointer + "/" + strings.ToLower(method)
+		for i, param := range op.Parameters {
+			x.parameter(param, document, opPointer+"/parameters/"+strconv.Itoa(i))
+		}
+		x.requestBody(op.RequestBody, document, opPointer+"/requestBody")
+		if op.Responses != nil {
+			for code, response := range op.Responses.Map() {
+				x.response(response, document, opPointer+"/responses/"+escapeJSONPointer(code))
+			}
+		}
+		for name, callback := range op.Callbacks {
+			x.callback(callback, document, opPointer+"/callbacks/"+escapeJSONPointer(name))
+		}
+	}
+}
+
+// setPropertyOrder records names as the order of properties, the properties
+// of a schema built by the generator, eg, by a merge. The refs holding them
+// are replaced by copies, so that the positions of those of the schemas they
+// come from are left as they are.
+func (g *generator) setPropertyOrder(properties openapi3.Schemas, names []string) {
+	if len(properties) == 0 {
+		return
+	}
+	if g.propertyPositions == nil {
+		g.propertyPositions = make(map[*openapi3.SchemaRef]int)
+	}
+	copied := make(map[string]bool, len(properties))
+	for i, name := range names {
+		property := properties[name]
+		if property == nil || copied[name] {
+			continue
+		}
+		property = &openapi3.SchemaRef{Ref: property.Ref, Value: property.Value}
+		properties[name] = property
+		copied[name] = true
+		g.propertyPositions[property] = i
+	}
+}
+
+// orderedPropertyNames returns the names of the properties of schema in the
+// order of the fields generated for them. That's their sorted order, unless
+// the property-order output option is "declared", in which case it's the
+// order the spec declares them in, followed by those whose order isn't known,
+// sorted.
+func (g *generator) orderedPropertyNames(schema *openapi3.Schema) []string {
+	sorted := SortedSchemaKeys(schema.Properties)
+	if g.options.OutputOptions.PropertyOrder != PropertyOrderDeclared || len(sorted) == 0 {
+		return sorted
+	}
+	var declared, rest []string
+	for _, name := range sorted {
+		if _, found := g.propertyPositions[schema.Properties[name]]; found {
+			declared = append(declared, name)
+		} else {
+			rest = append(rest, name)
+		}
+	}
+	// The names are sorted already, which breaks the ties between the
+	// positions of properties declared by different schemas.
+	sort.SliceStable(declared, func(i, j int) bool {
+		return g.propertyPositions[schema.Properties[declared[i]]] < g.propertyPositions[schema.Properties[declared[j]]]
+	})
+	return append(declared, rest...)
+}
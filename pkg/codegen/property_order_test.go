@@ -0,0 +1,150 @@
+package codegen
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// propertyOrderSpec has a three-member allOf whose later members override
+// properties of the earlier ones.
+const propertyOrderSpec = `{
+  "openapi": "3.0.3",
+  "info": {"version": "1.0.0", "title": "property order"},
+  "paths": {},
+  "components": {
+    "schemas": {
+      "Base": {
+        "type": "object",
+        "properties": {
+          "zeta": {"type": "string"},
+          "alpha": {"type": "string"},
+          "on": {"type": "string"}
+        }
+      },
+      "Merged": {
+        "allOf": [
+          {"$ref": "#/components/schemas/Base"},
+          {"properties": {"mu": {"type": "integer"}, "alpha": {"type": "string", "description": "overridden"}}},
+          {"properties": {"zeta": {"type": "string", "description": "overridden again"}, "beta": {"type": "string"}}}
+        ]
+      }
+    }
+  }
+}`
+
+// mergedFields returns the JSON names of the fields of the struct Merged
+// generated with order.
+func mergedFields(t *testing.T, order PropertyOrder) (string, []string) {
+	t.Helper()
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{
+			SkipPrune:     true,
+			PropertyOrder: order,
+		},
+	}
+	files, _, err := GenerateFiles(context.Background(), []byte(propertyOrderSpec), cfg)
+	require.NoError(t, err)
+	code := string(files["api.gen.go"])
+	body := regexp.MustCompile(`(?s)type Merged struct \{(.*?)\n\}`).FindStringSubmatch(code)
+	require.NotNil(t, body, code)
+	var names []string
+	for _, tag := range regexp.MustCompile(`json:"(\w+)`).FindAllStringSubmatch(body[1], -1) {
+		names = append(names, tag[1])
+	}
+	return code, names
+}
+
+func TestPropertyOrder(t *testing.T) {
+	_, sorted := mergedFields(t, "")
+	assert.Equal(t, []string{"alpha", "beta", "mu", "on", "zeta"}, sorted)
+
+	code, declared := mergedFields(t, PropertyOrderDeclared)
+	assert.Equal(t, []string{"zeta", "alpha", "on", "mu", "beta"}, declared)
+
+	// The generation is the same every time.
+	for i := 0; i < 5; i++ {
+		again, _ := mergedFields(t, PropertyOrderDeclared)
+		require.Equal(t, code, again)
+	}
+}
+
+func TestRecordPropertyOrders(t *testing.T) {
+	g := &generator{}
+	g.recordPropertyOrders("specs/../doc.yaml", []byte(`
+schema:
+  properties:
+    b:
+      type: object
+      properties:
+        d: {}
+        c: {}
+    a:
+      type: string
+    properties:
+      type: object
+      additionalProperties: {type: string}
+  example:
+    properties: {x: {}, y: {}}
+paths:
+  /a/{b}:
+    get:
+      parameters:
+        - schema:
+            properties: {f: {}, e: {}}
+`))
+	assert.Equal(t, map[string][]string{
+		"doc.yaml#/schema":                                 {"b", "a", "properties"},
+		"doc.yaml#/schema/properties/b":                    {"d", "c"},
+		"doc.yaml#/paths/~1a~1{b}/get/parameters/0/schema": {"f", "e"},
+	}, g.propertyOrders)
+}
+
+func TestPropertyOrderOfReferencedDocuments(t *testing.T) {
+	spec := `
+openapi: "3.0.3"
+info: {version: 1.0.0, title: property order}
+paths:
+  /things:
+    get:
+      responses:
+        "200":
+          description: A thing.
+          content:
+            application/json:
+              schema:
+                $ref: './models.yaml#/components/schemas/Thing'
+`
+	models := `
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        zeta: {type: string}
+        alpha: {$ref: '#/components/schemas/Part'}
+    Part:
+      type: object
+      properties:
+        second: {type: string}
+        first: {type: string}
+`
+	cfg := Configuration{
+		SpecLocation:  "specs/api.yaml",
+		Loader:        mapLoader{"specs/models.yaml": models},
+		OutputOptions: OutputOptions{PropertyOrder: PropertyOrderDeclared},
+	}
+	g := &generator{options: cfg}
+	swagger, err := g.loadSpec(context.Background(), []byte(spec), cfg)
+	require.NoError(t, err)
+	thing := swagger.Paths.Find("/things").Get.Responses.Status(200).Value.Content.Get("application/json").Schema.Value
+	assert.Equal(t, []string{"zeta", "alpha"}, g.orderedPropertyNames(thing))
+	assert.Equal(t, []string{"second", "first"}, g.orderedPropertyNames(thing.Properties["alpha"].Value))
+	// The spec isn't rewritten to record the order.
+	assert.Nil(t, thing.Extensions)
+}
@@ -0,0 +1,141 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// queryStyleIndexedDeepObject is the x-go-query-style of query parameters
+// holding arrays of objects, whose properties are keyed by the index of their
+// object, eg, filters[0][field]=age&filters[0][op]=gt.
+const queryStyleIndexedDeepObject = "indexed-deep-object"
+
+// maxIndexedDeepObjectDepth is the number of nested objects, counting the
+// items of the array, which a parameter with the indexed-deep-object query
+// style may have, as the generated binder rejects deeper keys before walking
+// them. It matches the constant of indexed-deep-object-bind.tmpl.
+const maxIndexedDeepObjectDepth = 8
+
+// IsIndexedDeepObject reports whether the parameter is an array of objects
+// in the query with x-go-query-style set to indexed-deep-object, eg,
+// filters[0][field]=age&filters[0][op]=gt, which is styled and bound by the
+// generated code, see checkQueryStyles.
+func (pd *ParameterDefinition) IsIndexedDeepObject() bool {
+	if pd.Spec == nil {
+		return false
+	}
+	style, _ := pd.Spec.Extensions[extGoQueryStyle].(string)
+	return style == queryStyleIndexedDeepObject
+}
+
+// IndexedDeepObjectRequiredProperties returns the keys, after the index, of
+// the required properties of the items of an indexed-deep-object parameter,
+// eg, [field] or [range][min], which the binder checks for in every item, and
+// for the nested ones, in every item having the object holding them.
+func (pd *ParameterDefinition) IndexedDeepObjectRequiredProperties() []string {
+	items := pd.Spec.Schema.Value.Items
+	if items == nil {
+		return nil
+	}
+	return indexedDeepObjectRequiredProperties(items.Value, "")
+}
+
+func indexedDeepObjectRequiredProperties(s *openapi3.Schema, prefix string) []string {
+	var required []string
+	for _, name := range s.Required {
+		required = append(required, prefix+"["+name+"]")
+	}
+	for _, name := range SortedSchemaKeys(s.Properties) {
+		property := s.Properties[name]
+		if property.Value != nil && schemaType(property.Value) == "object" {
+			required = append(required, indexedDeepObjectRequiredProperties(property.Value, prefix+"["+name+"]")...)
+		}
+	}
+	return required
+}
+
+// HasIndexedDeepObjectQueryParams returns whether any query parameter has
+// the indexed-deep-object query style, see
+// ParameterDefinition.IsIndexedDeepObject.
+func (o OperationDefinition) HasIndexedDeepObjectQueryParams() bool {
+	for _, param := range o.QueryParams {
+		if param.IsIndexedDeepObject() {
+			return true
+		}
+	}
+	return false
+}
+
+// checkQueryStyles checks the x-go-query-style of params: only query
+// parameters may have one, and the indexed-deep-object style requires an
+// array of objects, whose properties are primitive values or objects, nested
+// at most maxIndexedDeepObjectDepth levels deep.
+func checkQueryStyles(params []ParameterDefinition) error {
+	for _, param := range params {
+		if param.Spec == nil {
+			continue
+		}
+		extension, found := param.Spec.Extensions[extGoQueryStyle]
+		if !found {
+			continue
+		}
+		style, err := extString(extension)
+		if err != nil {
+			return fmt.Errorf("invalid value for %q of parameter '%s': %w", extGoQueryStyle, param.ParamName, err)
+		}
+		if style != queryStyleIndexedDeepObject {
+			return fmt.Errorf("invalid value for %q of parameter '%s': %q, expected %q",
+				extGoQueryStyle, param.ParamName, style, queryStyleIndexedDeepObject)
+		}
+		if param.In != "query" {
+			return fmt.Errorf("%s of %s parameter '%s': only query parameters may have a query style",
+				extGoQueryStyle, param.In, param.ParamName)
+		}
+		schema := param.Spec.Schema
+		if schema == nil || schema.Value == nil || schemaType(schema.Value) != "array" ||
+			schema.Value.Items == nil || schema.Value.Items.Value == nil || schemaType(schema.Value.Items.Value) != "object" {
+			return fmt.Errorf("%s %s of parameter '%s' requires an array of objects",
+				extGoQueryStyle, style, param.ParamName)
+		}
+		if err := checkIndexedDeepObjectItem(schema.Value.Items.Value, "", 1); err != nil {
+			return fmt.Errorf("%s %s of parameter '%s': %w", extGoQueryStyle, style, param.ParamName, err)
+		}
+	}
+	return nil
+}
+
+// checkIndexedDeepObjectItem checks the object s, at the key prefix after
+// the index, and depth levels deep, can be styled as an indexed deep object.
+func checkIndexedDeepObjectItem(s *openapi3.Schema, prefix string, depth int) error {
+	if depth > maxIndexedDeepObjectDepth {
+		return fmt.Errorf("objects are nested more than %d levels deep at %s", maxIndexedDeepObjectDepth, prefix)
+	}
+	if len(s.Properties) == 0 || SchemaHasAdditionalProperties(s) ||
+		len(s.AllOf) != 0 || len(s.AnyOf) != 0 || len(s.OneOf) != 0 {
+		what := "the items"
+		if prefix != "" {
+			what = "property " + prefix
+		}
+		return fmt.Errorf("%s must be objects with properties, and without additionalProperties, allOf, anyOf or oneOf", what)
+	}
+	for _, name := range SortedSchemaKeys(s.Properties) {
+		if strings.ContainsAny(name, "[]") {
+			return fmt.Errorf("property %s[%s] has brackets in its name", prefix, name)
+		}
+		property := s.Properties[name].Value
+		if property == nil {
+			continue
+		}
+		switch schemaType(property) {
+		case "object":
+			if err := checkIndexedDeepObjectItem(property, prefix+"["+name+"]", depth+1); err != nil {
+				return err
+			}
+		case "array":
+			return fmt.Errorf("property %s[%s] is an array, which can't be styled", prefix, name)
+		}
+	}
+	return nil
+}
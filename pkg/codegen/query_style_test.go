@@ -0,0 +1,198 @@
+package codegen
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// queryStyleSpec is a spec with a single query parameter, filters, whose
+// schema and extra fields are given.
+func queryStyleSpec(param string) string {
+	return fmt.Sprintf(`
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: x-go-query-style
+paths:
+  /people:
+    get:
+      operationId: listPeople
+      parameters:
+        - name: filters
+          style: deepObject
+%s
+      responses:
+        "204":
+          description: No content.
+`, param)
+}
+
+func TestIndexedDeepObject(t *testing.T) {
+	spec := queryStyleSpec(`
+          in: query
+          x-go-query-style: indexed-deep-object
+          schema:
+            type: array
+            items:
+              type: object
+              required: [field]
+              properties:
+                field:
+                  type: string
+                range:
+                  type: object
+                  required: [min]
+                  properties:
+                    min:
+                      type: integer`)
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	for _, generate := range []GenerateOptions{{ChiServer: true}, {EchoServer: true}, {GinServer: true}, {FiberServer: true}, {IrisServer: true}, {GorillaServer: true}} {
+		code, err := Generate(swagger, Configuration{
+			PackageName: "api",
+			Generate:    generate,
+		})
+		require.NoError(t, err)
+		assert.Contains(t, code, `err = bindIndexedDeepObjectQueryParam(false, "filters", `)
+		assert.Contains(t, code, `&params.Filters, "[field]", "[range][min]")`)
+		assert.Contains(t, code, "type InvalidQueryKeyError struct {")
+	}
+
+	code, err := Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Client: true},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, `styleIndexedDeepObjectQueryParam("filters", *params.Filters)`)
+}
+
+func TestIndexedDeepObjectErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		param string
+		err   string
+	}{
+		{
+			name: "unknown style",
+			param: `
+          in: query
+          x-go-query-style: brackets
+          schema:
+            type: array
+            items:
+              type: string`,
+			err: `invalid value for "x-go-query-style" of parameter 'filters': "brackets", expected "indexed-deep-object"`,
+		},
+		{
+			name: "header",
+			param: `
+          in: header
+          x-go-query-style: indexed-deep-object
+          schema:
+            type: array
+            items:
+              type: string`,
+			err: "x-go-query-style of header parameter 'filters': only query parameters may have a query style",
+		},
+		{
+			name: "array of strings",
+			param: `
+          in: query
+          x-go-query-style: indexed-deep-object
+          schema:
+            type: array
+            items:
+              type: string`,
+			err: "x-go-query-style indexed-deep-object of parameter 'filters' requires an array of objects",
+		},
+		{
+			name: "array property",
+			param: `
+          in: query
+          x-go-query-style: indexed-deep-object
+          schema:
+            type: array
+            items:
+              type: object
+              properties:
+                tags:
+                  type: array
+                  items:
+                    type: string`,
+			err: "x-go-query-style indexed-deep-object of parameter 'filters': property [tags] is an array, which can't be styled",
+		},
+		{
+			name: "additional properties",
+			param: `
+          in: query
+          x-go-query-style: indexed-deep-object
+          schema:
+            type: array
+            items:
+              type: object
+              properties:
+                range:
+                  type: object
+                  additionalProperties:
+                    type: integer`,
+			err: "x-go-query-style indexed-deep-object of parameter 'filters': property [range] must be objects with properties, and without additionalProperties, allOf, anyOf or oneOf",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			swagger, err := openapi3.NewLoader().LoadFromData([]byte(queryStyleSpec(tt.param)))
+			require.NoError(t, err)
+			_, err = Generate(swagger, Configuration{
+				PackageName: "api",
+				Generate:    GenerateOptions{ChiServer: true},
+			})
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestIndexedDeepObjectDepth(t *testing.T) {
+	const spec = `
+openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: x-go-query-style
+paths:
+  /trees:
+    get:
+      operationId: listTrees
+      parameters:
+        - name: nodes
+          in: query
+          style: deepObject
+          x-go-query-style: indexed-deep-object
+          schema:
+            type: array
+            items:
+              $ref: '#/components/schemas/Node'
+      responses:
+        "204":
+          description: No content.
+components:
+  schemas:
+    Node:
+      type: object
+      properties:
+        name:
+          type: string
+        child:
+          $ref: '#/components/schemas/Node'
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	_, err = Generate(swagger, Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{ChiServer: true},
+	})
+	assert.ErrorContains(t, err, "objects are nested more than 8 levels deep at [child][child][child][child][child][child][child][child]")
+}
@@ -0,0 +1,116 @@
+package codegen
+
+import (
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// The parameters, request bodies, responses and headers of operations may be
+// refs to another document, as their schemas may. The refs found in them are
+// then relative to that document, and are resolved against it, as those of
+// remote schemas are by propagateRemoteRefs, so that their types are those of
+// the package the document is import-mapped to rather than ours.
+
+// remoteDocument returns the document ref points into, or "" when ref is
+// local to our spec, or isn't a ref.
+func remoteDocument(ref string) string {
+	if ref == "" || ref[0] == '#' {
+		return ""
+	}
+	document, _, _ := strings.Cut(ref, "#")
+	return document
+}
+
+// parameterOfRef returns the parameter paramOrRef refers to, with the refs of
+// its schemas resolved against the document it comes from.
+func parameterOfRef(paramOrRef *openapi3.ParameterRef) *openapi3.Parameter {
+	document := remoteDocument(paramOrRef.Ref)
+	if document == "" || paramOrRef.Value == nil {
+		return paramOrRef.Value
+	}
+	param := *paramOrRef.Value
+	param.Schema = propagateRemoteRef(param.Schema, document)
+	param.Content = contentOfDocument(param.Content, document)
+	return &param
+}
+
+// requestBodyOfRef returns the request body bodyOrRef refers to, with the refs
+// of its schemas resolved against the document it comes from.
+func requestBodyOfRef(bodyOrRef *openapi3.RequestBodyRef) *openapi3.RequestBody {
+	document := remoteDocument(bodyOrRef.Ref)
+	if document == "" || bodyOrRef.Value == nil {
+		return bodyOrRef.Value
+	}
+	body := *bodyOrRef.Value
+	body.Content = contentOfDocument(body.Content, document)
+	return &body
+}
+
+// responseOfRef returns the response responseOrRef refers to, with the refs
+// of its schemas and headers resolved against the document it comes from.
+func responseOfRef(responseOrRef *openapi3.ResponseRef) *openapi3.Response {
+	document := remoteDocument(responseOrRef.Ref)
+	if document == "" || responseOrRef.Value == nil {
+		return responseOrRef.Value
+	}
+	response := *responseOrRef.Value
+	response.Content = contentOfDocument(response.Content, document)
+	if response.Headers != nil {
+		response.Headers = make(openapi3.Headers, len(responseOrRef.Value.Headers))
+		for name, header := range responseOrRef.Value.Headers {
+			response.Headers[name] = headerRefOfDocument(header, document)
+		}
+	}
+	return &response
+}
+
+// headerOfRef returns the header headerOrRef refers to, with the refs of its
+// schema resolved against the document it comes from.
+func headerOfRef(headerOrRef *openapi3.HeaderRef) *openapi3.Header {
+	document := remoteDocument(headerOrRef.Ref)
+	if document == "" || headerOrRef.Value == nil {
+		return headerOrRef.Value
+	}
+	header := *headerOrRef.Value
+	header.Schema = propagateRemoteRef(header.Schema, document)
+	header.Content = contentOfDocument(header.Content, document)
+	return &header
+}
+
+// headerRefOfDocument returns headerOrRef, found in document, with its ref, or
+// the refs of its schema, resolved against document.
+func headerRefOfDocument(headerOrRef *openapi3.HeaderRef, document string) *openapi3.HeaderRef {
+	if headerOrRef == nil {
+		return nil
+	}
+	if headerOrRef.Ref != "" {
+		return &openapi3.HeaderRef{Ref: resolveDocumentRef(document, headerOrRef.Ref), Value: headerOrRef.Value}
+	}
+	if headerOrRef.Value == nil {
+		return headerOrRef
+	}
+	header := *headerOrRef.Value
+	header.Schema = propagateRemoteRef(header.Schema, document)
+	header.Content = contentOfDocument(header.Content, document)
+	return &openapi3.HeaderRef{Value: &header}
+}
+
+// contentOfDocument returns content, found in document, with the refs of its
+// schemas resolved against document.
+func contentOfDocument(content openapi3.Content, document string) openapi3.Content {
+	if content == nil {
+		return nil
+	}
+	result := make(openapi3.Content, len(content))
+	for contentType, mediaType := range content {
+		if mediaType == nil {
+			result[contentType] = nil
+			continue
+		}
+		propagated := *mediaType
+		propagated.Schema = propagateRemoteRef(mediaType.Schema, document)
+		result[contentType] = &propagated
+	}
+	return result
+}
@@ -0,0 +1,56 @@
+package codegen
+
+import (
+	"sort"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// RouteDefinition describes an operation listed in the generated route table.
+type RouteDefinition struct {
+	OperationId string
+	Method      string
+	Path        string // The path template the operation is served on, with the route prefix
+	// Security holds the alternative security requirements of the operation,
+	// each mapping security scheme names to the scopes they require.
+	Security []map[string][]string
+}
+
+// routeDefinitions lists the operations for the route table, taking their
+// security requirements from the operation when it sets any, and from the
+// spec otherwise.
+func (g *generator) routeDefinitions(spec *openapi3.T, operations []OperationDefinition) []RouteDefinition {
+	routes := make([]RouteDefinition, 0, len(operations))
+	for _, op := range operations {
+		requirements := spec.Security
+		if op.Spec != nil && op.Spec.Security != nil {
+			requirements = *op.Spec.Security
+		}
+		var security []map[string][]string
+		for _, requirement := range requirements {
+			security = append(security, map[string][]string(requirement))
+		}
+		routes = append(routes, RouteDefinition{
+			OperationId: op.OperationId,
+			Method:      op.Method,
+			Path:        g.routePath(op.RoutePath()),
+			Security:    security,
+		})
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes
+}
+
+// generateRouteTable generates the OperationRoutes table, along with
+// FindOperation matching requests against it. It depends on neither the
+// models nor a server, so that it can be generated on its own, or along with
+// the embedded spec.
+func (g *generator) generateRouteTable(t *template.Template, spec *openapi3.T, operations []OperationDefinition) (string, error) {
+	return GenerateTemplates([]string{"route-table.tmpl"}, t, g.routeDefinitions(spec, operations))
+}
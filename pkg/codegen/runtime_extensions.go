@@ -0,0 +1,155 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// ExtensionLiteral is a vendor extension, along with its value as a Go
+// literal.
+type ExtensionLiteral struct {
+	Name  string
+	Value string
+}
+
+// OperationExtensionsDefinition lists the vendor extensions of an operation.
+type OperationExtensionsDefinition struct {
+	OperationId string
+	Extensions  []ExtensionLiteral
+}
+
+// FieldExtensionsDefinition lists the vendor extensions of the properties of a
+// struct type, keyed by their JSON name.
+type FieldExtensionsDefinition struct {
+	TypeName string
+	Fields   map[string][]ExtensionLiteral
+}
+
+// GenerateOperationExtensions generates OperationExtensions, returning the
+// vendor extensions of every operation, for the runtime-extensions output
+// option.
+func GenerateOperationExtensions(t *template.Template, ops []OperationDefinition) (string, error) {
+	definitions := make([]OperationExtensionsDefinition, 0, len(ops))
+	for _, op := range ops {
+		if op.Spec == nil || len(op.Spec.Extensions) == 0 {
+			continue
+		}
+		extensions, err := extensionLiterals(op.Spec.Extensions)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", operationLocation(op.Path, op.Method), err)
+		}
+		definitions = append(definitions, OperationExtensionsDefinition{
+			OperationId: op.OperationId,
+			Extensions:  extensions,
+		})
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].OperationId < definitions[j].OperationId
+	})
+	return GenerateTemplates([]string{"operation-extensions.tmpl"}, t, definitions)
+}
+
+// GenerateFieldExtensions generates a FieldExtensions method for the struct
+// types among the given type definitions having properties with vendor
+// extensions, for the runtime-extensions output option.
+func GenerateFieldExtensions(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	var definitions []FieldExtensionsDefinition
+	generated := make(map[string]bool)
+	for _, td := range typeDefs {
+		if generated[td.TypeName] || td.IsAlias() || td.Schema.IsRef() || !isStructType(td.Schema.GoType) {
+			continue
+		}
+		generated[td.TypeName] = true
+
+		fields := make(map[string][]ExtensionLiteral)
+		for _, p := range td.Schema.Properties {
+			if len(p.Extensions) == 0 {
+				continue
+			}
+			extensions, err := extensionLiterals(p.Extensions)
+			if err != nil {
+				return "", fmt.Errorf("property %s of %s: %w", p.JsonFieldName, td.TypeName, err)
+			}
+			fields[p.JsonFieldName] = extensions
+		}
+		if len(fields) != 0 {
+			definitions = append(definitions, FieldExtensionsDefinition{
+				TypeName: td.TypeName,
+				Fields:   fields,
+			})
+		}
+	}
+	if len(definitions) == 0 {
+		return "", nil
+	}
+	return GenerateTemplates([]string{"field-extensions.tmpl"}, t, definitions)
+}
+
+// extensionLiterals returns the given extensions as Go literals, sorted by
+// name.
+func extensionLiterals(extensions map[string]interface{}) ([]ExtensionLiteral, error) {
+	names := sortedInterfaceKeys(extensions)
+	literals := make([]ExtensionLiteral, 0, len(names))
+	for _, name := range names {
+		value, err := extensionLiteral(extensions[name])
+		if err != nil {
+			return nil, fmt.Errorf("extension %s: %w", name, err)
+		}
+		literals = append(literals, ExtensionLiteral{Name: name, Value: value})
+	}
+	return literals, nil
+}
+
+// extensionLiteral returns value, as decoded from the spec, as a Go literal
+// of the type encoding/json decodes it to in an interface{}, so that numbers
+// are float64, arrays []interface{} and objects map[string]interface{}. Other
+// values, which the spec decoder leaves in some other form, are an error,
+// rather than being partially decoded.
+func extensionLiteral(value interface{}) (string, error) {
+	switch value := value.(type) {
+	case nil:
+		return "nil", nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	case string:
+		return strconv.Quote(value), nil
+	case float64:
+		return "float64(" + strconv.FormatFloat(value, 'g', -1, 64) + ")", nil
+	case []interface{}:
+		items := make([]string, len(value))
+		for i, item := range value {
+			literal, err := extensionLiteral(item)
+			if err != nil {
+				return "", fmt.Errorf("item %d: %w", i, err)
+			}
+			items[i] = literal
+		}
+		return "[]interface{}{" + strings.Join(items, ", ") + "}", nil
+	case map[string]interface{}:
+		keys := sortedInterfaceKeys(value)
+		entries := make([]string, len(keys))
+		for i, key := range keys {
+			literal, err := extensionLiteral(value[key])
+			if err != nil {
+				return "", fmt.Errorf("key %s: %w", key, err)
+			}
+			entries[i] = strconv.Quote(key) + ": " + literal
+		}
+		return "map[string]interface{}{" + strings.Join(entries, ", ") + "}", nil
+	default:
+		return "", fmt.Errorf("value of type %T can't be decoded", value)
+	}
+}
+
+// sortedInterfaceKeys returns the keys of dict, sorted.
+func sortedInterfaceKeys(dict map[string]interface{}) []string {
+	keys := make([]string, 0, len(dict))
+	for key := range dict {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
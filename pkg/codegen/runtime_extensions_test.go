@@ -0,0 +1,30 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtensionLiteral(t *testing.T) {
+	got, err := extensionLiteral(map[string]interface{}{
+		"b": []interface{}{float64(1), "two", nil},
+		"a": true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `map[string]interface{}{"a": true, "b": []interface{}{float64(1), "two", nil}}`, got)
+
+	_, err = extensionLiteral(map[string]interface{}{"a": []interface{}{1}})
+	assert.EqualError(t, err, "key a: item 0: value of type int can't be decoded")
+}
+
+func TestGenerateOperationExtensionsError(t *testing.T) {
+	op := openapi3.NewOperation()
+	op.Extensions = map[string]interface{}{"x-limit": struct{}{}}
+	_, err := GenerateOperationExtensions(nil, []OperationDefinition{
+		{OperationId: "ListPets", Path: "/pets", Method: "GET", Spec: op},
+	})
+	assert.EqualError(t, err, operationLocation("/pets", "GET")+": extension x-limit: value of type struct {} can't be decoded")
+}
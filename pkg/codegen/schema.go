@@ -1,8 +1,10 @@
 package codegen
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -16,25 +18,50 @@ type Schema struct {
 
 	ArrayType *Schema // The schema of array element
 
-	EnumValues map[string]string // Enum values
+	EnumValues       map[string]string // Enum values
+	EnumDescriptions map[string]string // Enum value descriptions, keyed by value
 
 	Properties               []Property       // For an object, the fields with names
 	HasAdditionalProperties  bool             // Whether we support additional properties
 	AdditionalPropertiesType *Schema          // And if we do, their type
+	NoAdditionalProperties   bool             // Whether additional properties are explicitly disallowed
 	AdditionalTypes          []TypeDefinition // We may need to generate auxiliary helper types, stored here
 
 	SkipOptionalPointer bool // Some types don't need a * in front when they're optional
 
 	Description string // The description of the element
 
+	// Patterns are the regular expressions a string must all match: the
+	// pattern of the schema, or those of all the members of an allOf.
+	Patterns []string
+
 	UnionElements []UnionElement // Possible elements of oneOf/anyOf union
 	Discriminator *Discriminator // Describes which value is stored in a union
 
+	// NullableUnion is set when the schema is the member of a oneOf or anyOf
+	// with null, as OpenAPI 3.1 writes nullable schemas, see
+	// nullableUnionMember.
+	NullableUnion bool
+
+	// NullableAllOf is set when the schema is an allOf whose members merge
+	// into a nullable schema, see CompatibilityOptions.AllOfNullableResolution.
+	NullableAllOf bool
+
 	// If this is set, the schema will declare a type via alias, eg,
 	// `type Foo = bool`. If this is not set, we will define this type via
-	// type definition `type Foo bool`
+	// type definition `type Foo bool`, as it always is with the old-aliasing
+	// compatibility option.
 	DefineViaAlias bool
 
+	// untypedAdditionalProperties is set when the AdditionalProperties field
+	// holds interface{} values, with the old-additional-properties-type
+	// compatibility option, see UntypedAdditionalProperties.
+	untypedAdditionalProperties bool
+
+	// nullableEnum is set when the schema is a nullable enum, see
+	// isNullableEnum.
+	nullableEnum bool
+
 	// The original OpenAPIv3 Schema.
 	OAPISchema *openapi3.Schema
 }
@@ -43,6 +70,14 @@ func (s Schema) IsRef() bool {
 	return s.RefType != ""
 }
 
+// isNullableUnion returns whether s is a oneOf or anyOf of a schema with
+// null, either inline or referenced, or an allOf merged into a nullable
+// schema, making the fields, array items and additional properties of its
+// type pointers.
+func (s Schema) isNullableUnion() bool {
+	return s.NullableUnion || s.NullableAllOf || nullableUnionMember(s.OAPISchema) != nil
+}
+
 func (s Schema) TypeDecl() string {
 	if s.IsRef() {
 		return s.RefType
@@ -50,6 +85,14 @@ func (s Schema) TypeDecl() string {
 	return s.GoType
 }
 
+// UntypedAdditionalProperties returns whether the AdditionalProperties field
+// holds interface{} values rather than those of the type of the additional
+// properties, for the old-additional-properties-type compatibility option.
+func (s Schema) UntypedAdditionalProperties() bool {
+	return s.untypedAdditionalProperties &&
+		s.HasAdditionalProperties && s.AdditionalPropertiesType.TypeDecl() != "interface{}"
+}
+
 // AddProperty adds a new property to the current Schema, and returns an error
 // if it collides. Two identical fields will not collide, but two properties by
 // the same name, but different definition, will collide. It's safe to merge the
@@ -86,17 +129,38 @@ type Property struct {
 	NeedsFormTag  bool
 	Extensions    map[string]interface{}
 	Deprecated    bool
+	// JSONCodec is set when the property is encoded by the functions named
+	// with x-go-json-codec.
+	JSONCodec *jsonCodec
+
+	// goFieldName is set when the field of the property is renamed, because
+	// its name collides with another field or method of the struct.
+	goFieldName string
+	// requiredReadOnlyValue is set when a required read-only property is a
+	// value rather than a pointer, with the
+	// disable-required-readonly-as-pointer compatibility option.
+	requiredReadOnlyValue bool
 }
 
+// GoFieldName returns the name of the property's field in the generated
+// struct, taking x-go-name into account.
 func (p Property) GoFieldName() string {
+	if p.goFieldName != "" {
+		return p.goFieldName
+	}
+	if _, ok := p.Extensions[extGoName]; ok {
+		if extGoFieldName, err := extParseGoFieldName(p.Extensions[extGoName]); err == nil {
+			return extGoFieldName
+		}
+	}
 	return SchemaNameToTypeName(p.JsonFieldName)
 }
 
 func (p Property) GoTypeDef() string {
 	typeDef := p.Schema.TypeDecl()
-	if !p.Schema.SkipOptionalPointer &&
+	if !p.Schema.SkipOptionalPointer && !(p.preserveZero() && !p.Nullable) &&
 		(!p.Required || p.Nullable ||
-			(p.ReadOnly && (!p.Required || !globalState.options.Compatibility.DisableRequiredReadOnlyAsPointer)) ||
+			(p.ReadOnly && (!p.Required || !p.requiredReadOnlyValue)) ||
 			p.WriteOnly) {
 
 		typeDef = "*" + typeDef
@@ -104,6 +168,46 @@ func (p Property) GoTypeDef() string {
 	return typeDef
 }
 
+// omitEmpty reports whether the field of property p is tagged omitempty,
+// which x-omitempty overrides, and x-go-preserve-zero prevents.
+func (p Property) omitEmpty() bool {
+	omitEmpty := !p.Nullable &&
+		(!p.Required || p.ReadOnly || p.WriteOnly) &&
+		(!p.Required || !p.ReadOnly || !p.requiredReadOnlyValue)
+
+	// Support x-omitempty
+	if extOmitEmptyValue, ok := p.Extensions[extPropOmitEmpty]; ok {
+		if extOmitEmpty, err := extParseOmitEmpty(extOmitEmptyValue); err == nil {
+			omitEmpty = extOmitEmpty
+		}
+	}
+	return omitEmpty && !p.preserveZero()
+}
+
+// preserveZero reports whether property p has x-go-preserve-zero, making its
+// field always encoded, even when it holds the zero value. Its field isn't a
+// pointer, unless the property is nullable, when a nil one encodes null.
+func (p Property) preserveZero() bool {
+	if extension, ok := p.Extensions[extPropGoPreserveZero]; ok {
+		if preserveZero, err := extParseGoPreserveZero(extension); err == nil {
+			return preserveZero
+		}
+	}
+	return false
+}
+
+// fieldTypeDef returns the type of the field of property p, mirroring
+// GenFieldsFromProperties, which lets x-go-type-skip-optional-pointer decide
+// on pointers.
+func fieldTypeDef(p Property) string {
+	if extension, ok := p.Extensions[extPropGoTypeSkipOptionalPointer]; ok {
+		if skipOptionalPointer, err := extParsePropGoTypeSkipOptionalPointer(extension); err == nil {
+			p.Schema.SkipOptionalPointer = skipOptionalPointer
+		}
+	}
+	return p.GoTypeDef()
+}
+
 // EnumDefinition holds type information for enum
 type EnumDefinition struct {
 	// Schema is the scheme of a type which has a list of enum values, eg, the
@@ -119,13 +223,101 @@ type EnumDefinition struct {
 	// TypeNames or when explicitly requested via the
 	// `compatibility.always-prefix-enum-values` option.
 	PrefixTypeName bool
+	// Compact is set when the values of the enum are listed in a slice,
+	// which IsValid looks them up in, rather than switching over constants,
+	// see compactEnum.
+	Compact bool
+
+	// nullable is set when the enum is nullable, or has a null member, see
+	// Nullable.
+	nullable bool
+}
+
+// compactEnum returns whether the enum of schema is generated in the compact
+// form, having x-go-enum-skip-constants, or more values than the
+// compact-enum-threshold output option allows.
+func (g *generator) compactEnum(schema Schema) (bool, error) {
+	if schema.OAPISchema != nil {
+		if extension, ok := schema.OAPISchema.Extensions[extGoEnumSkipConstants]; ok {
+			skipConstants, err := extParseGoEnumSkipConstants(extension)
+			if err != nil {
+				return false, fmt.Errorf("invalid value for %q: %w", extGoEnumSkipConstants, err)
+			}
+			if skipConstants {
+				return true, nil
+			}
+		}
+	}
+	threshold := g.options.OutputOptions.CompactEnumThreshold
+	return threshold > 0 && len(schema.EnumValues) > threshold, nil
+}
+
+// HasConstants returns whether the values of the enum are generated as
+// constants, which compact enums only are when they're named with
+// x-enum-varnames.
+func (e *EnumDefinition) HasConstants() bool {
+	if !e.Compact {
+		return true
+	}
+	if e.Schema.OAPISchema == nil {
+		return false
+	}
+	_, varNames := e.Schema.OAPISchema.Extensions[extEnumVarNames]
+	_, names := e.Schema.OAPISchema.Extensions[extEnumNames]
+	return varNames || names
+}
+
+// DistinctValueLiterals returns the Go literals of the distinct values of the
+// enum, in the order of DistinctValueNames.
+func (e *EnumDefinition) DistinctValueLiterals() []string {
+	values := e.GetValues()
+	names := e.DistinctValueNames()
+	literals := make([]string, len(names))
+	for i, name := range names {
+		literals[i] = e.ValueLiteral(values[name])
+	}
+	return literals
+}
+
+// Nullable returns whether the enum is nullable, or has a null member, in
+// which case null isn't one of its values.
+func (e *EnumDefinition) Nullable() bool {
+	return e.nullable
+}
+
+// DistinctValueNames returns the sorted names of the values of the enum,
+// keeping only the first name of a value having several, as listing them all
+// in a switch would be a duplicate case.
+func (e *EnumDefinition) DistinctValueNames() []string {
+	values := e.GetValues()
+	names := SortedStringKeys(values)
+	seen := make(map[string]bool, len(names))
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		if !seen[values[name]] {
+			seen[values[name]] = true
+			result = append(result, name)
+		}
+	}
+	return result
 }
 
 // GetValues generates enum names in a way to minimize global conflicts
 func (e *EnumDefinition) GetValues() map[string]string {
-	// in case there are no conflicts, it's safe to use the values as-is
+	// in case there are no conflicts, it's safe to use the values as-is,
+	// except for the empty one, which is always named after the type
 	if !e.PrefixTypeName {
-		return e.Schema.EnumValues
+		if v, found := e.Schema.EnumValues["Empty"]; !found || v != "" {
+			return e.Schema.EnumValues
+		}
+		newValues := make(map[string]string, len(e.Schema.EnumValues))
+		for k, v := range e.Schema.EnumValues {
+			if k == "Empty" {
+				k = e.TypeName + k
+			}
+			newValues[k] = v
+		}
+		return newValues
 	}
 	// If we do have conflicts, we will prefix the enum's typename to the values.
 	newValues := make(map[string]string, len(e.Schema.EnumValues))
@@ -136,11 +328,24 @@ func (e *EnumDefinition) GetValues() map[string]string {
 	return newValues
 }
 
+// ValueLiteral returns the Go literal of the given enum value, which is quoted
+// and escaped for string enums, so that the constant holds the value of the
+// spec verbatim.
+func (e *EnumDefinition) ValueLiteral(value string) string {
+	if e.ValueWrapper == `"` {
+		return strconv.Quote(value)
+	}
+	return e.ValueWrapper + value + e.ValueWrapper
+}
+
 type Constants struct {
 	// SecuritySchemeProviderNames holds all provider names for security schemes.
 	SecuritySchemeProviderNames []string
 	// EnumDefinitions holds type and value information for all enums
 	EnumDefinitions []EnumDefinition
+	// CorrelationHeader is the header named by the correlation-header output
+	// option, when operations declare it.
+	CorrelationHeader string
 }
 
 // TypeDefinition describes a Go type definition in generated code.
@@ -178,7 +383,14 @@ type ResponseTypeDefinition struct {
 }
 
 func (t *TypeDefinition) IsAlias() bool {
-	return !globalState.options.Compatibility.OldAliasing && t.Schema.DefineViaAlias
+	return t.Schema.DefineViaAlias
+}
+
+// definesViaAlias returns the DefineViaAlias of the schemas declared as
+// aliases, which the old-aliasing compatibility option declares as types
+// instead.
+func (g *generator) definesViaAlias() bool {
+	return !g.options.Compatibility.OldAliasing
 }
 
 type Discriminator struct {
@@ -197,6 +409,27 @@ func (d *Discriminator) PropertyName() string {
 	return SchemaNameToTypeName(d.Property)
 }
 
+// ValueOf returns the discriminator value identifying element, the first of
+// those mapped to it when there are several, or "" when there's none.
+func (d *Discriminator) ValueOf(element UnionElement) string {
+	for _, value := range SortedStringKeys(d.Mapping) {
+		if d.Mapping[value] == string(element) {
+			return value
+		}
+	}
+	return ""
+}
+
+// JSONValue returns the Go string literal of the JSON of value, a
+// discriminator value.
+func (d *Discriminator) JSONValue(value string) string {
+	data, _ := json.Marshal(value)
+	if strings.Contains(string(data), "`") {
+		return strconv.Quote(string(data))
+	}
+	return "`" + string(data) + "`"
+}
+
 // UnionElement describe union element, based on prefix externalRef\d+ and real ref name from external schema.
 type UnionElement string
 
@@ -218,7 +451,52 @@ func PropertiesEqual(a, b Property) bool {
 	return a.JsonFieldName == b.JsonFieldName && a.Schema.TypeDecl() == b.Schema.TypeDecl() && a.Required == b.Required
 }
 
+// schemaType returns the type of the schema, inferring it when it's omitted:
+// properties or additionalProperties make an object, items or prefixItems an
+// array, and an enum of strings a string. Lacking these, required makes an
+// object too, being the weakest hint, as allOf members only listing required
+// properties are often mixed into other schemas. It's empty for the schemas
+// implying none, which accept any value.
+func schemaType(s *openapi3.Schema) string {
+	if s.Type != "" {
+		return s.Type
+	}
+	if len(s.Properties) != 0 || s.AdditionalProperties.Has != nil || s.AdditionalProperties.Schema != nil {
+		return "object"
+	}
+	// kin-openapi keeps the prefixItems of OpenAPI 3.1 with the extensions.
+	if _, ok := s.Extensions["prefixItems"]; ok || s.Items != nil {
+		return "array"
+	}
+	if isStringEnum(s.Enum) {
+		return "string"
+	}
+	if len(s.Required) != 0 {
+		return "object"
+	}
+	return ""
+}
+
+// isStringEnum returns whether the members of enum are strings, but for a null
+// one, which makes the enum nullable instead, see isNullableEnum.
+func isStringEnum(enum []interface{}) bool {
+	hasString := false
+	for _, value := range enum {
+		if _, ok := value.(string); ok {
+			hasString = true
+		} else if value != nil {
+			return false
+		}
+	}
+	return hasString
+}
+
 func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
+	return exportedGenerator().generateGoSchema(sref, path)
+}
+
+// generateGoSchema does the work of GenerateGoSchema.
+func (g *generator) generateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// Add a fallback value in case the sref is nil.
 	// i.e. the parent schema defines a type:array, but the array has
 	// no items defined. Therefore, we have at least valid Go-Code.
@@ -232,7 +510,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// another type. We're not de-referencing, so simply use the referenced type.
 	if IsGoTypeReference(sref.Ref) {
 		// Convert the reference path to Go type
-		refType, err := RefPathToGoType(sref.Ref)
+		refType, err := g.refPathToGoType(sref.Ref, true)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error turning reference (%s) into a Go type: %s",
 				sref.Ref, err)
@@ -240,26 +518,68 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		return Schema{
 			GoType:         refType,
 			Description:    schema.Description,
-			DefineViaAlias: true,
+			DefineViaAlias: g.definesViaAlias(),
 			OAPISchema:     schema,
 		}, nil
 	}
 
 	outSchema := Schema{
 		Description: schema.Description,
+		Patterns:    schemaPatterns(schema),
 		OAPISchema:  schema,
 	}
 
+	// A oneOf or anyOf of a schema with null is just that schema, which
+	// makes what holds it nullable, rather than a union.
+	if member := nullableUnionMember(schema); member != nil {
+		memberSchema, err := g.generateGoSchema(member, path)
+		if err != nil {
+			return Schema{}, err
+		}
+		if schema.Description != "" {
+			memberSchema.Description = schema.Description
+		}
+		memberSchema.NullableUnion = true
+		return memberSchema, nil
+	}
+
+	if g.options.OutputOptions.FlattenAnyOfObjects {
+		if flat := g.flattenAnyOfObjects(schema); flat != nil {
+			return g.generateGoSchema(openapi3.NewSchemaRef("", flat), path)
+		}
+	}
+
 	// AllOf is interesting, and useful. It's the union of a number of other
 	// schemas. A common usage is to create a union of an object with an ID,
 	// so that in a RESTful paradigm, the Create operation can return
 	// (object, id), so that other operations can refer to (id)
 	if schema.AllOf != nil {
-		mergedSchema, err := MergeSchemas(schema.AllOf, path)
+		mergedSchema, err := g.mergeAllOfSchemas(schema.AllOf, path)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error merging schemas: %w", err)
 		}
+		mergedSchema.NullableAllOf = mergedSchema.OAPISchema != nil && mergedSchema.OAPISchema.Nullable
 		mergedSchema.OAPISchema = schema
+		if g.options.OutputOptions.AllOfDocStrategy == AllOfDocConcatenate {
+			var docs openapi3.Schema
+			concatenateDocs(&docs, schema.Description, schema.AllOf)
+			mergedSchema.Description = docs.Description
+		} else if schema.Description != "" {
+			// The description next to the allOf is the one of this very type.
+			mergedSchema.Description = schema.Description
+		}
+		if isAdditionalPropertiesExplicitFalse(schema) {
+			mergedSchema.NoAdditionalProperties = true
+		}
+		if extension, ok := schema.Extensions[extGoComparable]; ok {
+			applied, err := applyGoComparable(&mergedSchema, extension)
+			if err != nil {
+				return Schema{}, err
+			}
+			if applied {
+				mergedSchema.GoType = GenStructFromSchema(mergedSchema)
+			}
+		}
 		return mergedSchema, nil
 	}
 
@@ -271,7 +591,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			return outSchema, fmt.Errorf("invalid value for %q: %w", extPropGoType, err)
 		}
 		outSchema.GoType = typeName
-		outSchema.DefineViaAlias = true
+		outSchema.DefineViaAlias = g.definesViaAlias()
 
 		return outSchema, nil
 	}
@@ -287,11 +607,19 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	}
 
 	// Schema type and format, eg. string / binary
-	t := schema.Type
+	t := schemaType(schema)
 	// Handle objects and empty schemas first as a special case
 	if t == "" || t == "object" {
 		var outType string
 
+		// Objects without properties are maps, or interface{}, which can't be
+		// made comparable.
+		if len(schema.Properties) == 0 {
+			if comparable, _, _ := extParseGoComparable(schema.Extensions[extGoComparable]); comparable {
+				return Schema{}, fmt.Errorf("%s requires an object with properties", extGoComparable)
+			}
+		}
+
 		if len(schema.Properties) == 0 && !SchemaHasAdditionalProperties(schema) && schema.AnyOf == nil && schema.OneOf == nil {
 			// If the object has no properties or additional properties, we
 			// have some special cases for its type.
@@ -305,7 +633,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 				outType = "interface{}"
 			}
 			outSchema.GoType = outType
-			outSchema.DefineViaAlias = true
+			outSchema.DefineViaAlias = g.definesViaAlias()
 		} else {
 			// When we define an object, we want it to be a type definition,
 			// not a type alias, eg, "type Foo struct {...}"
@@ -314,6 +642,8 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			// If the schema has additional properties, we need to special case
 			// a lot of behaviors.
 			outSchema.HasAdditionalProperties = SchemaHasAdditionalProperties(schema)
+			outSchema.untypedAdditionalProperties = g.options.Compatibility.OldAdditionalPropertiesType
+			outSchema.NoAdditionalProperties = isAdditionalPropertiesExplicitFalse(schema)
 
 			// Until we have a concrete additional properties type, we default to
 			// any schema.
@@ -324,7 +654,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			// If additional properties are defined, we will override the default
 			// above with the specific definition.
 			if schema.AdditionalProperties.Schema != nil {
-				additionalSchema, err := GenerateGoSchema(schema.AdditionalProperties.Schema, path)
+				additionalSchema, err := g.generateGoSchema(schema.AdditionalProperties.Schema, path)
 				if err != nil {
 					return Schema{}, fmt.Errorf("error generating type for additional properties: %w", err)
 				}
@@ -343,6 +673,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 					additionalSchema.RefType = typeName
 					additionalSchema.AdditionalTypes = append(additionalSchema.AdditionalTypes, typeDef)
 				}
+				additionalSchema.nullableEnum = g.isNullableEnum(additionalSchema.OAPISchema)
 				outSchema.AdditionalPropertiesType = &additionalSchema
 				outSchema.AdditionalTypes = append(outSchema.AdditionalTypes, additionalSchema.AdditionalTypes...)
 			}
@@ -351,7 +682,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			// early-out here and generate a map[string]<schema> instead of an object
 			// that contains this map. We skip over anyOf/oneOf here because they can
 			// introduce properties. allOf was handled above.
-			if !globalState.options.Compatibility.DisableFlattenAdditionalProperties &&
+			if !g.options.Compatibility.DisableFlattenAdditionalProperties &&
 				len(schema.Properties) == 0 && schema.AnyOf == nil && schema.OneOf == nil {
 				// We have a dictionary here. Returns the goType to be just a map from
 				// string to the property type. HasAdditionalProperties=false means
@@ -363,10 +694,10 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			}
 
 			// We've got an object with some properties.
-			for _, pName := range SortedSchemaKeys(schema.Properties) {
+			for _, pName := range g.orderedPropertyNames(schema) {
 				p := schema.Properties[pName]
 				propertyPath := append(path, pName)
-				pSchema, err := GenerateGoSchema(p, propertyPath)
+				pSchema, err := g.generateGoSchema(p, propertyPath)
 				if err != nil {
 					return Schema{}, fmt.Errorf("error generating Go schema for property '%s': %w", pName, err)
 				}
@@ -398,26 +729,61 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 					Schema:        pSchema,
 					Required:      required,
 					Description:   description,
-					Nullable:      p.Value.Nullable,
+					Nullable:      p.Value.Nullable || g.isNullableEnum(p.Value) || pSchema.isNullableUnion(),
 					ReadOnly:      p.Value.ReadOnly,
 					WriteOnly:     p.Value.WriteOnly,
 					Extensions:    p.Value.Extensions,
 					Deprecated:    p.Value.Deprecated,
+
+					requiredReadOnlyValue: required && p.Value.ReadOnly && g.options.Compatibility.DisableRequiredReadOnlyAsPointer,
+				}
+				if extension, ok := p.Value.Extensions[extPropGoPreserveZero]; ok {
+					preserveZero, err := extParseGoPreserveZero(extension)
+					if err != nil {
+						return Schema{}, fmt.Errorf("invalid value for %q of property '%s': %w", extPropGoPreserveZero, pName, err)
+					}
+					// Required properties are always encoded, but for the
+					// read-only and write-only ones.
+					if preserveZero && required && !p.Value.ReadOnly && !p.Value.WriteOnly {
+						g.addWarning(strings.Join(path, "."), "%s has no effect on property %q, which is always encoded, being required", extPropGoPreserveZero, pName)
+					}
+				}
+				if extension, ok := p.Value.Extensions[extPropGoJSONCodec]; ok {
+					prop.JSONCodec, err = extParseGoJSONCodec(extension)
+					if err != nil {
+						return Schema{}, fmt.Errorf("invalid value for %q of property '%s': %w", extPropGoJSONCodec, pName, err)
+					}
+					g.usesJSONCodec = true
 				}
 				outSchema.Properties = append(outSchema.Properties, prop)
 			}
 
+			// The properties are flattened with those of the union on the
+			// wire, so they may not have the same names.
+			for _, elements := range []openapi3.SchemaRefs{schema.AnyOf, schema.OneOf} {
+				if err := g.checkUnionPropertyCollisions(schema, elements); err != nil {
+					return Schema{}, err
+				}
+			}
+
 			if schema.AnyOf != nil {
-				if err := generateUnion(&outSchema, schema.AnyOf, schema.Discriminator, path); err != nil {
+				if err := g.generateUnion(&outSchema, schema.AnyOf, schema.Discriminator, path); err != nil {
 					return Schema{}, fmt.Errorf("error generating type for anyOf: %w", err)
 				}
 			}
 			if schema.OneOf != nil {
-				if err := generateUnion(&outSchema, schema.OneOf, schema.Discriminator, path); err != nil {
+				if err := g.generateUnion(&outSchema, schema.OneOf, schema.Discriminator, path); err != nil {
 					return Schema{}, fmt.Errorf("error generating type for oneOf: %w", err)
 				}
 			}
 
+			if extension, ok := schema.Extensions[extGoComparable]; ok {
+				if _, err := applyGoComparable(&outSchema, extension); err != nil {
+					return Schema{}, err
+				}
+			}
+
+			g.disambiguateFieldNames(strings.Join(path, "."), &outSchema)
 			outSchema.GoType = GenStructFromSchema(outSchema)
 		}
 
@@ -437,14 +803,14 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			outSchema = Schema{
 				Description:     newTypeDef.Schema.Description,
 				GoType:          typeName,
-				DefineViaAlias:  true,
+				DefineViaAlias:  g.definesViaAlias(),
 				AdditionalTypes: []TypeDefinition{newTypeDef},
 			}
 		}
 
 		return outSchema, nil
 	} else if len(schema.Enum) > 0 {
-		err := oapiSchemaToGoType(schema, path, &outSchema)
+		err := g.oapiSchemaToGoType(schema, path, &outSchema)
 		// Enums need to be typed, so that the values aren't interchangeable,
 		// so no matter what schema conversion thinks, we need to define a
 		// new type.
@@ -453,21 +819,44 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		if err != nil {
 			return Schema{}, fmt.Errorf("error resolving primitive type: %w", err)
 		}
-		enumValues := make([]string, len(schema.Enum))
-		for i, enumValue := range schema.Enum {
-			enumValues[i] = fmt.Sprintf("%v", enumValue)
+		// A null member isn't one of the values of the enum, it makes the
+		// properties holding it nullable instead, see isNullableEnum. The
+		// names and descriptions given by extensions skip it too.
+		var enumValues []string
+		for _, enumValue := range schema.Enum {
+			if enumValue != nil {
+				enumValues = append(enumValues, fmt.Sprintf("%v", enumValue))
+			}
 		}
 
 		enumNames := enumValues
 		for _, key := range []string{extEnumVarNames, extEnumNames} {
 			if _, ok := schema.Extensions[key]; ok {
 				if extEnumNames, err := extParseEnumVarNames(schema.Extensions[key]); err == nil {
-					enumNames = extEnumNames
+					enumNames = withoutNullEnumEntries(extEnumNames, schema.Enum)
 					break
 				}
 			}
 		}
 
+		if extension, ok := schema.Extensions[extEnumDescriptions]; ok {
+			descriptions, err := extParseEnumDescriptions(extension)
+			if err != nil {
+				return Schema{}, fmt.Errorf("invalid value for %q: %w", extEnumDescriptions, err)
+			}
+			if len(descriptions) != len(schema.Enum) {
+				return Schema{}, fmt.Errorf("%q has %d entries, but the enum has %d values",
+					extEnumDescriptions, len(descriptions), len(schema.Enum))
+			}
+			descriptions = withoutNullEnumEntries(descriptions, schema.Enum)
+			outSchema.EnumDescriptions = make(map[string]string, len(descriptions))
+			for i, description := range descriptions {
+				if description != "" {
+					outSchema.EnumDescriptions[enumValues[i]] = description
+				}
+			}
+		}
+
 		sanitizedValues := SanitizeEnumNames(enumNames, enumValues)
 		outSchema.EnumValues = make(map[string]string, len(sanitizedValues))
 
@@ -478,7 +867,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			} else {
 				enumName = k
 			}
-			if globalState.options.Compatibility.OldEnumConflicts {
+			if g.options.Compatibility.OldEnumConflicts {
 				outSchema.EnumValues[SchemaNameToTypeName(PathToTypeName(append(path, enumName)))] = v
 			} else {
 				outSchema.EnumValues[SchemaNameToTypeName(k)] = v
@@ -506,7 +895,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			outSchema.RefType = typeName
 		}
 	} else {
-		err := oapiSchemaToGoType(schema, path, &outSchema)
+		err := g.oapiSchemaToGoType(schema, path, &outSchema)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error resolving primitive type: %w", err)
 		}
@@ -516,15 +905,15 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 
 // oapiSchemaToGoType converts an OpenApi schema into a Go type definition for
 // all non-object types.
-func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schema) error {
+func (g *generator) oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schema) error {
 	f := schema.Format
-	t := schema.Type
+	t := schemaType(schema)
 
 	switch t {
 	case "array":
 		// For arrays, we'll get the type of the Items and throw a
 		// [] in front of it.
-		arrayType, err := GenerateGoSchema(schema.Items, path)
+		arrayType, err := g.generateGoSchema(schema.Items, path)
 		if err != nil {
 			return fmt.Errorf("error generating type for array: %w", err)
 		}
@@ -546,9 +935,25 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 		}
 		outSchema.ArrayType = &arrayType
 		outSchema.GoType = "[]" + arrayType.TypeDecl()
+		if arrayType.isNullableUnion() {
+			outSchema.GoType = "[]*" + arrayType.TypeDecl()
+		}
+		if extension, ok := schema.Extensions[extGoSet]; ok {
+			goSet, err := extParseGoSet(extension)
+			if err != nil {
+				return fmt.Errorf("invalid value for %q: %w", extGoSet, err)
+			}
+			if goSet {
+				if !isComparableSchema(arrayType) {
+					return fmt.Errorf("%s requires items of a comparable type, not %s", extGoSet, arrayType.TypeDecl())
+				}
+				outSchema.GoType = "OrderedSet[" + arrayType.TypeDecl() + "]"
+				g.usesOrderedSet = true
+			}
+		}
 		outSchema.AdditionalTypes = arrayType.AdditionalTypes
 		outSchema.Properties = arrayType.Properties
-		outSchema.DefineViaAlias = true
+		outSchema.DefineViaAlias = g.definesViaAlias()
 	case "integer":
 		// We default to int if format doesn't ask for something else.
 		if f == "int64" {
@@ -574,7 +979,7 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 		} else {
 			outSchema.GoType = "int"
 		}
-		outSchema.DefineViaAlias = true
+		outSchema.DefineViaAlias = g.definesViaAlias()
 	case "number":
 		// We default to float for "number"
 		if f == "double" {
@@ -584,13 +989,13 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 		} else {
 			return fmt.Errorf("invalid number format: %s", f)
 		}
-		outSchema.DefineViaAlias = true
+		outSchema.DefineViaAlias = g.definesViaAlias()
 	case "boolean":
 		if f != "" {
 			return fmt.Errorf("invalid format (%s) for boolean", f)
 		}
 		outSchema.GoType = "bool"
-		outSchema.DefineViaAlias = true
+		outSchema.DefineViaAlias = g.definesViaAlias()
 	case "string":
 		// Special case string formats here.
 		switch f {
@@ -600,6 +1005,10 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 			outSchema.GoType = "openapi_types.Email"
 		case "date":
 			outSchema.GoType = "openapi_types.Date"
+			if g.options.Compatibility.StrictDates {
+				outSchema.GoType = "StrictDate"
+				g.usesStrictDate = true
+			}
 		case "date-time":
 			outSchema.GoType = "time.Time"
 		case "json":
@@ -613,7 +1022,7 @@ func oapiSchemaToGoType(schema *openapi3.Schema, path []string, outSchema *Schem
 			// All unrecognized formats are simply a regular string.
 			outSchema.GoType = "string"
 		}
-		outSchema.DefineViaAlias = true
+		outSchema.DefineViaAlias = g.definesViaAlias()
 	default:
 		return fmt.Errorf("unhandled Schema type: %s", t)
 	}
@@ -635,6 +1044,25 @@ type FieldDescriptor struct {
 	IsRef    bool   // Is this schema a reference to predefined object?
 }
 
+// isComparableSchema reports whether the Go type of a schema is comparable,
+// that is, whether it's one of the primitive types, or a type defined from
+// one, such as an enum.
+func isComparableSchema(s Schema) bool {
+	if s.OAPISchema == nil || len(s.UnionElements) != 0 {
+		return false
+	}
+	if _, found := s.OAPISchema.Extensions[extPropGoType]; found {
+		return false
+	}
+	switch schemaType(s.OAPISchema) {
+	case "integer", "number", "boolean":
+		return true
+	case "string":
+		return s.OAPISchema.Format != "binary"
+	}
+	return false
+}
+
 // GenFieldsFromProperties produce corresponding field names with JSON annotations,
 // given a list of schema descriptors
 func GenFieldsFromProperties(props []Property) []string {
@@ -643,11 +1071,6 @@ func GenFieldsFromProperties(props []Property) []string {
 		field := ""
 
 		goFieldName := p.GoFieldName()
-		if _, ok := p.Extensions[extGoName]; ok {
-			if extGoFieldName, err := extParseGoFieldName(p.Extensions[extGoName]); err == nil {
-				goFieldName = extGoFieldName
-			}
-		}
 
 		// Add a comment to a field in case we have one, otherwise skip.
 		if p.Description != "" {
@@ -656,7 +1079,7 @@ func GenFieldsFromProperties(props []Property) []string {
 			if i != 0 {
 				field += "\n"
 			}
-			field += fmt.Sprintf("%s\n", StringWithTypeNameToGoComment(p.Description, p.GoFieldName()))
+			field += fmt.Sprintf("%s\n", StringWithTypeNameToGoComment(p.Description, goFieldName))
 		}
 
 		if p.Deprecated {
@@ -681,16 +1104,7 @@ func GenFieldsFromProperties(props []Property) []string {
 
 		field += fmt.Sprintf("    %s %s", goFieldName, p.GoTypeDef())
 
-		omitEmpty := !p.Nullable &&
-			(!p.Required || p.ReadOnly || p.WriteOnly) &&
-			(!p.Required || !p.ReadOnly || !globalState.options.Compatibility.DisableRequiredReadOnlyAsPointer)
-
-		// Support x-omitempty
-		if extOmitEmptyValue, ok := p.Extensions[extPropOmitEmpty]; ok {
-			if extOmitEmpty, err := extParseOmitEmpty(extOmitEmptyValue); err == nil {
-				omitEmpty = extOmitEmpty
-			}
-		}
+		omitEmpty := p.omitEmpty()
 
 		fieldTags := make(map[string]string)
 
@@ -734,24 +1148,178 @@ func GenFieldsFromProperties(props []Property) []string {
 	return fields
 }
 
+// isNullableEnum returns whether schema is an enum which is nullable, or has a
+// null member, as in enum: [active, inactive, null], including when it's
+// merged from the members of an allOf.
+func (g *generator) isNullableEnum(schema *openapi3.Schema) bool {
+	if schema == nil {
+		return false
+	}
+	if len(schema.AllOf) != 0 && schema.AllOf[0].Value != nil {
+		merged := *schema.AllOf[0].Value
+		for _, member := range schema.AllOf[1:] {
+			if member.Value == nil {
+				return false
+			}
+			var err error
+			if merged, err = g.mergeOpenapiSchemas(merged, *member.Value, true); err != nil {
+				return false
+			}
+		}
+		return g.isNullableEnum(&merged)
+	}
+	if len(schema.Enum) == 0 {
+		return false
+	}
+	if schema.Nullable {
+		return true
+	}
+	for _, value := range schema.Enum {
+		if value == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// nullableUnionMember returns the other member of schema when it's a oneOf or
+// anyOf of two members, one of them being the null type, as in
+// oneOf: [{$ref: Pet}, {type: "null"}], and nothing else. Members which
+// aren't references, but have a discriminator, are left to the unions.
+func nullableUnionMember(schema *openapi3.Schema) *openapi3.SchemaRef {
+	if schema == nil || len(schema.Properties) != 0 || schema.AllOf != nil || schema.Discriminator != nil ||
+		schema.AdditionalProperties.Has != nil || schema.AdditionalProperties.Schema != nil {
+		return nil
+	}
+	members := schema.OneOf
+	if members == nil {
+		members = schema.AnyOf
+	} else if schema.AnyOf != nil {
+		return nil
+	}
+	if len(members) != 2 {
+		return nil
+	}
+	isNull := func(member *openapi3.SchemaRef) bool {
+		return member != nil && member.Value != nil && member.Value.Type == "null"
+	}
+	var member *openapi3.SchemaRef
+	switch {
+	case isNull(members[0]) && !isNull(members[1]):
+		member = members[1]
+	case isNull(members[1]) && !isNull(members[0]):
+		member = members[0]
+	default:
+		return nil
+	}
+	if member == nil || member.Value == nil || (member.Ref == "" && member.Value.Discriminator != nil) {
+		return nil
+	}
+	return member
+}
+
+// withoutNullEnumEntries returns the entries of an extension listing a name or
+// description for each member of enum, without those of its null members.
+func withoutNullEnumEntries(entries []string, enum []interface{}) []string {
+	result := make([]string, 0, len(entries))
+	for i, entry := range entries {
+		if i >= len(enum) || enum[i] != nil {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
 func additionalPropertiesType(schema Schema) string {
 	addPropsType := schema.AdditionalPropertiesType.GoType
 	if schema.AdditionalPropertiesType.RefType != "" {
 		addPropsType = schema.AdditionalPropertiesType.RefType
 	}
-	if schema.AdditionalPropertiesType.OAPISchema != nil && schema.AdditionalPropertiesType.OAPISchema.Nullable {
+	if schema.AdditionalPropertiesType.OAPISchema != nil && (schema.AdditionalPropertiesType.OAPISchema.Nullable || schema.AdditionalPropertiesType.nullableEnum) ||
+		schema.AdditionalPropertiesType.isNullableUnion() {
 		addPropsType = "*" + addPropsType
 	}
 	return addPropsType
 }
 
+// disambiguateFieldNames renames the fields of the properties of s whose Go
+// name is already taken, by another property, or by a field or method the
+// generator adds to the struct, such as AdditionalProperties. The name is
+// suffixed with a number, like duplicate enum values. Properties keep their
+// name in the order of the spec, but those whose JSON name starts with an
+// underscore, which ToCamelCase drops, yield to the others, so that "id" stays
+// Id next to "_id".
+func (g *generator) disambiguateFieldNames(location string, s *Schema) {
+	taken := g.generatedMemberNames(*s)
+	var first, second []int
+	for i, p := range s.Properties {
+		if strings.HasPrefix(p.JsonFieldName, "_") {
+			second = append(second, i)
+		} else {
+			first = append(first, i)
+		}
+	}
+	for _, i := range append(first, second...) {
+		p := &s.Properties[i]
+		name := p.GoFieldName()
+		if taken[name] {
+			renamed := name
+			for n := 1; taken[renamed]; n++ {
+				renamed = name + strconv.Itoa(n)
+			}
+			p.goFieldName = renamed
+			g.addWarning(location, "property %q has the same Go name as another field or method, %s, generating it as %s; use %s to choose its name",
+				p.JsonFieldName, name, renamed, extGoName)
+		}
+		taken[p.GoFieldName()] = true
+	}
+}
+
+// generatedMemberNames returns the names of the fields and methods which the
+// generator adds to the struct of s, besides those of its properties.
+func (g *generator) generatedMemberNames(s Schema) map[string]bool {
+	names := make(map[string]bool)
+	if s.HasAdditionalProperties {
+		for _, name := range []string{"AdditionalProperties", "Get", "Set", "MarshalJSON", "UnmarshalJSON"} {
+			names[name] = true
+		}
+	}
+	if g.options.OutputOptions.SchemaNames {
+		names["SchemaName"] = true
+		names["SchemaPointer"] = true
+	}
+	if hasGoComparableKey(s.OAPISchema) {
+		names["Key"] = true
+	}
+	if len(s.UnionElements) != 0 {
+		for _, name := range []string{"union", "MarshalJSON", "UnmarshalJSON"} {
+			names[name] = true
+		}
+		for _, element := range s.UnionElements {
+			names["As"+element.Method()] = true
+			names["From"+element.Method()] = true
+			names["Merge"+element.Method()] = true
+		}
+		if s.Discriminator != nil {
+			names["Discriminator"] = true
+			names["ValueByDiscriminator"] = true
+		}
+	}
+	return names
+}
+
 func GenStructFromSchema(schema Schema) string {
 	// Start out with struct {
 	objectParts := []string{"struct {"}
 	// Append all the field definitions
 	objectParts = append(objectParts, GenFieldsFromProperties(schema.Properties)...)
 	// Close the struct
-	if schema.HasAdditionalProperties {
+	if schema.UntypedAdditionalProperties() {
+		objectParts = append(objectParts,
+			"// Deprecated: AdditionalProperties holds interface{} values because of the",
+			"// old-additional-properties-type compatibility option, use Get and Set instead.",
+			"AdditionalProperties map[string]interface{} `json:\"-\"`")
+	} else if schema.HasAdditionalProperties {
 		objectParts = append(objectParts,
 			fmt.Sprintf("AdditionalProperties map[string]%s `json:\"-\"`",
 				additionalPropertiesType(schema)))
@@ -765,14 +1333,18 @@ func GenStructFromSchema(schema Schema) string {
 
 // This constructs a Go type for a parameter, looking at either the schema or
 // the content, whichever is available
-func paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
+func (g *generator) paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
 	if param.Content == nil && param.Schema == nil {
 		return Schema{}, fmt.Errorf("parameter '%s' has no schema or content", param.Name)
 	}
 
 	// We can process the schema through the generic schema processor
 	if param.Schema != nil {
-		return GenerateGoSchema(param.Schema, path)
+		schema, err := g.generateGoSchema(param.Schema, path)
+		if err == nil && strings.HasPrefix(schema.GoType, "OrderedSet[") {
+			return Schema{}, fmt.Errorf("parameter '%s': %s isn't supported for parameters", param.Name, extGoSet)
+		}
+		return schema, err
 	}
 
 	// At this point, we have a content type. We know how to deal with
@@ -796,10 +1368,105 @@ func paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
 	}
 
 	// For json, we go through the standard schema mechanism
-	return GenerateGoSchema(mt.Schema, path)
+	return g.generateGoSchema(mt.Schema, path)
+}
+
+// checkUnionPropertyCollisions returns an error when an element of the union
+// of schema has a property with the name of one of the properties of schema,
+// other than the discriminator, since both would be marshaled under that
+// name. This happens when a type composes the fields of a base schema with a
+// oneOf, through allOf.
+func (g *generator) checkUnionPropertyCollisions(schema *openapi3.Schema, elements openapi3.SchemaRefs) error {
+	for i, element := range elements {
+		if element == nil || element.Value == nil {
+			continue
+		}
+		name := element.Ref
+		if name == "" {
+			name = fmt.Sprintf("element %d", i)
+		}
+
+		properties := element.Value.Properties
+		if len(element.Value.AllOf) != 0 {
+			merged, err := g.mergeAllOf(element.Value.AllOf, []string{name})
+			if err != nil {
+				return err
+			}
+			properties = merged.Properties
+		}
+		for _, pName := range SortedSchemaKeys(properties) {
+			if _, found := schema.Properties[pName]; !found {
+				continue
+			}
+			if schema.Discriminator != nil && schema.Discriminator.PropertyName == pName {
+				continue
+			}
+			return fmt.Errorf("property '%s' of union %s collides with the property of the same name of the object it's composed with; only the discriminator may be shared", pName, name)
+		}
+	}
+	return nil
+}
+
+// unionElementNames returns the names given to the elements of a union in
+// the path of their types, so that the types of the inline elements, and of
+// what they define, are named after them. They're named after their position
+// in the union, unless the stable-union-element-names compatibility option is
+// set: inline elements are then named after their x-go-name, title, or type
+// and format, or the items they hold when they're arrays, so that their names
+// don't change when they're reordered. Objects without a title, and elements
+// which would have the same name, are still named after their position.
+func (g *generator) unionElementNames(elements openapi3.SchemaRefs) []string {
+	names := make([]string, len(elements))
+	counts := make(map[string]int)
+	if g.options.Compatibility.StableUnionElementNames {
+		for i, element := range elements {
+			if element.Ref == "" && element.Value != nil {
+				names[i] = unionElementName(element.Value)
+				counts[SchemaNameToTypeName(names[i])]++
+			}
+		}
+	}
+	for i := range names {
+		if names[i] == "" || counts[SchemaNameToTypeName(names[i])] > 1 {
+			names[i] = fmt.Sprint(i)
+		}
+	}
+	return names
+}
+
+// unionElementName returns the name of the inline union element s, or "" when
+// it's anonymous.
+func unionElementName(s *openapi3.Schema) string {
+	if goName, ok := s.Extensions[extGoName]; ok {
+		if name, err := extParseGoFieldName(goName); err == nil {
+			return name
+		}
+	}
+	if s.Title != "" {
+		return s.Title
+	}
+	t := schemaType(s)
+	switch t {
+	case "", "object":
+		return ""
+	case "array":
+		if s.Items != nil && IsGoTypeReference(s.Items.Ref) {
+			return RefPathToObjName(s.Items.Ref) + " array"
+		}
+		if s.Items != nil && s.Items.Value != nil {
+			if itemType := schemaType(s.Items.Value); itemType != "" && itemType != "object" {
+				return itemType + " array"
+			}
+		}
+		return ""
+	}
+	if s.Format != "" {
+		return t + " " + s.Format
+	}
+	return t
 }
 
-func generateUnion(outSchema *Schema, elements openapi3.SchemaRefs, discriminator *openapi3.Discriminator, path []string) error {
+func (g *generator) generateUnion(outSchema *Schema, elements openapi3.SchemaRefs, discriminator *openapi3.Discriminator, path []string) error {
 	if discriminator != nil {
 		outSchema.Discriminator = &Discriminator{
 			Property: discriminator.PropertyName,
@@ -808,15 +1475,21 @@ func generateUnion(outSchema *Schema, elements openapi3.SchemaRefs, discriminato
 	}
 
 	refToGoTypeMap := make(map[string]string)
+	elementNames := g.unionElementNames(elements)
 	for i, element := range elements {
-		elementPath := append(path, fmt.Sprint(i))
-		elementSchema, err := GenerateGoSchema(element, elementPath)
+		elementPath := append(path, elementNames[i])
+		elementSchema, err := g.generateGoSchema(element, elementPath)
 		if err != nil {
 			return err
 		}
 
 		if element.Ref == "" {
 			elementName := SchemaNameToTypeName(PathToTypeName(elementPath))
+			if goName, ok := element.Value.Extensions[extGoName]; ok {
+				if elementName, err = extParseGoFieldName(goName); err != nil {
+					return fmt.Errorf("invalid value for %q: %w", extGoName, err)
+				}
+			}
 			if elementSchema.TypeDecl() == elementName {
 				elementSchema.GoType = elementName
 			} else {
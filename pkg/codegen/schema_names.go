@@ -0,0 +1,187 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// SchemaNameDefinition describes the SchemaName and SchemaPointer methods
+// generated for a type.
+type SchemaNameDefinition struct {
+	TypeName string
+	// SchemaName is the name of the component schema the type is generated
+	// from, or the path synthesized for an inline schema.
+	SchemaName string
+	// Pointer is the JSON pointer of the schema in the spec.
+	Pointer string
+}
+
+// generateSchemaNames generates SchemaName and SchemaPointer methods for the
+// types among the given type definitions which are generated from a schema of
+// the spec, for the schema-names output option.
+func (g *generator) generateSchemaNames(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	pointers := schemaPointers(g.spec)
+
+	generated := make(map[string]bool)
+	var definitions []SchemaNameDefinition
+	for _, td := range typeDefs {
+		if generated[td.TypeName] || td.IsAlias() || td.Schema.OAPISchema == nil || !canHaveMethods(td.Schema.GoType) {
+			continue
+		}
+		generated[td.TypeName] = true
+
+		name := td.JsonName
+		if name == "" {
+			name = td.TypeName
+		}
+		definitions = append(definitions, SchemaNameDefinition{
+			TypeName:   td.TypeName,
+			SchemaName: name,
+			Pointer:    pointers[td.Schema.OAPISchema],
+		})
+	}
+	if len(definitions) == 0 {
+		return "", nil
+	}
+	return GenerateTemplates([]string{"schema-names.tmpl"}, t, definitions)
+}
+
+// canHaveMethods returns whether a type defined as goType can have methods,
+// which pointer and interface types can't.
+func canHaveMethods(goType string) bool {
+	return !strings.HasPrefix(goType, "*") && !strings.HasPrefix(goType, "interface{") && goType != "any"
+}
+
+// schemaPointers returns the JSON pointers of the inline schemas of spec, the
+// components being inline at their own place, keyed by the schema. A schema
+// found in several places gets the pointer of the first, the components coming
+// before the paths.
+func schemaPointers(spec *openapi3.T) map[*openapi3.Schema]string {
+	w := schemaPointerWalker{pointers: make(map[*openapi3.Schema]string)}
+	if spec == nil {
+		return w.pointers
+	}
+
+	components := spec.Components
+	for _, name := range SortedSchemaKeys(components.Schemas) {
+		w.schema("#/components/schemas/"+escapeJSONPointer(name), components.Schemas[name])
+	}
+	for _, name := range SortedParameterKeys(components.Parameters) {
+		w.parameter("#/components/parameters/"+escapeJSONPointer(name), components.Parameters[name])
+	}
+	for _, name := range SortedHeadersKeys(components.Headers) {
+		w.header("#/components/headers/"+escapeJSONPointer(name), components.Headers[name])
+	}
+	for _, name := range SortedRequestBodyKeys(components.RequestBodies) {
+		if body := components.RequestBodies[name]; body.Ref == "" && body.Value != nil {
+			w.content("#/components/requestBodies/"+escapeJSONPointer(name), body.Value.Content)
+		}
+	}
+	for _, name := range SortedResponsesKeys(components.Responses) {
+		w.response("#/components/responses/"+escapeJSONPointer(name), components.Responses[name])
+	}
+
+	if spec.Paths == nil {
+		return w.pointers
+	}
+	paths := spec.Paths.Map()
+	for _, path := range SortedPathsKeys(paths) {
+		pointer := "#/paths/" + escapeJSONPointer(path)
+		item := paths[path]
+		for i, param := range item.Parameters {
+			w.parameter(fmt.Sprintf("%s/parameters/%d", pointer, i), param)
+		}
+		operations := item.Operations()
+		for _, method := range SortedOperationsKeys(operations) {
+			op := operations[method]
+			opPointer := pointer + "/" + strings.ToLower(method)
+			for i, param := range op.Parameters {
+				w.parameter(fmt.Sprintf("%s/parameters/%d", opPointer, i), param)
+			}
+			if op.RequestBody != nil && op.RequestBody.Ref == "" && op.RequestBody.Value != nil {
+				w.content(opPointer+"/requestBody", op.RequestBody.Value.Content)
+			}
+			if op.Responses != nil {
+				responses := op.Responses.Map()
+				for _, code := range SortedResponsesKeys(responses) {
+					w.response(opPointer+"/responses/"+escapeJSONPointer(code), responses[code])
+				}
+			}
+		}
+	}
+	return w.pointers
+}
+
+// schemaPointerWalker records the JSON pointers of the inline schemas it
+// walks through, not following references, whose schemas have their own place.
+type schemaPointerWalker struct {
+	pointers map[*openapi3.Schema]string
+}
+
+func (w *schemaPointerWalker) schema(pointer string, ref *openapi3.SchemaRef) {
+	if ref == nil || ref.Ref != "" || ref.Value == nil {
+		return
+	}
+	s := ref.Value
+	if _, found := w.pointers[s]; found {
+		return
+	}
+	w.pointers[s] = pointer
+
+	for _, name := range SortedSchemaKeys(s.Properties) {
+		w.schema(pointer+"/properties/"+escapeJSONPointer(name), s.Properties[name])
+	}
+	w.schema(pointer+"/items", s.Items)
+	w.schema(pointer+"/additionalProperties", s.AdditionalProperties.Schema)
+	w.schema(pointer+"/not", s.Not)
+	for i, member := range s.AllOf {
+		w.schema(fmt.Sprintf("%s/allOf/%d", pointer, i), member)
+	}
+	for i, member := range s.OneOf {
+		w.schema(fmt.Sprintf("%s/oneOf/%d", pointer, i), member)
+	}
+	for i, member := range s.AnyOf {
+		w.schema(fmt.Sprintf("%s/anyOf/%d", pointer, i), member)
+	}
+}
+
+func (w *schemaPointerWalker) content(pointer string, content openapi3.Content) {
+	for _, mediaType := range SortedContentKeys(content) {
+		w.schema(pointer+"/content/"+escapeJSONPointer(mediaType)+"/schema", content[mediaType].Schema)
+	}
+}
+
+func (w *schemaPointerWalker) parameter(pointer string, ref *openapi3.ParameterRef) {
+	if ref == nil || ref.Ref != "" || ref.Value == nil {
+		return
+	}
+	w.schema(pointer+"/schema", ref.Value.Schema)
+	w.content(pointer, ref.Value.Content)
+}
+
+func (w *schemaPointerWalker) header(pointer string, ref *openapi3.HeaderRef) {
+	if ref == nil || ref.Ref != "" || ref.Value == nil {
+		return
+	}
+	w.schema(pointer+"/schema", ref.Value.Schema)
+	w.content(pointer, ref.Value.Content)
+}
+
+func (w *schemaPointerWalker) response(pointer string, ref *openapi3.ResponseRef) {
+	if ref == nil || ref.Ref != "" || ref.Value == nil {
+		return
+	}
+	w.content(pointer, ref.Value.Content)
+	for _, name := range SortedHeadersKeys(ref.Value.Headers) {
+		w.header(pointer+"/headers/"+escapeJSONPointer(name), ref.Value.Headers[name])
+	}
+}
+
+// escapeJSONPointer escapes token to be a reference token of a JSON pointer,
+// as RFC 6901 defines.
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,55 @@
+package codegen
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// OperationScopesDefinition lists the security requirements of an operation,
+// for the scope-checks output option.
+type OperationScopesDefinition struct {
+	OperationId string
+	// Requirements are the security requirements, any one of which must be
+	// met, as Go literals of the map[string][]string of the scopes required by
+	// each of their security schemes.
+	Requirements []string
+}
+
+// GenerateScopeChecks generates RequiredScopes, returning the security
+// requirements of every operation, along with CheckScopes and the context
+// helpers of the granted scopes, for the scope-checks output option.
+func GenerateScopeChecks(t *template.Template, ops []OperationDefinition) (string, error) {
+	definitions := make([]OperationScopesDefinition, 0, len(ops))
+	for _, op := range ops {
+		requirements := make([]string, 0, len(op.SecurityRequirements))
+		for _, requirement := range op.SecurityRequirements {
+			requirements = append(requirements, securityRequirementLiteral(requirement))
+		}
+		definitions = append(definitions, OperationScopesDefinition{
+			OperationId:  op.OperationId,
+			Requirements: requirements,
+		})
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].OperationId < definitions[j].OperationId
+	})
+	return GenerateTemplates([]string{"scope-checks.tmpl"}, t, definitions)
+}
+
+// securityRequirementLiteral returns the element of a []map[string][]string
+// literal holding the scopes requirement needs per security scheme.
+func securityRequirementLiteral(requirement openapi3.SecurityRequirement) string {
+	schemes := make([]string, 0, len(requirement))
+	for _, name := range SortedSecurityRequirementKeys(requirement) {
+		scopes := make([]string, 0, len(requirement[name]))
+		for _, scope := range requirement[name] {
+			scopes = append(scopes, strconv.Quote(scope))
+		}
+		schemes = append(schemes, strconv.Quote(name)+": {"+strings.Join(scopes, ", ")+"}")
+	}
+	return "{" + strings.Join(schemes, ", ") + "}"
+}
@@ -0,0 +1,16 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityRequirementLiteral(t *testing.T) {
+	assert.Equal(t, `{}`, securityRequirementLiteral(openapi3.SecurityRequirement{}))
+	assert.Equal(t, `{"api_key": {}, "petstore_auth": {"write:pets", "read:pets"}}`, securityRequirementLiteral(openapi3.SecurityRequirement{
+		"petstore_auth": {"write:pets", "read:pets"},
+		"api_key":       {},
+	}))
+}
@@ -16,7 +16,9 @@ package codegen
 import (
 	"bytes"
 	"fmt"
-	"os"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -33,15 +35,13 @@ const (
 	defaultClientTypeName = "Client"
 )
 
+const defaultResponseTypeSuffix = "Response"
+
 var (
 	contentTypesJSON    = []string{"application/json", "text/x-json", "application/problem+json"}
 	contentTypesHalJSON = []string{"application/hal+json"}
 	contentTypesYAML    = []string{"application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml"}
 	contentTypesXML     = []string{"application/xml", "text/xml", "application/problems+xml"}
-
-	responseTypeSuffix = "Response"
-
-	titleCaser = cases.Title(language.English)
 )
 
 // genParamArgs takes an array of Parameter definition, and generates a valid
@@ -90,11 +90,11 @@ func genParamNames(params []ParameterDefinition) string {
 }
 
 // genResponsePayload generates the payload returned at the end of each client request function
-func genResponsePayload(operationID string) string {
+func (g *generator) genResponsePayload(operationID string) string {
 	var buffer = bytes.NewBufferString("")
 
 	// Here is where we build up a response:
-	fmt.Fprintf(buffer, "&%s{\n", genResponseTypeName(operationID))
+	fmt.Fprintf(buffer, "&%s{\n", g.genResponseTypeName(operationID))
 	fmt.Fprintf(buffer, "Body: bodyBytes,\n")
 	fmt.Fprintf(buffer, "HTTPResponse: rsp,\n")
 	fmt.Fprintf(buffer, "}")
@@ -103,17 +103,22 @@ func genResponsePayload(operationID string) string {
 }
 
 // genResponseUnmarshal generates unmarshaling steps for structured response payloads
-func genResponseUnmarshal(op *OperationDefinition) string {
+func (g *generator) genResponseUnmarshal(op *OperationDefinition) string {
 	var handledCaseClauses = make(map[string]string)
 	var unhandledCaseClauses = make(map[string]string)
 
 	// Get the type definitions from the operation:
-	typeDefinitions, err := op.GetResponseTypeDefinitions()
+	typeDefinitions, err := g.responseTypeDefinitions(op)
+	if err != nil {
+		panic(err)
+	}
+
+	rawContents, err := op.GetRawResponseContents()
 	if err != nil {
 		panic(err)
 	}
 
-	if len(typeDefinitions) == 0 {
+	if len(typeDefinitions) == 0 && len(rawContents) == 0 {
 		// No types.
 		return ""
 	}
@@ -130,7 +135,7 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 
 		// We can't do much without a value:
 		if responseRef.Value == nil {
-			fmt.Fprintf(os.Stderr, "Response %s.%s has nil value\n", op.OperationId, typeDefinition.ResponseName)
+			g.addWarning(operationLocation(op.Path, op.Method), "response %s has nil value", typeDefinition.ResponseName)
 			continue
 		}
 
@@ -144,12 +149,16 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 
 		// If we made it this far then we need to handle unmarshaling for each content-type:
 		sortedContentKeys := SortedContentKeys(responseRef.Value.Content)
-		jsonCount := 0
+		var jsonContentTypes []string
 		for _, contentTypeName := range sortedContentKeys {
 			if StringInArray(contentTypeName, contentTypesJSON) || util.IsMediaTypeJson(contentTypeName) {
-				jsonCount++
+				jsonContentTypes = append(jsonContentTypes, contentTypeName)
 			}
 		}
+		jsonCount := len(jsonContentTypes)
+		// Content types telling apart JSON responses by their parameters
+		// need those to be parsed.
+		matchParams := jsonCount > 1 && hasContentTypeParams(jsonContentTypes)
 
 		for _, contentTypeName := range sortedContentKeys {
 
@@ -159,7 +168,8 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 				continue
 			}
 
-			// Add content-types here (json / yaml / xml etc):
+			// Add content-types here (json / yaml / xml etc), the others
+			// are kept as they are, see GetRawResponseContents:
 			switch {
 
 			// JSON:
@@ -173,7 +183,10 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 						typeDefinition.Schema.TypeDecl(),
 						typeDefinition.TypeName)
 
-					if jsonCount > 1 {
+					if matchParams {
+						caseKey, caseClause := buildUnmarshalCaseWithParams(typeDefinition, caseAction, contentTypeName)
+						handledCaseClauses[caseKey] = caseClause
+					} else if jsonCount > 1 {
 						caseKey, caseClause := buildUnmarshalCaseStrict(typeDefinition, caseAction, contentTypeName)
 						handledCaseClauses[caseKey] = caseClause
 					} else {
@@ -209,16 +222,16 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 					caseKey, caseClause := buildUnmarshalCase(typeDefinition, caseAction, "xml")
 					handledCaseClauses[caseKey] = caseClause
 				}
-
-			// Everything else:
-			default:
-				caseAction := fmt.Sprintf("// Content-type (%s) unsupported", contentTypeName)
-				caseClauseKey := "case " + getConditionOfResponseName("rsp.StatusCode", typeDefinition.ResponseName) + ":"
-				unhandledCaseClauses[prefixLeastSpecific+caseClauseKey] = fmt.Sprintf("%s\n%s\n", caseClauseKey, caseAction)
 			}
 		}
 	}
 
+	// The bodies of the content types without a type are kept as they are.
+	for _, content := range rawContents {
+		caseKey, caseClause := g.buildRawCase(content)
+		handledCaseClauses[caseKey] = caseClause
+	}
+
 	if len(handledCaseClauses)+len(unhandledCaseClauses) == 0 {
 		// switch would be empty.
 		return ""
@@ -241,6 +254,75 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 	return buffer.String()
 }
 
+// genResponseHeadersTypeName returns the name of the struct holding the typed
+// headers of the given response, which is shared with the strict server when
+// it is generated as well.
+func (g *generator) genResponseHeadersTypeName(operationID string, response ResponseDefinition) string {
+	if g.options.Generate.Strict && response.IsRef() {
+		return UppercaseFirstCharacterWithPkgName(response.Ref) + "ResponseHeaders"
+	}
+	return operationID + response.StatusCode + "ResponseHeaders"
+}
+
+// genResponseHeadersUnmarshal generates the parsing of the typed headers of
+// each response which declares any.
+func (g *generator) genResponseHeadersUnmarshal(op *OperationDefinition) string {
+	hasHeaders, hasRangedHeaders := false, false
+	for _, response := range op.Responses {
+		if len(response.Headers) > 0 {
+			hasHeaders = true
+			hasRangedHeaders = hasRangedHeaders || !response.HasFixedStatusCode()
+		}
+	}
+	if !hasHeaders {
+		return ""
+	}
+
+	buffer := new(bytes.Buffer)
+	fmt.Fprintf(buffer, "switch {\n")
+	for _, response := range op.Responses {
+		if len(response.Headers) == 0 {
+			// Responses without headers only need a case when they would
+			// otherwise match a status code range or the default response.
+			if hasRangedHeaders {
+				fmt.Fprintf(buffer, "case %s:\nbreak // No headers\n", getConditionOfResponseName("rsp.StatusCode", response.StatusCode))
+			}
+			continue
+		}
+		fmt.Fprintf(buffer, "case %s:\n", getConditionOfResponseName("rsp.StatusCode", response.StatusCode))
+		fmt.Fprintf(buffer, "var headers %s\n", g.genResponseHeadersTypeName(op.OperationId, response))
+		for _, header := range response.Headers {
+			fmt.Fprintf(buffer, "if value := rsp.Header.Get(%q); value != \"\" {\n", header.Name)
+			if header.IsHTTPDate() {
+				fmt.Fprintf(buffer, "date, err := http.ParseTime(value)\nif err != nil {\n")
+				fmt.Fprintf(buffer, "return nil, fmt.Errorf(\"invalid format for header %s: %%w\", err)\n}\n", header.Name)
+				fmt.Fprintf(buffer, "headers.%s = date\n}\n", header.GoName)
+				continue
+			}
+			fmt.Fprintf(buffer, "if err := runtime.BindStyledParameterWithOptions(\"simple\", %q, value, &headers.%s, "+
+				"runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader}); err != nil {\n", header.Name, header.GoName)
+			fmt.Fprintf(buffer, "return nil, fmt.Errorf(\"invalid format for header %s: %%w\", err)\n}\n", header.Name)
+			if len(header.Schema.EnumValues) > 0 {
+				values := make([]string, 0, len(header.Schema.EnumValues))
+				for _, value := range header.Schema.EnumValues {
+					if header.Schema.GoType == "string" {
+						value = strconv.Quote(value)
+					}
+					values = append(values, value)
+				}
+				sort.Strings(values)
+				fmt.Fprintf(buffer, "switch headers.%s {\ncase %s:\ndefault:\n", header.GoName, strings.Join(values, ", "))
+				fmt.Fprintf(buffer, "return nil, fmt.Errorf(\"invalid value %%q for header %s\", value)\n}\n", header.Name)
+			}
+			fmt.Fprintf(buffer, "}\n")
+		}
+		fmt.Fprintf(buffer, "response.Headers%s = &headers\n", ToCamelCase(response.StatusCode))
+	}
+	fmt.Fprintf(buffer, "}\n")
+
+	return buffer.String()
+}
+
 // buildUnmarshalCase builds an unmarshaling case clause for different content-types:
 func buildUnmarshalCase(typeDefinition ResponseTypeDefinition, caseAction string, contentType string) (caseKey string, caseClause string) {
 	caseKey = fmt.Sprintf("%s.%s.%s", prefixLeastSpecific, contentType, typeDefinition.ResponseName)
@@ -256,19 +338,97 @@ func buildUnmarshalCaseStrict(typeDefinition ResponseTypeDefinition, caseAction
 	return caseKey, caseClause
 }
 
+// buildUnmarshalCaseWithParams builds an unmarshaling case clause matching the
+// media type and parameters of contentType, ordered before the content types
+// of the same media type with fewer parameters, so that responses without
+// parameters fall back to the content type without any.
+func buildUnmarshalCaseWithParams(typeDefinition ResponseTypeDefinition, caseAction string, contentType string) (caseKey string, caseClause string) {
+	mediaType, params := splitContentType(contentType)
+	specificity := "1"
+	if params != "" {
+		specificity = "0"
+	}
+	caseKey = fmt.Sprintf("%s.%s.%s.%s.%s", prefixLeastSpecific, mediaType, typeDefinition.ResponseName, specificity, contentType)
+	caseClauseKey := getConditionOfResponseName("rsp.StatusCode", typeDefinition.ResponseName)
+	caseClause = fmt.Sprintf("case responseHasContentType(rsp.Header.Get(\"%s\"), %q) && %s:\n%s\n", "Content-Type", contentType, caseClauseKey, caseAction)
+	return caseKey, caseClause
+}
+
+// buildRawCase builds the case clause keeping the body of a response of a
+// content type without a type, ordered after the typed ones, and, for each
+// response, before the content types of the same media type with fewer
+// parameters, and the ones with wildcards, which match more.
+func (g *generator) buildRawCase(content RawResponseContent) (caseKey string, caseClause string) {
+	mediaType, params := splitContentType(content.ContentType)
+	specificity := "1"
+	if params != "" {
+		specificity = "0"
+	}
+	caseKey = fmt.Sprintf("%s.~.%s.%d.%s.%s", prefixLeastSpecific, content.ResponseName, strings.Count(mediaType, "*"), specificity, content.ContentType)
+	caseClauseKey := getConditionOfResponseName("rsp.StatusCode", content.ResponseName)
+	caseClause = fmt.Sprintf("case responseHasContentType(rsp.Header.Get(\"%s\"), %q) && %s:\nresponse.%s = bodyBytes\n", "Content-Type", content.ContentType, caseClauseKey, content.FieldName)
+	if len(content.Parts) != 0 {
+		caseClause += fmt.Sprintf("var dest %s\n"+
+			"if err := %s; err != nil {\n"+
+			"return nil, err\n"+
+			"}\n"+
+			"response.%s = &dest\n",
+			content.Schema.TypeDecl(), g.genReadMultipartParts(content.Parts), content.PartsFieldName)
+	}
+	return caseKey, caseClause
+}
+
+// hasResponseContentTypeParams returns whether any of the operations tells
+// apart JSON responses by the parameters of their content type, or has
+// responses of content types without a type, which the generated client needs
+// a helper to match.
+func hasResponseContentTypeParams(ops []OperationDefinition) bool {
+	for _, op := range ops {
+		if op.Spec == nil || op.Spec.Responses == nil {
+			continue
+		}
+		for _, response := range op.Spec.Responses.Map() {
+			if response.Value == nil {
+				continue
+			}
+			var jsonContentTypes []string
+			for contentType := range response.Value.Content {
+				if StringInArray(contentType, contentTypesJSON) || util.IsMediaTypeJson(contentType) {
+					jsonContentTypes = append(jsonContentTypes, contentType)
+				}
+			}
+			if len(jsonContentTypes) > 1 && hasContentTypeParams(jsonContentTypes) {
+				return true
+			}
+		}
+		if contents, err := op.GetRawResponseContents(); err == nil && len(contents) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // genResponseTypeName creates the name of generated response types (given the operationID):
-func genResponseTypeName(operationID string) string {
-	return fmt.Sprintf("%s%s", UppercaseFirstCharacter(operationID), responseTypeSuffix)
+func (g *generator) genResponseTypeName(operationID string) string {
+	return fmt.Sprintf("%s%s", UppercaseFirstCharacter(operationID), g.responseTypeSuffix)
 }
 
-func getResponseTypeDefinitions(op *OperationDefinition) []ResponseTypeDefinition {
-	td, err := op.GetResponseTypeDefinitions()
+func (g *generator) getResponseTypeDefinitions(op *OperationDefinition) []ResponseTypeDefinition {
+	td, err := g.responseTypeDefinitions(op)
 	if err != nil {
 		panic(err)
 	}
 	return td
 }
 
+func getRawResponseContents(op *OperationDefinition) []RawResponseContent {
+	contents, err := op.GetRawResponseContents()
+	if err != nil {
+		panic(err)
+	}
+	return contents
+}
+
 // Return the statusCode comparison clause from the response name.
 func getConditionOfResponseName(statusCodeVar, responseName string) string {
 	switch responseName {
@@ -296,30 +456,85 @@ func stripNewLines(s string) string {
 }
 
 // TemplateFunctions is passed to the template engine, and we can call each
-// function here by keyName from the template code.
-var TemplateFunctions = template.FuncMap{
-	"genParamArgs":               genParamArgs,
-	"genParamTypes":              genParamTypes,
-	"genParamNames":              genParamNames,
-	"genParamFmtString":          ReplacePathParamsWithStr,
-	"swaggerUriToIrisUri":        SwaggerUriToIrisUri,
-	"swaggerUriToEchoUri":        SwaggerUriToEchoUri,
-	"swaggerUriToFiberUri":       SwaggerUriToFiberUri,
-	"swaggerUriToChiUri":         SwaggerUriToChiUri,
-	"swaggerUriToGinUri":         SwaggerUriToGinUri,
-	"swaggerUriToGorillaUri":     SwaggerUriToGorillaUri,
-	"lcFirst":                    LowercaseFirstCharacter,
-	"ucFirst":                    UppercaseFirstCharacter,
-	"ucFirstWithPkgName":         UppercaseFirstCharacterWithPkgName,
-	"camelCase":                  ToCamelCase,
-	"genResponsePayload":         genResponsePayload,
-	"genResponseTypeName":        genResponseTypeName,
-	"genResponseUnmarshal":       genResponseUnmarshal,
-	"getResponseTypeDefinitions": getResponseTypeDefinitions,
-	"toStringArray":              toStringArray,
-	"lower":                      strings.ToLower,
-	"title":                      titleCaser.String,
-	"stripNewLines":              stripNewLines,
-	"sanitizeGoIdentity":         SanitizeGoIdentity,
-	"toGoComment":                StringWithTypeNameToGoComment,
+// function here by keyName from the template code. Those depending on the
+// generation run use the spec and the options of the last call to Generate
+// when they are called, the code generated by Generate uses those of its own
+// run, see templateFunctions.
+var TemplateFunctions = exportedTemplateFunctions()
+
+// exportedTemplateFunctions returns the functions of TemplateFunctions, those
+// depending on the run calling the ones of exportedGenerator.
+func exportedTemplateFunctions() template.FuncMap {
+	funcs := template.FuncMap{}
+	for name, f := range new(generator).templateFunctions() {
+		if _, found := templateFunctions[name]; found {
+			funcs[name] = f
+			continue
+		}
+		name := name
+		funcs[name] = reflect.MakeFunc(reflect.TypeOf(f), func(args []reflect.Value) []reflect.Value {
+			f := reflect.ValueOf(exportedGenerator().templateFunctions()[name])
+			if f.Type().IsVariadic() {
+				return f.CallSlice(args)
+			}
+			return f.Call(args)
+		}).Interface()
+	}
+	return funcs
+}
+
+// templateFunctions returns the functions of the templates of the run g,
+// those which don't depend on the run along with those bound to g.
+func (g *generator) templateFunctions() template.FuncMap {
+	funcs := template.FuncMap{
+		"opts":                        func() Configuration { return g.options },
+		"routePath":                   g.routePath,
+		"routePrefix":                 func() string { return g.routePrefix },
+		"genResponsePayload":          g.genResponsePayload,
+		"genResponseTypeName":         g.genResponseTypeName,
+		"genResponseUnmarshal":        g.genResponseUnmarshal,
+		"genWriteMultipartParts":      g.genWriteMultipartParts,
+		"genResponseHeadersUnmarshal": g.genResponseHeadersUnmarshal,
+		"genResponseHeadersTypeName":  g.genResponseHeadersTypeName,
+		"getResponseTypeDefinitions":  g.getResponseTypeDefinitions,
+		"normalizedCollection":        g.normalizedCollection,
+		// A Caser keeps state, so each run has its own.
+		"title": cases.Title(language.English).String,
+	}
+	for name, f := range templateFunctions {
+		funcs[name] = f
+	}
+	return funcs
+}
+
+// templateFunctions are the functions of the templates which don't depend on
+// the generation run.
+var templateFunctions = template.FuncMap{
+	"genParamArgs":                 genParamArgs,
+	"genParamTypes":                genParamTypes,
+	"genParamNames":                genParamNames,
+	"genParamFmtString":            ReplacePathParamsWithStr,
+	"swaggerUriToIrisUri":          SwaggerUriToIrisUri,
+	"swaggerUriToEchoUri":          SwaggerUriToEchoUri,
+	"swaggerUriToFiberUri":         SwaggerUriToFiberUri,
+	"swaggerUriToChiUri":           SwaggerUriToChiUri,
+	"swaggerUriToGinUri":           SwaggerUriToGinUri,
+	"swaggerUriToGorillaUri":       SwaggerUriToGorillaUri,
+	"lcFirst":                      LowercaseFirstCharacter,
+	"ucFirst":                      UppercaseFirstCharacter,
+	"ucFirstWithPkgName":           UppercaseFirstCharacterWithPkgName,
+	"camelCase":                    ToCamelCase,
+	"getRawResponseContents":       getRawResponseContents,
+	"hasResponseContentTypeParams": hasResponseContentTypeParams,
+	"toStringArray":                toStringArray,
+	"lower":                        strings.ToLower,
+	"stripNewLines":                stripNewLines,
+	"sanitizeGoIdentity":           SanitizeGoIdentity,
+	"toGoComment":                  StringWithTypeNameToGoComment,
+	"marshalProperty":              marshalProperty,
+	"unmarshalProperty":            unmarshalProperty,
+	"unmarshalsWithJSONCodecs":     unmarshalsWithJSONCodecs,
+	"isPointerField":               isPointerField,
+	"omitEmpty":                    Property.omitEmpty,
+	"preserveZero":                 Property.preserveZero,
 }
@@ -0,0 +1,178 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+// Timing is the measurement of a step of a generation run, passed to
+// Configuration.Timing. Kind is "phase", "schema" or "operation".
+//
+// The phases are, in order, "parse", which includes resolving references,
+// "prune", "operations", "schemas", "templates" and "format", some of which
+// are skipped depending on the configuration. Each component schema and
+// operation is measured too, named after the schema and the location of the
+// operation, eg, paths./pets.get, so that pathological ones, such as long
+// allOf chains, can be found.
+type Timing struct {
+	Kind     string        `json:"kind"`
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"durationNs"`
+	// Allocs and AllocBytes count the heap allocations made during a phase.
+	// They aren't measured for schemas and operations.
+	Allocs     uint64 `json:"allocs,omitempty"`
+	AllocBytes uint64 `json:"allocBytes,omitempty"`
+}
+
+// TimingFunc receives the timings of a generation run as its steps
+// complete.
+type TimingFunc func(Timing)
+
+// startPhase starts measuring a phase, returning the function to call once
+// it's complete. Allocations are only counted when timing is set, as reading
+// them stops the world.
+func startPhase(timing TimingFunc, name string) func() {
+	if timing == nil {
+		return func() {}
+	}
+	var before runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	return func() {
+		elapsed := time.Since(start)
+		var after runtime.MemStats
+		runtime.ReadMemStats(&after)
+		timing(Timing{
+			Kind:       "phase",
+			Name:       name,
+			Duration:   elapsed,
+			Allocs:     after.Mallocs - before.Mallocs,
+			AllocBytes: after.TotalAlloc - before.TotalAlloc,
+		})
+	}
+}
+
+// startStep starts measuring the schema or operation called name within the
+// current generation run, returning the function to call once it's
+// complete.
+func (g *generator) startStep(kind string, name string) func() {
+	timing := g.options.Timing
+	if timing == nil {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		timing(Timing{Kind: kind, Name: name, Duration: time.Since(start)})
+	}
+}
+
+// TimingReport collects the timings of a generation run, when its Record
+// method is passed as Configuration.Timing, and summarizes them.
+type TimingReport struct {
+	Phases     []Timing
+	Schemas    []Timing
+	Operations []Timing
+}
+
+// Record adds timing to the report.
+func (r *TimingReport) Record(timing Timing) {
+	switch timing.Kind {
+	case "phase":
+		r.Phases = append(r.Phases, timing)
+	case "schema":
+		r.Schemas = append(r.Schemas, timing)
+	case "operation":
+		r.Operations = append(r.Operations, timing)
+	}
+}
+
+// Total returns the time spent in all the phases.
+func (r *TimingReport) Total() time.Duration {
+	var total time.Duration
+	for _, phase := range r.Phases {
+		total += phase.Duration
+	}
+	return total
+}
+
+// TimingSummary is the summary of a TimingReport, as written by WriteJSON.
+type TimingSummary struct {
+	Total             time.Duration `json:"totalNs"`
+	Phases            []Timing      `json:"phases"`
+	SlowestSchemas    []Timing      `json:"slowestSchemas"`
+	SlowestOperations []Timing      `json:"slowestOperations"`
+}
+
+// Summary returns the phases of the report, along with its n slowest
+// schemas and operations.
+func (r *TimingReport) Summary(n int) TimingSummary {
+	return TimingSummary{
+		Total:             r.Total(),
+		Phases:            append([]Timing{}, r.Phases...),
+		SlowestSchemas:    slowest(r.Schemas, n),
+		SlowestOperations: slowest(r.Operations, n),
+	}
+}
+
+// slowest returns the n slowest of timings, slowest first.
+func slowest(timings []Timing, n int) []Timing {
+	sorted := append([]Timing{}, timings...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Duration > sorted[j].Duration
+	})
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	return sorted
+}
+
+// WriteText writes the summary of the report, with its n slowest schemas and
+// operations, to w as text.
+func (r *TimingReport) WriteText(w io.Writer, n int) error {
+	summary := r.Summary(n)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "phase\ttime\tallocs\tbytes\n")
+	for _, phase := range summary.Phases {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\n", phase.Name, phase.Duration.Round(time.Microsecond), phase.Allocs, phase.AllocBytes)
+	}
+	fmt.Fprintf(tw, "total\t%s\n", summary.Total.Round(time.Microsecond))
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, slowest := range []struct {
+		title   string
+		timings []Timing
+	}{
+		{"schemas", summary.SlowestSchemas},
+		{"operations", summary.SlowestOperations},
+	} {
+		if len(slowest.timings) == 0 {
+			continue
+		}
+		fmt.Fprintf(tw, "\nslowest %s\n", slowest.title)
+		for _, timing := range slowest.timings {
+			fmt.Fprintf(tw, "%s\t%s\n", timing.Name, timing.Duration.Round(time.Microsecond))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the summary of the report, with its n slowest schemas and
+// operations, to w as JSON.
+func (r *TimingReport) WriteJSON(w io.Writer, n int) error {
+	buf, err := json.MarshalIndent(r.Summary(n), "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(buf, '\n'))
+	return err
+}
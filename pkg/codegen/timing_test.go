@@ -0,0 +1,72 @@
+package codegen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateFilesTiming(t *testing.T) {
+	var report TimingReport
+	cfg := Configuration{
+		PackageName: "api",
+		Generate:    GenerateOptions{Models: true, Client: true},
+		ImportMapping: map[string]string{
+			"./models.yaml": "example.com/models",
+		},
+		SpecLocation: "specs/api.yaml",
+		Loader:       mapLoader{"specs/models.yaml": generatorModels},
+		Timing:       report.Record,
+	}
+
+	_, _, err := GenerateFiles(context.Background(), []byte(generatorSpec), cfg)
+	require.NoError(t, err)
+
+	var phases []string
+	for _, phase := range report.Phases {
+		phases = append(phases, phase.Name)
+		assert.NotZero(t, phase.Allocs, phase.Name)
+	}
+	assert.Equal(t, []string{"parse", "prune", "operations", "schemas", "templates", "format"}, phases)
+	require.Len(t, report.Operations, 1)
+	assert.Equal(t, "paths./things/{id}.get", report.Operations[0].Name)
+}
+
+func TestTimingReport(t *testing.T) {
+	var report TimingReport
+	report.Record(Timing{Kind: "phase", Name: "parse", Duration: 2 * time.Millisecond, Allocs: 10, AllocBytes: 100})
+	report.Record(Timing{Kind: "phase", Name: "format", Duration: time.Millisecond, Allocs: 5, AllocBytes: 50})
+	report.Record(Timing{Kind: "schema", Name: "Fast", Duration: time.Microsecond})
+	report.Record(Timing{Kind: "schema", Name: "Slow", Duration: time.Millisecond})
+	report.Record(Timing{Kind: "schema", Name: "Medium", Duration: 10 * time.Microsecond})
+
+	summary := report.Summary(2)
+	assert.Equal(t, 3*time.Millisecond, summary.Total)
+	require.Len(t, summary.SlowestSchemas, 2)
+	assert.Equal(t, "Slow", summary.SlowestSchemas[0].Name)
+	assert.Equal(t, "Medium", summary.SlowestSchemas[1].Name)
+	assert.Empty(t, summary.SlowestOperations)
+
+	var text bytes.Buffer
+	require.NoError(t, report.WriteText(&text, 2))
+	assert.Equal(t, `phase   time  allocs  bytes
+parse   2ms   10      100
+format  1ms   5       50
+total   3ms
+
+slowest schemas
+Slow    1ms
+Medium  10µs
+`, text.String())
+
+	var buf bytes.Buffer
+	require.NoError(t, report.WriteJSON(&buf, 2))
+	var decoded TimingSummary
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, summary, decoded)
+}
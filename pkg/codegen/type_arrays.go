@@ -0,0 +1,115 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// normalizeTypeArrays rewrites the type arrays of the schemas of the document
+// data, as OpenAPI 3.1 writes them, to the single type of OpenAPI 3.0, the
+// only one kin-openapi reads, so that they're compared and merged like any
+// other type: the "null" type becomes `nullable: true`, eg, `type: [string,
+// "null"]` is read as `type: string` and `nullable: true`, and `[string]` as
+// `string`. An array of several other types is an error, as they'd be a union
+// which can't be declared that way. Documents without type arrays are
+// returned as they are.
+func normalizeTypeArrays(data []byte) ([]byte, error) {
+	if !bytes.Contains(data, []byte("type")) {
+		return data, nil
+	}
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		// The loader reports the error, in its own words.
+		return data, nil
+	}
+	changed, err := normalizeTypeArraysOf(&document)
+	if err != nil || !changed {
+		return data, err
+	}
+	return yaml.Marshal(&document)
+}
+
+// normalizeTypeArraysOf rewrites the type arrays of node and its descendants,
+// reporting whether there were any. The values of examples, defaults, enums
+// and constants aren't schemas, and are skipped.
+func normalizeTypeArraysOf(node *yaml.Node) (bool, error) {
+	changed := false
+	if node.Kind == yaml.MappingNode {
+		rewritten, err := normalizeTypeArray(node)
+		if err != nil {
+			return false, err
+		}
+		changed = rewritten
+	}
+	for i, child := range node.Content {
+		if node.Kind == yaml.MappingNode && i%2 == 1 {
+			switch node.Content[i-1].Value {
+			case "example", "examples", "default", "enum", "const":
+				continue
+			}
+		}
+		rewritten, err := normalizeTypeArraysOf(child)
+		if err != nil {
+			return false, err
+		}
+		changed = changed || rewritten
+	}
+	return changed, nil
+}
+
+// normalizeTypeArray rewrites the type of the mapping node, when it's an
+// array, along with its nullable.
+func normalizeTypeArray(node *yaml.Node) (bool, error) {
+	typeIndex, nullableIndex := -1, -1
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		switch node.Content[i].Value {
+		case "type":
+			typeIndex = i
+		case "nullable":
+			nullableIndex = i
+		}
+	}
+	if typeIndex < 0 || node.Content[typeIndex+1].Kind != yaml.SequenceNode {
+		return false, nil
+	}
+	value := node.Content[typeIndex+1]
+
+	var types []*yaml.Node
+	nullable := false
+	for _, t := range value.Content {
+		if t.Kind != yaml.ScalarNode {
+			return false, fmt.Errorf("invalid type at line %d: a type array holds the names of types", t.Line)
+		}
+		if t.Value == "null" {
+			nullable = true
+		} else {
+			types = append(types, t)
+		}
+	}
+	switch {
+	case len(types) > 1:
+		return false, fmt.Errorf("unsupported type array at line %d: it has several types other than null, which can be written as a oneOf instead", value.Line)
+	case len(types) == 0 && nullable:
+		// The null type alone is read as it is by the loader.
+		*value = yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "null"}
+		return true, nil
+	case len(types) == 0:
+		// An empty type array allows any type, like no type at all.
+		node.Content = append(node.Content[:typeIndex], node.Content[typeIndex+2:]...)
+		return true, nil
+	}
+
+	*value = yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: types[0].Value}
+	if nullable {
+		flag := yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"}
+		if nullableIndex >= 0 {
+			*node.Content[nullableIndex+1] = flag
+		} else {
+			key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "nullable"}
+			node.Content = append(node.Content, key, &flag)
+		}
+	}
+	return true, nil
+}
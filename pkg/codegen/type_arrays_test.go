@@ -0,0 +1,113 @@
+package codegen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTypeArrays(t *testing.T) {
+	tests := []struct {
+		name, data, normalized string
+	}{
+		{
+			name:       "nullable",
+			data:       "type: [string, \"null\"]\n",
+			normalized: "type: string\nnullable: true\n",
+		},
+		{
+			name:       "null first, nullable false",
+			data:       "type: [\"null\", integer]\nnullable: false\n",
+			normalized: "type: integer\nnullable: true\n",
+		},
+		{
+			name:       "single type",
+			data:       "type: [string]\n",
+			normalized: "type: string\n",
+		},
+		{
+			name:       "null alone",
+			data:       "type: [\"null\"]\n",
+			normalized: "type: \"null\"\n",
+		},
+		{
+			name:       "nested",
+			data:       "properties:\n  type:\n    type: [string, \"null\"]\n",
+			normalized: "properties:\n    type:\n        type: string\n        nullable: true\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalized, err := normalizeTypeArrays([]byte(tt.data))
+			require.NoError(t, err)
+			assert.Equal(t, tt.normalized, string(normalized))
+		})
+	}
+}
+
+func TestNormalizeTypeArraysUnchanged(t *testing.T) {
+	for _, data := range []string{
+		"type: string\n",
+		"example:\n  type: [a, b]\n",
+		"enum: [[string, \"null\"]]\n",
+	} {
+		normalized, err := normalizeTypeArrays([]byte(data))
+		require.NoError(t, err)
+		assert.Equal(t, data, string(normalized))
+	}
+}
+
+func TestNormalizeTypeArraysOfSeveralTypes(t *testing.T) {
+	_, err := normalizeTypeArrays([]byte("type: [string, integer]\n"))
+	assert.ErrorContains(t, err, "unsupported type array at line 1")
+}
+
+func TestMergeTypeArrays(t *testing.T) {
+	spec := `
+openapi: "3.1.0"
+info:
+  version: 1.0.0
+  title: type arrays
+paths: {}
+components:
+  schemas:
+    Name:
+      type: string
+      nullable: true
+    Base:
+      type: object
+      required: [name, label, code]
+      properties:
+        name:
+          type: [string, "null"]
+        label:
+          type: [string]
+        code:
+          $ref: '#/components/schemas/Name'
+    Merged:
+      allOf:
+        - $ref: '#/components/schemas/Base'
+        - type: object
+          properties:
+            name:
+              type: [string]
+              description: The name, refined.
+            label:
+              type: [string, "null"]
+            code:
+              type: [string, "null"]
+              description: The code, refined.
+`
+	files, _, err := GenerateFiles(context.Background(), []byte(spec), Configuration{
+		PackageName:   "api",
+		Generate:      GenerateOptions{Models: true},
+		OutputOptions: OutputOptions{SkipPrune: true},
+	})
+	require.NoError(t, err)
+	code := string(files["api.gen.go"])
+	assert.Regexp(t, `(?s)type Merged struct \{.*\tCode +\*string`, code)
+	assert.Regexp(t, `(?s)type Merged struct \{.*\tLabel +\*string`, code)
+	assert.Regexp(t, `(?s)type Merged struct \{.*\tName +\*string`, code)
+}
@@ -0,0 +1,193 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// URITemplateVariable is a variable of a URI template, along with the Go
+// parameter holding its value in the generated expansion helper.
+type URITemplateVariable struct {
+	Name   string // The name of the variable in the template, eg, petId
+	GoName string // The name of the Go parameter, eg, petId
+	GoType string // The type of the Go parameter, eg, *int32
+}
+
+// URITemplateDefinition is a URI template found in one of the vendor
+// extensions of an operation listed in the uri-template-extensions output
+// option, for which an expansion helper is generated.
+type URITemplateDefinition struct {
+	FuncName    string // The name of the expansion helper, eg, ExpandListPetsSelfLink
+	OperationId string
+	Extension   string // The name of the extension, eg, x-self-link
+	Template    string
+	Variables   []URITemplateVariable
+}
+
+// uriTemplateOperators are the expression operators of RFC 6570, up to
+// level 3. The reserved ones, "=", ",", "!", "@" and "|", aren't.
+const uriTemplateOperators = "+#./;?&"
+
+// GenerateURITemplates generates an expansion helper for the URI templates
+// found in the extensions of the operations named by the
+// uri-template-extensions output option, along with the expansion function
+// they call. Variables named after a parameter of the operation take its
+// type, others are strings.
+func GenerateURITemplates(t *template.Template, ops []OperationDefinition, extensions []string) (string, error) {
+	var definitions []URITemplateDefinition
+	for _, op := range ops {
+		if op.Spec == nil {
+			continue
+		}
+		for _, extension := range extensions {
+			value, ok := op.Spec.Extensions[extension]
+			if !ok {
+				continue
+			}
+			definition, err := uriTemplateDefinition(op, extension, value)
+			if err != nil {
+				return "", fmt.Errorf("%s: extension %s: %w", operationLocation(op.Path, op.Method), extension, err)
+			}
+			definitions = append(definitions, definition)
+		}
+	}
+	if len(definitions) == 0 {
+		return "", nil
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].FuncName < definitions[j].FuncName
+	})
+	return GenerateTemplates([]string{"uri-templates.tmpl"}, t, definitions)
+}
+
+// uriTemplateDefinition returns the definition of the expansion helper of the
+// URI template held by the extension of op.
+func uriTemplateDefinition(op OperationDefinition, extension string, value interface{}) (URITemplateDefinition, error) {
+	tmpl, ok := value.(string)
+	if !ok {
+		return URITemplateDefinition{}, fmt.Errorf("expected a URI template string, got %T", value)
+	}
+	names, err := uriTemplateVariables(tmpl)
+	if err != nil {
+		return URITemplateDefinition{}, fmt.Errorf("URI template %q: %w", tmpl, err)
+	}
+
+	params := op.AllParams()
+	// The names used by the body of the helper are taken.
+	used := map[string]bool{"uri": true, "expandURITemplate": true}
+	variables := make([]URITemplateVariable, 0, len(names))
+	for _, name := range names {
+		variable := URITemplateVariable{Name: name, GoType: "string"}
+		if param := ParameterDefinitions(params).FindByName(name); param != nil {
+			variable.GoName = param.GoVariableName()
+			variable.GoType = param.TypeDef()
+			if param.IndirectOptional() {
+				variable.GoType = "*" + variable.GoType
+			}
+		} else {
+			variable.GoName = LowercaseFirstCharacter(SchemaNameToTypeName(name))
+			if IsGoKeyword(variable.GoName) {
+				variable.GoName = "p" + UppercaseFirstCharacter(variable.GoName)
+			}
+		}
+		for goName, i := variable.GoName, 1; used[variable.GoName]; i++ {
+			variable.GoName = goName + strconv.Itoa(i)
+		}
+		used[variable.GoName] = true
+		variables = append(variables, variable)
+	}
+
+	return URITemplateDefinition{
+		FuncName:    "Expand" + op.OperationId + SchemaNameToTypeName(strings.TrimPrefix(extension, "x-")),
+		OperationId: op.OperationId,
+		Extension:   extension,
+		Template:    tmpl,
+		Variables:   variables,
+	}, nil
+}
+
+// uriTemplateVariables parses tmpl as an RFC 6570 URI template, returning the
+// names of its variables in the order they first appear.
+func uriTemplateVariables(tmpl string) ([]string, error) {
+	var names []string
+	seen := make(map[string]bool)
+	for rest := tmpl; ; {
+		start := strings.IndexAny(rest, "{}")
+		if start < 0 {
+			break
+		}
+		if rest[start] == '}' {
+			return nil, fmt.Errorf("unexpected '}' at offset %d", len(tmpl)-len(rest)+start)
+		}
+		end := strings.IndexAny(rest[start+1:], "{}")
+		if end < 0 || rest[start+1+end] == '{' {
+			return nil, fmt.Errorf("unterminated expression at offset %d", len(tmpl)-len(rest)+start)
+		}
+		expression := rest[start+1 : start+1+end]
+		rest = rest[start+1+end+1:]
+
+		if expression == "" {
+			return nil, fmt.Errorf("empty expression")
+		}
+		if c := expression[0]; !isURITemplateVarchar(c) && c != '%' {
+			if !strings.ContainsRune(uriTemplateOperators, rune(c)) {
+				return nil, fmt.Errorf("unsupported operator '%c' in expression {%s}", c, expression)
+			}
+			expression = expression[1:]
+		}
+		for _, varspec := range strings.Split(expression, ",") {
+			name, err := parseURITemplateVarspec(varspec)
+			if err != nil {
+				return nil, fmt.Errorf("expression {%s}: %w", expression, err)
+			}
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names, nil
+}
+
+// parseURITemplateVarspec returns the name of the variable of varspec, the
+// name optionally followed by a prefix, eg, :3, or explode, *, modifier.
+func parseURITemplateVarspec(varspec string) (string, error) {
+	name := varspec
+	if strings.HasSuffix(name, "*") {
+		name = strings.TrimSuffix(name, "*")
+	} else if i := strings.IndexByte(name, ':'); i >= 0 {
+		name = varspec[:i]
+		length := varspec[i+1:]
+		if n, err := strconv.Atoi(length); err != nil || n < 1 || n > 9999 || length[0] == '0' {
+			return "", fmt.Errorf("invalid prefix length %q of variable %s", length, name)
+		}
+	}
+
+	valid := name != "" && name[0] != '.' && name[len(name)-1] != '.' && !strings.Contains(name, "..")
+	for i := 0; valid && i < len(name); i++ {
+		switch c := name[i]; {
+		case c == '%':
+			valid = i+2 < len(name) && isHexDigit(name[i+1]) && isHexDigit(name[i+2])
+			i += 2
+		case c != '.':
+			valid = isURITemplateVarchar(c)
+		}
+	}
+	if !valid {
+		return "", fmt.Errorf("invalid variable name %q", name)
+	}
+	return name, nil
+}
+
+// isURITemplateVarchar returns whether c may appear, unescaped, in the name
+// of a variable of a URI template.
+func isURITemplateVarchar(c byte) bool {
+	return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
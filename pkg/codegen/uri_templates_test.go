@@ -0,0 +1,39 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURITemplateVariables(t *testing.T) {
+	names, err := uriTemplateVariables("/pets/{petId}/photos{?limit,tags*}{&limit}{#frag:3}{+base.url}{%41}")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"petId", "limit", "tags", "frag", "base.url", "%41"}, names)
+
+	for tmpl, expected := range map[string]string{
+		"/pets{=x}":   "unsupported operator '=' in expression {=x}",
+		"/pets{|x}":   "unsupported operator '|' in expression {|x}",
+		"/pets{x":     "unterminated expression at offset 5",
+		"/pets{x{y}}": "unterminated expression at offset 5",
+		"/pets}":      "unexpected '}' at offset 5",
+		"/pets{}":     "empty expression",
+		"/pets{x:0}":  `expression {x:0}: invalid prefix length "0" of variable x`,
+		"/pets{x,}":   `expression {x,}: invalid variable name ""`,
+		"/pets{a..b}": `expression {a..b}: invalid variable name "a..b"`,
+	} {
+		_, err := uriTemplateVariables(tmpl)
+		assert.EqualError(t, err, expected, tmpl)
+	}
+}
+
+func TestGenerateURITemplatesError(t *testing.T) {
+	op := openapi3.NewOperation()
+	op.Extensions = map[string]interface{}{"x-self-link": "/pets{!id}"}
+	_, err := GenerateURITemplates(nil, []OperationDefinition{
+		{OperationId: "ListPets", Path: "/pets", Method: "GET", Spec: op},
+	}, []string{"x-self-link"})
+	assert.EqualError(t, err, operationLocation("/pets", "GET")+`: extension x-self-link: URI template "/pets{!id}": unsupported operator '!' in expression {!id}`)
+}
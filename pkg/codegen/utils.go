@@ -16,7 +16,9 @@ package codegen
 import (
 	"fmt"
 	"go/token"
+	"mime"
 	"net/url"
+	"path"
 	"reflect"
 	"regexp"
 	"sort"
@@ -156,6 +158,10 @@ func ToCamelCase(str string) string {
 				n += string(v)
 			}
 		}
+		// Letters without case, eg, Japanese ones, are kept as they are.
+		if unicode.IsLetter(v) && !unicode.IsUpper(v) && !unicode.IsLower(v) {
+			n += string(v)
+		}
 		_, capNext = separatorSet[v]
 	}
 	return n
@@ -190,6 +196,69 @@ func mediaTypeToCamelCase(s string) string {
 	return ToCamelCaseWithInitialism(s)
 }
 
+// splitContentType splits a content type into its media type and the name
+// its parameters add to generated identifiers, eg, "application/json;
+// version=2" gives "application/json" and "Version2".
+func splitContentType(contentType string) (mediaType string, params string) {
+	mediaType, parsed, err := mime.ParseMediaType(contentType)
+	if err != nil || len(parsed) == 0 {
+		return contentType, ""
+	}
+	keys := make([]string, 0, len(parsed))
+	for key := range parsed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		params += ToCamelCase(key + "_" + parsed[key])
+	}
+	return mediaType, params
+}
+
+// hasContentTypeParams returns whether any of the given content types has
+// parameters, like "application/json; version=2".
+func hasContentTypeParams(contentTypes []string) bool {
+	for _, contentType := range contentTypes {
+		if _, params := splitContentType(contentType); params != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// contentTypeNames names the given content types of a request body or
+// response, naming each after its media type with name, and adding the name
+// of its parameters when another content type has the same media type, eg,
+// "application/json" and "application/json; version=2" give "JSON" and
+// "JSONVersion2". Content types named "" aren't supported, and content types
+// which would still share a name are reported.
+func contentTypeNames(contentTypes []string, name func(mediaType string) string) (map[string]string, error) {
+	mediaTypes := make(map[string]int)
+	for _, contentType := range contentTypes {
+		mediaType, _ := splitContentType(contentType)
+		mediaTypes[mediaType]++
+	}
+
+	names := make(map[string]string, len(contentTypes))
+	named := make(map[string]string)
+	for _, contentType := range contentTypes {
+		mediaType, params := splitContentType(contentType)
+		n := name(mediaType)
+		if n == "" {
+			continue
+		}
+		if mediaTypes[mediaType] > 1 {
+			n += params
+		}
+		if other, found := named[n]; found {
+			return nil, fmt.Errorf("content types %q and %q would both be named %s", other, contentType, n)
+		}
+		named[n] = contentType
+		names[contentType] = n
+	}
+	return names, nil
+}
+
 // SortedSchemaKeys returns the keys of the given SchemaRef dictionary in sorted
 // order, since Golang scrambles dictionary keys
 func SortedSchemaKeys(dict map[string]*openapi3.SchemaRef) []string {
@@ -345,11 +414,11 @@ func RefPathToObjName(refPath string) string {
 // URL components (http://deepmap.com/schemas/document.json#/Foo) are supported if they present in --import-mapping
 // Remote and URL also support standard local paths even though the spec doesn't mention them.
 func RefPathToGoType(refPath string) (string, error) {
-	return refPathToGoType(refPath, true)
+	return exportedGenerator().refPathToGoType(refPath, true)
 }
 
 // refPathToGoType returns the Go typename for refPath given its
-func refPathToGoType(refPath string, local bool) (string, error) {
+func (g *generator) refPathToGoType(refPath string, local bool) (string, error) {
 	if refPath[0] == '#' {
 		pathParts := strings.Split(refPath, "/")
 		depth := len(pathParts)
@@ -363,7 +432,7 @@ func refPathToGoType(refPath string, local bool) (string, error) {
 
 		// Schemas may have been renamed locally, so look up the actual name in
 		// the spec.
-		name, err := findSchemaNameByRefPath(refPath, globalState.spec)
+		name, err := findSchemaNameByRefPath(refPath, g.spec)
 		if err != nil {
 			return "", fmt.Errorf("error finding ref: %s in spec: %v", refPath, err)
 		}
@@ -380,15 +449,56 @@ func refPathToGoType(refPath string, local bool) (string, error) {
 		return "", fmt.Errorf("unsupported reference: %s", refPath)
 	}
 	remoteComponent, flatComponent := pathParts[0], pathParts[1]
-	if goImport, ok := globalState.importMapping[remoteComponent]; !ok {
+	goImport, ok := g.importMapping.lookup(remoteComponent)
+	if !ok {
 		return "", fmt.Errorf("unrecognized external reference '%s'; please provide the known import for this reference using option --import-mapping", remoteComponent)
-	} else {
-		goType, err := refPathToGoType("#"+flatComponent, false)
-		if err != nil {
-			return "", err
-		}
-		return fmt.Sprintf("%s.%s", goImport.Name, goType), nil
 	}
+	// The type belongs to the remote document, so unlike the local refs, its
+	// name isn't looked up in our spec, where a schema of the same name may
+	// have been renamed.
+	pathParts = strings.Split(flatComponent, "/")
+	if depth := len(pathParts); depth != 4 && depth != 2 {
+		return "", fmt.Errorf("unexpected reference depth: %d for ref: %s local: %t", depth, refPath, local)
+	}
+	goType := SchemaNameToTypeName(pathParts[len(pathParts)-1])
+	return fmt.Sprintf("%s.%s", goImport.Name, goType), nil
+}
+
+// documentIdentity returns the canonical form of the location of a document,
+// as written in a ref or an import mapping, with its relative path cleaned.
+func documentIdentity(document string) string {
+	if u, err := url.Parse(document); err == nil && u.IsAbs() {
+		return document
+	}
+	return path.Clean(document)
+}
+
+// resolveDocumentRef returns ref, found in the document remoteComponent, as
+// it is written from our spec: its local refs point into remoteComponent,
+// and its relative refs to other documents are resolved against it.
+func resolveDocumentRef(remoteComponent string, ref string) string {
+	if ref == "" {
+		return ref
+	}
+	if ref[0] == '#' {
+		return remoteComponent + ref
+	}
+	target, err := url.Parse(ref)
+	if err != nil || target.IsAbs() || strings.HasPrefix(target.Path, "/") {
+		return ref
+	}
+	base, err := url.Parse(remoteComponent)
+	if err != nil {
+		return ref
+	}
+	if base.IsAbs() {
+		return base.ResolveReference(target).String()
+	}
+	resolved := path.Join(path.Dir(base.Path), target.Path)
+	if strings.Contains(ref, "#") {
+		resolved += "#" + target.Fragment
+	}
+	return resolved
 }
 
 // IsGoTypeReference takes a $ref value and checks if it has link to go type.
@@ -521,6 +631,37 @@ func ReplacePathParamsWithStr(uri string) string {
 	return pathParamRE.ReplaceAllString(uri, "%s")
 }
 
+// resolveRoutePrefix returns the prefix configured for the operation paths,
+// normalized to have a leading slash and no trailing one.
+func resolveRoutePrefix(spec *openapi3.T, opts OutputOptions) (string, error) {
+	prefix := opts.RoutePrefix
+	if opts.RoutePrefixFromVersion == "major" {
+		var version string
+		if spec.Info != nil {
+			version = spec.Info.Version
+		}
+		major := strings.TrimPrefix(strings.TrimPrefix(version, "v"), "V")
+		if i := strings.IndexFunc(major, func(r rune) bool { return !unicode.IsDigit(r) }); i >= 0 {
+			major = major[:i]
+		}
+		if major == "" {
+			return "", fmt.Errorf("can't derive a route prefix from info.version %q", version)
+		}
+		prefix = "v" + major
+	}
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return "", nil
+	}
+	return "/" + prefix, nil
+}
+
+// routePath returns the path under which an operation is served, that is, its
+// path in the spec with the configured route prefix.
+func (g *generator) routePath(path string) string {
+	return g.routePrefix + path
+}
+
 // SortParamsByPath reorders the given parameter definitions to match those in the path URI.
 func SortParamsByPath(path string, in []ParameterDefinition) ([]ParameterDefinition, error) {
 	pathParams := OrderedParamsFromUri(path)
@@ -658,6 +799,11 @@ func typeNamePrefix(name string) (prefix string) {
 			if len(name) == 1 {
 				return "DollarSign"
 			}
+		case '_':
+			// Leading underscores are dropped, unless there is nothing else
+			if strings.Trim(name, "_") == "" {
+				return "Underscore"
+			}
 		case '-':
 			prefix += "Minus"
 		case '+':
@@ -686,6 +832,12 @@ func typeNamePrefix(name string) (prefix string) {
 				return "N"
 			}
 
+			// Prepend "X" to schemas starting with a letter without case,
+			// which can't be exported otherwise
+			if prefix == "" && unicode.IsLetter(r) && !unicode.IsUpper(r) && !unicode.IsLower(r) {
+				return "X"
+			}
+
 			// break the loop, done parsing prefix
 			return
 		}
@@ -863,6 +1015,24 @@ func renameRequestBody(requestBodyName string, requestBodyRef *openapi3.RequestB
 	return SchemaNameToTypeName(requestBodyName), nil
 }
 
+// renameHeader generates the name for a header, taking x-go-name into
+// account
+func renameHeader(headerName string, headerRef *openapi3.HeaderRef) (string, error) {
+	if headerRef.Ref != "" {
+		return SchemaNameToTypeName(headerName), nil
+	}
+	header := headerRef.Value
+
+	if extension, ok := header.Extensions[extGoName]; ok {
+		typeName, err := extTypeName(extension)
+		if err != nil {
+			return "", fmt.Errorf("invalid value for %q: %w", extPropGoType, err)
+		}
+		return typeName, nil
+	}
+	return SchemaNameToTypeName(headerName), nil
+}
+
 // findSchemaByRefPath turns a $ref path into a schema. This will return ""
 // if the schema wasn't found, and it'll only work successfully for schemas
 // defined within the spec that we parsed.
@@ -902,6 +1072,10 @@ func findSchemaNameByRefPath(refPath string, spec *openapi3.T) (string, error) {
 		if requestBody, found := spec.Components.RequestBodies[propertyName]; found {
 			return renameRequestBody(propertyName, requestBody)
 		}
+	case "headers":
+		if header, found := spec.Components.Headers[propertyName]; found {
+			return renameHeader(propertyName, header)
+		}
 	}
 	return "", nil
 }
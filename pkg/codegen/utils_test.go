@@ -18,6 +18,7 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStringOps(t *testing.T) {
@@ -134,12 +135,10 @@ func TestSortedRequestBodyKeys(t *testing.T) {
 }
 
 func TestRefPathToGoType(t *testing.T) {
-	old := globalState.importMapping
-	globalState.importMapping = constructImportMapping(map[string]string{
+	g := newGenerator(&openapi3.T{}, Configuration{ImportMapping: map[string]string{
 		"doc.json":                    "externalref0",
 		"http://deepmap.com/doc.json": "externalref1",
-	})
-	defer func() { globalState.importMapping = old }()
+	}})
 
 	tests := []struct {
 		name   string
@@ -171,6 +170,11 @@ func TestRefPathToGoType(t *testing.T) {
 			path:   "doc.json#/components/parameters/foo",
 			goType: "externalRef0.Foo",
 		},
+		{
+			name:   "remote-same-document",
+			path:   "./doc.json#/components/schemas/Foo",
+			goType: "externalRef0.Foo",
+		},
 		{
 			name:   "url-root",
 			path:   "http://deepmap.com/doc.json#/foo_bar",
@@ -197,7 +201,7 @@ func TestRefPathToGoType(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			goType, err := RefPathToGoType(tc.path)
+			goType, err := g.refPathToGoType(tc.path, true)
 			if tc.goType == "" {
 				assert.Error(t, err)
 				return
@@ -209,6 +213,14 @@ func TestRefPathToGoType(t *testing.T) {
 	}
 }
 
+func TestResolveDocumentRef(t *testing.T) {
+	assert.Equal(t, "a/doc.yaml#/components/schemas/Foo", resolveDocumentRef("a/doc.yaml", "#/components/schemas/Foo"))
+	assert.Equal(t, "common/doc.yaml#/components/schemas/Foo", resolveDocumentRef("./a/doc.yaml", "../common/doc.yaml#/components/schemas/Foo"))
+	assert.Equal(t, "a/other.yaml", resolveDocumentRef("a/doc.yaml", "other.yaml"))
+	assert.Equal(t, "http://deepmap.com/common/doc.json#/Foo", resolveDocumentRef("http://deepmap.com/a/doc.json", "../common/doc.json#/Foo"))
+	assert.Equal(t, "http://deepmap.com/doc.json#/Foo", resolveDocumentRef("a/doc.yaml", "http://deepmap.com/doc.json#/Foo"))
+}
+
 func TestIsWholeDocumentReference(t *testing.T) {
 	assert.Equal(t, false, IsWholeDocumentReference(""))
 	assert.Equal(t, false, IsWholeDocumentReference("#/components/schemas/Foo"))
@@ -327,6 +339,46 @@ func TestReplacePathParamsWithStr(t *testing.T) {
 	assert.EqualValues(t, "/path/%s/%s/%s/foo", result)
 }
 
+func TestResolveRoutePrefix(t *testing.T) {
+	spec := &openapi3.T{Info: &openapi3.Info{Version: "v2.3.1"}}
+
+	for _, tc := range []struct {
+		opts     OutputOptions
+		expected string
+	}{
+		{OutputOptions{}, ""},
+		{OutputOptions{RoutePrefix: "/"}, ""},
+		{OutputOptions{RoutePrefix: "v1"}, "/v1"},
+		{OutputOptions{RoutePrefix: "/api/v1/"}, "/api/v1"},
+		{OutputOptions{RoutePrefixFromVersion: "major"}, "/v2"},
+	} {
+		prefix, err := resolveRoutePrefix(spec, tc.opts)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, prefix)
+	}
+
+	_, err := resolveRoutePrefix(&openapi3.T{Info: &openapi3.Info{Version: "latest"}}, OutputOptions{RoutePrefixFromVersion: "major"})
+	assert.Error(t, err)
+}
+
+func TestContentTypeNames(t *testing.T) {
+	names, err := contentTypeNames([]string{
+		"application/json",
+		"application/json; version=2",
+		"application/vnd.api+json; charset=utf-8",
+		"image/png",
+	}, contentTypeTag)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"application/json":                        "JSON",
+		"application/json; version=2":             "JSONVersion2",
+		"application/vnd.api+json; charset=utf-8": "ApplicationVndAPIPlusJSON",
+	}, names)
+
+	_, err = contentTypeNames([]string{"application/json; version=2", "application/json;version=2"}, contentTypeTag)
+	assert.EqualError(t, err, `content types "application/json; version=2" and "application/json;version=2" would both be named JSONVersion2`)
+}
+
 func TestStringToGoComment(t *testing.T) {
 	testCases := []struct {
 		input    string
@@ -459,9 +511,14 @@ func TestSchemaNameToTypeName(t *testing.T) {
 		"&now":         "AndNow",
 		"~":            "Tilde",
 		"_foo":         "Foo",
+		"__":           "Underscore",
+		"_2fa":         "N2fa",
+		"type":         "Type",
 		"=3":           "Equal3",
 		"#Tag":         "HashTag",
 		".com":         "DotCom",
+		"日本":           "X日本",
+		"ünïcode":      "Ünïcode",
 	} {
 		assert.Equal(t, want, SchemaNameToTypeName(in))
 	}
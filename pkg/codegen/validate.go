@@ -0,0 +1,290 @@
+package codegen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ValidateDefinition describes the Validate method generated for a struct
+// type.
+type ValidateDefinition struct {
+	TypeName string
+	// Checks are statements returning an error when a constraint doesn't
+	// hold.
+	Checks []string
+}
+
+// validateGenerator builds the checks of the Validate methods, resolving the
+// types fields refer to among the generated ones.
+type validateGenerator struct {
+	types map[string]TypeDefinition
+	// validated holds the structs which get a Validate method, because they,
+	// or a struct they hold, have a constraint to check.
+	validated map[string]bool
+	// usesUniqueItems, usesUniqueJSONItems and usesCheckCount are set when
+	// the checks rely on the generated helpers of the same name.
+	usesUniqueItems     bool
+	usesUniqueJSONItems bool
+	usesCheckCount      bool
+}
+
+// generateValidateBoilerplate generates a Validate method for the structs
+// among the given type definitions which have constraints the Go types don't
+// enforce by themselves, and for the arrays and maps which have some
+// themselves. These are the uniqueItems, minItems and maxItems of arrays, and
+// the minProperties and maxProperties of objects.
+func (g *generator) generateValidateBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	v := validateGenerator{
+		types:     make(map[string]TypeDefinition),
+		validated: make(map[string]bool),
+	}
+
+	var structTypes, collectionTypes []TypeDefinition
+	for _, td := range typeDefs {
+		if _, found := v.types[td.TypeName]; found {
+			continue
+		}
+		v.types[td.TypeName] = td
+		if td.IsAlias() || td.Schema.IsRef() {
+			continue
+		}
+		if isStructType(td.Schema.GoType) {
+			structTypes = append(structTypes, td)
+		} else if isCollectionType(td.Schema.GoType) {
+			collectionTypes = append(collectionTypes, td)
+		}
+	}
+
+	// A struct holding a validated struct is validated too, which takes as
+	// many passes as structs are nested.
+	for changed := true; changed; {
+		changed = false
+		for _, td := range structTypes {
+			if !v.validated[td.TypeName] && len(v.structChecks("t", td.TypeName, "", td.Schema)) != 0 {
+				v.validated[td.TypeName] = true
+				changed = true
+			}
+		}
+	}
+
+	var definitions []ValidateDefinition
+	for _, td := range structTypes {
+		if v.validated[td.TypeName] {
+			checks := v.structChecks("t", td.TypeName, "", td.Schema)
+			g.warnPatternProperties(td.TypeName, td.Schema)
+			definitions = append(definitions, ValidateDefinition{
+				TypeName: td.TypeName,
+				Checks:   checks,
+			})
+		}
+	}
+	// Arrays and maps are checked where they are held, like the fields of
+	// their type, but get a Validate method of their own for when they are
+	// used by themselves, eg, as a request body.
+	for _, td := range collectionTypes {
+		if check := v.check(td.TypeName, "t", "t", td.Schema); check != "" {
+			definitions = append(definitions, ValidateDefinition{
+				TypeName: td.TypeName,
+				Checks:   []string{check},
+			})
+		}
+	}
+	if len(definitions) == 0 {
+		return "", nil
+	}
+
+	context := struct {
+		Types               []ValidateDefinition
+		UsesUniqueItems     bool
+		UsesUniqueJSONItems bool
+		UsesCheckCount      bool
+	}{
+		Types:               definitions,
+		UsesUniqueItems:     v.usesUniqueItems,
+		UsesUniqueJSONItems: v.usesUniqueJSONItems,
+		UsesCheckCount:      v.usesCheckCount,
+	}
+
+	return GenerateTemplates([]string{"validate.tmpl"}, t, context)
+}
+
+// structChecks returns the checks of the struct held in v, named name in
+// errors, and of its fields, whose names are prefixed with prefix.
+func (g *validateGenerator) structChecks(v string, name string, prefix string, s Schema) []string {
+	var checks []string
+	if check := g.propertyCountCheck(v, name, s); check != "" {
+		checks = append(checks, check)
+	}
+	for _, p := range s.Properties {
+		field := v + "." + p.GoFieldName()
+		pointer := isPointerField(p)
+		value := field
+		if pointer {
+			value = "*" + field
+		}
+
+		check := g.check(prefix+p.JsonFieldName, value, field, p.Schema)
+		if check == "" {
+			continue
+		}
+		if pointer {
+			check = fmt.Sprintf("if %s != nil {\n%s\n}", field, check)
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// isPointerField returns whether the field of property p is a pointer.
+func isPointerField(p Property) bool {
+	return strings.HasPrefix(fieldTypeDef(p), "*")
+}
+
+// check returns the check of a field named name, holding value, which is
+// accessed through field, or an empty string when there is nothing to check.
+func (g *validateGenerator) check(name string, value string, field string, s Schema) string {
+	goType := s.TypeDecl()
+
+	if g.validated[goType] {
+		return fmt.Sprintf("if err := %s.Validate(); err != nil {\nreturn fmt.Errorf(\"%s: %%w\", err)\n}", field, name)
+	}
+	if isStructType(goType) {
+		checks := g.structChecks(field, name, name+".", s)
+		return strings.Join(checks, "\n")
+	}
+
+	if s.OAPISchema == nil {
+		return ""
+	}
+	items := s.ArrayType
+	// References to array and map types hold the name of the type rather
+	// than the array or map.
+	if td, found := g.types[goType]; found && !isStructType(td.Schema.GoType) {
+		if items == nil {
+			items = td.Schema.ArrayType
+		}
+		goType = td.Schema.GoType
+	}
+
+	var checks []string
+	if check := g.itemCountCheck(name, value, goType, s.OAPISchema); check != "" {
+		checks = append(checks, check)
+	}
+	// Arrays generated as an OrderedSet are unique by construction.
+	if s.OAPISchema.UniqueItems && items != nil && strings.HasPrefix(goType, "[]") {
+		helper := "uniqueJSONItems"
+		if isComparableSchema(*items) {
+			helper = "uniqueItems"
+			g.usesUniqueItems = true
+		} else {
+			g.usesUniqueJSONItems = true
+		}
+		checks = append(checks, fmt.Sprintf("if err := %s(%q, %s); err != nil {\nreturn err\n}", helper, name, value))
+	}
+	return strings.Join(checks, "\n")
+}
+
+// itemCountCheck returns the check of the minItems and maxItems, or the
+// minProperties and maxProperties, of value, an array or a map of goType
+// named name, or an empty string when it has none.
+func (g *validateGenerator) itemCountCheck(name string, value string, goType string, s *openapi3.Schema) string {
+	var count, unit string
+	var minCount uint64
+	var maxCount *uint64
+	switch {
+	case strings.HasPrefix(goType, "[]"):
+		count, unit, minCount, maxCount = "len("+value+")", "items", s.MinItems, s.MaxItems
+	case strings.HasPrefix(goType, "OrderedSet["):
+		count, unit, minCount, maxCount = "("+value+").Len()", "items", s.MinItems, s.MaxItems
+	case strings.HasPrefix(goType, "map["):
+		count, unit, minCount, maxCount = "len("+value+")", "properties", s.MinProps, s.MaxProps
+	default:
+		return ""
+	}
+	if minCount == 0 && maxCount == nil {
+		return ""
+	}
+	g.usesCheckCount = true
+	return fmt.Sprintf("if err := checkCount(%q, %q, %s, %d, %s); err != nil {\nreturn err\n}",
+		name, unit, count, minCount, maxCountArg(maxCount))
+}
+
+// propertyCountCheck returns the check of the minProperties and
+// maxProperties of the struct held in v, named name, or an empty string when
+// it has none. The properties counted are the required ones, the optional ones
+// which are set, and the additional ones. When additional properties aren't
+// allowed, a maxProperties which isn't below the number of properties always
+// holds, and isn't checked.
+func (g *validateGenerator) propertyCountCheck(v string, name string, s Schema) string {
+	if s.OAPISchema == nil || len(s.UnionElements) != 0 {
+		return ""
+	}
+	minCount, maxCount := s.OAPISchema.MinProps, s.OAPISchema.MaxProps
+	if s.NoAdditionalProperties && maxCount != nil && *maxCount >= uint64(len(s.Properties)) {
+		maxCount = nil
+	}
+	if minCount == 0 && maxCount == nil {
+		return ""
+	}
+
+	required := 0
+	var optional []string
+	for _, p := range s.Properties {
+		field := v + "." + p.GoFieldName()
+		switch {
+		case p.Required:
+			required++
+		case isPointerField(p):
+			optional = append(optional, fmt.Sprintf("if %s != nil {\nn++\n}", field))
+		default:
+			optional = append(optional, fmt.Sprintf("if !reflect.ValueOf(%s).IsZero() {\nn++\n}", field))
+		}
+	}
+	count := strconv.Itoa(required)
+	if s.HasAdditionalProperties {
+		count = "len(" + v + ".AdditionalProperties)"
+		if required != 0 {
+			count = strconv.Itoa(required) + " + " + count
+		}
+	}
+
+	g.usesCheckCount = true
+	return fmt.Sprintf("{\nn := %s\n%s\nif err := checkCount(%q, \"properties\", n, %d, %s); err != nil {\nreturn err\n}\n}",
+		count, strings.Join(optional, "\n"), name, minCount, maxCountArg(maxCount))
+}
+
+// maxCountArg returns the maximum count passed to checkCount, which is -1 when
+// there is none.
+func maxCountArg(maxCount *uint64) string {
+	if maxCount == nil {
+		return "-1"
+	}
+	return strconv.FormatUint(*maxCount, 10)
+}
+
+// warnPatternProperties warns about the minProperties or maxProperties of the
+// struct type named typeName, when they count properties matched by
+// patternProperties, which OpenAPI 3.0 doesn't have. These properties are only
+// kept, and counted, as additional properties, when the struct has them.
+func (g *generator) warnPatternProperties(typeName string, s Schema) {
+	if s.OAPISchema == nil || (s.OAPISchema.MinProps == 0 && s.OAPISchema.MaxProps == nil) {
+		return
+	}
+	if _, found := s.OAPISchema.Extensions["patternProperties"]; !found {
+		return
+	}
+	if !s.HasAdditionalProperties {
+		g.addWarning(typeName, "patternProperties isn't supported, and additional properties aren't kept, so the properties it matches are dropped, and don't count towards minProperties and maxProperties")
+		return
+	}
+	g.addWarning(typeName, "patternProperties isn't supported, so the properties it matches are kept, and counted towards minProperties and maxProperties, as additional properties")
+}
+
+// isCollectionType returns whether goType is an array or a map.
+func isCollectionType(goType string) bool {
+	return strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[") || strings.HasPrefix(goType, "OrderedSet[")
+}